@@ -217,6 +217,24 @@ func JwtParse(tokenStr string, keys ...string) (*jwt.Token, []byte, error) {
 	// return nil, nil, utils.Errorf("cannot guess jwt key token: %v", tokenStr)
 }
 
+// JwtGuessKey 使用给定的密钥与内置弱密钥校验 JWT 的签名，只校验签名而忽略过期时间等声明，返回通过校验的密钥
+func JwtGuessKey(tokenStr string, keys ...string) ([]byte, bool) {
+	parser := &jwt.Parser{SkipClaimsValidation: true}
+	candidates := make([]string, 0, len(keys)+len(weakJWTTokenKeys))
+	candidates = append(candidates, keys...)
+	candidates = append(candidates, weakJWTTokenKeys...)
+	for _, i := range candidates {
+		token, err := parser.Parse(tokenStr, func(token *jwt.Token) (interface{}, error) {
+			return []byte(i), nil
+		})
+		if err != nil || !token.Valid {
+			continue
+		}
+		return []byte(i), true
+	}
+	return nil, false
+}
+
 func JwtChangeAlgToNone(token string) (string, error) {
 	t, _, err := JwtParse(token)
 	if err != nil {
@@ -130,3 +130,24 @@ func TestJwtParse5(t *testing.T) {
 	println(string(secret))
 	spew.Dump(tokenIns)
 }
+
+func TestJwtGuessKey(t *testing.T) {
+	test := assert.New(t)
+	token, err := JwtGenerate("HS256", map[string]interface{}{
+		"user": "admin",
+		"exp":  1,
+	}, "", []byte("my-own-secret"))
+	test.Nil(err)
+
+	_, ok := JwtGuessKey(token)
+	test.False(ok)
+	key, ok := JwtGuessKey(token, "wrong", "my-own-secret")
+	test.True(ok)
+	test.Equal("my-own-secret", string(key))
+
+	token, err = JwtGenerate("HS256", map[string]interface{}{"user": "admin"}, "", []byte("secret"))
+	test.Nil(err)
+	key, ok = JwtGuessKey(token)
+	test.True(ok)
+	test.Equal("secret", string(key))
+}
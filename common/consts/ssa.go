@@ -21,6 +21,8 @@ const (
 	PHP  Language = "php"
 	JAVA Language = "java"
 	GO   Language = "golang"
+	PY   Language = "python"
+	C    Language = "c"
 )
 
 func ValidateLanguage(language string) (Language, error) {
@@ -35,6 +37,10 @@ func ValidateLanguage(language string) (Language, error) {
 		return JS, nil
 	case "go", "golang":
 		return GO, nil
+	case "py", "python", "python3":
+		return PY, nil
+	case "c", "cpp", "c++", "cxx":
+		return C, nil
 	}
 	return "", errors.Errorf("unsupported language: %s", language)
 }
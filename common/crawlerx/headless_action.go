@@ -0,0 +1,224 @@
+// Package crawlerx
+package crawlerx
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-rod/rod"
+	"github.com/go-rod/rod/lib/proto"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// HeadlessAction 无头浏览器中的一个页面动作，与 nuclei headless 模板中 steps 的定义保持一致
+type HeadlessAction struct {
+	Action string
+	Name   string
+	Args   map[string]string
+}
+
+func (a *HeadlessAction) arg(keys ...string) string {
+	for _, key := range keys {
+		if v, ok := a.Args[key]; ok && v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+// HeadlessResult 执行完所有页面动作后的结果
+type HeadlessResult struct {
+	URL  string
+	HTML string
+	// Outputs 具名动作（script / extract / screenshot）的输出
+	Outputs map[string]string
+}
+
+// RunHeadlessActions 启动浏览器并在同一个页面中依次执行动作，返回最终页面内容以及具名动作的输出
+func RunHeadlessActions(actions []*HeadlessAction, opts ...ConfigOpt) (*HeadlessResult, error) {
+	config := NewConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.baseConfig.ctx == nil {
+		config.baseConfig.ctx = context.Background()
+	}
+	if config.baseConfig.targetUrl == "" {
+		for _, action := range actions {
+			if strings.ToLower(action.Action) == "navigate" {
+				config.baseConfig.targetUrl = action.arg("url")
+				break
+			}
+		}
+	}
+	var browserConfig *BrowserConfig
+	if len(config.browsers) > 0 {
+		browserConfig = config.browsers[0]
+	} else {
+		browserConfig = &BrowserConfig{}
+	}
+	starter := NewBrowserStarter(browserConfig, config.baseConfig)
+	defer starter.cancel()
+	if err := starter.baseBrowserStarter(); err != nil {
+		return nil, err
+	}
+	defer starter.browser.Close()
+
+	page, err := starter.browser.Page(proto.TargetCreateTarget{URL: "about:blank"})
+	if err != nil {
+		return nil, utils.Errorf("create page error: %v", err)
+	}
+	if len(starter.headers) > 0 {
+		var dict []string
+		for _, header := range starter.headers {
+			dict = append(dict, header.Key, header.Value)
+		}
+		if _, err := page.SetExtraHeaders(dict); err != nil {
+			log.Warnf("set extra headers error: %v", err)
+		}
+	}
+
+	pageTimeout := time.Duration(config.baseConfig.pageTimeout) * time.Second
+	if pageTimeout <= 0 {
+		pageTimeout = 30 * time.Second
+	}
+	result := &HeadlessResult{Outputs: make(map[string]string)}
+	for _, action := range actions {
+		output, err := doHeadlessAction(page.Timeout(pageTimeout), action)
+		if err != nil {
+			return nil, utils.Errorf("headless action %v error: %v", action.Action, err)
+		}
+		if action.Name != "" && output != "" {
+			result.Outputs[action.Name] = output
+		}
+	}
+
+	page = page.Timeout(pageTimeout)
+	if info, err := page.Info(); err == nil {
+		result.URL = info.URL
+	}
+	result.HTML, err = page.HTML()
+	if err != nil {
+		return nil, utils.Errorf("get page html error: %v", err)
+	}
+	return result, nil
+}
+
+// headlessElement 根据 by 参数选择元素：xpath / regex / css selector（默认）
+func headlessElement(page *rod.Page, action *HeadlessAction) (*rod.Element, error) {
+	switch strings.ToLower(action.arg("by")) {
+	case "x", "xpath":
+		return page.ElementX(action.arg("xpath"))
+	case "r", "regex":
+		return page.ElementR(action.arg("selector"), action.arg("regex"))
+	case "", "s", "selector", "css":
+		if xpath := action.arg("xpath"); xpath != "" {
+			return page.ElementX(xpath)
+		}
+		return page.Element(action.arg("selector"))
+	default:
+		return nil, utils.Errorf("unsupported element selector type: %v", action.arg("by"))
+	}
+}
+
+func doHeadlessAction(page *rod.Page, action *HeadlessAction) (string, error) {
+	switch strings.ToLower(action.Action) {
+	case "navigate":
+		if err := page.Navigate(action.arg("url")); err != nil {
+			return "", err
+		}
+		return "", page.WaitLoad()
+	case "waitload", "waitdom", "waitfcp", "waitfmp":
+		return "", page.WaitLoad()
+	case "waitidle", "waitstable":
+		return "", page.WaitIdle(5 * time.Second)
+	case "sleep":
+		duration, _ := strconv.ParseFloat(action.arg("duration"), 64)
+		if duration <= 0 {
+			duration = 1
+		}
+		time.Sleep(utils.FloatSecondDuration(duration))
+		return "", nil
+	case "click", "rightclick":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		button := proto.InputMouseButtonLeft
+		if strings.ToLower(action.Action) == "rightclick" {
+			button = proto.InputMouseButtonRight
+		}
+		return "", element.Click(button, 1)
+	case "text":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		_ = element.SelectAllText()
+		return "", element.Input(action.arg("value"))
+	case "keyboard":
+		return "", page.InsertText(action.arg("keys"))
+	case "select":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		return "", element.Select([]string{action.arg("value")}, true, rod.SelectorTypeText)
+	case "files":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		return "", element.SetFiles([]string{action.arg("value")})
+	case "waitvisible", "waitelem":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		return "", element.WaitVisible()
+	case "extract":
+		element, err := headlessElement(page, action)
+		if err != nil {
+			return "", err
+		}
+		if strings.ToLower(action.arg("target")) == "attribute" {
+			attr, err := element.Attribute(action.arg("attribute"))
+			if err != nil || attr == nil {
+				return "", err
+			}
+			return *attr, nil
+		}
+		return element.Text()
+	case "script":
+		// 使用 eval 执行，既支持表达式也支持多条语句（取最后一条语句的值）
+		res, err := page.Eval(`(code) => eval(code)`, action.arg("code"))
+		if err != nil {
+			return "", err
+		}
+		if res == nil || res.Value.Nil() {
+			return "", nil
+		}
+		if s, ok := res.Value.Val().(string); ok {
+			return s, nil
+		}
+		return res.Value.JSON("", ""), nil
+	case "screenshot":
+		raw, err := page.Screenshot(strings.ToLower(action.arg("fullpage")) == "true", &proto.PageCaptureScreenshot{
+			Format: proto.PageCaptureScreenshotFormatPng,
+		})
+		if err != nil {
+			return "", err
+		}
+		return "data:image/png;base64," + base64.StdEncoding.EncodeToString(raw), nil
+	case "setheader", "addheader":
+		_, err := page.SetExtraHeaders([]string{action.arg("key"), action.arg("value")})
+		return "", err
+	default:
+		log.Warnf("headless action %v is not supported, skipped", action.Action)
+		return "", nil
+	}
+}
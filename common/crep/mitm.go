@@ -23,6 +23,7 @@ import (
 
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/minimartian"
 	"github.com/yaklang/yaklang/common/minimartian/mitm"
 	"github.com/yaklang/yaklang/common/utils"
@@ -224,6 +225,7 @@ type MITMServer struct {
 	dnsCache              *sync.Map
 	lowerHeaders          []string
 	http2                 bool
+	http3                 bool
 	gmtls                 bool
 	gmPrefer              bool
 	gmOnly                bool
@@ -237,6 +239,7 @@ type MITMServer struct {
 	allowForwarded bool
 	// httpTransport            *http.Transport
 	proxyUrl                 *url.URL
+	proxyRouter              *netx.ProxyRouter
 	hijackedMaxContentLength int
 
 	// transparent hijack mode
@@ -253,6 +256,10 @@ type MITMServer struct {
 	responseHijackHandler func(isHttps bool, r *http.Request, rspIns *http.Response, rsp []byte, remoteAddr string) []byte
 	httpFlowMirror        func(isHttps bool, r *http.Request, rsp *http.Response, startTs int64)
 
+	// passthrough(filtered) traffic rewriter, return nil to drop
+	passthroughRequestRewriter  func(isHttps bool, r *http.Request, req []byte) []byte
+	passthroughResponseRewriter func(isHttps bool, r *http.Request, rsp []byte) []byte
+
 	// websocket
 	websocketHijackMode            *utils.AtomicBool
 	forceTextFrame                 *utils.AtomicBool
@@ -320,6 +327,9 @@ func (m *MITMServer) ServeWithListenedCallback(ctx context.Context, addr string,
 		}
 		return []string{m.proxyUrl.String()}
 	}))
+	if m.proxyRouter != nil {
+		config = append(config, lowhttp.WithProxyRouter(m.proxyRouter))
+	}
 
 	if len(m.DNSServers) > 0 {
 		config = append(config, lowhttp.WithDNSServers(m.DNSServers))
@@ -350,6 +360,20 @@ func (m *MITMServer) ServeWithListenedCallback(ctx context.Context, addr string,
 	}
 	defer lis.Close()
 
+	if m.http3 {
+		// HTTP/3 is served on the udp port with the same number
+		pc, err := net.ListenPacket("udp", lis.Addr().String())
+		if err != nil {
+			return utils.Errorf("listen udp port: %v failed: %s", addr, err)
+		}
+		defer pc.Close()
+		go func() {
+			if err := m.proxy.ServeHTTP3(ctx, pc); err != nil && ctx.Err() == nil {
+				log.Errorf("serve mitm http3 failed: %s", err)
+			}
+		}()
+	}
+
 	if callback != nil {
 		callback()
 	}
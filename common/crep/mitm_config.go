@@ -15,6 +15,7 @@ import (
 	"github.com/yaklang/yaklang/common/minimartian"
 	"github.com/yaklang/yaklang/common/minimartian/h2"
 	"github.com/yaklang/yaklang/common/minimartian/mitm"
+	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
@@ -183,6 +184,15 @@ func MITM_SetHTTP2(b bool) MITMConfig {
 	}
 }
 
+// MITM_SetHTTP3 also serves HTTP/3 (QUIC) on the udp port of the same number,
+// requests are relayed upstream over HTTP/3 and saved like the others.
+func MITM_SetHTTP3(b bool) MITMConfig {
+	return func(server *MITMServer) error {
+		server.http3 = b
+		return nil
+	}
+}
+
 func MITM_SetGM(b bool) MITMConfig {
 	return func(server *MITMServer) error {
 		server.gmtls = b
@@ -239,6 +249,14 @@ func MITM_SetDownstreamProxy(s string) MITMConfig {
 	}
 }
 
+// MITM_SetDownstreamProxyRouter 设置下游代理路由表，未设置 DownstreamProxy 时按目标选择代理链
+func MITM_SetDownstreamProxyRouter(r *netx.ProxyRouter) MITMConfig {
+	return func(server *MITMServer) error {
+		server.proxyRouter = r
+		return nil
+	}
+}
+
 func MITM_SetHTTPRequestHijackRaw(c func(isHttps bool, reqIns *http.Request, req []byte) []byte) MITMConfig {
 	return func(server *MITMServer) error {
 		server.requestHijackHandler = c
@@ -253,6 +271,22 @@ func MITM_SetHTTPResponseHijackRaw(c func(isHttps bool, req *http.Request, rspIn
 	}
 }
 
+// MITM_SetPassthroughRequestRewriter 设置对被过滤（直接放行）请求的改写函数，返回 nil 表示丢弃该请求
+func MITM_SetPassthroughRequestRewriter(c func(isHttps bool, reqIns *http.Request, req []byte) []byte) MITMConfig {
+	return func(server *MITMServer) error {
+		server.passthroughRequestRewriter = c
+		return nil
+	}
+}
+
+// MITM_SetPassthroughResponseRewriter 设置对被过滤（直接放行）响应的改写函数，返回 nil 表示丢弃该响应
+func MITM_SetPassthroughResponseRewriter(c func(isHttps bool, req *http.Request, rsp []byte) []byte) MITMConfig {
+	return func(server *MITMServer) error {
+		server.passthroughResponseRewriter = c
+		return nil
+	}
+}
+
 func MITM_ProxyAuth(username string, password string) MITMConfig {
 	return func(server *MITMServer) error {
 		if username == "" || password == "" {
@@ -178,6 +178,10 @@ func (m *MITMServer) hijackRequestHandler(rootCtx context.Context, wsModifier *W
 			return utils.Error("request hijacker error: MITM Proxy Context Canceled")
 		default:
 		}
+		// filtered request is not hijacked, but passthrough rewriter still works
+		if hijackedRequestRaw != nil && m.passthroughRequestRewriter != nil && httpctx.IsFiltered(req) {
+			hijackedRequestRaw = m.passthroughRequestRewriter(isHttps, req, hijackedRequestRaw)
+		}
 		if hijackedRequestRaw == nil {
 			httpctx.SetContextValueInfoFromRequest(req, httpctx.REQUEST_CONTEXT_KEY_IsDropped, true)
 		} else {
@@ -268,7 +272,7 @@ func (m *MITMServer) hijackResponseHandler(rsp *http.Response) error {
 		}
 
 		if httpctx.IsFiltered(req) {
-			return nil
+			return m.rewritePassthroughResponse(rsp, responseBytes)
 		}
 		isHttps := httpctx.GetRequestHTTPS(rsp.Request)
 		result := m.responseHijackHandler(isHttps, req, rsp, responseBytes, httpctx.GetRemoteAddr(req))
@@ -320,6 +324,31 @@ func (m *MITMServer) hijackResponseHandler(rsp *http.Response) error {
 	return nil
 }
 
+func (m *MITMServer) rewritePassthroughResponse(rsp *http.Response, responseBytes []byte) error {
+	if m.passthroughResponseRewriter == nil {
+		return nil
+	}
+	req := rsp.Request
+	result := m.passthroughResponseRewriter(httpctx.GetRequestHTTPS(req), req, responseBytes)
+	if result == nil {
+		newRsp := proxyutil.NewResponseFromOldResponse(200, strings.NewReader("响应被用户丢弃"), req, rsp)
+		*rsp = *newRsp
+		return minimartian.IsDroppedError
+	}
+	if bytes.Equal(result, responseBytes) {
+		return nil
+	}
+	resultRsp, err := utils.ReadHTTPResponseFromBytes(result, nil)
+	if err != nil {
+		log.Errorf("parse passthrough rewritten response failed: %s", err)
+		return nil
+	}
+	*rsp = *resultRsp
+	rsp.Request = req
+	rsp.TLS = req.TLS
+	return nil
+}
+
 func handleBuildInMITMDefaultPageResponse(rsp *http.Response) error {
 	if strings.HasPrefix(rsp.Request.URL.Path, "/static") {
 		filePath := strings.TrimPrefix(rsp.Request.URL.Path, "/static/")
@@ -94,6 +94,9 @@ type Config struct {
 	// ctx
 	Ctx context.Context
 
+	// 对 TLS 服务进行主动指纹识别（JARM / JA4S / 证书链），JARM 会额外建立 10 个连接，默认关闭
+	EnableTLSFingerprint bool
+
 	// Disable default fingerprint
 	DisableDefaultFingerprint    bool
 	DisableDefaultIotFingerprint bool
@@ -467,6 +470,25 @@ func WithProbeTimeoutHumanRead(f float64) ConfigOption {
 	}
 }
 
+// tlsFingerprint servicescan 的配置选项，对开放的 TLS 服务进行 JARM / JA4S / 证书链指纹识别，并与本地指纹库匹配
+// @param {bool} b 是否启用
+// @return {ConfigOption} 返回配置项
+// Example:
+// ```
+// result, err = servicescan.Scan("127.0.0.1", "443,8443", servicescan.tlsFingerprint(true))
+// die(err)
+//
+//	for v := range result {
+//		fmt.Println(v.String(), v.GetJARM(), v.GetTLSFingerprintNames())
+//	}
+//
+// ```
+func WithTLSFingerprint(b bool) ConfigOption {
+	return func(config *Config) {
+		config.EnableTLSFingerprint = b
+	}
+}
+
 func WithForceEnableAllFingerprint(b bool) ConfigOption {
 	return func(config *Config) {
 		config.ForceEnableAllFingerprint = b
@@ -14,6 +14,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/fp/webfingerprint"
+	"github.com/yaklang/yaklang/common/ja3"
 	log "github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
 	"github.com/yaklang/yaklang/common/netx"
@@ -121,6 +122,9 @@ func (m *MatchResult) Merge(f *MatchResult) {
 	m.Fingerprint.ServiceName = strings.Trim(m.Fingerprint.ServiceName, "/")
 
 	m.Fingerprint.HttpFlows = append(m.Fingerprint.HttpFlows, f.Fingerprint.HttpFlows...)
+	if m.Fingerprint.TLSFingerprint == nil {
+		m.Fingerprint.TLSFingerprint = f.Fingerprint.TLSFingerprint
+	}
 	if f.Fingerprint.CPEFromUrls != nil && m.Fingerprint.CPEFromUrls != nil {
 		for k, v := range f.Fingerprint.CPEFromUrls {
 			_, ok := m.Fingerprint.CPEFromUrls[k]
@@ -340,6 +344,39 @@ func (m *MatchResult) GetProto() TransportProto {
 	return m.Fingerprint.Proto
 }
 
+func (m *MatchResult) GetTLSFingerprint() *ja3.ServerTLSFingerprint {
+	if m == nil || m.Fingerprint == nil {
+		return nil
+	}
+	return m.Fingerprint.TLSFingerprint
+}
+
+func (m *MatchResult) GetJARM() string {
+	if f := m.GetTLSFingerprint(); f != nil {
+		return f.JARM
+	}
+	return ""
+}
+
+func (m *MatchResult) GetJA4S() string {
+	if f := m.GetTLSFingerprint(); f != nil {
+		return f.JA4S
+	}
+	return ""
+}
+
+func (m *MatchResult) GetCertChainHash() string {
+	if f := m.GetTLSFingerprint(); f != nil {
+		return f.CertChainHash
+	}
+	return ""
+}
+
+// GetTLSFingerprintNames 返回命中本地 TLS 指纹库的名称，例如 C2 框架
+func (m *MatchResult) GetTLSFingerprintNames() []string {
+	return m.GetTLSFingerprint().MatchedNames()
+}
+
 func (m *MatchResult) GetDomains() []string {
 	if ret := net.ParseIP(utils2.FixForParseIP(m.Target)); ret == nil {
 		return []string{m.Target}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/jinzhu/copier"
 	"github.com/pkg/errors"
+	"github.com/yaklang/yaklang/common/ja3"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/netx"
 	utils2 "github.com/yaklang/yaklang/common/utils"
@@ -169,6 +170,19 @@ func (f *Matcher) MatchWithContext(ctx context.Context, host string, port int, o
 	// if port open, check tls...
 	if matchResult.State == OPEN && matchResult.Fingerprint != nil {
 		matchResult.Fingerprint.TLSInspectResults, _ = netx.TLSInspectTimeout(utils2.HostPort(host, port), 5)
+		if config.EnableTLSFingerprint && len(matchResult.Fingerprint.TLSInspectResults) > 0 {
+			tlsFp, err := ja3.ServerFingerprint(
+				utils2.HostPort(host, port),
+				ja3.WithContext(ctx),
+				ja3.WithTimeout(config.ProbeTimeout.Seconds()),
+				ja3.WithProxy(config.Proxies...),
+			)
+			if err != nil {
+				log.Debugf("tls fingerprint %v failed: %s", utils2.HostPort(host, port), err)
+			} else {
+				matchResult.Fingerprint.TLSFingerprint = tlsFp
+			}
+		}
 	}
 
 	matchResult.Tidy()
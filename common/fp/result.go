@@ -7,6 +7,7 @@ import (
 	"github.com/dlclark/regexp2"
 	tablewriter "github.com/olekukonko/tablewriter"
 	"github.com/yaklang/yaklang/common/fp/webfingerprint"
+	"github.com/yaklang/yaklang/common/ja3"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/schema"
@@ -37,6 +38,9 @@ type FingerprintInfo struct {
 
 	// tls info for fill...
 	TLSInspectResults []*netx.TLSInspectResult
+
+	// 主动 TLS 服务端指纹，启用 tlsFingerprint 时填充
+	TLSFingerprint *ja3.ServerTLSFingerprint `json:"tls_fingerprint"`
 }
 
 type HTTPFlow struct {
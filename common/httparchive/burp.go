@@ -0,0 +1,183 @@
+package httparchive
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// burpTimeLayout 与 Java Date.toString() 输出一致，例如 Mon Jan 02 15:04:05 UTC 2006
+const burpTimeLayout = "Mon Jan 02 15:04:05 MST 2006"
+
+// BurpItems 对应 Burp Suite "Save items" 导出的 XML
+type BurpItems struct {
+	XMLName     xml.Name    `xml:"items"`
+	BurpVersion string      `xml:"burpVersion,attr"`
+	ExportTime  string      `xml:"exportTime,attr"`
+	Items       []*BurpItem `xml:"item"`
+}
+
+type BurpItem struct {
+	Time           string     `xml:"time"`
+	URL            burpCDATA  `xml:"url"`
+	Host           burpHost   `xml:"host"`
+	Port           int        `xml:"port"`
+	Protocol       string     `xml:"protocol"`
+	Method         burpCDATA  `xml:"method"`
+	Path           burpCDATA  `xml:"path"`
+	Extension      string     `xml:"extension"`
+	Request        burpBase64 `xml:"request"`
+	Status         int        `xml:"status"`
+	ResponseLength int        `xml:"responselength"`
+	MimeType       string     `xml:"mimetype"`
+	Response       burpBase64 `xml:"response"`
+	Comment        string     `xml:"comment"`
+
+	// Tags 不是 Burp 的标准字段，Burp 导入时会忽略
+	Tags string `xml:"tags,omitempty"`
+}
+
+type burpCDATA struct {
+	Value string `xml:",cdata"`
+}
+
+type burpHost struct {
+	IP    string `xml:"ip,attr"`
+	Value string `xml:",chardata"`
+}
+
+type burpBase64 struct {
+	Base64 bool   `xml:"base64,attr"`
+	Value  string `xml:",cdata"`
+}
+
+func (b burpBase64) bytes() []byte {
+	if !b.Base64 {
+		return []byte(b.Value)
+	}
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimSpace(b.Value))
+	if err != nil {
+		return []byte(b.Value)
+	}
+	return raw
+}
+
+// burpMimeType 将 Content-Type 映射为 Burp 的 MIME type 分类
+func burpMimeType(contentType string) string {
+	contentType = strings.ToLower(contentType)
+	switch {
+	case contentType == "":
+		return ""
+	case strings.Contains(contentType, "html"):
+		return "HTML"
+	case strings.Contains(contentType, "json"):
+		return "JSON"
+	case strings.Contains(contentType, "xml"):
+		return "XML"
+	case strings.Contains(contentType, "javascript"):
+		return "script"
+	case strings.Contains(contentType, "css"):
+		return "CSS"
+	case strings.HasPrefix(contentType, "image/"):
+		return "image"
+	case strings.HasPrefix(contentType, "text/"):
+		return "text"
+	}
+	return ""
+}
+
+func MarshalBurpXML(flows []*Flow) ([]byte, error) {
+	items := &BurpItems{
+		BurpVersion: "yaklang-" + consts.GetYakVersion(),
+		ExportTime:  time.Now().Format(burpTimeLayout),
+	}
+	for _, flow := range flows {
+		u, err := url.Parse(flow.GetUrl())
+		if err != nil {
+			return nil, utils.Errorf("invalid flow url: %v", err)
+		}
+		host, port, _ := utils.ParseStringToHostPort(flow.GetUrl())
+		ip, _, _ := utils.ParseStringToHostPort(flow.RemoteAddr)
+		ext := strings.TrimPrefix(path.Ext(u.Path), ".")
+		if ext == "" {
+			ext = "null"
+		}
+		startedAt := flow.StartedAt
+		if startedAt.IsZero() {
+			startedAt = time.Now()
+		}
+		item := &BurpItem{
+			Time:      startedAt.Format(burpTimeLayout),
+			URL:       burpCDATA{Value: u.String()},
+			Host:      burpHost{IP: ip, Value: host},
+			Port:      port,
+			Protocol:  u.Scheme,
+			Method:    burpCDATA{Value: flow.Method()},
+			Path:      burpCDATA{Value: u.RequestURI()},
+			Extension: ext,
+			Request:   burpBase64{Base64: true, Value: base64.StdEncoding.EncodeToString(flow.Request)},
+			Comment:   flow.Notes,
+			Tags:      strings.Join(flow.Tags, "|"),
+		}
+		if len(flow.Response) > 0 {
+			item.Status = flow.StatusCode()
+			item.ResponseLength = len(flow.Response)
+			item.MimeType = burpMimeType(lowhttp.GetHTTPPacketHeader(flow.Response, "Content-Type"))
+			item.Response = burpBase64{Base64: true, Value: base64.StdEncoding.EncodeToString(flow.Response)}
+		}
+		items.Items = append(items.Items, item)
+	}
+	raw, err := xml.MarshalIndent(items, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), raw...), nil
+}
+
+func ParseBurpXML(raw []byte) ([]*Flow, error) {
+	var items BurpItems
+	decoder := xml.NewDecoder(bytes.NewReader(raw))
+	// Burp 导出的 XML 带有 DOCTYPE 声明，不需要严格校验
+	decoder.Strict = false
+	if err := decoder.Decode(&items); err != nil {
+		return nil, utils.Errorf("unmarshal burp xml failed: %v", err)
+	}
+	var flows []*Flow
+	for i, item := range items.Items {
+		request := item.Request.bytes()
+		if len(request) <= 0 {
+			return nil, utils.Errorf("burp item[%d] without request", i)
+		}
+		flow := &Flow{
+			IsHTTPS:  strings.EqualFold(item.Protocol, "https"),
+			Url:      strings.TrimSpace(item.URL.Value),
+			Request:  request,
+			Response: item.Response.bytes(),
+			Notes:    item.Comment,
+		}
+		if item.Host.IP != "" && item.Port > 0 {
+			flow.RemoteAddr = utils.HostPort(item.Host.IP, item.Port)
+		}
+		if t, err := time.Parse(burpTimeLayout, strings.TrimSpace(item.Time)); err == nil {
+			flow.StartedAt = t
+		} else if t, err := time.Parse(time.UnixDate, strings.TrimSpace(item.Time)); err == nil {
+			flow.StartedAt = t
+		}
+		if item.Tags != "" {
+			flow.Tags = utils.PrettifyListFromStringSplitEx(item.Tags, "|")
+		}
+		if flow.Url == "" && item.Port > 0 {
+			flow.Url = strings.ToLower(item.Protocol) + "://" + utils.HostPort(item.Host.Value, item.Port) + item.Path.Value
+		}
+		flows = append(flows, flow)
+	}
+	return flows, nil
+}
@@ -0,0 +1,242 @@
+package httparchive
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type Format string
+
+const (
+	FormatHAR     Format = "har"
+	FormatBurp    Format = "burp"
+	FormatPostman Format = "postman"
+)
+
+// Flow 是与具体存档格式无关的一条 HTTP 流量，Request / Response 为原始数据包
+type Flow struct {
+	IsHTTPS    bool
+	Url        string
+	Request    []byte
+	Response   []byte
+	RemoteAddr string
+	StartedAt  time.Time
+	Duration   time.Duration
+	Tags       []string
+	Notes      string
+}
+
+func (f *Flow) Method() string {
+	method, _, _ := lowhttp.GetHTTPPacketFirstLine(f.Request)
+	return method
+}
+
+func (f *Flow) StatusCode() int {
+	if len(f.Response) <= 0 {
+		return 0
+	}
+	return lowhttp.GetStatusCodeFromResponse(f.Response)
+}
+
+// GetUrl 返回流量的 URL，为空时从请求包中提取
+func (f *Flow) GetUrl() string {
+	if f.Url != "" {
+		return f.Url
+	}
+	u, err := lowhttp.ExtractURLFromHTTPRequestRaw(f.Request, f.IsHTTPS)
+	if err != nil {
+		return ""
+	}
+	return u.String()
+}
+
+func ParseFormat(s string) (Format, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "har", "har1.2":
+		return FormatHAR, nil
+	case "burp", "burp-xml", "burpxml", "xml":
+		return FormatBurp, nil
+	case "postman", "postman-collection", "postman2.1":
+		return FormatPostman, nil
+	}
+	return "", utils.Errorf("unsupported http archive format: %#v (har / burp / postman)", s)
+}
+
+// DetectFormat 根据内容识别存档格式
+func DetectFormat(raw []byte) (Format, error) {
+	raw = bytes.TrimSpace(bytes.TrimPrefix(raw, []byte("\xef\xbb\xbf")))
+	if bytes.HasPrefix(raw, []byte("<")) {
+		if bytes.Contains(raw, []byte("<items")) {
+			return FormatBurp, nil
+		}
+		return "", utils.Error("unknown xml archive, burp saved items need <items> root")
+	}
+	var probe struct {
+		Log  json.RawMessage `json:"log"`
+		Info json.RawMessage `json:"info"`
+		Item json.RawMessage `json:"item"`
+	}
+	if err := json.Unmarshal(raw, &probe); err != nil {
+		return "", utils.Errorf("cannot detect archive format: %v", err)
+	}
+	switch {
+	case probe.Log != nil:
+		return FormatHAR, nil
+	case probe.Item != nil || probe.Info != nil:
+		return FormatPostman, nil
+	}
+	return "", utils.Error("cannot detect archive format: neither har nor postman collection")
+}
+
+// Parse 解析存档内容，format 为空时自动识别
+func Parse(raw []byte, format Format) ([]*Flow, error) {
+	if format == "" {
+		var err error
+		format, err = DetectFormat(raw)
+		if err != nil {
+			return nil, err
+		}
+	}
+	switch format {
+	case FormatHAR:
+		return ParseHAR(raw)
+	case FormatBurp:
+		return ParseBurpXML(raw)
+	case FormatPostman:
+		return ParsePostman(raw)
+	}
+	return nil, utils.Errorf("unsupported http archive format: %v", format)
+}
+
+// Marshal 将流量导出为指定格式，name 用于 postman collection 名称
+func Marshal(flows []*Flow, format Format, name string) ([]byte, error) {
+	switch format {
+	case FormatHAR:
+		return MarshalHAR(flows)
+	case FormatBurp:
+		return MarshalBurpXML(flows)
+	case FormatPostman:
+		return MarshalPostman(flows, name)
+	}
+	return nil, utils.Errorf("unsupported http archive format: %v", format)
+}
+
+type packetHeader struct {
+	Name  string
+	Value string
+}
+
+// splitPacket 拆分数据包，返回首行、按顺序排列的 header 以及 body
+func splitPacket(packet []byte) (string, []packetHeader, []byte) {
+	var headers []packetHeader
+	var firstLine string
+	headerRaw, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(packet)
+	for i, line := range strings.Split(strings.TrimRight(headerRaw, "\r\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		if i == 0 {
+			firstLine = line
+			continue
+		}
+		if line == "" {
+			continue
+		}
+		k, v := lowhttp.SplitHTTPHeader(line)
+		headers = append(headers, packetHeader{Name: k, Value: v})
+	}
+	return firstLine, headers, body
+}
+
+func buildPacket(firstLine string, headers []packetHeader, body []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(firstLine)
+	buf.WriteString("\r\n")
+	for _, h := range headers {
+		buf.WriteString(h.Name)
+		buf.WriteString(": ")
+		buf.WriteString(h.Value)
+		buf.WriteString("\r\n")
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+// normalizeHTTPVersion 浏览器导出的 HAR 中版本可能是 "http/2.0" 或 "h2"
+func normalizeHTTPVersion(httpVersion string) string {
+	httpVersion = strings.ToUpper(strings.TrimSpace(httpVersion))
+	switch {
+	case strings.HasPrefix(httpVersion, "HTTP/"):
+		return httpVersion
+	case httpVersion == "H2":
+		return "HTTP/2.0"
+	}
+	return "HTTP/1.1"
+}
+
+// buildRequest 由结构化数据重建请求包，headers 中没有 Host 时会自动补充
+func buildRequest(method string, rawURL string, httpVersion string, headers []packetHeader, body []byte) ([]byte, bool, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, false, utils.Errorf("invalid url %#v: %v", rawURL, err)
+	}
+	if method == "" {
+		method = "GET"
+	}
+	httpVersion = normalizeHTTPVersion(httpVersion)
+	uri := u.RequestURI()
+	if u.Opaque == "" && u.RawPath == "" && u.Path == "" {
+		uri = "/"
+		if u.RawQuery != "" {
+			uri += "?" + u.RawQuery
+		}
+	}
+	hasHost := false
+	var filtered []packetHeader
+	for _, h := range headers {
+		// HTTP/2 伪首部在 HTTP/1.1 数据包中没有意义
+		if strings.HasPrefix(h.Name, ":") {
+			if h.Name == ":authority" && !hasHost {
+				filtered = append(filtered, packetHeader{Name: "Host", Value: h.Value})
+				hasHost = true
+			}
+			continue
+		}
+		if strings.EqualFold(h.Name, "host") {
+			hasHost = true
+		}
+		filtered = append(filtered, h)
+	}
+	if !hasHost {
+		filtered = append([]packetHeader{{Name: "Host", Value: u.Host}}, filtered...)
+	}
+	packet := buildPacket(fmt.Sprintf("%s %s %s", strings.ToUpper(method), uri, httpVersion), filtered, body)
+	if len(body) > 0 {
+		packet = lowhttp.ReplaceHTTPPacketBody(packet, body, false)
+	}
+	return packet, strings.EqualFold(u.Scheme, "https") || strings.EqualFold(u.Scheme, "wss"), nil
+}
+
+func buildResponse(httpVersion string, status int, statusText string, headers []packetHeader, body []byte) []byte {
+	httpVersion = normalizeHTTPVersion(httpVersion)
+	if statusText == "" {
+		statusText = http.StatusText(status)
+	}
+	var filtered []packetHeader
+	for _, h := range headers {
+		// 存档中的 body 已经解码，去掉编码相关的 header 避免重复解码
+		if strings.EqualFold(h.Name, "content-encoding") || strings.EqualFold(h.Name, "transfer-encoding") {
+			continue
+		}
+		filtered = append(filtered, h)
+	}
+	packet := buildPacket(strings.TrimSpace(fmt.Sprintf("%s %d %s", httpVersion, status, statusText)), filtered, body)
+	return lowhttp.ReplaceHTTPPacketBody(packet, body, false)
+}
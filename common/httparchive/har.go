@@ -0,0 +1,341 @@
+package httparchive
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// HAR 1.2: http://www.softwareishard.com/blog/har-12-spec/
+// 以 "_" 开头的自定义字段用于无损保存原始数据包与标签
+
+type HAR struct {
+	Log *HARLog `json:"log"`
+}
+
+type HARLog struct {
+	Version string      `json:"version"`
+	Creator *HARCreator `json:"creator"`
+	Pages   []any       `json:"pages,omitempty"`
+	Entries []*HAREntry `json:"entries"`
+	Comment string      `json:"comment,omitempty"`
+}
+
+type HARCreator struct {
+	Name    string `json:"name"`
+	Version string `json:"version"`
+}
+
+type HAREntry struct {
+	StartedDateTime string       `json:"startedDateTime"`
+	Time            float64      `json:"time"`
+	Request         *HARRequest  `json:"request"`
+	Response        *HARResponse `json:"response"`
+	Cache           struct{}     `json:"cache"`
+	Timings         *HARTimings  `json:"timings"`
+	ServerIPAddress string       `json:"serverIPAddress,omitempty"`
+	Comment         string       `json:"comment,omitempty"`
+
+	RawRequest  string   `json:"_rawRequest,omitempty"`
+	RawResponse string   `json:"_rawResponse,omitempty"`
+	RemoteAddr  string   `json:"_remoteAddr,omitempty"`
+	Tags        []string `json:"_tags,omitempty"`
+}
+
+type HARNameValue struct {
+	Name    string `json:"name"`
+	Value   string `json:"value"`
+	Comment string `json:"comment,omitempty"`
+}
+
+type HARRequest struct {
+	Method      string          `json:"method"`
+	URL         string          `json:"url"`
+	HTTPVersion string          `json:"httpVersion"`
+	Cookies     []*HARNameValue `json:"cookies"`
+	Headers     []*HARNameValue `json:"headers"`
+	QueryString []*HARNameValue `json:"queryString"`
+	PostData    *HARPostData    `json:"postData,omitempty"`
+	HeadersSize int             `json:"headersSize"`
+	BodySize    int             `json:"bodySize"`
+}
+
+type HARPostData struct {
+	MimeType string          `json:"mimeType"`
+	Text     string          `json:"text"`
+	Params   []*HARNameValue `json:"params,omitempty"`
+}
+
+type HARResponse struct {
+	Status      int             `json:"status"`
+	StatusText  string          `json:"statusText"`
+	HTTPVersion string          `json:"httpVersion"`
+	Cookies     []*HARNameValue `json:"cookies"`
+	Headers     []*HARNameValue `json:"headers"`
+	Content     *HARContent     `json:"content"`
+	RedirectURL string          `json:"redirectURL"`
+	HeadersSize int             `json:"headersSize"`
+	BodySize    int             `json:"bodySize"`
+}
+
+type HARContent struct {
+	Size     int    `json:"size"`
+	MimeType string `json:"mimeType"`
+	Text     string `json:"text,omitempty"`
+	Encoding string `json:"encoding,omitempty"`
+}
+
+type HARTimings struct {
+	Blocked float64 `json:"blocked"`
+	DNS     float64 `json:"dns"`
+	Connect float64 `json:"connect"`
+	Send    float64 `json:"send"`
+	Wait    float64 `json:"wait"`
+	Receive float64 `json:"receive"`
+	SSL     float64 `json:"ssl"`
+}
+
+func toHARNameValues(headers []packetHeader) []*HARNameValue {
+	ret := make([]*HARNameValue, 0, len(headers))
+	for _, h := range headers {
+		ret = append(ret, &HARNameValue{Name: h.Name, Value: h.Value})
+	}
+	return ret
+}
+
+func fromHARNameValues(values []*HARNameValue) []packetHeader {
+	ret := make([]packetHeader, 0, len(values))
+	for _, v := range values {
+		if v == nil {
+			continue
+		}
+		ret = append(ret, packetHeader{Name: v.Name, Value: v.Value})
+	}
+	return ret
+}
+
+func packetVersion(firstLine string, isResponse bool) string {
+	fields := strings.Fields(firstLine)
+	if isResponse && len(fields) > 0 {
+		return fields[0]
+	}
+	if !isResponse && len(fields) > 2 {
+		return fields[2]
+	}
+	return "HTTP/1.1"
+}
+
+// harBodyText 文本内容直接保存，二进制内容使用 base64 编码
+func harBodyText(body []byte) (string, string) {
+	if utf8.Valid(body) {
+		return string(body), ""
+	}
+	return base64.StdEncoding.EncodeToString(body), "base64"
+}
+
+func headerValue(headers []packetHeader, name string) string {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, name) {
+			return h.Value
+		}
+	}
+	return ""
+}
+
+func newHAREntry(flow *Flow) *HAREntry {
+	reqFirstLine, reqHeaders, reqBody := splitPacket(flow.Request)
+	method, _, _ := lowhttp.GetHTTPPacketFirstLine(flow.Request)
+	rawURL := flow.GetUrl()
+
+	query := []*HARNameValue{}
+	if u, err := url.Parse(rawURL); err == nil {
+		for k, values := range u.Query() {
+			for _, v := range values {
+				query = append(query, &HARNameValue{Name: k, Value: v})
+			}
+		}
+	}
+	cookies := []*HARNameValue{}
+	for _, c := range lowhttp.ParseCookie("cookie", headerValue(reqHeaders, "Cookie")) {
+		cookies = append(cookies, &HARNameValue{Name: c.Name, Value: c.Value})
+	}
+
+	startedAt := flow.StartedAt
+	if startedAt.IsZero() {
+		startedAt = time.Now()
+	}
+	ms := float64(flow.Duration) / float64(time.Millisecond)
+	entry := &HAREntry{
+		StartedDateTime: startedAt.Format(time.RFC3339Nano),
+		Time:            ms,
+		Request: &HARRequest{
+			Method:      method,
+			URL:         rawURL,
+			HTTPVersion: packetVersion(reqFirstLine, false),
+			Cookies:     cookies,
+			Headers:     toHARNameValues(reqHeaders),
+			QueryString: query,
+			HeadersSize: -1,
+			BodySize:    len(reqBody),
+		},
+		Response: &HARResponse{
+			Cookies:     []*HARNameValue{},
+			Headers:     []*HARNameValue{},
+			Content:     &HARContent{},
+			HeadersSize: -1,
+			BodySize:    -1,
+		},
+		Timings:    &HARTimings{Blocked: -1, DNS: -1, Connect: -1, SSL: -1, Wait: ms},
+		Comment:    flow.Notes,
+		RawRequest: base64.StdEncoding.EncodeToString(flow.Request),
+		RemoteAddr: flow.RemoteAddr,
+		Tags:       flow.Tags,
+	}
+	if host, _, err := utils.ParseStringToHostPort(flow.RemoteAddr); err == nil {
+		entry.ServerIPAddress = host
+	}
+	if len(reqBody) > 0 {
+		text, _ := harBodyText(reqBody)
+		entry.Request.PostData = &HARPostData{MimeType: headerValue(reqHeaders, "Content-Type"), Text: text}
+	}
+
+	if len(flow.Response) > 0 {
+		rspFirstLine, rspHeaders, rspBody := splitPacket(flow.Response)
+		_, code, statusText, _ := utils.ParseHTTPResponseLine(rspFirstLine)
+		text, encoding := harBodyText(rspBody)
+		mimeType := headerValue(rspHeaders, "Content-Type")
+		rspCookies := []*HARNameValue{}
+		for _, h := range rspHeaders {
+			if !strings.EqualFold(h.Name, "set-cookie") {
+				continue
+			}
+			for _, c := range lowhttp.ParseCookie("set-cookie", h.Value) {
+				rspCookies = append(rspCookies, &HARNameValue{Name: c.Name, Value: c.Value})
+			}
+		}
+		entry.Response = &HARResponse{
+			Status:      code,
+			StatusText:  statusText,
+			HTTPVersion: packetVersion(rspFirstLine, true),
+			Cookies:     rspCookies,
+			Headers:     toHARNameValues(rspHeaders),
+			Content: &HARContent{
+				Size:     len(rspBody),
+				MimeType: mimeType,
+				Text:     text,
+				Encoding: encoding,
+			},
+			RedirectURL: headerValue(rspHeaders, "Location"),
+			HeadersSize: -1,
+			BodySize:    len(rspBody),
+		}
+		entry.RawResponse = base64.StdEncoding.EncodeToString(flow.Response)
+	}
+	return entry
+}
+
+func MarshalHAR(flows []*Flow) ([]byte, error) {
+	har := &HAR{Log: &HARLog{
+		Version: "1.2",
+		Creator: &HARCreator{Name: "yaklang", Version: consts.GetYakVersion()},
+		Entries: make([]*HAREntry, 0, len(flows)),
+	}}
+	for _, flow := range flows {
+		har.Log.Entries = append(har.Log.Entries, newHAREntry(flow))
+	}
+	return json.MarshalIndent(har, "", "  ")
+}
+
+func (e *HAREntry) toFlow() (*Flow, error) {
+	if e.Request == nil {
+		return nil, utils.Error("har entry without request")
+	}
+	flow := &Flow{
+		Url:        e.Request.URL,
+		RemoteAddr: e.RemoteAddr,
+		Tags:       e.Tags,
+		Notes:      e.Comment,
+		Duration:   time.Duration(e.Time * float64(time.Millisecond)),
+	}
+	if t, err := time.Parse(time.RFC3339Nano, e.StartedDateTime); err == nil {
+		flow.StartedAt = t
+	}
+	if flow.RemoteAddr == "" && e.ServerIPAddress != "" {
+		if u, err := url.Parse(e.Request.URL); err == nil {
+			port := u.Port()
+			if port == "" {
+				port = "80"
+				if u.Scheme == "https" {
+					port = "443"
+				}
+			}
+			flow.RemoteAddr = utils.HostPort(strings.Trim(e.ServerIPAddress, "[]"), port)
+		}
+	}
+
+	if raw, err := base64.StdEncoding.DecodeString(e.RawRequest); err == nil && len(raw) > 0 {
+		flow.Request = raw
+		if u, err := url.Parse(e.Request.URL); err == nil {
+			flow.IsHTTPS = u.Scheme == "https" || u.Scheme == "wss"
+		}
+	} else {
+		var body []byte
+		if e.Request.PostData != nil {
+			body = []byte(e.Request.PostData.Text)
+			if len(body) == 0 && len(e.Request.PostData.Params) > 0 {
+				values := make(url.Values)
+				for _, p := range e.Request.PostData.Params {
+					values.Add(p.Name, p.Value)
+				}
+				body = []byte(values.Encode())
+			}
+		}
+		req, isHttps, err := buildRequest(e.Request.Method, e.Request.URL, e.Request.HTTPVersion, fromHARNameValues(e.Request.Headers), body)
+		if err != nil {
+			return nil, err
+		}
+		flow.Request, flow.IsHTTPS = req, isHttps
+	}
+
+	if raw, err := base64.StdEncoding.DecodeString(e.RawResponse); err == nil && len(raw) > 0 {
+		flow.Response = raw
+	} else if e.Response != nil && e.Response.Status > 0 {
+		var body []byte
+		if c := e.Response.Content; c != nil {
+			body = []byte(c.Text)
+			if c.Encoding == "base64" {
+				if decoded, err := base64.StdEncoding.DecodeString(c.Text); err == nil {
+					body = decoded
+				}
+			}
+		}
+		flow.Response = buildResponse(e.Response.HTTPVersion, e.Response.Status, e.Response.StatusText, fromHARNameValues(e.Response.Headers), body)
+	}
+	return flow, nil
+}
+
+func ParseHAR(raw []byte) ([]*Flow, error) {
+	var har HAR
+	if err := json.Unmarshal(raw, &har); err != nil {
+		return nil, utils.Errorf("unmarshal har failed: %v", err)
+	}
+	if har.Log == nil {
+		return nil, utils.Error("invalid har: missing log")
+	}
+	var flows []*Flow
+	for i, entry := range har.Log.Entries {
+		flow, err := entry.toFlow()
+		if err != nil {
+			return nil, utils.Errorf("parse har entry[%d] failed: %v", i, err)
+		}
+		flows = append(flows, flow)
+	}
+	return flows, nil
+}
@@ -0,0 +1,178 @@
+package httparchive
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+func testFlows() []*Flow {
+	return []*Flow{
+		{
+			IsHTTPS:    true,
+			Url:        "https://example.com/api/login?from=index",
+			Request:    []byte("POST /api/login?from=index HTTP/1.1\r\nHost: example.com\r\nCookie: a=1; b=2\r\nContent-Type: application/json\r\nContent-Length: 15\r\n\r\n{\"user\":\"abc\"}\n"),
+			Response:   []byte("HTTP/1.1 200 OK\r\nContent-Type: application/json\r\nSet-Cookie: session=xyz\r\nContent-Length: 11\r\n\r\n{\"ok\":true}"),
+			RemoteAddr: "93.184.216.34:443",
+			StartedAt:  time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			Duration:   123 * time.Millisecond,
+			Tags:       []string{"login", "YAKIT_COLOR_RED"},
+			Notes:      "check auth bypass",
+		},
+		{
+			Url:       "http://example.com/static/a.png",
+			Request:   []byte("GET /static/a.png HTTP/1.1\r\nHost: example.com\r\n\r\n"),
+			Response:  []byte("HTTP/1.1 200 OK\r\nContent-Type: image/png\r\nContent-Length: 4\r\n\r\n\x89PNG"),
+			StartedAt: time.Date(2024, 1, 2, 3, 4, 6, 0, time.UTC),
+		},
+	}
+}
+
+func TestHTTPArchive_RoundTrip(t *testing.T) {
+	for _, format := range []Format{FormatHAR, FormatBurp, FormatPostman} {
+		t.Run(string(format), func(t *testing.T) {
+			flows := testFlows()
+			raw, err := Marshal(flows, format, "test")
+			require.NoError(t, err)
+
+			detected, err := DetectFormat(raw)
+			require.NoError(t, err)
+			require.Equal(t, format, detected)
+
+			parsed, err := Parse(raw, "")
+			require.NoError(t, err)
+			require.Len(t, parsed, len(flows))
+			for i, flow := range flows {
+				got := parsed[i]
+				require.Equal(t, flow.Request, got.Request)
+				require.Equal(t, flow.Response, got.Response)
+				require.Equal(t, flow.IsHTTPS, got.IsHTTPS)
+				require.Equal(t, flow.Url, got.Url)
+				require.Equal(t, flow.Tags, got.Tags)
+				require.Equal(t, flow.Notes, got.Notes)
+				require.Equal(t, flow.RemoteAddr, got.RemoteAddr)
+				require.True(t, flow.StartedAt.Equal(got.StartedAt), "%v != %v", flow.StartedAt, got.StartedAt)
+				if format != FormatBurp {
+					require.Equal(t, flow.Duration, got.Duration)
+				}
+			}
+		})
+	}
+}
+
+func TestHTTPArchive_ParseThirdPartyHAR(t *testing.T) {
+	raw := `{"log":{"version":"1.2","creator":{"name":"WebInspector","version":"537.36"},"entries":[{
+"startedDateTime":"2024-01-02T03:04:05.000Z","time":56.7,"serverIPAddress":"[::1]",
+"request":{"method":"POST","url":"https://example.com/search?q=1","httpVersion":"http/2.0",
+"headers":[{"name":":authority","value":"example.com"},{"name":":method","value":"POST"},{"name":"content-type","value":"application/x-www-form-urlencoded"}],
+"queryString":[],"cookies":[],"headersSize":-1,"bodySize":5,"postData":{"mimeType":"application/x-www-form-urlencoded","text":"a=b&c"}},
+"response":{"status":302,"statusText":"","httpVersion":"http/2.0","headers":[{"name":"location","value":"/next"},{"name":"content-encoding","value":"gzip"}],
+"cookies":[],"content":{"size":5,"mimeType":"text/plain","text":"aGVsbG8=","encoding":"base64"},"redirectURL":"/next","headersSize":-1,"bodySize":-1},
+"cache":{},"timings":{"send":1,"wait":50,"receive":5.7},"comment":"from devtools"}]}}`
+	flows, err := Parse([]byte(raw), "")
+	require.NoError(t, err)
+	require.Len(t, flows, 1)
+	flow := flows[0]
+	require.True(t, flow.IsHTTPS)
+	require.Equal(t, "POST", flow.Method())
+	require.Equal(t, "example.com", lowhttp.GetHTTPPacketHeader(flow.Request, "Host"))
+	require.NotContains(t, string(flow.Request), ":method")
+	require.Equal(t, "a=b&c", string(lowhttp.GetHTTPPacketBody(flow.Request)))
+	require.Equal(t, 302, flow.StatusCode())
+	require.Equal(t, "hello", string(lowhttp.GetHTTPPacketBody(flow.Response)))
+	require.Empty(t, lowhttp.GetHTTPPacketHeader(flow.Response, "Content-Encoding"))
+	require.Equal(t, "[::1]:443", flow.RemoteAddr)
+	require.Equal(t, "from devtools", flow.Notes)
+	require.Equal(t, 56700*time.Microsecond, flow.Duration)
+}
+
+func TestHTTPArchive_ParseHARPostDataParams(t *testing.T) {
+	raw := `{"log":{"version":"1.2","creator":{"name":"Firefox","version":"120.0"},"entries":[{
+"startedDateTime":"2024-01-02T03:04:05.000Z","time":10,
+"request":{"method":"POST","url":"http://example.com/login","httpVersion":"HTTP/1.1",
+"headers":[{"name":"Host","value":"example.com"},{"name":"Content-Type","value":"application/x-www-form-urlencoded"}],
+"queryString":[],"cookies":[],"headersSize":-1,"bodySize":-1,
+"postData":{"mimeType":"application/x-www-form-urlencoded","params":[{"name":"user","value":"admin"},{"name":"pass","value":"a b"}]}},
+"response":{"status":200,"statusText":"OK","httpVersion":"HTTP/1.1","headers":[],"cookies":[],"content":{"size":0,"mimeType":"text/plain"},"redirectURL":"","headersSize":-1,"bodySize":-1},
+"cache":{},"timings":{"send":1,"wait":8,"receive":1}}]}}`
+	flows, err := Parse([]byte(raw), "")
+	require.NoError(t, err)
+	require.Len(t, flows, 1)
+	body := string(lowhttp.GetHTTPPacketBody(flows[0].Request))
+	require.Contains(t, body, "user=admin")
+	require.Contains(t, body, "pass=a+b")
+}
+
+func TestHTTPArchive_ParseBurpSavedItems(t *testing.T) {
+	raw := `<?xml version="1.0"?>
+<!DOCTYPE items [
+<!ELEMENT items (item*)>
+<!ATTLIST items burpVersion CDATA "">
+]>
+<items burpVersion="2023.10.3.4" exportTime="Tue Jan 02 03:04:05 UTC 2024">
+  <item>
+    <time>Tue Jan 02 03:04:05 UTC 2024</time>
+    <url><![CDATA[http://testphp.vulnweb.com/listproducts.php?cat=1]]></url>
+    <host ip="44.228.249.3">testphp.vulnweb.com</host>
+    <port>80</port>
+    <protocol>http</protocol>
+    <method><![CDATA[GET]]></method>
+    <path><![CDATA[/listproducts.php?cat=1]]></path>
+    <extension>php</extension>
+    <request base64="true"><![CDATA[R0VUIC9saXN0cHJvZHVjdHMucGhwP2NhdD0xIEhUVFAvMS4xDQpIb3N0OiB0ZXN0cGhwLnZ1bG53ZWIuY29tDQoNCg==]]></request>
+    <status>200</status>
+    <responselength>38</responselength>
+    <mimetype>HTML</mimetype>
+    <response base64="false"><![CDATA[HTTP/1.1 200 OK
+Content-Length: 2
+
+ok]]></response>
+    <comment>sqli candidate</comment>
+  </item>
+</items>`
+	flows, err := Parse([]byte(raw), "")
+	require.NoError(t, err)
+	require.Len(t, flows, 1)
+	flow := flows[0]
+	require.Equal(t, "GET /listproducts.php?cat=1 HTTP/1.1\r\nHost: testphp.vulnweb.com\r\n\r\n", string(flow.Request))
+	require.Equal(t, 200, flow.StatusCode())
+	require.Equal(t, "44.228.249.3:80", flow.RemoteAddr)
+	require.Equal(t, "sqli candidate", flow.Notes)
+	require.Equal(t, 2024, flow.StartedAt.Year())
+}
+
+func TestHTTPArchive_ParsePostmanVariables(t *testing.T) {
+	raw := `{
+"info":{"name":"api","schema":"https://schema.getpostman.com/json/collection/v2.1.0/collection.json"},
+"variable":[{"key":"baseUrl","value":"https://api.example.com"},{"key":"token","value":"t0k3n"}],
+"item":[{"name":"users","variable":[{"key":"uid","value":"42"}],"item":[
+  {"name":"get user","request":{"method":"GET","header":[{"key":"X-Trace","value":"{{trace}}"}],
+   "auth":{"type":"bearer","bearer":[{"key":"token","value":"{{token}}","type":"string"}]},
+   "url":{"raw":"{{baseUrl}}/users/{{uid}}?full=1","host":["{{baseUrl}}"],"path":["users","{{uid}}"]}}},
+  {"name":"login","request":{"method":"POST","header":[],
+   "body":{"mode":"urlencoded","urlencoded":[{"key":"user","value":"admin"},{"key":"skip","value":"1","disabled":true}]},
+   "url":"{{baseUrl}}/login"},
+   "response":[{"name":"ok","status":"OK","code":200,"header":[{"key":"Content-Type","value":"text/plain"}],"body":"welcome"}]}
+]}]}`
+	flows, err := Parse([]byte(raw), FormatPostman)
+	require.NoError(t, err)
+	require.Len(t, flows, 2)
+
+	get := flows[0]
+	require.True(t, get.IsHTTPS)
+	require.Equal(t, "https://api.example.com/users/42?full=1", get.Url)
+	require.True(t, strings.HasPrefix(string(get.Request), "GET /users/42?full=1 HTTP/1.1\r\n"))
+	require.Equal(t, "api.example.com", lowhttp.GetHTTPPacketHeader(get.Request, "Host"))
+	require.Equal(t, "Bearer t0k3n", lowhttp.GetHTTPPacketHeader(get.Request, "Authorization"))
+	require.Equal(t, "{{trace}}", lowhttp.GetHTTPPacketHeader(get.Request, "X-Trace"))
+	require.Empty(t, get.Response)
+
+	login := flows[1]
+	require.Equal(t, "POST", login.Method())
+	require.Equal(t, "user=admin", string(lowhttp.GetHTTPPacketBody(login.Request)))
+	require.Equal(t, "application/x-www-form-urlencoded", lowhttp.GetHTTPPacketHeader(login.Request, "Content-Type"))
+	require.Equal(t, "welcome", string(lowhttp.GetHTTPPacketBody(login.Response)))
+}
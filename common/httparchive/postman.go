@@ -0,0 +1,421 @@
+package httparchive
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"mime/multipart"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const postmanSchemaV21 = "https://schema.getpostman.com/json/collection/v2.1.0/collection.json"
+
+// Postman Collection v2.1: https://schema.postman.com/collection/json/v2.1.0/draft-07/docs/index.html
+// 以 "_" 开头的自定义字段用于无损保存原始数据包与标签，Postman 导入时会忽略
+
+type PostmanCollection struct {
+	Info     *PostmanInfo       `json:"info"`
+	Item     []*PostmanItem     `json:"item"`
+	Variable []*PostmanKeyValue `json:"variable,omitempty"`
+}
+
+type PostmanInfo struct {
+	PostmanID   string `json:"_postman_id,omitempty"`
+	Name        string `json:"name"`
+	Schema      string `json:"schema"`
+	Description string `json:"description,omitempty"`
+}
+
+type PostmanItem struct {
+	Name        string             `json:"name"`
+	Description string             `json:"description,omitempty"`
+	Item        []*PostmanItem     `json:"item,omitempty"`
+	Request     *PostmanRequest    `json:"request,omitempty"`
+	Response    []*PostmanResponse `json:"response,omitempty"`
+	Variable    []*PostmanKeyValue `json:"variable,omitempty"`
+
+	RawRequest string   `json:"_rawRequest,omitempty"`
+	IsHTTPS    bool     `json:"_https,omitempty"`
+	RemoteAddr string   `json:"_remoteAddr,omitempty"`
+	StartedAt  string   `json:"_startedDateTime,omitempty"`
+	Tags       []string `json:"_tags,omitempty"`
+}
+
+type PostmanKeyValue struct {
+	Key      string `json:"key"`
+	Value    string `json:"value"`
+	Type     string `json:"type,omitempty"`
+	Disabled bool   `json:"disabled,omitempty"`
+}
+
+type PostmanRequest struct {
+	Method      string             `json:"method"`
+	Header      []*PostmanKeyValue `json:"header"`
+	Body        *PostmanBody       `json:"body,omitempty"`
+	URL         *PostmanURL        `json:"url"`
+	Auth        *PostmanAuth       `json:"auth,omitempty"`
+	Description string             `json:"description,omitempty"`
+}
+
+type PostmanBody struct {
+	Mode       string             `json:"mode"`
+	Raw        string             `json:"raw,omitempty"`
+	URLEncoded []*PostmanKeyValue `json:"urlencoded,omitempty"`
+	FormData   []*PostmanKeyValue `json:"formdata,omitempty"`
+	GraphQL    *struct {
+		Query     string `json:"query"`
+		Variables string `json:"variables,omitempty"`
+	} `json:"graphql,omitempty"`
+}
+
+type PostmanAuth struct {
+	Type   string             `json:"type"`
+	Basic  []*PostmanKeyValue `json:"basic,omitempty"`
+	Bearer []*PostmanKeyValue `json:"bearer,omitempty"`
+}
+
+// PostmanURL 在 collection 中既可以是字符串也可以是对象
+type PostmanURL struct {
+	Raw      string             `json:"raw"`
+	Protocol string             `json:"protocol,omitempty"`
+	Host     []string           `json:"host,omitempty"`
+	Port     string             `json:"port,omitempty"`
+	Path     []string           `json:"path,omitempty"`
+	Query    []*PostmanKeyValue `json:"query,omitempty"`
+}
+
+func (u *PostmanURL) UnmarshalJSON(raw []byte) error {
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		u.Raw = s
+		return nil
+	}
+	type alias PostmanURL
+	return json.Unmarshal(raw, (*alias)(u))
+}
+
+type PostmanResponse struct {
+	Name            string             `json:"name"`
+	OriginalRequest *PostmanRequest    `json:"originalRequest,omitempty"`
+	Status          string             `json:"status"`
+	Code            int                `json:"code"`
+	Header          []*PostmanKeyValue `json:"header"`
+	Body            string             `json:"body"`
+	ResponseTime    float64            `json:"responseTime,omitempty"`
+
+	RawResponse string `json:"_rawResponse,omitempty"`
+}
+
+func toPostmanHeaders(headers []packetHeader) []*PostmanKeyValue {
+	ret := make([]*PostmanKeyValue, 0, len(headers))
+	for _, h := range headers {
+		ret = append(ret, &PostmanKeyValue{Key: h.Name, Value: h.Value})
+	}
+	return ret
+}
+
+func newPostmanURL(raw string) *PostmanURL {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return &PostmanURL{Raw: raw}
+	}
+	ret := &PostmanURL{
+		Raw:      raw,
+		Protocol: u.Scheme,
+		Host:     strings.Split(u.Hostname(), "."),
+		Port:     u.Port(),
+	}
+	if p := strings.Trim(u.EscapedPath(), "/"); p != "" {
+		ret.Path = strings.Split(p, "/")
+	}
+	for _, pair := range strings.Split(u.RawQuery, "&") {
+		if pair == "" {
+			continue
+		}
+		k, v, _ := strings.Cut(pair, "=")
+		ret.Query = append(ret.Query, &PostmanKeyValue{Key: k, Value: v})
+	}
+	return ret
+}
+
+func newPostmanItem(flow *Flow) *PostmanItem {
+	_, reqHeaders, reqBody := splitPacket(flow.Request)
+	rawURL := flow.GetUrl()
+	name := rawURL
+	if u, err := url.Parse(rawURL); err == nil {
+		name = u.RequestURI()
+	}
+	request := &PostmanRequest{
+		Method:      flow.Method(),
+		Header:      toPostmanHeaders(reqHeaders),
+		URL:         newPostmanURL(rawURL),
+		Description: flow.Notes,
+	}
+	if len(reqBody) > 0 {
+		request.Body = &PostmanBody{Mode: "raw", Raw: string(reqBody)}
+	}
+	item := &PostmanItem{
+		Name:       fmt.Sprintf("%s %s", request.Method, name),
+		Request:    request,
+		RawRequest: base64.StdEncoding.EncodeToString(flow.Request),
+		IsHTTPS:    flow.IsHTTPS,
+		RemoteAddr: flow.RemoteAddr,
+		Tags:       flow.Tags,
+	}
+	if !flow.StartedAt.IsZero() {
+		item.StartedAt = flow.StartedAt.Format(time.RFC3339Nano)
+	}
+	if len(flow.Response) > 0 {
+		rspFirstLine, rspHeaders, rspBody := splitPacket(flow.Response)
+		_, code, status, _ := utils.ParseHTTPResponseLine(rspFirstLine)
+		item.Response = append(item.Response, &PostmanResponse{
+			Name:            item.Name,
+			OriginalRequest: request,
+			Status:          status,
+			Code:            code,
+			Header:          toPostmanHeaders(rspHeaders),
+			Body:            string(rspBody),
+			ResponseTime:    float64(flow.Duration) / float64(time.Millisecond),
+			RawResponse:     base64.StdEncoding.EncodeToString(flow.Response),
+		})
+	}
+	return item
+}
+
+// MarshalPostman 导出为 Postman Collection v2.1，按 host 分组为文件夹
+func MarshalPostman(flows []*Flow, name string) ([]byte, error) {
+	if name == "" {
+		name = "yaklang http flows"
+	}
+	collection := &PostmanCollection{
+		Info: &PostmanInfo{
+			PostmanID: utils.RandStringBytes(16),
+			Name:      name,
+			Schema:    postmanSchemaV21,
+		},
+		Item: []*PostmanItem{},
+	}
+	folders := make(map[string]*PostmanItem)
+	for _, flow := range flows {
+		host := "unknown"
+		if u, err := url.Parse(flow.GetUrl()); err == nil && u.Host != "" {
+			host = u.Host
+		}
+		folder, ok := folders[host]
+		if !ok {
+			folder = &PostmanItem{Name: host}
+			folders[host] = folder
+			collection.Item = append(collection.Item, folder)
+		}
+		folder.Item = append(folder.Item, newPostmanItem(flow))
+	}
+	return json.MarshalIndent(collection, "", "  ")
+}
+
+var postmanVariableRegexp = regexp.MustCompile(`\{\{\s*([^{}]+?)\s*\}\}`)
+
+type postmanVariables map[string]string
+
+func (v postmanVariables) with(vars []*PostmanKeyValue) postmanVariables {
+	if len(vars) <= 0 {
+		return v
+	}
+	ret := make(postmanVariables, len(v)+len(vars))
+	for k, val := range v {
+		ret[k] = val
+	}
+	for _, kv := range vars {
+		if kv == nil || kv.Disabled {
+			continue
+		}
+		ret[kv.Key] = kv.Value
+	}
+	return ret
+}
+
+// render 替换 {{var}}，未定义的变量保持原样
+func (v postmanVariables) render(s string) string {
+	return postmanVariableRegexp.ReplaceAllStringFunc(s, func(m string) string {
+		key := postmanVariableRegexp.FindStringSubmatch(m)[1]
+		if val, ok := v[key]; ok {
+			return val
+		}
+		return m
+	})
+}
+
+func (v postmanVariables) requestURL(u *PostmanURL) string {
+	if u == nil {
+		return ""
+	}
+	if u.Raw != "" {
+		raw := v.render(u.Raw)
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		return raw
+	}
+	protocol := u.Protocol
+	if protocol == "" {
+		protocol = "http"
+	}
+	host := v.render(strings.Join(u.Host, "."))
+	if u.Port != "" {
+		host = utils.HostPort(host, v.render(u.Port))
+	}
+	var query []string
+	for _, q := range u.Query {
+		if q == nil || q.Disabled {
+			continue
+		}
+		query = append(query, v.render(q.Key)+"="+v.render(q.Value))
+	}
+	raw := protocol + "://" + host + "/" + v.render(strings.Join(u.Path, "/"))
+	if len(query) > 0 {
+		raw += "?" + strings.Join(query, "&")
+	}
+	return raw
+}
+
+func (v postmanVariables) buildRequest(req *PostmanRequest) ([]byte, bool, string, error) {
+	rawURL := v.requestURL(req.URL)
+	var headers []packetHeader
+	for _, h := range req.Header {
+		if h == nil || h.Disabled {
+			continue
+		}
+		headers = append(headers, packetHeader{Name: v.render(h.Key), Value: v.render(h.Value)})
+	}
+	if auth := req.Auth; auth != nil {
+		params := make(map[string]string)
+		for _, kv := range append(auth.Basic, auth.Bearer...) {
+			params[kv.Key] = v.render(kv.Value)
+		}
+		switch auth.Type {
+		case "basic":
+			token := base64.StdEncoding.EncodeToString([]byte(params["username"] + ":" + params["password"]))
+			headers = append(headers, packetHeader{Name: "Authorization", Value: "Basic " + token})
+		case "bearer":
+			headers = append(headers, packetHeader{Name: "Authorization", Value: "Bearer " + params["token"]})
+		}
+	}
+
+	var body []byte
+	if b := req.Body; b != nil {
+		switch b.Mode {
+		case "raw":
+			body = []byte(v.render(b.Raw))
+		case "urlencoded":
+			values := make(url.Values)
+			for _, kv := range b.URLEncoded {
+				if kv == nil || kv.Disabled {
+					continue
+				}
+				values.Add(v.render(kv.Key), v.render(kv.Value))
+			}
+			body = []byte(values.Encode())
+			if headerValue(headers, "Content-Type") == "" {
+				headers = append(headers, packetHeader{Name: "Content-Type", Value: "application/x-www-form-urlencoded"})
+			}
+		case "formdata":
+			var buf bytes.Buffer
+			form := multipart.NewWriter(&buf)
+			for _, kv := range b.FormData {
+				// 文件类型的表单项在存档中没有内容，跳过
+				if kv == nil || kv.Disabled || kv.Type == "file" {
+					continue
+				}
+				form.WriteField(v.render(kv.Key), v.render(kv.Value))
+			}
+			form.Close()
+			body = buf.Bytes()
+			headers = append(headers, packetHeader{Name: "Content-Type", Value: form.FormDataContentType()})
+		case "graphql":
+			if b.GraphQL != nil {
+				payload := map[string]any{"query": v.render(b.GraphQL.Query)}
+				if vars := v.render(b.GraphQL.Variables); vars != "" {
+					payload["variables"] = json.RawMessage(vars)
+				}
+				body, _ = json.Marshal(payload)
+				if headerValue(headers, "Content-Type") == "" {
+					headers = append(headers, packetHeader{Name: "Content-Type", Value: "application/json"})
+				}
+			}
+		}
+	}
+	packet, isHttps, err := buildRequest(req.Method, rawURL, "HTTP/1.1", headers, body)
+	return packet, isHttps, rawURL, err
+}
+
+func (v postmanVariables) walk(items []*PostmanItem, folder []string, flows *[]*Flow) error {
+	for _, item := range items {
+		if item == nil {
+			continue
+		}
+		vars := v.with(item.Variable)
+		if item.Request == nil {
+			if err := vars.walk(item.Item, append(folder, item.Name), flows); err != nil {
+				return err
+			}
+			continue
+		}
+		flow := &Flow{
+			IsHTTPS:    item.IsHTTPS,
+			RemoteAddr: item.RemoteAddr,
+			Tags:       item.Tags,
+			Notes:      item.Request.Description,
+		}
+		if flow.Notes == "" {
+			flow.Notes = item.Description
+		}
+		if t, err := time.Parse(time.RFC3339Nano, item.StartedAt); err == nil {
+			flow.StartedAt = t
+		}
+		if raw, err := base64.StdEncoding.DecodeString(item.RawRequest); err == nil && len(raw) > 0 {
+			flow.Request = raw
+			flow.Url = vars.requestURL(item.Request.URL)
+		} else {
+			packet, isHttps, rawURL, err := vars.buildRequest(item.Request)
+			if err != nil {
+				return utils.Errorf("build request for postman item %#v failed: %v", strings.Join(append(folder, item.Name), "/"), err)
+			}
+			flow.Request, flow.IsHTTPS, flow.Url = packet, isHttps, rawURL
+		}
+		if len(item.Response) > 0 && item.Response[0] != nil {
+			rsp := item.Response[0]
+			flow.Duration = time.Duration(rsp.ResponseTime * float64(time.Millisecond))
+			if raw, err := base64.StdEncoding.DecodeString(rsp.RawResponse); err == nil && len(raw) > 0 {
+				flow.Response = raw
+			} else if rsp.Code > 0 {
+				var headers []packetHeader
+				for _, h := range rsp.Header {
+					if h != nil {
+						headers = append(headers, packetHeader{Name: h.Key, Value: h.Value})
+					}
+				}
+				flow.Response = buildResponse("HTTP/1.1", rsp.Code, rsp.Status, headers, []byte(rsp.Body))
+			}
+		}
+		*flows = append(*flows, flow)
+	}
+	return nil
+}
+
+// ParsePostman 解析 Postman Collection v2.0 / v2.1，collection 与文件夹中的 variable 会被替换到请求中
+func ParsePostman(raw []byte) ([]*Flow, error) {
+	var collection PostmanCollection
+	if err := json.Unmarshal(raw, &collection); err != nil {
+		return nil, utils.Errorf("unmarshal postman collection failed: %v", err)
+	}
+	var flows []*Flow
+	if err := postmanVariables(nil).with(collection.Variable).walk(collection.Item, nil, &flows); err != nil {
+		return nil, err
+	}
+	return flows, nil
+}
@@ -6,5 +6,24 @@ var (
 		"ParseJA3S":                     ParseJA3S,
 		"ParseJA3ToClientHelloSpec":     ParseJA3ToClientHelloSpec,
 		"GetTransportByClientHelloSpec": GetTransportByClientHelloSpec,
+
+		// 服务端 TLS 指纹
+		"ParseJA4":                ParseJA4,
+		"ParseJA4S":               ParseJA4S,
+		"CertJA4X":                CertJA4X,
+		"CertChainHash":           CertChainHash,
+		"JARM":                    JARM,
+		"JARMHash":                JARMHash,
+		"ServerFingerprint":       ServerFingerprint,
+		"Cluster":                 Cluster,
+		"AddFingerprint":          AddFingerprint,
+		"LoadFingerprintDatabase": LoadFingerprintDatabase,
+		"LookupFingerprint":       LookupFingerprint,
+
+		"timeout": WithTimeout,
+		"sni":     WithSNI,
+		"proxy":   WithProxy,
+		"context": WithContext,
+		"jarm":    WithJARM,
 	}
 )
@@ -0,0 +1,128 @@
+package ja3
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	FingerprintTypeJARM       = "jarm"
+	FingerprintTypeJA4S       = "ja4s"
+	FingerprintTypeJA4X       = "ja4x"
+	FingerprintTypeCert       = "cert"        // 证书链指纹
+	FingerprintTypeCertSHA256 = "cert-sha256" // 链中任意一张证书的 sha256
+)
+
+// FingerprintRecord 本地指纹库中的一条记录
+type FingerprintRecord struct {
+	Type        string   `json:"type"`
+	Hash        string   `json:"hash"`
+	Name        string   `json:"name"`
+	Tags        []string `json:"tags"`
+	Description string   `json:"description"`
+}
+
+// 公开资料中常见 C2 / 恶意服务的默认 JARM，
+// 注意 JARM 反映的是 TLS 实现与配置，同一个 TLS 栈的正常服务也可能命中，
+// 因此不收录 Java 默认 TLS 配置的 JARM（如 Cobalt Strike 默认配置），这类服务需要结合证书 / JA4X 自行添加
+var defaultFingerprintRecords = []*FingerprintRecord{
+	{Type: FingerprintTypeJARM, Hash: "07d14d16d21d21d00042d43d000000aa99ce74e2c6d013c745aa52b5cc042d", Name: "Metasploit", Tags: []string{"c2", "metasploit"}, Description: "Metasploit https handler default config"},
+	{Type: FingerprintTypeJARM, Hash: "29d21b20d29d29d21c41d21b21b41d494e0df9532e75299f15ba73156cee38", Name: "Merlin C2", Tags: []string{"c2", "merlin"}, Description: "Merlin C2 default config"},
+	{Type: FingerprintTypeJARM, Hash: "22b22b09b22b22b22b22b22b22b22b352842cd5d6b0278445702035e06875c", Name: "Trickbot", Tags: []string{"malware", "trickbot"}, Description: "Trickbot C2"},
+	{Type: FingerprintTypeJARM, Hash: "1dd40d40d00040d1dc1dd40d1dd40d3df2d6a0c2caaa0dc59908f0d3602943", Name: "AsyncRAT", Tags: []string{"malware", "asyncrat"}, Description: "AsyncRAT C2"},
+}
+
+var (
+	fingerprintDatabaseMutex = new(sync.RWMutex)
+	fingerprintDatabase      = make(map[string][]*FingerprintRecord)
+)
+
+func init() {
+	for _, r := range defaultFingerprintRecords {
+		addFingerprintRecord(r)
+	}
+}
+
+func fingerprintKey(kind, hash string) string {
+	return strings.ToLower(strings.TrimSpace(kind)) + ":" + strings.ToLower(strings.TrimSpace(hash))
+}
+
+func addFingerprintRecord(r *FingerprintRecord) {
+	if r == nil || r.Hash == "" || r.Type == "" {
+		return
+	}
+	r.Type = strings.ToLower(strings.TrimSpace(r.Type))
+	key := fingerprintKey(r.Type, r.Hash)
+
+	fingerprintDatabaseMutex.Lock()
+	defer fingerprintDatabaseMutex.Unlock()
+	for _, existed := range fingerprintDatabase[key] {
+		if existed.Name == r.Name {
+			return
+		}
+	}
+	fingerprintDatabase[key] = append(fingerprintDatabase[key], r)
+}
+
+// AddFingerprint 向本地指纹库添加一条记录
+// kind 可选 jarm / ja4s / ja4x / cert / cert-sha256
+// Example:
+// ```
+// ja3.AddFingerprint("jarm", "2ad2ad0002ad2ad00042d42d000000ad9bf51cc3f5a1e29eecb81d0c7b06eb", "my-c2", "c2")
+// ```
+func AddFingerprint(kind string, hash string, name string, tags ...string) {
+	addFingerprintRecord(&FingerprintRecord{Type: kind, Hash: hash, Name: name, Tags: tags})
+}
+
+// LoadFingerprintDatabase 从 JSON 文件中加载指纹记录，文件内容为 FingerprintRecord 数组
+// Example:
+// ```
+// ja3.LoadFingerprintDatabase("/tmp/c2-jarm.json")~
+// ```
+func LoadFingerprintDatabase(file string) error {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return utils.Errorf("read fingerprint database %v failed: %s", file, err)
+	}
+	var records []*FingerprintRecord
+	if err := json.Unmarshal(raw, &records); err != nil {
+		return utils.Errorf("parse fingerprint database %v failed: %s", file, err)
+	}
+	for _, r := range records {
+		addFingerprintRecord(r)
+	}
+	return nil
+}
+
+// LookupFingerprint 按类型与哈希查询本地指纹库
+func LookupFingerprint(kind string, hash string) []*FingerprintRecord {
+	if hash == "" {
+		return nil
+	}
+	fingerprintDatabaseMutex.RLock()
+	defer fingerprintDatabaseMutex.RUnlock()
+	records := fingerprintDatabase[fingerprintKey(kind, hash)]
+	return append([]*FingerprintRecord(nil), records...)
+}
+
+// MatchFingerprint 将服务端指纹与本地指纹库进行匹配
+func MatchFingerprint(f *ServerTLSFingerprint) []*FingerprintRecord {
+	if f == nil {
+		return nil
+	}
+	var ret []*FingerprintRecord
+	if f.JARM != jarmEmptyHash {
+		ret = append(ret, LookupFingerprint(FingerprintTypeJARM, f.JARM)...)
+	}
+	ret = append(ret, LookupFingerprint(FingerprintTypeJA4S, f.JA4S)...)
+	ret = append(ret, LookupFingerprint(FingerprintTypeCert, f.CertChainHash)...)
+	for _, cert := range f.Certificates {
+		ret = append(ret, LookupFingerprint(FingerprintTypeJA4X, cert.JA4X)...)
+		ret = append(ret, LookupFingerprint(FingerprintTypeCertSHA256, cert.SHA256)...)
+	}
+	return ret
+}
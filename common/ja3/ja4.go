@@ -0,0 +1,207 @@
+package ja3
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// JA4 客户端指纹，格式参考 https://github.com/FoxIO-LLC/ja4
+// Fingerprint 为 ja4 形式（a_b_c），Raw 为未做哈希的 ja4_r 形式，便于人工比对
+type JA4 struct {
+	Fingerprint string
+	Raw         string
+}
+
+func (j JA4) String() string {
+	return j.Fingerprint
+}
+
+// JA4S 服务端指纹（针对 ServerHello）
+type JA4S struct {
+	Fingerprint string
+	Raw         string
+}
+
+func (j JA4S) String() string {
+	return j.Fingerprint
+}
+
+const ja4EmptyHash = "000000000000"
+
+func ja4Hash(s string) string {
+	if s == "" {
+		return ja4EmptyHash
+	}
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])[:12]
+}
+
+func ja4Version(v uint16) string {
+	switch v {
+	case VersionTLS13:
+		return "13"
+	case VersionTLS12:
+		return "12"
+	case VersionTLS11:
+		return "11"
+	case VersionTLS10:
+		return "10"
+	case VersionSSL30:
+		return "s3"
+	case 0x0002:
+		return "s2"
+	}
+	return "00"
+}
+
+// ja4ALPN 取协议名的首尾字符，非字母数字时改用十六进制的首尾字符
+func ja4ALPN(alpn string) string {
+	if alpn == "" {
+		return "00"
+	}
+	isAlnum := func(c byte) bool {
+		return (c >= '0' && c <= '9') || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+	}
+	first, last := alpn[0], alpn[len(alpn)-1]
+	if !isAlnum(first) || !isAlnum(last) {
+		h := hex.EncodeToString([]byte(alpn))
+		return string(h[0]) + string(h[len(h)-1])
+	}
+	return string(first) + string(last)
+}
+
+func ja4Count(n int) string {
+	if n > 99 {
+		n = 99
+	}
+	return fmt.Sprintf("%02d", n)
+}
+
+func joinHex16(values []uint16) string {
+	items := make([]string, 0, len(values))
+	for _, v := range values {
+		items = append(items, fmt.Sprintf("%04x", v))
+	}
+	return strings.Join(items, ",")
+}
+
+// ParseJA4 从 ClientHello（TLS 记录或握手消息）中计算 JA4 指纹
+// Example:
+// ```
+// ja4 = ja3.ParseJA4(clientHelloRaw)~
+// println(ja4.Fingerprint)
+// ```
+func ParseJA4(raw []byte) (*JA4, error) {
+	hello, err := parseClientHello(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	var ciphers []uint16
+	for _, c := range hello.CipherSuites {
+		if !isGREASE(c) {
+			ciphers = append(ciphers, c)
+		}
+	}
+	var extensions []uint16
+	sni := "i"
+	for _, ext := range hello.Extensions {
+		if isGREASE(ext.Type) {
+			continue
+		}
+		extensions = append(extensions, ext.Type)
+		if ext.Type == extensionServerName {
+			sni = "d"
+		}
+	}
+
+	a := "t" + ja4Version(hello.highestVersion()) + sni + ja4Count(len(ciphers)) + ja4Count(len(extensions)) + ja4ALPN(firstALPN(hello.Extensions))
+
+	sortedCiphers := append([]uint16(nil), ciphers...)
+	sort.Slice(sortedCiphers, func(i, j int) bool { return sortedCiphers[i] < sortedCiphers[j] })
+	b := joinHex16(sortedCiphers)
+
+	// SNI 与 ALPN 已经体现在 a 段中，c 段不再计入
+	var sortedExtensions []uint16
+	for _, ext := range extensions {
+		if ext != extensionServerName && ext != extensionALPN {
+			sortedExtensions = append(sortedExtensions, ext)
+		}
+	}
+	sort.Slice(sortedExtensions, func(i, j int) bool { return sortedExtensions[i] < sortedExtensions[j] })
+	c := joinHex16(sortedExtensions)
+	if algs := hello.signatureAlgorithms(); len(algs) > 0 {
+		c += "_" + joinHex16(algs)
+	}
+
+	return &JA4{
+		Fingerprint: a + "_" + ja4Hash(b) + "_" + ja4Hash(c),
+		Raw:         a + "_" + b + "_" + c,
+	}, nil
+}
+
+// ParseJA4S 从 ServerHello（TLS 记录或握手消息）中计算 JA4S 指纹
+// 与 JA4 不同，扩展按照服务端返回的原始顺序参与计算
+// Example:
+// ```
+// ja4s = ja3.ParseJA4S(serverHelloRaw)~
+// println(ja4s.Fingerprint)
+// ```
+func ParseJA4S(raw []byte) (*JA4S, error) {
+	hello, err := parseServerHello(raw)
+	if err != nil {
+		return nil, err
+	}
+	return hello.ja4s(), nil
+}
+
+func (h *serverHello) ja4s() *JA4S {
+	var extensions []uint16
+	for _, ext := range h.Extensions {
+		extensions = append(extensions, ext.Type)
+	}
+	a := "t" + ja4Version(h.negotiatedVersion()) + ja4Count(len(extensions)) + ja4ALPN(firstALPN(h.Extensions))
+	b := fmt.Sprintf("%04x", h.CipherSuite)
+	c := joinHex16(extensions)
+	return &JA4S{
+		Fingerprint: a + "_" + b + "_" + ja4Hash(c),
+		Raw:         a + "_" + b + "_" + c,
+	}
+}
+
+func joinOIDs(oids []asn1.ObjectIdentifier) string {
+	items := make([]string, 0, len(oids))
+	for _, oid := range oids {
+		raw, err := asn1.Marshal(oid)
+		if err != nil || len(raw) < 2 {
+			continue
+		}
+		// 去掉 tag 与 length，只保留 OID 的内容编码
+		items = append(items, hex.EncodeToString(raw[2:]))
+	}
+	return strings.Join(items, ",")
+}
+
+// CertJA4X 计算证书的 JA4X 指纹：颁发者 RDN、主体 RDN、扩展三组 OID 分别哈希
+// JA4X 只关注证书“是怎么生成的”，同一个工具批量生成的证书往往拥有相同的 JA4X
+func CertJA4X(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+	var issuer, subject, extensions []asn1.ObjectIdentifier
+	for _, name := range cert.Issuer.Names {
+		issuer = append(issuer, name.Type)
+	}
+	for _, name := range cert.Subject.Names {
+		subject = append(subject, name.Type)
+	}
+	for _, ext := range cert.Extensions {
+		extensions = append(extensions, ext.Id)
+	}
+	return ja4Hash(joinOIDs(issuer)) + "_" + ja4Hash(joinOIDs(subject)) + "_" + ja4Hash(joinOIDs(extensions))
+}
@@ -0,0 +1,392 @@
+package ja3
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// JARM 主动式 TLS 服务端指纹，算法与 https://github.com/salesforce/jarm 保持一致：
+// 发送 10 个精心构造的 ClientHello，记录服务端每次选中的密码套件、版本、ALPN 与扩展顺序，
+// 前 30 位是密码套件与版本的“模糊哈希”，后 32 位是 ALPN 与扩展的 sha256 截断
+const jarmEmptyHash = "00000000000000000000000000000000000000000000000000000000000000"
+
+type jarmProbe struct {
+	version        string // TLS_1.1 / TLS_1.2 / TLS_1.3
+	cipherList     string // ALL / NO1.3
+	cipherOrder    string // FORWARD / REVERSE / TOP_HALF / BOTTOM_HALF / MIDDLE_OUT
+	grease         bool
+	rareALPN       bool
+	versionSupport string // 1.2_SUPPORT / 1.3_SUPPORT / NO_SUPPORT
+	extensionOrder string
+}
+
+var jarmProbes = []jarmProbe{
+	{"TLS_1.2", "ALL", "FORWARD", false, false, "1.2_SUPPORT", "REVERSE"},
+	{"TLS_1.2", "ALL", "REVERSE", false, false, "1.2_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "TOP_HALF", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "BOTTOM_HALF", false, true, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.2", "ALL", "MIDDLE_OUT", true, true, "NO_SUPPORT", "REVERSE"},
+	{"TLS_1.1", "ALL", "FORWARD", false, false, "NO_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "FORWARD", false, false, "1.3_SUPPORT", "REVERSE"},
+	{"TLS_1.3", "ALL", "REVERSE", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "NO1.3", "FORWARD", false, false, "1.3_SUPPORT", "FORWARD"},
+	{"TLS_1.3", "ALL", "MIDDLE_OUT", true, false, "1.3_SUPPORT", "REVERSE"},
+}
+
+var jarmCiphersAll = []uint16{
+	0x0016, 0x0033, 0x0067, 0xc09e, 0xc0a2, 0x009e, 0x0039, 0x006b, 0xc09f, 0xc0a3, 0x009f, 0x0045, 0x00be, 0x0088,
+	0x00c4, 0x009a, 0xc008, 0xc009, 0xc023, 0xc0ac, 0xc0ae, 0xc02b, 0xc00a, 0xc024, 0xc0ad, 0xc0af, 0xc02c, 0xc072,
+	0xc073, 0xcca9, 0x1302, 0x1301, 0xcc14, 0xc007, 0xc012, 0xc013, 0xc027, 0xc02f, 0xc014, 0xc028, 0xc030, 0xc060,
+	0xc061, 0xc076, 0xc077, 0xcca8, 0x1305, 0x1304, 0x1303, 0xcc13, 0xc011, 0x000a, 0x002f, 0x003c, 0xc09c, 0xc0a0,
+	0x009c, 0x0035, 0x003d, 0xc09d, 0xc0a1, 0x009d, 0x0041, 0x00ba, 0x0084, 0x00c0, 0x0007, 0x0004, 0x0005,
+}
+
+// jarmCipherIndex 计算模糊哈希时使用的密码套件顺序表
+var jarmCipherIndex = []uint16{
+	0x0004, 0x0005, 0x0007, 0x000a, 0x0016, 0x002f, 0x0033, 0x0035, 0x0039, 0x003c, 0x003d, 0x0041, 0x0045, 0x0067,
+	0x006b, 0x0084, 0x0088, 0x009a, 0x009c, 0x009d, 0x009e, 0x009f, 0x00ba, 0x00be, 0x00c0, 0x00c4, 0xc007, 0xc008,
+	0xc009, 0xc00a, 0xc011, 0xc012, 0xc013, 0xc014, 0xc023, 0xc024, 0xc027, 0xc028, 0xc02b, 0xc02c, 0xc02f, 0xc030,
+	0xc060, 0xc061, 0xc072, 0xc073, 0xc076, 0xc077, 0xc09c, 0xc09d, 0xc09e, 0xc09f, 0xc0a0, 0xc0a1, 0xc0a2, 0xc0a3,
+	0xc0ac, 0xc0ad, 0xc0ae, 0xc0af, 0xcc13, 0xcc14, 0xcca8, 0xcca9, 0x1301, 0x1302, 0x1303, 0x1304, 0x1305,
+}
+
+var jarmALPNs = []string{"http/0.9", "http/1.0", "http/1.1", "spdy/1", "spdy/2", "spdy/3", "h2", "h2c", "hq"}
+
+var jarmRareALPNs = []string{"http/0.9", "http/1.0", "spdy/1", "spdy/2", "spdy/3", "h2c", "hq"}
+
+// jarmMung 按照 JARM 的规则重排列表（密码套件、ALPN、版本共用）
+func jarmMung[T any](items []T, order string) []T {
+	n := len(items)
+	var ret []T
+	switch order {
+	case "REVERSE":
+		for i := n - 1; i >= 0; i-- {
+			ret = append(ret, items[i])
+		}
+	case "BOTTOM_HALF":
+		if n%2 == 1 {
+			ret = append(ret, items[n/2+1:]...)
+		} else {
+			ret = append(ret, items[n/2:]...)
+		}
+	case "TOP_HALF":
+		if n%2 == 1 {
+			ret = append(ret, items[n/2])
+		}
+		ret = append(ret, jarmMung(jarmMung(items, "REVERSE"), "BOTTOM_HALF")...)
+	case "MIDDLE_OUT":
+		middle := n / 2
+		if n%2 == 1 {
+			ret = append(ret, items[middle])
+			for i := 1; i <= middle; i++ {
+				ret = append(ret, items[middle+i], items[middle-i])
+			}
+		} else {
+			for i := 1; i <= middle; i++ {
+				ret = append(ret, items[middle-1+i], items[middle-i])
+			}
+		}
+	default:
+		ret = append(ret, items...)
+	}
+	return ret
+}
+
+func randomGREASE() uint16 {
+	var b [1]byte
+	_, _ = rand.Read(b[:])
+	v := uint16(b[0]&0xf0 | 0x0a)
+	return v<<8 | v
+}
+
+func randomBytes(n int) []byte {
+	ret := make([]byte, n)
+	_, _ = rand.Read(ret)
+	return ret
+}
+
+func appendUint16(b []byte, v uint16) []byte {
+	return binary.BigEndian.AppendUint16(b, v)
+}
+
+func appendUint16Prefixed(b []byte, data []byte) []byte {
+	return append(appendUint16(b, uint16(len(data))), data...)
+}
+
+func (p jarmProbe) ciphers() []byte {
+	var list []uint16
+	for _, c := range jarmCiphersAll {
+		if p.cipherList == "NO1.3" && c>>8 == 0x13 {
+			continue
+		}
+		list = append(list, c)
+	}
+	if p.cipherOrder != "FORWARD" {
+		list = jarmMung(list, p.cipherOrder)
+	}
+	if p.grease {
+		list = append([]uint16{randomGREASE()}, list...)
+	}
+	var ret []byte
+	for _, c := range list {
+		ret = appendUint16(ret, c)
+	}
+	return ret
+}
+
+func (p jarmProbe) extensions(host string) []byte {
+	var exts []byte
+	if p.grease {
+		exts = appendUint16(exts, randomGREASE())
+		exts = append(exts, 0x00, 0x00)
+	}
+
+	// server_name
+	var sni []byte
+	sni = append(sni, 0x00)
+	sni = appendUint16Prefixed(sni, []byte(host))
+	exts = append(exts, 0x00, 0x00)
+	exts = appendUint16Prefixed(exts, appendUint16Prefixed(nil, sni))
+
+	exts = append(exts,
+		0x00, 0x17, 0x00, 0x00, // extended_master_secret
+		0x00, 0x01, 0x00, 0x01, 0x01, // max_fragment_length
+		0xff, 0x01, 0x00, 0x01, 0x00, // renegotiation_info
+		0x00, 0x0a, 0x00, 0x0a, 0x00, 0x08, 0x00, 0x1d, 0x00, 0x17, 0x00, 0x18, 0x00, 0x19, // supported_groups
+		0x00, 0x0b, 0x00, 0x02, 0x01, 0x00, // ec_point_formats
+		0x00, 0x23, 0x00, 0x00, // session_ticket
+	)
+
+	// ALPN
+	alpns := jarmALPNs
+	if p.rareALPN {
+		alpns = jarmRareALPNs
+	}
+	if p.extensionOrder != "FORWARD" {
+		alpns = jarmMung(alpns, p.extensionOrder)
+	}
+	var alpnList []byte
+	for _, a := range alpns {
+		alpnList = append(alpnList, byte(len(a)))
+		alpnList = append(alpnList, a...)
+	}
+	exts = appendUint16(exts, extensionALPN)
+	exts = appendUint16Prefixed(exts, appendUint16Prefixed(nil, alpnList))
+
+	// signature_algorithms
+	exts = append(exts, 0x00, 0x0d, 0x00, 0x14, 0x00, 0x12, 0x04, 0x03, 0x08, 0x04, 0x04, 0x01, 0x05, 0x03, 0x08, 0x05, 0x05, 0x01, 0x08, 0x06, 0x06, 0x01, 0x02, 0x01)
+
+	// key_share
+	var share []byte
+	if p.grease {
+		share = appendUint16(share, randomGREASE())
+		share = append(share, 0x00, 0x01, 0x00)
+	}
+	share = append(share, 0x00, 0x1d, 0x00, 0x20)
+	share = append(share, randomBytes(32)...)
+	exts = append(exts, 0x00, 0x33)
+	exts = appendUint16Prefixed(exts, appendUint16Prefixed(nil, share))
+
+	// psk_key_exchange_modes
+	exts = append(exts, 0x00, 0x2d, 0x00, 0x02, 0x01, 0x01)
+
+	// supported_versions
+	if p.version == "TLS_1.3" || p.versionSupport == "1.2_SUPPORT" {
+		versions := []uint16{VersionTLS10, VersionTLS11, VersionTLS12}
+		if p.versionSupport != "1.2_SUPPORT" {
+			versions = append(versions, VersionTLS13)
+		}
+		if p.extensionOrder != "FORWARD" {
+			versions = jarmMung(versions, p.extensionOrder)
+		}
+		var list []byte
+		if p.grease {
+			list = appendUint16(list, randomGREASE())
+		}
+		for _, v := range versions {
+			list = appendUint16(list, v)
+		}
+		exts = append(exts, 0x00, 0x2b)
+		exts = appendUint16(exts, uint16(len(list)+1))
+		exts = append(exts, byte(len(list)))
+		exts = append(exts, list...)
+	}
+	return appendUint16Prefixed(nil, exts)
+}
+
+// packet 构造完整的 ClientHello 记录
+func (p jarmProbe) packet(host string) []byte {
+	recordVersion, helloVersion := uint16(VersionTLS12), uint16(VersionTLS12)
+	switch p.version {
+	case "TLS_1.3":
+		recordVersion = VersionTLS10
+	case "TLS_1.1":
+		recordVersion, helloVersion = VersionTLS11, VersionTLS11
+	}
+
+	var hello []byte
+	hello = appendUint16(hello, helloVersion)
+	hello = append(hello, randomBytes(32)...)
+	hello = append(hello, 32)
+	hello = append(hello, randomBytes(32)...)
+	hello = appendUint16Prefixed(hello, p.ciphers())
+	hello = append(hello, 0x01, 0x00) // compression methods: null
+	hello = append(hello, p.extensions(host)...)
+
+	handshake := []byte{handshakeTypeClientHello, byte(len(hello) >> 16), byte(len(hello) >> 8), byte(len(hello))}
+	handshake = append(handshake, hello...)
+
+	record := []byte{recordTypeHandshake}
+	record = appendUint16(record, recordVersion)
+	return appendUint16Prefixed(record, handshake)
+}
+
+// jarmResult 将 ServerHello 格式化为 “cipher|version|alpn|extensions”，失败时为 “|||”
+func jarmResult(hello *serverHello) string {
+	if hello == nil {
+		return "|||"
+	}
+	var types []string
+	for _, ext := range hello.Extensions {
+		types = append(types, fmt.Sprintf("%04x", ext.Type))
+	}
+	return fmt.Sprintf("%04x|%04x|%s|%s", hello.CipherSuite, hello.Version, firstALPN(hello.Extensions), strings.Join(types, "-"))
+}
+
+func jarmCipherByte(cipher string) string {
+	if cipher == "" {
+		return "00"
+	}
+	count := 1
+	for _, c := range jarmCipherIndex {
+		if fmt.Sprintf("%04x", c) == cipher {
+			break
+		}
+		count++
+	}
+	return fmt.Sprintf("%02x", count)
+}
+
+func jarmVersionByte(version string) string {
+	if len(version) < 4 {
+		return "0"
+	}
+	idx := int(version[3] - '0')
+	if idx < 0 || idx >= len("abcdef") {
+		return "0"
+	}
+	return string("abcdef"[idx])
+}
+
+// JARMHash 根据 10 个探测的原始结果（逗号分隔）计算 JARM 指纹
+func JARMHash(raw string) string {
+	handshakes := strings.Split(raw, ",")
+	empty := true
+	for _, h := range handshakes {
+		if h != "|||" {
+			empty = false
+			break
+		}
+	}
+	if empty {
+		return jarmEmptyHash
+	}
+
+	var fuzzy, alpnAndExt strings.Builder
+	for _, h := range handshakes {
+		components := strings.SplitN(h, "|", 4)
+		for len(components) < 4 {
+			components = append(components, "")
+		}
+		fuzzy.WriteString(jarmCipherByte(components[0]))
+		fuzzy.WriteString(jarmVersionByte(components[1]))
+		alpnAndExt.WriteString(components[2])
+		alpnAndExt.WriteString(components[3])
+	}
+	sum := sha256.Sum256([]byte(alpnAndExt.String()))
+	return fuzzy.String() + hex.EncodeToString(sum[:])[:32]
+}
+
+// jarmSendProbe 发送单个探测包并读取 ServerHello，任何错误都视为服务端拒绝
+func jarmSendProbe(ctx context.Context, config *serverFingerprintConfig, addr string, host string, probe jarmProbe) string {
+	conn, err := config.dial(ctx, addr)
+	if err != nil {
+		log.Debugf("jarm dial %v failed: %s", addr, err)
+		return "|||"
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(config.timeout))
+	if _, err := conn.Write(probe.packet(host)); err != nil {
+		return "|||"
+	}
+	hello, _ := readServerHello(conn)
+	return jarmResult(hello)
+}
+
+// readServerHello 持续读取直到可以解析出完整的 ServerHello
+func readServerHello(conn net.Conn) (*serverHello, error) {
+	var buf []byte
+	chunk := make([]byte, 4096)
+	for len(buf) < 64*1024 {
+		n, err := conn.Read(chunk)
+		buf = append(buf, chunk[:n]...)
+		if hello, parseErr := parseServerHello(buf); parseErr == nil {
+			return hello, nil
+		} else if parseErr == errTLSAlert || parseErr == errNotTLSHandshake || parseErr == errMalformedTLSHello {
+			return nil, parseErr
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return nil, utils.Error("server hello too large")
+}
+
+// JARM 计算目标 TLS 服务的 JARM 指纹
+// Example:
+// ```
+// jarm = ja3.JARM("example.com:443")~
+// println(jarm)
+// ```
+func JARM(addr string, opts ...ServerFingerprintOption) (string, error) {
+	config := newServerFingerprintConfig(opts...)
+	raw, err := jarmRaw(config.ctx, config, addr)
+	if err != nil {
+		return "", err
+	}
+	return JARMHash(raw), nil
+}
+
+func jarmRaw(ctx context.Context, config *serverFingerprintConfig, addr string) (string, error) {
+	host, port, err := utils.ParseStringToHostPort(addr)
+	if err != nil || port <= 0 {
+		host, port = addr, 443
+	}
+	addr = utils.HostPort(host, port)
+	sni := config.sni
+	if sni == "" {
+		sni = host
+	}
+
+	results := make([]string, len(jarmProbes))
+	for i, probe := range jarmProbes {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+		}
+		results[i] = jarmSendProbe(ctx, config, addr, sni, probe)
+	}
+	return strings.Join(results, ","), nil
+}
@@ -0,0 +1,328 @@
+package ja3
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// CertFingerprint 证书链中单张证书的指纹
+type CertFingerprint struct {
+	Subject      string `json:"subject"`
+	Issuer       string `json:"issuer"`
+	SerialNumber string `json:"serial_number"`
+	SHA1         string `json:"sha1"`
+	SHA256       string `json:"sha256"`
+	JA4X         string `json:"ja4x"`
+	SelfSigned   bool   `json:"self_signed"`
+}
+
+func NewCertFingerprint(cert *x509.Certificate) *CertFingerprint {
+	if cert == nil {
+		return nil
+	}
+	sha1Sum := sha1.Sum(cert.Raw)
+	sha256Sum := sha256.Sum256(cert.Raw)
+	return &CertFingerprint{
+		Subject:      cert.Subject.String(),
+		Issuer:       cert.Issuer.String(),
+		SerialNumber: cert.SerialNumber.String(),
+		SHA1:         hex.EncodeToString(sha1Sum[:]),
+		SHA256:       hex.EncodeToString(sha256Sum[:]),
+		JA4X:         CertJA4X(cert),
+		SelfSigned:   bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil,
+	}
+}
+
+// CertChainHash 证书链指纹：按服务端发送顺序拼接每张证书的 sha256 后再做一次 sha256
+func CertChainHash(certs []*x509.Certificate) string {
+	if len(certs) == 0 {
+		return ""
+	}
+	items := make([]string, 0, len(certs))
+	for _, cert := range certs {
+		sum := sha256.Sum256(cert.Raw)
+		items = append(items, hex.EncodeToString(sum[:]))
+	}
+	sum := sha256.Sum256([]byte(strings.Join(items, ",")))
+	return hex.EncodeToString(sum[:])
+}
+
+// ServerTLSFingerprint 主动探测得到的 TLS 服务端指纹
+type ServerTLSFingerprint struct {
+	Addr string `json:"addr"`
+
+	JARM    string `json:"jarm"`
+	JARMRaw string `json:"jarm_raw"`
+	JA4S    string `json:"ja4s"`
+	JA4SRaw string `json:"ja4s_raw"`
+
+	TLSVersion  string `json:"tls_version"`
+	CipherSuite string `json:"cipher_suite"`
+	ALPN        string `json:"alpn"`
+
+	Certificates  []*CertFingerprint `json:"certificates"`
+	CertChainHash string             `json:"cert_chain_hash"`
+
+	// 命中本地指纹库的记录
+	Matched []*FingerprintRecord `json:"matched"`
+}
+
+// LeafJA4X 叶子证书的 JA4X
+func (f *ServerTLSFingerprint) LeafJA4X() string {
+	if f == nil || len(f.Certificates) == 0 {
+		return ""
+	}
+	return f.Certificates[0].JA4X
+}
+
+// MatchedNames 命中的指纹名称（去重）
+func (f *ServerTLSFingerprint) MatchedNames() []string {
+	if f == nil {
+		return nil
+	}
+	var names []string
+	for _, r := range f.Matched {
+		names = append(names, r.Name)
+	}
+	return utils.RemoveRepeatStringSlice(names)
+}
+
+func (f *ServerTLSFingerprint) String() string {
+	if f == nil {
+		return ""
+	}
+	var buf strings.Builder
+	buf.WriteString("[" + f.Addr + "]")
+	if f.JARM != "" {
+		buf.WriteString(" jarm:" + f.JARM)
+	}
+	if f.JA4S != "" {
+		buf.WriteString(" ja4s:" + f.JA4S)
+	}
+	if f.CertChainHash != "" {
+		buf.WriteString(" chain:" + f.CertChainHash[:16])
+	}
+	if names := f.MatchedNames(); len(names) > 0 {
+		buf.WriteString(" matched:" + strings.Join(names, "|"))
+	}
+	return buf.String()
+}
+
+type serverFingerprintConfig struct {
+	ctx         context.Context
+	timeout     time.Duration
+	sni         string
+	proxies     []string
+	disableJARM bool
+}
+
+type ServerFingerprintOption func(*serverFingerprintConfig)
+
+func newServerFingerprintConfig(opts ...ServerFingerprintOption) *serverFingerprintConfig {
+	config := &serverFingerprintConfig{
+		ctx:     context.Background(),
+		timeout: 5 * time.Second,
+	}
+	for _, opt := range opts {
+		opt(config)
+	}
+	return config
+}
+
+func (c *serverFingerprintConfig) dial(ctx context.Context, addr string) (net.Conn, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return netx.DialX(addr, netx.DialX_WithTimeout(c.timeout), netx.DialX_WithProxy(c.proxies...))
+}
+
+// WithTimeout 设置每个连接的超时时间（秒）
+func WithTimeout(seconds float64) ServerFingerprintOption {
+	return func(c *serverFingerprintConfig) {
+		if seconds > 0 {
+			c.timeout = time.Duration(seconds * float64(time.Second))
+		}
+	}
+}
+
+// WithSNI 指定握手时使用的 SNI，默认使用目标主机
+func WithSNI(sni string) ServerFingerprintOption {
+	return func(c *serverFingerprintConfig) {
+		c.sni = sni
+	}
+}
+
+// WithProxy 通过代理进行探测
+func WithProxy(proxies ...string) ServerFingerprintOption {
+	return func(c *serverFingerprintConfig) {
+		c.proxies = utils.StringArrayFilterEmpty(proxies)
+	}
+}
+
+func WithContext(ctx context.Context) ServerFingerprintOption {
+	return func(c *serverFingerprintConfig) {
+		if ctx != nil {
+			c.ctx = ctx
+		}
+	}
+}
+
+// WithJARM 是否进行 JARM 探测，JARM 需要额外建立 10 个连接
+func WithJARM(b bool) ServerFingerprintOption {
+	return func(c *serverFingerprintConfig) {
+		c.disableJARM = !b
+	}
+}
+
+// recordConn 记录服务端返回的原始数据，用于从标准握手中提取 ServerHello
+type recordConn struct {
+	net.Conn
+	mu   sync.Mutex
+	read []byte
+}
+
+func (c *recordConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	c.mu.Lock()
+	if len(c.read) < 64*1024 {
+		c.read = append(c.read, b[:n]...)
+	}
+	c.mu.Unlock()
+	return n, err
+}
+
+func (c *recordConn) raw() []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.read
+}
+
+// ServerFingerprint 对目标 TLS 服务进行主动指纹识别，包括 JARM、JA4S 以及证书链指纹，
+// 并与本地指纹库进行匹配
+// Example:
+// ```
+// f = ja3.ServerFingerprint("example.com:443", ja3.timeout(3))~
+// println(f.JARM, f.JA4S, f.CertChainHash)
+// for r in f.Matched { println(r.Name) }
+// ```
+func ServerFingerprint(addr string, opts ...ServerFingerprintOption) (*ServerTLSFingerprint, error) {
+	config := newServerFingerprintConfig(opts...)
+	host, port, err := utils.ParseStringToHostPort(addr)
+	if err != nil || port <= 0 {
+		host, port = addr, 443
+	}
+	addr = utils.HostPort(host, port)
+	sni := config.sni
+	if sni == "" {
+		sni = host
+	}
+
+	result := &ServerTLSFingerprint{Addr: addr}
+
+	conn, err := config.dial(config.ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	rc := &recordConn{Conn: conn}
+	tlsConn := tls.Client(rc, &tls.Config{
+		ServerName:         sni,
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionTLS10,
+		MaxVersion:         tls.VersionTLS13,
+		NextProtos:         []string{"h2", "http/1.1"},
+	})
+	ctx, cancel := context.WithTimeout(config.ctx, config.timeout)
+	handshakeErr := tlsConn.HandshakeContext(ctx)
+	cancel()
+	if handshakeErr == nil {
+		state := tlsConn.ConnectionState()
+		result.TLSVersion = ParseTLSVersion(fmt.Sprint(state.Version)).VersionName
+		result.CipherSuite = tls.CipherSuiteName(state.CipherSuite)
+		result.ALPN = state.NegotiatedProtocol
+		for _, cert := range state.PeerCertificates {
+			result.Certificates = append(result.Certificates, NewCertFingerprint(cert))
+		}
+		result.CertChainHash = CertChainHash(state.PeerCertificates)
+	} else {
+		log.Debugf("tls handshake with %v failed: %s", addr, handshakeErr)
+	}
+	// 握手失败时只要拿到了 ServerHello 依然可以计算 JA4S
+	if hello, err := parseServerHello(rc.raw()); err == nil {
+		ja4s := hello.ja4s()
+		result.JA4S, result.JA4SRaw = ja4s.Fingerprint, ja4s.Raw
+	}
+	tlsConn.Close()
+
+	if !config.disableJARM {
+		result.JARMRaw, err = jarmRaw(config.ctx, config, addr)
+		if err != nil {
+			log.Debugf("jarm %v failed: %s", addr, err)
+		} else {
+			result.JARM = JARMHash(result.JARMRaw)
+		}
+	}
+
+	if result.JA4S == "" && result.CertChainHash == "" && (result.JARM == "" || result.JARM == jarmEmptyHash) {
+		if handshakeErr != nil {
+			return nil, utils.Errorf("%v is not a tls service: %s", addr, handshakeErr)
+		}
+		return nil, utils.Errorf("%v is not a tls service", addr)
+	}
+
+	result.Matched = MatchFingerprint(result)
+	return result, nil
+}
+
+// Cluster 按照指定的指纹类型对多个目标进行聚类，返回 指纹 -> 目标列表
+// by 可选 jarm / ja4s / ja4x / cert，默认为 jarm
+// Example:
+// ```
+// groups = ja3.Cluster(results, "jarm")
+// for hash, addrs = range groups { println(hash, addrs) }
+// ```
+func Cluster(results []*ServerTLSFingerprint, by ...string) map[string][]string {
+	kind := FingerprintTypeJARM
+	if len(by) > 0 && by[0] != "" {
+		kind = strings.ToLower(by[0])
+	}
+	ret := make(map[string][]string)
+	for _, r := range results {
+		if r == nil {
+			continue
+		}
+		key := r.hashOf(kind)
+		if key == "" || key == jarmEmptyHash {
+			continue
+		}
+		ret[key] = append(ret[key], r.Addr)
+	}
+	return ret
+}
+
+func (f *ServerTLSFingerprint) hashOf(kind string) string {
+	switch kind {
+	case FingerprintTypeJARM:
+		return f.JARM
+	case FingerprintTypeJA4S:
+		return f.JA4S
+	case FingerprintTypeJA4X:
+		return f.LeafJA4X()
+	case FingerprintTypeCert, "cert-chain", "chain":
+		return f.CertChainHash
+	}
+	return ""
+}
@@ -0,0 +1,164 @@
+package ja3
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTLSServer(t *testing.T) *httptest.Server {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("ok"))
+	}))
+	server.EnableHTTP2 = true
+	// JARM 的探测包大多会被拒绝，屏蔽握手失败的日志
+	server.Config.ErrorLog = log.New(io.Discard, "", 0)
+	server.StartTLS()
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestServerFingerprint(t *testing.T) {
+	server := newTestTLSServer(t)
+	addr := server.Listener.Addr().String()
+
+	result, err := ServerFingerprint(addr, WithTimeout(3))
+	require.NoError(t, err)
+	require.Len(t, result.JARM, 62)
+	require.NotEqual(t, jarmEmptyHash, result.JARM)
+	require.Regexp(t, regexp.MustCompile(`^t1[23]\d{2}(h2|00)_[0-9a-f]{4}_[0-9a-f]{12}$`), result.JA4S)
+	require.Equal(t, "h2", result.ALPN)
+
+	require.Len(t, result.Certificates, 1)
+	cert := server.Certificate()
+	require.Equal(t, CertChainHash([]*x509.Certificate{cert}), result.CertChainHash)
+	require.Equal(t, CertJA4X(cert), result.LeafJA4X())
+	require.Len(t, strings.Split(result.LeafJA4X(), "_"), 3)
+
+	// 同一个 TLS 栈与配置，JARM 应当稳定
+	jarm, err := JARM(addr, WithTimeout(3))
+	require.NoError(t, err)
+	require.Equal(t, result.JARM, jarm)
+}
+
+func TestServerFingerprintMatchAndCluster(t *testing.T) {
+	server1 := newTestTLSServer(t)
+	server2 := newTestTLSServer(t)
+
+	r1, err := ServerFingerprint(server1.Listener.Addr().String(), WithTimeout(3))
+	require.NoError(t, err)
+	require.Empty(t, r1.Matched)
+
+	AddFingerprint("jarm", strings.ToUpper(r1.JARM), "local-test-c2", "c2")
+	AddFingerprint("ja4x", r1.LeafJA4X(), "httptest-cert")
+
+	r2, err := ServerFingerprint(server2.Listener.Addr().String(), WithTimeout(3))
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"local-test-c2", "httptest-cert"}, r2.MatchedNames())
+
+	groups := Cluster([]*ServerTLSFingerprint{r1, r2})
+	require.Len(t, groups, 1)
+	require.Len(t, groups[r1.JARM], 2)
+	// httptest 的两个服务使用同一张证书
+	require.Len(t, Cluster([]*ServerTLSFingerprint{r1, r2}, "cert"), 1)
+}
+
+func TestDefaultFingerprintWithoutJavaJARM(t *testing.T) {
+	// Java 默认 TLS 配置的 JARM，大量正常的 Java 服务都会命中
+	for _, hash := range []string{
+		"07d14d16d21d21d00042d41d00041de5fb3038104f457d92ba02e9311512c2",
+		"07d14d16d21d21d07c42d41d00041d24a458a375eef0c576d23a7bab9a9fb1",
+	} {
+		require.Empty(t, LookupFingerprint(FingerprintTypeJARM, hash))
+	}
+}
+
+func TestServerFingerprintNotTLS(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				conn.Write([]byte("SSH-2.0-OpenSSH_8.9\r\n"))
+				time.Sleep(100 * time.Millisecond)
+			}()
+		}
+	}()
+
+	_, err = ServerFingerprint(lis.Addr().String(), WithTimeout(1))
+	require.Error(t, err)
+}
+
+func TestJARMHash(t *testing.T) {
+	require.Equal(t, jarmEmptyHash, JARMHash(strings.TrimSuffix(strings.Repeat("|||,", 10), ",")))
+
+	raw := "c02f|0303|h2|ff01-0000-0001-0023-0010-0017,|||,|||,|||,|||,|||,1301|0303|h2|002b-0033,|||,|||,|||"
+	hash := JARMHash(raw)
+	require.Len(t, hash, 62)
+	// c02f 在索引表中位于第 41 位（0x29），1301 位于第 65 位（0x41），0303 对应版本字符 d
+	require.True(t, strings.HasPrefix(hash, "29d00000000000000041d"), hash)
+}
+
+func TestJARMMung(t *testing.T) {
+	items := []int{1, 2, 3, 4, 5}
+	require.Equal(t, []int{5, 4, 3, 2, 1}, jarmMung(items, "REVERSE"))
+	require.Equal(t, []int{4, 5}, jarmMung(items, "BOTTOM_HALF"))
+	require.Equal(t, []int{3, 2, 1}, jarmMung(items, "TOP_HALF"))
+	require.Equal(t, []int{3, 4, 2, 5, 1}, jarmMung(items, "MIDDLE_OUT"))
+	require.Equal(t, []int{3, 2, 4, 1}, jarmMung([]int{1, 2, 3, 4}, "MIDDLE_OUT"))
+}
+
+func TestParseJA4(t *testing.T) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+
+	helloC := make(chan []byte, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 4096)
+		n, _ := conn.Read(buf)
+		helloC <- buf[:n]
+	}()
+
+	conn, err := net.Dial("tcp", lis.Addr().String())
+	require.NoError(t, err)
+	client := tls.Client(conn, &tls.Config{ServerName: "example.com", NextProtos: []string{"h2", "http/1.1"}, InsecureSkipVerify: true})
+	client.SetDeadline(time.Now().Add(time.Second))
+	go client.Handshake()
+	defer client.Close()
+
+	raw := <-helloC
+	ja4, err := ParseJA4(raw)
+	require.NoError(t, err)
+	require.Regexp(t, regexp.MustCompile(`^t13d\d{4}h2_[0-9a-f]{12}_[0-9a-f]{12}$`), ja4.Fingerprint)
+	require.True(t, strings.HasPrefix(ja4.Raw, ja4.Fingerprint[:10]+"_"))
+
+	// 记录层与握手消息两种输入得到的结果一致
+	ja4FromHandshake, err := ParseJA4(raw[5:])
+	require.NoError(t, err)
+	require.Equal(t, ja4.Fingerprint, ja4FromHandshake.Fingerprint)
+
+	_, err = ParseJA4S(raw)
+	require.Error(t, err)
+}
@@ -0,0 +1,251 @@
+package ja3
+
+import (
+	"errors"
+	"io"
+
+	"golang.org/x/crypto/cryptobyte"
+)
+
+const (
+	recordTypeAlert     = 21
+	recordTypeHandshake = 22
+
+	handshakeTypeClientHello = 1
+	handshakeTypeServerHello = 2
+)
+
+var (
+	errTLSAlert          = errors.New("tls alert received")
+	errNotTLSHandshake   = errors.New("not a tls handshake")
+	errMalformedTLSHello = errors.New("malformed tls hello")
+)
+
+type helloExtension struct {
+	Type uint16
+	Data []byte
+}
+
+type clientHello struct {
+	Version      uint16
+	CipherSuites []uint16
+	Extensions   []helloExtension
+}
+
+type serverHello struct {
+	Version     uint16
+	CipherSuite uint16
+	Extensions  []helloExtension
+}
+
+// isGREASE 判断是否为 GREASE 值（RFC 8701），计算指纹时需要忽略
+func isGREASE(v uint16) bool {
+	return v&0x0f0f == 0x0a0a && v>>8 == v&0xff
+}
+
+// readHandshakeMessage 从原始数据中取出第一个完整的握手消息
+// raw 可以是 TLS 记录流（0x16 开头），也可以是不带记录头的握手消息
+func readHandshakeMessage(raw []byte) (uint8, []byte, error) {
+	if len(raw) == 0 {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+
+	var msg []byte
+	switch raw[0] {
+	case recordTypeAlert:
+		return 0, nil, errTLSAlert
+	case recordTypeHandshake:
+		// 握手消息可能被拆分到多个记录中，按顺序拼接
+		for len(raw) >= 5 {
+			recordType, length := raw[0], int(raw[3])<<8|int(raw[4])
+			if recordType != recordTypeHandshake {
+				break
+			}
+			if len(raw) < 5+length {
+				msg = append(msg, raw[5:]...)
+				raw = nil
+				break
+			}
+			msg = append(msg, raw[5:5+length]...)
+			raw = raw[5+length:]
+			if len(msg) >= 4 && len(msg) >= 4+(int(msg[1])<<16|int(msg[2])<<8|int(msg[3])) {
+				break
+			}
+		}
+	case handshakeTypeClientHello, handshakeTypeServerHello:
+		msg = raw
+	default:
+		return 0, nil, errNotTLSHandshake
+	}
+
+	if len(msg) < 4 {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	length := int(msg[1])<<16 | int(msg[2])<<8 | int(msg[3])
+	if len(msg) < 4+length {
+		return 0, nil, io.ErrUnexpectedEOF
+	}
+	return msg[0], msg[4 : 4+length], nil
+}
+
+func readHelloExtensions(s *cryptobyte.String) ([]helloExtension, bool) {
+	if s.Empty() {
+		// 没有扩展字段
+		return nil, true
+	}
+	var extensions cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&extensions) {
+		return nil, false
+	}
+	var ret []helloExtension
+	for !extensions.Empty() {
+		var extType uint16
+		var extData cryptobyte.String
+		if !extensions.ReadUint16(&extType) || !extensions.ReadUint16LengthPrefixed(&extData) {
+			return nil, false
+		}
+		ret = append(ret, helloExtension{Type: extType, Data: extData})
+	}
+	return ret, true
+}
+
+func parseClientHello(raw []byte) (*clientHello, error) {
+	msgType, body, err := readHandshakeMessage(raw)
+	if err != nil {
+		return nil, err
+	}
+	if msgType != handshakeTypeClientHello {
+		return nil, errNotTLSHandshake
+	}
+
+	hello := &clientHello{}
+	s := cryptobyte.String(body)
+	var sessionID, cipherSuites, compressionMethods cryptobyte.String
+	if !s.ReadUint16(&hello.Version) || !s.Skip(32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) ||
+		!s.ReadUint16LengthPrefixed(&cipherSuites) ||
+		!s.ReadUint8LengthPrefixed(&compressionMethods) {
+		return nil, errMalformedTLSHello
+	}
+	for !cipherSuites.Empty() {
+		var suite uint16
+		if !cipherSuites.ReadUint16(&suite) {
+			return nil, errMalformedTLSHello
+		}
+		hello.CipherSuites = append(hello.CipherSuites, suite)
+	}
+	var ok bool
+	hello.Extensions, ok = readHelloExtensions(&s)
+	if !ok {
+		return nil, errMalformedTLSHello
+	}
+	return hello, nil
+}
+
+func parseServerHello(raw []byte) (*serverHello, error) {
+	msgType, body, err := readHandshakeMessage(raw)
+	if err != nil {
+		return nil, err
+	}
+	if msgType != handshakeTypeServerHello {
+		return nil, errNotTLSHandshake
+	}
+
+	hello := &serverHello{}
+	s := cryptobyte.String(body)
+	var sessionID cryptobyte.String
+	var compressionMethod uint8
+	if !s.ReadUint16(&hello.Version) || !s.Skip(32) ||
+		!s.ReadUint8LengthPrefixed(&sessionID) ||
+		!s.ReadUint16(&hello.CipherSuite) ||
+		!s.ReadUint8(&compressionMethod) {
+		return nil, errMalformedTLSHello
+	}
+	var ok bool
+	hello.Extensions, ok = readHelloExtensions(&s)
+	if !ok {
+		return nil, errMalformedTLSHello
+	}
+	return hello, nil
+}
+
+func findExtension(extensions []helloExtension, extType uint16) ([]byte, bool) {
+	for _, ext := range extensions {
+		if ext.Type == extType {
+			return ext.Data, true
+		}
+	}
+	return nil, false
+}
+
+// firstALPN 取 ALPN 扩展中的第一个协议名
+func firstALPN(extensions []helloExtension) string {
+	data, ok := findExtension(extensions, extensionALPN)
+	if !ok {
+		return ""
+	}
+	s := cryptobyte.String(data)
+	var list, proto cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&list) || !list.ReadUint8LengthPrefixed(&proto) {
+		return ""
+	}
+	return string(proto)
+}
+
+// negotiatedVersion 服务端在 supported_versions 中选定的版本优先于 legacy_version
+func (h *serverHello) negotiatedVersion() uint16 {
+	if data, ok := findExtension(h.Extensions, extensionSupportedVersions); ok && len(data) == 2 {
+		return uint16(data[0])<<8 | uint16(data[1])
+	}
+	return h.Version
+}
+
+// highestVersion 客户端 supported_versions 中最高的非 GREASE 版本，没有该扩展时使用 legacy_version
+func (h *clientHello) highestVersion() uint16 {
+	data, ok := findExtension(h.Extensions, extensionSupportedVersions)
+	if !ok {
+		return h.Version
+	}
+	s := cryptobyte.String(data)
+	var versions cryptobyte.String
+	if !s.ReadUint8LengthPrefixed(&versions) {
+		return h.Version
+	}
+	var highest uint16
+	for !versions.Empty() {
+		var v uint16
+		if !versions.ReadUint16(&v) {
+			break
+		}
+		if !isGREASE(v) && v > highest {
+			highest = v
+		}
+	}
+	if highest == 0 {
+		return h.Version
+	}
+	return highest
+}
+
+func (h *clientHello) signatureAlgorithms() []uint16 {
+	data, ok := findExtension(h.Extensions, extensionSignatureAlgorithms)
+	if !ok {
+		return nil
+	}
+	s := cryptobyte.String(data)
+	var algs cryptobyte.String
+	if !s.ReadUint16LengthPrefixed(&algs) {
+		return nil
+	}
+	var ret []uint16
+	for !algs.Empty() {
+		var alg uint16
+		if !algs.ReadUint16(&alg) {
+			break
+		}
+		if !isGREASE(alg) {
+			ret = append(ret, alg)
+		}
+	}
+	return ret
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io"
 	"net"
+	"net/http"
 	"net/url"
 
 	"github.com/yaklang/yaklang/common/log"
@@ -43,39 +44,45 @@ func (p *Proxy) proxyH2(closing chan bool, cc net.Conn, url *url.URL, ctx *Conte
 		if req.URL != nil {
 			req.URL.Scheme = "https"
 		}
-		if err := p.reqmod.ModifyRequest(req); err != nil {
-			log.Errorf("mitm: error modifying request: %v", err)
-			proxyutil.Warning(req.Header, err)
-			return nil, nil, err
-		}
-		if httpctx.GetContextBoolInfoFromRequest(req, httpctx.REQUEST_CONTEXT_KEY_IsDropped) {
-			return []byte(`HTTP/2 200 OK
+		return p.proxyMultiplexedRequest(req, "HTTP/2")
+	})
+}
+
+// proxyMultiplexedRequest runs the modifiers and the upstream request for one h2/h3 stream,
+// returns the response header and body to be written back to the stream
+func (p *Proxy) proxyMultiplexedRequest(req *http.Request, proto string) ([]byte, io.ReadCloser, error) {
+	if err := p.reqmod.ModifyRequest(req); err != nil {
+		log.Errorf("mitm: error modifying request: %v", err)
+		proxyutil.Warning(req.Header, err)
+		return nil, nil, err
+	}
+	if httpctx.GetContextBoolInfoFromRequest(req, httpctx.REQUEST_CONTEXT_KEY_IsDropped) {
+		return []byte(proto + ` 200 OK
 Content-Type: text/html
 `), io.NopCloser(bytes.NewBufferString(proxyutil.GetPrettyErrorRsp("请求被用户丢弃"))), nil
-		} else {
-			rsp, err := p.execLowhttp(req)
-			if err != nil {
-				log.Errorf("mitm: error requesting to remote server: %v", err)
-				return nil, nil, err
-			}
-			defer func() {
-				if rsp != nil && rsp.Body != nil {
-					rsp.Body.Close()
-				}
-			}()
+	}
 
-			if err := p.resmod.ModifyResponse(rsp); err != nil {
-				log.Errorf("mitm: error modifying response: %v", err)
-				proxyutil.Warning(req.Header, err)
-				return nil, nil, err
-			}
-
-			rspBytes, err := utils.DumpHTTPResponse(rsp, true)
-			if err != nil {
-				return nil, nil, err
-			}
-			header, body := lowhttp.SplitHTTPPacketFast(rspBytes)
-			return []byte(header), io.NopCloser(bytes.NewBuffer(body)), nil
+	rsp, err := p.execLowhttp(req)
+	if err != nil {
+		log.Errorf("mitm: error requesting to remote server: %v", err)
+		return nil, nil, err
+	}
+	defer func() {
+		if rsp != nil && rsp.Body != nil {
+			rsp.Body.Close()
 		}
-	})
+	}()
+
+	if err := p.resmod.ModifyResponse(rsp); err != nil {
+		log.Errorf("mitm: error modifying response: %v", err)
+		proxyutil.Warning(req.Header, err)
+		return nil, nil, err
+	}
+
+	rspBytes, err := utils.DumpHTTPResponse(rsp, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	header, body := lowhttp.SplitHTTPPacketFast(rspBytes)
+	return []byte(header), io.NopCloser(bytes.NewBuffer(body)), nil
 }
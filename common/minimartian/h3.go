@@ -0,0 +1,62 @@
+package minimartian
+
+import (
+	"context"
+	"io"
+	"net"
+
+	"github.com/quic-go/quic-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
+)
+
+// ServeHTTP3 terminates HTTP/3 (QUIC) on pc with the MITM certificates and relays every request upstream over HTTP/3.
+// Since browsers never send HTTP/3 via a proxy, clients reach pc by dns/hosts hijacking or Alt-Svc,
+// the upstream is taken from the :authority of each request.
+func (p *Proxy) ServeHTTP3(ctx context.Context, pc net.PacketConn) error {
+	if p.mitm == nil {
+		return utils.Error("mitm: HTTP/3 requires mitm config")
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tlsConfig := p.mitm.TLSForHost(utils.ExtractHost(pc.LocalAddr().String()), false)
+	tlsConfig.NextProtos = []string{lowhttp.H3}
+
+	l, err := quic.Listen(pc, tlsConfig, nil)
+	if err != nil {
+		return err
+	}
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-p.closing:
+		}
+		l.Close()
+	}()
+	log.Infof("mitm: serving HTTP/3 on udp://%v", pc.LocalAddr())
+	return lowhttp.ServeHTTP3(ctx, l, p.proxyH3)
+}
+
+func (p *Proxy) proxyH3(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+	raw := header
+	bodyBytes, err := io.ReadAll(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(bodyBytes) > 0 {
+		// HTTP/3 frames the body by itself, content-length is fixed for the upstream and flow
+		raw = lowhttp.ReplaceHTTPPacketBody(header, bodyBytes, false)
+	}
+	req, err := utils.ReadHTTPRequestFromBytes(raw)
+	if err != nil {
+		return nil, nil, err
+	}
+	httpctx.SetRequestHTTPS(req, true)
+	if req.URL != nil {
+		req.URL.Scheme = "https"
+	}
+	return p.proxyMultiplexedRequest(req, "HTTP/3")
+}
@@ -67,6 +67,7 @@ func (p *Proxy) execLowhttp(req *http.Request) (*http.Response, error) {
 		p.lowhttpConfig,
 		lowhttp.WithRequest(reqBytes),
 		lowhttp.WithHttp2(isH2),
+		lowhttp.WithHttp3(req.ProtoMajor == 3),
 		lowhttp.WithHttps(isHttps),
 		lowhttp.WithGmTLS(isGmTLS),
 		lowhttp.WithGmTLSOnly(p.gmTLSOnly),
@@ -19,6 +19,8 @@ import (
 	"github.com/tidwall/gjson"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/graphqlx"
+	"github.com/yaklang/yaklang/common/utils/grpcx"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/http_struct"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
@@ -47,6 +49,7 @@ type FuzzHTTPRequest struct {
 	mode                   int
 	mutex                  sync.Mutex
 	fromPlugin             string
+	jwtKeys                []string
 }
 
 func (f *FuzzHTTPRequest) NoAutoEncode() bool {
@@ -128,6 +131,18 @@ type FuzzHTTPRequestIf interface {
 	// 测试 PostXML 中的数据
 	FuzzPostXMLParams(k, v interface{}) FuzzHTTPRequestIf
 
+	// 测试 gRPC / gRPC-Web 请求中 Protobuf 消息的字段
+	FuzzPostGRPCParams(k, v interface{}) FuzzHTTPRequestIf
+
+	// 测试 GraphQL 请求中的参数，包括 query 中的字面量参数、variables 与 operationName
+	FuzzPostGraphQLParams(k, v interface{}) FuzzHTTPRequestIf
+
+	// 根据 GraphQL Schema（内省结果或 SDL）为每一个 query/mutation 字段生成合法请求
+	FuzzPostGraphQLSchema(schema interface{}) FuzzHTTPRequestIf
+
+	// 按编码链测试多层编码中的值，例如 `cookie:session|base64|json:$.token|jwt:payload|json:$.role`，修改后逐层重新编码
+	FuzzEncodingChain(chain string, v any) FuzzHTTPRequestIf
+
 	// 测试 Cookie 中的数据
 	FuzzCookieRaw(value interface{}) FuzzHTTPRequestIf
 
@@ -244,6 +259,7 @@ type buildFuzzHTTPRequestConfig struct {
 	Proxy           string
 	Ctx             context.Context
 	FromPlugin      string
+	JWTKeys         []string
 }
 
 type BuildFuzzHTTPRequestOption func(config *buildFuzzHTTPRequestConfig)
@@ -296,6 +312,13 @@ func OptFromPlugin(i string) BuildFuzzHTTPRequestOption {
 	}
 }
 
+// OptJWTKey 设置 JWT 的签名密钥（HMAC 密钥或 PEM 格式私钥），嵌套在参数中的 JWT 被修改后会使用该密钥重新签名
+func OptJWTKey(key string) BuildFuzzHTTPRequestOption {
+	return func(config *buildFuzzHTTPRequestConfig) {
+		config.JWTKeys = append(config.JWTKeys, key)
+	}
+}
+
 func OptContext(ctx context.Context) BuildFuzzHTTPRequestOption {
 	return func(config *buildFuzzHTTPRequestConfig) {
 		config.Ctx = ctx
@@ -475,6 +498,7 @@ func NewFuzzHTTPRequest(i interface{}, opts ...BuildFuzzHTTPRequestOption) (*Fuz
 		opts:            opts,
 		mode:            packetFuzz,
 		fromPlugin:      config.FromPlugin,
+		jwtKeys:         config.JWTKeys,
 	}
 
 	return req, nil
@@ -509,6 +533,10 @@ func (f *FuzzHTTPRequest) GetCurrentOptions() []BuildFuzzHTTPRequestOption {
 		result = append(result, OptQueryParams(f.queryParams))
 	}
 
+	for _, key := range f.jwtKeys {
+		result = append(result, OptJWTKey(key))
+	}
+
 	return result
 }
 
@@ -601,6 +629,8 @@ func (f *FuzzHTTPRequest) GetGetQueryParams() []*FuzzHTTPRequestParam {
 
 		}
 
+		fuzzParams = append(fuzzParams, f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerQuery, Key: key}, value)...)
+
 		param := &FuzzHTTPRequestParam{
 			position:   lowhttp.PosGetQuery,
 			param:      key,
@@ -614,7 +644,19 @@ func (f *FuzzHTTPRequest) GetGetQueryParams() []*FuzzHTTPRequestParam {
 }
 
 func (f *FuzzHTTPRequest) GetPostCommonParams() []*FuzzHTTPRequestParam {
+	if grpcx.IsGRPCContentType(f.GetHeader("Content-Type")) {
+		return f.GetPostGRPCParams()
+	}
+	if graphQLParams := f.GetPostGraphQLParams(); len(graphQLParams) > 0 {
+		return graphQLParams
+	}
+	if f.IsBodyFormEncoded() {
+		return f.GetPostMultipartParams()
+	}
 	postParams := f.GetPostJsonParams()
+	if len(postParams) > 0 {
+		postParams = append(postParams, f.GetPostJsonEncodedParams()...)
+	}
 	if len(postParams) <= 0 {
 		postParams = f.GetPostXMLParams()
 	}
@@ -751,6 +793,72 @@ func (f *FuzzHTTPRequest) GetPostXMLParams() []*FuzzHTTPRequestParam {
 	return fuzzParams
 }
 
+// GetPostGRPCParams 获取 gRPC 消息中的标量字段，注册过 .proto 的方法使用字段名，否则使用字段编号
+func (f *FuzzHTTPRequest) GetPostGRPCParams() []*FuzzHTTPRequestParam {
+	packet, err := grpcx.ParsePacket(f.GetBytes())
+	if err != nil {
+		return nil
+	}
+
+	var fuzzParams []*FuzzHTTPRequestParam
+	for _, param := range packet.Params(grpcx.MessageDescriptor(f.GetBytes(), false)) {
+		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+			position:   lowhttp.PosPostGRPC,
+			param:      param.Name,
+			paramValue: param.Value,
+			raw:        param.Value,
+			path:       param.Path,
+			origin:     f,
+		})
+	}
+	return fuzzParams
+}
+
+// GetPostGraphQLParams 获取 GraphQL 请求中的参数：query 中参数的字面量、variables 中的值以及 operationName，
+// 批量请求（JSON 数组）中的参数名以 `[i].` 开头
+func (f *FuzzHTTPRequest) GetPostGraphQLParams() []*FuzzHTTPRequestParam {
+	req, err := f.GetOriginHTTPRequest()
+	if err != nil {
+		return nil
+	}
+	queries := graphqlx.ExtractQueries(httpRequestReadBody(req), f.GetHeader("Content-Type"))
+
+	var fuzzParams []*FuzzHTTPRequestParam
+	for i, q := range queries {
+		namePrefix := ""
+		if q.IsBatch() {
+			namePrefix = fmt.Sprintf("[%d].", i)
+		}
+		add := func(name string, value interface{}, path string) {
+			fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+				position:   lowhttp.PosPostGraphQL,
+				param:      namePrefix + name,
+				paramValue: value,
+				raw:        value,
+				path:       path,
+				origin:     f,
+			})
+		}
+
+		if q.OperationName != "" {
+			add("operationName", q.OperationName, q.JSONPath("operationName"))
+		}
+		// query 中的字面量参数，path 为 `<json prefix>#<document path>`
+		for _, param := range q.Document.Params() {
+			add(param.Name, param.Value, q.Prefix+"#"+param.Path)
+		}
+		if q.Variables != "" && q.Prefix != "" {
+			walk(gjson.Parse(q.Variables), "", q.JSONPath("variables"), func(key, val gjson.Result, gPath, jPath string) {
+				if val.IsObject() || val.IsArray() {
+					return
+				}
+				add("$"+gPath, val.Value(), jPath)
+			})
+		}
+	}
+	return fuzzParams
+}
+
 func (f *FuzzHTTPRequest) GetPostParams() []*FuzzHTTPRequestParam {
 	req, err := f.GetOriginHTTPRequest()
 	if err != nil {
@@ -823,6 +931,8 @@ func (f *FuzzHTTPRequest) GetPostParams() []*FuzzHTTPRequestParam {
 
 		}
 
+		fuzzParams = append(fuzzParams, f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerPost, Key: key}, value)...)
+
 		param := &FuzzHTTPRequestParam{
 			position:   lowhttp.PosPostQuery,
 			param:      key,
@@ -891,6 +1001,8 @@ func (f *FuzzHTTPRequest) GetCookieParams() []*FuzzHTTPRequestParam {
 			})
 		}
 
+		fuzzParams = append(fuzzParams, f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerCookie, Key: k.Name}, k.Value)...)
+
 		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
 			position:   lowhttp.PosCookie,
 			param:      k.Name,
@@ -1006,6 +1118,7 @@ func (f *FuzzHTTPRequest) GetAllParams() []*FuzzHTTPRequestParam {
 func (f *FuzzHTTPRequest) GetHeaderParams() []*FuzzHTTPRequestParam {
 	keys := f.GetHeaderKeys()
 	params := make([]*FuzzHTTPRequestParam, len(keys))
+	var encodedParams []*FuzzHTTPRequestParam
 	for i, k := range keys {
 		value := f.GetHeader(k)
 		params[i] = &FuzzHTTPRequestParam{
@@ -1014,8 +1127,12 @@ func (f *FuzzHTTPRequest) GetHeaderParams() []*FuzzHTTPRequestParam {
 			paramValue: value,
 			origin:     f,
 		}
+		// Cookie 中的值由 Cookie 参数处理
+		if !strings.EqualFold(k, "Cookie") {
+			encodedParams = append(encodedParams, f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerHeader, Key: k}, value)...)
+		}
 	}
-	return params
+	return append(params, encodedParams...)
 }
 
 func (f *FuzzHTTPRequest) GetHeaderParamByName(k string) *FuzzHTTPRequestParam {
@@ -286,6 +286,54 @@ func (f *FuzzHTTPRequestBatch) FuzzPostJsonParams(k, v interface{}) FuzzHTTPRequ
 	return f.toFuzzHTTPRequestIf(reqs)
 }
 
+func (f *FuzzHTTPRequestBatch) FuzzPostGRPCParams(k, v interface{}) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzPostGRPCParams(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzPostGRPCParams(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzPostGraphQLParams(k, v interface{}) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzPostGraphQLParams(k, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzPostGraphQLParams(k, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzPostGraphQLSchema(schema interface{}) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzPostGraphQLSchema(schema)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzPostGraphQLSchema(schema))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
+func (f *FuzzHTTPRequestBatch) FuzzEncodingChain(chain string, v any) FuzzHTTPRequestIf {
+	if len(f.nextFuzzRequests) <= 0 {
+		return f.fallback.FuzzEncodingChain(chain, v)
+	}
+	var reqs []FuzzHTTPRequestIf
+	for _, req := range f.nextFuzzRequests {
+		reqs = append(reqs, req.FuzzEncodingChain(chain, v))
+	}
+
+	return f.toFuzzHTTPRequestIf(reqs)
+}
+
 func (f *FuzzHTTPRequestBatch) FuzzPostXMLParams(k, v interface{}) FuzzHTTPRequestIf {
 	if len(f.nextFuzzRequests) <= 0 {
 		return f.fallback.FuzzPostXMLParams(k, v)
@@ -0,0 +1,605 @@
+package mutate
+
+import (
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/dgrijalva/jwt-go"
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/authhack"
+	"github.com/yaklang/yaklang/common/jsonpath"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
+	"github.com/yaklang/yaklang/common/utils/multipart"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+// 编码链描述了参数值从请求中取出后逐层解码的过程，例如 `cookie:session|base64|json:$.token|jwt:payload|json:$.role`，
+// 第一层是值在请求中的位置，其余每一层都是一次解码，模糊测试时按相反的顺序逐层重新编码
+const (
+	EncodingLayerQuery     = "query"
+	EncodingLayerPost      = "post"
+	EncodingLayerCookie    = "cookie"
+	EncodingLayerHeader    = "header"
+	EncodingLayerMultipart = "multipart"
+	EncodingLayerBody      = "body"
+
+	EncodingLayerScheme    = "scheme"
+	EncodingLayerURL       = "url"
+	EncodingLayerBase64    = "base64"
+	EncodingLayerBase64Url = "base64url"
+	EncodingLayerForm      = "form"
+	EncodingLayerJSON      = "json"
+	EncodingLayerXML       = "xml"
+	EncodingLayerJWT       = "jwt"
+)
+
+const maxEncodingChainDepth = 8
+
+var (
+	encodingFormKeyRegexp      = regexp.MustCompile(`^[\w.\-\[\]]+$`)
+	encodingURLEscapeRegexp    = regexp.MustCompile(`%[0-9a-fA-F]{2}`)
+	encodingAuthSchemeRegexp   = regexp.MustCompile(`^([A-Za-z][\w\-]*) (\S+)$`)
+	encodingContainerPositions = map[string]lowhttp.HttpParamPositionType{
+		EncodingLayerQuery:     lowhttp.PosGetQueryEncoded,
+		EncodingLayerPost:      lowhttp.PosPostQueryEncoded,
+		EncodingLayerCookie:    lowhttp.PosCookieEncoded,
+		EncodingLayerHeader:    lowhttp.PosHeaderEncoded,
+		EncodingLayerMultipart: lowhttp.PosPostMultipart,
+		EncodingLayerBody:      lowhttp.PosPostJsonEncoded,
+	}
+)
+
+// EncodingLayer 是编码链中的一层，Key 用于在这一层中定位内层的值，例如参数名、JsonPath、XPath 或 JWT 的 header/payload
+type EncodingLayer struct {
+	Kind string
+	Key  string
+}
+
+func (l *EncodingLayer) String() string {
+	if l.Key == "" {
+		return l.Kind
+	}
+	return l.Kind + ":" + l.Key
+}
+
+type EncodingChain []*EncodingLayer
+
+func (c EncodingChain) String() string {
+	items := make([]string, len(c))
+	for i, layer := range c {
+		items[i] = layer.String()
+	}
+	return strings.Join(items, "|")
+}
+
+// ParseEncodingChain 解析编码链，第一层必须是值在请求中的位置（query/post/cookie/header/multipart/body）
+func ParseEncodingChain(s string) (EncodingChain, error) {
+	var chain EncodingChain
+	for i, item := range strings.Split(s, "|") {
+		kind, key, _ := strings.Cut(item, ":")
+		_, isContainer := encodingContainerPositions[kind]
+		if isContainer != (i == 0) {
+			return nil, utils.Errorf("invalid encoding chain: %v", s)
+		}
+		switch kind {
+		case EncodingLayerQuery, EncodingLayerPost, EncodingLayerCookie, EncodingLayerHeader, EncodingLayerMultipart,
+			EncodingLayerScheme, EncodingLayerForm, EncodingLayerJSON, EncodingLayerXML:
+			if key == "" {
+				return nil, utils.Errorf("encoding layer %v needs a key", kind)
+			}
+		case EncodingLayerJWT:
+			if key != "header" && key != "payload" {
+				return nil, utils.Errorf("jwt layer key must be header or payload, got: %v", key)
+			}
+		case EncodingLayerBody, EncodingLayerURL, EncodingLayerBase64, EncodingLayerBase64Url:
+		default:
+			return nil, utils.Errorf("unknown encoding layer: %v", kind)
+		}
+		chain = append(chain, &EncodingLayer{Kind: kind, Key: key})
+	}
+	return chain, nil
+}
+
+type encodingLeaf struct {
+	chain EncodingChain
+	value any
+}
+
+type decodedLayer struct {
+	layer *EncodingLayer
+	value any
+}
+
+// discoverEncodingLeaves 逐层尝试解码 raw，返回所有叶子值以及从 raw 到叶子值的编码链
+func discoverEncodingLeaves(raw string, depth int) []*encodingLeaf {
+	if depth < maxEncodingChainDepth {
+		if children := decodeEncodingLayer(raw); len(children) > 0 {
+			var leaves []*encodingLeaf
+			for _, child := range children {
+				s, ok := child.value.(string)
+				if !ok {
+					leaves = append(leaves, &encodingLeaf{chain: EncodingChain{child.layer}, value: child.value})
+					continue
+				}
+				for _, leaf := range discoverEncodingLeaves(s, depth+1) {
+					leaf.chain = append(EncodingChain{child.layer}, leaf.chain...)
+					leaves = append(leaves, leaf)
+				}
+			}
+			return leaves
+		}
+	}
+	return []*encodingLeaf{{value: raw}}
+}
+
+// decodeEncodingLayer 识别 raw 最外层的编码，依次尝试 JWT、JSON、XML、Base64、表单与 URL 编码
+func decodeEncodingLayer(raw string) []*decodedLayer {
+	trimmed := strings.TrimSpace(raw)
+	if trimmed == "" || trimmed != raw {
+		return nil
+	}
+
+	if header, payload, ok := splitJWT(raw); ok {
+		return []*decodedLayer{
+			{layer: &EncodingLayer{Kind: EncodingLayerJWT, Key: "header"}, value: header},
+			{layer: &EncodingLayer{Kind: EncodingLayerJWT, Key: "payload"}, value: payload},
+		}
+	}
+
+	if (strings.HasPrefix(raw, "{") || strings.HasPrefix(raw, "[")) && gjson.Valid(raw) {
+		var children []*decodedLayer
+		walk(gjson.Parse(raw), "", "$", func(key, val gjson.Result, gPath, jPath string) {
+			if val.IsObject() || val.IsArray() || strings.Contains(jPath, "|") {
+				return
+			}
+			var value any = val.Value()
+			if val.Type == gjson.String {
+				value = val.String()
+			}
+			children = append(children, &decodedLayer{layer: &EncodingLayer{Kind: EncodingLayerJSON, Key: jPath}, value: value})
+		})
+		return children
+	}
+
+	if children := decodeXMLLayer(raw); len(children) > 0 {
+		return children
+	}
+
+	if decoded, ok := IsStrictBase64(raw); ok && !strings.Contains(raw, "%") {
+		kind := EncodingLayerBase64
+		if strings.ContainsAny(raw, "-_") {
+			kind = EncodingLayerBase64Url
+		}
+		return []*decodedLayer{{layer: &EncodingLayer{Kind: kind}, value: decoded}}
+	}
+
+	if children := decodeFormLayer(raw); len(children) > 0 {
+		return children
+	}
+
+	if encodingURLEscapeRegexp.MatchString(raw) {
+		if decoded, err := url.QueryUnescape(raw); err == nil && decoded != raw {
+			return []*decodedLayer{{layer: &EncodingLayer{Kind: EncodingLayerURL}, value: decoded}}
+		}
+	}
+	return nil
+}
+
+func decodeFormLayer(raw string) []*decodedLayer {
+	if !strings.Contains(raw, "=") || strings.ContainsAny(raw, " \t\r\n\"'{}<>|") {
+		return nil
+	}
+	var children []*decodedLayer
+	filtered := make(map[string]struct{})
+	for _, item := range lowhttp.ParseQueryParams(raw).Items {
+		if item.Key == "" || !encodingFormKeyRegexp.MatchString(item.Key) {
+			return nil
+		}
+		if _, ok := filtered[item.Key]; ok {
+			continue
+		}
+		filtered[item.Key] = struct{}{}
+		children = append(children, &decodedLayer{layer: &EncodingLayer{Kind: EncodingLayerForm, Key: item.Key}, value: item.Value})
+	}
+	return children
+}
+
+func decodeXMLLayer(raw string) []*decodedLayer {
+	if !strings.HasPrefix(raw, "<") || !strings.HasSuffix(raw, ">") {
+		return nil
+	}
+	rootNode, err := xmlquery.Parse(strings.NewReader(raw))
+	if err != nil {
+		return nil
+	}
+	var children []*decodedLayer
+	RecursiveXMLNode(rootNode, func(node *xmlquery.Node) {
+		// 只处理仅包含文本的叶子元素
+		if node.Type != xmlquery.ElementNode || node.FirstChild == nil ||
+			node.FirstChild != node.LastChild || node.FirstChild.Type != xmlquery.TextNode {
+			return
+		}
+		xpath := GetXpathFromNode(node)
+		if xpath == "" || strings.Contains(xpath, "|") {
+			return
+		}
+		children = append(children, &decodedLayer{layer: &EncodingLayer{Kind: EncodingLayerXML, Key: xpath}, value: node.InnerText()})
+	})
+	return children
+}
+
+func decodeJWTSegment(segment string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segment, "="))
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+func splitJWT(raw string) (string, string, bool) {
+	parts := strings.Split(raw, ".")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	header, err := decodeJWTSegment(parts[0])
+	if err != nil || !gjson.Valid(header) || !gjson.Get(header, "alg").Exists() {
+		return "", "", false
+	}
+	payload, err := decodeJWTSegment(parts[1])
+	if err != nil || !gjson.Valid(payload) {
+		return "", "", false
+	}
+	return header, payload, true
+}
+
+// encodingChainApplier 按编码链把新值逐层编码回原始值中，JWT 被修改时如果已知密钥则重新签名
+type encodingChainApplier struct {
+	jwtKeys    []string
+	jwtSignKey map[string]any
+}
+
+func newEncodingChainApplier(jwtKeys []string) *encodingChainApplier {
+	return &encodingChainApplier{jwtKeys: jwtKeys, jwtSignKey: make(map[string]any)}
+}
+
+func (a *encodingChainApplier) apply(raw string, chain EncodingChain, value string) (string, error) {
+	if len(chain) == 0 {
+		return value, nil
+	}
+	layer, rest := chain[0], chain[1:]
+	switch layer.Kind {
+	case EncodingLayerScheme:
+		token, ok := strings.CutPrefix(raw, layer.Key+" ")
+		if !ok {
+			return "", utils.Errorf("auth scheme %v not found", layer.Key)
+		}
+		inner, err := a.apply(token, rest, value)
+		if err != nil {
+			return "", err
+		}
+		return layer.Key + " " + inner, nil
+	case EncodingLayerURL:
+		decoded, err := url.QueryUnescape(raw)
+		if err != nil {
+			return "", err
+		}
+		inner, err := a.apply(decoded, rest, value)
+		if err != nil {
+			return "", err
+		}
+		return codec.QueryEscape(inner), nil
+	case EncodingLayerBase64, EncodingLayerBase64Url:
+		decoded, err := codec.DecodeBase64(raw)
+		if err != nil {
+			return "", err
+		}
+		inner, err := a.apply(string(decoded), rest, value)
+		if err != nil {
+			return "", err
+		}
+		if layer.Kind == EncodingLayerBase64Url {
+			return base64.URLEncoding.EncodeToString([]byte(inner)), nil
+		}
+		return codec.EncodeBase64(inner), nil
+	case EncodingLayerForm:
+		params := lowhttp.ParseQueryParams(raw)
+		inner, err := a.apply(params.Get(layer.Key), rest, value)
+		if err != nil {
+			return "", err
+		}
+		params.Set(layer.Key, inner)
+		return params.Encode(), nil
+	case EncodingLayerJSON:
+		origin := jsonpath.Find(raw, layer.Key)
+		if len(rest) == 0 {
+			// 叶子值尽量保持原有的 JSON 类型
+			if origin == nil {
+				origin = ""
+			}
+			return modifyJSONValue(raw, layer.Key, value, origin, 0)
+		}
+		inner, err := a.apply(utils.InterfaceToString(origin), rest, value)
+		if err != nil {
+			return "", err
+		}
+		return jsonpath.ReplaceStringWithError(raw, layer.Key, inner)
+	case EncodingLayerXML:
+		rootNode, err := xmlquery.Parse(strings.NewReader(raw))
+		if err != nil {
+			return "", utils.Wrap(err, "parse xml failed")
+		}
+		node, err := xmlquery.Query(rootNode, layer.Key)
+		if err != nil || node == nil {
+			return "", utils.Errorf("xml node %v not found", layer.Key)
+		}
+		inner, err := a.apply(node.InnerText(), rest, value)
+		if err != nil {
+			return "", err
+		}
+		text := &xmlquery.Node{Data: inner, Type: xmlquery.TextNode, Parent: node}
+		node.FirstChild, node.LastChild = text, text
+		return rootNode.OutputXML(false), nil
+	case EncodingLayerJWT:
+		parts := strings.Split(raw, ".")
+		if len(parts) != 3 {
+			return "", utils.Errorf("invalid jwt: %v", raw)
+		}
+		index := 1
+		if layer.Key == "header" {
+			index = 0
+		}
+		segment, err := decodeJWTSegment(parts[index])
+		if err != nil {
+			return "", utils.Wrap(err, "decode jwt failed")
+		}
+		inner, err := a.apply(segment, rest, value)
+		if err != nil {
+			return "", err
+		}
+		parts[index] = base64.RawURLEncoding.EncodeToString([]byte(inner))
+		parts[2] = a.signJWT(raw, parts[0], parts[1], parts[2])
+		return strings.Join(parts, "."), nil
+	}
+	return "", utils.Errorf("unsupported encoding layer: %v", layer)
+}
+
+// signJWT 重新计算签名，alg 为 none 时签名为空，没有可用密钥时保留原签名
+func (a *encodingChainApplier) signJWT(originToken, header, payload, signature string) string {
+	headerRaw, err := decodeJWTSegment(header)
+	if err != nil {
+		return signature
+	}
+	alg := gjson.Get(headerRaw, "alg").String()
+	if strings.EqualFold(alg, "none") {
+		return ""
+	}
+	method := jwt.GetSigningMethod(alg)
+	if method == nil {
+		return signature
+	}
+	key := a.jwtSigningKey(originToken, alg)
+	if key == nil {
+		return signature
+	}
+	newSignature, err := method.Sign(header+"."+payload, key)
+	if err != nil {
+		return signature
+	}
+	return newSignature
+}
+
+func (a *encodingChainApplier) jwtSigningKey(originToken string, alg string) any {
+	cacheKey := alg + "|" + originToken
+	if key, ok := a.jwtSignKey[cacheKey]; ok {
+		return key
+	}
+	var key any
+	switch {
+	case strings.HasPrefix(alg, "HS"):
+		if guessed, ok := authhack.JwtGuessKey(originToken, a.jwtKeys...); ok {
+			key = guessed
+		} else if len(a.jwtKeys) > 0 {
+			// 原始签名无法校验时（例如 RS256 改为 HS256），直接使用给定的密钥
+			key = []byte(a.jwtKeys[0])
+		}
+	case strings.HasPrefix(alg, "RS"), strings.HasPrefix(alg, "PS"):
+		for _, k := range a.jwtKeys {
+			if privateKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(k)); err == nil {
+				key = privateKey
+				break
+			}
+		}
+	case strings.HasPrefix(alg, "ES"):
+		for _, k := range a.jwtKeys {
+			if privateKey, err := jwt.ParseECPrivateKeyFromPEM([]byte(k)); err == nil {
+				key = privateKey
+				break
+			}
+		}
+	}
+	a.jwtSignKey[cacheKey] = key
+	return key
+}
+
+// isCoveredEncodingChain 检查编码链是否已经被原有的参数位置（如 Cookie参数(Base64+JSON)）覆盖
+func isCoveredEncodingChain(container *EncodingLayer, chain EncodingChain) bool {
+	kinds := make([]string, len(chain))
+	for i, layer := range chain {
+		kinds[i] = layer.Kind
+	}
+	switch container.Kind {
+	case EncodingLayerQuery, EncodingLayerPost, EncodingLayerCookie:
+		switch strings.Join(kinds, "|") {
+		case "", EncodingLayerBase64, EncodingLayerJSON, EncodingLayerBase64 + "|" + EncodingLayerJSON:
+			return true
+		}
+	case EncodingLayerBody:
+		return len(kinds) <= 1
+	case EncodingLayerHeader:
+		return len(kinds) == 0 || (len(kinds) == 1 && kinds[0] == EncodingLayerScheme)
+	}
+	return false
+}
+
+func (f *FuzzHTTPRequest) getEncodingChainParams(container *EncodingLayer, value string) []*FuzzHTTPRequestParam {
+	if strings.Contains(container.Key, "|") {
+		return nil
+	}
+	var leaves []*encodingLeaf
+	if matched := encodingAuthSchemeRegexp.FindStringSubmatch(value); container.Kind == EncodingLayerHeader && matched != nil {
+		// Authorization: Bearer <jwt> / Basic <base64>
+		for _, leaf := range discoverEncodingLeaves(matched[2], 0) {
+			leaf.chain = append(EncodingChain{{Kind: EncodingLayerScheme, Key: matched[1]}}, leaf.chain...)
+			leaves = append(leaves, leaf)
+		}
+	} else {
+		leaves = discoverEncodingLeaves(value, 0)
+	}
+
+	var fuzzParams []*FuzzHTTPRequestParam
+	for _, leaf := range leaves {
+		if isCoveredEncodingChain(container, leaf.chain) {
+			continue
+		}
+		fuzzParams = append(fuzzParams, &FuzzHTTPRequestParam{
+			position:   encodingContainerPositions[container.Kind],
+			param:      container.Key,
+			paramValue: leaf.value,
+			raw:        value,
+			path:       append(EncodingChain{container}, leaf.chain...).String(),
+			origin:     f,
+		})
+	}
+	return fuzzParams
+}
+
+// GetPostMultipartParams 获取 multipart/form-data 请求中的表单字段（不包括上传的文件），字段值中的嵌套编码会被逐层展开
+func (f *FuzzHTTPRequest) GetPostMultipartParams() []*FuzzHTTPRequestParam {
+	if !f.IsBodyFormEncoded() {
+		return nil
+	}
+	var fuzzParams []*FuzzHTTPRequestParam
+	filtered := make(map[string]struct{})
+	_ = lowhttp.ParseMultiPartFormWithCallback(f.GetBytes(), func(part *multipart.Part) {
+		name := part.FormName()
+		if _, ok := filtered[name]; ok || part.FileName() != "" {
+			return
+		}
+		filtered[name] = struct{}{}
+		raw, err := io.ReadAll(part)
+		if err != nil {
+			return
+		}
+		fuzzParams = append(fuzzParams, f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerMultipart, Key: name}, string(raw))...)
+	})
+	return fuzzParams
+}
+
+// GetPostJsonEncodedParams 获取 JSON Body 中被再次编码的字段，例如 JSON 中的 JWT、URL 编码的表单或 XML
+func (f *FuzzHTTPRequest) GetPostJsonEncodedParams() []*FuzzHTTPRequestParam {
+	body := strings.TrimSpace(string(f.GetBody()))
+	if _, ok := utils.IsJSON(body); !ok {
+		return nil
+	}
+	return f.getEncodingChainParams(&EncodingLayer{Kind: EncodingLayerBody}, body)
+}
+
+func getEncodingContainerValue(packet []byte, container *EncodingLayer) (string, error) {
+	switch container.Kind {
+	case EncodingLayerQuery:
+		return lowhttp.GetHTTPRequestQueryParam(packet, container.Key), nil
+	case EncodingLayerPost:
+		return lowhttp.ParseQueryParams(string(lowhttp.GetHTTPPacketBody(packet))).Get(container.Key), nil
+	case EncodingLayerCookie:
+		return lowhttp.GetHTTPPacketCookie(packet, container.Key), nil
+	case EncodingLayerHeader:
+		return lowhttp.GetHTTPPacketHeader(packet, container.Key), nil
+	case EncodingLayerBody:
+		return strings.TrimSpace(string(lowhttp.GetHTTPPacketBody(packet))), nil
+	case EncodingLayerMultipart:
+		var value string
+		found := false
+		err := lowhttp.ParseMultiPartFormWithCallback(packet, func(part *multipart.Part) {
+			if found || part.FormName() != container.Key || part.FileName() != "" {
+				return
+			}
+			raw, _ := io.ReadAll(part)
+			value, found = string(raw), true
+		})
+		if err != nil {
+			return "", err
+		}
+		if !found {
+			return "", utils.Errorf("multipart field %v not found", container.Key)
+		}
+		return value, nil
+	}
+	return "", utils.Errorf("unsupported encoding container: %v", container)
+}
+
+func replaceEncodingContainerValue(packet []byte, container *EncodingLayer, value string) []byte {
+	switch container.Kind {
+	case EncodingLayerQuery:
+		return lowhttp.ReplaceHTTPPacketQueryParam(packet, container.Key, value)
+	case EncodingLayerPost:
+		return lowhttp.ReplaceHTTPPacketPostParam(packet, container.Key, value)
+	case EncodingLayerCookie:
+		return lowhttp.ReplaceHTTPPacketCookie(packet, container.Key, value)
+	case EncodingLayerHeader:
+		return lowhttp.ReplaceHTTPPacketHeader(packet, container.Key, value)
+	case EncodingLayerMultipart:
+		return lowhttp.ReplaceHTTPPacketFormEncoded(packet, container.Key, value)
+	default:
+		return lowhttp.ReplaceHTTPPacketBodyFast(packet, []byte(value))
+	}
+}
+
+func (f *FuzzHTTPRequest) fuzzEncodingChain(chain string, v any) ([]*http.Request, error) {
+	req, err := f.GetOriginHTTPRequest()
+	if err != nil {
+		return nil, err
+	}
+	layers, err := ParseEncodingChain(chain)
+	if err != nil {
+		return nil, err
+	}
+	values := InterfaceToFuzzResults(v)
+	if len(values) <= 0 {
+		return nil, utils.Error("fuzz values are empty...")
+	}
+
+	origin := httpctx.GetBareRequestBytes(req)
+	raw, err := getEncodingContainerValue(origin, layers[0])
+	if err != nil {
+		return nil, err
+	}
+	applier := newEncodingChainApplier(f.jwtKeys)
+	var reqs []*http.Request
+	for _, value := range values {
+		encoded, err := applier.apply(raw, layers[1:], value)
+		if err != nil {
+			return nil, utils.Wrapf(err, "apply encoding chain %v failed", chain)
+		}
+		reqIns, err := lowhttp.ParseBytesToHttpRequest(replaceEncodingContainerValue(origin, layers[0], encoded))
+		if err != nil {
+			continue
+		}
+		reqs = append(reqs, reqIns)
+	}
+	return reqs, nil
+}
+
+func (f *FuzzHTTPRequest) FuzzEncodingChain(chain string, v any) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzEncodingChain(chain, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
@@ -18,6 +18,8 @@ import (
 	"github.com/yaklang/yaklang/common/jsonpath"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/graphqlx"
+	"github.com/yaklang/yaklang/common/utils/grpcx"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/mixer"
 	"github.com/yaklang/yaklang/common/yak/cartesian"
@@ -833,6 +835,169 @@ func (f *FuzzHTTPRequest) fuzzXMLWithRaw(k, v any) ([]*http.Request, error) {
 	return reqs, nil
 }
 
+func (f *FuzzHTTPRequest) fuzzGRPCWithRaw(k, v any) ([]*http.Request, error) {
+	originPacket, err := grpcx.ParsePacket(f.GetBytes())
+	if err != nil {
+		return nil, utils.Wrap(err, "parse body as grpc failed")
+	}
+	params := originPacket.Params(grpcx.MessageDescriptor(f.GetBytes(), false))
+
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if keys == nil || values == nil {
+		return nil, utils.Errorf("key or value is empty...")
+	}
+
+	m, err := mixer.NewMixer(keys, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqs []*http.Request
+	for {
+		pair := m.Value()
+		key, value := pair[0], pair[1]
+
+		// key can be the field path (0/1.2) or the readable name (user.name)
+		for _, param := range params {
+			if param.Path != key && param.Name != key {
+				continue
+			}
+			packet, err := grpcx.ParsePacket(f.GetBytes())
+			if err != nil {
+				break
+			}
+			if err := packet.SetParam(param.Path, ConvertValue(param.Value, value)); err != nil {
+				continue
+			}
+			body, err := packet.MarshalBody()
+			if err != nil {
+				continue
+			}
+			reqIns, err := lowhttp.ParseBytesToHttpRequest(lowhttp.ReplaceHTTPPacketBodyFast(f.GetBytes(), body))
+			if err != nil {
+				continue
+			}
+			reqs = append(reqs, reqIns)
+		}
+
+		if err = m.Next(); err != nil {
+			break
+		}
+	}
+
+	return reqs, nil
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLWithRaw(k, v any) ([]*http.Request, error) {
+	req, err := f.GetOriginHTTPRequest()
+	if err != nil {
+		return nil, err
+	}
+	rawBody := httpRequestReadBody(req)
+	queries := graphqlx.ExtractQueries(rawBody, f.GetHeader("Content-Type"))
+	if len(queries) == 0 {
+		return nil, utils.Error("body is not a graphql request")
+	}
+	params := f.GetPostGraphQLParams()
+
+	keys, values := InterfaceToFuzzResults(k), InterfaceToFuzzResults(v)
+	if keys == nil || values == nil {
+		return nil, utils.Errorf("key or value is empty...")
+	}
+
+	m, err := mixer.NewMixer(keys, values)
+	if err != nil {
+		return nil, err
+	}
+
+	var reqs []*http.Request
+	origin := httpctx.GetBareRequestBytes(req)
+	for {
+		pair := m.Value()
+		key, value := pair[0], pair[1]
+
+		// key can be the path or the name of param
+		for _, param := range params {
+			if param.path != key && utils.InterfaceToString(param.param) != key {
+				continue
+			}
+			body, err := replaceGraphQLParam(string(rawBody), queries, param, value)
+			if err != nil {
+				continue
+			}
+			reqIns, err := lowhttp.ParseBytesToHttpRequest(lowhttp.ReplaceHTTPPacketBodyFast(origin, []byte(body)))
+			if err != nil {
+				continue
+			}
+			reqs = append(reqs, reqIns)
+		}
+
+		if err = m.Next(); err != nil {
+			break
+		}
+	}
+	return reqs, nil
+}
+
+// replaceGraphQLParam replaces the literal in query (path with `#`), or the value in json body (variables / operationName)
+func replaceGraphQLParam(body string, queries []*graphqlx.Query, param *FuzzHTTPRequestParam, value string) (string, error) {
+	prefix, docPath, isLiteral := strings.Cut(param.path, "#")
+	if !isLiteral {
+		return modifyJSONValue(body, param.path, value, param.paramValue, 0)
+	}
+	for _, q := range queries {
+		if q.Prefix != prefix {
+			continue
+		}
+		doc, err := graphqlx.ParseQuery(q.Query)
+		if err != nil {
+			return "", err
+		}
+		if err := doc.SetParam(docPath, value); err != nil {
+			return "", err
+		}
+		if prefix == "" {
+			return doc.String(), nil
+		}
+		return jsonpath.ReplaceString(body, q.JSONPath("query"), doc.String()), nil
+	}
+	return "", utils.Errorf("graphql query %v not found", prefix)
+}
+
+// parseGraphQLSchema accepts *graphqlx.Schema, introspection result (json) and SDL
+func parseGraphQLSchema(schema interface{}) (*graphqlx.Schema, error) {
+	switch ret := schema.(type) {
+	case *graphqlx.Schema:
+		return ret, nil
+	case graphqlx.Schema:
+		return &ret, nil
+	}
+	raw := utils.InterfaceToString(schema)
+	if _, ok := utils.IsJSON(raw); ok {
+		return graphqlx.ParseIntrospection([]byte(raw))
+	}
+	return graphqlx.ParseSDL(raw)
+}
+
+func (f *FuzzHTTPRequest) fuzzGraphQLSchema(schema interface{}) ([]*http.Request, error) {
+	s, err := parseGraphQLSchema(schema)
+	if err != nil {
+		return nil, err
+	}
+	origin := lowhttp.ReplaceHTTPPacketMethod(f.GetBytes(), "POST")
+	origin = lowhttp.ReplaceHTTPPacketHeader(origin, "Content-Type", "application/json")
+
+	var reqs []*http.Request
+	for _, op := range s.GenerateOperations(graphqlx.DefaultGenerateDepth) {
+		reqIns, err := lowhttp.ParseBytesToHttpRequest(lowhttp.ReplaceHTTPPacketBodyFast(origin, op.Body()))
+		if err != nil {
+			continue
+		}
+		reqs = append(reqs, reqIns)
+	}
+	return reqs, nil
+}
+
 func (f *FuzzHTTPRequest) fuzzPostJsonParamsWithRaw(k, v interface{}) ([]*http.Request, error) {
 	req, err := f.GetOriginHTTPRequest()
 	if err != nil {
@@ -902,6 +1067,30 @@ func (f *FuzzHTTPRequest) FuzzPostXMLParams(k, v interface{}) FuzzHTTPRequestIf
 	return NewFuzzHTTPRequestBatch(f, reqs...)
 }
 
+func (f *FuzzHTTPRequest) FuzzPostGRPCParams(k, v interface{}) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGRPCWithRaw(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+func (f *FuzzHTTPRequest) FuzzPostGraphQLParams(k, v interface{}) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGraphQLWithRaw(k, v)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
+func (f *FuzzHTTPRequest) FuzzPostGraphQLSchema(schema interface{}) FuzzHTTPRequestIf {
+	reqs, err := f.fuzzGraphQLSchema(schema)
+	if err != nil {
+		return f.toFuzzHTTPRequestBatch()
+	}
+	return NewFuzzHTTPRequestBatch(f, reqs...)
+}
+
 func (f *FuzzHTTPRequest) FuzzCookieRaw(v interface{}) FuzzHTTPRequestIf {
 	return f.FuzzHTTPHeader("Cookie", v)
 }
@@ -24,6 +24,8 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "GET参数(JSON)"
 	case lowhttp.PosGetQueryBase64Json:
 		return "GET参数(Base64+JSON)"
+	case lowhttp.PosGetQueryEncoded:
+		return "GET参数(嵌套编码)"
 	case lowhttp.PosPathAppend:
 		return "URL路径(追加)"
 	case lowhttp.PosPathBlock:
@@ -32,6 +34,8 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "URL路径"
 	case lowhttp.PosHeader:
 		return "Header"
+	case lowhttp.PosHeaderEncoded:
+		return "Header(嵌套编码)"
 	case lowhttp.PosPostQuery:
 		return "POST参数"
 	case lowhttp.PosPostXML:
@@ -42,8 +46,18 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "POST参数(JSON)"
 	case lowhttp.PosPostQueryBase64Json:
 		return "POST参数(Base64+JSON)"
+	case lowhttp.PosPostQueryEncoded:
+		return "POST参数(嵌套编码)"
+	case lowhttp.PosPostMultipart:
+		return "POST参数(Multipart)"
 	case lowhttp.PosPostJson:
 		return "JSON-Body参数"
+	case lowhttp.PosPostJsonEncoded:
+		return "JSON-Body参数(嵌套编码)"
+	case lowhttp.PosPostGRPC:
+		return "gRPC参数(Protobuf)"
+	case lowhttp.PosPostGraphQL:
+		return "GraphQL参数"
 	case lowhttp.PosCookie:
 		return "Cookie参数"
 	case lowhttp.PosCookieBase64:
@@ -52,6 +66,8 @@ func PositionTypeVerbose(pos lowhttp.HttpParamPositionType) string {
 		return "Cookie参数(JSON)"
 	case lowhttp.PosCookieBase64Json:
 		return "Cookie参数(Base64+JSON)"
+	case lowhttp.PosCookieEncoded:
+		return "Cookie参数(嵌套编码)"
 	default:
 		return string(pos)
 	}
@@ -72,7 +88,8 @@ type FuzzHTTPRequestParam struct {
 func (p *FuzzHTTPRequestParam) IsPostParams() bool {
 	switch p.position {
 	case lowhttp.PosPostJson, lowhttp.PosPostQuery, lowhttp.PosPostQueryBase64,
-		lowhttp.PosPostQueryJson, lowhttp.PosPostQueryBase64Json, lowhttp.PosPostXML:
+		lowhttp.PosPostQueryJson, lowhttp.PosPostQueryBase64Json, lowhttp.PosPostXML, lowhttp.PosPostGRPC, lowhttp.PosPostGraphQL,
+		lowhttp.PosPostQueryEncoded, lowhttp.PosPostJsonEncoded, lowhttp.PosPostMultipart:
 		return true
 	}
 	return false
@@ -81,7 +98,7 @@ func (p *FuzzHTTPRequestParam) IsPostParams() bool {
 func (p *FuzzHTTPRequestParam) IsGetParams() bool {
 	switch p.position {
 	case lowhttp.PosGetQuery, lowhttp.PosGetQueryBase64, lowhttp.PosGetQueryJson,
-		lowhttp.PosGetQueryBase64Json:
+		lowhttp.PosGetQueryBase64Json, lowhttp.PosGetQueryEncoded:
 		return true
 	}
 	return false
@@ -102,7 +119,17 @@ func (p *FuzzHTTPRequestParam) IsGetValueJSON() bool {
 func (p *FuzzHTTPRequestParam) IsCookieParams() bool {
 	switch p.position {
 	case lowhttp.PosCookie, lowhttp.PosCookieJson, lowhttp.PosCookieBase64,
-		lowhttp.PosCookieBase64Json:
+		lowhttp.PosCookieBase64Json, lowhttp.PosCookieEncoded:
+		return true
+	}
+	return false
+}
+
+// IsEncodingChain 参数值是否经过多层编码，此时 Path 为编码链
+func (p *FuzzHTTPRequestParam) IsEncodingChain() bool {
+	switch p.position {
+	case lowhttp.PosGetQueryEncoded, lowhttp.PosPostQueryEncoded, lowhttp.PosPostJsonEncoded,
+		lowhttp.PosPostMultipart, lowhttp.PosCookieEncoded, lowhttp.PosHeaderEncoded:
 		return true
 	}
 	return false
@@ -280,8 +307,15 @@ func (p *FuzzHTTPRequestParam) Fuzz(i ...interface{}) FuzzHTTPRequestIf {
 		return p.origin.FuzzPostParams(p.param, i)
 	case lowhttp.PosPostXML:
 		return p.origin.FuzzPostXMLParams(p.path, i)
+	case lowhttp.PosPostGRPC:
+		return p.origin.FuzzPostGRPCParams(p.path, i)
+	case lowhttp.PosPostGraphQL:
+		return p.origin.FuzzPostGraphQLParams(p.path, i)
 	case lowhttp.PosPostQueryBase64:
 		return p.origin.FuzzPostBase64Params(p.param, i)
+	case lowhttp.PosGetQueryEncoded, lowhttp.PosPostQueryEncoded, lowhttp.PosPostJsonEncoded,
+		lowhttp.PosPostMultipart, lowhttp.PosCookieEncoded, lowhttp.PosHeaderEncoded:
+		return p.origin.FuzzEncodingChain(p.path, i)
 	case lowhttp.PosPostQueryJson:
 		return p.origin.FuzzPostJsonPathParams(p.param, p.path, i)
 	case lowhttp.PosPostQueryBase64Json:
@@ -326,6 +360,12 @@ func (p *FuzzHTTPRequestParam) String() string {
 		pathName := "JsonPath"
 		if p.position == lowhttp.PosPostXML {
 			pathName = "XPath"
+		} else if p.position == lowhttp.PosPostGRPC {
+			pathName = "FieldPath"
+		} else if p.position == lowhttp.PosPostGraphQL {
+			pathName = "GraphQLPath"
+		} else if p.IsEncodingChain() {
+			pathName = "EncodingChain"
 		}
 		return fmt.Sprintf("Name:%-20s %s: %-12s Position:[%v(%v)]\n", p.Name(), pathName, p.path, p.PositionVerbose(), p.Position())
 	}
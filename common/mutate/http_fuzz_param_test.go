@@ -1,10 +1,18 @@
 package mutate
 
 import (
+	"strings"
 	"testing"
 
+	"github.com/dgrijalva/jwt-go"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/authhack"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/grpcx"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"google.golang.org/protobuf/encoding/protowire"
 )
 
 func TestFuzzQueryParams(t *testing.T) {
@@ -46,3 +54,136 @@ Host: 127.0.0.1`,
 		}
 	}
 }
+
+func TestFuzzGRPCParams(t *testing.T) {
+	var nested, msg []byte
+	nested = protowire.AppendTag(nested, 1, protowire.BytesType)
+	nested = protowire.AppendString(nested, "beijing")
+	msg = protowire.AppendTag(msg, 1, protowire.BytesType)
+	msg = protowire.AppendString(msg, "admin")
+	msg = protowire.AppendTag(msg, 2, protowire.VarintType)
+	msg = protowire.AppendVarint(msg, 42)
+	msg = protowire.AppendTag(msg, 3, protowire.BytesType)
+	msg = protowire.AppendBytes(msg, nested)
+	body, err := grpcx.MarshalFrames([]*grpcx.Frame{{Payload: msg}}, grpcx.KindGRPCWeb, "")
+	require.NoError(t, err)
+	packet := lowhttp.ReplaceHTTPPacketBodyFast([]byte("POST /demo.UserService/GetUser HTTP/1.1\r\n"+
+		"Host: 127.0.0.1\r\nContent-Type: application/grpc-web+proto\r\n\r\n"), body)
+
+	request, err := NewFuzzHTTPRequest(packet)
+	require.NoError(t, err)
+	params := request.GetCommonParams()
+	var paths []string
+	for _, param := range params {
+		if param.Position() == string(lowhttp.PosPostGRPC) {
+			require.True(t, param.IsPostParams())
+			paths = append(paths, param.path)
+		}
+	}
+	require.Equal(t, []string{"0/1", "0/2", "0/3.1"}, paths)
+
+	for _, param := range params {
+		if param.path != "0/3.1" {
+			continue
+		}
+		reqs, err := param.Fuzz("shanghai").Results()
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		raw, err := utils.DumpHTTPRequest(reqs[0], true)
+		require.NoError(t, err)
+		require.Equal(t, []string{`{"1":"admin","2":42,"3":{"1":"shanghai"}}`}, grpcx.DecodeRequestMessages(raw))
+	}
+}
+
+func TestFuzzGraphQLParams(t *testing.T) {
+	packet := []byte("POST /graphql HTTP/1.1\r\nHost: 127.0.0.1\r\nContent-Type: application/json\r\n\r\n" +
+		`{"query":"query Q($id: ID) { user(id: $id, name: \"admin\") { posts(first: 2) { title } } }","operationName":"Q","variables":{"id":"1"}}`)
+
+	request, err := NewFuzzHTTPRequest(packet)
+	require.NoError(t, err)
+	params := request.GetCommonParams()
+	var names []string
+	for _, param := range params {
+		if param.Position() == string(lowhttp.PosPostGraphQL) {
+			require.True(t, param.IsPostParams())
+			names = append(names, utils.InterfaceToString(param.param))
+		}
+	}
+	require.Equal(t, []string{"operationName", "user(name)", "user.posts(first)", "$id"}, names)
+
+	check := func(key, value, path, expected string) {
+		reqs, err := request.FuzzPostGraphQLParams(key, value).Results()
+		require.NoError(t, err)
+		require.Len(t, reqs, 1)
+		raw, err := utils.DumpHTTPRequest(reqs[0], true)
+		require.NoError(t, err)
+		require.Equal(t, expected, gjson.GetBytes(lowhttp.GetHTTPPacketBody(raw), path).String())
+	}
+	check("user(name)", `' or 1=1--`, "query", `query Q($id: ID) { user(id: $id, name: "' or 1=1--") { posts(first: 2) { title } } }`)
+	check("user.posts(first)", "100", "query", `query Q($id: ID) { user(id: $id, name: "admin") { posts(first: 100) { title } } }`)
+	check("$id", "2", "variables.id", "2")
+}
+
+func TestFuzzEncodingChainParams(t *testing.T) {
+	token, err := authhack.JwtGenerate("HS256", map[string]interface{}{"role": "user"}, "", []byte("k3y-for-test"))
+	require.NoError(t, err)
+	session := codec.EncodeBase64(`{"token":"` + token + `","lang":"zh"}`)
+	packet := "POST /upload HTTP/1.1\r\nHost: 127.0.0.1\r\n" +
+		"Authorization: Bearer " + token + "\r\n" +
+		"Cookie: session=" + session + "\r\n" +
+		"Content-Type: multipart/form-data; boundary=----X\r\n\r\n" +
+		"------X\r\nContent-Disposition: form-data; name=\"data\"\r\n\r\n" +
+		`{"user":"admin","meta":"a=1&b=2"}` + "\r\n" +
+		"------X\r\nContent-Disposition: form-data; name=\"file\"; filename=\"a.txt\"\r\n\r\n" +
+		"content\r\n------X--\r\n"
+
+	request, err := NewFuzzHTTPRequest(packet, OptJWTKey("k3y-for-test"))
+	require.NoError(t, err)
+	var chains []string
+	for _, param := range append(request.GetCommonParams(), request.GetHeaderParams()...) {
+		if param.IsEncodingChain() {
+			chains = append(chains, param.Path())
+		}
+	}
+	require.Equal(t, []string{
+		"multipart:data|json:$.user",
+		"multipart:data|json:$.meta|form:a",
+		"multipart:data|json:$.meta|form:b",
+		"cookie:session|base64|json:$.token|jwt:header|json:$.alg",
+		"cookie:session|base64|json:$.token|jwt:header|json:$.typ",
+		"cookie:session|base64|json:$.token|jwt:payload|json:$.role",
+		"header:Authorization|scheme:Bearer|jwt:header|json:$.alg",
+		"header:Authorization|scheme:Bearer|jwt:header|json:$.typ",
+		"header:Authorization|scheme:Bearer|jwt:payload|json:$.role",
+	}, chains)
+
+	// 修改 JWT 中的声明后使用已知密钥重新签名
+	reqs, err := request.FuzzEncodingChain("cookie:session|base64|json:$.token|jwt:payload|json:$.role", "admin").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	cookie, err := reqs[0].Cookie("session")
+	require.NoError(t, err)
+	decoded, err := codec.DecodeBase64(cookie.Value)
+	require.NoError(t, err)
+	require.Equal(t, "zh", gjson.GetBytes(decoded, "lang").String())
+	newToken, key, err := authhack.JwtParse(gjson.GetBytes(decoded, "token").String(), "k3y-for-test")
+	require.NoError(t, err)
+	require.True(t, newToken.Valid)
+	require.Equal(t, "k3y-for-test", string(key))
+	require.Equal(t, "admin", newToken.Claims.(jwt.MapClaims)["role"])
+
+	reqs, err = request.FuzzEncodingChain("multipart:data|json:$.meta|form:b", "3").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	require.Equal(t, "a=1&b=3", gjson.Get(reqs[0].FormValue("data"), "meta").String())
+
+	reqs, err = request.FuzzEncodingChain("header:Authorization|scheme:Bearer|jwt:header|json:$.alg", "none").Results()
+	require.NoError(t, err)
+	require.Len(t, reqs, 1)
+	authorization := reqs[0].Header.Get("Authorization")
+	require.True(t, strings.HasPrefix(authorization, "Bearer "))
+	require.True(t, strings.HasSuffix(authorization, "."))
+
+	_, err = ParseEncodingChain("base64|json:$.a")
+	require.Error(t, err)
+}
@@ -95,6 +95,11 @@ type httpPoolConfig struct {
 	WithPayloads bool
 
 	RandomSession bool // for cookie jar
+	// Session 所有请求共享的 session（cookie jar 与挂载的会话处理规则），优先于 RandomSession
+	Session string
+
+	// 会话处理规则：宏请求、登出检测与自动重新登录
+	SessionHandler *lowhttp.SessionHandler
 
 	FromPlugin string
 }
@@ -517,6 +522,18 @@ func _httpPool_withRandomSession(randomSession bool) HttpPoolConfigOption {
 	}
 }
 
+func _httpPool_withSession(session string) HttpPoolConfigOption {
+	return func(config *httpPoolConfig) {
+		config.Session = session
+	}
+}
+
+func _httpPool_SessionHandler(h *lowhttp.SessionHandler) HttpPoolConfigOption {
+	return func(config *httpPoolConfig) {
+		config.SessionHandler = h
+	}
+}
+
 type HttpPoolConfigOption func(config *httpPoolConfig)
 
 type HttpResult struct {
@@ -773,11 +790,17 @@ func _httpPool(i interface{}, opts ...HttpPoolConfigOption) (chan *HttpResult, e
 							lowhttp.WithGmTLS(config.IsGmTLS),
 							lowhttp.WithConnPool(config.WithConnPool),
 						}
-						if config.RandomSession {
+						if config.Session != "" {
+							lowhttpOptions = append(lowhttpOptions, lowhttp.WithSession(config.Session))
+						} else if config.RandomSession {
 							tmpSession := uuid.NewString()
 							lowhttpOptions = append(lowhttpOptions, lowhttp.WithSession(tmpSession))
 						}
 
+						if config.SessionHandler != nil {
+							lowhttpOptions = append(lowhttpOptions, lowhttp.WithSessionHandler(config.SessionHandler))
+						}
+
 						if config.FromPlugin != "" {
 							lowhttpOptions = append(lowhttpOptions, lowhttp.WithFromPlugin(config.FromPlugin))
 						}
@@ -1032,6 +1055,7 @@ var HttpPoolExports = map[string]interface{}{
 	"fuzzParams":         _httpPool_SetFuzzParams,
 	"noFixContentLength": _httpPool_noFixContentLength,
 	"connPool":           _httpPool_withConnPool,
+	"sessionHandler":     _httpPool_SessionHandler,
 }
 
 var (
@@ -1079,4 +1103,6 @@ var (
 	WithPoolOpt_ExternSwitch               = _httpPool_ExternSwitch
 	WithPoolOpt_WithPayloads               = _httpPool_withPayloads
 	WithPoolOpt_RandomSession              = _httpPool_withRandomSession
+	WithPoolOpt_Session                    = _httpPool_withSession
+	WithPoolOpt_SessionHandler             = _httpPool_SessionHandler
 )
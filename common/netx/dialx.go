@@ -51,6 +51,10 @@ RETRY:
 	// not need to upgrade
 	var conn net.Conn
 
+	if len(config.Proxy) == 0 && !config.ForceDisableProxy && config.ProxyRouter != nil {
+		return config.ProxyRouter.dial(target, config)
+	}
+
 	if len(config.Proxy) == 0 && config.EnableSystemProxyFromEnv && FixProxy(GetProxyFromEnv()) != "" {
 		config.Proxy = append(config.Proxy, FixProxy(GetProxyFromEnv()))
 	}
@@ -104,16 +108,8 @@ RETRY:
 		return conn, nil
 	}
 
-	DnsConfig := NewDefaultReliableDNSConfig()
-	for _, o := range config.DNSOpts {
-		o(DnsConfig)
-	}
-
-	if DnsConfig.DisabledDomain != nil {
-		host, _, err := utils.ParseStringToHostPort(target)
-		if err == nil && DnsConfig.DisabledDomain.Contains(host) {
-			return nil, utils.Errorf("disallow domain %v by config(check your yakit system/network config)", target)
-		}
+	if err := checkDisabledDomain(target, config); err != nil {
+		return nil, err
 	}
 
 	var errs error
@@ -139,6 +135,21 @@ RETRY:
 	return nil, utils.Wrapf(errs, "connect: %v failed: no proxy available (in %v)", target, config.Proxy)
 }
 
+func checkDisabledDomain(target string, config *dialXConfig) error {
+	DnsConfig := NewDefaultReliableDNSConfig()
+	for _, o := range config.DNSOpts {
+		o(DnsConfig)
+	}
+
+	if DnsConfig.DisabledDomain != nil {
+		host, _, err := utils.ParseStringToHostPort(target)
+		if err == nil && DnsConfig.DisabledDomain.Contains(host) {
+			return utils.Errorf("disallow domain %v by config(check your yakit system/network config)", target)
+		}
+	}
+	return nil
+}
+
 /*
 DialX is netx dial with more options
 
@@ -153,6 +164,7 @@ func DialX(target string, opt ...DialXOption) (net.Conn, error) {
 		TimeoutRetryMinWait: 100 * time.Millisecond,
 		TimeoutRetryMaxWait: 500 * time.Millisecond,
 		DisallowAddress:     utils.NewHostsFilter(),
+		ProxyRouter:         GetDefaultProxyRouter(),
 	}
 
 	// default init
@@ -274,7 +286,7 @@ func DialX(target string, opt ...DialXOption) (net.Conn, error) {
 }
 
 // dialPlainUdpConn get abstract udp conn, with global netx config (disallow address, etc)
-func dialPlainUdpConn(target string, config *dialXConfig) (*net.UDPConn, error) {
+func resolveUdpAddr(target string, config *dialXConfig) (*net.UDPAddr, error) {
 	host, port, err := utils.ParseStringToHostPort(target)
 	if err != nil {
 		return nil, utils.Errorf("invalid target %#v, cannot find host:port", target)
@@ -296,14 +308,21 @@ func dialPlainUdpConn(target string, config *dialXConfig) (*net.UDPConn, error)
 			return nil, utils.Errorf("disallow address %v by config(check your yakit system/network config)", host)
 		}
 	}
-
-	return net.DialUDP("udp", config.LocalAddr, &net.UDPAddr{
+	return &net.UDPAddr{
 		IP:   ipIns,
 		Port: port,
-	})
+	}, nil
 }
 
-func DialUdpX(target string, opt ...DialXOption) (*net.UDPConn, error) {
+func dialPlainUdpConn(target string, config *dialXConfig) (*net.UDPConn, error) {
+	addr, err := resolveUdpAddr(target, config)
+	if err != nil {
+		return nil, err
+	}
+	return net.DialUDP("udp", config.LocalAddr, addr)
+}
+
+func newDialXConfig(opt ...DialXOption) *dialXConfig {
 	config := &dialXConfig{
 		DisallowAddress: utils.NewHostsFilter(),
 	}
@@ -319,5 +338,9 @@ func DialUdpX(target string, opt ...DialXOption) (*net.UDPConn, error) {
 	for _, o := range opt {
 		o(config)
 	}
-	return dialPlainUdpConn(target, config)
+	return config
+}
+
+func DialUdpX(target string, opt ...DialXOption) (*net.UDPConn, error) {
+	return dialPlainUdpConn(target, newDialXConfig(opt...))
 }
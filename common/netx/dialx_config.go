@@ -1,6 +1,7 @@
 package netx
 
 import (
+	"context"
 	"crypto/tls"
 	"net"
 	"sync"
@@ -19,7 +20,11 @@ type dialXConfig struct {
 	EnableSystemProxyFromEnv bool
 	ForceProxy               bool
 	Proxy                    []string
-	KeepAlive                time.Duration
+	// ProxyRouter 在 Proxy 为空时按目标选择代理链
+	ProxyRouter *ProxyRouter
+	KeepAlive   time.Duration
+	// Ctx 用于取消通过代理（链）建立的连接
+	Ctx context.Context
 
 	// EnableTLS is true, force to use TLS, auto upgrade
 	EnableTLS                 bool
@@ -190,6 +195,18 @@ func DialX_WithProxy(proxy ...string) DialXOption {
 	}
 }
 
+func DialX_WithProxyRouter(r *ProxyRouter) DialXOption {
+	return func(c *dialXConfig) {
+		c.ProxyRouter = r
+	}
+}
+
+func DialX_WithContext(ctx context.Context) DialXOption {
+	return func(c *dialXConfig) {
+		c.Ctx = ctx
+	}
+}
+
 func DialX_WithTLSNextProto(nextProtos ...string) DialXOption {
 	return func(c *dialXConfig) {
 		c.TLSNextProto = nextProtos
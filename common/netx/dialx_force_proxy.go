@@ -2,6 +2,7 @@ package netx
 
 import (
 	"context"
+	"crypto/tls"
 	"fmt"
 	"net"
 	"net/url"
@@ -34,6 +35,15 @@ func UnsetProxyFromEnv() {
 
 func DialContext(ctx context.Context, target string, proxies ...string) (net.Conn, error) {
 	if proxies := utils.StringArrayFilterEmpty(proxies); len(proxies) <= 0 {
+		if router := GetDefaultProxyRouter(); router != nil {
+			timeout := 30 * time.Second
+			if ddl, ok := ctx.Deadline(); ok {
+				if du := time.Until(ddl); du > 0 && du < timeout {
+					timeout = du
+				}
+			}
+			return router.Dial(target, DialX_WithTimeout(timeout), DialX_WithContext(ctx))
+		}
 		return DialContextWithoutProxy(ctx, "tcp", target)
 	} else {
 		for _, proxy := range proxies {
@@ -105,6 +115,98 @@ func connectForceProxy(ctx context.Context, target string, proxy string, connect
 	}
 }
 
+// connectProxyChain 依次通过代理链中的每一跳连接到 target，前一跳建立的连接作为下一跳的底层连接
+func connectProxyChain(ctx context.Context, target string, chain ProxyChain, connectTimeout time.Duration) (net.Conn, error) {
+	if len(chain) <= 0 {
+		return nil, utils.Errorf("empty proxy chain for %v", target)
+	}
+	if len(chain) == 1 {
+		return connectForceProxy(ctx, target, chain[0], connectTimeout)
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, connectTimeout*time.Duration(len(chain)))
+	defer cancel()
+
+	nextHop := func(i int) string {
+		if i+1 >= len(chain) {
+			return target
+		}
+		host, port, _ := utils.ParseStringToHostPort(chain[i+1])
+		return utils.HostPort(host, port)
+	}
+
+	conn, err := connectForceProxy(ctx, nextHop(0), chain[0], connectTimeout)
+	if err != nil {
+		return nil, utils.Wrapf(err, "proxy chain hop[0] %v failed", chain[0])
+	}
+	for i := 1; i < len(chain); i++ {
+		conn, err = connectProxyOverConn(ctx, conn, nextHop(i), chain[i])
+		if err != nil {
+			return nil, utils.Wrapf(err, "proxy chain hop[%d] %v failed", i, chain[i])
+		}
+	}
+	return conn, nil
+}
+
+// connectProxyOverConn 在已经建立的连接上与 proxy 握手并请求连接 target，失败时会关闭 conn
+func connectProxyOverConn(ctx context.Context, conn net.Conn, target string, proxy string) (_ net.Conn, err error) {
+	defer func() {
+		if err != nil {
+			conn.Close()
+		}
+	}()
+
+	host, port, _ := utils.ParseStringToHostPort(proxy)
+	if host == "" || port <= 0 {
+		return nil, utils.Errorf("proxy need host:port... at least[%v]", proxy)
+	}
+	proxyAddr := utils.HostPort(host, port)
+	username, password := parseProxyCredential(proxy)
+
+	var socksType = -1
+	switch true {
+	case utils.IHasPrefix(proxy, "socks://"), utils.IHasPrefix(proxy, "socks5://"), utils.IHasPrefix(proxy, "s5://"):
+		socksType = SOCKS5
+	case utils.IHasPrefix(proxy, "s4://"), utils.IHasPrefix(proxy, "socks4://"):
+		socksType = SOCKS4
+	case utils.IHasPrefix(proxy, "s4a://"), utils.IHasPrefix(proxy, "socks4a://"):
+		socksType = SOCKS4A
+	}
+	if socksType >= 0 {
+		cfg := &config{Context: ctx, Proto: socksType, Host: proxyAddr, Conn: conn}
+		if username != "" {
+			cfg.Auth = &auth{username, password}
+		}
+		return cfg.dialFunc()("tcp", target)
+	}
+
+	if utils.IHasPrefix(proxy, "https://") {
+		tlsConn := tls.Client(conn, &tls.Config{ServerName: host, InsecureSkipVerify: true})
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	if ddl, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(ddl)
+	}
+	if username != "" {
+		_, err = conn.Write(generateHTTPProxyConnectWithCredential(target, fmt.Sprintf("%s:%s", username, password)))
+	} else {
+		_, err = conn.Write(generateHTTPProxyConnect(target))
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = isHTTPConnectWork(conn); err != nil {
+		return nil, utils.Wrapf(err, "connect proxy [%s] failed", proxy)
+	}
+	conn.SetDeadline(time.Time{})
+	return conn, nil
+}
+
 func httpProxyDial(ctx context.Context, proxyAddr string, username string, credential string, proxy string, target string, https bool) (net.Conn, error) {
 	httpsString := "https"
 	if !https {
@@ -0,0 +1,68 @@
+package netx
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// DialQUICX dials target over QUIC, dns / timeout / sni / local addr options of DialX are used,
+// proxy is not supported because QUIC runs on udp
+func DialQUICX(ctx context.Context, target string, nextProtos []string, opt ...DialXOption) (quic.Connection, error) {
+	config := newDialXConfig(opt...)
+	if len(config.Proxy) > 0 && config.ForceProxy {
+		return nil, utils.Error("quic(udp) cannot be dialed via proxy")
+	}
+
+	addr, err := resolveUdpAddr(target, config)
+	if err != nil {
+		return nil, err
+	}
+
+	var tlsConfig *tls.Config
+	if config.TLSConfig != nil {
+		tlsConfig = config.TLSConfig.Clone()
+	} else {
+		tlsConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	if len(nextProtos) > 0 {
+		tlsConfig.NextProtos = nextProtos
+	}
+	if config.SNI != "" && (config.ShouldOverrideSNI || tlsConfig.ServerName == "") {
+		tlsConfig.ServerName = config.SNI
+	}
+	if tlsConfig.ServerName == "" {
+		host, _, _ := utils.ParseStringToHostPort(target)
+		tlsConfig.ServerName = host
+	}
+
+	timeout := config.Timeout
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	udpConn, err := net.ListenUDP("udp", config.LocalAddr)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := quic.Dial(ctx, udpConn, addr, tlsConfig, &quic.Config{HandshakeIdleTimeout: timeout})
+	if err != nil {
+		udpConn.Close()
+		return nil, err
+	}
+	// quic-go never closes the packet conn passed by the caller, release it with the connection
+	go func() {
+		<-conn.Context().Done()
+		udpConn.Close()
+	}()
+	return conn, nil
+}
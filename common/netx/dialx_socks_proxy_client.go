@@ -97,6 +97,8 @@ type (
 		Auth    *auth
 		Timeout time.Duration
 		Check   bool
+		// Conn 不为空时直接在该连接上进行 socks 握手（多级代理链）
+		Conn net.Conn
 	}
 	auth struct {
 		Username string
@@ -146,11 +148,7 @@ func parse(proxyURI string) (*config, error) {
 
 func (cfg *config) dialSocks5(targetAddr string) (_ net.Conn, err error) {
 RECON:
-	proxy := cfg.Host
-	ctx := cfg.Context
-
-	// dial TCP
-	conn, err := DialContextWithoutProxy(ctx, "tcp", proxy)
+	conn, err := cfg.dialProxy()
 	if err != nil {
 		return nil, err
 	}
@@ -183,7 +181,7 @@ RECON:
 	} else if resp[0] != 5 {
 		return nil, errors.New("server does not support Socks 5")
 	} else if resp[1] != method {
-		if cfg.Auth != nil {
+		if cfg.Auth != nil && cfg.Conn == nil {
 			log.Warn("remote socks5 proxy do not have authentication, try fall back using no authentication")
 			cfg.Auth = nil
 			goto RECON
@@ -277,6 +275,13 @@ func dialSocksProxyCheckConfig(ctx context.Context, socksType int, proxy string,
 	return cfg
 }
 
+func (cfg *config) dialProxy() (net.Conn, error) {
+	if cfg.Conn != nil {
+		return cfg.Conn, nil
+	}
+	return DialContextWithoutProxy(cfg.Context, "tcp", cfg.Host)
+}
+
 func (c *config) dialFunc() func(string, string) (net.Conn, error) {
 	switch c.Proto {
 	case SOCKS5:
@@ -299,12 +304,9 @@ func dialError(err error) func(string, string) (net.Conn, error) {
 
 func (cfg *config) dialSocks4(targetAddr string) (_ net.Conn, err error) {
 	socksType := cfg.Proto
-	proxy := cfg.Host
-	ctx := cfg.Context
 
 	// dial TCP
-
-	conn, err := DialContextWithoutProxy(ctx, "tcp", proxy)
+	conn, err := cfg.dialProxy()
 	if err != nil {
 		return nil, err
 	}
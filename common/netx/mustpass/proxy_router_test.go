@@ -0,0 +1,261 @@
+package mustpass
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func pipeConn(a, b net.Conn, aReader io.Reader) {
+	go func() {
+		io.Copy(b, aReader)
+		b.Close()
+	}()
+	io.Copy(a, b)
+	a.Close()
+}
+
+func mockHTTPConnectProxy(t *testing.T, hits *int64) string {
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		reader := bufio.NewReader(conn)
+		req, err := http.ReadRequest(reader)
+		if err != nil || req.Method != http.MethodConnect {
+			conn.Close()
+			return
+		}
+		atomic.AddInt64(hits, 1)
+		remote, err := net.Dial("tcp", req.Host)
+		if err != nil {
+			conn.Write([]byte("HTTP/1.1 502 Bad Gateway\r\nContent-Length: 0\r\n\r\n"))
+			conn.Close()
+			return
+		}
+		conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+		pipeConn(conn, remote, reader)
+	})
+	return fmt.Sprintf("http://%v", utils.HostPort(host, port))
+}
+
+func mockSocks5Proxy(t *testing.T, hits *int64) string {
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		reader := bufio.NewReader(conn)
+		greet := make([]byte, 3)
+		if _, err := io.ReadFull(reader, greet); err != nil {
+			conn.Close()
+			return
+		}
+		conn.Write([]byte{5, 0})
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(reader, header); err != nil {
+			conn.Close()
+			return
+		}
+		var targetHost string
+		switch header[3] {
+		case 1:
+			ip := make([]byte, 4)
+			io.ReadFull(reader, ip)
+			targetHost = net.IP(ip).String()
+		case 3:
+			l, _ := reader.ReadByte()
+			domain := make([]byte, l)
+			io.ReadFull(reader, domain)
+			targetHost = string(domain)
+		default:
+			conn.Close()
+			return
+		}
+		portBytes := make([]byte, 2)
+		io.ReadFull(reader, portBytes)
+		target := net.JoinHostPort(targetHost, strconv.Itoa(int(binary.BigEndian.Uint16(portBytes))))
+
+		atomic.AddInt64(hits, 1)
+		remote, err := net.Dial("tcp", target)
+		if err != nil {
+			conn.Write([]byte{5, 5, 0, 1, 0, 0, 0, 0, 0, 0})
+			conn.Close()
+			return
+		}
+		conn.Write([]byte{5, 0, 0, 1, 0, 0, 0, 0, 0, 0})
+		pipeConn(conn, remote, reader)
+	})
+	return fmt.Sprintf("socks5://%v", utils.HostPort(host, port))
+}
+
+func TestProxyRouter_Resolve(t *testing.T) {
+	router := netx.NewProxyRouter()
+	require.NoError(t, router.AddRouteRule(`*.corp.example.com, 10.0.0.0/8 => socks5://127.0.0.1:1080 -> http://10.1.1.1:8080 | direct`))
+	require.NoError(t, router.AddRouteRule(`internal.example.com => direct`))
+	require.NoError(t, router.SetPAC(`
+function FindProxyForURL(url, host) {
+	if (shExpMatch(host, "*.pac.example.com") && url.indexOf("https://") === 0) {
+		return "PROXY 127.0.0.1:8083; SOCKS5 127.0.0.1:1081; DIRECT";
+	}
+	if (isInNet(host, "192.168.0.0", "255.255.0.0")) {
+		return "SOCKS 127.0.0.1:1082";
+	}
+	return "DIRECT";
+}`))
+
+	chains := router.Resolve("a.corp.example.com:443")
+	require.Len(t, chains, 2)
+	require.Equal(t, netx.ProxyChain{"socks5://127.0.0.1:1080", "http://10.1.1.1:8080"}, chains[0])
+	require.True(t, chains[1].IsDirect())
+
+	chains = router.Resolve("10.2.3.4:22")
+	require.Len(t, chains, 2)
+	require.Equal(t, "socks5://127.0.0.1:1080 -> http://10.1.1.1:8080", chains[0].String())
+
+	chains = router.Resolve("internal.example.com:80")
+	require.Len(t, chains, 1)
+	require.True(t, chains[0].IsDirect())
+
+	chains = router.Resolve("www.pac.example.com:443")
+	require.Len(t, chains, 3)
+	require.Equal(t, netx.ProxyChain{"http://127.0.0.1:8083"}, chains[0])
+	require.Equal(t, netx.ProxyChain{"socks5://127.0.0.1:1081"}, chains[1])
+	require.True(t, chains[2].IsDirect())
+
+	chains = router.Resolve("www.pac.example.com:80")
+	require.Len(t, chains, 1)
+	require.True(t, chains[0].IsDirect())
+
+	chains = router.Resolve("192.168.1.1:3306")
+	require.Equal(t, []netx.ProxyChain{{"socks5://127.0.0.1:1082"}}, chains)
+
+	_, err := netx.ParseProxyRoute(`*.example.com`)
+	require.Error(t, err)
+	require.Error(t, router.SetPAC(`function notPAC() {}`))
+}
+
+func TestProxyRouter_ChainAndFailover(t *testing.T) {
+	var socksHits, httpHits int64
+	socksProxy := mockSocks5Proxy(t, &socksHits)
+	httpProxy := mockHTTPConnectProxy(t, &httpHits)
+	deadProxy := fmt.Sprintf("http://127.0.0.1:%v", utils.GetRandomAvailableTCPPort())
+
+	token := utils.RandStringBytes(16)
+	host, port := utils.DebugMockTCP([]byte(token))
+	target := utils.HostPort(host, port)
+
+	router := netx.NewProxyRouter()
+	router.RecoverAfter = time.Minute
+	require.NoError(t, router.AddRouteRule(fmt.Sprintf(`127.0.0.1 => %v | %v -> %v`, deadProxy, socksProxy, httpProxy)))
+
+	readToken := func() {
+		conn, err := netx.DialX(target, netx.DialX_WithProxyRouter(router), netx.DialX_WithTimeout(3*time.Second))
+		require.NoError(t, err)
+		defer conn.Close()
+		conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+		buf, _ := io.ReadAll(conn)
+		require.Equal(t, token, string(buf))
+	}
+
+	readToken()
+	require.EqualValues(t, 1, atomic.LoadInt64(&socksHits))
+	require.EqualValues(t, 1, atomic.LoadInt64(&httpHits))
+
+	// dead proxy is unhealthy now, the chain is preferred
+	chains := router.Resolve(target)
+	require.Len(t, chains, 2)
+	require.Equal(t, netx.ProxyChain{socksProxy, httpProxy}, chains[0])
+	require.False(t, router.IsHealthy(netx.ProxyChain{deadProxy}))
+
+	readToken()
+	require.EqualValues(t, 2, atomic.LoadInt64(&socksHits))
+
+	results := router.CheckHealth(target, 3*time.Second)
+	require.Error(t, results[deadProxy])
+	require.NoError(t, results[chains[0].String()])
+}
+
+func TestProxyRouter_DialContext(t *testing.T) {
+	// proxy accepts the connection but never answers the handshake
+	host, port := utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		<-ctx.Done()
+		conn.Close()
+	})
+	router := netx.NewProxyRouter()
+	router.SetDefaultChains(netx.ProxyChain{fmt.Sprintf("socks5://%v", utils.HostPort(host, port))})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	_, err := router.Dial(utils.HostPort("127.0.0.1", utils.GetRandomAvailableTCPPort()), netx.DialX_WithTimeout(10*time.Second), netx.DialX_WithContext(ctx))
+	require.Error(t, err)
+	require.Less(t, time.Since(start), 3*time.Second)
+}
+
+func TestProxyRouter_PACTimeout(t *testing.T) {
+	router := netx.NewProxyRouter()
+	require.NoError(t, router.SetPAC(`
+function FindProxyForURL(url, host) {
+	if (host === "loop.example.com") {
+		while (true) {}
+	}
+	return "SOCKS5 127.0.0.1:1080";
+}`))
+
+	start := time.Now()
+	chains := router.Resolve("loop.example.com:80")
+	require.Less(t, time.Since(start), 10*time.Second)
+	require.Len(t, chains, 1)
+	require.True(t, chains[0].IsDirect())
+
+	// the runtime is still usable after interrupted
+	require.Equal(t, []netx.ProxyChain{{"socks5://127.0.0.1:1080"}}, router.Resolve("www.example.com:80"))
+
+	require.Error(t, router.SetPAC(`while (true) {}`))
+}
+
+func TestConfigureDefaultProxyRouter(t *testing.T) {
+	defer netx.SetDefaultProxyRouter(nil)
+
+	rules := []string{`*.corp.example.com => socks5://127.0.0.1:1080`}
+	require.NoError(t, netx.ConfigureDefaultProxyRouter(rules, "", time.Hour, ""))
+	router := netx.GetDefaultProxyRouter()
+	require.NotNil(t, router)
+
+	// unchanged config keeps the router and its health check
+	require.NoError(t, netx.ConfigureDefaultProxyRouter(rules, "", time.Hour, ""))
+	require.Same(t, router, netx.GetDefaultProxyRouter())
+
+	require.NoError(t, netx.ConfigureDefaultProxyRouter(rules, "", time.Minute, ""))
+	require.NotSame(t, router, netx.GetDefaultProxyRouter())
+
+	require.Error(t, netx.ConfigureDefaultProxyRouter([]string{`invalid`}, "", 0, ""))
+	require.Nil(t, netx.GetDefaultProxyRouter())
+
+	require.NoError(t, netx.ConfigureDefaultProxyRouter(rules, "", 0, ""))
+	require.NoError(t, netx.ConfigureDefaultProxyRouter(nil, "", 0, ""))
+	require.Nil(t, netx.GetDefaultProxyRouter())
+}
+
+func TestProxyRouter_DisabledDomain(t *testing.T) {
+	var hits int64
+	socksProxy := mockSocks5Proxy(t, &hits)
+	router := netx.NewProxyRouter()
+	require.NoError(t, router.AddRouteRule(fmt.Sprintf(`*.example.com => %v`, socksProxy)))
+
+	disabled := netx.DialX_WithDNSOptions(netx.WithDNSDisabledDomain("blocked.example.com"))
+	_, err := netx.DialX("blocked.example.com:80", netx.DialX_WithProxyRouter(router), netx.DialX_WithTimeout(3*time.Second), disabled)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "disallow domain")
+
+	_, err = router.Dial("blocked.example.com:80", netx.DialX_WithTimeout(3*time.Second), disabled)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "disallow domain")
+	require.EqualValues(t, 0, atomic.LoadInt64(&hits))
+}
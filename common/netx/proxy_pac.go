@@ -0,0 +1,164 @@
+package netx
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dop251/goja"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// pacBuiltins 是 PAC 规范中纯 JavaScript 实现的辅助函数，dnsResolve / myIpAddress 由 Go 注入
+const pacBuiltins = `
+function isPlainHostName(host) { return host.indexOf('.') < 0; }
+function dnsDomainIs(host, domain) {
+	return host.length >= domain.length && host.substring(host.length - domain.length) === domain;
+}
+function localHostOrDomainIs(host, hostdom) {
+	return host === hostdom || hostdom.lastIndexOf(host + '.', 0) === 0;
+}
+function dnsDomainLevels(host) { return host.split('.').length - 1; }
+function isResolvable(host) { return dnsResolve(host) !== null; }
+function shExpMatch(str, shexp) {
+	var re = shexp.replace(/[.+^${}()|[\]\\]/g, '\\$&').replace(/\*/g, '.*').replace(/\?/g, '.');
+	return new RegExp('^' + re + '$').test(str);
+}
+function convert_addr(ipchars) {
+	var bytes = ipchars.split('.');
+	return ((bytes[0] & 0xff) << 24) | ((bytes[1] & 0xff) << 16) | ((bytes[2] & 0xff) << 8) | (bytes[3] & 0xff);
+}
+function isInNet(host, pattern, mask) {
+	var ip = /^\d+\.\d+\.\d+\.\d+$/.test(host) ? host : dnsResolve(host);
+	if (ip === null) { return false; }
+	return (convert_addr(ip) & convert_addr(mask)) === (convert_addr(pattern) & convert_addr(mask));
+}
+function weekdayRange() { return true; }
+function dateRange() { return true; }
+function timeRange() { return true; }
+`
+
+// pacEvaluateTimeout 单次执行 PAC 脚本的超时时间，避免死循环的脚本阻塞所有连接
+var pacEvaluateTimeout = 3 * time.Second
+
+// pacEvaluator 执行 PAC 脚本，goja 运行时不是并发安全的，因此需要加锁
+type pacEvaluator struct {
+	mu         sync.Mutex
+	vm         *goja.Runtime
+	findProxy  goja.Callable
+	resolveDNS func(host string) string
+}
+
+// run 执行 f，超过 pacEvaluateTimeout 时中断 vm，调用方需要持有锁
+func (p *pacEvaluator) run(f func() (goja.Value, error)) (goja.Value, error) {
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		timer := time.NewTimer(pacEvaluateTimeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			p.vm.Interrupt("pac evaluate timeout")
+		case <-stop:
+		}
+	}()
+	ret, err := f()
+	close(stop)
+	<-done
+	p.vm.ClearInterrupt()
+	return ret, err
+}
+
+func newPACEvaluator(script string) (*pacEvaluator, error) {
+	pac := &pacEvaluator{
+		vm: goja.New(),
+		resolveDNS: func(host string) string {
+			return LookupFirst(host)
+		},
+	}
+	_ = pac.vm.Set("dnsResolve", func(host string) any {
+		if ip := pac.resolveDNS(host); ip != "" {
+			return ip
+		}
+		return nil
+	})
+	_ = pac.vm.Set("myIpAddress", func() string {
+		return utils.GetLocalIPAddress()
+	})
+	if _, err := pac.vm.RunString(pacBuiltins); err != nil {
+		return nil, utils.Errorf("init pac builtins failed: %v", err)
+	}
+	if _, err := pac.run(func() (goja.Value, error) { return pac.vm.RunString(script) }); err != nil {
+		return nil, utils.Errorf("evaluate pac script failed: %v", err)
+	}
+	findProxy, ok := goja.AssertFunction(pac.vm.Get("FindProxyForURL"))
+	if !ok {
+		return nil, utils.Error("pac script need function FindProxyForURL(url, host)")
+	}
+	pac.findProxy = findProxy
+	return pac, nil
+}
+
+// FindProxy 调用 FindProxyForURL 并将结果转换为候选代理链（每条为单跳）
+func (p *pacEvaluator) FindProxy(host string, port int) ([]ProxyChain, error) {
+	u := fmt.Sprintf("http://%v/", utils.HostPort(host, port))
+	switch port {
+	case 80, 0:
+		u = fmt.Sprintf("http://%v/", host)
+	case 443:
+		u = fmt.Sprintf("https://%v/", host)
+	}
+
+	p.mu.Lock()
+	ret, err := p.run(func() (goja.Value, error) {
+		return p.findProxy(goja.Undefined(), p.vm.ToValue(u), p.vm.ToValue(host))
+	})
+	p.mu.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	return parsePACResult(ret.String())
+}
+
+// parsePACResult 解析 PAC 返回值，例如 "PROXY 10.0.0.1:8080; SOCKS5 127.0.0.1:1080; DIRECT"
+func parsePACResult(result string) ([]ProxyChain, error) {
+	var chains []ProxyChain
+	for _, item := range strings.Split(result, ";") {
+		fields := strings.Fields(item)
+		if len(fields) <= 0 {
+			continue
+		}
+		kind := strings.ToUpper(fields[0])
+		if kind == "DIRECT" {
+			chains = append(chains, nil)
+			continue
+		}
+		if len(fields) < 2 {
+			return nil, utils.Errorf("invalid pac result item: %#v", item)
+		}
+		addr := fields[1]
+		if host, port, _ := utils.ParseStringToHostPort(addr); host == "" || port <= 0 {
+			return nil, utils.Errorf("invalid pac proxy address: %#v", item)
+		}
+		var scheme string
+		switch kind {
+		case "PROXY", "HTTP":
+			scheme = "http"
+		case "HTTPS":
+			scheme = "https"
+		case "SOCKS", "SOCKS5":
+			scheme = "socks5"
+		case "SOCKS4":
+			scheme = "socks4"
+		default:
+			return nil, utils.Errorf("unsupported pac proxy type: %#v", item)
+		}
+		chains = append(chains, ProxyChain{fmt.Sprintf("%v://%v", scheme, addr)})
+	}
+	if len(chains) <= 0 {
+		return []ProxyChain{nil}, nil
+	}
+	return chains, nil
+}
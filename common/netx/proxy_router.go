@@ -0,0 +1,465 @@
+package netx
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const proxyChainDirect = "direct"
+
+// ProxyChain 代理链，按顺序经过每一跳代理，空链表示直连
+type ProxyChain []string
+
+func (c ProxyChain) IsDirect() bool {
+	return len(c) <= 0
+}
+
+func (c ProxyChain) String() string {
+	if c.IsDirect() {
+		return proxyChainDirect
+	}
+	return strings.Join(c, " -> ")
+}
+
+// ParseProxyChain 解析代理链，例如 "socks5://127.0.0.1:1080 -> http://10.0.0.1:8080"，"direct" 表示直连
+func ParseProxyChain(raw string) (ProxyChain, error) {
+	var chain ProxyChain
+	for _, hop := range strings.Split(raw, "->") {
+		hop = strings.TrimSpace(hop)
+		if hop == "" || strings.EqualFold(hop, proxyChainDirect) {
+			continue
+		}
+		hop = FixProxy(hop)
+		if host, port, _ := utils.ParseStringToHostPort(hop); host == "" || port <= 0 {
+			return nil, utils.Errorf("invalid proxy hop %#v in chain %#v", hop, raw)
+		}
+		chain = append(chain, hop)
+	}
+	return chain, nil
+}
+
+// ProxyRoute 路由规则：目标命中 Hosts（glob / IP / CIDR）时按顺序尝试 Chains，前面的失败后自动切换到后面的
+type ProxyRoute struct {
+	Hosts  []string
+	Chains []ProxyChain
+
+	networks []*net.IPNet
+	globs    []string
+}
+
+func NewProxyRoute(hosts []string, chains ...ProxyChain) *ProxyRoute {
+	route := &ProxyRoute{Hosts: hosts, Chains: chains}
+	for _, h := range hosts {
+		h = strings.TrimSpace(h)
+		if h == "" {
+			continue
+		}
+		if _, network, err := net.ParseCIDR(h); err == nil {
+			route.networks = append(route.networks, network)
+			continue
+		}
+		route.globs = append(route.globs, strings.ToLower(h))
+	}
+	if len(route.Chains) <= 0 {
+		route.Chains = []ProxyChain{nil}
+	}
+	return route
+}
+
+// ParseProxyRoute 解析文本形式的路由规则：
+//
+//	*.corp.example.com, 10.0.0.0/8 => socks5://127.0.0.1:1080 -> http://10.1.1.1:8080 | direct
+//
+// "=>" 左侧为逗号分隔的 host glob / IP / CIDR，右侧为 "|" 分隔的候选代理链（按顺序故障转移）
+func ParseProxyRoute(raw string) (*ProxyRoute, error) {
+	hostsRaw, chainsRaw, ok := strings.Cut(raw, "=>")
+	if !ok {
+		return nil, utils.Errorf("invalid proxy route %#v, need `hosts => chain`", raw)
+	}
+	hosts := utils.StringArrayFilterEmpty(utils.PrettifyListFromStringSplitEx(hostsRaw, ",", " "))
+	if len(hosts) <= 0 {
+		return nil, utils.Errorf("invalid proxy route %#v, empty hosts", raw)
+	}
+	var chains []ProxyChain
+	for _, chainRaw := range strings.Split(chainsRaw, "|") {
+		chain, err := ParseProxyChain(chainRaw)
+		if err != nil {
+			return nil, err
+		}
+		chains = append(chains, chain)
+	}
+	return NewProxyRoute(hosts, chains...), nil
+}
+
+func (r *ProxyRoute) Match(host string) bool {
+	host = strings.ToLower(strings.Trim(host, "[]"))
+	if ip := net.ParseIP(host); ip != nil {
+		for _, network := range r.networks {
+			if network.Contains(ip) {
+				return true
+			}
+		}
+	}
+	return utils.MatchAnyOfGlob(host, r.globs...)
+}
+
+type proxyChainHealth struct {
+	failures    int
+	lastFailure time.Time
+}
+
+// ProxyRouter 代理路由表，按目标选择代理链（路由规则 > PAC > 默认代理链 > 直连），
+// 并记录代理链的健康状态，不可用的代理链会被排到候选列表末尾
+type ProxyRouter struct {
+	mu       sync.RWMutex
+	routes   []*ProxyRoute
+	defaults []ProxyChain
+	pac      *pacEvaluator
+
+	healthMu sync.Mutex
+	health   map[string]*proxyChainHealth
+
+	// MaxFailures 连续失败多少次后认为代理链不可用
+	MaxFailures int
+	// RecoverAfter 不可用的代理链经过多久之后重新参与排序
+	RecoverAfter time.Duration
+
+	healthCheckCancel context.CancelFunc
+}
+
+func NewProxyRouter() *ProxyRouter {
+	return &ProxyRouter{
+		health:       make(map[string]*proxyChainHealth),
+		MaxFailures:  1,
+		RecoverAfter: 30 * time.Second,
+	}
+}
+
+// NewProxyRouterFromRules 通过文本路由规则与 PAC 脚本创建路由表，rules 格式见 ParseProxyRoute
+func NewProxyRouterFromRules(rules []string, pacScript string) (*ProxyRouter, error) {
+	router := NewProxyRouter()
+	for _, rule := range rules {
+		if strings.TrimSpace(rule) == "" {
+			continue
+		}
+		if err := router.AddRouteRule(rule); err != nil {
+			return nil, err
+		}
+	}
+	if strings.TrimSpace(pacScript) != "" {
+		if err := router.SetPAC(pacScript); err != nil {
+			return nil, err
+		}
+	}
+	return router, nil
+}
+
+func (r *ProxyRouter) AddRoute(route ...*ProxyRoute) *ProxyRouter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.routes = append(r.routes, route...)
+	return r
+}
+
+func (r *ProxyRouter) AddRouteRule(rule string) error {
+	route, err := ParseProxyRoute(rule)
+	if err != nil {
+		return err
+	}
+	r.AddRoute(route)
+	return nil
+}
+
+// SetDefaultChains 设置没有命中任何规则时使用的代理链
+func (r *ProxyRouter) SetDefaultChains(chains ...ProxyChain) *ProxyRouter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.defaults = chains
+	return r
+}
+
+// SetPAC 设置 PAC 脚本（需要定义 FindProxyForURL(url, host)），在路由规则未命中时使用
+func (r *ProxyRouter) SetPAC(script string) error {
+	pac, err := newPACEvaluator(script)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.pac = pac
+	return nil
+}
+
+func (r *ProxyRouter) Routes() []*ProxyRoute {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return append([]*ProxyRoute(nil), r.routes...)
+}
+
+func (r *ProxyRouter) allChains() []ProxyChain {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var chains []ProxyChain
+	for _, route := range r.routes {
+		chains = append(chains, route.Chains...)
+	}
+	return append(chains, r.defaults...)
+}
+
+// Resolve 返回 target（host:port）的候选代理链，健康的代理链排在前面
+func (r *ProxyRouter) Resolve(target string) []ProxyChain {
+	host, port, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		host = target
+	}
+
+	var chains []ProxyChain
+	r.mu.RLock()
+	for _, route := range r.routes {
+		if route.Match(host) {
+			chains = route.Chains
+			break
+		}
+	}
+	pac, defaults := r.pac, r.defaults
+	r.mu.RUnlock()
+
+	if chains == nil && pac != nil {
+		ret, err := pac.FindProxy(host, port)
+		if err != nil {
+			log.Warnf("evaluate pac for %v failed: %v", target, err)
+		} else {
+			chains = ret
+		}
+	}
+	if chains == nil {
+		chains = defaults
+	}
+	if len(chains) <= 0 {
+		return []ProxyChain{nil}
+	}
+	return r.sortByHealth(chains)
+}
+
+func (r *ProxyRouter) sortByHealth(chains []ProxyChain) []ProxyChain {
+	var healthy, unhealthy []ProxyChain
+	for _, chain := range chains {
+		if r.IsHealthy(chain) {
+			healthy = append(healthy, chain)
+		} else {
+			unhealthy = append(unhealthy, chain)
+		}
+	}
+	return append(healthy, unhealthy...)
+}
+
+func (r *ProxyRouter) IsHealthy(chain ProxyChain) bool {
+	if chain.IsDirect() {
+		return true
+	}
+	r.healthMu.Lock()
+	defer r.healthMu.Unlock()
+	h, ok := r.health[chain.String()]
+	if !ok {
+		return true
+	}
+	if h.failures < r.MaxFailures {
+		return true
+	}
+	return r.RecoverAfter > 0 && time.Since(h.lastFailure) > r.RecoverAfter
+}
+
+func (r *ProxyRouter) markResult(chain ProxyChain, err error) {
+	if chain.IsDirect() {
+		return
+	}
+	r.healthMu.Lock()
+	defer r.healthMu.Unlock()
+	key := chain.String()
+	if err == nil {
+		delete(r.health, key)
+		return
+	}
+	h, ok := r.health[key]
+	if !ok {
+		h = &proxyChainHealth{}
+		r.health[key] = h
+	}
+	h.failures++
+	h.lastFailure = time.Now()
+}
+
+// Dial 按路由结果依次尝试候选代理链连接 target，直连时沿用 config 的 DNS / 禁止地址等配置
+func (r *ProxyRouter) Dial(target string, opts ...DialXOption) (net.Conn, error) {
+	config := &dialXConfig{Timeout: 10 * time.Second}
+	for _, o := range opts {
+		o(config)
+	}
+	return r.dial(target, config)
+}
+
+func (r *ProxyRouter) dial(target string, config *dialXConfig) (net.Conn, error) {
+	// 代理链由代理服务器解析域名，不会经过本地 DNS 的禁用域名检查
+	if err := checkDisabledDomain(target, config); err != nil {
+		return nil, err
+	}
+
+	var errs error
+	chains := r.Resolve(target)
+	for _, chain := range chains {
+		if chain.IsDirect() && config.ForceProxy {
+			continue
+		}
+		if config.Debug {
+			log.Infof("dial %s via proxy route: %v", target, chain)
+		}
+
+		var conn net.Conn
+		var err error
+		if chain.IsDirect() {
+			direct := *config
+			direct.ProxyRouter = nil
+			direct.Proxy = nil
+			direct.ForceDisableProxy = true
+			conn, err = dialPlainTCPConnWithRetry(target, &direct)
+		} else {
+			conn, err = connectProxyChain(config.Ctx, target, chain, config.Timeout)
+		}
+		r.markResult(chain, err)
+		if err != nil {
+			log.Errorf("proxy route %v for %v failed: %v", chain, target, err)
+			errs = utils.JoinErrors(errs, err)
+			continue
+		}
+		return conn, nil
+	}
+	if errs == nil {
+		return nil, utils.Errorf("force proxy but no proxy available for target: %v", target)
+	}
+	return nil, utils.Wrapf(errs, "connect: %v failed: no proxy available (in %v)", target, chains)
+}
+
+// CheckHealth 检查所有路由规则与默认代理链的可用性；checkTarget 不为空时通过完整代理链连接该目标，
+// 否则只检查代理链第一跳代理是否可用
+func (r *ProxyRouter) CheckHealth(checkTarget string, timeout time.Duration) map[string]error {
+	return r.checkHealth(context.Background(), checkTarget, timeout)
+}
+
+func (r *ProxyRouter) checkHealth(ctx context.Context, checkTarget string, timeout time.Duration) map[string]error {
+	results := make(map[string]error)
+	for _, chain := range r.allChains() {
+		if chain.IsDirect() {
+			continue
+		}
+		key := chain.String()
+		if _, ok := results[key]; ok {
+			continue
+		}
+		var conn net.Conn
+		var err error
+		if checkTarget != "" {
+			conn, err = connectProxyChain(ctx, checkTarget, chain, timeout)
+		} else {
+			conn, err = ProxyCheck(chain[0], timeout)
+		}
+		if conn != nil {
+			conn.Close()
+		}
+		results[key] = err
+		r.markResult(chain, err)
+	}
+	return results
+}
+
+// StartHealthCheck 后台定期执行 CheckHealth，重复调用会停止上一次的检查
+func (r *ProxyRouter) StartHealthCheck(ctx context.Context, interval time.Duration, checkTarget string) {
+	if interval <= 0 {
+		return
+	}
+	r.StopHealthCheck()
+	ctx, cancel := context.WithCancel(ctx)
+	r.mu.Lock()
+	r.healthCheckCancel = cancel
+	r.mu.Unlock()
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			r.checkHealth(ctx, checkTarget, 5*time.Second)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (r *ProxyRouter) StopHealthCheck() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.healthCheckCancel != nil {
+		r.healthCheckCancel()
+		r.healthCheckCancel = nil
+	}
+}
+
+var (
+	defaultProxyRouter      *ProxyRouter
+	defaultProxyRouterKey   string
+	defaultProxyRouterMutex = new(sync.RWMutex)
+)
+
+// SetDefaultProxyRouter 设置全局代理路由表，DialX（以及基于它的 lowhttp / MITM / 爆破 / 端口扫描）在没有显式代理时使用
+func SetDefaultProxyRouter(r *ProxyRouter) {
+	defaultProxyRouterMutex.Lock()
+	defer defaultProxyRouterMutex.Unlock()
+	setDefaultProxyRouter(r, "")
+}
+
+func setDefaultProxyRouter(r *ProxyRouter, key string) {
+	if defaultProxyRouter != nil && defaultProxyRouter != r {
+		defaultProxyRouter.StopHealthCheck()
+	}
+	defaultProxyRouter = r
+	defaultProxyRouterKey = key
+}
+
+// ConfigureDefaultProxyRouter 通过路由规则与 PAC 脚本配置全局代理路由表，rules 与 pacScript 都为空时清除路由表；
+// 配置没有变化时沿用当前路由表（保留健康状态与健康检查），否则停止旧路由表的健康检查后替换
+func ConfigureDefaultProxyRouter(rules []string, pacScript string, healthCheckInterval time.Duration, healthCheckTarget string) error {
+	rules = utils.StringArrayFilterEmpty(rules)
+	defaultProxyRouterMutex.Lock()
+	defer defaultProxyRouterMutex.Unlock()
+	if len(rules) <= 0 && strings.TrimSpace(pacScript) == "" {
+		setDefaultProxyRouter(nil, "")
+		return nil
+	}
+
+	key := fmt.Sprintf("%q|%q|%v|%q", rules, pacScript, healthCheckInterval, healthCheckTarget)
+	if defaultProxyRouter != nil && defaultProxyRouterKey == key {
+		return nil
+	}
+	router, err := NewProxyRouterFromRules(rules, pacScript)
+	if err != nil {
+		setDefaultProxyRouter(nil, "")
+		return err
+	}
+	setDefaultProxyRouter(router, key)
+	router.StartHealthCheck(context.Background(), healthCheckInterval, healthCheckTarget)
+	return nil
+}
+
+func GetDefaultProxyRouter() *ProxyRouter {
+	defaultProxyRouterMutex.RLock()
+	defer defaultProxyRouterMutex.RUnlock()
+	return defaultProxyRouter
+}
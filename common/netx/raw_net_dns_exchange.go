@@ -0,0 +1,69 @@
+package netx
+
+import (
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// ExchangeDNSMsg 使用 ReliableDNSConfig 中配置的 DNS 服务器发送完整的 DNS 报文并返回原始响应，
+// 与 LookupFirst / LookupAll 不同，这里不会只保留 A / AAAA 记录，适合需要任意记录类型与响应细节的场景
+// 返回值依次为：响应报文、实际应答的服务器、错误
+func ExchangeDNSMsg(msg *dns.Msg, opt ...DNSOption) (*dns.Msg, string, error) {
+	if msg == nil || len(msg.Question) <= 0 {
+		return nil, "", utils.Error("dns message question is empty")
+	}
+
+	config := NewDefaultReliableDNSConfig()
+	for _, o := range opt {
+		o(config)
+	}
+	if config.RetryTimes <= 0 {
+		config.RetryTimes = 1
+	}
+	servers := utils.StringArrayFilterEmpty(config.SpecificDNSServers)
+	if len(servers) <= 0 {
+		servers = DefaultCustomDNSServers
+	}
+
+	ctx := config.GetBaseContext()
+	var lastErr error
+	for i := 0; i < config.RetryTimes; i++ {
+		for _, server := range servers {
+			if err := ctx.Err(); err != nil {
+				return nil, "", err
+			}
+			server = utils.AppendDefaultPort(server, 53)
+			rsp, err := exchangeDNSMsg(config, msg, server)
+			if err != nil {
+				log.Debugf("exchange dns msg with %v failed: %s", server, err)
+				lastErr = err
+				continue
+			}
+			return rsp, server, nil
+		}
+	}
+	if lastErr == nil {
+		lastErr = utils.Error("no dns server available")
+	}
+	return nil, "", utils.Errorf("exchange dns msg for %v failed: %s", msg.Question[0].Name, lastErr)
+}
+
+func exchangeDNSMsg(config *ReliableDNSConfig, msg *dns.Msg, server string) (*dns.Msg, error) {
+	client := &dns.Client{Net: "udp", Timeout: config.Timeout}
+	if config.PreferTCP {
+		client.Net = "tcp"
+	}
+	rsp, _, err := client.ExchangeContext(config.GetBaseContext(), msg, server)
+	if err != nil {
+		return nil, err
+	}
+	// 响应被截断时使用 TCP 重新查询
+	if rsp.Truncated && client.Net == "udp" && config.FallbackTCP {
+		client.Net = "tcp"
+		if tcpRsp, _, err := client.ExchangeContext(config.GetBaseContext(), msg, server); err == nil {
+			return tcpRsp, nil
+		}
+	}
+	return rsp, nil
+}
@@ -36,6 +36,11 @@ var (
 )
 
 func ArpWithContext(ctx context.Context, ifaceName string, target string) (net.HardwareAddr, error) {
+	// IPv6 没有 ARP，使用邻居发现协议获取下一跳的 MAC 地址
+	if utils.IsIPv6(target) {
+		return NdpWithContext(ctx, ifaceName, target)
+	}
+
 	if arpTableTTLCache != nil {
 		if hw, ok := arpTableTTLCache.Get(target); ok {
 			return hw, nil
@@ -0,0 +1,251 @@
+package arpx
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Ndp 通过 ICMPv6 邻居发现（Neighbor Discovery）获取 IPv6 地址的 MAC 地址，IPv6 下替代 ARP
+func Ndp(ifaceName string, target string) (net.HardwareAddr, error) {
+	return NdpWithContext(utils.TimeoutContext(5*time.Second), ifaceName, target)
+}
+
+func NdpWithTimeout(timeout time.Duration, ifaceName string, target string) (net.HardwareAddr, error) {
+	return NdpWithContext(utils.TimeoutContext(timeout), ifaceName, target)
+}
+
+func NdpWithContext(ctx context.Context, ifaceName string, target string) (net.HardwareAddr, error) {
+	targetIP := net.ParseIP(utils.FixForParseIP(target))
+	if targetIP == nil || targetIP.To4() != nil {
+		return nil, utils.Errorf("invalid ipv6 address: %v", target)
+	}
+	target = targetIP.String()
+	if hw, ok := arpTableTTLCache.Get(target); ok {
+		return hw, nil
+	}
+
+	r, err := NdpWithPcap(ctx, ifaceName, target)
+	if err != nil {
+		return nil, err
+	}
+	if hw, ok := r[target]; ok {
+		return hw, nil
+	}
+	return nil, utils.Error("empty result")
+}
+
+// NdpWithPcap 向每个目标的请求节点组播地址发送 Neighbor Solicitation，收集 Neighbor Advertisement
+// targets 以逗号分隔
+func NdpWithPcap(ctx context.Context, ifaceName string, targets string) (map[string]net.HardwareAddr, error) {
+	ifaceIns, err := net.InterfaceByName(ifaceName)
+	if err != nil {
+		return nil, err
+	}
+	if ifaceIns.Flags&net.FlagLoopback != 0 {
+		return nil, TargetIsLoopback
+	}
+	if len(ifaceIns.HardwareAddr) == 0 {
+		return nil, LinkTypeIsNull
+	}
+
+	var targetIPs []net.IP
+	for _, t := range utils.PrettifyListFromStringSplited(targets, ",") {
+		ip := net.ParseIP(utils.FixForParseIP(t))
+		if ip == nil || ip.To4() != nil {
+			log.Debugf("skip invalid ndp target: %v", t)
+			continue
+		}
+		targetIPs = append(targetIPs, ip)
+	}
+	if len(targetIPs) == 0 {
+		return nil, utils.Errorf("no valid ipv6 target in %v", targets)
+	}
+
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if _, ok := ctx.Deadline(); !ok {
+		ctx = utils.TimeoutContext(5 * time.Second)
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := new(sync.Map)
+	var resultsLock sync.Mutex
+	var resultSize int
+
+	err = pcaputil.Start(
+		pcaputil.WithDevice(ifaceName),
+		pcaputil.WithEnableCache(true),
+		pcaputil.WithBPFFilter("icmp6"),
+		pcaputil.WithContext(ctx),
+		pcaputil.WithNetInterfaceCreated(func(handle *pcaputil.PcapHandleWrapper) {
+			go func() {
+				for i := 0; i < 3; i++ {
+					for _, ip := range targetIPs {
+						if _, ok := results.Load(ip.String()); ok {
+							continue
+						}
+						raw, err := newNdpNeighborSolicitationPacket(ifaceIns, ip)
+						if err != nil {
+							log.Errorf("new ndp packet failed: %s", err)
+							continue
+						}
+						if err := handle.WritePacketData(raw); err != nil {
+							log.Errorf("write ndp packet failed: %s", err)
+						}
+					}
+					select {
+					case <-ctx.Done():
+						return
+					case <-time.After(500 * time.Millisecond):
+					}
+				}
+			}()
+		}),
+		pcaputil.WithEveryPacket(func(packet gopacket.Packet) {
+			ip, hw, ok := ParseNeighborAdvertisement(packet)
+			if !ok || bytes.Equal(hw, ifaceIns.HardwareAddr) {
+				return
+			}
+			for _, target := range targetIPs {
+				if !target.Equal(ip) {
+					continue
+				}
+				log.Debugf("IPv6[%v] 's mac addr: %v", ip, hw)
+				if _, loaded := results.LoadOrStore(ip.String(), hw); loaded {
+					return
+				}
+				arpTableTTLCache.Set(ip.String(), hw)
+				resultsLock.Lock()
+				resultSize++
+				if resultSize >= len(targetIPs) {
+					cancel()
+				}
+				resultsLock.Unlock()
+				return
+			}
+		}),
+	)
+
+	ret := make(map[string]net.HardwareAddr)
+	results.Range(func(key, value any) bool {
+		ret[key.(string)] = value.(net.HardwareAddr)
+		return true
+	})
+	if len(ret) > 0 {
+		return ret, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, utils.Errorf("cannot fetch (%v) %v 's mac address by ndp", ifaceName, targets)
+}
+
+// ParseNeighborAdvertisement 从数据包中提取 Neighbor Advertisement 的目标地址与 MAC 地址
+// 优先使用 Target Link-Layer Address 选项，缺失时使用以太网源地址
+func ParseNeighborAdvertisement(packet gopacket.Packet) (net.IP, net.HardwareAddr, bool) {
+	naLayer := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement)
+	if naLayer == nil {
+		return nil, nil, false
+	}
+	na, ok := naLayer.(*layers.ICMPv6NeighborAdvertisement)
+	if !ok || na.TargetAddress == nil {
+		return nil, nil, false
+	}
+	for _, opt := range na.Options {
+		if opt.Type == layers.ICMPv6OptTargetAddress && len(opt.Data) >= 6 {
+			return na.TargetAddress, net.HardwareAddr(opt.Data[:6]), true
+		}
+	}
+	if ethLayer := packet.Layer(layers.LayerTypeEthernet); ethLayer != nil {
+		if eth, ok := ethLayer.(*layers.Ethernet); ok {
+			return na.TargetAddress, eth.SrcMAC, true
+		}
+	}
+	return nil, nil, false
+}
+
+// selectNdpSourceIP 选择发送 NDP 使用的源地址：优先与目标同网段的地址，其次链路本地地址
+func selectNdpSourceIP(iface *net.Interface, target net.IP) (net.IP, error) {
+	addrs, err := iface.Addrs()
+	if err != nil {
+		return nil, utils.Errorf("fetch src ip failed: %s", err)
+	}
+	var linkLocal, fallback net.IP
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet.IP.To4() != nil {
+			continue
+		}
+		if ipNet.Contains(target) {
+			return ipNet.IP, nil
+		}
+		if ipNet.IP.IsLinkLocalUnicast() {
+			if linkLocal == nil {
+				linkLocal = ipNet.IP
+			}
+		} else if fallback == nil {
+			fallback = ipNet.IP
+		}
+	}
+	if linkLocal != nil {
+		return linkLocal, nil
+	}
+	if fallback != nil {
+		return fallback, nil
+	}
+	return nil, errors.New("iface[" + iface.Name + "] has no ipv6 address")
+}
+
+func newNdpNeighborSolicitationPacket(iface *net.Interface, target net.IP) ([]byte, error) {
+	src, err := selectNdpSourceIP(iface, target)
+	if err != nil {
+		return nil, err
+	}
+	target = target.To16()
+	// 请求节点组播地址 ff02::1:ffXX:XXXX 以及对应的组播 MAC 33:33:ff:XX:XX:XX
+	dst := net.ParseIP("ff02::1:ff00:0")
+	copy(dst[13:], target[13:])
+
+	eth := &layers.Ethernet{
+		SrcMAC:       iface.HardwareAddr,
+		DstMAC:       net.HardwareAddr{0x33, 0x33, dst[12], dst[13], dst[14], dst[15]},
+		EthernetType: layers.EthernetTypeIPv6,
+	}
+	ip6 := &layers.IPv6{
+		Version:    6,
+		NextHeader: layers.IPProtocolICMPv6,
+		HopLimit:   255,
+		SrcIP:      src,
+		DstIP:      dst,
+	}
+	icmp6 := &layers.ICMPv6{
+		TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0),
+	}
+	if err := icmp6.SetNetworkLayerForChecksum(ip6); err != nil {
+		return nil, err
+	}
+	ns := &layers.ICMPv6NeighborSolicitation{
+		TargetAddress: target,
+		Options: layers.ICMPv6Options{
+			{Type: layers.ICMPv6OptSourceAddress, Data: iface.HardwareAddr},
+		},
+	}
+	buf := gopacket.NewSerializeBuffer()
+	err = gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip6, icmp6, ns)
+	if err != nil {
+		return nil, utils.Errorf("serialize ndp packet failed: %s", err)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,39 @@
+package arpx
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/require"
+)
+
+func buildNeighborAdvertisement(t *testing.T, withOption bool) gopacket.Packet {
+	srcMac := net.HardwareAddr{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}
+	target := net.ParseIP("fe80::211:22ff:fe33:4455")
+	eth := &layers.Ethernet{SrcMAC: srcMac, DstMAC: net.HardwareAddr{0x33, 0x33, 0, 0, 0, 1}, EthernetType: layers.EthernetTypeIPv6}
+	ip6 := &layers.IPv6{Version: 6, NextHeader: layers.IPProtocolICMPv6, HopLimit: 255, SrcIP: target, DstIP: net.ParseIP("ff02::1")}
+	icmp6 := &layers.ICMPv6{TypeCode: layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)}
+	require.NoError(t, icmp6.SetNetworkLayerForChecksum(ip6))
+	na := &layers.ICMPv6NeighborAdvertisement{Flags: 0x60, TargetAddress: target}
+	if withOption {
+		na.Options = layers.ICMPv6Options{{Type: layers.ICMPv6OptTargetAddress, Data: net.HardwareAddr{0xaa, 0xbb, 0xcc, 0xdd, 0xee, 0xff}}}
+	}
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true}, eth, ip6, icmp6, na))
+	return gopacket.NewPacket(buf.Bytes(), layers.LayerTypeEthernet, gopacket.Default)
+}
+
+func TestParseNeighborAdvertisement(t *testing.T) {
+	ip, hw, ok := ParseNeighborAdvertisement(buildNeighborAdvertisement(t, true))
+	require.True(t, ok)
+	require.Equal(t, "fe80::211:22ff:fe33:4455", ip.String())
+	require.Equal(t, "aa:bb:cc:dd:ee:ff", hw.String())
+
+	// 没有 Target Link-Layer Address 选项时回退到以太网源地址
+	ip, hw, ok = ParseNeighborAdvertisement(buildNeighborAdvertisement(t, false))
+	require.True(t, ok)
+	require.Equal(t, "fe80::211:22ff:fe33:4455", ip.String())
+	require.Equal(t, "00:11:22:33:44:55", hw.String())
+}
@@ -17,5 +17,10 @@ func RouteAndArpWithTimeout(t time.Duration, target string) (net.HardwareAddr, e
 		return iface.HardwareAddr, nil
 	}
 
+	// 目标与本机直连（常见于 IPv6 同链路）时没有网关，直接解析目标本身
+	if targetIP == nil || targetIP.IsUnspecified() {
+		return ArpWithTimeout(t, iface.Name, utils.FixForParseIP(target))
+	}
+
 	return ArpWithTimeout(t, iface.Name, targetIP.String())
 }
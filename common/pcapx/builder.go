@@ -3,7 +3,6 @@ package pcapx
 import (
 	"github.com/google/gopacket"
 	"github.com/google/gopacket/layers"
-	"github.com/yaklang/yaklang/common/go-funk"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"net"
@@ -48,10 +47,12 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		udpConfig   *layers.UDP
 		tcpConfig   *layers.TCP
 		icmp4Config *layers.ICMPv4
+		icmp6Config *ICMPv6Config
 
 		// link and network
 		arpConfig      *layers.ARP
 		ip4Config      *layers.IPv4
+		ip6Config      *layers.IPv6
 		ethernetConfig *layers.Ethernet
 		loopbackConfig *layers.Loopback
 	)
@@ -83,6 +84,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set icmp4 config failed: %s", err)
 			}
+		case ICMPv6Option:
+			if icmp6Config == nil {
+				icmp6Config = NewDefaultICMPv6Config()
+			}
+			err := optFunc(icmp6Config)
+			if err != nil {
+				return nil, utils.Errorf("set icmp6 config failed: %s", err)
+			}
 		case ArpConfig:
 			if arpConfig == nil {
 				arpConfig = &layers.ARP{
@@ -104,6 +113,14 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 			if err != nil {
 				return nil, utils.Errorf("set ipv4 config failed: %s", err)
 			}
+		case IPv6Option:
+			if ip6Config == nil {
+				ip6Config = NewDefaultIPv6Layer()
+			}
+			err := optFunc(ip6Config)
+			if err != nil {
+				return nil, utils.Errorf("set ipv6 config failed: %s", err)
+			}
 		case EthernetOption:
 			if ethernetConfig == nil {
 				ethernetConfig = &layers.Ethernet{
@@ -133,6 +150,31 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		}
 	}
 
+	/*
+		check network layer: only one of arp / ipv4 / ipv6 is allowed
+	*/
+	var networkLayerCount int
+	for _, l := range []bool{arpConfig != nil, ip4Config != nil, ip6Config != nil} {
+		if l {
+			networkLayerCount++
+		}
+	}
+	if networkLayerCount > 1 {
+		return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / ipv6 / arp layer")
+	}
+	// ICMPv6 without explicit ip layer, use ipv6
+	if networkLayerCount == 0 && icmp6Config != nil {
+		ip6Config = NewDefaultIPv6Layer()
+	}
+	if ip6Config != nil && icmp6Config != nil {
+		if ip6Config.DstIP == nil {
+			ip6Config.DstIP = icmp6Config.defaultDstIP()
+		}
+		if icmp6Config.isNDP() {
+			ip6Config.HopLimit = 255
+		}
+	}
+
 	/**
 	LinkLayer can be Ethernet(Default) or Loopback
 	*/
@@ -143,86 +185,118 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 				Family: layers.ProtocolFamilyIPv4,
 			}
 		}
+		if ip6Config != nil && loopbackConfig.Family == layers.ProtocolFamilyIPv4 {
+			loopbackConfig.Family = loopbackFamilyIPv6()
+		}
 		linkLayer = loopbackConfig
-	} else if ethernetConfig != nil {
-		linkLayer = ethernetConfig
 	} else {
-		var err error
-		linkLayer, err = GetPublicToServerLinkLayerIPv4()
-		if err != nil {
-			log.Errorf("PacketBuilder: %v", err)
-			linkLayer = &layers.Ethernet{
+		var ethernet *layers.Ethernet
+		if ethernetConfig != nil {
+			ethernet = ethernetConfig
+		} else if ip6Config != nil && ip6Config.DstIP != nil && ip6Config.DstIP.IsMulticast() {
+			// 组播不需要解析下一跳，直接使用组播 MAC
+			ethernet = &layers.Ethernet{SrcMAC: net.HardwareAddr{0, 0, 0, 0, 0, 1}}
+			if iface, _, _, err := getPublicRouteIPv6(); err == nil {
+				ethernet.SrcMAC = iface.HardwareAddr
+			}
+		} else {
+			var err error
+			if ip6Config != nil {
+				ethernet, err = GetPublicToServerLinkLayerIPv6()
+			} else {
+				ethernet, err = GetPublicToServerLinkLayerIPv4()
+			}
+			if err != nil {
+				log.Errorf("PacketBuilder: %v", err)
+			}
+		}
+		if ethernet == nil {
+			ethernet = &layers.Ethernet{
 				EthernetType: layers.EthernetTypeIPv4,
 				SrcMAC:       net.HardwareAddr{0, 0, 0, 0, 0, 1},
 				DstMAC:       net.HardwareAddr{0, 0, 0, 0, 0, 2},
 			}
 		}
-	}
-
-	/*
-		check network layer?
-	*/
-	var fetched = false
-	var networkLayerRaw any
-	for _, l := range []any{
-		arpConfig, ip4Config,
-	} {
-		if funk.IsEmpty(l) {
-			if fetched {
-				return nil, utils.Errorf("PacketBuilder: only one network layer is allowed, need ip / arp layer")
-			} else {
-				fetched = true
-				networkLayerRaw = l
+		if ip6Config != nil {
+			ethernet.EthernetType = layers.EthernetTypeIPv6
+			if len(ethernet.DstMAC) == 0 && ip6Config.DstIP != nil && ip6Config.DstIP.IsMulticast() {
+				ethernet.DstMAC = IPv6MulticastMAC(ip6Config.DstIP)
 			}
 		}
-	}
-	if networkLayerRaw == nil {
-		return nil, utils.Errorf("PacketBuilder: network layer is empty")
+		linkLayer = ethernet
 	}
 
 	var networkLayer gopacket.SerializableLayer
 	var ipEnabled bool
-	if !funk.IsEmpty(ip4Config) {
+	if ip4Config != nil {
 		ipEnabled = true
 		networkLayer = ip4Config
-		if ip4Config.Version == 6 {
-			linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeIPv6
+		if eth, ok := linkLayer.(*layers.Ethernet); ok && ip4Config.Version == 6 {
+			eth.EthernetType = layers.EthernetTypeIPv6
 		}
-	} else if !funk.IsEmpty(arpConfig) {
+	} else if ip6Config != nil {
+		ipEnabled = true
+		networkLayer = ip6Config
+	} else if arpConfig != nil {
 		networkLayer = arpConfig
-		linkLayer.(*layers.Ethernet).EthernetType = layers.EthernetTypeARP
+		if eth, ok := linkLayer.(*layers.Ethernet); ok {
+			eth.EthernetType = layers.EthernetTypeARP
+		}
 	} else {
 		return nil, utils.Errorf("PacketBuilder: network layer is empty")
 	}
-	_ = networkLayer
+
+	// setIPProtocol 同时兼容 IPv4 Protocol 与 IPv6 NextHeader
+	setIPProtocol := func(protocol layers.IPProtocol) {
+		switch ret := networkLayer.(type) {
+		case *layers.IPv4:
+			ret.Protocol = protocol
+		case *layers.IPv6:
+			ret.NextHeader = protocol
+		}
+	}
 
 	var err error
 	if ipEnabled {
 		// TCP/IP Stack!
-		// TransportLayer can be TCP(Default) / ICMP / IGMP / UDP ...
-		var transportLayer gopacket.SerializableLayer
+		// TransportLayer can be TCP(Default) / ICMP / ICMPv6 / IGMP / UDP ...
+		ipLayer := networkLayer.(gopacket.NetworkLayer)
+		var transportLayers []gopacket.SerializableLayer
 	TRANS:
 		if tcpConfig != nil {
 			tcpLayer := tcpConfig
-			ip4Layer := networkLayer.(*layers.IPv4)
-			err := tcpLayer.SetNetworkLayerForChecksum(ip4Layer)
+			err := tcpLayer.SetNetworkLayerForChecksum(ipLayer)
 			if err != nil {
 				return nil, utils.Errorf("TCP checksum failed: %s", err)
 			}
-			ip4Layer.Protocol = layers.IPProtocolTCP
-			transportLayer = tcpLayer
+			setIPProtocol(layers.IPProtocolTCP)
+			transportLayers = append(transportLayers, tcpLayer)
 		} else if icmp4Config != nil {
-			transportLayer = icmp4Config
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolICMPv4
+			if ip6Config != nil {
+				return nil, utils.Errorf("PacketBuilder: icmpv4 cannot be carried by ipv6, use icmp6 options")
+			}
+			transportLayers = append(transportLayers, icmp4Config)
+			setIPProtocol(layers.IPProtocolICMPv4)
+		} else if icmp6Config != nil {
+			if ip6Config == nil {
+				return nil, utils.Errorf("PacketBuilder: icmpv6 need ipv6 layer")
+			}
+			err := icmp6Config.ICMPv6.SetNetworkLayerForChecksum(ipLayer)
+			if err != nil {
+				return nil, utils.Errorf("ICMPv6 checksum failed: %s", err)
+			}
+			setIPProtocol(layers.IPProtocolICMPv6)
+			transportLayers = append(transportLayers, icmp6Config.ICMPv6)
+			if icmp6Config.Message != nil {
+				transportLayers = append(transportLayers, icmp6Config.Message)
+			}
 		} else if udpConfig != nil {
-			ip4Layer := networkLayer.(*layers.IPv4)
-			ip4Layer.Protocol = layers.IPProtocolUDP
-			err := udpConfig.SetNetworkLayerForChecksum(ip4Layer)
+			setIPProtocol(layers.IPProtocolUDP)
+			err := udpConfig.SetNetworkLayerForChecksum(ipLayer)
 			if err != nil {
 				return nil, utils.Errorf("UDP checksum failed: %s", err)
 			}
-			transportLayer = udpConfig
+			transportLayers = append(transportLayers, udpConfig)
 		} else {
 			log.Warn("PacketBuilder: tcp layer is empty, use default")
 			tcpConfig = NewDefaultTCPLayer()
@@ -235,7 +309,7 @@ func PacketBuilder(opts ...any) ([]byte, error) {
 		var buf = gopacket.NewSerializeBuffer()
 		err = gopacket.SerializeLayers(
 			buf, gopacket.SerializeOptions{FixLengths: true, ComputeChecksums: true},
-			linkLayer, networkLayer, transportLayer, gopacket.Payload(baseConfig.Payload),
+			append(append([]gopacket.SerializableLayer{linkLayer, networkLayer}, transportLayers...), gopacket.Payload(baseConfig.Payload))...,
 		)
 		if err != nil {
 			return nil, utils.Errorf(`gopacket.SerializeLayers failed: %s`, err)
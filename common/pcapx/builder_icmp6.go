@@ -0,0 +1,100 @@
+package pcapx
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/yaklang/yaklang/common/go-funk"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var icmp6LayerExports = map[string]any{
+	"ICMPV6_TYPE_DEST_UNREACH":           layers.ICMPv6TypeDestinationUnreachable,
+	"ICMPV6_TYPE_PACKET_TOO_BIG":         layers.ICMPv6TypePacketTooBig,
+	"ICMPV6_TYPE_TIME_EXCEEDED":          layers.ICMPv6TypeTimeExceeded,
+	"ICMPV6_TYPE_PARAM_PROBLEM":          layers.ICMPv6TypeParameterProblem,
+	"ICMPV6_TYPE_ECHO_REQUEST":           layers.ICMPv6TypeEchoRequest,
+	"ICMPV6_TYPE_ECHO_REPLY":             layers.ICMPv6TypeEchoReply,
+	"ICMPV6_TYPE_ROUTER_SOLICITATION":    layers.ICMPv6TypeRouterSolicitation,
+	"ICMPV6_TYPE_ROUTER_ADVERTISEMENT":   layers.ICMPv6TypeRouterAdvertisement,
+	"ICMPV6_TYPE_NEIGHBOR_SOLICITATION":  layers.ICMPv6TypeNeighborSolicitation,
+	"ICMPV6_TYPE_NEIGHBOR_ADVERTISEMENT": layers.ICMPv6TypeNeighborAdvertisement,
+	"ICMPV6_TYPE_REDIRECT":               layers.ICMPv6TypeRedirect,
+	"ICMPV6_CODE_UNREACH_NO_ROUTE":       layers.ICMPv6CodeNoRouteToDst,
+	"ICMPV6_CODE_UNREACH_ADMIN":          layers.ICMPv6CodeAdminProhibited,
+	"ICMPV6_CODE_UNREACH_ADDRESS":        layers.ICMPv6CodeAddressUnreachable,
+	"ICMPV6_CODE_UNREACH_PORT":           layers.ICMPv6CodePortUnreachable,
+	"ICMPV6_CODE_TIME_EXCEEDED_HOP":      layers.ICMPv6CodeHopLimitExceeded,
+	"ICMPV6_CODE_TIME_EXCEEDED_FRAG":     layers.ICMPv6CodeFragmentReassemblyTimeExceeded,
+
+	"icmp6_type": WithICMPv6_Type,
+	"icmp6_id":   WithICMPv6_Id,
+	"icmp6_seq":  WithICMPv6_Sequence,
+}
+
+func init() {
+	for k, v := range icmp6LayerExports {
+		Exports[k] = v
+	}
+}
+
+// ICMPv6Config ICMPv6 头部以及紧随其后的消息体（Echo / NDP 等）
+// gopacket 中这两部分是两个独立的 layer，序列化时按顺序拼接
+type ICMPv6Config struct {
+	ICMPv6  *layers.ICMPv6
+	Message gopacket.SerializableLayer
+}
+
+type ICMPv6Option func(config *ICMPv6Config) error
+
+func NewDefaultICMPv6Config() *ICMPv6Config {
+	return &ICMPv6Config{ICMPv6: &layers.ICMPv6{}}
+}
+
+func WithICMPv6_Type(icmpType any, icmpCode any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		if funk.IsEmpty(icmpCode) {
+			icmpCode = 0
+		}
+		config.ICMPv6.TypeCode = layers.CreateICMPv6TypeCode(uint8(utils.InterfaceToInt(icmpType)), uint8(utils.InterfaceToInt(icmpCode)))
+		return nil
+	}
+}
+
+func (c *ICMPv6Config) echo() *layers.ICMPv6Echo {
+	if echo, ok := c.Message.(*layers.ICMPv6Echo); ok {
+		return echo
+	}
+	echo := &layers.ICMPv6Echo{}
+	c.Message = echo
+	if c.ICMPv6.TypeCode == 0 {
+		c.ICMPv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeEchoRequest, 0)
+	}
+	return echo
+}
+
+// WithICMPv6_Id 设置 Echo 的 Identifier，未指定类型时默认为 Echo Request
+func WithICMPv6_Id(id any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		config.echo().Identifier = uint16(utils.InterfaceToInt(id))
+		return nil
+	}
+}
+
+// WithICMPv6_Sequence 设置 Echo 的序列号，未指定类型时默认为 Echo Request
+func WithICMPv6_Sequence(sequence any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		config.echo().SeqNumber = uint16(utils.InterfaceToInt(sequence))
+		return nil
+	}
+}
+
+// isNDP 判断是否为邻居发现协议报文，NDP 报文要求 Hop Limit 必须为 255
+func (c *ICMPv6Config) isNDP() bool {
+	switch c.ICMPv6.TypeCode.Type() {
+	case layers.ICMPv6TypeRouterSolicitation, layers.ICMPv6TypeRouterAdvertisement,
+		layers.ICMPv6TypeNeighborSolicitation, layers.ICMPv6TypeNeighborAdvertisement,
+		layers.ICMPv6TypeRedirect:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package pcapx
+
+import (
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSmoking_ICMPv6Echo(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithIPv6_DstIP("2001:db8::2"),
+		WithICMPv6_Id(1234),
+		WithICMPv6_Sequence(7),
+		WithPayload([]byte("hello yakit pcapx world")),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, layers.IPProtocolICMPv6, ip6.NextHeader)
+	icmp := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6)
+	require.Equal(t, uint8(layers.ICMPv6TypeEchoRequest), icmp.TypeCode.Type())
+	echo := packet.Layer(layers.LayerTypeICMPv6Echo).(*layers.ICMPv6Echo)
+	require.EqualValues(t, 1234, echo.Identifier)
+	require.EqualValues(t, 7, echo.SeqNumber)
+	// gopacket 解析 Echo 时不会设置 payload，这里直接从 ICMPv6 的 payload 中截取
+	require.Equal(t, "hello yakit pcapx world", string(icmp.Payload[4:]))
+}
+
+func TestSmoking_NDPNeighborSolicitation(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithIPv6_SrcIP("fe80::211:22ff:fe33:4455"),
+		WithNDP_NeighborSolicitation("fe80::1:23ab:cdef", "00:11:22:33:44:55"),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+
+	eth := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	require.Equal(t, layers.EthernetTypeIPv6, eth.EthernetType)
+	require.Equal(t, "33:33:ff:ab:cd:ef", eth.DstMAC.String())
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, "ff02::1:ffab:cdef", ip6.DstIP.String())
+	require.EqualValues(t, 255, ip6.HopLimit)
+
+	ns := packet.Layer(layers.LayerTypeICMPv6NeighborSolicitation).(*layers.ICMPv6NeighborSolicitation)
+	require.Equal(t, "fe80::1:23ab:cdef", ns.TargetAddress.String())
+	require.Len(t, ns.Options, 1)
+	require.Equal(t, layers.ICMPv6OptSourceAddress, ns.Options[0].Type)
+	require.Equal(t, []byte{0x00, 0x11, 0x22, 0x33, 0x44, 0x55}, ns.Options[0].Data)
+
+	// 校验和需要覆盖 ICMPv6 头部以及 NS 消息体
+	icmp := packet.Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6)
+	checksum := icmp.Checksum
+	require.NoError(t, icmp.SetNetworkLayerForChecksum(ip6))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{ComputeChecksums: true}, icmp, ns))
+	require.Equal(t, checksum, gopacket.NewPacket(buf.Bytes(), layers.LayerTypeICMPv6, gopacket.Default).Layer(layers.LayerTypeICMPv6).(*layers.ICMPv6).Checksum)
+}
+
+func TestSmoking_NDPNeighborAdvertisement(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithIPv6_SrcIP("fe80::211:22ff:fe33:4455"),
+		WithNDP_NeighborAdvertisement("fe80::211:22ff:fe33:4455", "00:11:22:33:44:55", NDPFlagSolicited|NDPFlagOverride),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	require.Equal(t, "ff02::1", packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6).DstIP.String())
+	require.Equal(t, "33:33:00:00:00:01", packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet).DstMAC.String())
+	na := packet.Layer(layers.LayerTypeICMPv6NeighborAdvertisement).(*layers.ICMPv6NeighborAdvertisement)
+	require.True(t, na.Solicited())
+	require.True(t, na.Override())
+	require.False(t, na.Router())
+}
+
+func TestPacketBuilder_ICMPv4OverIPv6(t *testing.T) {
+	_, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithIPv6_DstIP("2001:db8::2"),
+		WithICMP_Type(layers.ICMPv4TypeEchoRequest, nil),
+	)
+	require.Error(t, err)
+}
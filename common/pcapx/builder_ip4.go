@@ -180,65 +180,76 @@ func WithIPv4_DstIP(i any) IPv4Option {
 
 func WithIPv4_NextProtocol(i any) IPv4Option {
 	return func(pv4 *layers.IPv4) error {
-		strI := utils.InterfaceToString(i)
-		switch strings.ToLower(strI) {
-		case "ipv6_hop_by_hop":
-			pv4.Protocol = layers.IPProtocolIPv6HopByHop
-		case "icmp", "icmp4", "icmpv4", "icmp_v4":
-			pv4.Protocol = layers.IPProtocolICMPv4
-		case "igmp":
-			pv4.Protocol = layers.IPProtocolIGMP
-		case "ipv4", "ip4": // ?
-			pv4.Protocol = layers.IPProtocolIPv4
-		case "tcp":
-			pv4.Protocol = layers.IPProtocolTCP
-		case "udp":
-			pv4.Protocol = layers.IPProtocolUDP
-		case "rudp":
-			pv4.Protocol = layers.IPProtocolRUDP
-		case "ipv6", "ip6": // ?
-			pv4.Protocol = layers.IPProtocolIPv6
-		case "ipv6_routing":
-			pv4.Protocol = layers.IPProtocolIPv6Routing
-		case "ipv6_fragment":
-			pv4.Protocol = layers.IPProtocolIPv6Fragment
-		case "ipv6_icmp", "icmp6", "icmp_v6":
-			pv4.Protocol = layers.IPProtocolICMPv6
-		case "no_next_header":
-			pv4.Protocol = layers.IPProtocolNoNextHeader
-		case "ipv6_destination":
-			pv4.Protocol = layers.IPProtocolIPv6Destination
-		case "gre":
-			pv4.Protocol = layers.IPProtocolGRE
-		case "esp":
-			pv4.Protocol = layers.IPProtocolESP
-		case "ah":
-			pv4.Protocol = layers.IPProtocolAH
-		case "ospf":
-			pv4.Protocol = layers.IPProtocolOSPF
-		case "ipip":
-			pv4.Protocol = layers.IPProtocolIPIP
-		case "etherip":
-			pv4.Protocol = layers.IPProtocolEtherIP
-		case "vrrp":
-			pv4.Protocol = layers.IPProtocolVRRP
-		case "sctp":
-			pv4.Protocol = layers.IPProtocolSCTP
-		case "udplite":
-			pv4.Protocol = layers.IPProtocolUDPLite
-		case "mplsinip":
-			pv4.Protocol = layers.IPProtocolMPLSInIP
-		default:
-			if utils.MatchAllOfRegexp(i, `\d+`) {
-				pv4.Protocol = layers.IPProtocol(utils.InterfaceToInt(i))
-				return nil
-			}
-			return utils.Errorf("unknown parse ip_protocol: %v", i)
+		protocol, err := parseIPProtocol(i)
+		if err != nil {
+			return err
 		}
+		pv4.Protocol = protocol
 		return nil
 	}
 }
 
+// parseIPProtocol 解析协议名或协议号，IPv4 Protocol 与 IPv6 NextHeader 共用
+func parseIPProtocol(i any) (layers.IPProtocol, error) {
+	if ret, ok := i.(layers.IPProtocol); ok {
+		return ret, nil
+	}
+	strI := utils.InterfaceToString(i)
+	switch strings.ToLower(strI) {
+	case "ipv6_hop_by_hop", "ipv6hopbyhop":
+		return layers.IPProtocolIPv6HopByHop, nil
+	case "icmp", "icmp4", "icmpv4", "icmp_v4":
+		return layers.IPProtocolICMPv4, nil
+	case "igmp":
+		return layers.IPProtocolIGMP, nil
+	case "ipv4", "ip4": // ?
+		return layers.IPProtocolIPv4, nil
+	case "tcp":
+		return layers.IPProtocolTCP, nil
+	case "udp":
+		return layers.IPProtocolUDP, nil
+	case "rudp":
+		return layers.IPProtocolRUDP, nil
+	case "ipv6", "ip6": // ?
+		return layers.IPProtocolIPv6, nil
+	case "ipv6_routing", "ipv6routing":
+		return layers.IPProtocolIPv6Routing, nil
+	case "ipv6_fragment", "ipv6fragment":
+		return layers.IPProtocolIPv6Fragment, nil
+	case "ipv6_icmp", "icmp6", "icmpv6", "icmp_v6":
+		return layers.IPProtocolICMPv6, nil
+	case "no_next_header", "nonextheader":
+		return layers.IPProtocolNoNextHeader, nil
+	case "ipv6_destination", "ipv6destination":
+		return layers.IPProtocolIPv6Destination, nil
+	case "gre":
+		return layers.IPProtocolGRE, nil
+	case "esp":
+		return layers.IPProtocolESP, nil
+	case "ah":
+		return layers.IPProtocolAH, nil
+	case "ospf":
+		return layers.IPProtocolOSPF, nil
+	case "ipip":
+		return layers.IPProtocolIPIP, nil
+	case "etherip":
+		return layers.IPProtocolEtherIP, nil
+	case "vrrp":
+		return layers.IPProtocolVRRP, nil
+	case "sctp":
+		return layers.IPProtocolSCTP, nil
+	case "udplite":
+		return layers.IPProtocolUDPLite, nil
+	case "mplsinip":
+		return layers.IPProtocolMPLSInIP, nil
+	default:
+		if utils.MatchAllOfRegexp(i, `\d+`) {
+			return layers.IPProtocol(utils.InterfaceToInt(i)), nil
+		}
+		return 0, utils.Errorf("unknown parse ip_protocol: %v", i)
+	}
+}
+
 func WithIPv4_Option(optType any, data []byte) IPv4Option {
 	return func(pv4 *layers.IPv4) error {
 		if len(data)+2 > 255 {
@@ -0,0 +1,162 @@
+package pcapx
+
+import (
+	"github.com/google/gopacket/layers"
+	"github.com/yaklang/yaklang/common/utils"
+	"net"
+)
+
+var ipv6LayerExports = map[string]any{
+	"ipv6_trafficClass":   WithIPv6_TrafficClass,
+	"ipv6_flowLabel":      WithIPv6_FlowLabel,
+	"ipv6_nextHeader":     WithIPv6_NextHeader,
+	"ipv6_hopLimit":       WithIPv6_HopLimit,
+	"ipv6_srcIp":          WithIPv6_SrcIP,
+	"ipv6_dstIp":          WithIPv6_DstIP,
+	"ipv6_solicitedNode":  IPv6SolicitedNodeMulticast,
+	"ipv6_multicastMac":   IPv6MulticastMAC,
+	"ipv6_isLinkLocal":    IsIPv6LinkLocal,
+	"IPV6_ALL_NODES":      IPv6AllNodesMulticast.String(),
+	"IPV6_ALL_ROUTERS":    IPv6AllRoutersMulticast.String(),
+	"IPV6_PROTOCOL_TCP":   int(layers.IPProtocolTCP),
+	"IPV6_PROTOCOL_UDP":   int(layers.IPProtocolUDP),
+	"IPV6_PROTOCOL_ICMP":  int(layers.IPProtocolICMPv6),
+	"IPV6_PROTOCOL_NONXT": int(layers.IPProtocolNoNextHeader),
+}
+
+func init() {
+	for k, v := range ipv6LayerExports {
+		Exports[k] = v
+	}
+}
+
+var (
+	// IPv6AllNodesMulticast ff02::1，链路上的所有节点
+	IPv6AllNodesMulticast = net.ParseIP("ff02::1")
+	// IPv6AllRoutersMulticast ff02::2，链路上的所有路由器
+	IPv6AllRoutersMulticast = net.ParseIP("ff02::2")
+)
+
+type IPv6Option func(pv6 *layers.IPv6) error
+
+func NewDefaultIPv6Layer() *layers.IPv6 {
+	return &layers.IPv6{
+		Version:    6,
+		HopLimit:   64,
+		NextHeader: layers.IPProtocolTCP,
+	}
+}
+
+/*
+// IPv6 is the layer for the IPv6 header.
+type IPv6 struct {
+	// http://www.networksorcery.com/enp/protocol/ipv6.htm
+	BaseLayer
+	Version      uint8
+	TrafficClass uint8
+	FlowLabel    uint32
+	Length       uint16
+	NextHeader   IPProtocol
+	HopLimit     uint8
+	SrcIP        net.IP
+	DstIP        net.IP
+	HopByHop     *IPv6HopByHop
+	// hbh will be pointed to by HopByHop if that layer exists.
+	hbh IPv6HopByHop
+}
+
+一般来说，不需要操作的字段有：Version / Length，NextHeader 会根据上层协议自动修正
+*/
+
+func WithIPv6_TrafficClass(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.TrafficClass = uint8(utils.InterfaceToInt(i))
+		return nil
+	}
+}
+
+func WithIPv6_FlowLabel(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		// flow label 只有 20 位
+		pv6.FlowLabel = uint32(utils.InterfaceToInt(i)) & 0xfffff
+		return nil
+	}
+}
+
+func WithIPv6_HopLimit(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.HopLimit = uint8(utils.InterfaceToInt(i))
+		return nil
+	}
+}
+
+func WithIPv6_NextHeader(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		protocol, err := parseIPProtocol(i)
+		if err != nil {
+			return err
+		}
+		pv6.NextHeader = protocol
+		return nil
+	}
+}
+
+func WithIPv6_SrcIP(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.SrcIP = parseIPv6(i)
+		if pv6.SrcIP == nil {
+			return utils.Errorf("WithIPv6_SrcIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+func WithIPv6_DstIP(i any) IPv6Option {
+	return func(pv6 *layers.IPv6) error {
+		pv6.DstIP = parseIPv6(i)
+		if pv6.DstIP == nil {
+			return utils.Errorf("WithIPv6_DstIP error: %v", i)
+		}
+		return nil
+	}
+}
+
+func parseIPv6(i any) net.IP {
+	var ip net.IP
+	switch ret := i.(type) {
+	case net.IP:
+		ip = ret
+	default:
+		ip = net.ParseIP(utils.FixForParseIP(utils.InterfaceToString(i)))
+	}
+	if ip == nil || ip.To4() != nil {
+		return nil
+	}
+	return ip.To16()
+}
+
+// IPv6SolicitedNodeMulticast 计算 IPv6 地址对应的请求节点组播地址（ff02::1:ffXX:XXXX），NDP 邻居请求发往此地址
+func IPv6SolicitedNodeMulticast(i any) net.IP {
+	ip := parseIPv6(i)
+	if ip == nil {
+		return nil
+	}
+	ret := net.ParseIP("ff02::1:ff00:0")
+	copy(ret[13:], ip[13:])
+	return ret
+}
+
+// IPv6MulticastMAC 计算 IPv6 组播地址对应的以太网组播 MAC（33:33:XX:XX:XX:XX）
+func IPv6MulticastMAC(i any) net.HardwareAddr {
+	ip := parseIPv6(i)
+	if ip == nil {
+		return nil
+	}
+	return net.HardwareAddr{0x33, 0x33, ip[12], ip[13], ip[14], ip[15]}
+}
+
+// IsIPv6LinkLocal 判断是否为 IPv6 链路本地地址（fe80::/10）
+func IsIPv6LinkLocal(i any) bool {
+	ip := parseIPv6(i)
+	return ip != nil && ip.IsLinkLocalUnicast()
+}
@@ -0,0 +1,77 @@
+package pcapx
+
+import (
+	"fmt"
+	"github.com/google/gopacket"
+	"github.com/google/gopacket/layers"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestSmoking_IPv6TCP(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithIPv6_DstIP("2001:db8::2"),
+		WithTCP_SrcPort(40000),
+		WithTCP_DstPort(443),
+		WithTCP_Flags(TCP_FLAG_SYN),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	fmt.Println(packet.String())
+
+	eth := packet.Layer(layers.LayerTypeEthernet).(*layers.Ethernet)
+	require.Equal(t, layers.EthernetTypeIPv6, eth.EthernetType)
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, layers.IPProtocolTCP, ip6.NextHeader)
+	require.Equal(t, "2001:db8::2", ip6.DstIP.String())
+	tcp := packet.Layer(layers.LayerTypeTCP).(*layers.TCP)
+	require.True(t, tcp.SYN)
+	require.EqualValues(t, 443, tcp.DstPort)
+
+	// 重新计算校验和，确认伪首部使用的是 IPv6
+	checksum := tcp.Checksum
+	require.NoError(t, tcp.SetNetworkLayerForChecksum(ip6))
+	buf := gopacket.NewSerializeBuffer()
+	require.NoError(t, gopacket.SerializeLayers(buf, gopacket.SerializeOptions{ComputeChecksums: true, FixLengths: true}, tcp))
+	require.Equal(t, checksum, gopacket.NewPacket(buf.Bytes(), layers.LayerTypeTCP, gopacket.Default).Layer(layers.LayerTypeTCP).(*layers.TCP).Checksum)
+}
+
+func TestSmoking_IPv6UDP(t *testing.T) {
+	packets, err := PacketBuilder(
+		WithEthernet_SrcMac("00:11:22:33:44:55"),
+		WithEthernet_DstMac("66:77:88:99:aa:bb"),
+		WithIPv6_SrcIP("2001:db8::1"),
+		WithIPv6_DstIP("2001:db8::2"),
+		WithIPv6_HopLimit(32),
+		WithUDP_DstPort(9999),
+		WithPayload([]byte("hello")),
+	)
+	require.NoError(t, err)
+	packet := gopacket.NewPacket(packets, layers.LayerTypeEthernet, gopacket.Default)
+	require.Nil(t, packet.ErrorLayer())
+	ip6 := packet.Layer(layers.LayerTypeIPv6).(*layers.IPv6)
+	require.Equal(t, layers.IPProtocolUDP, ip6.NextHeader)
+	require.EqualValues(t, 32, ip6.HopLimit)
+	require.Equal(t, "hello", string(packet.ApplicationLayer().Payload()))
+}
+
+func TestIPv6_Multicast(t *testing.T) {
+	require.Equal(t, "ff02::1:ff00:2", IPv6SolicitedNodeMulticast("2001:db8::2").String())
+	require.Equal(t, "ff02::1:ffab:cdef", IPv6SolicitedNodeMulticast("fe80::1:23ab:cdef").String())
+	require.Equal(t, "33:33:ff:ab:cd:ef", IPv6MulticastMAC("ff02::1:ffab:cdef").String())
+	require.Nil(t, IPv6SolicitedNodeMulticast("1.1.1.1"))
+	require.True(t, IsIPv6LinkLocal("fe80::1"))
+	require.False(t, IsIPv6LinkLocal("2001:db8::1"))
+}
+
+func TestPacketBuilder_MultipleNetworkLayer(t *testing.T) {
+	_, err := PacketBuilder(
+		WithIPv4_SrcIP("1.1.1.1"),
+		WithIPv6_SrcIP("2001:db8::1"),
+	)
+	require.Error(t, err)
+}
@@ -3,6 +3,7 @@ package pcapx
 import (
 	"github.com/google/gopacket/layers"
 	"github.com/yaklang/yaklang/common/utils"
+	"runtime"
 )
 
 var loopbackLayerExports = map[string]interface{}{
@@ -10,7 +11,6 @@ var loopbackLayerExports = map[string]interface{}{
 	"loopback_family":  WithLoopback_Family,
 }
 
-
 func init() {
 	for k, v := range loopbackLayerExports {
 		Exports[k] = v
@@ -19,7 +19,6 @@ func init() {
 
 type LoopbackOption func(config *layers.Loopback) error
 
-
 func WithLoopback_Payload(payload []byte) LoopbackOption {
 	return func(config *layers.Loopback) error {
 		config.Payload = payload
@@ -37,4 +36,21 @@ func WithLoopback_Family(i any) LoopbackOption {
 			return utils.Errorf("invalid link type: %v", i)
 		}
 	}
-}
\ No newline at end of file
+}
+
+// loopbackFamilyIPv6 BSD loopback 头部中 AF_INET6 的取值与操作系统相关
+func loopbackFamilyIPv6() layers.ProtocolFamily {
+	switch runtime.GOOS {
+	case "darwin", "ios":
+		return layers.ProtocolFamilyIPv6Darwin
+	case "freebsd", "dragonfly":
+		return layers.ProtocolFamilyIPv6FreeBSD
+	case "linux", "android":
+		return layers.ProtocolFamilyIPv6Linux
+	case "windows":
+		// npcap loopback 使用 windows 的 AF_INET6
+		return layers.ProtocolFamily(23)
+	default:
+		return layers.ProtocolFamilyIPv6BSD
+	}
+}
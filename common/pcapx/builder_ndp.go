@@ -0,0 +1,127 @@
+package pcapx
+
+import (
+	"github.com/google/gopacket/layers"
+	"github.com/yaklang/yaklang/common/utils"
+	"net"
+)
+
+var ndpLayerExports = map[string]any{
+	"ndp_solicit":       WithNDP_NeighborSolicitation,
+	"ndp_advert":        WithNDP_NeighborAdvertisement,
+	"ndp_routerSolicit": WithNDP_RouterSolicitation,
+
+	"NDP_FLAG_ROUTER":    NDPFlagRouter,
+	"NDP_FLAG_SOLICITED": NDPFlagSolicited,
+	"NDP_FLAG_OVERRIDE":  NDPFlagOverride,
+}
+
+func init() {
+	for k, v := range ndpLayerExports {
+		Exports[k] = v
+	}
+}
+
+// Neighbor Advertisement 的标志位（RFC 4861 4.4）
+const (
+	NDPFlagRouter    = 0x80
+	NDPFlagSolicited = 0x40
+	NDPFlagOverride  = 0x20
+)
+
+func parseMac(i any) (net.HardwareAddr, error) {
+	switch ret := i.(type) {
+	case net.HardwareAddr:
+		return ret, nil
+	case nil:
+		return nil, nil
+	default:
+		if utils.InterfaceToString(ret) == "" {
+			return nil, nil
+		}
+		mac, err := net.ParseMAC(utils.InterfaceToString(ret))
+		if err != nil {
+			return nil, utils.Errorf("parse %v to mac failed: %s", ret, err)
+		}
+		return mac, nil
+	}
+}
+
+// WithNDP_NeighborSolicitation 构造邻居请求（IPv6 中替代 ARP Request）
+// srcMac 会作为 Source Link-Layer Address 选项携带，为空时不携带（用于重复地址检测）
+// 未指定 IPv6 目的地址时，默认发往 target 的请求节点组播地址
+func WithNDP_NeighborSolicitation(target any, srcMac any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		targetIP := parseIPv6(target)
+		if targetIP == nil {
+			return utils.Errorf("invalid ndp target: %v", target)
+		}
+		mac, err := parseMac(srcMac)
+		if err != nil {
+			return err
+		}
+		ns := &layers.ICMPv6NeighborSolicitation{TargetAddress: targetIP}
+		if len(mac) > 0 {
+			ns.Options = append(ns.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptSourceAddress, Data: mac})
+		}
+		config.ICMPv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborSolicitation, 0)
+		config.Message = ns
+		return nil
+	}
+}
+
+// WithNDP_NeighborAdvertisement 构造邻居通告（IPv6 中替代 ARP Reply）
+// flags 可以组合 NDP_FLAG_ROUTER / NDP_FLAG_SOLICITED / NDP_FLAG_OVERRIDE
+// 未指定 IPv6 目的地址时，默认发往所有节点组播地址 ff02::1
+func WithNDP_NeighborAdvertisement(target any, targetMac any, flags any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		targetIP := parseIPv6(target)
+		if targetIP == nil {
+			return utils.Errorf("invalid ndp target: %v", target)
+		}
+		mac, err := parseMac(targetMac)
+		if err != nil {
+			return err
+		}
+		na := &layers.ICMPv6NeighborAdvertisement{
+			Flags:         uint8(utils.InterfaceToInt(flags)),
+			TargetAddress: targetIP,
+		}
+		if len(mac) > 0 {
+			na.Options = append(na.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptTargetAddress, Data: mac})
+		}
+		config.ICMPv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeNeighborAdvertisement, 0)
+		config.Message = na
+		return nil
+	}
+}
+
+// WithNDP_RouterSolicitation 构造路由器请求，默认发往所有路由器组播地址 ff02::2
+func WithNDP_RouterSolicitation(srcMac any) ICMPv6Option {
+	return func(config *ICMPv6Config) error {
+		mac, err := parseMac(srcMac)
+		if err != nil {
+			return err
+		}
+		rs := &layers.ICMPv6RouterSolicitation{}
+		if len(mac) > 0 {
+			rs.Options = append(rs.Options, layers.ICMPv6Option{Type: layers.ICMPv6OptSourceAddress, Data: mac})
+		}
+		config.ICMPv6.TypeCode = layers.CreateICMPv6TypeCode(layers.ICMPv6TypeRouterSolicitation, 0)
+		config.Message = rs
+		return nil
+	}
+}
+
+// defaultDstIP NDP 报文未指定目的地址时使用的组播地址
+func (c *ICMPv6Config) defaultDstIP() net.IP {
+	switch msg := c.Message.(type) {
+	case *layers.ICMPv6NeighborSolicitation:
+		return IPv6SolicitedNodeMulticast(msg.TargetAddress)
+	case *layers.ICMPv6NeighborAdvertisement:
+		return IPv6AllNodesMulticast
+	case *layers.ICMPv6RouterSolicitation:
+		return IPv6AllRoutersMulticast
+	}
+	return nil
+}
@@ -8,8 +8,10 @@ import (
 	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/netutil"
 	"math/rand"
 	"net"
+	"time"
 )
 
 type ChaosTraffic struct {
@@ -27,8 +29,8 @@ type ChaosTraffic struct {
 }
 
 func injectWithError(raw []byte, c *Config) error {
-	if c.Iface == "" && PublicInterface != nil {
-		iface, _, _, err := getPublicRoute()
+	if c.Iface == "" {
+		iface, err := getInjectIface(raw)
 		if err != nil {
 			return utils.Errorf("get default public iface failed: %s", err)
 		}
@@ -42,6 +44,30 @@ func injectWithError(raw []byte, c *Config) error {
 	return injectRaw(c.Iface, raw)
 }
 
+// getInjectIface 未指定网卡时，根据数据包的目的地址选择出口网卡，IPv6 走 IPv6 路由
+func getInjectIface(raw []byte) (*net.Interface, error) {
+	packet := gopacket.NewPacket(raw, layers.LayerTypeEthernet, gopacket.NoCopy)
+	if ipLayer := packet.Layer(layers.LayerTypeIPv6); ipLayer != nil {
+		ip6 := ipLayer.(*layers.IPv6)
+		if ip6.DstIP != nil && !ip6.DstIP.IsMulticast() && !ip6.DstIP.IsUnspecified() {
+			iface, _, _, err := netutil.Route(3*time.Second, ip6.DstIP.String())
+			if err == nil && iface != nil {
+				return iface, nil
+			}
+		}
+		iface, _, _, err := getPublicRouteIPv6()
+		if err == nil {
+			return iface, nil
+		}
+		log.Debugf("get ipv6 public route failed: %s, fallback to ipv4", err)
+	}
+	iface, _, _, err := getPublicRoute()
+	if err != nil {
+		return nil, err
+	}
+	return iface, nil
+}
+
 func RegenerateTCPTraffic(raw []byte, localIPAddress string, opt ...ConfigOption) {
 	c := &Config{}
 	for _, o := range opt {
@@ -3,6 +3,7 @@ package pcapx
 import (
 	"github.com/google/gopacket/layers"
 	"github.com/yaklang/yaklang/common/pcapx/arpx"
+	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/netutil"
 	"net"
 	"time"
@@ -12,8 +13,15 @@ var (
 	PublicGatewayAddress   net.IP
 	PublicPreferredAddress net.IP
 	PublicInterface        *net.Interface
+
+	PublicGatewayAddressIPv6   net.IP
+	PublicPreferredAddressIPv6 net.IP
+	PublicInterfaceIPv6        *net.Interface
 )
 
+// publicIPv6Target 用于查询 IPv6 默认路由的公网地址
+const publicIPv6Target = "2001:4860:4860::8888"
+
 func getPublicRoute() (*net.Interface, net.IP, net.IP, error) {
 	if PublicInterface != nil && PublicGatewayAddress != nil && PublicPreferredAddress != nil {
 		return PublicInterface, PublicGatewayAddress, PublicPreferredAddress, nil
@@ -28,8 +36,30 @@ func getPublicRoute() (*net.Interface, net.IP, net.IP, error) {
 	return iface, gw, ip, nil
 }
 
+func getPublicRouteIPv6() (*net.Interface, net.IP, net.IP, error) {
+	if PublicInterfaceIPv6 != nil && PublicGatewayAddressIPv6 != nil && PublicPreferredAddressIPv6 != nil {
+		return PublicInterfaceIPv6, PublicGatewayAddressIPv6, PublicPreferredAddressIPv6, nil
+	}
+	iface, gw, ip, err := netutil.Route(3*time.Second, publicIPv6Target)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if gw == nil || gw.To4() != nil {
+		return nil, nil, nil, utils.Errorf("cannot found ipv6 gateway via %v", iface.Name)
+	}
+	PublicInterfaceIPv6 = iface
+	PublicPreferredAddressIPv6 = ip
+	PublicGatewayAddressIPv6 = gw
+	return iface, gw, ip, nil
+}
+
+// GetPublicLinkLayer 获取公网出口的链路层，IPv6 通过 NDP 解析网关 MAC，IPv4 通过 ARP
 func GetPublicLinkLayer(t layers.EthernetType, toServer bool) (*layers.Ethernet, error) {
-	iface, gw, _, err := getPublicRoute()
+	route := getPublicRoute
+	if t == layers.EthernetTypeIPv6 {
+		route = getPublicRouteIPv6
+	}
+	iface, gw, _, err := route()
 	if err != nil {
 		return nil, err
 	}
@@ -67,11 +97,17 @@ func GetPublicToServerLinkLayerIPv4() (*layers.Ethernet, error) {
 
 func GetPublicToServerLinkLayerIPv6() (*layers.Ethernet, error) {
 	if ethIPv6ToServer != nil {
-		return ethIPv6ToServer, nil
+		ethernet := *ethIPv6ToServer
+		return &ethernet, nil
 	}
 	var err error
 	ethIPv6ToServer, err = GetPublicLinkLayer(layers.EthernetTypeIPv6, true)
-	return ethIPv6ToServer, err
+	if err != nil {
+		return nil, err
+	}
+
+	ethernet := *ethIPv6ToServer
+	return &ethernet, nil
 }
 
 func GetPublicToClientLinkLayerIPv4() (*layers.Ethernet, error) {
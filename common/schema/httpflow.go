@@ -39,6 +39,7 @@ type HTTPFlow struct {
 	IPInteger          int
 	Tags               string // 用来打标！
 	Payload            string
+	Notes              string // 备注，导入导出 HAR / Burp / Postman 时保留
 
 	// Websocket 相关字段
 	IsWebsocket bool
@@ -22,6 +22,13 @@ type Port struct {
 	Hash        string `json:"hash"`
 	TaskName    string `json:"task_name"`
 
+	// TLS 服务端指纹，用于跨目标聚类相同配置的服务（C2、中间设备等）
+	Jarm               string `json:"jarm" gorm:"index"`
+	JA4S               string `json:"ja4s" gorm:"column:ja4s;index"`
+	JA4X               string `json:"ja4x" gorm:"column:ja4x"`
+	CertChainHash      string `json:"cert_chain_hash" gorm:"column:cert_chain_hash;index"`
+	TLSFingerprintName string `json:"tls_fingerprint_name" gorm:"column:tls_fingerprint_name"`
+
 	// runtime id 运行时 ID
 	RuntimeId string `json:"runtime_id"`
 }
@@ -0,0 +1,236 @@
+package smuggle
+
+import (
+	"context"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Technique 是请求走私 / 解析不同步的检测手法
+type Technique string
+
+const (
+	// TechniqueCLTE 前端使用 Content-Length，后端使用 Transfer-Encoding
+	TechniqueCLTE Technique = "CL.TE"
+	// TechniqueTECL 前端使用 Transfer-Encoding，后端使用 Content-Length
+	TechniqueTECL Technique = "TE.CL"
+	// TechniqueTETE 前后端都支持 Transfer-Encoding，但其中一方不认识混淆后的 Transfer-Encoding
+	TechniqueTETE Technique = "TE.TE"
+	// TechniqueH2CL HTTP/2 降级为 HTTP/1.1 时透传了 content-length
+	TechniqueH2CL Technique = "H2.CL"
+	// TechniqueH2TE HTTP/2 降级为 HTTP/1.1 时透传了 transfer-encoding
+	TechniqueH2TE Technique = "H2.TE"
+	// TechniqueClientSide 服务器忽略 Content-Length，浏览器可以直接触发的客户端解析不同步
+	TechniqueClientSide Technique = "CSD"
+)
+
+var allTechniques = []Technique{
+	TechniqueCLTE, TechniqueTECL, TechniqueTETE,
+	TechniqueH2CL, TechniqueH2TE, TechniqueClientSide,
+}
+
+type Config struct {
+	ctx context.Context
+
+	https   bool
+	http2   bool
+	proxy   []string
+	request []byte
+
+	// timeout 是基线请求与差异响应探测的超时
+	timeout time.Duration
+	// timingTimeout 是时间探测的超时，后端等待超过这个时间认为请求被挂起
+	timingTimeout time.Duration
+	// confirmTimes 每个结果需要复现的次数
+	confirmTimes int
+
+	techniques  []Technique
+	obfuscation bool
+
+	runtimeID string
+	saveRisk  bool
+}
+
+type ConfigOpt func(c *Config)
+
+func NewConfig(opts ...ConfigOpt) *Config {
+	c := &Config{
+		ctx:           context.Background(),
+		http2:         true,
+		timeout:       5 * time.Second,
+		timingTimeout: 8 * time.Second,
+		confirmTimes:  2,
+		techniques:    allTechniques,
+		obfuscation:   true,
+		saveRisk:      true,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	if c.confirmTimes <= 0 {
+		c.confirmTimes = 1
+	}
+	return c
+}
+
+func (c *Config) enabled(t Technique) bool {
+	for _, i := range c.techniques {
+		if i == t {
+			return true
+		}
+	}
+	return false
+}
+
+// context 是一个选项参数，用于设置扫描的上下文，上下文取消后扫描会尽快结束
+// Example:
+// ```
+// ctx, cancel = context.WithTimeout(context.Background(), 60)
+// smuggle.Scan("http://example.com", smuggle.context(ctx))
+// ```
+func WithContext(ctx context.Context) ConfigOpt {
+	return func(c *Config) {
+		if ctx != nil {
+			c.ctx = ctx
+		}
+	}
+}
+
+// https 是一个选项参数，用于指定目标（未带协议头时）是否使用 HTTPS
+// Example:
+// ```
+// smuggle.Scan("example.com:8443", smuggle.https(true))
+// ```
+func WithHttps(b bool) ConfigOpt {
+	return func(c *Config) {
+		c.https = b
+	}
+}
+
+// http2 是一个选项参数，用于指定是否尝试 H2.CL / H2.TE 降级走私检测，默认开启，仅对支持 HTTP/2 的 HTTPS 目标生效
+// Example:
+// ```
+// smuggle.Scan("https://example.com", smuggle.http2(false))
+// ```
+func WithHttp2(b bool) ConfigOpt {
+	return func(c *Config) {
+		c.http2 = b
+	}
+}
+
+// proxy 是一个选项参数，用于指定扫描使用的代理
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.proxy("http://127.0.0.1:8083"))
+// ```
+func WithProxy(proxy ...string) ConfigOpt {
+	return func(c *Config) {
+		c.proxy = utils.StringArrayFilterEmpty(proxy)
+	}
+}
+
+// request 是一个选项参数，用于指定探测使用的模板请求，探测报文会沿用其中的路径与请求头
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.request("GET /index.php HTTP/1.1\r\nHost: example.com\r\nCookie: a=1\r\n\r\n"))
+// ```
+func WithRequest(i any) ConfigOpt {
+	return func(c *Config) {
+		if utils.IsNil(i) {
+			return
+		}
+		c.request = utils.InterfaceToBytes(i)
+	}
+}
+
+// timeout 是一个选项参数，用于指定基线请求与差异响应探测的超时时间（秒），默认为 5 秒
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.timeout(10))
+// ```
+func WithTimeout(seconds float64) ConfigOpt {
+	return func(c *Config) {
+		if seconds > 0 {
+			c.timeout = utils.FloatSecondDuration(seconds)
+		}
+	}
+}
+
+// timingTimeout 是一个选项参数，用于指定时间探测的超时时间（秒），后端等待超过这个时间会被认为挂起，默认为 8 秒
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.timingTimeout(10))
+// ```
+func WithTimingTimeout(seconds float64) ConfigOpt {
+	return func(c *Config) {
+		if seconds > 0 {
+			c.timingTimeout = utils.FloatSecondDuration(seconds)
+		}
+	}
+}
+
+// confirmTimes 是一个选项参数，用于指定每种结果需要复现的次数，默认为 2 次
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.confirmTimes(3))
+// ```
+func WithConfirmTimes(i int) ConfigOpt {
+	return func(c *Config) {
+		c.confirmTimes = i
+	}
+}
+
+// techniques 是一个选项参数，用于指定需要检测的手法，可选 CL.TE / TE.CL / TE.TE / H2.CL / H2.TE / CSD，默认全部检测
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.techniques("CL.TE", "TE.CL"))
+// ```
+func WithTechniques(techniques ...string) ConfigOpt {
+	return func(c *Config) {
+		var ret []Technique
+		for _, t := range techniques {
+			for _, i := range allTechniques {
+				if utils.AsciiEqualFold(string(i), t) {
+					ret = append(ret, i)
+				}
+			}
+		}
+		if len(ret) > 0 {
+			c.techniques = ret
+		}
+	}
+}
+
+// obfuscation 是一个选项参数，用于指定是否使用 Transfer-Encoding 混淆（TE.TE）进行检测，默认开启
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.obfuscation(false))
+// ```
+func WithObfuscation(b bool) ConfigOpt {
+	return func(c *Config) {
+		c.obfuscation = b
+	}
+}
+
+// runtimeID 是一个选项参数，用于指定保存的漏洞与流量所属的 runtime id
+// Example:
+// ```
+// smuggle.Scan("http://example.com", smuggle.runtimeID(runtimeID))
+// ```
+func WithRuntimeID(id string) ConfigOpt {
+	return func(c *Config) {
+		c.runtimeID = id
+	}
+}
+
+// saveRisk 是一个选项参数，用于指定是否把检测结果保存为漏洞（risk），默认开启
+// Example:
+// ```
+// results = smuggle.Scan("http://example.com", smuggle.saveRisk(false))~
+// ```
+func WithSaveRisk(b bool) ConfigOpt {
+	return func(c *Config) {
+		c.saveRisk = b
+	}
+}
@@ -0,0 +1,376 @@
+package smuggle
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/hpack"
+)
+
+// desyncServer 模拟前端代理与后端服务器，后端连接在多个客户端请求之间复用，
+// 前后端对请求边界理解不一致时，前一个请求剩余的数据会被拼接到下一个请求前面
+type desyncServer struct {
+	// frontTE / backTE 判断一个头是否被前端 / 后端识别为 Transfer-Encoding: chunked
+	frontTE, backTE func(name, value string) bool
+	// ignoreCL 服务器忽略 Content-Length，请求 body 会被当作下一个请求（CSD）
+	ignoreCL bool
+	// h2KeepCL / h2KeepTE HTTP/2 降级为 HTTP/1.1 时透传客户端的 content-length / transfer-encoding
+	h2KeepCL, h2KeepTE bool
+
+	mu     sync.Mutex
+	stream []byte
+}
+
+func strictTE(name, value string) bool {
+	return strings.EqualFold(name, "Transfer-Encoding") && strings.EqualFold(strings.TrimSpace(value), "chunked")
+}
+
+// lenientTE 忽略头名称前后的空白
+func lenientTE(name, value string) bool {
+	return strictTE(strings.TrimSpace(name), value)
+}
+
+func noTE(string, string) bool {
+	return false
+}
+
+const desyncBadRequest = "HTTP/1.1 400 Bad Request\r\nContent-Length: 11\r\nConnection: close\r\n\r\nbad request"
+
+func desyncResponse(path string) []byte {
+	if path == "/" {
+		return []byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok")
+	}
+	return []byte("HTTP/1.1 404 Not Found\r\nContent-Length: 9\r\n\r\nnot found")
+}
+
+func splitDesyncHeader(line string) (string, string) {
+	name, value, _ := strings.Cut(line, ":")
+	return name, value
+}
+
+func validRequestLine(line string) (string, bool) {
+	fields := strings.Split(line, " ")
+	if len(fields) != 3 || !strings.HasPrefix(fields[2], "HTTP/1.") {
+		return "", false
+	}
+	return fields[1], true
+}
+
+// readFront 按前端的理解从客户端连接读取一个完整的请求
+func (s *desyncServer) readFront(r *bufio.Reader) ([]byte, bool, error) {
+	var lines []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			if len(lines) == 0 && line == "" {
+				return nil, false, io.EOF
+			}
+			return nil, false, err
+		}
+		line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	if _, ok := validRequestLine(lines[0]); !ok {
+		return nil, false, utils.Errorf("invalid request line: %q", lines[0])
+	}
+
+	chunked, contentLength, closeConn := false, 0, false
+	for _, line := range lines[1:] {
+		name, value := splitDesyncHeader(line)
+		switch {
+		case s.frontTE(name, value):
+			chunked = true
+		case strings.EqualFold(name, "Content-Length"):
+			contentLength, _ = strconv.Atoi(strings.TrimSpace(value))
+		case strings.EqualFold(name, "Connection"):
+			closeConn = strings.EqualFold(strings.TrimSpace(value), "close")
+		}
+	}
+
+	var body bytes.Buffer
+	switch {
+	case chunked:
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return nil, false, err
+			}
+			body.WriteString(line)
+			size, err := strconv.ParseInt(strings.TrimSpace(line), 16, 64)
+			if err != nil {
+				return nil, false, utils.Errorf("invalid chunk size: %q", line)
+			}
+			if size == 0 {
+				trailer, err := r.ReadString('\n')
+				if err != nil {
+					return nil, false, err
+				}
+				body.WriteString(trailer)
+				break
+			}
+			chunk := make([]byte, size+2)
+			if _, err := io.ReadFull(r, chunk); err != nil {
+				return nil, false, err
+			}
+			body.Write(chunk)
+		}
+	case contentLength > 0 && !s.ignoreCL:
+		if _, err := io.CopyN(&body, r, int64(contentLength)); err != nil {
+			return nil, false, err
+		}
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n\r\n" + body.String()), closeConn, nil
+}
+
+// parseBack 按后端的理解从复用的连接数据中解析一个请求，数据不完整时返回的长度为 0
+func (s *desyncServer) parseBack(stream []byte) (string, int, error) {
+	end := bytes.Index(stream, []byte("\r\n\r\n"))
+	if end < 0 {
+		return "", 0, nil
+	}
+	lines := strings.Split(string(stream[:end]), "\r\n")
+	path, ok := validRequestLine(lines[0])
+	if !ok {
+		return "", 0, utils.Errorf("invalid request line: %q", lines[0])
+	}
+	chunked, contentLength := false, 0
+	for _, line := range lines[1:] {
+		name, value := splitDesyncHeader(line)
+		switch {
+		case s.backTE(name, value):
+			chunked = true
+		case strings.EqualFold(name, "Content-Length"):
+			contentLength, _ = strconv.Atoi(strings.TrimSpace(value))
+		}
+	}
+
+	pos := end + 4
+	switch {
+	case chunked:
+		for {
+			lineEnd := bytes.Index(stream[pos:], []byte("\r\n"))
+			if lineEnd < 0 {
+				return "", 0, nil
+			}
+			size, err := strconv.ParseInt(strings.TrimSpace(string(stream[pos:pos+lineEnd])), 16, 64)
+			if err != nil {
+				return "", 0, utils.Errorf("invalid chunk size")
+			}
+			pos += lineEnd + 2
+			if size == 0 {
+				if !bytes.HasPrefix(stream[pos:], []byte("\r\n")) {
+					return "", 0, nil
+				}
+				return path, pos + 2, nil
+			}
+			if len(stream) < pos+int(size)+2 {
+				return "", 0, nil
+			}
+			pos += int(size) + 2
+		}
+	case contentLength > 0 && !s.ignoreCL:
+		if len(stream) < pos+contentLength {
+			return "", 0, nil
+		}
+		return path, pos + contentLength, nil
+	}
+	return path, pos, nil
+}
+
+// forward 把请求写入复用的后端连接，后端等待更多数据时返回 hang
+func (s *desyncServer) forward(req []byte) ([]byte, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stream := append(append([]byte{}, s.stream...), req...)
+	path, n, err := s.parseBack(stream)
+	if err != nil {
+		s.stream = nil
+		return []byte(desyncBadRequest), false
+	}
+	if n == 0 {
+		// 后端一直等待直到超时，连接被关闭后残留的数据也随之丢弃
+		s.stream = nil
+		return nil, true
+	}
+	s.stream = stream[n:]
+	return desyncResponse(path), false
+}
+
+func (s *desyncServer) serveHTTP1(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	for {
+		req, closeConn, err := s.readFront(reader)
+		if err != nil {
+			if err != io.EOF {
+				conn.Write([]byte(desyncBadRequest))
+			}
+			return
+		}
+		rsp, hang := s.forward(req)
+		if hang {
+			io.Copy(io.Discard, conn)
+			return
+		}
+		conn.Write(rsp)
+		if closeConn {
+			return
+		}
+	}
+}
+
+// serveHTTP2 一个最小的 HTTP/2 前端，每个请求降级为 HTTP/1.1 后转发给后端
+func (s *desyncServer) serveHTTP2(conn net.Conn) {
+	defer conn.Close()
+	preface := make([]byte, len(http2.ClientPreface))
+	if _, err := io.ReadFull(conn, preface); err != nil || string(preface) != http2.ClientPreface {
+		return
+	}
+	framer := http2.NewFramer(conn, conn)
+	framer.ReadMetaHeaders = hpack.NewDecoder(4096, nil)
+	if err := framer.WriteSettings(); err != nil {
+		return
+	}
+
+	headers := make(map[uint32][]hpack.HeaderField)
+	bodies := make(map[uint32]*bytes.Buffer)
+	handle := func(id uint32) {
+		req := s.downgrade(headers[id], bodies[id].Bytes())
+		rsp, hang := s.forward(req)
+		if hang {
+			// 挂起的只是这一个 stream，同一连接上的其他 stream 仍然正常处理
+			return
+		}
+		var block bytes.Buffer
+		encoder := hpack.NewEncoder(&block)
+		body := lowhttp.GetHTTPPacketBody(rsp)
+		encoder.WriteField(hpack.HeaderField{Name: ":status", Value: strconv.Itoa(lowhttp.GetStatusCodeFromResponse(rsp))})
+		encoder.WriteField(hpack.HeaderField{Name: "content-length", Value: strconv.Itoa(len(body))})
+		framer.WriteHeaders(http2.HeadersFrameParam{StreamID: id, BlockFragment: block.Bytes(), EndHeaders: true})
+		framer.WriteData(id, true, body)
+	}
+	for {
+		frame, err := framer.ReadFrame()
+		if err != nil {
+			return
+		}
+		switch f := frame.(type) {
+		case *http2.SettingsFrame:
+			if !f.IsAck() {
+				framer.WriteSettingsAck()
+			}
+		case *http2.PingFrame:
+			if !f.IsAck() {
+				framer.WritePing(true, f.Data)
+			}
+		case *http2.MetaHeadersFrame:
+			id := f.Header().StreamID
+			headers[id], bodies[id] = f.Fields, new(bytes.Buffer)
+			if f.StreamEnded() {
+				handle(id)
+			}
+		case *http2.DataFrame:
+			id := f.Header().StreamID
+			if bodies[id] == nil {
+				return
+			}
+			bodies[id].Write(f.Data())
+			if f.StreamEnded() {
+				handle(id)
+			}
+		case *http2.GoAwayFrame:
+			return
+		}
+	}
+}
+
+func (s *desyncServer) downgrade(fields []hpack.HeaderField, body []byte) []byte {
+	var method, path, authority string
+	var lines []string
+	hasCL := false
+	for _, h := range fields {
+		switch h.Name {
+		case ":method":
+			method = h.Value
+		case ":path":
+			path = h.Value
+		case ":authority":
+			authority = h.Value
+		case ":scheme":
+		case "content-length":
+			if s.h2KeepCL {
+				hasCL = true
+				lines = append(lines, "Content-Length: "+h.Value)
+			}
+		case "transfer-encoding":
+			if s.h2KeepTE {
+				lines = append(lines, "Transfer-Encoding: "+h.Value)
+			}
+		default:
+			lines = append(lines, h.Name+": "+h.Value)
+		}
+	}
+	if !hasCL && len(body) > 0 {
+		lines = append(lines, fmt.Sprintf("Content-Length: %d", len(body)))
+	}
+	lines = append([]string{fmt.Sprintf("%s %s HTTP/1.1", method, path), "Host: " + authority}, lines...)
+	return []byte(strings.Join(lines, "\r\n") + "\r\n\r\n" + string(body))
+}
+
+// start 启动服务器，https 时通过 ALPN 同时支持 HTTP/1.1 与 HTTP/2
+func (s *desyncServer) start(t *testing.T, https bool) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { lis.Close() })
+
+	var tlsConfig *tls.Config
+	if https {
+		origin := utils.GetDefaultTLSConfig(5)
+		require.NotNil(t, origin)
+		tlsConfig = origin.Clone()
+		tlsConfig.NextProtos = []string{"h2", "http/1.1"}
+	}
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				if tlsConfig == nil {
+					s.serveHTTP1(conn)
+					return
+				}
+				tlsConn := tls.Server(conn, tlsConfig)
+				if err := tlsConn.Handshake(); err != nil {
+					conn.Close()
+					return
+				}
+				if tlsConn.ConnectionState().NegotiatedProtocol == "h2" {
+					s.serveHTTP2(tlsConn)
+					return
+				}
+				s.serveHTTP1(tlsConn)
+			}()
+		}
+	}()
+	if https {
+		return "https://" + lis.Addr().String()
+	}
+	return "http://" + lis.Addr().String()
+}
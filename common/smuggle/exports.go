@@ -0,0 +1,26 @@
+package smuggle
+
+var Exports = map[string]interface{}{
+	"Scan":     Scan,
+	"SaveRisk": SaveRisk,
+
+	"context":       WithContext,
+	"https":         WithHttps,
+	"http2":         WithHttp2,
+	"proxy":         WithProxy,
+	"request":       WithRequest,
+	"timeout":       WithTimeout,
+	"timingTimeout": WithTimingTimeout,
+	"confirmTimes":  WithConfirmTimes,
+	"techniques":    WithTechniques,
+	"obfuscation":   WithObfuscation,
+	"runtimeID":     WithRuntimeID,
+	"saveRisk":      WithSaveRisk,
+
+	"CL_TE": TechniqueCLTE,
+	"TE_CL": TechniqueTECL,
+	"TE_TE": TechniqueTETE,
+	"H2_CL": TechniqueH2CL,
+	"H2_TE": TechniqueH2TE,
+	"CSD":   TechniqueClientSide,
+}
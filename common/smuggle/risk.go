@@ -0,0 +1,64 @@
+package smuggle
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+const riskDescription = `HTTP 请求走私（HTTP Request Smuggling）/ 解析不同步（Desync）是指前端（CDN、反向代理、负载均衡）与后端服务器对同一个请求的边界理解不一致，攻击者可以借此把恶意请求的前缀“走私”到后端连接中，影响其他用户的请求。
+
+常见的成因包括：
+1. CL.TE / TE.CL：前后端对 Content-Length 与 Transfer-Encoding 的优先级处理不一致；
+2. TE.TE：前后端都支持 Transfer-Encoding，但其中一方无法识别混淆后的 Transfer-Encoding 头；
+3. H2.CL / H2.TE：前端将 HTTP/2 请求降级为 HTTP/1.1 时，没有校验并透传了 content-length 或 transfer-encoding；
+4. 客户端解析不同步（CSD）：服务器忽略了 Content-Length，浏览器发出的请求即可污染同一连接上的后续请求。
+
+该漏洞可能导致绕过前端安全控制、缓存投毒、劫持其他用户的请求与会话等危害。`
+
+const riskSolution = `1. 前后端统一使用 HTTP/2 通信，或确保前后端使用相同的 HTTP 解析实现；
+2. 前端拒绝同时包含 Content-Length 与 Transfer-Encoding 的请求，拒绝无法识别或重复的 Transfer-Encoding 头；
+3. HTTP/2 降级时校验 content-length 与实际数据长度是否一致，并移除 transfer-encoding；
+4. 后端在请求解析出错时关闭连接，不要复用可能被污染的后端连接。`
+
+// SaveRisk 把检测结果保存为漏洞，差异响应确认的结果为高危，仅时间探测命中的结果为中危并标记为潜在漏洞
+func SaveRisk(r *Result, runtimeID string) error {
+	if r == nil {
+		return utils.Error("empty smuggle result")
+	}
+	name := string(r.Technique)
+	if r.Technique == TechniqueTETE {
+		name = fmt.Sprintf("%s/%s, %s", r.Technique, r.Direction, r.Obfuscation)
+	}
+	title := fmt.Sprintf("HTTP Request Smuggling (%s) Detected: %v", name, r.Target)
+	titleVerbose := fmt.Sprintf("HTTP 请求走私（%s）：%v", name, r.Target)
+	if !r.Confirmed() {
+		title = "Maybe " + title
+		titleVerbose = "疑似" + titleVerbose
+	}
+	_, err := yakit.NewRisk(
+		r.Target,
+		yakit.WithRiskParam_Title(title),
+		yakit.WithRiskParam_TitleVerbose(titleVerbose),
+		yakit.WithRiskParam_RiskType("http-request-smuggling"),
+		yakit.WithRiskParam_Severity(r.Severity()),
+		yakit.WithRiskParam_Potential(!r.Confirmed()),
+		yakit.WithRiskParam_Request(r.Payload),
+		yakit.WithRiskParam_Response(r.Response),
+		yakit.WithRiskParam_Payload(string(r.Payload)),
+		yakit.WithRiskParam_Description(riskDescription),
+		yakit.WithRiskParam_Solution(riskSolution),
+		yakit.WithRiskParam_Details(map[string]any{
+			"technique":    string(r.Technique),
+			"direction":    string(r.Direction),
+			"obfuscation":  r.Obfuscation,
+			"http2":        r.Http2,
+			"timing":       r.TimingConfirmed,
+			"differential": r.DifferentialConfirmed,
+			"evidence":     r.Evidence,
+		}),
+		yakit.WithRiskParam_RuntimeId(runtimeID),
+	)
+	return err
+}
@@ -0,0 +1,421 @@
+package smuggle
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// Result 是一条请求走私 / 解析不同步的检测结果
+type Result struct {
+	Target    string
+	Technique Technique
+	// Direction 是 TE.TE 结果实际生效的方向（CL.TE 或 TE.CL）
+	Direction   Technique
+	Obfuscation string
+	Http2       bool
+
+	// TimingConfirmed 时间探测复现成功：探测请求被后端挂起，而对照请求正常返回
+	TimingConfirmed bool
+	// DifferentialConfirmed 差异响应复现成功：被走私的前缀影响了后续请求的响应
+	DifferentialConfirmed bool
+	Evidence              []string
+
+	Payload  []byte
+	Response []byte
+}
+
+// Confirmed 只有差异响应得到复现的结果才认为是确认的漏洞，仅有时间探测的结果为疑似
+func (r *Result) Confirmed() bool {
+	return r.DifferentialConfirmed
+}
+
+func (r *Result) Severity() string {
+	if r.Confirmed() {
+		return "high"
+	}
+	return "middle"
+}
+
+func (r *Result) String() string {
+	name := string(r.Technique)
+	if r.Technique == TechniqueTETE {
+		name = fmt.Sprintf("%s(%s, %s)", r.Technique, r.Direction, r.Obfuscation)
+	}
+	return fmt.Sprintf("[%s] %s: %s", r.Severity(), name, strings.Join(r.Evidence, "; "))
+}
+
+type check struct {
+	technique   Technique
+	direction   Technique
+	obfuscation string
+	http2       bool
+
+	timingProbe   []byte
+	timingControl []byte
+	attack        []byte
+	// victim 表示是否需要在新连接上发送正常请求观察是否被走私前缀污染
+	victim bool
+}
+
+type probeResult struct {
+	statusCodes []int
+	raw         []byte
+	elapsed     time.Duration
+	err         error
+}
+
+type scanner struct {
+	config *Config
+	target string
+	https  bool
+	base   []byte
+
+	notFoundPath   string
+	normalStatus   int
+	notFoundStatus int
+	http2          bool
+}
+
+// Scan 对目标进行 HTTP 请求走私与解析不同步检测，支持 CL.TE、TE.CL、TE.TE 混淆、H2.CL / H2.TE 降级与客户端解析不同步（CSD）
+// 每种手法都会先进行时间探测，再通过走私前缀影响后续请求的差异响应进行确认，检测结果默认会保存为漏洞
+// Example:
+// ```
+// results = smuggle.Scan("http://example.com")~
+// for r in results {
+// println(r.String())
+// }
+// ```
+func Scan(target string, opts ...ConfigOpt) ([]*Result, error) {
+	config := NewConfig(opts...)
+	s, err := newScanner(target, config)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.baseline(); err != nil {
+		return nil, err
+	}
+	results := s.run()
+	if config.saveRisk {
+		for _, r := range results {
+			if err := SaveRisk(r, config.runtimeID); err != nil {
+				log.Warnf("save smuggle risk failed: %v", err)
+			}
+		}
+	}
+	return results, nil
+}
+
+func newScanner(target string, config *Config) (*scanner, error) {
+	if !strings.Contains(target, "://") {
+		scheme := "http"
+		if config.https {
+			scheme = "https"
+		}
+		target = scheme + "://" + target
+	}
+	u, err := url.Parse(target)
+	if err != nil {
+		return nil, utils.Wrapf(err, "parse smuggle target %v failed", target)
+	}
+	if u.Host == "" {
+		return nil, utils.Errorf("invalid smuggle target: %v", target)
+	}
+	https := u.Scheme == "https" || u.Scheme == "wss"
+	base := config.request
+	if len(base) == 0 {
+		base = lowhttp.UrlToGetRequestPacket(target, nil, https)
+	}
+	if len(base) == 0 {
+		return nil, utils.Errorf("build request for %v failed", target)
+	}
+	return &scanner{
+		config:       config,
+		target:       target,
+		https:        https,
+		base:         base,
+		notFoundPath: "/" + strings.ToLower(utils.RandStringBytes(16)),
+	}, nil
+}
+
+func (s *scanner) send(packet []byte, http2 bool, timeout time.Duration, noFixContentLength bool) *probeResult {
+	opts := []lowhttp.LowhttpOpt{
+		lowhttp.WithPacketBytes(packet),
+		lowhttp.WithHttps(s.https),
+		lowhttp.WithHttp2(http2),
+		lowhttp.WithTimeout(timeout),
+		lowhttp.WithRedirectTimes(0),
+		lowhttp.WithConnPool(false),
+		lowhttp.WithNoFixContentLength(noFixContentLength),
+		lowhttp.WithContext(s.config.ctx),
+		lowhttp.WithRuntimeId(s.config.runtimeID),
+	}
+	if len(s.config.proxy) > 0 {
+		opts = append(opts, lowhttp.WithProxy(s.config.proxy...))
+	}
+	start := time.Now()
+	rsp, err := lowhttp.HTTP(opts...)
+	result := &probeResult{elapsed: time.Since(start), err: err}
+	if rsp == nil {
+		return result
+	}
+	result.raw = rsp.BareResponse
+	if len(result.raw) == 0 {
+		result.raw = rsp.RawPacket
+	}
+	if http2 {
+		if code := lowhttp.GetStatusCodeFromResponse(rsp.RawPacket); code > 0 {
+			result.statusCodes = []int{code}
+		}
+		return result
+	}
+	result.statusCodes = parseStatusCodes(result.raw)
+	return result
+}
+
+// parseStatusCodes 解析同一个连接上返回的所有响应的状态码
+func parseStatusCodes(raw []byte) []int {
+	var codes []int
+	reader := bufio.NewReader(bytes.NewReader(raw))
+	for {
+		rsp, err := utils.ReadHTTPResponseFromBufioReader(reader, nil)
+		if err != nil {
+			break
+		}
+		if rsp.Body != nil {
+			_, _ = io.Copy(io.Discard, rsp.Body)
+		}
+		codes = append(codes, rsp.StatusCode)
+	}
+	return codes
+}
+
+func (p *probeResult) status() int {
+	if len(p.statusCodes) > 0 {
+		return p.statusCodes[0]
+	}
+	return 0
+}
+
+// baseline 获取正常请求与不存在路径的状态码，二者不同时才能进行差异响应确认
+func (s *scanner) baseline() error {
+	normal := s.send(s.base, false, s.config.timeout, false)
+	if normal.err != nil && normal.status() <= 0 {
+		return utils.Wrapf(normal.err, "request smuggle target %v failed", s.target)
+	}
+	s.normalStatus = normal.status()
+	notFound := s.send(lowhttp.ReplaceHTTPPacketPath(s.base, s.notFoundPath), false, s.config.timeout, false)
+	s.notFoundStatus = notFound.status()
+
+	if s.https && s.config.http2 && (s.config.enabled(TechniqueH2CL) || s.config.enabled(TechniqueH2TE)) {
+		rsp, err := lowhttp.HTTP(
+			lowhttp.WithPacketBytes(s.base), lowhttp.WithHttps(true), lowhttp.WithHttp2(true),
+			lowhttp.WithTimeout(s.config.timeout), lowhttp.WithRedirectTimes(0),
+			lowhttp.WithContext(s.config.ctx), lowhttp.WithProxy(s.config.proxy...),
+		)
+		s.http2 = err == nil && rsp != nil && rsp.Http2
+	}
+	if !s.canDiff() {
+		log.Infof("smuggle target %v: normal(%v) and not-found(%v) status code are the same, differential confirmation is disabled",
+			s.target, s.normalStatus, s.notFoundStatus)
+	}
+	return nil
+}
+
+func (s *scanner) canDiff() bool {
+	return s.normalStatus > 0 && s.notFoundStatus > 0 && s.normalStatus != s.notFoundStatus
+}
+
+func (s *scanner) clteCheck(technique Technique, obfuscation, te string) *check {
+	probe, control := clteTiming(s.base, te)
+	return &check{
+		technique: technique, direction: TechniqueCLTE, obfuscation: obfuscation,
+		timingProbe: probe, timingControl: control,
+		attack: clteAttack(s.base, te, s.notFoundPath), victim: true,
+	}
+}
+
+func (s *scanner) teclCheck(technique Technique, obfuscation, te string) *check {
+	probe, control := teclTiming(s.base, te)
+	return &check{
+		technique: technique, direction: TechniqueTECL, obfuscation: obfuscation,
+		timingProbe: probe, timingControl: control,
+		attack: teclAttack(s.base, te, s.notFoundPath), victim: true,
+	}
+}
+
+// checks 按顺序生成检测项，CL.TE 总是在 TE.CL 之前，TE.TE 的混淆头在标准头之后
+func (s *scanner) checks() []*check {
+	var checks []*check
+	path := s.notFoundPath
+	if s.config.enabled(TechniqueCLTE) {
+		checks = append(checks, s.clteCheck(TechniqueCLTE, "", plainTransferEncoding))
+	}
+	if s.config.enabled(TechniqueTECL) {
+		checks = append(checks, s.teclCheck(TechniqueTECL, "", plainTransferEncoding))
+	}
+	if s.config.enabled(TechniqueTETE) && s.config.obfuscation {
+		for _, o := range teObfuscations {
+			checks = append(checks,
+				s.clteCheck(TechniqueTETE, o.Name, o.Header),
+				s.teclCheck(TechniqueTETE, o.Name, o.Header),
+			)
+		}
+	}
+	if s.http2 && s.config.enabled(TechniqueH2CL) {
+		probe, control := h2clTiming(s.base)
+		checks = append(checks, &check{
+			technique: TechniqueH2CL, http2: true,
+			timingProbe: probe, timingControl: control,
+			attack: h2clAttack(s.base, path), victim: true,
+		})
+	}
+	if s.http2 && s.config.enabled(TechniqueH2TE) {
+		probe, control := h2teTiming(s.base)
+		checks = append(checks, &check{
+			technique: TechniqueH2TE, http2: true,
+			timingProbe: probe, timingControl: control,
+			attack: h2teAttack(s.base, path), victim: true,
+		})
+	}
+	if s.config.enabled(TechniqueClientSide) {
+		checks = append(checks, &check{
+			technique: TechniqueClientSide,
+			attack:    clientSideAttack(s.base, path),
+		})
+	}
+	return checks
+}
+
+func (s *scanner) run() []*Result {
+	var results []*Result
+	// 已经检测到的方向（CL.TE / TE.CL）不再使用混淆头重复检测
+	detected := make(map[Technique]bool)
+	// 某个 TE 头已经检测到 CL.TE 时跳过 TE.CL，避免 TE.CL 探测污染后端连接；标准 TE 头命中后混淆头也没有意义
+	detectedObfuscation := make(map[string]bool)
+	for _, c := range s.checks() {
+		if s.config.ctx.Err() != nil {
+			break
+		}
+		if c.direction != "" {
+			if detectedObfuscation[c.obfuscation] {
+				continue
+			}
+			if c.obfuscation != "" && (detected[c.direction] || detectedObfuscation[""]) {
+				continue
+			}
+		}
+		r := s.check(c)
+		if r == nil {
+			continue
+		}
+		log.Infof("smuggle detected on %v: %v", s.target, r.String())
+		results = append(results, r)
+		if c.direction != "" {
+			detected[c.direction] = true
+			detectedObfuscation[c.obfuscation] = true
+		}
+	}
+	return results
+}
+
+func (s *scanner) check(c *check) *Result {
+	r := &Result{
+		Target:      s.target,
+		Technique:   c.technique,
+		Obfuscation: c.obfuscation,
+		Http2:       c.http2,
+	}
+	if c.technique == TechniqueTETE {
+		r.Direction = c.direction
+	}
+	if len(c.timingProbe) > 0 {
+		if evidence, ok := s.timing(c); ok {
+			r.TimingConfirmed = true
+			r.Evidence = append(r.Evidence, evidence)
+			r.Payload = c.timingProbe
+		}
+	}
+	if len(c.attack) > 0 && s.canDiff() {
+		if evidence, rsp, ok := s.differential(c); ok {
+			r.DifferentialConfirmed = true
+			r.Evidence = append(r.Evidence, evidence)
+			r.Payload = c.attack
+			r.Response = rsp
+		}
+	}
+	if !r.TimingConfirmed && !r.DifferentialConfirmed {
+		return nil
+	}
+	return r
+}
+
+// timing 探测请求需要每次都被挂起到超时，同时对照请求必须在超时的一半以内正常返回
+func (s *scanner) timing(c *check) (string, bool) {
+	timeout := s.config.timingTimeout
+	var control, probe *probeResult
+	for i := 0; i < s.config.confirmTimes; i++ {
+		if s.config.ctx.Err() != nil {
+			return "", false
+		}
+		control = s.send(c.timingControl, c.http2, timeout, true)
+		if control.status() <= 0 || control.elapsed >= timeout/2 {
+			return "", false
+		}
+		probe = s.send(c.timingProbe, c.http2, timeout, true)
+		if probe.elapsed < timeout*9/10 {
+			return "", false
+		}
+	}
+	return fmt.Sprintf("timing: probe hung for %v while control returned %v in %v (%d times)",
+		probe.elapsed.Round(time.Millisecond), control.status(), control.elapsed.Round(time.Millisecond), s.config.confirmTimes), true
+}
+
+// differential 发送携带走私前缀的请求，若同一连接上的后续响应或新连接上的正常请求得到了不存在路径的响应，则认为前缀被走私成功
+func (s *scanner) differential(c *check) (string, []byte, bool) {
+	hits := 0
+	var (
+		evidence string
+		response []byte
+	)
+	for i := 0; i < s.config.confirmTimes*2 && hits < s.config.confirmTimes; i++ {
+		if s.config.ctx.Err() != nil {
+			return "", nil, false
+		}
+		// 剩余的次数已经不足以确认
+		if s.config.confirmTimes-hits > s.config.confirmTimes*2-i {
+			break
+		}
+		attack := s.send(c.attack, c.http2, s.config.timeout, true)
+		if attack.status() <= 0 || attack.status() == 400 {
+			continue
+		}
+		if len(attack.statusCodes) > 1 && attack.statusCodes[1] == s.notFoundStatus {
+			hits++
+			response = attack.raw
+			evidence = fmt.Sprintf("differential: smuggled request answered on the same connection, status codes %v", attack.statusCodes)
+			continue
+		}
+		if !c.victim {
+			continue
+		}
+		victim := s.send(s.base, c.http2, s.config.timeout, false)
+		if victim.status() == s.notFoundStatus {
+			hits++
+			response = victim.raw
+			evidence = fmt.Sprintf("differential: follow-up normal request got %v (normal: %v)", victim.status(), s.normalStatus)
+		}
+	}
+	if hits < s.config.confirmTimes {
+		return "", nil, false
+	}
+	return fmt.Sprintf("%s (%d times)", evidence, hits), response, true
+}
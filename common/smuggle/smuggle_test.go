@@ -0,0 +1,87 @@
+package smuggle
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/vulinbox"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+func TestScan_Smuggle(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	port := utils.GetRandomAvailableTCPPort()
+	go vulinbox.Smuggle(ctx, port)
+	require.NoError(t, utils.WaitConnect(utils.HostPort("127.0.0.1", port), 5))
+
+	runtimeID := uuid.NewString()
+	results, err := Scan(utils.HostPort("127.0.0.1", port), WithTimeout(3), WithTimingTimeout(3), WithRuntimeID(runtimeID))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, TechniqueCLTE, results[0].Technique)
+	require.True(t, results[0].Confirmed())
+
+	count := 0
+	for r := range yakit.YieldRisksByRuntimeId(consts.GetGormProjectDatabase(), ctx, runtimeID) {
+		require.Contains(t, r.Title, "CL.TE")
+		require.Equal(t, port, r.Port)
+		count++
+	}
+	require.Equal(t, 1, count)
+}
+
+func TestScan_Pipeline_Negative(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	port := utils.GetRandomAvailableTCPPort()
+	go vulinbox.Pipeline(ctx, port)
+	require.NoError(t, utils.WaitConnect(utils.HostPort("127.0.0.1", port), 5))
+
+	results, err := Scan(utils.HostPort("127.0.0.1", port), WithTimeout(3), WithTimingTimeout(3), WithSaveRisk(false))
+	require.NoError(t, err)
+	require.Empty(t, results)
+}
+
+func TestScan_DesyncStandIn(t *testing.T) {
+	for _, c := range []struct {
+		name        string
+		server      *desyncServer
+		https       bool
+		expect      Technique
+		direction   Technique
+		obfuscation string
+	}{
+		{name: "TE.CL", server: &desyncServer{frontTE: lenientTE, backTE: noTE}, expect: TechniqueTECL},
+		{name: "TE.CL negative", server: &desyncServer{frontTE: lenientTE, backTE: lenientTE}},
+		{name: "TE.TE", server: &desyncServer{frontTE: lenientTE, backTE: strictTE}, expect: TechniqueTETE, direction: TechniqueTECL, obfuscation: "space-before-colon"},
+		{name: "TE.TE negative", server: &desyncServer{frontTE: strictTE, backTE: strictTE}},
+		{name: "CSD", server: &desyncServer{frontTE: noTE, backTE: noTE, ignoreCL: true}, expect: TechniqueClientSide},
+		{name: "CSD negative", server: &desyncServer{frontTE: noTE, backTE: noTE}},
+		{name: "H2.CL", server: &desyncServer{frontTE: lenientTE, backTE: lenientTE, h2KeepCL: true}, https: true, expect: TechniqueH2CL},
+		{name: "H2.TE", server: &desyncServer{frontTE: lenientTE, backTE: lenientTE, h2KeepTE: true}, https: true, expect: TechniqueH2TE},
+		{name: "H2 negative", server: &desyncServer{frontTE: lenientTE, backTE: lenientTE}, https: true},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+			target := c.server.start(t, c.https)
+			results, err := Scan(target, WithTimeout(1), WithTimingTimeout(1.5), WithSaveRisk(false))
+			require.NoError(t, err)
+			if c.expect == "" {
+				require.Empty(t, results)
+				return
+			}
+			require.Len(t, results, 1, "results: %v", results)
+			r := results[0]
+			require.Equal(t, c.expect, r.Technique)
+			require.True(t, r.Confirmed(), r.String())
+			require.Equal(t, c.direction, r.Direction)
+			require.Equal(t, c.obfuscation, r.Obfuscation)
+		})
+	}
+}
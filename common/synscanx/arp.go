@@ -8,21 +8,39 @@ import (
 )
 
 func (s *Scannerx) getGatewayMac() (net.HardwareAddr, error) {
-	gateway := s.config.GatewayIP.String()
+	dstHw, err := s.resolveGatewayMac(s.config.GatewayIP)
+	if err != nil {
+		return nil, err
+	}
+	s.config.RemoteMac = dstHw
+	return dstHw, nil
+}
+
+// getGatewayMacFor 根据目标地址族获取网关的 MAC 地址
+// IPv6 网关的 MAC 不写入 RemoteMac，避免同链路的 IPv6 目标也被发往网关
+func (s *Scannerx) getGatewayMacFor(host string) (net.HardwareAddr, error) {
+	if utils.IsIPv6(host) {
+		return s.resolveGatewayMac(s.config.GatewayIPv6)
+	}
+	return s.getGatewayMac()
+}
+
+func (s *Scannerx) resolveGatewayMac(gatewayIP net.IP) (net.HardwareAddr, error) {
+	gateway := gatewayIP.String()
 	if gateway != "" && gateway != "<nil>" {
 		var retry int
 		for {
-			// 通过 ARP 协议获取网关的 MAC 地址
-			s.arp(gateway)
+			if dstHw, ok := s.macCacheTable.Load(gateway); ok {
+				if hw, ok := dstHw.(net.HardwareAddr); ok {
+					log.Debugf("use arpx/ndp proto to fetch gateway's hw address: %s", hw)
+					return hw, nil
+				}
+			}
 			if retry > 2 {
 				return nil, utils.Errorf("cannot fetch hw addr for %v[%v]", s.sampleIP, s.config.Iface.Name)
 			}
-			dstHw, ok := s.macCacheTable.Load(gateway)
-			if ok {
-				s.config.RemoteMac = dstHw.(net.HardwareAddr)
-				log.Debugf("use arpx proto to fetch gateway's hw address: %s", dstHw)
-				return dstHw.(net.HardwareAddr), nil
-			}
+			// 通过 ARP(IPv4) / NDP(IPv6) 协议获取网关的 MAC 地址
+			s.arp(gateway)
 			retry++
 			time.Sleep(time.Millisecond * 50)
 		}
@@ -34,7 +52,8 @@ func (s *Scannerx) onArp(ip net.IP, hw net.HardwareAddr) {
 	if s.MacHandlers != nil {
 		s.MacHandlers(ip, hw)
 	}
-	if s.config.SourceIP.Equal(ip) || s.config.GatewayIP.Equal(ip) {
+	if s.config.SourceIP.Equal(ip) || s.config.GatewayIP.Equal(ip) ||
+		s.config.SourceIPv6.Equal(ip) || s.config.GatewayIPv6.Equal(ip) {
 		s.macCacheTable.Store(ip.String(), hw)
 		return
 	}
@@ -73,7 +92,10 @@ func (s *Scannerx) getInterfaceNetworks() (*net.IPNet, *net.IPNet) {
 		if ipNet.IP.To4() != nil {
 			ifaceIPNetV4 = ipNet
 		} else if ipNet.IP.To16() != nil {
-			ifaceIPNetV6 = ipNet
+			// 链路本地地址总是直连的，在 isInternalAddress 中单独判断，这里优先记录全局地址的网段
+			if ifaceIPNetV6 == nil || !ipNet.IP.IsLinkLocalUnicast() {
+				ifaceIPNetV6 = ipNet
+			}
 		}
 	}
 
@@ -96,8 +118,11 @@ func (s *Scannerx) isInternalAddress(target string) bool {
 	if targetIP == nil || utils.IsLoopback(target) {
 		return false
 	}
+	if targetIP.To4() == nil && targetIP.IsLinkLocalUnicast() {
+		return true
+	}
 	return (targetIP.To4() != nil && ifaceIPNetV4 != nil && ifaceIPNetV4.Contains(targetIP.To4())) ||
-		(targetIP.To16() != nil && ifaceIPNetV6 != nil && ifaceIPNetV6.Contains(targetIP.To16()))
+		(targetIP.To4() == nil && ifaceIPNetV6 != nil && ifaceIPNetV6.Contains(targetIP.To16()))
 }
 
 func (s *Scannerx) arpScan() {
@@ -113,10 +138,16 @@ func (s *Scannerx) arpScan() {
 		}
 	}
 }
+
+// arp 获取目标的 MAC 地址，IPv6 目标使用 NDP 邻居请求
 func (s *Scannerx) arp(target string) {
-	packet, err := s.assemblePacket(target, 0, ARP)
+	proto := ARP
+	if utils.IsIPv6(target) {
+		proto = NDP
+	}
+	packet, err := s.assemblePacket(target, 0, proto)
 	if err != nil {
-		log.Errorf("assemble arp packet failed: %v", err)
+		log.Errorf("assemble arp/ndp packet failed: %v", err)
 		return
 	}
 	s.PacketChan <- packet
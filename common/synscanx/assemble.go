@@ -22,6 +22,10 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 
 	dstMac := s.config.RemoteMac
 	srcMac := s.config.SourceMac
+	if utils.IsIPv6(host) {
+		// RemoteMac 缓存的是 IPv4 网关的 MAC，IPv6 目标需要单独通过 NDP 解析下一跳
+		dstMac = nil
+	}
 
 	if dstMac == nil {
 		if isLoopback {
@@ -36,7 +40,7 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.getGatewayMacFor(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -88,25 +92,14 @@ func (s *Scannerx) assembleSynPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
-	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
-	} else {
-		ipSrc = s.config.SourceIP.String()
-	}
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolTCP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	// IPv4 / IPv6
+	opts, err = s.appendNetworkLayer(opts, host, isLoopback, layers.IPProtocolTCP)
+	if err != nil {
+		return nil, err
+	}
 
 	// TCP
 	opts = append(opts,
@@ -135,6 +128,10 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 
 	dstMac := s.config.RemoteMac
 	srcMac := s.config.SourceMac
+	if utils.IsIPv6(host) {
+		// RemoteMac 缓存的是 IPv4 网关的 MAC，IPv6 目标需要单独通过 NDP 解析下一跳
+		dstMac = nil
+	}
 
 	if dstMac == nil {
 		if isLoopback {
@@ -149,7 +146,7 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		// 外网扫描
 		if !isLoopback && !s.isInternalAddress(host) {
 			// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
-			dstMac, err = s.getGatewayMac()
+			dstMac, err = s.getGatewayMacFor(host)
 			if err != nil {
 				return nil, utils.Errorf("get gateway mac failed: %s", err)
 			}
@@ -201,26 +198,15 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 		)
 	}
 
-	var ipSrc string
-	if isLoopback {
-		ipSrc = net.ParseIP("127.0.0.1").String()
-		host = ipSrc
-	} else {
-		ipSrc = s.config.SourceIP.String()
-	}
 	srcPort := rand.Intn(65534) + 1
 	// wireshark filter port
 	//srcPort := 52873
 
-	// IPv4
-	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
-	opts = append(opts, pcapx.WithIPv4_Version(4))
-	opts = append(opts, pcapx.WithIPv4_NextProtocol(layers.IPProtocolUDP))
-	opts = append(opts, pcapx.WithIPv4_TTL(64))
-	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
-	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
-	opts = append(opts, pcapx.WithIPv4_DstIP(host))
-	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	// IPv4 / IPv6
+	opts, err = s.appendNetworkLayer(opts, host, isLoopback, layers.IPProtocolUDP)
+	if err != nil {
+		return nil, err
+	}
 
 	// UDP
 	opts = append(opts, pcapx.WithUDP_SrcPort(srcPort))
@@ -246,6 +232,43 @@ func (s *Scannerx) assembleUdpPacket(host string, port int) ([]byte, error) {
 	return packetBytes, nil
 }
 
+// appendNetworkLayer 按目标地址族追加 IPv4 / IPv6 网络层选项
+func (s *Scannerx) appendNetworkLayer(opts []any, host string, isLoopback bool, proto layers.IPProtocol) ([]any, error) {
+	isIPv6 := utils.IsIPv6(host)
+	var ipSrc string
+	if isLoopback {
+		ipSrc = "127.0.0.1"
+		if isIPv6 {
+			ipSrc = "::1"
+		}
+		host = ipSrc
+	} else {
+		srcIP, err := s.sourceIPFor(host)
+		if err != nil {
+			return nil, err
+		}
+		ipSrc = srcIP.String()
+	}
+
+	if isIPv6 {
+		opts = append(opts, pcapx.WithIPv6_NextHeader(proto))
+		opts = append(opts, pcapx.WithIPv6_HopLimit(64))
+		opts = append(opts, pcapx.WithIPv6_SrcIP(ipSrc))
+		opts = append(opts, pcapx.WithIPv6_DstIP(host))
+		return opts, nil
+	}
+
+	opts = append(opts, pcapx.WithIPv4_Flags(layers.IPv4DontFragment))
+	opts = append(opts, pcapx.WithIPv4_Version(4))
+	opts = append(opts, pcapx.WithIPv4_NextProtocol(proto))
+	opts = append(opts, pcapx.WithIPv4_TTL(64))
+	opts = append(opts, pcapx.WithIPv4_ID(40000+rand.Intn(10000)))
+	opts = append(opts, pcapx.WithIPv4_SrcIP(ipSrc))
+	opts = append(opts, pcapx.WithIPv4_DstIP(host))
+	opts = append(opts, pcapx.WithIPv4_Option(nil, nil))
+	return opts, nil
+}
+
 func (s *Scannerx) assembleArpPacket(host string) ([]byte, error) {
 	var opts []any
 	srcMac := s.config.SourceMac.String()
@@ -262,3 +285,21 @@ func (s *Scannerx) assembleArpPacket(host string) ([]byte, error) {
 	}
 	return packetBytes, nil
 }
+
+// assembleNdpPacket 构造 Neighbor Solicitation，发往目标的请求节点组播地址
+func (s *Scannerx) assembleNdpPacket(host string) ([]byte, error) {
+	srcIP, err := s.sourceIPFor(host)
+	if err != nil {
+		return nil, err
+	}
+	var opts []any
+	opts = append(opts, pcapx.WithEthernet_SrcMac(s.config.SourceMac))
+	opts = append(opts, pcapx.WithIPv6_SrcIP(srcIP))
+	opts = append(opts, pcapx.WithNDP_NeighborSolicitation(host, s.config.SourceMac))
+
+	packetBytes, err := pcapx.PacketBuilder(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return packetBytes, nil
+}
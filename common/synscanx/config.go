@@ -39,6 +39,9 @@ type SynxConfig struct {
 	Iface     *net.Interface
 	GatewayIP net.IP
 	SourceIP  net.IP
+	// IPv6 目标使用的源地址与网关，下一跳 MAC 地址通过 NDP 获取
+	GatewayIPv6 net.IP
+	SourceIPv6  net.IP
 	// 内网扫描时，目标机器的 MAC 地址来自 ARP
 	// 外网扫描时，目标机器的 MAC 地址就是网关的 MAC 地址
 	SourceMac, RemoteMac net.HardwareAddr
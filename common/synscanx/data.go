@@ -7,6 +7,8 @@ const (
 	UDP
 	ICMP
 	ARP
+	// NDP IPv6 邻居发现，替代 ARP
+	NDP
 )
 
 type SynxTarget struct {
@@ -4,6 +4,7 @@ import (
 	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/hostsparser"
+	"net"
 	"strconv"
 	"strings"
 	"time"
@@ -56,11 +57,17 @@ func (s *Scannerx) GetNonExcludedHosts(targets string) []string {
 				if s.excludedHost(_host) {
 					continue
 				}
-				if utils.IsIPv4(_host) || utils.IsIPv6(_host) {
+				if utils.IsIPv6(_host) {
+					nonExcludedHosts = append(nonExcludedHosts, canonicalIPv6(_host))
+				} else if utils.IsIPv4(_host) {
 					nonExcludedHosts = append(nonExcludedHosts, _host)
 				}
 			}
 		}
+		if utils.IsIPv6(host) {
+			// 统一为标准格式，和收包时解析出的源地址保持一致
+			host = canonicalIPv6(host)
+		}
 		if s.excludedHost(host) {
 			continue
 		}
@@ -74,3 +81,10 @@ func (s *Scannerx) GetNonExcludedHosts(targets string) []string {
 	}
 	return nonExcludedHosts
 }
+
+func canonicalIPv6(host string) string {
+	if ip := net.ParseIP(utils.FixForParseIP(host)); ip != nil {
+		return ip.String()
+	}
+	return host
+}
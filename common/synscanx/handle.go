@@ -8,6 +8,7 @@ import (
 	"github.com/google/gopacket/layers"
 	"github.com/yaklang/pcap"
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/pcapx/arpx"
 	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
 	"github.com/yaklang/yaklang/common/synscan"
 	"github.com/yaklang/yaklang/common/utils"
@@ -18,6 +19,14 @@ import (
 	"unicode/utf8"
 )
 
+// tcp[tcpflags] 只能匹配 IPv4，IPv6 在没有扩展头时 TCP flags 位于 ip6[53]
+// icmp6 用于接收 NDP 的 Neighbor Advertisement
+const (
+	synAckBPF   = "tcp[tcpflags] == tcp-syn|tcp-ack || (ip6[6] == 6 && ip6[53] & 0x12 == 0x12)"
+	etherBPF    = "ether dst %s && (arp || icmp6 || udp || " + synAckBPF + ")"
+	loopbackBPF = "udp || " + synAckBPF
+)
+
 // windows 的pcap 错误信息是gb18030编码的，需要转换成utf8
 func handleError(err error) error {
 	if err == nil {
@@ -56,11 +65,11 @@ func (s *Scannerx) initHandle() error {
 	var bpf string
 	if s.config.Iface.Flags&net.FlagLoopback == 0 {
 		// Interface is not loopback, set the filter.
-		bpf = fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String())
+		bpf = fmt.Sprintf(etherBPF, s.config.Iface.HardwareAddr.String())
 	} else {
 		// Interface is loopback, set a different filter.
 		// Replace the following line with the appropriate filter for your use case.
-		bpf = "udp || tcp[tcpflags] == tcp-syn|tcp-ack"
+		bpf = loopbackBPF
 	}
 	err = handle.SetBPFFilter(bpf)
 	if err != nil {
@@ -88,7 +97,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if s.config.Iface != nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: s.config.Iface.Name,
-				BPF:        fmt.Sprintf("ether dst %s && (arp || udp  || tcp[tcpflags] == tcp-syn|tcp-ack)", s.config.Iface.HardwareAddr.String()),
+				BPF:        fmt.Sprintf(etherBPF, s.config.Iface.HardwareAddr.String()),
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -99,7 +108,7 @@ func (s *Scannerx) initHandlerStart(ctx context.Context) error {
 		if err == nil {
 			adapters = append(adapters, &pcaputil.DeviceAdapter{
 				DeviceName: loop.Name,
-				BPF:        "udp || tcp[tcpflags] == tcp-syn|tcp-ack",
+				BPF:        loopbackBPF,
 				Snaplen:    128,
 				Promisc:    false,
 				Timeout:    pcap.BlockForever,
@@ -232,6 +241,12 @@ func (s *Scannerx) handlePacket(packet gopacket.Packet) {
 		}
 	}
 
+	// IPv6 的 NDP 邻居通告，等同于 ARP 应答
+	if ip, hw, ok := arpx.ParseNeighborAdvertisement(packet); ok {
+		s.onArp(ip, hw)
+		return
+	}
+
 	//if icmpLayer := packet.Layer(layers.LayerTypeICMPv4); icmpLayer != nil {
 	//	icmp := icmpLayer.(*layers.ICMPv4)
 	//
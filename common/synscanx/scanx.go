@@ -8,6 +8,7 @@ import (
 	"github.com/yaklang/yaklang/common/filter"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/pcapx"
 	"github.com/yaklang/yaklang/common/pcapx/pcaputil"
 	"github.com/yaklang/yaklang/common/synscan"
 	"github.com/yaklang/yaklang/common/utils"
@@ -47,6 +48,8 @@ type Scannerx struct {
 	ifaceIPNetV4 *net.IPNet
 	ifaceIPNetV6 *net.IPNet
 	ifaceUpdated bool
+	// 网卡的 IPv6 链路本地地址，扫描 fe80::/10 目标时作为源地址
+	linkLocalIPv6 net.IP
 
 	Handle    *pcap.Handle
 	limiter   *rate.Limiter
@@ -71,6 +74,24 @@ func getRoute(sampleIP string) (*net.Interface, net.IP, net.IP, error) {
 	return routeCache.iface, routeCache.gatewayIP, routeCache.srcIP, routeCache.err
 }
 
+// publicIPv6Sample 取样 IP 不是 IPv6 时，用于查询 IPv6 默认路由
+const publicIPv6Sample = "2001:4860:4860::8888"
+
+var routeCacheIPv6 struct {
+	iface     *net.Interface
+	gatewayIP net.IP
+	srcIP     net.IP
+	err       error
+	once      sync.Once
+}
+
+func getRouteIPv6() (*net.Interface, net.IP, net.IP, error) {
+	routeCacheIPv6.once.Do(func() {
+		routeCacheIPv6.iface, routeCacheIPv6.gatewayIP, routeCacheIPv6.srcIP, routeCacheIPv6.err = netutil.Route(time.Second*2, publicIPv6Sample)
+	})
+	return routeCacheIPv6.iface, routeCacheIPv6.gatewayIP, routeCacheIPv6.srcIP, routeCacheIPv6.err
+}
+
 func NewScannerx(ctx context.Context, sample string, config *SynxConfig) (*Scannerx, error) {
 	limitInterval := time.Duration(config.rateLimitDelayMs * float64(time.Millisecond))
 	s := &Scannerx{
@@ -142,15 +163,84 @@ func (s *Scannerx) initEssentialInfo() error {
 	}
 
 	s.config.Iface = iface
-	s.config.SourceIP = srcIP
 	s.config.SourceMac = iface.HardwareAddr
-	s.config.GatewayIP = gatewayIP
+	if srcIP.To4() != nil {
+		s.config.SourceIP = srcIP
+		s.config.GatewayIP = gatewayIP
+	} else {
+		// 取样 IP 为 IPv6 时，路由得到的是 IPv6 的源地址与网关
+		s.config.SourceIPv6 = srcIP
+		s.config.GatewayIPv6 = gatewayIP
+	}
+	s.initAddressFromIface()
 
 	// 不确定扫描目标中是否存在回环地址，所以这里先初始化一个回环地址的映射表
-	s.loopbackMap["127.0.0.1"] = s.config.SourceIP.String()
+	if s.config.SourceIP != nil {
+		s.loopbackMap["127.0.0.1"] = s.config.SourceIP.String()
+	}
+	if s.config.SourceIPv6 != nil {
+		s.loopbackMap["::1"] = s.config.SourceIPv6.String()
+	}
 	return nil
 }
 
+// initAddressFromIface 补全另一协议族的源地址，并获取 IPv6 网关
+func (s *Scannerx) initAddressFromIface() {
+	addrs, _ := s.config.Iface.Addrs()
+	for _, addr := range addrs {
+		ipNet, ok := addr.(*net.IPNet)
+		if !ok || ipNet == nil {
+			continue
+		}
+		ip := ipNet.IP
+		switch {
+		case ip.To4() != nil:
+			if s.config.SourceIP == nil {
+				s.config.SourceIP = ip
+			}
+		case ip.IsLinkLocalUnicast():
+			if s.linkLocalIPv6 == nil {
+				s.linkLocalIPv6 = ip
+			}
+		case ip.IsGlobalUnicast():
+			if s.config.SourceIPv6 == nil {
+				s.config.SourceIPv6 = ip
+			}
+		}
+	}
+	if s.config.SourceIPv6 == nil {
+		s.config.SourceIPv6 = s.linkLocalIPv6
+	}
+
+	if s.config.SourceIPv6 != nil && s.config.GatewayIPv6 == nil {
+		iface, gatewayIP, _, err := getRouteIPv6()
+		if err != nil {
+			log.Debugf("get ipv6 route failed: %v", err)
+			return
+		}
+		if iface != nil && iface.Name == s.config.Iface.Name && gatewayIP != nil && gatewayIP.To4() == nil {
+			s.config.GatewayIPv6 = gatewayIP
+		}
+	}
+}
+
+// sourceIPFor 根据目标地址族选择源地址
+func (s *Scannerx) sourceIPFor(host string) (net.IP, error) {
+	if !utils.IsIPv6(host) {
+		if s.config.SourceIP == nil {
+			return nil, utils.Errorf("iface %v has no ipv4 address", s.config.Iface.Name)
+		}
+		return s.config.SourceIP, nil
+	}
+	if s.linkLocalIPv6 != nil && pcapx.IsIPv6LinkLocal(host) {
+		return s.linkLocalIPv6, nil
+	}
+	if s.config.SourceIPv6 == nil {
+		return nil, utils.Errorf("iface %v has no ipv6 address", s.config.Iface.Name)
+	}
+	return s.config.SourceIPv6, nil
+}
+
 func (s *Scannerx) rateLimit() {
 	s.limiter.Wait(s.ctx)
 }
@@ -244,6 +334,9 @@ func (s *Scannerx) SubmitTargetFromPing(res chan string, ports string) <-chan *S
 					log.Infof("Resolving %s", host)
 					host = netx.LookupFirst(host, netx.WithTimeout(3*time.Second))
 				}
+				if utils.IsIPv6(host) {
+					host = canonicalIPv6(host)
+				}
 
 				if s.excludedHost(host) {
 					continue
@@ -459,6 +552,8 @@ func (s *Scannerx) assemblePacket(host string, port int, proto ProtocolType) ([]
 	case ICMP:
 	case ARP:
 		return s.assembleArpPacket(host)
+	case NDP:
+		return s.assembleNdpPacket(host)
 	}
 	return nil, nil
 }
@@ -235,6 +235,10 @@ func CheckSyntaxFlowLanguage(languageRaw string) (consts.Language, error) {
 		return consts.JS, nil
 	case "go":
 		return consts.GO, nil
+	case "py", "python", "python3":
+		return consts.PY, nil
+	case "c", "cpp", "c++", "cxx":
+		return consts.C, nil
 	}
 	return "", utils.Errorf("invalid language: %v is not supported yet", languageRaw)
 }
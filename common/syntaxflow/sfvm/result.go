@@ -215,6 +215,11 @@ func showValueMap(buf *bytes.Buffer, varName string, value ValueOperator, cfg *s
 		}
 
 		if cfg.showDot {
+			// the data flow path graph is the same as the code flows in sarif
+			if showPath, ok := v.(interface{ DataFlowPathDotGraph() string }); ok {
+				buf.WriteString(showPath.DataFlowPathDotGraph())
+				continue
+			}
 			showDot, ok := v.(interface{ DotGraph() string })
 			if !ok {
 				continue
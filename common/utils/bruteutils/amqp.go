@@ -0,0 +1,149 @@
+package bruteutils
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	amqp "github.com/rabbitmq/amqp091-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// amqpProtocolHeader AMQP 0-9-1 协议头
+var amqpProtocolHeader = []byte("AMQP\x00\x00\x09\x01")
+
+// amqpLogin 使用 PLAIN 机制登录默认 vhost
+func amqpLogin(target, username, password string) error {
+	host, port, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		return err
+	}
+	scheme := "amqp"
+	config := amqp.Config{
+		SASL:      []amqp.Authentication{&amqp.PlainAuth{Username: username, Password: password}},
+		Vhost:     "/",
+		Heartbeat: 10 * time.Second,
+		Locale:    "en_US",
+		Dial: func(network, addr string) (net.Conn, error) {
+			conn, err := netx.DialTCPTimeout(defaultTimeout, addr)
+			if err != nil {
+				return nil, err
+			}
+			_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+			return conn, nil
+		},
+	}
+	if port == 5671 {
+		scheme = "amqps"
+		config.TLSClientConfig = &tls.Config{InsecureSkipVerify: true}
+	}
+	u := fmt.Sprintf("%v://%v:%v@%v/", scheme, url.QueryEscape(username), url.QueryEscape(password), utils.HostPort(host, port))
+	conn, err := amqp.DialConfig(u, config)
+	if err != nil {
+		return err
+	}
+	return conn.Close()
+}
+
+var amqpAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "amqp",
+	DefaultPorts:     "5672,5671",
+	DefaultUsernames: append([]string{"guest", "admin", "rabbitmq", "mq"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"guest", "admin", "rabbitmq"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 5672)
+		_, port, _ := utils.ParseStringToHostPort(target)
+		if port == 5671 {
+			return result
+		}
+		// AMQP 没有匿名访问，这里只确认目标为 AMQP 0-9-1 服务（服务端应回复 Connection.Start）
+		conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		defer conn.Close()
+		_ = conn.SetDeadline(time.Now().Add(5 * time.Second))
+		_, _ = conn.Write(amqpProtocolHeader)
+		// frame header (7) + class-id/method-id (4)
+		raw := make([]byte, 11)
+		if _, err := io.ReadFull(conn, raw); err != nil || raw[0] != 1 || !bytes.Equal(raw[7:11], []byte{0, 10, 0, 10}) {
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		err := amqpLogin(appendDefaultPort(i.Target, 5672), i.Username, i.Password)
+		if err == nil {
+			result.Ok = true
+			return result
+		}
+		var amqpErr *amqp.Error
+		if errors.As(err, &amqpErr) && amqpErr.Code == amqp.NotAllowed {
+			// 认证通过但没有默认 vhost 的权限
+			result.Ok = true
+			result.ExtraInfo = []byte(amqpErr.Reason)
+			return result
+		}
+		log.Debugf("amqp login %v failed: %s", i.Target, err)
+		return result
+	},
+}
+
+// rabbitmqAuth RabbitMQ management 插件的 HTTP API
+var rabbitmqAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "rabbitmq",
+	DefaultPorts:     "15672",
+	DefaultUsernames: append([]string{"guest", "admin", "rabbitmq"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"guest", "admin", "rabbitmq"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 15672)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/api/whoami", nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 200 && bytes.Contains(httpBody(rsp), []byte(`"name"`)):
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		case code == 401:
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 15672)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/api/whoami", nil, nil, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("rabbitmq auth %v failed: %s", i.Target, err)
+			return result
+		}
+		if httpStatusCode(rsp) == 200 && bytes.Contains(httpBody(rsp), []byte(`"name"`)) {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
@@ -0,0 +1,112 @@
+package bruteutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// mockAMQPServer 只实现 Connection.Start/Tune/Open/Close，凭证错误时直接断开连接（与 RabbitMQ 行为一致）
+func mockAMQPServer(username, password string) (string, int) {
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		writeMethod := func(method uint16, args []byte) {
+			payload := []byte{0, 10, byte(method >> 8), byte(method)}
+			payload = append(payload, args...)
+			frame := []byte{1, 0, 0, 0, 0, 0, 0}
+			binary.BigEndian.PutUint32(frame[3:], uint32(len(payload)))
+			frame = append(frame, payload...)
+			conn.Write(append(frame, 0xce))
+		}
+		readMethod := func() (uint16, []byte) {
+			for {
+				header := make([]byte, 7)
+				if _, err := io.ReadFull(conn, header); err != nil {
+					return 0, nil
+				}
+				payload := make([]byte, binary.BigEndian.Uint32(header[3:])+1)
+				if _, err := io.ReadFull(conn, payload); err != nil {
+					return 0, nil
+				}
+				// 忽略心跳等非方法帧
+				if header[0] == 1 && len(payload) >= 5 {
+					return binary.BigEndian.Uint16(payload[2:4]), payload[4 : len(payload)-1]
+				}
+			}
+		}
+		longstr := func(s string) []byte {
+			raw := make([]byte, 4)
+			binary.BigEndian.PutUint32(raw, uint32(len(s)))
+			return append(raw, s...)
+		}
+
+		header := make([]byte, 8)
+		if _, err := io.ReadFull(conn, header); err != nil || !bytes.Equal(header, amqpProtocolHeader) {
+			return
+		}
+		var start bytes.Buffer
+		start.Write([]byte{0, 9, 0, 0, 0, 0})
+		start.Write(longstr("PLAIN AMQPLAIN"))
+		start.Write(longstr("en_US"))
+		writeMethod(10, start.Bytes())
+
+		method, args := readMethod()
+		if method != 11 || len(args) < 4 {
+			return
+		}
+		// Start-Ok: client-properties table, mechanism, response, locale
+		rest := args[4+binary.BigEndian.Uint32(args[:4]):]
+		rest = rest[1+int(rest[0]):]
+		response := rest[4 : 4+binary.BigEndian.Uint32(rest[:4])]
+		if !bytes.Equal(response, []byte("\x00"+username+"\x00"+password)) {
+			return
+		}
+		writeMethod(30, []byte{0, 0, 0, 2, 0, 0, 0, 0})
+		for {
+			method, _ := readMethod()
+			switch method {
+			case 31:
+			case 40:
+				writeMethod(41, []byte{0})
+			case 50:
+				writeMethod(51, nil)
+				return
+			default:
+				return
+			}
+		}
+	})
+}
+
+func TestAMQPAuth(t *testing.T) {
+	host, port := mockAMQPServer("guest", "guest")
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(amqpAuth, target, "guest", "admin").Ok)
+	require.True(t, testBrute(amqpAuth, target, "guest", "guest").Ok)
+
+	// 非 AMQP 服务
+	host, port = utils.DebugMockHTTP([]byte("HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"))
+	require.True(t, testBrute(amqpAuth, utils.HostPort(host, port), "guest", "guest").Finished)
+}
+
+func TestRabbitMQManagementAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.URL.Path != "/api/whoami" || !ok || username != "admin" || password != "rabbitmq" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"not_authorized","reason":"Login failed"}`))
+			return
+		}
+		w.Write([]byte(`{"name":"admin","tags":["administrator"]}`))
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(rabbitmqAuth, target, "admin", "guest").Ok)
+	require.True(t, testBrute(rabbitmqAuth, target, "admin", "rabbitmq").Ok)
+}
@@ -68,6 +68,22 @@ var AuthFunctionMap = []struct {
 	{Name: "socks_proxy/v4a", Data: "socks4a_proxy"},
 	{Name: "pptp", Data: "pptp"},
 	{Name: "ldap", Data: "ldap"},
+	{Name: "winrm", Data: "winrm"},
+	{Name: "kerberos", Data: "kerberos"},
+	{Name: "mqtt", Data: "mqtt"},
+	{Name: "amqp", Data: "amqp"},
+	{Name: "rabbitmq", Data: "rabbitmq"},
+	{Name: "elasticsearch", Data: "elasticsearch"},
+	{Name: "kibana", Data: "kibana"},
+	{Name: "couchdb", Data: "couchdb"},
+	{Name: "influxdb", Data: "influxdb"},
+	{Name: "etcd", Data: "etcd"},
+	{Name: "zookeeper", Data: "zookeeper"},
+	{Name: "cassandra", Data: "cassandra"},
+	{Name: "sip", Data: "sip"},
+	{Name: "xmpp", Data: "xmpp"},
+	{Name: "http_auth/basic", Data: "http_basic"},
+	{Name: "http_auth/form", Data: "http_form"},
 }
 
 // rdp https://palm/common/utils/bruteutils/grdp
@@ -103,6 +119,22 @@ var authFunc = map[string]*DefaultServiceAuthInfo{
 	"socks4a_proxy":  SocksProxyBruteAuthFactory("socks4a"),
 	"pptp":           pptp_Auth,
 	"ldap":           ldapAuth,
+	"winrm":          winrmAuth,
+	"kerberos":       kerberosAuth,
+	"mqtt":           mqttAuth,
+	"amqp":           amqpAuth,
+	"rabbitmq":       rabbitmqAuth,
+	"elasticsearch":  elasticsearchAuth,
+	"kibana":         kibanaAuth,
+	"couchdb":        couchdbAuth,
+	"influxdb":       influxdbAuth,
+	"etcd":           etcdAuth,
+	"zookeeper":      zookeeperAuth,
+	"cassandra":      cassandraAuth,
+	"sip":            sipAuth,
+	"xmpp":           xmppAuth,
+	"http_basic":     httpBasicAuth,
+	"http_form":      httpFormAuth,
 }
 
 func GetUsernameListFromBruteType(t string) []string {
@@ -0,0 +1,151 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// CQL native protocol v4 opcode
+const (
+	cqlOpError        = 0x00
+	cqlOpStartup      = 0x01
+	cqlOpReady        = 0x02
+	cqlOpAuthenticate = 0x03
+	cqlOpAuthResponse = 0x0f
+	cqlOpAuthSuccess  = 0x10
+)
+
+func cqlWriteFrame(conn net.Conn, opcode byte, body []byte) error {
+	header := make([]byte, 9)
+	header[0] = 0x04
+	header[4] = opcode
+	binary.BigEndian.PutUint32(header[5:], uint32(len(body)))
+	_, err := conn.Write(append(header, body...))
+	return err
+}
+
+func cqlReadFrame(conn net.Conn) (byte, []byte, error) {
+	header := make([]byte, 9)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return 0, nil, err
+	}
+	if header[0]&0x7f < 3 || header[0]&0x80 == 0 {
+		return 0, nil, utils.Errorf("invalid cql response version: %#x", header[0])
+	}
+	length := binary.BigEndian.Uint32(header[5:])
+	if length > 1<<20 {
+		return 0, nil, utils.Errorf("cql frame too large: %v", length)
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(conn, body); err != nil {
+		return 0, nil, err
+	}
+	return header[4], body, nil
+}
+
+func cqlStringMap(m map[string]string) []byte {
+	var buf bytes.Buffer
+	_ = binary.Write(&buf, binary.BigEndian, uint16(len(m)))
+	for k, v := range m {
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(k)))
+		buf.WriteString(k)
+		_ = binary.Write(&buf, binary.BigEndian, uint16(len(v)))
+		buf.WriteString(v)
+	}
+	return buf.Bytes()
+}
+
+// cqlErrorMessage ERROR 消息体为 [int code][string message]
+func cqlErrorMessage(body []byte) string {
+	if len(body) < 6 {
+		return ""
+	}
+	size := int(binary.BigEndian.Uint16(body[4:6]))
+	if 6+size > len(body) {
+		return ""
+	}
+	return string(body[6 : 6+size])
+}
+
+// cassandraLogin 发送 STARTUP，需要认证时使用 SASL PLAIN 发送 AUTH_RESPONSE
+// 返回 needAuth 表示服务端要求认证
+func cassandraLogin(target, username, password string) (needAuth bool, ok bool, err error) {
+	conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+	if err != nil {
+		return false, false, err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+
+	if err := cqlWriteFrame(conn, cqlOpStartup, cqlStringMap(map[string]string{"CQL_VERSION": "3.0.0"})); err != nil {
+		return false, false, err
+	}
+	opcode, body, err := cqlReadFrame(conn)
+	if err != nil {
+		return false, false, err
+	}
+	switch opcode {
+	case cqlOpReady:
+		return false, true, nil
+	case cqlOpAuthenticate:
+	case cqlOpError:
+		return false, false, utils.Errorf("cassandra startup failed: %s", cqlErrorMessage(body))
+	default:
+		return false, false, utils.Errorf("unexpected cql opcode: %#x", opcode)
+	}
+	if username == "" && password == "" {
+		return true, false, nil
+	}
+
+	token := []byte("\x00" + username + "\x00" + password)
+	auth := make([]byte, 4, 4+len(token))
+	binary.BigEndian.PutUint32(auth, uint32(len(token)))
+	if err := cqlWriteFrame(conn, cqlOpAuthResponse, append(auth, token...)); err != nil {
+		return true, false, err
+	}
+	opcode, body, err = cqlReadFrame(conn)
+	if err != nil {
+		return true, false, err
+	}
+	if opcode == cqlOpError {
+		log.Debugf("cassandra auth failed: %s", cqlErrorMessage(body))
+	}
+	return true, opcode == cqlOpAuthSuccess, nil
+}
+
+var cassandraAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "cassandra",
+	DefaultPorts:     "9042",
+	DefaultUsernames: append([]string{"cassandra", "admin"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"cassandra"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		needAuth, ok, err := cassandraLogin(appendDefaultPort(i.Target, 9042), "", "")
+		if err != nil {
+			log.Debugf("cassandra %v: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		if ok && !needAuth {
+			result.Ok = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		_, ok, err := cassandraLogin(appendDefaultPort(i.Target, 9042), i.Username, i.Password)
+		if err != nil {
+			log.Debugf("cassandra %v: %s", i.Target, err)
+			return result
+		}
+		result.Ok = ok
+		return result
+	},
+}
@@ -0,0 +1,68 @@
+package bruteutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func mockCassandra(requireAuth bool, username, password string) (string, int) {
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		writeFrame := func(opcode byte, body []byte) {
+			header := []byte{0x84, 0, 0, 0, opcode, 0, 0, 0, 0}
+			binary.BigEndian.PutUint32(header[5:], uint32(len(body)))
+			conn.Write(append(header, body...))
+		}
+		readFrame := func() (byte, []byte) {
+			header := make([]byte, 9)
+			if _, err := io.ReadFull(conn, header); err != nil {
+				return 0xff, nil
+			}
+			body := make([]byte, binary.BigEndian.Uint32(header[5:]))
+			if _, err := io.ReadFull(conn, body); err != nil {
+				return 0xff, nil
+			}
+			return header[4], body
+		}
+
+		if opcode, _ := readFrame(); opcode != cqlOpStartup {
+			return
+		}
+		if !requireAuth {
+			writeFrame(cqlOpReady, nil)
+			return
+		}
+		authenticator := "org.apache.cassandra.auth.PasswordAuthenticator"
+		writeFrame(cqlOpAuthenticate, append([]byte{0, byte(len(authenticator))}, authenticator...))
+		opcode, body := readFrame()
+		if opcode != cqlOpAuthResponse || len(body) < 4 {
+			return
+		}
+		if bytes.Equal(body[4:], []byte("\x00"+username+"\x00"+password)) {
+			writeFrame(cqlOpAuthSuccess, []byte{0xff, 0xff, 0xff, 0xff})
+			return
+		}
+		message := "Provided username and/or password are incorrect"
+		errBody := []byte{0, 0, 1, 0, 0, byte(len(message))}
+		writeFrame(cqlOpError, append(errBody, message...))
+	})
+}
+
+func TestCassandraAuth(t *testing.T) {
+	host, port := mockCassandra(true, "cassandra", "cassandra")
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(cassandraAuth, target, "cassandra", "admin").Ok)
+	require.True(t, testBrute(cassandraAuth, target, "cassandra", "cassandra").Ok)
+
+	host, port = mockCassandra(false, "", "")
+	result := testBrute(cassandraAuth, utils.HostPort(host, port), "cassandra", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,60 @@
+package bruteutils
+
+import (
+	"bytes"
+	"net/http"
+	"regexp"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+// couchdbSessionUserRegexp /_session 中 userCtx.name 不为 null 时说明认证成功
+var couchdbSessionUserRegexp = regexp.MustCompile(`"userCtx"\s*:\s*\{\s*"name"\s*:\s*"`)
+
+var couchdbAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "couchdb",
+	DefaultPorts:     "5984,6984",
+	DefaultUsernames: []string{"admin", "couchdb", "root"},
+	DefaultPasswords: append([]string{"couchdb", "password"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 5984)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		// admin party：未设置管理员时任何人都可以列出数据库
+		rsp, err := target.request(http.MethodGet, "/_all_dbs", nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 200 && bytes.HasPrefix(bytes.TrimSpace(httpBody(rsp)), []byte("[")):
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		case code == 401 || code == 403:
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 5984)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/_session", nil, nil, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("couchdb auth %v failed: %s", i.Target, err)
+			return result
+		}
+		if httpStatusCode(rsp) == 200 && couchdbSessionUserRegexp.Match(httpBody(rsp)) {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
@@ -0,0 +1,47 @@
+package bruteutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestCouchDBAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		authed := ok && username == "admin" && password == "couchdb"
+		switch r.URL.Path {
+		case "/_all_dbs":
+			if !authed {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"unauthorized","reason":"You are not a server admin."}`))
+				return
+			}
+			w.Write([]byte(`["_users"]`))
+		case "/_session":
+			if ok && !authed {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"error":"unauthorized","reason":"Name or password is incorrect."}`))
+				return
+			}
+			if !authed {
+				w.Write([]byte(`{"ok":true,"userCtx":{"name":null,"roles":[]}}`))
+				return
+			}
+			w.Write([]byte(`{"ok":true,"userCtx":{"name":"admin","roles":["_admin"]}}`))
+		}
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(couchdbAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(couchdbAuth, target, "admin", "couchdb").Ok)
+
+	// admin party
+	host, port = utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`["_replicator","_users","secret"]`))
+	})
+	result := testBrute(couchdbAuth, utils.HostPort(host, port), "admin", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,114 @@
+package bruteutils
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+func isElasticsearchResponse(body []byte) bool {
+	return bytes.Contains(body, []byte(`"cluster_name"`)) || bytes.Contains(body, []byte("You Know, for Search"))
+}
+
+var elasticsearchAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "elasticsearch",
+	DefaultPorts:     "9200",
+	DefaultUsernames: []string{"elastic", "admin", "kibana", "kibana_system", "logstash_system", "beats_system", "apm_system", "remote_monitoring_user"},
+	DefaultPasswords: append([]string{"changeme", "elastic", "elasticsearch"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 9200)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/", nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 200 && isElasticsearchResponse(httpBody(rsp)):
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		case code == 401:
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 9200)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/_security/_authenticate", nil, nil, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("elasticsearch auth %v failed: %s", i.Target, err)
+			return result
+		}
+		if httpStatusCode(rsp) == 200 && bytes.Contains(httpBody(rsp), []byte(`"username"`)) {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
+
+var kibanaAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "kibana",
+	DefaultPorts:     "5601",
+	DefaultUsernames: []string{"elastic", "kibana", "admin"},
+	DefaultPasswords: append([]string{"changeme", "elastic", "kibana"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 5601)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, "/api/status", nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 200 && bytes.Contains(httpBody(rsp), []byte(`"status"`)):
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		case code == 401:
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 5601)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		// kibana 同样接受 Basic 认证，6.x 及以前的版本使用 /api/security/v1/me
+		for _, path := range []string{"/internal/security/me", "/api/security/v1/me"} {
+			rsp, err := target.request(http.MethodGet, path, map[string]string{"kbn-xsrf": "true"}, nil, i.Username, i.Password)
+			if err != nil {
+				log.Debugf("kibana auth %v failed: %s", i.Target, err)
+				return result
+			}
+			code := httpStatusCode(rsp)
+			if code == 404 {
+				continue
+			}
+			if code == 200 && bytes.Contains(httpBody(rsp), []byte(`"username"`)) {
+				result.Ok = true
+				result.ExtraInfo = rsp.RawPacket
+			}
+			break
+		}
+		return result
+	},
+}
@@ -0,0 +1,56 @@
+package bruteutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestElasticsearchAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "elastic" || password != "changeme" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="security" charset="UTF-8"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":{"type":"security_exception"},"status":401}`))
+			return
+		}
+		switch r.URL.Path {
+		case "/_security/_authenticate":
+			w.Write([]byte(`{"username":"elastic","roles":["superuser"]}`))
+		default:
+			w.Write([]byte(`{"cluster_name":"yak","tagline":"You Know, for Search"}`))
+		}
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(elasticsearchAuth, target, "elastic", "elastic").Ok)
+	require.True(t, testBrute(elasticsearchAuth, target, "elastic", "changeme").Ok)
+
+	host, port = utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"name":"node-1","cluster_name":"yak","tagline":"You Know, for Search"}`))
+	})
+	result := testBrute(elasticsearchAuth, utils.HostPort(host, port), "elastic", "elastic")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Username)
+}
+
+func TestKibanaAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "kibana" || password != "kibana123" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		// 旧版本 kibana 只有 /api/security/v1/me
+		if r.URL.Path != "/api/security/v1/me" || r.Header.Get("kbn-xsrf") == "" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"username":"kibana","roles":["kibana_admin"]}`))
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(kibanaAuth, target, "kibana", "changeme").Ok)
+	require.True(t, testBrute(kibanaAuth, target, "kibana", "kibana123").Ok)
+}
@@ -0,0 +1,86 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+// etcdAPIPrefixes v3 的 grpc-gateway 在 3.3 及以前的版本中为 /v3beta 与 /v3alpha
+var etcdAPIPrefixes = []string{"/v3", "/v3beta", "/v3alpha"}
+
+// etcdRequest 依次尝试不同版本的 api 前缀，返回第一个不是 404 的响应
+func etcdRequest(target *httpBruteTarget, path string, body any, headers map[string]string) (int, []byte, error) {
+	raw, _ := json.Marshal(body)
+	if headers == nil {
+		headers = make(map[string]string)
+	}
+	headers["Content-Type"] = "application/json"
+	var lastCode int
+	var lastBody []byte
+	for _, prefix := range etcdAPIPrefixes {
+		rsp, err := target.request(http.MethodPost, prefix+path, headers, raw, "", "")
+		if err != nil {
+			return 0, nil, err
+		}
+		lastCode, lastBody = httpStatusCode(rsp), httpBody(rsp)
+		if lastCode != 404 {
+			return lastCode, lastBody, nil
+		}
+	}
+	return lastCode, lastBody, nil
+}
+
+var etcdAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "etcd",
+	DefaultPorts:     "2379",
+	DefaultUsernames: []string{"root", "admin", "etcd"},
+	DefaultPasswords: append([]string{"etcd"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 2379)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		// 读取一个 key，未开启认证时可以直接访问
+		code, body, err := etcdRequest(target, "/kv/range", map[string]any{"key": "AA==", "limit": 1}, nil)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch {
+		case code == 200 && bytes.Contains(body, []byte(`"header"`)):
+			result.Ok = true
+			result.ExtraInfo = body
+		case bytes.Contains(body, []byte("etcdserver:")):
+			// etcdserver: user name is empty / invalid auth token
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 2379)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		code, body, err := etcdRequest(target, "/auth/authenticate", map[string]any{"name": i.Username, "password": i.Password}, nil)
+		if err != nil {
+			log.Debugf("etcd auth %v failed: %s", i.Target, err)
+			return result
+		}
+		var rsp struct {
+			Token string `json:"token"`
+		}
+		if code == 200 && json.Unmarshal(body, &rsp) == nil && rsp.Token != "" {
+			result.Ok = true
+			result.ExtraInfo = body
+		}
+		return result
+	},
+}
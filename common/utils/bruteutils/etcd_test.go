@@ -0,0 +1,45 @@
+package bruteutils
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestEtcdAuth(t *testing.T) {
+	// 3.3 版本的 grpc-gateway 只有 /v3beta
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v3beta/kv/range":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"etcdserver: user name is empty","code":2}`))
+		case "/v3beta/auth/authenticate":
+			var req struct {
+				Name     string `json:"name"`
+				Password string `json:"password"`
+			}
+			_ = json.NewDecoder(r.Body).Decode(&req)
+			if req.Name != "root" || req.Password != "etcd" {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte(`{"error":"etcdserver: authentication failed, invalid user ID or password","code":3}`))
+				return
+			}
+			w.Write([]byte(`{"header":{"cluster_id":"1"},"token":"yak.token"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(etcdAuth, target, "root", "root").Ok)
+	require.True(t, testBrute(etcdAuth, target, "root", "etcd").Ok)
+
+	host, port = utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"header":{"cluster_id":"1","revision":"5"},"count":"0"}`))
+	})
+	result := testBrute(etcdAuth, utils.HostPort(host, port), "root", "root")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,407 @@
+package bruteutils
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+var httpBruteTlsCache = utils.NewTTLCache[bool](30 * time.Minute)
+
+// httpBruteTarget 目标可以是 URL 或 host:port，未指定协议时探测是否为 TLS 服务
+type httpBruteTarget struct {
+	Host  string
+	Port  int
+	IsTls bool
+	// Path 目标 URL 中的路径，默认为 /
+	Path string
+}
+
+func (t *httpBruteTarget) Addr() string {
+	return utils.HostPort(t.Host, t.Port)
+}
+
+func (t *httpBruteTarget) URL(path string) string {
+	scheme := "http"
+	if t.IsTls {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%v://%v%v", scheme, t.Addr(), path)
+}
+
+func parseHTTPBruteTarget(target string, defaultPort int) (*httpBruteTarget, error) {
+	t := &httpBruteTarget{Path: "/"}
+	if strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://") {
+		u, err := url.Parse(target)
+		if err != nil {
+			return nil, utils.Errorf("parse target url %v failed: %s", target, err)
+		}
+		t.IsTls = u.Scheme == "https"
+		host, port, err := utils.ParseStringToHostPort(u.Host)
+		if err != nil {
+			host, port = u.Host, 80
+			if t.IsTls {
+				port = 443
+			}
+		}
+		t.Host, t.Port = host, port
+		if u.RequestURI() != "" {
+			t.Path = u.RequestURI()
+		}
+		return t, nil
+	}
+
+	host, port, err := utils.ParseStringToHostPort(appendDefaultPort(target, defaultPort))
+	if err != nil {
+		return nil, err
+	}
+	t.Host, t.Port = host, port
+	addr := t.Addr()
+	if isTls, ok := httpBruteTlsCache.Get(addr); ok {
+		t.IsTls = isTls
+	} else {
+		t.IsTls = netx.IsTLSService(addr)
+		httpBruteTlsCache.Set(addr, t.IsTls)
+	}
+	return t, nil
+}
+
+// do 发送数据包，Host 头由目标决定，不跟随跳转
+func (t *httpBruteTarget) do(packet []byte) (*lowhttp.LowhttpResponse, error) {
+	packet = lowhttp.ReplaceHTTPPacketHeader(packet, "Host", t.Addr())
+	return lowhttp.HTTP(
+		lowhttp.WithHttps(t.IsTls),
+		lowhttp.WithHost(t.Host),
+		lowhttp.WithPort(t.Port),
+		lowhttp.WithTimeout(defaultTimeout),
+		lowhttp.WithRedirectTimes(0),
+		lowhttp.WithPacketBytes(packet),
+	)
+}
+
+// request 构造并发送请求，username 与 password 都为空时不携带 Basic 认证
+func (t *httpBruteTarget) request(method, path string, headers map[string]string, body []byte, username, password string) (*lowhttp.LowhttpResponse, error) {
+	packet := []byte(fmt.Sprintf("%v %v HTTP/1.1\r\nHost: %v\r\nUser-Agent: Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36\r\nAccept: */*\r\n\r\n", method, path, t.Addr()))
+	for k, v := range headers {
+		packet = lowhttp.ReplaceHTTPPacketHeader(packet, k, v)
+	}
+	if username != "" || password != "" {
+		packet = lowhttp.ReplaceHTTPPacketBasicAuth(packet, username, password)
+	}
+	if body != nil || method == http.MethodPost || method == http.MethodPut {
+		packet = lowhttp.ReplaceHTTPPacketBody(packet, body, false)
+	}
+	return t.do(packet)
+}
+
+func httpStatusCode(rsp *lowhttp.LowhttpResponse) int {
+	if rsp == nil {
+		return 0
+	}
+	return lowhttp.GetStatusCodeFromResponse(rsp.RawPacket)
+}
+
+func httpBody(rsp *lowhttp.LowhttpResponse) []byte {
+	if rsp == nil {
+		return nil
+	}
+	return lowhttp.GetHTTPPacketBody(rsp.RawPacket)
+}
+
+// httpBasicAuth 针对 HTTP Basic 认证保护的页面，目标即受保护的 URL
+var httpBasicAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "http_basic",
+	DefaultPorts:     "80,443,8080,8443",
+	DefaultUsernames: append([]string{"admin", "root", "user"}, CommonUsernames...),
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 80)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, target.Path, nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 401 && strings.Contains(strings.ToLower(lowhttp.GetHTTPPacketHeader(rsp.RawPacket, "WWW-Authenticate")), "basic"):
+			return result
+		case code >= 200 && code < 300:
+			// 页面没有认证保护
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 80)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, target.Path, nil, nil, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("http basic auth %v failed: %s", i.Target, err)
+			return result
+		}
+		if code := httpStatusCode(rsp); code != 401 && code != 403 && code < 500 {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
+
+// httpLoginForm 登录页面中的表单
+type httpLoginForm struct {
+	Method        string
+	Action        string
+	UsernameField string
+	PasswordField string
+	// Fields 其他字段，包括隐藏的 CSRF token
+	Fields  url.Values
+	Cookies []*http.Cookie
+}
+
+var (
+	httpFormUsernameRegexp = regexp.MustCompile(`(?i)(user|name|login|account|email|mail|uid)`)
+	httpFormCSRFRegexp     = regexp.MustCompile(`(?i)(csrf|xsrf|token|authenticity|nonce|verification)`)
+	httpFormFailedRegexp   = regexp.MustCompile(`(?i)(invalid|incorrect|wrong|failed|denied|错误|失败|不正确)`)
+)
+
+// parseHTTPLoginForm 从页面中找到包含密码输入框的表单，保留其中的隐藏字段（CSRF token 等）
+// 页面 meta 中的 csrf-token 会以同名字段与 X-CSRF-Token 头两种方式提交
+func parseHTTPLoginForm(body []byte) (*httpLoginForm, string) {
+	doc, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return nil, ""
+	}
+	metaToken, _ := doc.Find(`meta[name="csrf-token"], meta[name="_csrf"], meta[name="csrf_token"]`).First().Attr("content")
+
+	var form *httpLoginForm
+	doc.Find("form").EachWithBreak(func(_ int, s *goquery.Selection) bool {
+		password := s.Find(`input[type="password"]`).First()
+		if password.Length() <= 0 {
+			return true
+		}
+		form = &httpLoginForm{
+			Method: strings.ToUpper(strings.TrimSpace(s.AttrOr("method", "POST"))),
+			Action: strings.TrimSpace(s.AttrOr("action", "")),
+			Fields: make(url.Values),
+		}
+		form.PasswordField = password.AttrOr("name", "password")
+		s.Find("input").Each(func(_ int, input *goquery.Selection) {
+			name, ok := input.Attr("name")
+			if !ok || name == "" || name == form.PasswordField {
+				return
+			}
+			typ := strings.ToLower(input.AttrOr("type", "text"))
+			switch typ {
+			case "text", "email", "tel", "":
+				if form.UsernameField == "" && httpFormUsernameRegexp.MatchString(name) {
+					form.UsernameField = name
+					return
+				}
+			case "submit", "button", "image", "reset", "file":
+				return
+			case "checkbox", "radio":
+				if _, checked := input.Attr("checked"); !checked {
+					return
+				}
+			}
+			form.Fields.Set(name, input.AttrOr("value", ""))
+		})
+		if form.UsernameField == "" {
+			// 没有匹配到用户名时使用第一个文本框
+			s.Find(`input[type="text"], input[type="email"], input:not([type])`).EachWithBreak(func(_ int, input *goquery.Selection) bool {
+				if name := input.AttrOr("name", ""); name != "" {
+					form.UsernameField = name
+					form.Fields.Del(name)
+					return false
+				}
+				return true
+			})
+		}
+		return false
+	})
+	if form == nil {
+		return nil, metaToken
+	}
+	if form.Method != http.MethodGet {
+		form.Method = http.MethodPost
+	}
+	return form, metaToken
+}
+
+// httpFormLogin 获取登录页（以及 Cookie 与 CSRF token）后提交表单，返回提交后的响应与登录页原始表单
+func httpFormLogin(target *httpBruteTarget, username, password string) (*lowhttp.LowhttpResponse, *httpLoginForm, error) {
+	page, err := target.request(http.MethodGet, target.Path, nil, nil, "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+	form, metaToken := parseHTTPLoginForm(httpBody(page))
+	if form == nil {
+		return nil, nil, utils.Errorf("no login form found in %v", target.URL(target.Path))
+	}
+	form.Cookies = lowhttp.ExtractCookieJarFromHTTPResponse(page.RawPacket)
+
+	values := make(url.Values)
+	for k, v := range form.Fields {
+		values[k] = v
+	}
+	if form.UsernameField != "" {
+		values.Set(form.UsernameField, username)
+	}
+	values.Set(form.PasswordField, password)
+
+	action := target.Path
+	if form.Action != "" {
+		base, _ := url.Parse(target.URL(target.Path))
+		if u, err := base.Parse(form.Action); err == nil {
+			action = u.RequestURI()
+		}
+	}
+
+	headers := map[string]string{
+		"Referer": target.URL(target.Path),
+		"Origin":  target.URL(""),
+	}
+	if len(form.Cookies) > 0 {
+		headers["Cookie"] = lowhttp.CookiesToString(form.Cookies)
+	}
+	if metaToken != "" {
+		headers["X-CSRF-Token"] = metaToken
+		hasToken := false
+		for k := range values {
+			hasToken = hasToken || httpFormCSRFRegexp.MatchString(k)
+		}
+		if !hasToken {
+			values.Set("_csrf", metaToken)
+		}
+	}
+	// XSRF-TOKEN cookie 需要通过请求头回传
+	for _, cookie := range form.Cookies {
+		if strings.EqualFold(cookie.Name, "XSRF-TOKEN") || strings.EqualFold(cookie.Name, "csrftoken") {
+			value, _ := url.QueryUnescape(cookie.Value)
+			headers["X-XSRF-TOKEN"] = value
+			headers["X-CSRFToken"] = value
+		}
+	}
+
+	var rsp *lowhttp.LowhttpResponse
+	if form.Method == http.MethodGet {
+		sep := "?"
+		if strings.Contains(action, "?") {
+			sep = "&"
+		}
+		rsp, err = target.request(http.MethodGet, action+sep+values.Encode(), headers, nil, "", "")
+	} else {
+		headers["Content-Type"] = "application/x-www-form-urlencoded"
+		rsp, err = target.request(http.MethodPost, action, headers, []byte(values.Encode()), "", "")
+	}
+	if err != nil {
+		return nil, form, err
+	}
+	return rsp, form, nil
+}
+
+// httpFormLoginFailedCache 记录每个目标使用错误密码登录时的响应特征，用于对比
+var httpFormLoginFailedCache = utils.NewTTLCache[*httpFormLoginFeature](30 * time.Minute)
+
+type httpFormLoginFeature struct {
+	StatusCode int
+	Location   string
+}
+
+func newHTTPFormLoginFeature(rsp *lowhttp.LowhttpResponse) *httpFormLoginFeature {
+	return &httpFormLoginFeature{
+		StatusCode: httpStatusCode(rsp),
+		Location:   lowhttp.GetHTTPPacketHeader(rsp.RawPacket, "Location"),
+	}
+}
+
+// isHTTPFormLoginSuccess 登录后的响应与错误密码的响应不同，且不再包含登录表单与错误提示
+func isHTTPFormLoginSuccess(rsp *lowhttp.LowhttpResponse, failed *httpFormLoginFeature) bool {
+	code := httpStatusCode(rsp)
+	if code >= 400 || code == 0 {
+		return false
+	}
+	feature := newHTTPFormLoginFeature(rsp)
+	if failed != nil && feature.StatusCode == failed.StatusCode && feature.Location == failed.Location {
+		if code >= 300 {
+			return false
+		}
+	}
+	if code >= 300 && code < 400 {
+		location := strings.ToLower(feature.Location)
+		return location != "" && !strings.Contains(location, "login") && !strings.Contains(location, "signin") && !strings.Contains(location, "error")
+	}
+	body := httpBody(rsp)
+	if form, _ := parseHTTPLoginForm(body); form != nil {
+		return false
+	}
+	return !httpFormFailedRegexp.Match(body)
+}
+
+// httpFormAuth 针对 HTML 登录表单，目标为登录页面 URL，每次尝试都会重新获取 Cookie 与 CSRF token
+var httpFormAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "http_form",
+	DefaultPorts:     "80,443,8080,8443",
+	DefaultUsernames: append([]string{"admin", "root", "user"}, CommonUsernames...),
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 80)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		if _, ok := httpFormLoginFailedCache.Get(target.URL(target.Path)); ok {
+			return result
+		}
+		rsp, _, err := httpFormLogin(target, utils.RandStringBytes(12), utils.RandStringBytes(16))
+		if err != nil {
+			// 没有登录表单，无需爆破
+			log.Debugf("http form login %v failed: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		httpFormLoginFailedCache.Set(target.URL(target.Path), newHTTPFormLoginFeature(rsp))
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 80)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, _, err := httpFormLogin(target, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("http form login %v failed: %s", i.Target, err)
+			return result
+		}
+		failed, _ := httpFormLoginFailedCache.Get(target.URL(target.Path))
+		if isHTTPFormLoginSuccess(rsp, failed) {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
@@ -0,0 +1,94 @@
+package bruteutils
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func testBrute(info *DefaultServiceAuthInfo, target, username, password string) *BruteItemResult {
+	return info.GetBruteHandler()(&BruteItem{
+		Type:     info.ServiceName,
+		Target:   target,
+		Username: username,
+		Password: password,
+	})
+}
+
+func TestHTTPBasicAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "admin" || password != "P@ssw0rd" {
+			w.Header().Set("WWW-Authenticate", `Basic realm="yak"`)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Write([]byte("welcome"))
+	})
+	target := fmt.Sprintf("http://%v/admin/", utils.HostPort(host, port))
+
+	require.False(t, testBrute(httpBasicAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(httpBasicAuth, target, "admin", "P@ssw0rd").Ok)
+
+	// 页面没有认证保护
+	host, port = utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("public"))
+	})
+	result := testBrute(httpBasicAuth, utils.HostPort(host, port), "admin", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
+
+func TestHTTPFormAuth_CSRF(t *testing.T) {
+	const csrfToken = "0a1b2c3d4e5f"
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/login":
+			http.SetCookie(w, &http.Cookie{Name: "session", Value: "s1"})
+			w.Write([]byte(`<html><body><form method="post" action="/do_login">
+<input type="hidden" name="csrf_token" value="` + csrfToken + `">
+<input type="text" name="account">
+<input type="password" name="pwd">
+<input type="submit" value="login">
+</form></body></html>`))
+		case r.Method == http.MethodPost && r.URL.Path == "/do_login":
+			_ = r.ParseForm()
+			cookie, err := r.Cookie("session")
+			if err != nil || cookie.Value != "s1" || r.PostFormValue("csrf_token") != csrfToken {
+				w.WriteHeader(http.StatusForbidden)
+				w.Write([]byte("csrf check failed"))
+				return
+			}
+			if r.PostFormValue("account") == "admin" && r.PostFormValue("pwd") == "admin123" {
+				http.Redirect(w, r, "/dashboard", http.StatusFound)
+				return
+			}
+			http.Redirect(w, r, "/login?error=1", http.StatusFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	})
+	target := fmt.Sprintf("http://%v/login", utils.HostPort(host, port))
+
+	require.False(t, testBrute(httpFormAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(httpFormAuth, target, "admin", "admin123").Ok)
+}
+
+func TestParseHTTPLoginForm(t *testing.T) {
+	form, token := parseHTTPLoginForm([]byte(`<html><head><meta name="csrf-token" content="meta-token"></head><body>
+<form id="search"><input name="q"></form>
+<form action="/session"><input name="authenticity_token" type="hidden" value="abc">
+<input name="user[email]" type="email"><input name="user[password]" type="password">
+<input type="checkbox" name="remember"></form></body></html>`))
+	require.NotNil(t, form)
+	require.Equal(t, "meta-token", token)
+	require.Equal(t, http.MethodPost, form.Method)
+	require.Equal(t, "/session", form.Action)
+	require.Equal(t, "user[email]", form.UsernameField)
+	require.Equal(t, "user[password]", form.PasswordField)
+	require.Equal(t, "abc", form.Fields.Get("authenticity_token"))
+	require.False(t, form.Fields.Has("remember"))
+}
@@ -0,0 +1,76 @@
+package bruteutils
+
+import (
+	"bytes"
+	"net/http"
+
+	"github.com/yaklang/yaklang/common/log"
+)
+
+const influxdbQueryPath = "/query?q=SHOW%20DATABASES"
+
+func isInfluxDBQueryResult(body []byte) bool {
+	return bytes.Contains(body, []byte(`"results"`)) && !bytes.Contains(body, []byte(`"error"`))
+}
+
+var influxdbAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "influxdb",
+	DefaultPorts:     "8086",
+	DefaultUsernames: []string{"admin", "root", "influxdb", "influx"},
+	DefaultPasswords: append([]string{"influxdb", "influx"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 8086)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		rsp, err := target.request(http.MethodGet, influxdbQueryPath, nil, nil, "", "")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code := httpStatusCode(rsp); {
+		case code == 200 && isInfluxDBQueryResult(httpBody(rsp)):
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		case code == 401 || code == 403:
+		default:
+			// 2.x 的 /query 同样需要 token，这里通过 /api/v2/signin 确认服务
+			signin, err := target.request(http.MethodPost, "/api/v2/signin", nil, nil, "", "")
+			if err != nil || httpStatusCode(signin) != 401 {
+				result.Finished = true
+			}
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseHTTPBruteTarget(i.Target, 8086)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		// 1.x 使用 Basic 认证查询
+		rsp, err := target.request(http.MethodGet, influxdbQueryPath, nil, nil, i.Username, i.Password)
+		if err != nil {
+			log.Debugf("influxdb auth %v failed: %s", i.Target, err)
+			return result
+		}
+		if httpStatusCode(rsp) == 200 && isInfluxDBQueryResult(httpBody(rsp)) {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+			return result
+		}
+		// 2.x 登录成功返回 204 与 session cookie
+		rsp, err = target.request(http.MethodPost, "/api/v2/signin", nil, nil, i.Username, i.Password)
+		if err != nil {
+			return result
+		}
+		if httpStatusCode(rsp) == 204 {
+			result.Ok = true
+			result.ExtraInfo = rsp.RawPacket
+		}
+		return result
+	},
+}
@@ -0,0 +1,58 @@
+package bruteutils
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func TestInfluxDBAuth_V1(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		if r.URL.Path != "/query" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if !ok || username != "root" || password != "influx" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"error":"unable to parse authentication credentials"}`))
+			return
+		}
+		w.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["_internal"]]}]}]}`))
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(influxdbAuth, target, "root", "root").Ok)
+	require.True(t, testBrute(influxdbAuth, target, "root", "influx").Ok)
+}
+
+func TestInfluxDBAuth_V2(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		username, password, ok := r.BasicAuth()
+		switch r.URL.Path {
+		case "/api/v2/signin":
+			if !ok || username != "admin" || password != "admin12345" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+				return
+			}
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`))
+		}
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(influxdbAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(influxdbAuth, target, "admin", "admin12345").Ok)
+}
+
+func TestInfluxDBAuth_UnAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"results":[{"statement_id":0,"series":[{"name":"databases","columns":["name"],"values":[["_internal"]]}]}]}`))
+	})
+	result := testBrute(influxdbAuth, utils.HostPort(host, port), "root", "root")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
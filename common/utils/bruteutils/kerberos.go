@@ -0,0 +1,614 @@
+package bruteutils
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rc4"
+	"crypto/sha1"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bruteutils/grdp/protocol/nla"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// Kerberos 消息类型与错误码 (RFC 4120)
+const (
+	krbMsgTypeASReq    = 10
+	krbMsgTypeASRep    = 11
+	krbMsgTypeKRBError = 30
+
+	krbErrPrincipalUnknown = 6
+	krbErrETypeNoSupport   = 14
+	krbErrClientRevoked    = 18
+	krbErrKeyExpired       = 23
+	krbErrPreAuthFailed    = 24
+	krbErrPreAuthRequired  = 25
+	krbErrWrongRealm       = 68
+
+	krbETypeAES128        = 17
+	krbETypeAES256        = 18
+	krbETypeRC4HMAC       = 23
+	krbPADataEncTimestamp = 2
+	krbPADataETypeInfo2   = 19
+)
+
+// krbSupportedETypes 按优先级排列的预认证加密类型
+var krbSupportedETypes = []int64{krbETypeAES256, krbETypeAES128, krbETypeRC4HMAC}
+
+// krbETypeInfo 预认证使用的加密类型，AES 需要 KDC 在 PA-ETYPE-INFO2 中给出的 salt 与迭代次数
+type krbETypeInfo struct {
+	EType      int64
+	Salt       string
+	Iterations int
+}
+
+func krbApplicationTag(n uint8) cbasn1.Tag {
+	return cbasn1.Tag(n) | cbasn1.Tag(0x60)
+}
+
+func krbContextTag(n uint8) cbasn1.Tag {
+	return cbasn1.Tag(n).Constructed().ContextSpecific()
+}
+
+func krbAddInt(b *cryptobyte.Builder, tag uint8, v int64) {
+	b.AddASN1(krbContextTag(tag), func(b *cryptobyte.Builder) {
+		b.AddASN1Int64(v)
+	})
+}
+
+func krbAddGeneralString(b *cryptobyte.Builder, s string) {
+	b.AddASN1(cbasn1.Tag(27), func(b *cryptobyte.Builder) {
+		b.AddBytes([]byte(s))
+	})
+}
+
+// krbAddPrincipal PrincipalName ::= SEQUENCE { name-type [0], name-string [1] SEQUENCE OF KerberosString }
+func krbAddPrincipal(b *cryptobyte.Builder, tag uint8, nameType int64, names ...string) {
+	b.AddASN1(krbContextTag(tag), func(b *cryptobyte.Builder) {
+		b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			krbAddInt(b, 0, nameType)
+			b.AddASN1(krbContextTag(1), func(b *cryptobyte.Builder) {
+				b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					for _, name := range names {
+						krbAddGeneralString(b, name)
+					}
+				})
+			})
+		})
+	})
+}
+
+// krbRC4HMACEncrypt RFC 4757 中的 RC4-HMAC 加密
+func krbRC4HMACEncrypt(password string, usage uint32, plaintext []byte) []byte {
+	key := nla.MD4(krbUTF16LE(password))
+	usageBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(usageBytes, usage)
+	k1 := nla.HMAC_MD5(key, usageBytes)
+
+	data := make([]byte, 8, 8+len(plaintext))
+	_, _ = rand.Read(data)
+	data = append(data, plaintext...)
+	checksum := nla.HMAC_MD5(k1, data)
+	k3 := nla.HMAC_MD5(k1, checksum)
+	c, _ := rc4.NewCipher(k3)
+	c.XORKeyStream(data, data)
+	return append(checksum, data...)
+}
+
+// krbAESEncrypt RFC 3962 中的 aes128/256-cts-hmac-sha1-96 加密
+func krbAESEncrypt(key []byte, usage uint32, plaintext []byte) []byte {
+	usageBytes := make([]byte, 5)
+	binary.BigEndian.PutUint32(usageBytes, usage)
+	usageBytes[4] = 0xaa
+	ke := krbAESDeriveKey(key, usageBytes)
+	usageBytes[4] = 0x55
+	ki := krbAESDeriveKey(key, usageBytes)
+
+	data := make([]byte, aes.BlockSize, aes.BlockSize+len(plaintext))
+	_, _ = rand.Read(data)
+	data = append(data, plaintext...)
+	mac := hmac.New(sha1.New, ki)
+	mac.Write(data)
+	return append(krbAESCTSEncrypt(ke, data), mac.Sum(nil)[:12]...)
+}
+
+// krbAESStringToKey 由密码、salt 生成 AES 密钥，keySize 为 16 (aes128) 或 32 (aes256)
+func krbAESStringToKey(password, salt string, iterations, keySize int) []byte {
+	if iterations <= 0 {
+		iterations = 4096
+	}
+	return krbAESDeriveKey(pbkdf2.Key([]byte(password), []byte(salt), iterations, keySize, sha1.New), []byte("kerberos"))
+}
+
+// krbAESDeriveKey RFC 3961 中的 DK(key, constant)，AES 的 random-to-key 为恒等变换
+func krbAESDeriveKey(key []byte, constant []byte) []byte {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil
+	}
+	in := krbNFold(constant, aes.BlockSize)
+	out := make([]byte, 0, len(key)+aes.BlockSize)
+	for len(out) < len(key) {
+		next := make([]byte, aes.BlockSize)
+		block.Encrypt(next, in)
+		out = append(out, next...)
+		in = next
+	}
+	return out[:len(key)]
+}
+
+// krbAESCTSEncrypt IV 为 0 的 AES-CBC 密文挪用模式，交换最后两个密文块并截断
+func krbAESCTSEncrypt(key []byte, plaintext []byte) []byte {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil
+	}
+	padded := make([]byte, (len(plaintext)+aes.BlockSize-1)/aes.BlockSize*aes.BlockSize)
+	copy(padded, plaintext)
+	out := make([]byte, len(padded))
+	cipher.NewCBCEncrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(out, padded)
+	if len(out) <= aes.BlockSize {
+		return out
+	}
+	last := len(out) - aes.BlockSize
+	secondLast := last - aes.BlockSize
+	ret := make([]byte, 0, len(plaintext))
+	ret = append(ret, out[:secondLast]...)
+	ret = append(ret, out[last:]...)
+	return append(ret, out[secondLast:secondLast+len(plaintext)-last]...)
+}
+
+// krbNFold RFC 3961 中的 n-fold，n 为输出的字节数
+func krbNFold(in []byte, n int) []byte {
+	inBits, outBits := len(in)*8, n*8
+	a, b := inBits, outBits
+	for b != 0 {
+		a, b = b, a%b
+	}
+	lcm := inBits / a * outBits
+
+	// 每次复制都比上一次多循环右移 13 位
+	buf := make([]byte, lcm/8)
+	for i := 0; i < lcm/inBits; i++ {
+		rotate := 13 * i % inBits
+		for j := 0; j < inBits; j++ {
+			src := (j - rotate + inBits) % inBits
+			if in[src/8]>>(7-src%8)&1 == 1 {
+				pos := i*inBits + j
+				buf[pos/8] |= 1 << (7 - pos%8)
+			}
+		}
+	}
+
+	// 按 n 字节分组做反码加法
+	out := make([]byte, n)
+	for i := 0; i < len(buf); i += n {
+		carry := 0
+		for j := n - 1; j >= 0; j-- {
+			sum := int(out[j]) + int(buf[i+j]) + carry
+			out[j], carry = byte(sum), sum>>8
+		}
+		for carry > 0 {
+			for j := n - 1; j >= 0 && carry > 0; j-- {
+				sum := int(out[j]) + carry
+				out[j], carry = byte(sum), sum>>8
+			}
+		}
+	}
+	return out
+}
+
+func krbUTF16LE(s string) []byte {
+	codes := utf16.Encode([]rune(s))
+	raw := make([]byte, len(codes)*2)
+	for i, c := range codes {
+		binary.LittleEndian.PutUint16(raw[i*2:], c)
+	}
+	return raw
+}
+
+// krbEncTimestamp PA-ENC-TIMESTAMP，以用户密码加密当前时间 (key usage 1)，info 为 nil 时使用 RC4-HMAC
+func krbEncTimestamp(password string, info *krbETypeInfo) []byte {
+	now := time.Now().UTC()
+	var ts cryptobyte.Builder
+	ts.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		b.AddASN1(krbContextTag(0), func(b *cryptobyte.Builder) {
+			b.AddASN1GeneralizedTime(now.Truncate(time.Second))
+		})
+		krbAddInt(b, 1, int64(now.Nanosecond()/1000))
+	})
+
+	etype := int64(krbETypeRC4HMAC)
+	var cipherText []byte
+	switch {
+	case info != nil && info.EType == krbETypeAES128:
+		etype = krbETypeAES128
+		cipherText = krbAESEncrypt(krbAESStringToKey(password, info.Salt, info.Iterations, 16), 1, ts.BytesOrPanic())
+	case info != nil && info.EType == krbETypeAES256:
+		etype = krbETypeAES256
+		cipherText = krbAESEncrypt(krbAESStringToKey(password, info.Salt, info.Iterations, 32), 1, ts.BytesOrPanic())
+	default:
+		cipherText = krbRC4HMACEncrypt(password, 1, ts.BytesOrPanic())
+	}
+
+	var encData cryptobyte.Builder
+	encData.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		krbAddInt(b, 0, etype)
+		b.AddASN1(krbContextTag(2), func(b *cryptobyte.Builder) {
+			b.AddASN1OctetString(cipherText)
+		})
+	})
+	return encData.BytesOrPanic()
+}
+
+// krbASReq 构造请求 krbtgt 的 AS-REQ，password 为 nil 时不携带预认证数据
+func krbASReq(realm, username string, password *string, info *krbETypeInfo) ([]byte, error) {
+	nonce := make([]byte, 4)
+	_, _ = rand.Read(nonce)
+
+	var b cryptobyte.Builder
+	b.AddASN1(krbApplicationTag(krbMsgTypeASReq), func(b *cryptobyte.Builder) {
+		b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			krbAddInt(b, 1, 5)
+			krbAddInt(b, 2, krbMsgTypeASReq)
+			if password != nil {
+				b.AddASN1(krbContextTag(3), func(b *cryptobyte.Builder) {
+					b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+						b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+							krbAddInt(b, 1, krbPADataEncTimestamp)
+							b.AddASN1(krbContextTag(2), func(b *cryptobyte.Builder) {
+								b.AddASN1OctetString(krbEncTimestamp(*password, info))
+							})
+						})
+					})
+				})
+			}
+			b.AddASN1(krbContextTag(4), func(b *cryptobyte.Builder) {
+				b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					// forwardable, renewable, canonicalize, renewable-ok
+					b.AddASN1(krbContextTag(0), func(b *cryptobyte.Builder) {
+						b.AddASN1BitString([]byte{0x40, 0x81, 0x00, 0x10})
+					})
+					krbAddPrincipal(b, 1, 1, username)
+					b.AddASN1(krbContextTag(2), func(b *cryptobyte.Builder) {
+						krbAddGeneralString(b, realm)
+					})
+					krbAddPrincipal(b, 3, 2, "krbtgt", realm)
+					b.AddASN1(krbContextTag(5), func(b *cryptobyte.Builder) {
+						b.AddASN1GeneralizedTime(time.Now().UTC().Add(24 * time.Hour).Truncate(time.Second))
+					})
+					krbAddInt(b, 7, int64(binary.BigEndian.Uint32(nonce)&math.MaxInt32))
+					b.AddASN1(krbContextTag(8), func(b *cryptobyte.Builder) {
+						b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+							for _, etype := range krbSupportedETypes {
+								b.AddASN1Int64(etype)
+							}
+						})
+					})
+				})
+			})
+		})
+	})
+	return b.Bytes()
+}
+
+// krbReply KDC 的响应，只解析爆破需要的字段
+type krbReply struct {
+	MsgType   int
+	ErrorCode int64
+	EData     []byte
+}
+
+func parseKrbReply(raw []byte) (*krbReply, error) {
+	s := cryptobyte.String(raw)
+	var body cryptobyte.String
+	var tag cbasn1.Tag
+	if !s.ReadAnyASN1(&body, &tag) {
+		return nil, utils.Error("invalid kerberos reply")
+	}
+	reply := &krbReply{}
+	switch tag {
+	case krbApplicationTag(krbMsgTypeASRep):
+		reply.MsgType = krbMsgTypeASRep
+		return reply, nil
+	case krbApplicationTag(krbMsgTypeKRBError):
+		reply.MsgType = krbMsgTypeKRBError
+	default:
+		return nil, utils.Errorf("unexpected kerberos message: %#x", uint8(tag))
+	}
+
+	var seq cryptobyte.String
+	if !body.ReadASN1(&seq, cbasn1.SEQUENCE) {
+		return nil, utils.Error("invalid KRB-ERROR")
+	}
+	found := false
+	for !seq.Empty() {
+		var field cryptobyte.String
+		var fieldTag cbasn1.Tag
+		if !seq.ReadAnyASN1(&field, &fieldTag) {
+			return nil, utils.Error("invalid KRB-ERROR field")
+		}
+		switch fieldTag {
+		case krbContextTag(6):
+			if !field.ReadASN1Int64WithTag(&reply.ErrorCode, cbasn1.INTEGER) {
+				return nil, utils.Error("invalid KRB-ERROR error-code")
+			}
+			found = true
+		case krbContextTag(12):
+			var edata cryptobyte.String
+			if field.ReadASN1(&edata, cbasn1.OCTET_STRING) {
+				reply.EData = edata
+			}
+		}
+	}
+	if !found {
+		return nil, utils.Error("KRB-ERROR without error-code")
+	}
+	return reply, nil
+}
+
+// preAuthEType 从 KDC_ERR_PREAUTH_REQUIRED 的 e-data (METHOD-DATA) 中解析 PA-ETYPE-INFO2，
+// 选择支持的加密类型，KDC 按优先级排列。未给出 salt 时使用默认的 realm + 用户名
+func (r *krbReply) preAuthEType(realm, user string) *krbETypeInfo {
+	methods := cryptobyte.String(r.EData)
+	var padataSeq cryptobyte.String
+	if !methods.ReadASN1(&padataSeq, cbasn1.SEQUENCE) {
+		return nil
+	}
+	for !padataSeq.Empty() {
+		var padata cryptobyte.String
+		if !padataSeq.ReadASN1(&padata, cbasn1.SEQUENCE) {
+			return nil
+		}
+		var padataType int64
+		var value cryptobyte.String
+		for !padata.Empty() {
+			var field cryptobyte.String
+			var fieldTag cbasn1.Tag
+			if !padata.ReadAnyASN1(&field, &fieldTag) {
+				return nil
+			}
+			switch fieldTag {
+			case krbContextTag(1):
+				field.ReadASN1Int64WithTag(&padataType, cbasn1.INTEGER)
+			case krbContextTag(2):
+				field.ReadASN1(&value, cbasn1.OCTET_STRING)
+			}
+		}
+		if padataType != krbPADataETypeInfo2 {
+			continue
+		}
+
+		var entries cryptobyte.String
+		if !value.ReadASN1(&entries, cbasn1.SEQUENCE) {
+			return nil
+		}
+		for !entries.Empty() {
+			var entry cryptobyte.String
+			if !entries.ReadASN1(&entry, cbasn1.SEQUENCE) {
+				return nil
+			}
+			info := &krbETypeInfo{EType: -1, Salt: realm + user}
+			for !entry.Empty() {
+				var field cryptobyte.String
+				var fieldTag cbasn1.Tag
+				if !entry.ReadAnyASN1(&field, &fieldTag) {
+					return nil
+				}
+				switch fieldTag {
+				case krbContextTag(0):
+					field.ReadASN1Int64WithTag(&info.EType, cbasn1.INTEGER)
+				case krbContextTag(1):
+					var salt cryptobyte.String
+					if field.ReadASN1(&salt, cbasn1.Tag(27)) {
+						info.Salt = string(salt)
+					}
+				case krbContextTag(2):
+					var params cryptobyte.String
+					if field.ReadASN1(&params, cbasn1.OCTET_STRING) && len(params) == 4 {
+						info.Iterations = int(binary.BigEndian.Uint32(params))
+					}
+				}
+			}
+			for _, etype := range krbSupportedETypes {
+				if info.EType == etype {
+					return info
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// krbExchange 通过 TCP 发送请求，TCP 上的消息带有 4 字节长度前缀
+func krbExchange(target string, req []byte) (*krbReply, error) {
+	conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	packet := make([]byte, 4, 4+len(req))
+	binary.BigEndian.PutUint32(packet, uint32(len(req)))
+	if _, err := conn.Write(append(packet, req...)); err != nil {
+		return nil, err
+	}
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header)
+	if length > 1<<20 {
+		return nil, utils.Errorf("kerberos reply too large: %v", length)
+	}
+	raw := make([]byte, length)
+	if _, err := io.ReadFull(conn, raw); err != nil {
+		return nil, err
+	}
+	return parseKrbReply(raw)
+}
+
+// krbSplitUser 用户名支持 user@REALM 与 REALM\user，未指定 realm 时根据目标域名推断
+func krbSplitUser(target, username string) (string, string) {
+	if realm, user, ok := strings.Cut(username, `\`); ok {
+		return strings.ToUpper(realm), user
+	}
+	if user, realm, ok := strings.Cut(username, "@"); ok {
+		return strings.ToUpper(realm), user
+	}
+	host, _, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		host = target
+	}
+	if host == "" || utils.IsIPv4(host) || utils.IsIPv6(host) || !strings.Contains(host, ".") {
+		return "", username
+	}
+	// dc01.corp.example.com -> CORP.EXAMPLE.COM
+	if labels := strings.Split(host, "."); len(labels) > 2 {
+		host = strings.Join(labels[1:], ".")
+	}
+	return strings.ToUpper(host), username
+}
+
+// kerberosUserCache 缓存用户是否存在，以及是否需要预认证
+var kerberosUserCache = utils.NewTTLCache[int64](30 * time.Minute)
+
+// kerberosETypeCache 缓存用户预认证使用的加密类型
+var kerberosETypeCache = utils.NewTTLCache[*krbETypeInfo](30 * time.Minute)
+
+var kerberosAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "kerberos",
+	DefaultPorts:     "88",
+	DefaultUsernames: []string{"administrator", "admin", "guest", "krbtgt", "sqlservice", "backup"},
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 88)
+		realm, _ := krbSplitUser(target, i.Username)
+		if realm == "" {
+			log.Warnf("kerberos %v: realm is unknown, use user@REALM as username", i.Target)
+			result.Finished = true
+			return result
+		}
+		key := fmt.Sprintf("%v|%v", target, realm)
+		if _, ok := kerberosUserCache.Get(key); ok {
+			return result
+		}
+		// 使用随机用户名确认 KDC 可用且 realm 正确
+		req, err := krbASReq(realm, utils.RandStringBytes(12), nil, nil)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		reply, err := krbExchange(target, req)
+		if err != nil || reply.MsgType != krbMsgTypeKRBError || reply.ErrorCode == krbErrWrongRealm {
+			log.Debugf("kerberos %v (realm %v) is not available: %v", i.Target, realm, err)
+			result.Finished = true
+			return result
+		}
+		kerberosUserCache.Set(key, reply.ErrorCode)
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 88)
+		realm, user := krbSplitUser(target, i.Username)
+		if realm == "" {
+			result.Finished = true
+			return result
+		}
+
+		// 先不带预认证请求，用于枚举用户以及发现不需要预认证的用户 (AS-REP Roasting)
+		userKey := fmt.Sprintf("%v|%v|%v", target, realm, strings.ToLower(user))
+		if _, ok := kerberosUserCache.Get(userKey); !ok {
+			req, err := krbASReq(realm, user, nil, nil)
+			if err != nil {
+				return result
+			}
+			reply, err := krbExchange(target, req)
+			if err != nil {
+				log.Debugf("kerberos %v enum user %v failed: %s", i.Target, user, err)
+				return result
+			}
+			if reply.MsgType == krbMsgTypeASRep {
+				result.Ok = true
+				result.Password = ""
+				result.UserEliminated = true
+				result.ExtraInfo = []byte(fmt.Sprintf("%v@%v does not require kerberos pre-authentication (AS-REP roastable)", user, realm))
+				return result
+			}
+			switch reply.ErrorCode {
+			case krbErrPrincipalUnknown, krbErrClientRevoked:
+				result.UserEliminated = true
+				return result
+			case krbErrETypeNoSupport:
+				log.Warnf("kerberos %v: KDC supports none of aes256/aes128/rc4-hmac for %v@%v", i.Target, user, realm)
+				result.Finished = true
+				return result
+			}
+			if info := reply.preAuthEType(realm, user); info != nil {
+				kerberosETypeCache.Set(userKey, info)
+			}
+			kerberosUserCache.Set(userKey, reply.ErrorCode)
+		}
+
+		info, _ := kerberosETypeCache.Get(userKey)
+		req, err := krbASReq(realm, user, &i.Password, info)
+		if err != nil {
+			return result
+		}
+		reply, err := krbExchange(target, req)
+		if err != nil {
+			log.Debugf("kerberos %v auth %v failed: %s", i.Target, user, err)
+			return result
+		}
+		if reply.MsgType == krbMsgTypeASRep {
+			result.Ok = true
+			return result
+		}
+		switch reply.ErrorCode {
+		case krbErrKeyExpired:
+			// 密码正确但已过期
+			result.Ok = true
+			result.ExtraInfo = []byte("password expired")
+		case krbErrClientRevoked, krbErrPrincipalUnknown:
+			// 账户被禁用或锁定
+			result.UserEliminated = true
+		case krbErrETypeNoSupport:
+			log.Warnf("kerberos %v: pre-authentication etype %v is not supported for %v@%v", i.Target, krbETypeName(info), user, realm)
+			result.Finished = true
+		case krbErrPreAuthFailed, krbErrPreAuthRequired:
+		default:
+			log.Debugf("kerberos %v auth %v: unexpected error code %v", i.Target, user, reply.ErrorCode)
+		}
+		return result
+	},
+}
+
+func krbETypeName(info *krbETypeInfo) string {
+	if info == nil {
+		return "rc4-hmac"
+	}
+	switch info.EType {
+	case krbETypeAES128:
+		return "aes128-cts-hmac-sha1-96"
+	case krbETypeAES256:
+		return "aes256-cts-hmac-sha1-96"
+	default:
+		return "rc4-hmac"
+	}
+}
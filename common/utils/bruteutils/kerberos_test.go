@@ -0,0 +1,351 @@
+package bruteutils
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rc4"
+	"crypto/sha1"
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bruteutils/grdp/protocol/nla"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+type mockKDCUser struct {
+	Password        string
+	NoPreAuth       bool
+	PasswordExpired bool
+	// AESSalt 不为空时用户只支持 aes256-cts-hmac-sha1-96 预认证
+	AESSalt string
+}
+
+// readKrbField 在 SEQUENCE 中找到指定的 context tag
+func readKrbField(seq cryptobyte.String, tag uint8) (cryptobyte.String, bool) {
+	for !seq.Empty() {
+		var field cryptobyte.String
+		var fieldTag cbasn1.Tag
+		if !seq.ReadAnyASN1(&field, &fieldTag) {
+			return nil, false
+		}
+		if fieldTag == krbContextTag(tag) {
+			return field, true
+		}
+	}
+	return nil, false
+}
+
+func krbRC4HMACDecrypt(password string, usage uint32, data []byte) ([]byte, bool) {
+	if len(data) < 24 {
+		return nil, false
+	}
+	key := nla.MD4(krbUTF16LE(password))
+	usageBytes := make([]byte, 4)
+	binary.LittleEndian.PutUint32(usageBytes, usage)
+	k1 := nla.HMAC_MD5(key, usageBytes)
+	checksum := data[:16]
+	k3 := nla.HMAC_MD5(k1, checksum)
+	plain := make([]byte, len(data)-16)
+	c, _ := rc4.NewCipher(k3)
+	c.XORKeyStream(plain, data[16:])
+	return plain[8:], hmac.Equal(nla.HMAC_MD5(k1, plain), checksum)
+}
+
+func krbAESDecrypt(key []byte, usage uint32, data []byte) ([]byte, bool) {
+	if len(data) < aes.BlockSize+12 {
+		return nil, false
+	}
+	usageBytes := make([]byte, 5)
+	binary.BigEndian.PutUint32(usageBytes, usage)
+	usageBytes[4] = 0xaa
+	ke := krbAESDeriveKey(key, usageBytes)
+	usageBytes[4] = 0x55
+	ki := krbAESDeriveKey(key, usageBytes)
+
+	cipherText, checksum := data[:len(data)-12], data[len(data)-12:]
+	// 还原为普通的 CBC 密文后解密
+	block, _ := aes.NewCipher(ke)
+	var plain []byte
+	if len(cipherText) == aes.BlockSize {
+		plain = make([]byte, aes.BlockSize)
+		block.Decrypt(plain, cipherText)
+	} else {
+		last := (len(cipherText) - 1) / aes.BlockSize * aes.BlockSize
+		secondLast := last - aes.BlockSize
+		dn := make([]byte, aes.BlockSize)
+		block.Decrypt(dn, cipherText[secondLast:last])
+		prev := append(append([]byte{}, cipherText[last:]...), dn[len(cipherText)-last:]...)
+		cbcText := append(append(append([]byte{}, cipherText[:secondLast]...), prev...), cipherText[secondLast:last]...)
+		plain = make([]byte, len(cbcText))
+		cipher.NewCBCDecrypter(block, make([]byte, aes.BlockSize)).CryptBlocks(plain, cbcText)
+		plain = plain[:len(cipherText)]
+	}
+	mac := hmac.New(sha1.New, ki)
+	mac.Write(plain)
+	return plain[aes.BlockSize:], hmac.Equal(mac.Sum(nil)[:12], checksum)
+}
+
+func mockKDC(t *testing.T, realm string, users map[string]*mockKDCUser) (string, int) {
+	krbError := func(code int64, edata ...[]byte) []byte {
+		var b cryptobyte.Builder
+		b.AddASN1(krbApplicationTag(krbMsgTypeKRBError), func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				krbAddInt(b, 0, 5)
+				krbAddInt(b, 1, krbMsgTypeKRBError)
+				b.AddASN1(krbContextTag(4), func(b *cryptobyte.Builder) {
+					b.AddASN1GeneralizedTime(time.Now().UTC().Truncate(time.Second))
+				})
+				krbAddInt(b, 5, 0)
+				krbAddInt(b, 6, code)
+				b.AddASN1(krbContextTag(9), func(b *cryptobyte.Builder) {
+					krbAddGeneralString(b, realm)
+				})
+				krbAddPrincipal(b, 10, 2, "krbtgt", realm)
+				for _, e := range edata {
+					b.AddASN1(krbContextTag(12), func(b *cryptobyte.Builder) {
+						b.AddASN1OctetString(e)
+					})
+				}
+			})
+		})
+		return b.BytesOrPanic()
+	}
+	// METHOD-DATA 中的 PA-ETYPE-INFO2
+	etypeInfo2 := func(etype int64, salt string) []byte {
+		var info cryptobyte.Builder
+		info.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				krbAddInt(b, 0, etype)
+				b.AddASN1(krbContextTag(1), func(b *cryptobyte.Builder) {
+					krbAddGeneralString(b, salt)
+				})
+			})
+		})
+		var methods cryptobyte.Builder
+		methods.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				krbAddInt(b, 1, krbPADataEncTimestamp)
+				b.AddASN1(krbContextTag(2), func(b *cryptobyte.Builder) {
+					b.AddASN1OctetString(nil)
+				})
+			})
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				krbAddInt(b, 1, krbPADataETypeInfo2)
+				b.AddASN1(krbContextTag(2), func(b *cryptobyte.Builder) {
+					b.AddASN1OctetString(info.BytesOrPanic())
+				})
+			})
+		})
+		return methods.BytesOrPanic()
+	}
+	asRep := func() []byte {
+		var b cryptobyte.Builder
+		b.AddASN1(krbApplicationTag(krbMsgTypeASRep), func(b *cryptobyte.Builder) {
+			b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+				krbAddInt(b, 0, 5)
+				krbAddInt(b, 1, krbMsgTypeASRep)
+			})
+		})
+		return b.BytesOrPanic()
+	}
+
+	handle := func(req cryptobyte.String) []byte {
+		var body, seq cryptobyte.String
+		if !req.ReadASN1(&body, krbApplicationTag(krbMsgTypeASReq)) || !body.ReadASN1(&seq, cbasn1.SEQUENCE) {
+			t.Error("invalid AS-REQ")
+			return krbError(krbErrETypeNoSupport)
+		}
+		reqBody, ok := readKrbField(seq, 4)
+		var kdcReqBody, cname, nameSeq, names, reqRealm, realmField cryptobyte.String
+		if !ok || !reqBody.ReadASN1(&kdcReqBody, cbasn1.SEQUENCE) {
+			t.Error("invalid KDC-REQ-BODY")
+			return krbError(krbErrETypeNoSupport)
+		}
+		if realmField, ok = readKrbField(kdcReqBody, 2); !ok || !realmField.ReadASN1(&reqRealm, cbasn1.Tag(27)) {
+			t.Error("invalid realm")
+		}
+		if string(reqRealm) != realm {
+			return krbError(krbErrWrongRealm)
+		}
+		var username cryptobyte.String
+		if cname, ok = readKrbField(kdcReqBody, 1); !ok || !cname.ReadASN1(&nameSeq, cbasn1.SEQUENCE) {
+			t.Error("invalid cname")
+		}
+		if names, ok = readKrbField(nameSeq, 1); !ok || !names.ReadASN1(&nameSeq, cbasn1.SEQUENCE) || !nameSeq.ReadASN1(&username, cbasn1.Tag(27)) {
+			t.Error("invalid cname name-string")
+		}
+
+		user, exists := users[string(username)]
+		if !exists {
+			return krbError(krbErrPrincipalUnknown)
+		}
+		if user.NoPreAuth {
+			return asRep()
+		}
+		padata, ok := readKrbField(seq, 3)
+		if !ok {
+			if user.AESSalt != "" {
+				return krbError(krbErrPreAuthRequired, etypeInfo2(krbETypeAES256, user.AESSalt))
+			}
+			return krbError(krbErrPreAuthRequired)
+		}
+		var paSeq, pa, paValue, encData, cipherField, cipherText cryptobyte.String
+		if !padata.ReadASN1(&paSeq, cbasn1.SEQUENCE) || !paSeq.ReadASN1(&pa, cbasn1.SEQUENCE) {
+			return krbError(krbErrPreAuthRequired)
+		}
+		if paValue, ok = readKrbField(pa, 2); !ok || !paValue.ReadASN1(&encData, cbasn1.OCTET_STRING) || !encData.ReadASN1(&encData, cbasn1.SEQUENCE) {
+			return krbError(krbErrPreAuthRequired)
+		}
+		var etype int64
+		if etypeField, ok := readKrbField(encData, 0); !ok || !etypeField.ReadASN1Int64WithTag(&etype, cbasn1.INTEGER) {
+			return krbError(krbErrPreAuthRequired)
+		}
+		if cipherField, ok = readKrbField(encData, 2); !ok || !cipherField.ReadASN1(&cipherText, cbasn1.OCTET_STRING) {
+			return krbError(krbErrPreAuthRequired)
+		}
+		var plain []byte
+		var valid bool
+		switch {
+		case user.AESSalt != "" && etype == krbETypeAES256:
+			plain, valid = krbAESDecrypt(krbAESStringToKey(user.Password, user.AESSalt, 4096, 32), 1, cipherText)
+		case user.AESSalt == "" && etype == krbETypeRC4HMAC:
+			plain, valid = krbRC4HMACDecrypt(user.Password, 1, cipherText)
+		default:
+			return krbError(krbErrETypeNoSupport)
+		}
+		if !valid {
+			return krbError(krbErrPreAuthFailed)
+		}
+		var tsSeq, tsField cryptobyte.String
+		var ts time.Time
+		plainString := cryptobyte.String(plain)
+		if !plainString.ReadASN1(&tsSeq, cbasn1.SEQUENCE) {
+			t.Error("invalid PA-ENC-TS-ENC")
+		}
+		if tsField, ok = readKrbField(tsSeq, 0); !ok || !tsField.ReadASN1GeneralizedTime(&ts) || time.Since(ts).Abs() > time.Minute {
+			t.Errorf("invalid pa timestamp: %v", ts)
+		}
+		if user.PasswordExpired {
+			return krbError(krbErrKeyExpired)
+		}
+		return asRep()
+	}
+
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		header := make([]byte, 4)
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+		req := make([]byte, binary.BigEndian.Uint32(header))
+		if _, err := io.ReadFull(conn, req); err != nil {
+			return
+		}
+		rsp := handle(req)
+		binary.BigEndian.PutUint32(header, uint32(len(rsp)))
+		conn.Write(append(header, rsp...))
+	})
+}
+
+func TestKerberosAuth(t *testing.T) {
+	host, port := mockKDC(t, "CORP.LOCAL", map[string]*mockKDCUser{
+		"alice":      {Password: "Summer2024!"},
+		"bob":        {Password: "Winter2023!", PasswordExpired: true},
+		"svc_backup": {Password: "backup", NoPreAuth: true},
+		"carol":      {Password: "Autumn2025!", AESSalt: "CORP.LOCALcarol"},
+	})
+	target := utils.HostPort(host, port)
+
+	require.False(t, testBrute(kerberosAuth, target, "alice@corp.local", "admin").Ok)
+	require.True(t, testBrute(kerberosAuth, target, "alice@corp.local", "Summer2024!").Ok)
+	require.True(t, testBrute(kerberosAuth, target, `CORP.LOCAL\bob`, "Winter2023!").Ok)
+
+	// 只支持 AES 的用户，使用 PA-ETYPE-INFO2 中的 salt
+	require.False(t, testBrute(kerberosAuth, target, "carol@corp.local", "admin").Ok)
+	result := testBrute(kerberosAuth, target, "carol@corp.local", "Autumn2025!")
+	require.True(t, result.Ok)
+	require.False(t, result.Finished)
+
+	// 用户不存在
+	result = testBrute(kerberosAuth, target, "nobody@CORP.LOCAL", "admin")
+	require.False(t, result.Ok)
+	require.True(t, result.UserEliminated)
+
+	// AS-REP Roasting
+	result = testBrute(kerberosAuth, target, "svc_backup@CORP.LOCAL", "whatever")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+	require.Contains(t, string(result.ExtraInfo), "AS-REP roastable")
+
+	// realm 错误或未知
+	require.True(t, testBrute(kerberosAuth, target, "alice@EXAMPLE.COM", "Summer2024!").Finished)
+	require.True(t, testBrute(kerberosAuth, target, "alice", "Summer2024!").Finished)
+}
+
+func TestKerberosSplitUser(t *testing.T) {
+	for _, c := range []struct {
+		target, username, realm, user string
+	}{
+		{"10.0.0.1:88", "alice@corp.local", "CORP.LOCAL", "alice"},
+		{"10.0.0.1:88", `CORP\alice`, "CORP", "alice"},
+		{"dc01.corp.local:88", "alice", "CORP.LOCAL", "alice"},
+		{"corp.local", "alice", "CORP.LOCAL", "alice"},
+		{"10.0.0.1:88", "alice", "", "alice"},
+	} {
+		realm, user := krbSplitUser(c.target, c.username)
+		require.Equal(t, c.realm, realm, c.target)
+		require.Equal(t, c.user, user, c.target)
+	}
+}
+
+func TestKerberosAESCrypto(t *testing.T) {
+	unhex := func(s string) []byte {
+		raw, err := hex.DecodeString(s)
+		require.NoError(t, err)
+		return raw
+	}
+
+	// RFC 3961 A.1
+	for _, c := range []struct {
+		in     string
+		n      int
+		expect string
+	}{
+		{"012345", 8, "be072631276b1955"},
+		{"password", 7, "78a07b6caf85fa"},
+		{"Rough Consensus, and Running Code", 8, "bb6ed30870b7f0e0"},
+		{"password", 21, "59e4a8ca7c0385c3c37b3f6d2000247cb6e6bd5b3e"},
+		{"MASSACHVSETTS INSTITVTE OF TECHNOLOGY", 24, "db3b0d8f0b061e603282b308a50841229ad798fab9540c1b"},
+		{"Q", 21, "518a54a215a8452a518a54a215a8452a518a54a215"},
+		{"kerberos", 16, "6b65726265726f737b9b5b2b93132b93"},
+	} {
+		require.Equal(t, c.expect, hex.EncodeToString(krbNFold([]byte(c.in), c.n)), c.in)
+	}
+
+	// RFC 3962 B
+	require.Equal(t, "42263c6e89f4fc28b8df68ee09799f15", hex.EncodeToString(krbAESStringToKey("password", "ATHENA.MIT.EDUraeburn", 1, 16)))
+	require.Equal(t, "fe697b52bc0d3ce14432ba036a92e65bbb52280990a2fa27883998d72af30161", hex.EncodeToString(krbAESStringToKey("password", "ATHENA.MIT.EDUraeburn", 1, 32)))
+	require.Equal(t, "55a6ac740ad17b4846941051e1e8b0a7548d93b0ab30a8bc3ff16280382b8c2a", hex.EncodeToString(krbAESStringToKey("password", "ATHENA.MIT.EDUraeburn", 1200, 32)))
+
+	key := []byte("chicken teriyaki")
+	require.Equal(t, unhex("c6353568f2bf8cb4d8a580362da7ff7f97"), krbAESCTSEncrypt(key, unhex("4920776f756c64206c696b652074686520")))
+	require.Equal(t, unhex("fc00783e0efdb2c1d445d4c8eff7ed2297687268d6ecccc0c07b25e25ecfe5"), krbAESCTSEncrypt(key, []byte("I would like the General Gau's ")))
+	require.Equal(t, unhex("39312523a78662d5be7fcbcc98ebf5a897687268d6ecccc0c07b25e25ecfe584"), krbAESCTSEncrypt(key, []byte("I would like the General Gau's C")))
+
+	baseKey := krbAESStringToKey("password", "ATHENA.MIT.EDUraeburn", 4096, 32)
+	for _, size := range []int{0, 5, 16, 33} {
+		plain := []byte(utils.RandStringBytes(size))
+		got, ok := krbAESDecrypt(baseKey, 1, krbAESEncrypt(baseKey, 1, plain))
+		require.True(t, ok)
+		require.Equal(t, plain, got)
+	}
+}
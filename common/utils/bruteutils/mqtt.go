@@ -0,0 +1,126 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// MQTT 3.1.1 CONNACK 返回码
+const (
+	mqttConnAccepted          = 0
+	mqttConnBadUserOrPassword = 4
+	mqttConnNotAuthorized     = 5
+)
+
+func mqttEncodeString(buf *bytes.Buffer, s string) {
+	_ = binary.Write(buf, binary.BigEndian, uint16(len(s)))
+	buf.WriteString(s)
+}
+
+func mqttEncodeRemainingLength(n int) []byte {
+	var raw []byte
+	for {
+		b := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			b |= 0x80
+		}
+		raw = append(raw, b)
+		if n <= 0 {
+			return raw
+		}
+	}
+}
+
+// mqttConnectPacket 构造 MQTT 3.1.1 CONNECT 报文，username 为空时不携带认证信息
+func mqttConnectPacket(clientId, username, password string) []byte {
+	var body bytes.Buffer
+	mqttEncodeString(&body, "MQTT")
+	body.WriteByte(4) // protocol level 3.1.1
+	flags := byte(0x02)
+	if username != "" {
+		flags |= 0x80
+		if password != "" {
+			flags |= 0x40
+		}
+	}
+	body.WriteByte(flags)
+	_ = binary.Write(&body, binary.BigEndian, uint16(30)) // keep alive
+	mqttEncodeString(&body, clientId)
+	if username != "" {
+		mqttEncodeString(&body, username)
+		if password != "" {
+			mqttEncodeString(&body, password)
+		}
+	}
+
+	packet := []byte{0x10}
+	packet = append(packet, mqttEncodeRemainingLength(body.Len())...)
+	return append(packet, body.Bytes()...)
+}
+
+// mqttConnect 发送 CONNECT 并返回 CONNACK 中的返回码
+func mqttConnect(target, username, password string) (int, error) {
+	conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+	if err != nil {
+		return -1, err
+	}
+	defer conn.Close()
+
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	if _, err := conn.Write(mqttConnectPacket("yak"+utils.RandStringBytes(8), username, password)); err != nil {
+		return -1, err
+	}
+	connack := make([]byte, 4)
+	if _, err := io.ReadFull(conn, connack); err != nil {
+		return -1, utils.Errorf("read mqtt connack failed: %s", err)
+	}
+	if connack[0] != 0x20 || connack[1] != 0x02 {
+		return -1, utils.Errorf("invalid mqtt connack: %x", connack)
+	}
+	// DISCONNECT
+	_, _ = conn.Write([]byte{0xe0, 0x00})
+	return int(connack[3]), nil
+}
+
+var mqttAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "mqtt",
+	DefaultPorts:     "1883",
+	DefaultUsernames: append([]string{"admin", "mqtt", "guest", "emqx", "mosquitto"}, CommonUsernames...),
+	DefaultPasswords: append([]string{"public", "mqtt", "guest", "admin", "password"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		code, err := mqttConnect(appendDefaultPort(i.Target, 1883), "", "")
+		if err != nil {
+			log.Debugf("mqtt connect %v failed: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		switch code {
+		case mqttConnAccepted:
+			result.Ok = true
+		case mqttConnBadUserOrPassword, mqttConnNotAuthorized:
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		code, err := mqttConnect(appendDefaultPort(i.Target, 1883), i.Username, i.Password)
+		if err != nil {
+			log.Debugf("mqtt connect %v failed: %s", i.Target, err)
+			return result
+		}
+		if code == mqttConnAccepted {
+			result.Ok = true
+		}
+		return result
+	},
+}
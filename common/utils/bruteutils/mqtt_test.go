@@ -0,0 +1,77 @@
+package bruteutils
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// mockMQTTBroker 解析 CONNECT 报文中的用户名密码并回复 CONNACK
+func mockMQTTBroker(check func(username, password string, hasUser bool) byte) (string, int) {
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		reader := bufio.NewReader(conn)
+		if b, err := reader.ReadByte(); err != nil || b != 0x10 {
+			return
+		}
+		length, multiplier := 0, 1
+		for {
+			b, err := reader.ReadByte()
+			if err != nil {
+				return
+			}
+			length += int(b&0x7f) * multiplier
+			multiplier *= 128
+			if b&0x80 == 0 {
+				break
+			}
+		}
+		body := make([]byte, length)
+		if _, err := io.ReadFull(reader, body); err != nil {
+			return
+		}
+		flags := body[7]
+		readString := func(raw []byte) (string, []byte) {
+			size := int(binary.BigEndian.Uint16(raw))
+			return string(raw[2 : 2+size]), raw[2+size:]
+		}
+		_, rest := readString(body[10:])
+		var username, password string
+		if flags&0x80 != 0 {
+			username, rest = readString(rest)
+		}
+		if flags&0x40 != 0 {
+			password, _ = readString(rest)
+		}
+		conn.Write([]byte{0x20, 0x02, 0x00, check(username, password, flags&0x80 != 0)})
+		_, _ = io.Copy(io.Discard, reader)
+	})
+}
+
+func TestMQTTAuth(t *testing.T) {
+	host, port := mockMQTTBroker(func(username, password string, hasUser bool) byte {
+		if !hasUser {
+			return mqttConnNotAuthorized
+		}
+		if username == "admin" && password == "public" {
+			return mqttConnAccepted
+		}
+		return mqttConnBadUserOrPassword
+	})
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(mqttAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(mqttAuth, target, "admin", "public").Ok)
+
+	host, port = mockMQTTBroker(func(string, string, bool) byte {
+		return mqttConnAccepted
+	})
+	result := testBrute(mqttAuth, utils.HostPort(host, port), "admin", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,227 @@
+package bruteutils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var sipDigestParamRegexp = regexp.MustCompile(`(\w+)=(?:"([^"]*)"|([^\s,]+))`)
+
+type sipResponse struct {
+	StatusCode int
+	Header     textproto.MIMEHeader
+}
+
+func parseSIPResponse(raw []byte) (*sipResponse, error) {
+	reader := textproto.NewReader(bufio.NewReader(bytes.NewReader(raw)))
+	line, err := reader.ReadLine()
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(line)
+	if len(fields) < 2 || !strings.HasPrefix(fields[0], "SIP/2.0") {
+		return nil, utils.Errorf("invalid sip response: %v", line)
+	}
+	code, err := strconv.Atoi(fields[1])
+	if err != nil {
+		return nil, utils.Errorf("invalid sip status code: %v", line)
+	}
+	header, _ := reader.ReadMIMEHeader()
+	return &sipResponse{StatusCode: code, Header: header}, nil
+}
+
+// sipDigestResponse RFC 2617 Digest（SIP 中常见 MD5 与 qop=auth）
+func sipDigestResponse(challenge, method, uri, username, password string) string {
+	params := make(map[string]string)
+	for _, m := range sipDigestParamRegexp.FindAllStringSubmatch(challenge, -1) {
+		params[strings.ToLower(m[1])] = m[2] + m[3]
+	}
+	md5hex := func(s string) string {
+		h := md5.Sum([]byte(s))
+		return hex.EncodeToString(h[:])
+	}
+	realm, nonce := params["realm"], params["nonce"]
+	ha1 := md5hex(fmt.Sprintf("%v:%v:%v", username, realm, password))
+	ha2 := md5hex(fmt.Sprintf("%v:%v", method, uri))
+
+	authorization := fmt.Sprintf(`Digest username="%v", realm="%v", nonce="%v", uri="%v", algorithm=MD5`, username, realm, nonce, uri)
+	qop := ""
+	for _, q := range strings.Split(params["qop"], ",") {
+		if strings.TrimSpace(q) == "auth" {
+			qop = "auth"
+		}
+	}
+	if qop != "" {
+		cnonce := utils.RandStringBytes(16)
+		nc := "00000001"
+		response := md5hex(fmt.Sprintf("%v:%v:%v:%v:%v:%v", ha1, nonce, nc, cnonce, qop, ha2))
+		authorization += fmt.Sprintf(`, response="%v", qop=%v, nc=%v, cnonce="%v"`, response, qop, nc, cnonce)
+	} else {
+		authorization += fmt.Sprintf(`, response="%v"`, md5hex(fmt.Sprintf("%v:%v:%v", ha1, nonce, ha2)))
+	}
+	if opaque, ok := params["opaque"]; ok {
+		authorization += fmt.Sprintf(`, opaque="%v"`, opaque)
+	}
+	return authorization
+}
+
+// sipClient 同一个 UDP 会话中发送 REGISTER
+type sipClient struct {
+	conn   *net.UDPConn
+	host   string
+	callId string
+	tag    string
+	cseq   int
+}
+
+func newSIPClient(target string) (*sipClient, error) {
+	host, _, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := netx.DialUdpX(target)
+	if err != nil {
+		return nil, err
+	}
+	return &sipClient{
+		conn:   conn,
+		host:   host,
+		callId: utils.RandStringBytes(24),
+		tag:    utils.RandStringBytes(10),
+	}, nil
+}
+
+func (c *sipClient) Close() error {
+	return c.conn.Close()
+}
+
+// register 发送 REGISTER（Expires: 0，不会真正注册联系地址），authorization 为空时不携带认证信息
+func (c *sipClient) register(username, authHeader, authorization string) (*sipResponse, error) {
+	c.cseq++
+	local := c.conn.LocalAddr().String()
+	uri := "sip:" + c.host
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("REGISTER %v SIP/2.0\r\n", uri))
+	buf.WriteString(fmt.Sprintf("Via: SIP/2.0/UDP %v;branch=z9hG4bK%v;rport\r\n", local, utils.RandStringBytes(12)))
+	buf.WriteString("Max-Forwards: 70\r\n")
+	buf.WriteString(fmt.Sprintf("From: <sip:%v@%v>;tag=%v\r\n", username, c.host, c.tag))
+	buf.WriteString(fmt.Sprintf("To: <sip:%v@%v>\r\n", username, c.host))
+	buf.WriteString(fmt.Sprintf("Call-ID: %v@%v\r\n", c.callId, local))
+	buf.WriteString(fmt.Sprintf("CSeq: %v REGISTER\r\n", c.cseq))
+	buf.WriteString(fmt.Sprintf("Contact: <sip:%v@%v>\r\n", username, local))
+	if authorization != "" {
+		buf.WriteString(fmt.Sprintf("%v: %v\r\n", authHeader, authorization))
+	}
+	buf.WriteString("Expires: 0\r\n")
+	buf.WriteString("User-Agent: yak-sip\r\n")
+	buf.WriteString("Content-Length: 0\r\n\r\n")
+	if _, err := c.conn.Write(buf.Bytes()); err != nil {
+		return nil, err
+	}
+
+	raw := make([]byte, 4096)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_ = c.conn.SetReadDeadline(deadline)
+		n, err := c.conn.Read(raw)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := parseSIPResponse(raw[:n])
+		if err != nil {
+			return nil, err
+		}
+		// 跳过 100 Trying 等临时响应
+		if rsp.StatusCode >= 200 {
+			return rsp, nil
+		}
+	}
+}
+
+// sipRegister 先不带认证注册以获取 nonce，再计算 Digest 认证
+func sipRegister(target, username, password string) (int, error) {
+	client, err := newSIPClient(target)
+	if err != nil {
+		return 0, err
+	}
+	defer client.Close()
+
+	rsp, err := client.register(username, "", "")
+	if err != nil {
+		return 0, err
+	}
+	var authHeader, challenge string
+	switch rsp.StatusCode {
+	case 401:
+		authHeader, challenge = "Authorization", rsp.Header.Get("WWW-Authenticate")
+	case 407:
+		authHeader, challenge = "Proxy-Authorization", rsp.Header.Get("Proxy-Authenticate")
+	default:
+		return rsp.StatusCode, nil
+	}
+	if !strings.HasPrefix(strings.ToLower(strings.TrimSpace(challenge)), "digest") {
+		return 0, utils.Errorf("unsupported sip auth challenge: %v", challenge)
+	}
+	rsp, err = client.register(username, authHeader, sipDigestResponse(challenge, "REGISTER", "sip:"+client.host, username, password))
+	if err != nil {
+		return 0, err
+	}
+	return rsp.StatusCode, nil
+}
+
+var sipAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "sip",
+	DefaultPorts:     "5060",
+	DefaultUsernames: []string{"100", "101", "102", "200", "201", "1000", "1001", "admin", "asterisk"},
+	DefaultPasswords: append([]string{"100", "101", "1000", "1234", "asterisk"}, CommonPasswords...),
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		client, err := newSIPClient(appendDefaultPort(i.Target, 5060))
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		defer client.Close()
+		rsp, err := client.register(i.Username, "", "")
+		if err != nil {
+			// UDP 服务没有响应
+			log.Debugf("sip register %v failed: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		if rsp.StatusCode >= 200 && rsp.StatusCode < 300 {
+			// 分机无需认证即可注册
+			result.Ok = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		code, err := sipRegister(appendDefaultPort(i.Target, 5060), i.Username, i.Password)
+		if err != nil {
+			log.Debugf("sip register %v failed: %s", i.Target, err)
+			return result
+		}
+		switch {
+		case code >= 200 && code < 300:
+			result.Ok = true
+		case code == 404:
+			// 分机不存在
+			result.UserEliminated = true
+		}
+		return result
+	},
+}
@@ -0,0 +1,101 @@
+package bruteutils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/textproto"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// mockSIPRegistrar 对 REGISTER 返回 Digest 挑战（qop=auth），并校验 Authorization
+func mockSIPRegistrar(t *testing.T, users map[string]string, requireAuth bool) (string, int) {
+	port := utils.GetRandomAvailableUDPPort()
+	conn, err := net.ListenPacket("udp", utils.HostPort("127.0.0.1", port))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	const realm, nonce = "asterisk", "5f1e2d3c"
+	md5hex := func(s string) string {
+		h := md5.Sum([]byte(s))
+		return hex.EncodeToString(h[:])
+	}
+	go func() {
+		buf := make([]byte, 4096)
+		for {
+			n, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			reader := textproto.NewReader(bufio.NewReader(bytes.NewReader(buf[:n])))
+			line, _ := reader.ReadLine()
+			header, _ := reader.ReadMIMEHeader()
+			fields := strings.Fields(line)
+			if len(fields) < 3 || fields[0] != "REGISTER" {
+				continue
+			}
+
+			reply := func(status string, extra ...string) {
+				var rsp bytes.Buffer
+				rsp.WriteString("SIP/2.0 " + status + "\r\n")
+				for _, key := range []string{"Via", "From", "To", "Call-ID", "CSeq"} {
+					rsp.WriteString(fmt.Sprintf("%v: %v\r\n", key, header.Get(key)))
+				}
+				for _, e := range extra {
+					rsp.WriteString(e + "\r\n")
+				}
+				rsp.WriteString("Content-Length: 0\r\n\r\n")
+				conn.WriteTo(rsp.Bytes(), addr)
+			}
+			reply("100 Trying")
+			if !requireAuth {
+				reply("200 OK")
+				continue
+			}
+
+			authorization := header.Get("Authorization")
+			if authorization == "" {
+				reply("401 Unauthorized", fmt.Sprintf(`WWW-Authenticate: Digest algorithm=MD5, realm="%v", nonce="%v", qop="auth"`, realm, nonce))
+				continue
+			}
+			params := make(map[string]string)
+			for _, m := range sipDigestParamRegexp.FindAllStringSubmatch(authorization, -1) {
+				params[m[1]] = m[2] + m[3]
+			}
+			password, ok := users[params["username"]]
+			if !ok {
+				reply("404 Not Found")
+				continue
+			}
+			ha1 := md5hex(fmt.Sprintf("%v:%v:%v", params["username"], realm, password))
+			ha2 := md5hex("REGISTER:" + params["uri"])
+			expected := md5hex(fmt.Sprintf("%v:%v:%v:%v:%v:%v", ha1, nonce, params["nc"], params["cnonce"], params["qop"], ha2))
+			if params["nonce"] != nonce || params["response"] != expected {
+				reply("403 Forbidden")
+				continue
+			}
+			reply("200 OK")
+		}
+	}()
+	return "127.0.0.1", port
+}
+
+func TestSIPAuth(t *testing.T) {
+	host, port := mockSIPRegistrar(t, map[string]string{"1001": "sip1001"}, true)
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(sipAuth, target, "1001", "1001").Ok)
+	require.True(t, testBrute(sipAuth, target, "1001", "sip1001").Ok)
+	require.True(t, testBrute(sipAuth, target, "2002", "1234").UserEliminated)
+
+	host, port = mockSIPRegistrar(t, nil, false)
+	result := testBrute(sipAuth, utils.HostPort(host, port), "1001", "1001")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,233 @@
+package bruteutils
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bruteutils/grdp/protocol/nla"
+)
+
+const winrmIdentifyBody = `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:wsmid="http://schemas.dmtf.org/wbem/wsman/identity/1/wsmanidentity.xsd"><s:Header/><s:Body><wsmid:Identify/></s:Body></s:Envelope>`
+
+// winrmSchemeCache 记录目标 401 响应中提供的认证方式
+var winrmSchemeCache = utils.NewTTLCache[[]string](30 * time.Minute)
+
+// winrmConn NTLM 认证需要在同一个连接上完成握手
+type winrmConn struct {
+	target *httpBruteTarget
+	path   string
+	conn   net.Conn
+	reader *bufio.Reader
+}
+
+func dialWinRM(target *httpBruteTarget) (*winrmConn, error) {
+	var (
+		conn net.Conn
+		err  error
+	)
+	if target.IsTls {
+		conn, err = netx.DialTLSTimeout(defaultTimeout, target.Addr(), &tls.Config{InsecureSkipVerify: true})
+	} else {
+		conn, err = netx.DialTCPTimeout(defaultTimeout, target.Addr())
+	}
+	if err != nil {
+		return nil, err
+	}
+	path := target.Path
+	if path == "" || path == "/" {
+		path = "/wsman"
+	}
+	_ = conn.SetDeadline(time.Now().Add(3 * defaultTimeout))
+	return &winrmConn{target: target, path: path, conn: conn, reader: bufio.NewReader(conn)}, nil
+}
+
+func (c *winrmConn) Close() error {
+	return c.conn.Close()
+}
+
+// identify 发送 WS-Management Identify 请求，返回状态码与 WWW-Authenticate 头
+func (c *winrmConn) identify(authorization string) (int, []string, error) {
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("POST %v HTTP/1.1\r\n", c.path))
+	buf.WriteString(fmt.Sprintf("Host: %v\r\n", c.target.Addr()))
+	buf.WriteString("User-Agent: Microsoft WinRM Client\r\n")
+	buf.WriteString("Content-Type: application/soap+xml;charset=UTF-8\r\n")
+	if authorization != "" {
+		buf.WriteString(fmt.Sprintf("Authorization: %v\r\n", authorization))
+	}
+	buf.WriteString(fmt.Sprintf("Content-Length: %v\r\n", len(winrmIdentifyBody)))
+	buf.WriteString("Connection: keep-alive\r\n\r\n")
+	buf.WriteString(winrmIdentifyBody)
+	if _, err := c.conn.Write(buf.Bytes()); err != nil {
+		return 0, nil, err
+	}
+	rsp, err := http.ReadResponse(c.reader, nil)
+	if err != nil {
+		return 0, nil, err
+	}
+	_, _ = io.Copy(io.Discard, rsp.Body)
+	_ = rsp.Body.Close()
+	return rsp.StatusCode, rsp.Header.Values("WWW-Authenticate"), nil
+}
+
+// winrmSplitUser 支持 DOMAIN\user 与 user@domain 两种写法
+func winrmSplitUser(username string) (string, string) {
+	if domain, user, ok := strings.Cut(username, `\`); ok {
+		return domain, user
+	}
+	if user, domain, ok := strings.Cut(username, "@"); ok {
+		return domain, user
+	}
+	return "", username
+}
+
+func winrmHasScheme(schemes []string, name string) bool {
+	for _, s := range schemes {
+		if strings.EqualFold(strings.Fields(s + " ")[0], name) {
+			return true
+		}
+	}
+	return false
+}
+
+// winrmNTLMLogin 完成 NTLM（或 Negotiate 中的 NTLM）握手，返回最终的状态码
+func winrmNTLMLogin(target *httpBruteTarget, scheme, username, password string) (int, error) {
+	c, err := dialWinRM(target)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+
+	domain, user := winrmSplitUser(username)
+	ntlm := nla.NewNTLMv2(domain, user, password)
+	code, headers, err := c.identify(scheme + " " + base64.StdEncoding.EncodeToString(ntlm.GetNegotiateMessage().Serialize()))
+	if err != nil {
+		return 0, err
+	}
+	if code != 401 {
+		return code, nil
+	}
+
+	var challenge []byte
+	for _, h := range headers {
+		name, token, ok := strings.Cut(strings.TrimSpace(h), " ")
+		if !ok || !strings.EqualFold(name, scheme) {
+			continue
+		}
+		challenge, err = base64.StdEncoding.DecodeString(strings.TrimSpace(token))
+		if err == nil && bytes.HasPrefix(challenge, []byte("NTLMSSP\x00")) {
+			break
+		}
+		challenge = nil
+	}
+	if len(challenge) <= 0 {
+		return 0, utils.Errorf("winrm %v: no ntlm challenge received", target.Addr())
+	}
+	authenticate, _ := ntlm.GetAuthenticateMessage(challenge)
+	if authenticate == nil {
+		return 0, utils.Errorf("winrm %v: invalid ntlm challenge", target.Addr())
+	}
+	code, _, err = c.identify(scheme + " " + base64.StdEncoding.EncodeToString(authenticate.Serialize()))
+	return code, err
+}
+
+func winrmBasicLogin(target *httpBruteTarget, username, password string) (int, error) {
+	c, err := dialWinRM(target)
+	if err != nil {
+		return 0, err
+	}
+	defer c.Close()
+	code, _, err := c.identify("Basic " + base64.StdEncoding.EncodeToString([]byte(username+":"+password)))
+	return code, err
+}
+
+func parseWinRMTarget(target string) (*httpBruteTarget, error) {
+	t, err := parseHTTPBruteTarget(target, 5985)
+	if err != nil {
+		return nil, err
+	}
+	if t.Port == 5986 {
+		t.IsTls = true
+	}
+	return t, nil
+}
+
+var winrmAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "winrm",
+	DefaultPorts:     "5985,5986",
+	DefaultUsernames: []string{"administrator", "admin", "guest"},
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseWinRMTarget(i.Target)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		if _, ok := winrmSchemeCache.Get(target.Addr()); ok {
+			return result
+		}
+		c, err := dialWinRM(target)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		defer c.Close()
+		code, schemes, err := c.identify("")
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		switch code {
+		case 200:
+			result.Ok = true
+		case 401:
+			winrmSchemeCache.Set(target.Addr(), schemes)
+		default:
+			result.Finished = true
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target, err := parseWinRMTarget(i.Target)
+		if err != nil {
+			result.Finished = true
+			return result
+		}
+		schemes, _ := winrmSchemeCache.Get(target.Addr())
+
+		var code int
+		switch {
+		case winrmHasScheme(schemes, "Negotiate"):
+			code, err = winrmNTLMLogin(target, "Negotiate", i.Username, i.Password)
+		case winrmHasScheme(schemes, "NTLM"):
+			code, err = winrmNTLMLogin(target, "NTLM", i.Username, i.Password)
+		case winrmHasScheme(schemes, "Basic"):
+			code, err = winrmBasicLogin(target, i.Username, i.Password)
+		default:
+			result.Finished = true
+			return result
+		}
+		if err != nil {
+			log.Debugf("winrm auth %v failed: %s", i.Target, err)
+			return result
+		}
+		// 认证通过后即使 SOAP 请求失败也不会再返回 401
+		if code != 0 && code != 401 && code != 403 {
+			result.Ok = true
+		}
+		return result
+	},
+}
@@ -0,0 +1,101 @@
+package bruteutils
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/binary"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bruteutils/grdp/protocol/nla"
+)
+
+func decodeUTF16LE(raw []byte) string {
+	codes := make([]uint16, len(raw)/2)
+	for i := range codes {
+		codes[i] = binary.LittleEndian.Uint16(raw[i*2:])
+	}
+	return string(utf16.Decode(codes))
+}
+
+// mockWinRM 在同一个 keep-alive 连接上完成 NTLM 握手，并校验 NTLMv2 响应
+func mockWinRM(username, password string) (string, int) {
+	var challenges sync.Map
+	return utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		unauthorized := func(token string) {
+			if token != "" {
+				w.Header().Set("WWW-Authenticate", "Negotiate "+token)
+			} else {
+				w.Header().Add("WWW-Authenticate", "Negotiate")
+				w.Header().Add("WWW-Authenticate", `Basic realm="WSMAN"`)
+			}
+			w.WriteHeader(http.StatusUnauthorized)
+		}
+		scheme, token, _ := strings.Cut(r.Header.Get("Authorization"), " ")
+		if r.URL.Path != "/wsman" || scheme != "Negotiate" {
+			unauthorized("")
+			return
+		}
+		msg, err := base64.StdEncoding.DecodeString(token)
+		if err != nil || len(msg) < 12 || !bytes.HasPrefix(msg, []byte("NTLMSSP\x00")) {
+			unauthorized("")
+			return
+		}
+		switch binary.LittleEndian.Uint32(msg[8:12]) {
+		case 1:
+			challenge := nla.NewChallengeMessage()
+			challenge.NegotiateFlags = binary.LittleEndian.Uint32(msg[12:16]) &^ nla.NTLMSSP_NEGOTIATE_VERSION
+			copy(challenge.ServerChallenge[:], utils.RandStringBytes(8))
+			challenge.Payload = []byte{0, 0, 0, 0} // MsvAvEOL
+			challenge.TargetInfoLen = uint16(len(challenge.Payload))
+			challenge.TargetInfoMaxLen = challenge.TargetInfoLen
+			challenge.TargetInfoBufferOffset = challenge.BaseLen()
+			challenges.Store(r.RemoteAddr, challenge.ServerChallenge)
+			unauthorized(base64.StdEncoding.EncodeToString(challenge.Serialize()))
+		case 3:
+			serverChallenge, ok := challenges.Load(r.RemoteAddr)
+			if !ok {
+				unauthorized("")
+				return
+			}
+			field := func(offset int) []byte {
+				size := int(binary.LittleEndian.Uint16(msg[offset:]))
+				start := int(binary.LittleEndian.Uint32(msg[offset+4:]))
+				return msg[start : start+size]
+			}
+			ntResponse, domain, user := field(20), decodeUTF16LE(field(28)), decodeUTF16LE(field(36))
+			sc := serverChallenge.([8]byte)
+			proof := nla.HMAC_MD5(nla.NTOWFv2(password, user, domain), append(sc[:], ntResponse[16:]...))
+			if !strings.EqualFold(user, username) || !hmac.Equal(proof, ntResponse[:16]) {
+				unauthorized("")
+				return
+			}
+			w.Header().Set("Content-Type", "application/soap+xml;charset=UTF-8")
+			w.Write([]byte(`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope"><s:Body><wsmid:IdentifyResponse/></s:Body></s:Envelope>`))
+		default:
+			unauthorized("")
+		}
+	})
+}
+
+func TestWinRMAuth_NTLM(t *testing.T) {
+	host, port := mockWinRM("administrator", "Winrm@2024")
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(winrmAuth, target, "administrator", "admin").Ok)
+	require.True(t, testBrute(winrmAuth, target, `CORP\administrator`, "Winrm@2024").Ok)
+}
+
+func TestWinRMAuth_UnAuth(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope"><s:Body><wsmid:IdentifyResponse/></s:Body></s:Envelope>`))
+	})
+	result := testBrute(winrmAuth, utils.HostPort(host, port), "administrator", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,248 @@
+package bruteutils
+
+import (
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/emersion/go-sasl"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	xmppNSTLS  = "urn:ietf:params:xml:ns:xmpp-tls"
+	xmppNSSASL = "urn:ietf:params:xml:ns:xmpp-sasl"
+)
+
+type xmppFeatures struct {
+	StartTLS   *struct{} `xml:"urn:ietf:params:xml:ns:xmpp-tls starttls"`
+	Mechanisms []string  `xml:"urn:ietf:params:xml:ns:xmpp-sasl mechanisms>mechanism"`
+}
+
+func (f *xmppFeatures) HasMechanism(name string) bool {
+	for _, m := range f.Mechanisms {
+		if strings.EqualFold(strings.TrimSpace(m), name) {
+			return true
+		}
+	}
+	return false
+}
+
+type xmppConn struct {
+	conn    net.Conn
+	decoder *xml.Decoder
+	domain  string
+}
+
+// openStream 打开（或在 STARTTLS 后重新打开）XML 流，返回服务端的 stream:features
+func (c *xmppConn) openStream() (*xmppFeatures, error) {
+	_, err := fmt.Fprintf(c.conn, "<?xml version='1.0'?><stream:stream to='%v' xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams' version='1.0'>", c.domain)
+	if err != nil {
+		return nil, err
+	}
+	c.decoder = xml.NewDecoder(c.conn)
+	for {
+		se, err := c.nextElement()
+		if err != nil {
+			return nil, err
+		}
+		switch se.Name.Local {
+		case "stream":
+			continue
+		case "features":
+			features := &xmppFeatures{}
+			if err := c.decoder.DecodeElement(features, se); err != nil {
+				return nil, err
+			}
+			return features, nil
+		default:
+			return nil, utils.Errorf("unexpected xmpp element: %v", se.Name.Local)
+		}
+	}
+}
+
+func (c *xmppConn) nextElement() (*xml.StartElement, error) {
+	for {
+		token, err := c.decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+		if se, ok := token.(xml.StartElement); ok {
+			return &se, nil
+		}
+	}
+}
+
+// dialXMPP 建立 XMPP 流，服务端提供 STARTTLS 时升级为 TLS
+func dialXMPP(target, domain string) (*xmppConn, *xmppFeatures, error) {
+	conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+	if err != nil {
+		return nil, nil, err
+	}
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+	c := &xmppConn{conn: conn, domain: domain}
+	features, err := c.openStream()
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if features.StartTLS == nil {
+		return c, features, nil
+	}
+
+	if _, err := fmt.Fprintf(conn, "<starttls xmlns='%v'/>", xmppNSTLS); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	se, err := c.nextElement()
+	if err != nil || se.Name.Local != "proceed" {
+		conn.Close()
+		return nil, nil, utils.Errorf("xmpp starttls failed: %v", err)
+	}
+	tlsConn := tls.Client(conn, &tls.Config{InsecureSkipVerify: true, ServerName: domain})
+	if err := tlsConn.Handshake(); err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	c.conn = tlsConn
+	features, err = c.openStream()
+	if err != nil {
+		tlsConn.Close()
+		return nil, nil, err
+	}
+	return c, features, nil
+}
+
+// auth 使用 SASL 进行认证，成功收到 <success/>，失败收到 <failure/>
+func (c *xmppConn) auth(client sasl.Client) (bool, error) {
+	mech, ir, err := client.Start()
+	if err != nil {
+		return false, err
+	}
+	payload := "="
+	if len(ir) > 0 {
+		payload = base64.StdEncoding.EncodeToString(ir)
+	}
+	if _, err := fmt.Fprintf(c.conn, "<auth xmlns='%v' mechanism='%v'>%v</auth>", xmppNSSASL, mech, payload); err != nil {
+		return false, err
+	}
+	for {
+		se, err := c.nextElement()
+		if err != nil {
+			return false, err
+		}
+		var content string
+		if err := c.decoder.DecodeElement(&content, se); err != nil {
+			return false, err
+		}
+		switch se.Name.Local {
+		case "success":
+			return true, nil
+		case "failure":
+			return false, nil
+		case "challenge":
+			challenge, err := base64.StdEncoding.DecodeString(strings.TrimSpace(content))
+			if err != nil {
+				return false, err
+			}
+			rsp, err := client.Next(challenge)
+			if err != nil {
+				return false, err
+			}
+			if _, err := fmt.Fprintf(c.conn, "<response xmlns='%v'>%v</response>", xmppNSSASL, base64.StdEncoding.EncodeToString(rsp)); err != nil {
+				return false, err
+			}
+		default:
+			return false, utils.Errorf("unexpected xmpp element: %v", se.Name.Local)
+		}
+	}
+}
+
+func (c *xmppConn) Close() error {
+	_, _ = c.conn.Write([]byte("</stream:stream>"))
+	return c.conn.Close()
+}
+
+// xmppSplitUser 用户名可以是 user@domain，未指定时使用目标主机名作为域
+func xmppSplitUser(target, username string) (string, string) {
+	if user, domain, ok := strings.Cut(username, "@"); ok {
+		return user, domain
+	}
+	host, _, err := utils.ParseStringToHostPort(target)
+	if err != nil {
+		host = target
+	}
+	return username, host
+}
+
+var xmppAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "xmpp",
+	DefaultPorts:     "5222",
+	DefaultUsernames: append([]string{"admin", "administrator", "test"}, CommonUsernames...),
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 5222)
+		_, domain := xmppSplitUser(target, i.Username)
+		conn, features, err := dialXMPP(target, domain)
+		if err != nil {
+			log.Debugf("xmpp %v: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		defer conn.Close()
+		if len(features.Mechanisms) <= 0 {
+			result.Finished = true
+			return result
+		}
+		if features.HasMechanism("ANONYMOUS") {
+			ok, err := conn.auth(sasl.NewAnonymousClient(""))
+			if err == nil && ok {
+				result.Ok = true
+				result.ExtraInfo = []byte("SASL ANONYMOUS login allowed")
+			}
+		}
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 5222)
+		user, domain := xmppSplitUser(target, i.Username)
+		conn, features, err := dialXMPP(target, domain)
+		if err != nil {
+			log.Debugf("xmpp %v: %s", i.Target, err)
+			return result
+		}
+		defer conn.Close()
+
+		var client sasl.Client
+		switch {
+		case features.HasMechanism("SCRAM-SHA-256"):
+			client, err = NewScramClient("SHA-256", user, i.Password)
+		case features.HasMechanism("SCRAM-SHA-1"):
+			client, err = NewScramClient("SHA-1", user, i.Password)
+		case features.HasMechanism("PLAIN"):
+			client = sasl.NewPlainClient("", user, i.Password)
+		default:
+			log.Debugf("xmpp %v: no supported sasl mechanism in %v", i.Target, features.Mechanisms)
+			result.Finished = true
+			return result
+		}
+		if err != nil {
+			return result
+		}
+		ok, err := conn.auth(client)
+		if err != nil {
+			log.Debugf("xmpp %v auth failed: %s", i.Target, err)
+			return result
+		}
+		result.Ok = ok
+		return result
+	},
+}
@@ -0,0 +1,68 @@
+package bruteutils
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// mockXMPPServer 只支持 SASL PLAIN（以及可选的 ANONYMOUS）
+func mockXMPPServer(username, password string, anonymous bool) (string, int) {
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		decoder := xml.NewDecoder(conn)
+		for {
+			token, err := decoder.Token()
+			if err != nil {
+				return
+			}
+			se, ok := token.(xml.StartElement)
+			if !ok {
+				continue
+			}
+			switch se.Name.Local {
+			case "stream":
+				mechanisms := "<mechanism>PLAIN</mechanism>"
+				if anonymous {
+					mechanisms += "<mechanism>ANONYMOUS</mechanism>"
+				}
+				fmt.Fprintf(conn, "<?xml version='1.0'?><stream:stream xmlns='jabber:client' xmlns:stream='http://etherx.jabber.org/streams' id='yak' from='localhost' version='1.0'>"+
+					"<stream:features><mechanisms xmlns='%v'>%v</mechanisms></stream:features>", xmppNSSASL, mechanisms)
+			case "auth":
+				var payload string
+				_ = decoder.DecodeElement(&payload, &se)
+				mechanism := ""
+				for _, attr := range se.Attr {
+					if attr.Name.Local == "mechanism" {
+						mechanism = attr.Value
+					}
+				}
+				raw, _ := base64.StdEncoding.DecodeString(strings.TrimSpace(payload))
+				if (mechanism == "ANONYMOUS" && anonymous) || (mechanism == "PLAIN" && string(raw) == "\x00"+username+"\x00"+password) {
+					fmt.Fprintf(conn, "<success xmlns='%v'/>", xmppNSSASL)
+				} else {
+					fmt.Fprintf(conn, "<failure xmlns='%v'><not-authorized/></failure>", xmppNSSASL)
+				}
+			}
+		}
+	})
+}
+
+func TestXMPPAuth(t *testing.T) {
+	host, port := mockXMPPServer("admin", "xmpp@123", false)
+	target := utils.HostPort(host, port)
+	require.False(t, testBrute(xmppAuth, target, "admin", "admin").Ok)
+	require.True(t, testBrute(xmppAuth, target, "admin@localhost", "xmpp@123").Ok)
+
+	host, port = mockXMPPServer("admin", "xmpp@123", true)
+	result := testBrute(xmppAuth, utils.HostPort(host, port), "admin", "admin")
+	require.True(t, result.Ok)
+	require.Empty(t, result.Password)
+}
@@ -0,0 +1,131 @@
+package bruteutils
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const zookeeperOpGetChildren = 8
+
+func zookeeperWritePacket(conn net.Conn, payload []byte) error {
+	packet := make([]byte, 4, 4+len(payload))
+	binary.BigEndian.PutUint32(packet, uint32(len(payload)))
+	_, err := conn.Write(append(packet, payload...))
+	return err
+}
+
+func zookeeperReadPacket(conn net.Conn) ([]byte, error) {
+	header := make([]byte, 4)
+	if _, err := io.ReadFull(conn, header); err != nil {
+		return nil, err
+	}
+	length := binary.BigEndian.Uint32(header)
+	if length > 1<<20 {
+		return nil, utils.Errorf("zookeeper packet too large: %v", length)
+	}
+	raw := make([]byte, length)
+	if _, err := io.ReadFull(conn, raw); err != nil {
+		return nil, err
+	}
+	return raw, nil
+}
+
+// zookeeperListRoot 建立会话后列出根节点的子节点，根节点设置了 ACL 时返回 NoAuth 错误
+func zookeeperListRoot(target string) ([]string, error) {
+	conn, err := netx.DialTCPTimeout(defaultTimeout, target)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	_ = conn.SetDeadline(time.Now().Add(defaultTimeout))
+
+	// ConnectRequest: protocolVersion, lastZxidSeen, timeOut, sessionId, passwd
+	var connect bytes.Buffer
+	_ = binary.Write(&connect, binary.BigEndian, int32(0))
+	_ = binary.Write(&connect, binary.BigEndian, int64(0))
+	_ = binary.Write(&connect, binary.BigEndian, int32(30000))
+	_ = binary.Write(&connect, binary.BigEndian, int64(0))
+	_ = binary.Write(&connect, binary.BigEndian, int32(16))
+	connect.Write(make([]byte, 16))
+	if err := zookeeperWritePacket(conn, connect.Bytes()); err != nil {
+		return nil, err
+	}
+	rsp, err := zookeeperReadPacket(conn)
+	if err != nil {
+		return nil, utils.Errorf("read zookeeper connect response failed: %s", err)
+	}
+	if len(rsp) < 16 || binary.BigEndian.Uint64(rsp[8:16]) == 0 {
+		return nil, utils.Error("zookeeper session is not established")
+	}
+
+	// GetChildrenRequest: xid, type, path, watch
+	var req bytes.Buffer
+	_ = binary.Write(&req, binary.BigEndian, int32(1))
+	_ = binary.Write(&req, binary.BigEndian, int32(zookeeperOpGetChildren))
+	_ = binary.Write(&req, binary.BigEndian, int32(1))
+	req.WriteString("/")
+	req.WriteByte(0)
+	if err := zookeeperWritePacket(conn, req.Bytes()); err != nil {
+		return nil, err
+	}
+	rsp, err = zookeeperReadPacket(conn)
+	if err != nil {
+		return nil, utils.Errorf("read zookeeper getChildren response failed: %s", err)
+	}
+	// ReplyHeader: xid, zxid, err
+	if len(rsp) < 20 {
+		return nil, utils.Error("invalid zookeeper reply")
+	}
+	if code := int32(binary.BigEndian.Uint32(rsp[12:16])); code != 0 {
+		return nil, utils.Errorf("zookeeper getChildren error code: %v", code)
+	}
+	r := bytes.NewReader(rsp[16:])
+	var count int32
+	_ = binary.Read(r, binary.BigEndian, &count)
+	var children []string
+	for i := int32(0); i < count && r.Len() >= 4; i++ {
+		var size int32
+		_ = binary.Read(r, binary.BigEndian, &size)
+		if size < 0 || int(size) > r.Len() {
+			break
+		}
+		name := make([]byte, size)
+		_, _ = r.Read(name)
+		children = append(children, string(name))
+	}
+	return children, nil
+}
+
+var zookeeperAuth = &DefaultServiceAuthInfo{
+	ServiceName:      "zookeeper",
+	DefaultPorts:     "2181",
+	DefaultUsernames: CommonUsernames,
+	DefaultPasswords: CommonPasswords,
+	UnAuthVerify: func(i *BruteItem) *BruteItemResult {
+		result := i.Result()
+		target := appendDefaultPort(i.Target, 2181)
+		children, err := zookeeperListRoot(target)
+		if err != nil {
+			log.Debugf("zookeeper %v: %s", i.Target, err)
+			result.Finished = true
+			return result
+		}
+		// 未授权访问
+		result.Ok = true
+		result.ExtraInfo = []byte("/: " + strings.Join(children, ", "))
+		return result
+	},
+	BrutePass: func(i *BruteItem) *BruteItemResult {
+		r := i.Result()
+		r.Finished = true
+		return r
+	},
+}
@@ -0,0 +1,59 @@
+package bruteutils
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+func mockZookeeper(errCode int32) (string, int) {
+	return utils.DebugMockTCPEx(func(ctx context.Context, lis net.Listener, conn net.Conn) {
+		defer conn.Close()
+		// ConnectRequest
+		if _, err := zookeeperReadPacket(conn); err != nil {
+			return
+		}
+		var connect bytes.Buffer
+		_ = binary.Write(&connect, binary.BigEndian, int32(0))
+		_ = binary.Write(&connect, binary.BigEndian, int32(30000))
+		_ = binary.Write(&connect, binary.BigEndian, int64(0x1234))
+		_ = binary.Write(&connect, binary.BigEndian, int32(16))
+		connect.Write(make([]byte, 16))
+		_ = zookeeperWritePacket(conn, connect.Bytes())
+
+		// GetChildrenRequest
+		if _, err := zookeeperReadPacket(conn); err != nil {
+			return
+		}
+		var reply bytes.Buffer
+		_ = binary.Write(&reply, binary.BigEndian, int32(1))
+		_ = binary.Write(&reply, binary.BigEndian, int64(1))
+		_ = binary.Write(&reply, binary.BigEndian, errCode)
+		if errCode == 0 {
+			_ = binary.Write(&reply, binary.BigEndian, int32(2))
+			for _, name := range []string{"zookeeper", "dubbo"} {
+				_ = binary.Write(&reply, binary.BigEndian, int32(len(name)))
+				reply.WriteString(name)
+			}
+		}
+		_ = zookeeperWritePacket(conn, reply.Bytes())
+	})
+}
+
+func TestZookeeperUnAuth(t *testing.T) {
+	host, port := mockZookeeper(0)
+	result := testBrute(zookeeperAuth, utils.HostPort(host, port), "", "")
+	require.True(t, result.Ok)
+	require.Contains(t, string(result.ExtraInfo), "dubbo")
+
+	// NoAuth
+	host, port = mockZookeeper(-102)
+	result = testBrute(zookeeperAuth, utils.HostPort(host, port), "", "")
+	require.False(t, result.Ok)
+	require.True(t, result.Finished)
+}
@@ -0,0 +1,283 @@
+package comparer
+
+import (
+	"fmt"
+	"math"
+	"math/bits"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+const (
+	defaultAnomalyBaselineSize = 3
+	defaultAnomalyThreshold    = 0.4
+
+	// simhash 汉明距离在这个范围内的 body 认为是同一种页面
+	similarSimHashDistance = 10
+	// 响应时间至少比基线慢这么多才认为是时间异常，避免网络抖动
+	minSlowDurationMs = 1000
+)
+
+// ResponseFingerprint 是 HTTP 响应的相似度指纹，由状态码、长度区间、body 的 simhash、Header 集合与响应时间组成
+type ResponseFingerprint struct {
+	StatusCode   int
+	BodyLength   int
+	LengthBucket int
+	BodySimHash  uint64
+	HeaderKeys   []string
+	DurationMs   int64
+}
+
+// NewResponseFingerprint 根据原始响应报文与响应时间计算指纹
+func NewResponseFingerprint(rsp []byte, durationMs int64) *ResponseFingerprint {
+	fp := &ResponseFingerprint{
+		StatusCode: lowhttp.GetStatusCodeFromResponse(rsp),
+		DurationMs: durationMs,
+	}
+	keys := make(map[string]struct{})
+	_, body := lowhttp.SplitHTTPPacket(rsp, nil, nil, func(line string) string {
+		if k, _, ok := strings.Cut(line, ":"); ok {
+			keys[strings.ToLower(strings.TrimSpace(k))] = struct{}{}
+		}
+		return line
+	})
+	for k := range keys {
+		fp.HeaderKeys = append(fp.HeaderKeys, k)
+	}
+	sort.Strings(fp.HeaderKeys)
+	fp.BodyLength = len(body)
+	fp.LengthBucket = lengthBucket(len(body))
+	if len(body) > 0 {
+		fp.BodySimHash = utils.SimHash(body)
+	}
+	return fp
+}
+
+// lengthBucket 按对数划分长度区间，相邻区间之间大约相差 19%
+func lengthBucket(n int) int {
+	if n <= 0 {
+		return 0
+	}
+	return int(math.Log2(float64(n))*4) + 1
+}
+
+func (f *ResponseFingerprint) simHashDistance(other *ResponseFingerprint) int {
+	return bits.OnesCount64(f.BodySimHash ^ other.BodySimHash)
+}
+
+func (f *ResponseFingerprint) headerDiff(other *ResponseFingerprint) (added, missing []string) {
+	set := make(map[string]struct{}, len(other.HeaderKeys))
+	for _, k := range other.HeaderKeys {
+		set[k] = struct{}{}
+	}
+	for _, k := range f.HeaderKeys {
+		if _, ok := set[k]; ok {
+			delete(set, k)
+			continue
+		}
+		added = append(added, k)
+	}
+	for k := range set {
+		missing = append(missing, k)
+	}
+	sort.Strings(missing)
+	return
+}
+
+func (f *ResponseFingerprint) headerSimilarity(other *ResponseFingerprint) float64 {
+	added, missing := f.headerDiff(other)
+	union := len(f.HeaderKeys) + len(missing)
+	if union == 0 {
+		return 1
+	}
+	return 1 - float64(len(added)+len(missing))/float64(union)
+}
+
+// IsSimilar 判断两个指纹是否属于同一类响应，响应时间不参与聚类
+func (f *ResponseFingerprint) IsSimilar(other *ResponseFingerprint) bool {
+	if f.StatusCode != other.StatusCode {
+		return false
+	}
+	if d := f.LengthBucket - other.LengthBucket; d > 1 || d < -1 {
+		return false
+	}
+	if f.simHashDistance(other) > similarSimHashDistance {
+		return false
+	}
+	return f.headerSimilarity(other) >= 0.8
+}
+
+// ResponseCluster 是一组相似的响应，Sample 为该类中第一个响应的指纹
+type ResponseCluster struct {
+	ID     int
+	Sample *ResponseFingerprint
+	Count  int
+}
+
+// AnomalyResult 是单个响应的异常检测结果，Score 越接近 1 越值得关注
+type AnomalyResult struct {
+	Score     float64
+	ClusterID int
+	IsOutlier bool
+	Reasons   []string
+}
+
+// AnomalyDetector 对连续输入的响应自动建立基线并聚类，数量最多的类被当作基线，偏离基线的响应会得到更高的分数
+type AnomalyDetector struct {
+	mu           sync.Mutex
+	baselineSize int
+	threshold    float64
+
+	total    int
+	clusters []*ResponseCluster
+
+	// 基线响应时间的均值与方差 (Welford)
+	durationCount int
+	durationMean  float64
+	durationM2    float64
+}
+
+type AnomalyDetectorOption func(d *AnomalyDetector)
+
+// WithAnomalyBaselineSize 设置建立基线所需的响应数量，在此之前的响应不会被标记为异常
+func WithAnomalyBaselineSize(n int) AnomalyDetectorOption {
+	return func(d *AnomalyDetector) {
+		if n > 0 {
+			d.baselineSize = n
+		}
+	}
+}
+
+// WithAnomalyThreshold 设置标记为异常的最低分数，取值范围 (0, 1]
+func WithAnomalyThreshold(f float64) AnomalyDetectorOption {
+	return func(d *AnomalyDetector) {
+		if f > 0 && f <= 1 {
+			d.threshold = f
+		}
+	}
+}
+
+func NewAnomalyDetector(opts ...AnomalyDetectorOption) *AnomalyDetector {
+	d := &AnomalyDetector{
+		baselineSize: defaultAnomalyBaselineSize,
+		threshold:    defaultAnomalyThreshold,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	return d
+}
+
+// Feed 计算原始响应报文的指纹并给出异常分数
+func (d *AnomalyDetector) Feed(rsp []byte, durationMs int64) *AnomalyResult {
+	return d.FeedFingerprint(NewResponseFingerprint(rsp, durationMs))
+}
+
+func (d *AnomalyDetector) FeedFingerprint(fp *ResponseFingerprint) *AnomalyResult {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	var cluster *ResponseCluster
+	for _, c := range d.clusters {
+		if c.Sample.IsSimilar(fp) {
+			cluster = c
+			break
+		}
+	}
+	if cluster == nil {
+		cluster = &ResponseCluster{ID: len(d.clusters) + 1, Sample: fp}
+		d.clusters = append(d.clusters, cluster)
+	}
+	cluster.Count++
+	d.total++
+
+	baseline := d.baseline()
+	result := &AnomalyResult{ClusterID: cluster.ID}
+	var score float64
+	if cluster != baseline {
+		score += d.structuralScore(fp, baseline.Sample, result)
+		// 越少见的类越值得关注
+		score += 0.1 * (1 - float64(cluster.Count)/float64(d.total))
+	}
+
+	slow := false
+	if d.durationCount >= d.baselineSize && fp.DurationMs > 0 {
+		mean := d.durationMean
+		std := math.Sqrt(d.durationM2 / float64(d.durationCount))
+		if delta := float64(fp.DurationMs) - mean; delta >= minSlowDurationMs && delta > 3*std {
+			slow = true
+			score += 0.4
+			result.Reasons = append(result.Reasons, fmt.Sprintf("response time %dms is much slower than baseline %.0fms", fp.DurationMs, mean))
+		}
+	}
+	if cluster == baseline && !slow && fp.DurationMs > 0 {
+		d.durationCount++
+		delta := float64(fp.DurationMs) - d.durationMean
+		d.durationMean += delta / float64(d.durationCount)
+		d.durationM2 += delta * (float64(fp.DurationMs) - d.durationMean)
+	}
+
+	result.Score = math.Min(score, 1)
+	result.IsOutlier = d.total > d.baselineSize && result.Score >= d.threshold
+	return result
+}
+
+func (d *AnomalyDetector) structuralScore(fp, base *ResponseFingerprint, result *AnomalyResult) float64 {
+	var score float64
+	if fp.StatusCode != base.StatusCode {
+		score += 0.4
+		result.Reasons = append(result.Reasons, fmt.Sprintf("status code %d differs from baseline %d", fp.StatusCode, base.StatusCode))
+	}
+	if maxLen := utils.Max(fp.BodyLength, base.BodyLength); maxLen > 0 {
+		diff := fp.BodyLength - base.BodyLength
+		if diff < 0 {
+			diff = -diff
+		}
+		if ratio := float64(diff) / float64(maxLen); ratio > 0.1 {
+			score += 0.2 * ratio
+			result.Reasons = append(result.Reasons, fmt.Sprintf("body length %d differs from baseline %d", fp.BodyLength, base.BodyLength))
+		}
+	}
+	if distance := fp.simHashDistance(base); distance > similarSimHashDistance {
+		score += 0.2 * math.Min(float64(distance)/32, 1)
+		result.Reasons = append(result.Reasons, fmt.Sprintf("body content differs from baseline (simhash distance %d)", distance))
+	}
+	if added, missing := fp.headerDiff(base); len(added) > 0 || len(missing) > 0 {
+		score += 0.1 * (1 - fp.headerSimilarity(base))
+		var diff []string
+		for _, k := range added {
+			diff = append(diff, "+"+k)
+		}
+		for _, k := range missing {
+			diff = append(diff, "-"+k)
+		}
+		result.Reasons = append(result.Reasons, "header set differs from baseline: "+strings.Join(diff, " "))
+	}
+	return score
+}
+
+// baseline 返回数量最多的类，数量相同时取最早出现的
+func (d *AnomalyDetector) baseline() *ResponseCluster {
+	var base *ResponseCluster
+	for _, c := range d.clusters {
+		if base == nil || c.Count > base.Count {
+			base = c
+		}
+	}
+	return base
+}
+
+// Clusters 返回当前所有聚类的快照
+func (d *AnomalyDetector) Clusters() []ResponseCluster {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	clusters := make([]ResponseCluster, 0, len(d.clusters))
+	for _, c := range d.clusters {
+		clusters = append(clusters, *c)
+	}
+	return clusters
+}
@@ -0,0 +1,67 @@
+package comparer
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func buildAnomalyTestResponse(status int, body string, headers ...string) []byte {
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "HTTP/1.1 %d OK\r\nContent-Type: text/html\r\nServer: nginx\r\nDate: Mon, 19 Oct 2026 08:00:00 GMT\r\nConnection: keep-alive\r\n", status)
+	for _, h := range headers {
+		buf.WriteString(h + "\r\n")
+	}
+	fmt.Fprintf(&buf, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return []byte(buf.String())
+}
+
+func TestAnomalyDetector(t *testing.T) {
+	page := func(name string) string {
+		return "<html><head><title>Search</title></head><body><h1>Search result</h1><p>no result for keyword " + name +
+			"</p><p>please try another keyword, or go back to the home page and browse the categories</p></body></html>"
+	}
+	d := NewAnomalyDetector()
+
+	for i := 0; i < 10; i++ {
+		result := d.Feed(buildAnomalyTestResponse(200, page(fmt.Sprintf("abc%d", i))), int64(100+i*10))
+		require.Equal(t, 1, result.ClusterID)
+		require.False(t, result.IsOutlier)
+		require.Zero(t, result.Score)
+	}
+
+	serverError := d.Feed(buildAnomalyTestResponse(500, "You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version"), 120)
+	require.True(t, serverError.IsOutlier, serverError.Reasons)
+	require.Equal(t, 2, serverError.ClusterID)
+	require.Contains(t, serverError.Reasons, "status code 500 differs from baseline 200")
+
+	headerOnly := d.Feed(buildAnomalyTestResponse(200, page("abc"), "X-Debug: 1"), 120)
+	require.Equal(t, 1, headerOnly.ClusterID)
+	require.False(t, headerOnly.IsOutlier)
+
+	slow := d.Feed(buildAnomalyTestResponse(200, page("sleep")), 5000)
+	require.Equal(t, 1, slow.ClusterID)
+	require.True(t, slow.IsOutlier, slow.Reasons)
+	require.Len(t, slow.Reasons, 1)
+
+	redirect := d.Feed(buildAnomalyTestResponse(302, "", "Location: /admin", "Set-Cookie: a=b"), 100)
+	require.True(t, redirect.IsOutlier)
+	require.Greater(t, redirect.Score, serverError.Score)
+	require.Contains(t, redirect.Reasons, "header set differs from baseline: +location +set-cookie")
+
+	clusters := d.Clusters()
+	require.Len(t, clusters, 3)
+	require.Equal(t, 12, clusters[0].Count)
+}
+
+func TestAnomalyDetector_Warmup(t *testing.T) {
+	d := NewAnomalyDetector(WithAnomalyBaselineSize(2))
+	require.False(t, d.Feed(buildAnomalyTestResponse(200, "hello world"), 0).IsOutlier)
+	// 基线尚未建立，即使与第一个响应完全不同也不标记
+	first := d.Feed(buildAnomalyTestResponse(404, "not found"), 0)
+	require.False(t, first.IsOutlier)
+	require.Greater(t, first.Score, 0.4)
+	require.True(t, d.Feed(buildAnomalyTestResponse(500, "internal error"), 0).IsOutlier)
+}
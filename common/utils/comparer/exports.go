@@ -4,4 +4,9 @@ var Exports = map[string]interface{}{
 	"CompareRaw":          CompareHTTPResponseRaw,
 	"CompareHTTPResponse": CompareHTTPResponse,
 	"NewDiscriminator":    NewDiscriminator,
+
+	"NewAnomalyDetector":     NewAnomalyDetector,
+	"NewResponseFingerprint": NewResponseFingerprint,
+	"anomalyBaselineSize":    WithAnomalyBaselineSize,
+	"anomalyThreshold":       WithAnomalyThreshold,
 }
@@ -0,0 +1,303 @@
+package graphqlx
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Document is a GraphQL executable document, the `query` of a GraphQL request
+type Document struct {
+	Operations []*Operation
+	Fragments  []*Fragment
+	// order keeps the original order of operations and fragments
+	order []any
+}
+
+// Operation is `query|mutation|subscription Name($var: Type) { ... }`, or the shorthand `{ ... }`
+type Operation struct {
+	Type         string
+	Name         string
+	Variables    []*VariableDefinition
+	Directives   []*Directive
+	SelectionSet []Selection
+}
+
+type Fragment struct {
+	Name          string
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+type VariableDefinition struct {
+	Name         string
+	Type         string
+	DefaultValue *Value
+	Directives   []*Directive
+}
+
+// Selection is one of *Field, *FragmentSpread and *InlineFragment
+type Selection interface {
+	isSelection()
+}
+
+type Field struct {
+	Alias        string
+	Name         string
+	Arguments    []*Argument
+	Directives   []*Directive
+	SelectionSet []Selection
+}
+
+type FragmentSpread struct {
+	Name       string
+	Directives []*Directive
+}
+
+type InlineFragment struct {
+	TypeCondition string
+	Directives    []*Directive
+	SelectionSet  []Selection
+}
+
+func (*Field) isSelection()          {}
+func (*FragmentSpread) isSelection() {}
+func (*InlineFragment) isSelection() {}
+
+// ResponseKey is the alias or the name of field
+func (f *Field) ResponseKey() string {
+	if f.Alias != "" {
+		return f.Alias
+	}
+	return f.Name
+}
+
+type Argument struct {
+	Name  string
+	Value *Value
+}
+
+type Directive struct {
+	Name      string
+	Arguments []*Argument
+}
+
+type ValueKind int
+
+const (
+	ValueVariable ValueKind = iota
+	ValueInt
+	ValueFloat
+	ValueString
+	ValueBoolean
+	ValueNull
+	ValueEnum
+	ValueList
+	ValueObject
+)
+
+// Value is an input value literal, Raw is the variable name, the unescaped string or the literal text
+type Value struct {
+	Kind   ValueKind
+	Raw    string
+	List   []*Value
+	Fields []*ObjectField
+}
+
+type ObjectField struct {
+	Name  string
+	Value *Value
+}
+
+// IsScalar checks the literal is a leaf which can be fuzzed
+func (v *Value) IsScalar() bool {
+	switch v.Kind {
+	case ValueVariable, ValueList, ValueObject:
+		return false
+	}
+	return true
+}
+
+// String prints the document in compact form
+func (d *Document) String() string {
+	var sb strings.Builder
+	for i, def := range d.order {
+		if i > 0 {
+			sb.WriteByte('\n')
+		}
+		switch def := def.(type) {
+		case *Operation:
+			def.write(&sb)
+		case *Fragment:
+			def.write(&sb)
+		}
+	}
+	return sb.String()
+}
+
+func (o *Operation) String() string {
+	var sb strings.Builder
+	o.write(&sb)
+	return sb.String()
+}
+
+func (o *Operation) write(sb *strings.Builder) {
+	if o.Name == "" && len(o.Variables) == 0 && len(o.Directives) == 0 && o.Type == "query" {
+		writeSelectionSet(sb, o.SelectionSet)
+		return
+	}
+	sb.WriteString(o.Type)
+	if o.Name != "" {
+		sb.WriteByte(' ')
+		sb.WriteString(o.Name)
+	}
+	if len(o.Variables) > 0 {
+		sb.WriteByte('(')
+		for i, v := range o.Variables {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("$" + v.Name + ": " + v.Type)
+			if v.DefaultValue != nil {
+				sb.WriteString(" = ")
+				v.DefaultValue.write(sb)
+			}
+			writeDirectives(sb, v.Directives)
+		}
+		sb.WriteByte(')')
+	}
+	writeDirectives(sb, o.Directives)
+	sb.WriteByte(' ')
+	writeSelectionSet(sb, o.SelectionSet)
+}
+
+func (f *Fragment) write(sb *strings.Builder) {
+	sb.WriteString("fragment " + f.Name + " on " + f.TypeCondition)
+	writeDirectives(sb, f.Directives)
+	sb.WriteByte(' ')
+	writeSelectionSet(sb, f.SelectionSet)
+}
+
+func writeSelectionSet(sb *strings.Builder, set []Selection) {
+	sb.WriteString("{ ")
+	for _, sel := range set {
+		switch sel := sel.(type) {
+		case *Field:
+			if sel.Alias != "" {
+				sb.WriteString(sel.Alias + ": ")
+			}
+			sb.WriteString(sel.Name)
+			writeArguments(sb, sel.Arguments)
+			writeDirectives(sb, sel.Directives)
+			if len(sel.SelectionSet) > 0 {
+				sb.WriteByte(' ')
+				writeSelectionSet(sb, sel.SelectionSet)
+			}
+		case *FragmentSpread:
+			sb.WriteString("..." + sel.Name)
+			writeDirectives(sb, sel.Directives)
+		case *InlineFragment:
+			sb.WriteString("...")
+			if sel.TypeCondition != "" {
+				sb.WriteString(" on " + sel.TypeCondition)
+			}
+			writeDirectives(sb, sel.Directives)
+			sb.WriteByte(' ')
+			writeSelectionSet(sb, sel.SelectionSet)
+		}
+		sb.WriteByte(' ')
+	}
+	sb.WriteByte('}')
+}
+
+func writeArguments(sb *strings.Builder, args []*Argument) {
+	if len(args) == 0 {
+		return
+	}
+	sb.WriteByte('(')
+	for i, arg := range args {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(arg.Name + ": ")
+		arg.Value.write(sb)
+	}
+	sb.WriteByte(')')
+}
+
+func writeDirectives(sb *strings.Builder, directives []*Directive) {
+	for _, d := range directives {
+		sb.WriteString(" @" + d.Name)
+		writeArguments(sb, d.Arguments)
+	}
+}
+
+func (v *Value) String() string {
+	var sb strings.Builder
+	v.write(&sb)
+	return sb.String()
+}
+
+func (v *Value) write(sb *strings.Builder) {
+	switch v.Kind {
+	case ValueVariable:
+		sb.WriteString("$" + v.Raw)
+	case ValueString:
+		sb.WriteString(quoteString(v.Raw))
+	case ValueList:
+		sb.WriteByte('[')
+		for i, item := range v.List {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			item.write(sb)
+		}
+		sb.WriteByte(']')
+	case ValueObject:
+		sb.WriteByte('{')
+		for i, field := range v.Fields {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(field.Name + ": ")
+			field.Value.write(sb)
+		}
+		sb.WriteByte('}')
+	default:
+		sb.WriteString(v.Raw)
+	}
+}
+
+// quoteString escapes string in GraphQL (json compatible) syntax
+func quoteString(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		default:
+			if r < 0x20 {
+				sb.WriteString(`\u00`)
+				sb.WriteString(strconv.FormatInt(int64(r)>>4, 16))
+				sb.WriteString(strconv.FormatInt(int64(r)&0xf, 16))
+				continue
+			}
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
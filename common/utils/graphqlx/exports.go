@@ -0,0 +1,56 @@
+package graphqlx
+
+import (
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// Introspect 以请求报文为模板向 GraphQL 端点发送内省查询，返回 Schema
+// Example:
+// ```
+// schema = graphql.Introspect(packet, true)~
+// for op in graphql.GenerateOperations(schema) { println(op.Query) }
+// ```
+func _introspect(packet []byte, isHttps bool) (*Schema, error) {
+	return Introspect(packet, lowhttp.WithHttps(isHttps))
+}
+
+// DetectBruteForce 检测 GraphQL 端点是否支持批量请求（JSON 数组）与别名（alias），二者都可以在一个 HTTP 请求中完成多次尝试从而绕过频率限制
+// Example:
+// ```
+// result = graphql.DetectBruteForce(packet, true)~
+// println(result.Batching, result.Alias)
+// ```
+func _detectBruteForce(packet []byte, isHttps bool) (*BruteForceSupport, error) {
+	return DetectBruteForce(packet, lowhttp.WithHttps(isHttps))
+}
+
+// GenerateOperations 为 Schema 中每一个 query/mutation/subscription 字段生成合法的请求
+// Example:
+// ```
+// schema = graphql.ParseSDL(file.ReadFile("schema.graphql")~)~
+// for op in graphql.GenerateOperations(schema) { println(string(op.Body())) }
+// ```
+func _generateOperations(schema *Schema) []*GeneratedOperation {
+	return schema.GenerateOperations(DefaultGenerateDepth)
+}
+
+// ParseSDL 解析本地的 GraphQL Schema 定义文件（SDL）
+// Example:
+// ```
+// schema = graphql.ParseSDL("type Query { user(id: ID!): String }")~
+// ```
+func _parseSDL(source string) (*Schema, error) {
+	return ParseSDL(source)
+}
+
+var Exports = map[string]interface{}{
+	"ParseQuery":         ParseQuery,
+	"ParseSDL":           _parseSDL,
+	"ParseIntrospection": ParseIntrospection,
+	"Introspect":         _introspect,
+	"DetectBruteForce":   _detectBruteForce,
+	"GenerateOperations": _generateOperations,
+	"BuildAliasQuery":    BuildAliasQuery,
+	"NewRequestBody":     NewRequestBody,
+	"IntrospectionQuery": IntrospectionQuery,
+}
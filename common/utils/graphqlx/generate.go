@@ -0,0 +1,209 @@
+package graphqlx
+
+import (
+	"encoding/json"
+	"strings"
+)
+
+// DefaultGenerateDepth is the depth of nested object selections in generated queries
+const DefaultGenerateDepth = 2
+
+// GeneratedOperation is a valid operation calling one root field, arguments are passed by variables
+type GeneratedOperation struct {
+	// Type is query, mutation or subscription
+	Type          string
+	Field         string
+	OperationName string
+	Query         string
+	Variables     map[string]any
+}
+
+// Body is the json body of GraphQL over HTTP
+func (o *GeneratedOperation) Body() []byte {
+	return NewRequestBody(o.Query, o.OperationName, o.Variables)
+}
+
+// NewRequestBody builds `{"query": ..., "operationName": ..., "variables": ...}`
+func NewRequestBody(query string, operationName string, variables map[string]any) []byte {
+	body := map[string]any{"query": query}
+	if operationName != "" {
+		body["operationName"] = operationName
+	}
+	if len(variables) > 0 {
+		body["variables"] = variables
+	}
+	raw, _ := json.Marshal(body)
+	return raw
+}
+
+// GenerateOperations generates one operation for every field of query, mutation and subscription types,
+// object fields are selected until maxDepth, nested fields with required arguments are skipped
+func (s *Schema) GenerateOperations(maxDepth int) []*GeneratedOperation {
+	if maxDepth <= 0 {
+		maxDepth = DefaultGenerateDepth
+	}
+	var ops []*GeneratedOperation
+	for _, root := range []struct {
+		typ  string
+		name string
+	}{
+		{"query", s.QueryType},
+		{"mutation", s.MutationType},
+		{"subscription", s.SubscriptionType},
+	} {
+		typ := s.Type(root.name)
+		if typ == nil {
+			continue
+		}
+		for _, field := range typ.Fields {
+			if strings.HasPrefix(field.Name, "__") {
+				continue
+			}
+			ops = append(ops, s.generateOperation(root.typ, field, maxDepth))
+		}
+	}
+	return ops
+}
+
+func (s *Schema) generateOperation(opType string, field *FieldDefinition, maxDepth int) *GeneratedOperation {
+	op := &GeneratedOperation{
+		Type:          opType,
+		Field:         field.Name,
+		OperationName: strings.ToUpper(opType[:1]) + opType[1:] + "_" + field.Name,
+		Variables:     make(map[string]any),
+	}
+	var sb strings.Builder
+	sb.WriteString(opType + " " + op.OperationName)
+	if len(field.Args) > 0 {
+		sb.WriteByte('(')
+		for i, arg := range field.Args {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("$" + arg.Name + ": " + arg.Type.String())
+			op.Variables[arg.Name] = s.sampleValue(arg.Type, 0)
+		}
+		sb.WriteByte(')')
+	}
+	sb.WriteString(" { " + field.Name)
+	if len(field.Args) > 0 {
+		sb.WriteByte('(')
+		for i, arg := range field.Args {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(arg.Name + ": $" + arg.Name)
+		}
+		sb.WriteByte(')')
+	}
+	if selection := s.selection(field.Type.NamedType(), 1, maxDepth); selection != "" {
+		sb.WriteString(" " + selection)
+	}
+	sb.WriteString(" }")
+	op.Query = sb.String()
+	return op
+}
+
+func (s *Schema) isLeaf(name string) bool {
+	typ := s.Type(name)
+	return typ == nil || typ.Kind == KindScalar || typ.Kind == KindEnum
+}
+
+// selection builds the selection set of composite type, empty for leaf types
+func (s *Schema) selection(name string, depth int, maxDepth int) string {
+	if s.isLeaf(name) {
+		return ""
+	}
+	typ := s.Type(name)
+	var items []string
+	switch typ.Kind {
+	case KindUnion:
+		items = append(items, "__typename")
+		if depth <= maxDepth {
+			for _, member := range typ.PossibleTypes {
+				if sub := s.selection(member, depth+1, maxDepth); sub != "" {
+					items = append(items, "... on "+member+" "+sub)
+				}
+			}
+		}
+	default:
+		if typ.Kind == KindInterface {
+			items = append(items, "__typename")
+		}
+		for _, field := range typ.Fields {
+			if hasRequiredArgs(field) {
+				continue
+			}
+			named := field.Type.NamedType()
+			if s.isLeaf(named) {
+				items = append(items, field.Name)
+				continue
+			}
+			if depth >= maxDepth {
+				continue
+			}
+			if sub := s.selection(named, depth+1, maxDepth); sub != "" {
+				items = append(items, field.Name+" "+sub)
+			}
+		}
+		if len(items) == 0 {
+			items = append(items, "__typename")
+		}
+	}
+	return "{ " + strings.Join(items, " ") + " }"
+}
+
+func hasRequiredArgs(field *FieldDefinition) bool {
+	for _, arg := range field.Args {
+		if arg.Type.IsNonNull() && arg.DefaultValue == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// sampleValue is a valid value of the input type, nullable fields of input object are filled only at top levels
+func (s *Schema) sampleValue(t *TypeRef, depth int) any {
+	switch t.Kind {
+	case KindNonNull:
+		return s.sampleValue(t.OfType, depth)
+	case KindList:
+		return []any{s.sampleValue(t.OfType, depth)}
+	}
+	switch t.Name {
+	case "Int":
+		return 1
+	case "Float":
+		return 1.5
+	case "Boolean":
+		return true
+	case "ID":
+		return "1"
+	case "String":
+		return "test"
+	}
+	typ := s.Type(t.Name)
+	if typ == nil {
+		return "test"
+	}
+	switch typ.Kind {
+	case KindEnum:
+		if len(typ.EnumValues) > 0 {
+			return typ.EnumValues[0]
+		}
+		return nil
+	case KindInputObject:
+		obj := make(map[string]any)
+		for _, field := range typ.InputFields {
+			if !field.Type.IsNonNull() && depth >= 1 {
+				continue
+			}
+			if depth > 8 {
+				break
+			}
+			obj[field.Name] = s.sampleValue(field.Type, depth+1)
+		}
+		return obj
+	}
+	return "test"
+}
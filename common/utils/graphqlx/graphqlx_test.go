@@ -0,0 +1,240 @@
+package graphqlx
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+const testSDL = `
+"""
+The root query
+"""
+type Query {
+  user(id: ID!): User
+  search(filter: SearchInput, first: Int = 10): [SearchResult!]!
+  node(id: ID!): Node
+}
+
+type Mutation {
+  login(username: String!, password: String!): Token
+}
+
+schema { query: Query mutation: Mutation }
+
+interface Node { id: ID! }
+
+type User implements Node @key(fields: "id") {
+  id: ID!
+  "the user name"
+  name: String
+  role: Role
+  friends(first: Int!): [User]
+  posts: [Post]
+}
+
+type Post implements Node { id: ID! title: String author: User }
+
+type Token { value: String }
+
+union SearchResult = User | Post
+
+enum Role { ADMIN USER }
+
+input SearchInput {
+  keyword: String!
+  role: Role
+}
+
+extend type Query { me: User }
+
+directive @key(fields: String!) repeatable on OBJECT | INTERFACE
+scalar DateTime
+`
+
+func TestParseQuery_Print(t *testing.T) {
+	query := `# comment
+query GetUser($id: ID! = "1", $tags: [String!]) @cached {
+  u: user(id: 1, filter: {name: "a\"b", roles: [ADMIN, USER]}, flag: true) {
+    ...UserFields
+    ... on Admin { level(min: -1.5e3) }
+    posts(first: $first) @include(if: $show) { title }
+  }
+}
+fragment UserFields on User { name(format: """ block
+   text """) }`
+	doc, err := ParseQuery(query)
+	require.NoError(t, err)
+	require.Len(t, doc.Operations, 1)
+	require.Len(t, doc.Fragments, 1)
+	printed := doc.String()
+	require.Equal(t, `query GetUser($id: ID! = "1", $tags: [String!]) @cached { u: user(id: 1, filter: {name: "a\"b", roles: [ADMIN, USER]}, flag: true) { ...UserFields ... on Admin { level(min: -1.5e3) } posts(first: $first) @include(if: $show) { title } } }
+fragment UserFields on User { name(format: " block\ntext ") }`, printed)
+
+	reparsed, err := ParseQuery(printed)
+	require.NoError(t, err)
+	require.Equal(t, printed, reparsed.String())
+
+	shorthand, err := ParseQuery(`{ a }`)
+	require.NoError(t, err)
+	require.Equal(t, `{ a }`, shorthand.String())
+
+	for _, bad := range []string{`{ }`, `query { a(b: ) }`, `{ a`, `query ($a: Int = $b) { a }`, `{ a(x: 01x) }`, `hello`} {
+		_, err := ParseQuery(bad)
+		require.Error(t, err, bad)
+	}
+}
+
+func TestDocument_Params(t *testing.T) {
+	doc, err := ParseQuery(`query { u: user(id: 1, filter: {name: "a", roles: [ADMIN]}) { posts(first: 2, after: $c) { title } } }
+fragment F on User { friends(first: 3) { name } }`)
+	require.NoError(t, err)
+
+	var names []string
+	for _, p := range doc.Params() {
+		names = append(names, p.Name+"="+p.Value)
+	}
+	require.Equal(t, []string{
+		"u(id)=1", "u(filter.name)=a", "u(filter.roles[0])=ADMIN", "u.posts(first)=2", "...F.friends(first)=3",
+	}, names)
+
+	param, ok := doc.FindParam("u(filter.name)")
+	require.True(t, ok)
+	require.Equal(t, "d0.s0.a1.o0", param.Path)
+
+	require.NoError(t, doc.SetParam(param.Path, `' or "1"="1`))
+	idParam, _ := doc.FindParam("u(id)")
+	require.NoError(t, doc.SetParam(idParam.Path, "2"))
+	first, _ := doc.FindParam("u.posts(first)")
+	require.NoError(t, doc.SetParam(first.Path, "1 OR 1=1"))
+	require.Error(t, doc.SetParam("d0.s0.a9", "x"))
+	require.Error(t, doc.SetParam("d0.s0.s0.a1", "x"))
+
+	require.Equal(t, `{ u: user(id: 2, filter: {name: "' or \"1\"=\"1", roles: [ADMIN]}) { posts(first: "1 OR 1=1", after: $c) { title } } }
+fragment F on User { friends(first: 3) { name } }`, doc.String())
+}
+
+func TestBuildAliasQuery(t *testing.T) {
+	query, err := BuildAliasQuery(`mutation { me { id } login(username: "admin", password: "x") { value } }`,
+		"login(password)", []string{"123456", "admin"})
+	require.NoError(t, err)
+	require.Equal(t, `mutation { me { id } alias0: login(username: "admin", password: "123456") { value } alias1: login(username: "admin", password: "admin") { value } }`, query)
+
+	_, err = BuildAliasQuery(`{ a(b: 1) }`, "a(c)", []string{"1"})
+	require.Error(t, err)
+}
+
+func TestParseSDL_Generate(t *testing.T) {
+	schema, err := ParseSDL(testSDL)
+	require.NoError(t, err)
+	require.Equal(t, "Query", schema.QueryType)
+	require.Equal(t, "Mutation", schema.MutationType)
+	require.Equal(t, []string{"User", "Post"}, schema.Type("SearchResult").PossibleTypes)
+	require.ElementsMatch(t, []string{"User", "Post"}, schema.Type("Node").PossibleTypes)
+	require.Equal(t, "the user name", schema.Type("User").Fields[1].Description)
+	require.Equal(t, "[SearchResult!]!", schema.Type("Query").Fields[1].Type.String())
+	require.Equal(t, KindUnion, schema.Type("Query").Fields[1].Type.OfType.OfType.OfType.Kind)
+	require.Equal(t, "10", schema.Type("Query").Fields[1].Args[1].DefaultValue)
+
+	ops := schema.GenerateOperations(0)
+	var fields []string
+	for _, op := range ops {
+		fields = append(fields, op.Type+"."+op.Field)
+		_, err := ParseQuery(op.Query)
+		require.NoError(t, err, op.Query)
+	}
+	require.Equal(t, []string{"query.user", "query.search", "query.node", "query.me", "mutation.login"}, fields)
+
+	require.Equal(t, `query Query_user($id: ID!) { user(id: $id) { id name role posts { id title } } }`, ops[0].Query)
+	require.Equal(t, map[string]any{"id": "1"}, ops[0].Variables)
+	require.Equal(t, map[string]any{"filter": map[string]any{"keyword": "test", "role": "ADMIN"}, "first": 1}, ops[1].Variables)
+	require.Contains(t, ops[1].Query, `... on User {`)
+	require.Contains(t, ops[2].Query, `node(id: $id) { __typename id }`)
+
+	body := gjson.ParseBytes(ops[4].Body())
+	require.Equal(t, "Mutation_login", body.Get("operationName").String())
+	require.Equal(t, "test", body.Get("variables.password").String())
+
+	_, err = ParseSDL(`type A { a: String } type A { b: String }`)
+	require.Error(t, err)
+}
+
+func TestParseIntrospection(t *testing.T) {
+	raw := `{"data":{"__schema":{"queryType":{"name":"Query"},"mutationType":null,"subscriptionType":null,"types":[
+{"kind":"OBJECT","name":"Query","fields":[{"name":"users","args":[{"name":"ids","type":{"kind":"NON_NULL","name":null,"ofType":{"kind":"LIST","name":null,"ofType":{"kind":"SCALAR","name":"Int","ofType":null}}},"defaultValue":null}],"type":{"kind":"LIST","name":null,"ofType":{"kind":"OBJECT","name":"User","ofType":null}}}],"inputFields":null,"interfaces":[],"enumValues":null,"possibleTypes":null},
+{"kind":"OBJECT","name":"User","fields":[{"name":"name","args":[],"type":{"kind":"SCALAR","name":"String","ofType":null}}],"inputFields":null,"interfaces":[],"enumValues":null,"possibleTypes":null},
+{"kind":"SCALAR","name":"Int"},{"kind":"SCALAR","name":"String"},{"kind":"OBJECT","name":"__Schema","fields":[]}]}}}`
+	schema, err := ParseIntrospection([]byte(raw))
+	require.NoError(t, err)
+	require.Equal(t, []string{"Int", "Query", "String", "User"}, schema.TypeNames())
+	ops := schema.GenerateOperations(2)
+	require.Len(t, ops, 1)
+	require.Equal(t, `query Query_users($ids: [Int]!) { users(ids: $ids) { name } }`, ops[0].Query)
+
+	_, err = ParseIntrospection([]byte(`{"errors":[{"message":"introspection disabled"}]}`))
+	require.ErrorContains(t, err, "introspection disabled")
+}
+
+func TestExtractQueries(t *testing.T) {
+	queries := ExtractQueries([]byte(`{"query":"query Q($id: ID) { user(id: $id) { name } }","operationName":"Q","variables":{"id":"1"}}`), "application/json")
+	require.Len(t, queries, 1)
+	require.Equal(t, "$", queries[0].Prefix)
+	require.Equal(t, "Q", queries[0].OperationName)
+	require.Equal(t, `{"id":"1"}`, queries[0].Variables)
+	require.Equal(t, "$.variables", queries[0].JSONPath("variables"))
+
+	queries = ExtractQueries([]byte(`[{"query":"{ a }"},{"query":"{ b(x: 1) }"}]`), "application/json")
+	require.Len(t, queries, 2)
+	require.True(t, queries[1].IsBatch())
+	require.Equal(t, "$[1].query", queries[1].JSONPath("query"))
+
+	queries = ExtractQueries([]byte(`{ a(x: "1") }`), "application/graphql")
+	require.Len(t, queries, 1)
+	require.Equal(t, "", queries[0].Prefix)
+
+	require.Nil(t, ExtractQueries([]byte(`{"query":"select * from a"}`), "application/json"))
+	require.Nil(t, ExtractQueries([]byte(`{"a":1}`), "application/json"))
+}
+
+func TestIntrospect_DetectBruteForce(t *testing.T) {
+	schemaRaw := `{"data":{"__schema":{"queryType":{"name":"Query"},"types":[{"kind":"OBJECT","name":"Query","fields":[{"name":"hello","args":[],"type":{"kind":"SCALAR","name":"String"}}]}]}}}`
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(string(body), "__schema"):
+			w.Write([]byte(schemaRaw))
+		case strings.HasPrefix(string(body), "["):
+			var items []any
+			_ = json.Unmarshal(body, &items)
+			var results []string
+			for range items {
+				results = append(results, `{"data":{"hello":"world"}}`)
+			}
+			w.Write([]byte("[" + strings.Join(results, ",") + "]"))
+		case strings.Contains(string(body), "alias1"):
+			w.Write([]byte(`{"data":{"alias0":"world","alias1":"world"}}`))
+		default:
+			w.Write([]byte(`{"data":{"hello":"world"}}`))
+		}
+	})
+	packet := []byte("POST /graphql HTTP/1.1\r\nHost: " + utils.HostPort(host, port) + "\r\nContent-Type: application/json\r\n\r\n" +
+		`{"query":"query Hello { hello }","operationName":"Hello"}`)
+
+	schema, err := Introspect(packet)
+	require.NoError(t, err)
+	require.Equal(t, "Query", schema.QueryType)
+
+	result, err := DetectBruteForce(packet)
+	require.NoError(t, err)
+	require.True(t, result.Batching)
+	require.True(t, result.Alias)
+	require.Contains(t, string(lowhttp.GetHTTPPacketBody(result.AliasRequest)), `alias0: hello alias1: hello`)
+}
@@ -0,0 +1,241 @@
+package graphqlx
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenPunct
+	tokenName
+	tokenInt
+	tokenFloat
+	tokenString
+	tokenBlockString
+)
+
+type token struct {
+	kind tokenKind
+	// text is the unescaped value of string, or the source text of others
+	text string
+	pos  int
+}
+
+type lexer struct {
+	src string
+	pos int
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameContinue(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+func (l *lexer) next() (token, error) {
+	// skip ignored tokens: whitespace, comma, comment and BOM
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			l.pos++
+			continue
+		}
+		if c == '#' {
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' && l.src[l.pos] != '\r' {
+				l.pos++
+			}
+			continue
+		}
+		if strings.HasPrefix(l.src[l.pos:], "\uFEFF") {
+			l.pos += 3
+			continue
+		}
+		break
+	}
+	start := l.pos
+	if l.pos >= len(l.src) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	c := l.src[l.pos]
+	switch {
+	case strings.ContainsRune("!$&()[]{}:=@|", rune(c)):
+		l.pos++
+		return token{kind: tokenPunct, text: string(c), pos: start}, nil
+	case c == '.':
+		if strings.HasPrefix(l.src[l.pos:], "...") {
+			l.pos += 3
+			return token{kind: tokenPunct, text: "...", pos: start}, nil
+		}
+		return token{}, utils.Errorf("graphql syntax error at %d: unexpected '.'", start)
+	case isNameStart(c):
+		for l.pos < len(l.src) && isNameContinue(l.src[l.pos]) {
+			l.pos++
+		}
+		return token{kind: tokenName, text: l.src[start:l.pos], pos: start}, nil
+	case c == '-' || (c >= '0' && c <= '9'):
+		return l.number()
+	case c == '"':
+		if strings.HasPrefix(l.src[l.pos:], `"""`) {
+			return l.blockString()
+		}
+		return l.string()
+	}
+	r, _ := utf8.DecodeRuneInString(l.src[l.pos:])
+	return token{}, utils.Errorf("graphql syntax error at %d: unexpected character %q", start, r)
+}
+
+func (l *lexer) number() (token, error) {
+	start := l.pos
+	isFloat := false
+	if l.src[l.pos] == '-' {
+		l.pos++
+	}
+	digits := func() int {
+		n := 0
+		for l.pos < len(l.src) && l.src[l.pos] >= '0' && l.src[l.pos] <= '9' {
+			l.pos++
+			n++
+		}
+		return n
+	}
+	if digits() == 0 {
+		return token{}, utils.Errorf("graphql syntax error at %d: invalid number", start)
+	}
+	if l.pos < len(l.src) && l.src[l.pos] == '.' {
+		isFloat = true
+		l.pos++
+		if digits() == 0 {
+			return token{}, utils.Errorf("graphql syntax error at %d: invalid number", start)
+		}
+	}
+	if l.pos < len(l.src) && (l.src[l.pos] == 'e' || l.src[l.pos] == 'E') {
+		isFloat = true
+		l.pos++
+		if l.pos < len(l.src) && (l.src[l.pos] == '+' || l.src[l.pos] == '-') {
+			l.pos++
+		}
+		if digits() == 0 {
+			return token{}, utils.Errorf("graphql syntax error at %d: invalid number", start)
+		}
+	}
+	if l.pos < len(l.src) && (isNameStart(l.src[l.pos]) || l.src[l.pos] == '.') {
+		return token{}, utils.Errorf("graphql syntax error at %d: invalid number", start)
+	}
+	kind := tokenInt
+	if isFloat {
+		kind = tokenFloat
+	}
+	return token{kind: kind, text: l.src[start:l.pos], pos: start}, nil
+}
+
+func (l *lexer) string() (token, error) {
+	start := l.pos
+	l.pos++
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch c {
+		case '"':
+			l.pos++
+			return token{kind: tokenString, text: sb.String(), pos: start}, nil
+		case '\n', '\r':
+			return token{}, utils.Errorf("graphql syntax error at %d: unterminated string", start)
+		case '\\':
+			if l.pos+1 >= len(l.src) {
+				return token{}, utils.Errorf("graphql syntax error at %d: unterminated string", start)
+			}
+			esc := l.src[l.pos+1]
+			l.pos += 2
+			switch esc {
+			case '"', '\\', '/':
+				sb.WriteByte(esc)
+			case 'b':
+				sb.WriteByte('\b')
+			case 'f':
+				sb.WriteByte('\f')
+			case 'n':
+				sb.WriteByte('\n')
+			case 'r':
+				sb.WriteByte('\r')
+			case 't':
+				sb.WriteByte('\t')
+			case 'u':
+				if l.pos+4 > len(l.src) {
+					return token{}, utils.Errorf("graphql syntax error at %d: invalid unicode escape", start)
+				}
+				code, err := strconv.ParseUint(l.src[l.pos:l.pos+4], 16, 32)
+				if err != nil {
+					return token{}, utils.Errorf("graphql syntax error at %d: invalid unicode escape", start)
+				}
+				sb.WriteRune(rune(code))
+				l.pos += 4
+			default:
+				return token{}, utils.Errorf("graphql syntax error at %d: invalid escape \\%c", start, esc)
+			}
+		default:
+			sb.WriteByte(c)
+			l.pos++
+		}
+	}
+	return token{}, utils.Errorf("graphql syntax error at %d: unterminated string", start)
+}
+
+func (l *lexer) blockString() (token, error) {
+	start := l.pos
+	l.pos += 3
+	var sb strings.Builder
+	for l.pos < len(l.src) {
+		if strings.HasPrefix(l.src[l.pos:], `\"""`) {
+			sb.WriteString(`"""`)
+			l.pos += 4
+			continue
+		}
+		if strings.HasPrefix(l.src[l.pos:], `"""`) {
+			l.pos += 3
+			return token{kind: tokenBlockString, text: dedentBlockString(sb.String()), pos: start}, nil
+		}
+		sb.WriteByte(l.src[l.pos])
+		l.pos++
+	}
+	return token{}, utils.Errorf("graphql syntax error at %d: unterminated block string", start)
+}
+
+// dedentBlockString removes the common indentation and blank leading/trailing lines of block string
+func dedentBlockString(raw string) string {
+	lines := strings.Split(strings.ReplaceAll(raw, "\r\n", "\n"), "\n")
+	common := -1
+	for i, line := range lines {
+		if i == 0 {
+			continue
+		}
+		indent := len(line) - len(strings.TrimLeft(line, " \t"))
+		if indent < len(line) && (common < 0 || indent < common) {
+			common = indent
+		}
+	}
+	if common > 0 {
+		for i := 1; i < len(lines); i++ {
+			if len(lines[i]) >= common {
+				lines[i] = lines[i][common:]
+			} else {
+				lines[i] = strings.TrimLeft(lines[i], " \t")
+			}
+		}
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[0]) == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && strings.TrimSpace(lines[len(lines)-1]) == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return strings.Join(lines, "\n")
+}
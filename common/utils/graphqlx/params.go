@@ -0,0 +1,249 @@
+package graphqlx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Param is a scalar argument literal of query which can be fuzzed
+type Param struct {
+	// Path locates the literal, eg. `d0.s1.a0.o2` is the 3rd object field of the 1st argument of the 2nd root field
+	Path string
+	// Name is readable, eg. `user.posts(first)`, `user(filter.name)`, `users(ids[1])`
+	Name  string
+	Value string
+	Kind  ValueKind
+}
+
+// Params lists all scalar argument literals of operations and fragments, variables are not included
+func (d *Document) Params() []*Param {
+	var params []*Param
+	for i, def := range d.order {
+		prefix := "d" + strconv.Itoa(i)
+		switch def := def.(type) {
+		case *Operation:
+			walkSelectionParams(def.SelectionSet, prefix, "", &params)
+		case *Fragment:
+			walkSelectionParams(def.SelectionSet, prefix, "..."+def.Name, &params)
+		}
+	}
+	return params
+}
+
+func walkSelectionParams(set []Selection, path string, name string, params *[]*Param) {
+	for i, sel := range set {
+		selPath := path + ".s" + strconv.Itoa(i)
+		switch sel := sel.(type) {
+		case *Field:
+			fieldName := sel.ResponseKey()
+			if name != "" {
+				fieldName = name + "." + fieldName
+			}
+			for j, arg := range sel.Arguments {
+				walkValueParams(arg.Value, selPath+".a"+strconv.Itoa(j), fieldName+"("+arg.Name, params)
+			}
+			walkSelectionParams(sel.SelectionSet, selPath, fieldName, params)
+		case *InlineFragment:
+			walkSelectionParams(sel.SelectionSet, selPath, name, params)
+		}
+	}
+}
+
+func walkValueParams(v *Value, path string, name string, params *[]*Param) {
+	switch v.Kind {
+	case ValueVariable:
+	case ValueList:
+		for i, item := range v.List {
+			walkValueParams(item, path+".l"+strconv.Itoa(i), name+"["+strconv.Itoa(i)+"]", params)
+		}
+	case ValueObject:
+		for i, field := range v.Fields {
+			walkValueParams(field.Value, path+".o"+strconv.Itoa(i), name+"."+field.Name, params)
+		}
+	default:
+		*params = append(*params, &Param{Path: path, Name: name + ")", Value: v.Raw, Kind: v.Kind})
+	}
+}
+
+// FindParam finds the param by Path or Name
+func (d *Document) FindParam(key string) (*Param, bool) {
+	for _, param := range d.Params() {
+		if param.Path == key || param.Name == key {
+			return param, true
+		}
+	}
+	return nil, false
+}
+
+// SetParam replaces the literal at path
+func (d *Document) SetParam(path string, value string) error {
+	v, err := d.lookupValue(path)
+	if err != nil {
+		return err
+	}
+	v.SetLiteral(value)
+	return nil
+}
+
+// SetLiteral replaces the scalar, non-string literal keeps its kind if value is still valid, or becomes a string
+func (v *Value) SetLiteral(value string) {
+	switch v.Kind {
+	case ValueString:
+		v.Raw = value
+		return
+	case ValueInt, ValueFloat, ValueBoolean, ValueNull, ValueEnum:
+		if parsed, err := ParseValue(value); err == nil && parsed.IsScalar() && parsed.Kind != ValueString {
+			*v = *parsed
+			return
+		}
+	}
+	*v = Value{Kind: ValueString, Raw: value}
+}
+
+func (d *Document) lookupValue(path string) (*Value, error) {
+	segments := strings.Split(path, ".")
+	if len(segments) < 3 {
+		return nil, utils.Errorf("invalid graphql param path: %v", path)
+	}
+	index := func(seg string, prefix byte, length int) (int, error) {
+		if len(seg) < 2 || seg[0] != prefix {
+			return 0, utils.Errorf("invalid graphql param path: %v", path)
+		}
+		i, err := strconv.Atoi(seg[1:])
+		if err != nil || i < 0 || i >= length {
+			return 0, utils.Errorf("graphql param %v not found", path)
+		}
+		return i, nil
+	}
+
+	i, err := index(segments[0], 'd', len(d.order))
+	if err != nil {
+		return nil, err
+	}
+	var set []Selection
+	switch def := d.order[i].(type) {
+	case *Operation:
+		set = def.SelectionSet
+	case *Fragment:
+		set = def.SelectionSet
+	}
+
+	var field *Field
+	var value *Value
+	for _, seg := range segments[1:] {
+		switch {
+		case value != nil:
+			switch seg[0] {
+			case 'l':
+				i, err := index(seg, 'l', len(value.List))
+				if err != nil {
+					return nil, err
+				}
+				value = value.List[i]
+			case 'o':
+				i, err := index(seg, 'o', len(value.Fields))
+				if err != nil {
+					return nil, err
+				}
+				value = value.Fields[i].Value
+			default:
+				return nil, utils.Errorf("invalid graphql param path: %v", path)
+			}
+		case strings.HasPrefix(seg, "a"):
+			if field == nil {
+				return nil, utils.Errorf("invalid graphql param path: %v", path)
+			}
+			i, err := index(seg, 'a', len(field.Arguments))
+			if err != nil {
+				return nil, err
+			}
+			value = field.Arguments[i].Value
+		default:
+			i, err := index(seg, 's', len(set))
+			if err != nil {
+				return nil, err
+			}
+			field = nil
+			switch sel := set[i].(type) {
+			case *Field:
+				field = sel
+				set = sel.SelectionSet
+			case *InlineFragment:
+				set = sel.SelectionSet
+			default:
+				return nil, utils.Errorf("graphql param %v not found", path)
+			}
+		}
+	}
+	if value == nil || !value.IsScalar() {
+		return nil, utils.Errorf("graphql param %v is not a scalar literal", path)
+	}
+	return value, nil
+}
+
+// BuildAliasQuery repeats the root field containing the param with aliases `alias0`, `alias1`...,
+// each copy uses one of values, which makes a brute force of many attempts in one request
+func BuildAliasQuery(query string, paramKey string, values []string) (string, error) {
+	if len(values) == 0 {
+		return "", utils.Error("no values for alias query")
+	}
+	doc, err := ParseQuery(query)
+	if err != nil {
+		return "", err
+	}
+	param, ok := doc.FindParam(paramKey)
+	if !ok {
+		return "", utils.Errorf("graphql param %v not found", paramKey)
+	}
+	segments := strings.SplitN(param.Path, ".", 3)
+	defIndex, _ := strconv.Atoi(strings.TrimPrefix(segments[0], "d"))
+	rootIndex, _ := strconv.Atoi(strings.TrimPrefix(segments[1], "s"))
+	return repeatRootField(query, defIndex, rootIndex, len(values), func(doc *Document, i int) error {
+		return doc.SetParam(param.Path, values[i])
+	})
+}
+
+// repeatRootField replaces the root field with count aliased copies, edit is called on the copy i before it is taken
+func repeatRootField(query string, defIndex int, rootIndex int, count int, edit func(doc *Document, i int) error) (string, error) {
+	doc, err := ParseQuery(query)
+	if err != nil {
+		return "", err
+	}
+	if defIndex < 0 || defIndex >= len(doc.order) {
+		return "", utils.Errorf("graphql operation %d not found", defIndex)
+	}
+	op, ok := doc.order[defIndex].(*Operation)
+	if !ok {
+		return "", utils.Error("graphql alias must be in operation")
+	}
+	if rootIndex < 0 || rootIndex >= len(op.SelectionSet) {
+		return "", utils.Errorf("graphql root field %d not found", rootIndex)
+	}
+	if _, ok := op.SelectionSet[rootIndex].(*Field); !ok {
+		return "", utils.Error("graphql alias must be on field")
+	}
+
+	copies := make([]Selection, 0, count)
+	for i := 0; i < count; i++ {
+		cloned, err := ParseQuery(query)
+		if err != nil {
+			return "", err
+		}
+		if edit != nil {
+			if err := edit(cloned, i); err != nil {
+				return "", err
+			}
+		}
+		field := cloned.order[defIndex].(*Operation).SelectionSet[rootIndex].(*Field)
+		field.Alias = "alias" + strconv.Itoa(i)
+		copies = append(copies, field)
+	}
+	set := make([]Selection, 0, len(op.SelectionSet)-1+len(copies))
+	set = append(set, op.SelectionSet[:rootIndex]...)
+	set = append(set, copies...)
+	set = append(set, op.SelectionSet[rootIndex+1:]...)
+	op.SelectionSet = set
+	return doc.String(), nil
+}
@@ -0,0 +1,468 @@
+package graphqlx
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const maxParseDepth = 128
+
+type parser struct {
+	tokens []token
+	pos    int
+	depth  int
+}
+
+func newParser(src string) (*parser, error) {
+	l := &lexer{src: src}
+	var tokens []token
+	for {
+		t, err := l.next()
+		if err != nil {
+			return nil, err
+		}
+		tokens = append(tokens, t)
+		if t.kind == tokenEOF {
+			break
+		}
+	}
+	return &parser{tokens: tokens}, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokenEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) peekPunct(text string) bool {
+	t := p.peek()
+	return t.kind == tokenPunct && t.text == text
+}
+
+func (p *parser) peekName(text string) bool {
+	t := p.peek()
+	return t.kind == tokenName && t.text == text
+}
+
+func (p *parser) skipPunct(text string) bool {
+	if p.peekPunct(text) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) unexpected() error {
+	t := p.peek()
+	if t.kind == tokenEOF {
+		return utils.Error("graphql syntax error: unexpected end of document")
+	}
+	return utils.Errorf("graphql syntax error at %d: unexpected %q", t.pos, t.text)
+}
+
+func (p *parser) expectPunct(text string) error {
+	if !p.skipPunct(text) {
+		return p.unexpected()
+	}
+	return nil
+}
+
+func (p *parser) expectName() (string, error) {
+	t := p.peek()
+	if t.kind != tokenName {
+		return "", p.unexpected()
+	}
+	p.pos++
+	return t.text, nil
+}
+
+func (p *parser) expectKeyword(keyword string) error {
+	if !p.peekName(keyword) {
+		return p.unexpected()
+	}
+	p.pos++
+	return nil
+}
+
+func (p *parser) enter() error {
+	p.depth++
+	if p.depth > maxParseDepth {
+		return utils.Error("graphql document nested too deep")
+	}
+	return nil
+}
+
+func (p *parser) leave() {
+	p.depth--
+}
+
+// ParseQuery parses the executable document in `query` of GraphQL request
+func ParseQuery(query string) (*Document, error) {
+	p, err := newParser(query)
+	if err != nil {
+		return nil, err
+	}
+	doc := &Document{}
+	for p.peek().kind != tokenEOF {
+		t := p.peek()
+		switch {
+		case t.kind == tokenPunct && t.text == "{":
+			set, err := p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			op := &Operation{Type: "query", SelectionSet: set}
+			doc.Operations = append(doc.Operations, op)
+			doc.order = append(doc.order, op)
+		case t.kind == tokenName && (t.text == "query" || t.text == "mutation" || t.text == "subscription"):
+			op, err := p.parseOperation()
+			if err != nil {
+				return nil, err
+			}
+			doc.Operations = append(doc.Operations, op)
+			doc.order = append(doc.order, op)
+		case t.kind == tokenName && t.text == "fragment":
+			frag, err := p.parseFragment()
+			if err != nil {
+				return nil, err
+			}
+			doc.Fragments = append(doc.Fragments, frag)
+			doc.order = append(doc.order, frag)
+		default:
+			return nil, p.unexpected()
+		}
+	}
+	if len(doc.Operations) == 0 {
+		return nil, utils.Error("graphql document has no operation")
+	}
+	return doc, nil
+}
+
+func (p *parser) parseOperation() (*Operation, error) {
+	op := &Operation{Type: p.advance().text}
+	if p.peek().kind == tokenName {
+		op.Name = p.advance().text
+	}
+	if p.skipPunct("(") {
+		for !p.skipPunct(")") {
+			if err := p.expectPunct("$"); err != nil {
+				return nil, err
+			}
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expectPunct(":"); err != nil {
+				return nil, err
+			}
+			typ, err := p.parseTypeRef()
+			if err != nil {
+				return nil, err
+			}
+			v := &VariableDefinition{Name: name, Type: typ}
+			if p.skipPunct("=") {
+				v.DefaultValue, err = p.parseValue(true)
+				if err != nil {
+					return nil, err
+				}
+			}
+			v.Directives, err = p.parseDirectives()
+			if err != nil {
+				return nil, err
+			}
+			op.Variables = append(op.Variables, v)
+		}
+	}
+	var err error
+	op.Directives, err = p.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+	op.SelectionSet, err = p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+func (p *parser) parseFragment() (*Fragment, error) {
+	p.advance()
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("on"); err != nil {
+		return nil, err
+	}
+	frag := &Fragment{Name: name}
+	frag.TypeCondition, err = p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	frag.Directives, err = p.parseDirectives()
+	if err != nil {
+		return nil, err
+	}
+	frag.SelectionSet, err = p.parseSelectionSet()
+	if err != nil {
+		return nil, err
+	}
+	return frag, nil
+}
+
+// parseTypeRef parses `Name`, `[Type]` and `Type!` and returns the normalized text
+func (p *parser) parseTypeRef() (string, error) {
+	if err := p.enter(); err != nil {
+		return "", err
+	}
+	defer p.leave()
+
+	var typ string
+	if p.skipPunct("[") {
+		inner, err := p.parseTypeRef()
+		if err != nil {
+			return "", err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return "", err
+		}
+		typ = "[" + inner + "]"
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return "", err
+		}
+		typ = name
+	}
+	if p.skipPunct("!") {
+		typ += "!"
+	}
+	return typ, nil
+}
+
+func (p *parser) parseSelectionSet() ([]Selection, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	if err := p.expectPunct("{"); err != nil {
+		return nil, err
+	}
+	var set []Selection
+	for !p.skipPunct("}") {
+		if p.skipPunct("...") {
+			if p.peek().kind == tokenName && p.peek().text != "on" {
+				spread := &FragmentSpread{Name: p.advance().text}
+				var err error
+				spread.Directives, err = p.parseDirectives()
+				if err != nil {
+					return nil, err
+				}
+				set = append(set, spread)
+				continue
+			}
+			inline := &InlineFragment{}
+			if p.peekName("on") {
+				p.advance()
+				name, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+				inline.TypeCondition = name
+			}
+			var err error
+			inline.Directives, err = p.parseDirectives()
+			if err != nil {
+				return nil, err
+			}
+			inline.SelectionSet, err = p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, inline)
+			continue
+		}
+
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		field := &Field{Name: name}
+		if p.skipPunct(":") {
+			field.Alias = name
+			field.Name, err = p.expectName()
+			if err != nil {
+				return nil, err
+			}
+		}
+		field.Arguments, err = p.parseArguments(false)
+		if err != nil {
+			return nil, err
+		}
+		field.Directives, err = p.parseDirectives()
+		if err != nil {
+			return nil, err
+		}
+		if p.peekPunct("{") {
+			field.SelectionSet, err = p.parseSelectionSet()
+			if err != nil {
+				return nil, err
+			}
+		}
+		set = append(set, field)
+	}
+	if len(set) == 0 {
+		return nil, utils.Error("graphql syntax error: empty selection set")
+	}
+	return set, nil
+}
+
+func (p *parser) parseArguments(isConst bool) ([]*Argument, error) {
+	if !p.skipPunct("(") {
+		return nil, nil
+	}
+	var args []*Argument
+	for !p.skipPunct(")") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		value, err := p.parseValue(isConst)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, &Argument{Name: name, Value: value})
+	}
+	return args, nil
+}
+
+func (p *parser) parseDirectives() ([]*Directive, error) {
+	var directives []*Directive
+	for p.skipPunct("@") {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		args, err := p.parseArguments(false)
+		if err != nil {
+			return nil, err
+		}
+		directives = append(directives, &Directive{Name: name, Arguments: args})
+	}
+	return directives, nil
+}
+
+func (p *parser) parseValue(isConst bool) (*Value, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	t := p.peek()
+	switch t.kind {
+	case tokenInt:
+		p.advance()
+		return &Value{Kind: ValueInt, Raw: t.text}, nil
+	case tokenFloat:
+		p.advance()
+		return &Value{Kind: ValueFloat, Raw: t.text}, nil
+	case tokenString, tokenBlockString:
+		p.advance()
+		return &Value{Kind: ValueString, Raw: t.text}, nil
+	case tokenName:
+		p.advance()
+		switch t.text {
+		case "true", "false":
+			return &Value{Kind: ValueBoolean, Raw: t.text}, nil
+		case "null":
+			return &Value{Kind: ValueNull, Raw: t.text}, nil
+		}
+		return &Value{Kind: ValueEnum, Raw: t.text}, nil
+	case tokenPunct:
+		switch t.text {
+		case "$":
+			if isConst {
+				return nil, utils.Errorf("graphql syntax error at %d: unexpected variable in constant value", t.pos)
+			}
+			p.advance()
+			name, err := p.expectName()
+			if err != nil {
+				return nil, err
+			}
+			return &Value{Kind: ValueVariable, Raw: name}, nil
+		case "[":
+			p.advance()
+			v := &Value{Kind: ValueList}
+			for !p.skipPunct("]") {
+				item, err := p.parseValue(isConst)
+				if err != nil {
+					return nil, err
+				}
+				v.List = append(v.List, item)
+			}
+			return v, nil
+		case "{":
+			p.advance()
+			v := &Value{Kind: ValueObject}
+			for !p.skipPunct("}") {
+				name, err := p.expectName()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expectPunct(":"); err != nil {
+					return nil, err
+				}
+				item, err := p.parseValue(isConst)
+				if err != nil {
+					return nil, err
+				}
+				v.Fields = append(v.Fields, &ObjectField{Name: name, Value: item})
+			}
+			return v, nil
+		}
+	}
+	return nil, p.unexpected()
+}
+
+// ParseValue parses a GraphQL literal, eg. the default value in schema
+func ParseValue(literal string) (*Value, error) {
+	p, err := newParser(literal)
+	if err != nil {
+		return nil, err
+	}
+	v, err := p.parseValue(true)
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.unexpected()
+	}
+	return v, nil
+}
+
+// LooksLikeQuery is a cheap check before parsing
+func LooksLikeQuery(s string) bool {
+	s = strings.TrimSpace(s)
+	for strings.HasPrefix(s, "#") {
+		idx := strings.IndexByte(s, '\n')
+		if idx < 0 {
+			return false
+		}
+		s = strings.TrimSpace(s[idx+1:])
+	}
+	return strings.HasPrefix(s, "{") || strings.HasPrefix(s, "query") ||
+		strings.HasPrefix(s, "mutation") || strings.HasPrefix(s, "subscription") ||
+		strings.HasPrefix(s, "fragment")
+}
@@ -0,0 +1,124 @@
+package graphqlx
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// replaceJSONBody makes a POST application/json request from the template packet
+func replaceJSONBody(packet []byte, body []byte) []byte {
+	packet = lowhttp.ReplaceHTTPPacketMethod(packet, "POST")
+	packet = lowhttp.ReplaceHTTPPacketHeader(packet, "Content-Type", "application/json")
+	return lowhttp.ReplaceHTTPPacketBodyFast(packet, body)
+}
+
+func sendPacket(packet []byte, opts ...lowhttp.LowhttpOpt) ([]byte, error) {
+	opts = append(opts, lowhttp.WithPacketBytes(packet))
+	rsp, err := lowhttp.HTTP(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return lowhttp.GetHTTPPacketBody(lowhttp.DeletePacketEncoding(rsp.RawPacket)), nil
+}
+
+// Introspect pulls the schema by IntrospectionQuery, packet is the template request to the GraphQL endpoint
+func Introspect(packet []byte, opts ...lowhttp.LowhttpOpt) (*Schema, error) {
+	body, err := sendPacket(replaceJSONBody(packet, NewRequestBody(IntrospectionQuery, "IntrospectionQuery", nil)), opts...)
+	if err != nil {
+		return nil, utils.Wrap(err, "send introspection query failed")
+	}
+	return ParseIntrospection(body)
+}
+
+// BruteForceSupport is the result of DetectBruteForce
+type BruteForceSupport struct {
+	// Batching means an array of operations is executed in one http request
+	Batching bool
+	// Alias means a root field can be repeated with aliases in one operation
+	Alias bool
+
+	BatchRequest  []byte
+	BatchResponse []byte
+	AliasRequest  []byte
+	AliasResponse []byte
+}
+
+// DetectBruteForce checks whether the endpoint allows batching and alias, both let an attacker
+// bypass the rate limit of http requests, eg. brute forcing a login mutation or an OTP
+func DetectBruteForce(packet []byte, opts ...lowhttp.LowhttpOpt) (*BruteForceSupport, error) {
+	queries := ExtractQueriesFromPacket(packet)
+	if len(queries) == 0 {
+		return nil, utils.Error("no graphql query in request")
+	}
+	q := queries[0]
+	result := &BruteForceSupport{}
+
+	// batching: the same request twice in a json array
+	single := NewRequestBody(q.Query, q.OperationName, nil)
+	switch originBody := lowhttp.GetHTTPPacketBody(packet); {
+	case q.Prefix == "$":
+		single = bytes.TrimSpace(originBody)
+	case q.IsBatch():
+		single = []byte(gjson.GetBytes(originBody, strings.Trim(q.Prefix, "$[]")).Raw)
+	}
+	result.BatchRequest = replaceJSONBody(packet, []byte("["+string(single)+","+string(single)+"]"))
+	if body, err := sendPacket(result.BatchRequest, opts...); err == nil {
+		result.BatchResponse = body
+		items := gjson.ParseBytes(body)
+		if items.IsArray() && len(items.Array()) >= 2 {
+			result.Batching = true
+			for _, item := range items.Array()[:2] {
+				if !item.Get("data").Exists() && !item.Get("errors").Exists() {
+					result.Batching = false
+				}
+			}
+		}
+	}
+
+	// alias: the first root field of the operation twice with aliases
+	defIndex, rootIndex := -1, -1
+	for i, def := range q.Document.order {
+		op, ok := def.(*Operation)
+		if !ok || (q.OperationName != "" && op.Name != q.OperationName) {
+			continue
+		}
+		for j, sel := range op.SelectionSet {
+			if _, ok := sel.(*Field); ok {
+				defIndex, rootIndex = i, j
+				break
+			}
+		}
+		break
+	}
+	if defIndex < 0 {
+		return result, nil
+	}
+	aliased, err := repeatRootField(q.Query, defIndex, rootIndex, 2, nil)
+	if err != nil {
+		return result, nil
+	}
+	var variables any
+	if q.Variables != "" {
+		_ = json.Unmarshal([]byte(q.Variables), &variables)
+	}
+	aliasBody := map[string]any{"query": aliased}
+	if q.OperationName != "" {
+		aliasBody["operationName"] = q.OperationName
+	}
+	if variables != nil {
+		aliasBody["variables"] = variables
+	}
+	raw, _ := json.Marshal(aliasBody)
+	result.AliasRequest = replaceJSONBody(packet, raw)
+	if body, err := sendPacket(result.AliasRequest, opts...); err == nil {
+		result.AliasResponse = body
+		data := gjson.GetBytes(body, "data")
+		result.Alias = data.Get("alias0").Exists() && data.Get("alias1").Exists()
+	}
+	return result, nil
+}
@@ -0,0 +1,100 @@
+package graphqlx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+// Query is a GraphQL request found in http body
+type Query struct {
+	// Prefix is the json path of the request object, `$` for single request and `$[1]` in batch,
+	// it is empty when the body is application/graphql
+	Prefix        string
+	Query         string
+	OperationName string
+	// Variables is the raw json of variables
+	Variables string
+	Document  *Document
+}
+
+// JSONPath returns the json path of key in the request object, eg. `$[1].variables`
+func (q *Query) JSONPath(key string) string {
+	return q.Prefix + "." + key
+}
+
+// IsBatch checks the query is an element of batched request
+func (q *Query) IsBatch() bool {
+	return strings.HasPrefix(q.Prefix, "$[")
+}
+
+// ExtractQueries finds GraphQL requests in http body,
+// json object, batched json array and the raw query of application/graphql are supported
+func ExtractQueries(body []byte, contentType string) []*Query {
+	trimmed := strings.TrimSpace(string(body))
+	if trimmed == "" {
+		return nil
+	}
+	if strings.Contains(strings.ToLower(contentType), "application/graphql") && !gjson.Valid(trimmed) {
+		doc, err := ParseQuery(trimmed)
+		if err != nil {
+			return nil
+		}
+		return []*Query{{Query: trimmed, Document: doc}}
+	}
+	if !gjson.Valid(trimmed) {
+		return nil
+	}
+
+	parse := func(prefix string, obj gjson.Result) *Query {
+		q := obj.Get("query")
+		if q.Type != gjson.String || !LooksLikeQuery(q.String()) {
+			return nil
+		}
+		doc, err := ParseQuery(q.String())
+		if err != nil {
+			return nil
+		}
+		return &Query{
+			Prefix:        prefix,
+			Query:         q.String(),
+			OperationName: obj.Get("operationName").String(),
+			Variables:     obj.Get("variables").Raw,
+			Document:      doc,
+		}
+	}
+
+	result := gjson.Parse(trimmed)
+	switch {
+	case result.IsObject():
+		if q := parse("$", result); q != nil {
+			return []*Query{q}
+		}
+	case result.IsArray():
+		var queries []*Query
+		for i, item := range result.Array() {
+			if !item.IsObject() {
+				return nil
+			}
+			q := parse("$["+strconv.Itoa(i)+"]", item)
+			if q == nil {
+				return nil
+			}
+			queries = append(queries, q)
+		}
+		return queries
+	}
+	return nil
+}
+
+// ExtractQueriesFromPacket finds GraphQL requests in the body of http request packet
+func ExtractQueriesFromPacket(packet []byte) []*Query {
+	return ExtractQueries(lowhttp.GetHTTPPacketBody(packet), lowhttp.GetHTTPPacketHeader(packet, "Content-Type"))
+}
+
+// IsGraphQLRequest checks the http request carries GraphQL queries
+func IsGraphQLRequest(packet []byte) bool {
+	return len(ExtractQueriesFromPacket(packet)) > 0
+}
@@ -0,0 +1,362 @@
+package graphqlx
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	KindScalar      = "SCALAR"
+	KindObject      = "OBJECT"
+	KindInterface   = "INTERFACE"
+	KindUnion       = "UNION"
+	KindEnum        = "ENUM"
+	KindInputObject = "INPUT_OBJECT"
+	KindList        = "LIST"
+	KindNonNull     = "NON_NULL"
+)
+
+// Schema is the type system of GraphQL server, from introspection or SDL
+type Schema struct {
+	QueryType        string
+	MutationType     string
+	SubscriptionType string
+	Types            map[string]*Type
+}
+
+type Type struct {
+	Kind          string
+	Name          string
+	Description   string
+	Fields        []*FieldDefinition
+	InputFields   []*InputValue
+	Interfaces    []string
+	EnumValues    []string
+	PossibleTypes []string
+}
+
+type FieldDefinition struct {
+	Name        string
+	Description string
+	Args        []*InputValue
+	Type        *TypeRef
+}
+
+type InputValue struct {
+	Name         string
+	Type         *TypeRef
+	DefaultValue string
+}
+
+// TypeRef is the (wrapped) type of field and argument
+type TypeRef struct {
+	Kind   string
+	Name   string
+	OfType *TypeRef
+}
+
+// String prints the type in GraphQL syntax, eg. `[Int!]!`
+func (t *TypeRef) String() string {
+	if t == nil {
+		return ""
+	}
+	switch t.Kind {
+	case KindNonNull:
+		return t.OfType.String() + "!"
+	case KindList:
+		return "[" + t.OfType.String() + "]"
+	}
+	return t.Name
+}
+
+// NamedType unwraps LIST and NON_NULL
+func (t *TypeRef) NamedType() string {
+	for t != nil && (t.Kind == KindNonNull || t.Kind == KindList) {
+		t = t.OfType
+	}
+	if t == nil {
+		return ""
+	}
+	return t.Name
+}
+
+func (t *TypeRef) IsNonNull() bool {
+	return t != nil && t.Kind == KindNonNull
+}
+
+// ParseTypeRef parses the GraphQL syntax type, kind of named type is left empty
+func ParseTypeRef(s string) (*TypeRef, error) {
+	p, err := newParser(s)
+	if err != nil {
+		return nil, err
+	}
+	t, err := p.parseSchemaTypeRef()
+	if err != nil {
+		return nil, err
+	}
+	if p.peek().kind != tokenEOF {
+		return nil, p.unexpected()
+	}
+	return t, nil
+}
+
+func (p *parser) parseSchemaTypeRef() (*TypeRef, error) {
+	if err := p.enter(); err != nil {
+		return nil, err
+	}
+	defer p.leave()
+
+	var t *TypeRef
+	if p.skipPunct("[") {
+		inner, err := p.parseSchemaTypeRef()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct("]"); err != nil {
+			return nil, err
+		}
+		t = &TypeRef{Kind: KindList, OfType: inner}
+	} else {
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		t = &TypeRef{Name: name}
+	}
+	if p.skipPunct("!") {
+		t = &TypeRef{Kind: KindNonNull, OfType: t}
+	}
+	return t, nil
+}
+
+// Type finds type by name
+func (s *Schema) Type(name string) *Type {
+	if s == nil || s.Types == nil {
+		return nil
+	}
+	return s.Types[name]
+}
+
+// TypeNames returns the sorted names of user types, introspection types (`__*`) are excluded
+func (s *Schema) TypeNames() []string {
+	var names []string
+	for name := range s.Types {
+		if len(name) >= 2 && name[:2] == "__" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// resolveKinds fills the kind of named types, types from SDL only know the names
+func (s *Schema) resolveKinds() {
+	var fill func(t *TypeRef)
+	fill = func(t *TypeRef) {
+		for ; t != nil; t = t.OfType {
+			if t.Kind == "" {
+				if typ := s.Type(t.Name); typ != nil {
+					t.Kind = typ.Kind
+				} else {
+					t.Kind = KindScalar
+				}
+			}
+		}
+	}
+	for _, typ := range s.Types {
+		for _, f := range typ.Fields {
+			fill(f.Type)
+			for _, arg := range f.Args {
+				fill(arg.Type)
+			}
+		}
+		for _, f := range typ.InputFields {
+			fill(f.Type)
+		}
+	}
+}
+
+// IntrospectionQuery is the standard introspection query, deprecated fields are included
+const IntrospectionQuery = `query IntrospectionQuery {
+  __schema {
+    queryType { name }
+    mutationType { name }
+    subscriptionType { name }
+    types { ...FullType }
+  }
+}
+
+fragment FullType on __Type {
+  kind
+  name
+  description
+  fields(includeDeprecated: true) {
+    name
+    description
+    args { ...InputValue }
+    type { ...TypeRef }
+  }
+  inputFields { ...InputValue }
+  interfaces { ...TypeRef }
+  enumValues(includeDeprecated: true) { name }
+  possibleTypes { ...TypeRef }
+}
+
+fragment InputValue on __InputValue {
+  name
+  type { ...TypeRef }
+  defaultValue
+}
+
+fragment TypeRef on __Type {
+  kind
+  name
+  ofType {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+            ofType {
+              kind
+              name
+              ofType { kind name }
+            }
+          }
+        }
+      }
+    }
+  }
+}`
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   *string               `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+func (t *introspectionTypeRef) toTypeRef() *TypeRef {
+	if t == nil {
+		return nil
+	}
+	ref := &TypeRef{Kind: t.Kind, OfType: t.OfType.toTypeRef()}
+	if t.Name != nil {
+		ref.Name = *t.Name
+	}
+	return ref
+}
+
+type introspectionInputValue struct {
+	Name         string                `json:"name"`
+	Type         *introspectionTypeRef `json:"type"`
+	DefaultValue *string               `json:"defaultValue"`
+}
+
+func (v *introspectionInputValue) toInputValue() *InputValue {
+	iv := &InputValue{Name: v.Name, Type: v.Type.toTypeRef()}
+	if v.DefaultValue != nil {
+		iv.DefaultValue = *v.DefaultValue
+	}
+	return iv
+}
+
+type introspectionSchema struct {
+	QueryType        *struct{ Name string } `json:"queryType"`
+	MutationType     *struct{ Name string } `json:"mutationType"`
+	SubscriptionType *struct{ Name string } `json:"subscriptionType"`
+	Types            []struct {
+		Kind        string  `json:"kind"`
+		Name        string  `json:"name"`
+		Description *string `json:"description"`
+		Fields      []struct {
+			Name        string                     `json:"name"`
+			Description *string                    `json:"description"`
+			Args        []*introspectionInputValue `json:"args"`
+			Type        *introspectionTypeRef      `json:"type"`
+		} `json:"fields"`
+		InputFields   []*introspectionInputValue `json:"inputFields"`
+		Interfaces    []*introspectionTypeRef    `json:"interfaces"`
+		EnumValues    []struct{ Name string }    `json:"enumValues"`
+		PossibleTypes []*introspectionTypeRef    `json:"possibleTypes"`
+	} `json:"types"`
+}
+
+// ParseIntrospection parses the response of IntrospectionQuery,
+// both the full response `{"data": {"__schema": ...}}` and the `__schema` object are accepted
+func ParseIntrospection(raw []byte) (*Schema, error) {
+	var wrapper struct {
+		Data *struct {
+			Schema *introspectionSchema `json:"__schema"`
+		} `json:"data"`
+		Schema *introspectionSchema `json:"__schema"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(raw, &wrapper); err != nil {
+		return nil, utils.Wrap(err, "parse introspection result failed")
+	}
+	is := wrapper.Schema
+	if wrapper.Data != nil && wrapper.Data.Schema != nil {
+		is = wrapper.Data.Schema
+	}
+	if is == nil {
+		if len(wrapper.Errors) > 0 {
+			return nil, utils.Errorf("introspection is not allowed: %v", wrapper.Errors[0].Message)
+		}
+		return nil, utils.Error("no __schema in introspection result")
+	}
+
+	schema := &Schema{Types: make(map[string]*Type, len(is.Types))}
+	if is.QueryType != nil {
+		schema.QueryType = is.QueryType.Name
+	}
+	if is.MutationType != nil {
+		schema.MutationType = is.MutationType.Name
+	}
+	if is.SubscriptionType != nil {
+		schema.SubscriptionType = is.SubscriptionType.Name
+	}
+	for _, it := range is.Types {
+		typ := &Type{Kind: it.Kind, Name: it.Name}
+		if it.Description != nil {
+			typ.Description = *it.Description
+		}
+		for _, f := range it.Fields {
+			fd := &FieldDefinition{Name: f.Name, Type: f.Type.toTypeRef()}
+			if f.Description != nil {
+				fd.Description = *f.Description
+			}
+			for _, arg := range f.Args {
+				fd.Args = append(fd.Args, arg.toInputValue())
+			}
+			typ.Fields = append(typ.Fields, fd)
+		}
+		for _, f := range it.InputFields {
+			typ.InputFields = append(typ.InputFields, f.toInputValue())
+		}
+		for _, i := range it.Interfaces {
+			typ.Interfaces = append(typ.Interfaces, i.toTypeRef().NamedType())
+		}
+		for _, e := range it.EnumValues {
+			typ.EnumValues = append(typ.EnumValues, e.Name)
+		}
+		for _, pt := range it.PossibleTypes {
+			typ.PossibleTypes = append(typ.PossibleTypes, pt.toTypeRef().NamedType())
+		}
+		schema.Types[typ.Name] = typ
+	}
+	return schema, nil
+}
@@ -0,0 +1,311 @@
+package graphqlx
+
+import (
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+var builtinScalars = []string{"Int", "Float", "String", "Boolean", "ID"}
+
+// ParseSDL parses schema definition language (a local `schema.graphql`) into Schema,
+// `extend` definitions are merged and descriptions are kept
+func ParseSDL(source string) (*Schema, error) {
+	p, err := newParser(source)
+	if err != nil {
+		return nil, err
+	}
+	schema := &Schema{Types: make(map[string]*Type)}
+	for _, name := range builtinScalars {
+		schema.Types[name] = &Type{Kind: KindScalar, Name: name}
+	}
+
+	for p.peek().kind != tokenEOF {
+		description := p.parseDescription()
+		extend := false
+		if p.peekName("extend") {
+			p.advance()
+			extend = true
+		}
+		keyword, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		if keyword == "schema" {
+			if err := p.parseSchemaDefinition(schema); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if keyword == "directive" {
+			if err := p.skipDirectiveDefinition(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		kind, ok := map[string]string{
+			"scalar":    KindScalar,
+			"type":      KindObject,
+			"interface": KindInterface,
+			"union":     KindUnion,
+			"enum":      KindEnum,
+			"input":     KindInputObject,
+		}[keyword]
+		if !ok {
+			return nil, utils.Errorf("graphql sdl syntax error: unexpected %q", keyword)
+		}
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		typ := schema.Types[name]
+		if typ == nil || !extend {
+			if typ != nil && typ.Kind != KindScalar {
+				return nil, utils.Errorf("graphql sdl: type %v defined more than once", name)
+			}
+			typ = &Type{Kind: kind, Name: name, Description: description}
+			schema.Types[name] = typ
+		}
+
+		switch kind {
+		case KindObject, KindInterface:
+			if p.peekName("implements") {
+				p.advance()
+				p.skipPunct("&")
+				for {
+					iface, err := p.expectName()
+					if err != nil {
+						return nil, err
+					}
+					typ.Interfaces = append(typ.Interfaces, iface)
+					if !p.skipPunct("&") {
+						break
+					}
+				}
+			}
+			if _, err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			fields, err := p.parseFieldDefinitions()
+			if err != nil {
+				return nil, err
+			}
+			typ.Fields = append(typ.Fields, fields...)
+		case KindUnion:
+			if _, err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			if p.skipPunct("=") {
+				p.skipPunct("|")
+				for {
+					member, err := p.expectName()
+					if err != nil {
+						return nil, err
+					}
+					typ.PossibleTypes = append(typ.PossibleTypes, member)
+					if !p.skipPunct("|") {
+						break
+					}
+				}
+			}
+		case KindEnum:
+			if _, err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			if p.skipPunct("{") {
+				for !p.skipPunct("}") {
+					p.parseDescription()
+					value, err := p.expectName()
+					if err != nil {
+						return nil, err
+					}
+					if _, err := p.parseDirectives(); err != nil {
+						return nil, err
+					}
+					typ.EnumValues = append(typ.EnumValues, value)
+				}
+			}
+		case KindInputObject:
+			if _, err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+			if p.skipPunct("{") {
+				for !p.skipPunct("}") {
+					value, err := p.parseInputValueDefinition()
+					if err != nil {
+						return nil, err
+					}
+					typ.InputFields = append(typ.InputFields, value)
+				}
+			}
+		case KindScalar:
+			if _, err := p.parseDirectives(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if schema.QueryType == "" && schema.Type("Query") != nil {
+		schema.QueryType = "Query"
+	}
+	if schema.MutationType == "" && schema.Type("Mutation") != nil {
+		schema.MutationType = "Mutation"
+	}
+	if schema.SubscriptionType == "" && schema.Type("Subscription") != nil {
+		schema.SubscriptionType = "Subscription"
+	}
+	for _, typ := range schema.Types {
+		if typ.Kind != KindInterface {
+			continue
+		}
+		for _, other := range schema.Types {
+			for _, iface := range other.Interfaces {
+				if iface == typ.Name && other.Kind == KindObject {
+					typ.PossibleTypes = append(typ.PossibleTypes, other.Name)
+				}
+			}
+		}
+	}
+	schema.resolveKinds()
+	return schema, nil
+}
+
+func (p *parser) parseDescription() string {
+	t := p.peek()
+	if t.kind == tokenString || t.kind == tokenBlockString {
+		p.advance()
+		return t.text
+	}
+	return ""
+}
+
+func (p *parser) parseSchemaDefinition(schema *Schema) error {
+	if _, err := p.parseDirectives(); err != nil {
+		return err
+	}
+	if !p.skipPunct("{") {
+		return nil
+	}
+	for !p.skipPunct("}") {
+		operation, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return err
+		}
+		name, err := p.expectName()
+		if err != nil {
+			return err
+		}
+		switch operation {
+		case "query":
+			schema.QueryType = name
+		case "mutation":
+			schema.MutationType = name
+		case "subscription":
+			schema.SubscriptionType = name
+		}
+	}
+	return nil
+}
+
+// skipDirectiveDefinition skips `directive @name(args) repeatable on A | B`
+func (p *parser) skipDirectiveDefinition() error {
+	if err := p.expectPunct("@"); err != nil {
+		return err
+	}
+	if _, err := p.expectName(); err != nil {
+		return err
+	}
+	if _, err := p.parseArgumentDefinitions(); err != nil {
+		return err
+	}
+	if p.peekName("repeatable") {
+		p.advance()
+	}
+	if err := p.expectKeyword("on"); err != nil {
+		return err
+	}
+	p.skipPunct("|")
+	for {
+		if _, err := p.expectName(); err != nil {
+			return err
+		}
+		if !p.skipPunct("|") {
+			return nil
+		}
+	}
+}
+
+func (p *parser) parseFieldDefinitions() ([]*FieldDefinition, error) {
+	if !p.skipPunct("{") {
+		return nil, nil
+	}
+	var fields []*FieldDefinition
+	for !p.skipPunct("}") {
+		description := p.parseDescription()
+		name, err := p.expectName()
+		if err != nil {
+			return nil, err
+		}
+		field := &FieldDefinition{Name: name, Description: description}
+		field.Args, err = p.parseArgumentDefinitions()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expectPunct(":"); err != nil {
+			return nil, err
+		}
+		field.Type, err = p.parseSchemaTypeRef()
+		if err != nil {
+			return nil, err
+		}
+		if _, err := p.parseDirectives(); err != nil {
+			return nil, err
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+func (p *parser) parseArgumentDefinitions() ([]*InputValue, error) {
+	if !p.skipPunct("(") {
+		return nil, nil
+	}
+	var args []*InputValue
+	for !p.skipPunct(")") {
+		arg, err := p.parseInputValueDefinition()
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, arg)
+	}
+	return args, nil
+}
+
+func (p *parser) parseInputValueDefinition() (*InputValue, error) {
+	p.parseDescription()
+	name, err := p.expectName()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expectPunct(":"); err != nil {
+		return nil, err
+	}
+	value := &InputValue{Name: name}
+	value.Type, err = p.parseSchemaTypeRef()
+	if err != nil {
+		return nil, err
+	}
+	if p.skipPunct("=") {
+		def, err := p.parseValue(true)
+		if err != nil {
+			return nil, err
+		}
+		value.DefaultValue = def.String()
+	}
+	if _, err := p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	return value, nil
+}
@@ -0,0 +1,267 @@
+package grpcx
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	// well-known types imported by most .proto files are resolved from the global registry
+	_ "google.golang.org/protobuf/types/known/anypb"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/emptypb"
+	_ "google.golang.org/protobuf/types/known/fieldmaskpb"
+	_ "google.golang.org/protobuf/types/known/structpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	_ "google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// Registry keeps the descriptors uploaded by user (.proto source, descriptor set or server reflection),
+// messages of known gRPC methods are decoded with field names instead of numbers
+type Registry struct {
+	mu     sync.RWMutex
+	protos map[string]*descriptorpb.FileDescriptorProto
+	files  *protoregistry.Files
+}
+
+// DefaultRegistry is used by the HTTP history and fuzzer
+var DefaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{
+		protos: make(map[string]*descriptorpb.FileDescriptorProto),
+		files:  new(protoregistry.Files),
+	}
+}
+
+// RegisterDescriptorSet registers a serialized FileDescriptorSet, eg. the output of `protoc --descriptor_set_out`
+func (r *Registry) RegisterDescriptorSet(raw []byte) error {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(raw, &set); err != nil {
+		return utils.Wrap(err, "unmarshal FileDescriptorSet failed")
+	}
+	return r.RegisterFileDescriptorProto(set.GetFile()...)
+}
+
+// RegisterFileDescriptorBytes registers serialized FileDescriptorProto, as returned by gRPC server reflection
+func (r *Registry) RegisterFileDescriptorBytes(raws ...[]byte) error {
+	var fdps []*descriptorpb.FileDescriptorProto
+	for _, raw := range raws {
+		fdp := new(descriptorpb.FileDescriptorProto)
+		if err := proto.Unmarshal(raw, fdp); err != nil {
+			return utils.Wrap(err, "unmarshal FileDescriptorProto failed")
+		}
+		fdps = append(fdps, fdp)
+	}
+	return r.RegisterFileDescriptorProto(fdps...)
+}
+
+// RegisterProtoSource parses and registers a .proto file
+func (r *Registry) RegisterProtoSource(filename string, source string) error {
+	fdp, err := ParseProtoSource(filename, source)
+	if err != nil {
+		return err
+	}
+	return r.RegisterFileDescriptorProto(fdp)
+}
+
+// RegisterFileDescriptorProto registers files, a file with the same name is replaced
+func (r *Registry) RegisterFileDescriptorProto(fdps ...*descriptorpb.FileDescriptorProto) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	protos := make(map[string]*descriptorpb.FileDescriptorProto, len(r.protos)+len(fdps))
+	for name, fdp := range r.protos {
+		protos[name] = fdp
+	}
+	for _, fdp := range fdps {
+		if fdp.GetName() == "" {
+			return utils.Error("file descriptor without name")
+		}
+		protos[fdp.GetName()] = fdp
+	}
+	files, err := buildFiles(protos)
+	if err != nil {
+		return err
+	}
+	r.protos = protos
+	r.files = files
+	return nil
+}
+
+// buildFiles resolves files in dependency order, well-known types are taken from the global registry
+func buildFiles(protos map[string]*descriptorpb.FileDescriptorProto) (*protoregistry.Files, error) {
+	files := new(protoregistry.Files)
+	pending := make(map[string]*descriptorpb.FileDescriptorProto, len(protos))
+	for name, fdp := range protos {
+		pending[name] = fdp
+	}
+	resolver := &fallbackResolver{files: files}
+	for len(pending) > 0 {
+		progress := false
+		var lastErr error
+		for name, fdp := range pending {
+			ready := true
+			for _, dep := range fdp.GetDependency() {
+				if _, ok := pending[dep]; ok {
+					ready = false
+					break
+				}
+			}
+			if !ready {
+				continue
+			}
+			fd, err := protodesc.NewFile(fdp, resolver)
+			if err != nil {
+				lastErr = utils.Wrapf(err, "build descriptor of %v failed", name)
+				delete(pending, name)
+				continue
+			}
+			if err := files.RegisterFile(fd); err != nil {
+				return nil, utils.Wrapf(err, "register descriptor of %v failed", name)
+			}
+			delete(pending, name)
+			progress = true
+		}
+		if lastErr != nil {
+			return nil, lastErr
+		}
+		if !progress {
+			var names []string
+			for name := range pending {
+				names = append(names, name)
+			}
+			return nil, utils.Errorf("unresolvable (cyclic) proto imports: %v", strings.Join(names, ", "))
+		}
+	}
+	return files, nil
+}
+
+type fallbackResolver struct {
+	files *protoregistry.Files
+}
+
+func (f *fallbackResolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := f.files.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return protoregistry.GlobalFiles.FindFileByPath(path)
+}
+
+func (f *fallbackResolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if d, err := f.files.FindDescriptorByName(name); err == nil {
+		return d, nil
+	}
+	return protoregistry.GlobalFiles.FindDescriptorByName(name)
+}
+
+// FindMethod finds the method by gRPC path `/package.Service/Method`
+func (r *Registry) FindMethod(path string) (protoreflect.MethodDescriptor, bool) {
+	path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+	if idx := strings.IndexAny(path, "?#"); idx >= 0 {
+		path = path[:idx]
+	}
+	service, method, ok := strings.Cut(path, "/")
+	if !ok {
+		return nil, false
+	}
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, err := r.files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, false
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, false
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	return md, md != nil
+}
+
+// FindMessage finds the message by full name
+func (r *Registry) FindMessage(name string) (protoreflect.MessageDescriptor, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	d, err := r.files.FindDescriptorByName(protoreflect.FullName(strings.TrimPrefix(name, ".")))
+	if err != nil {
+		return nil, false
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	return md, ok
+}
+
+// Services lists the full names of registered services
+func (r *Registry) Services() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	var services []string
+	r.files.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		for i := 0; i < fd.Services().Len(); i++ {
+			services = append(services, string(fd.Services().Get(i).FullName()))
+		}
+		return true
+	})
+	return services
+}
+
+// DecodeMessageJSON decodes payload with descriptor into protojson
+func DecodeMessageJSON(md protoreflect.MessageDescriptor, payload []byte) (string, error) {
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(payload, msg); err != nil {
+		return "", err
+	}
+	raw, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: false}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+	return string(raw), nil
+}
+
+// EncodeMessageJSON is the reverse of DecodeMessageJSON
+// the field order of dynamicpb is random, Deterministic keeps fields in field-number order
+func EncodeMessageJSON(md protoreflect.MessageDescriptor, jsonText string) ([]byte, error) {
+	msg := dynamicpb.NewMessage(md)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal([]byte(jsonText), msg); err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+}
+
+// FieldPathName converts a numeric field path (`1.2[1]`) to names (`user.tags[1]`), unknown fields keep the number
+func FieldPathName(md protoreflect.MessageDescriptor, path string) string {
+	if md == nil {
+		return path
+	}
+	segments := strings.Split(path, ".")
+	names := make([]string, len(segments))
+	for i, seg := range segments {
+		num, idx, err := parsePathSegment(seg)
+		if err != nil || md == nil {
+			names[i] = seg
+			md = nil
+			continue
+		}
+		fd := md.Fields().ByNumber(num)
+		if fd == nil {
+			names[i] = seg
+			md = nil
+			continue
+		}
+		name := string(fd.Name())
+		if idx > 0 {
+			name += "[" + strconv.Itoa(idx) + "]"
+		}
+		names[i] = name
+		md = fd.Message()
+	}
+	return strings.Join(names, ".")
+}
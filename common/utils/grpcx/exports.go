@@ -0,0 +1,67 @@
+package grpcx
+
+import (
+	"context"
+	"time"
+)
+
+// RegisterProto 注册 .proto 文件，HTTP History 与 Web Fuzzer 中对应方法的 gRPC 消息会按字段名解码
+// Example:
+// ```
+// grpc.RegisterProto("user.proto", file.ReadFile("user.proto")~)~
+// ```
+func _registerProto(filename string, source []byte) error {
+	return DefaultRegistry.RegisterProtoSource(filename, string(source))
+}
+
+// RegisterDescriptorSet 注册 `protoc --descriptor_set_out` 生成的 FileDescriptorSet
+// Example:
+// ```
+// grpc.RegisterDescriptorSet(file.ReadFile("user.pb")~)~
+// ```
+func _registerDescriptorSet(raw []byte) error {
+	return DefaultRegistry.RegisterDescriptorSet(raw)
+}
+
+// RegisterReflection 通过 gRPC Server Reflection 获取并注册目标的全部服务描述
+// Example:
+// ```
+// grpc.RegisterReflection("127.0.0.1:50051", false)~
+// ```
+func _registerReflection(target string, useTLS bool) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	return DefaultRegistry.RegisterReflection(ctx, target, useTLS)
+}
+
+// Services 返回已注册的 gRPC 服务名
+// Example:
+// ```
+// grpc.Services() // ["demo.v1.UserService"]
+// ```
+func _services() []string {
+	return DefaultRegistry.Services()
+}
+
+// DecodeMessage 在没有 .proto 的情况下解码 protobuf 消息，返回以字段编号为键的 JSON
+// Example:
+// ```
+// grpc.DecodeMessage("\x0a\x05admin\x10\x2a") // {"1":"admin","2":42}
+// ```
+func _decodeMessage(raw []byte) (string, error) {
+	msg, err := ParseMessage(raw)
+	if err != nil {
+		return "", err
+	}
+	return msg.JSON(), nil
+}
+
+var Exports = map[string]interface{}{
+	"RegisterProto":         _registerProto,
+	"RegisterDescriptorSet": _registerDescriptorSet,
+	"RegisterReflection":    _registerReflection,
+	"Services":              _services,
+	"DecodeMessage":         _decodeMessage,
+	"DecodeRequest":         DecodeRequestMessages,
+	"DecodeResponse":        DecodeResponseMessages,
+}
@@ -0,0 +1,204 @@
+package grpcx
+
+import (
+	"bytes"
+	"compress/flate"
+	"compress/gzip"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// Kind is the gRPC wire format of a http body
+type Kind int
+
+const (
+	KindNone Kind = iota
+	// KindGRPC application/grpc(+proto) over h2
+	KindGRPC
+	// KindGRPCWeb application/grpc-web(+proto)
+	KindGRPCWeb
+	// KindGRPCWebText application/grpc-web-text(+proto), base64 of grpc-web
+	KindGRPCWebText
+)
+
+const (
+	frameHeaderLen = 5
+
+	flagCompressed = 0x01
+	// flagTrailer marks the trailer frame of grpc-web
+	flagTrailer = 0x80
+
+	maxFrameSize = 64 << 20
+)
+
+// KindFromContentType returns the gRPC kind by Content-Type, KindNone for non-gRPC body
+func KindFromContentType(contentType string) Kind {
+	ct := strings.ToLower(strings.TrimSpace(contentType))
+	if idx := strings.IndexByte(ct, ';'); idx >= 0 {
+		ct = strings.TrimSpace(ct[:idx])
+	}
+	switch {
+	case strings.HasPrefix(ct, "application/grpc-web-text"):
+		return KindGRPCWebText
+	case strings.HasPrefix(ct, "application/grpc-web"):
+		return KindGRPCWeb
+	case ct == "application/grpc" || strings.HasPrefix(ct, "application/grpc+"):
+		return KindGRPC
+	}
+	return KindNone
+}
+
+// IsGRPCContentType checks application/grpc and application/grpc-web(-text)
+func IsGRPCContentType(contentType string) bool {
+	return KindFromContentType(contentType) != KindNone
+}
+
+// Frame is a length-prefixed message of gRPC, Payload is always decompressed
+type Frame struct {
+	Compressed bool
+	// Trailer is the grpc-web trailer frame, Payload is the http header text
+	Trailer bool
+	Payload []byte
+}
+
+// ParseFrames parses the length-prefixed messages of body,
+// encoding is the grpc-encoding header and used for the compressed frames
+func ParseFrames(body []byte, kind Kind, encoding string) ([]*Frame, error) {
+	if kind == KindGRPCWebText {
+		decoded, err := decodeWebText(body)
+		if err != nil {
+			return nil, err
+		}
+		body = decoded
+	}
+
+	var frames []*Frame
+	for len(body) > 0 {
+		if len(body) < frameHeaderLen {
+			return frames, utils.Errorf("grpc frame header truncated: %d bytes left", len(body))
+		}
+		flag := body[0]
+		length := binary.BigEndian.Uint32(body[1:frameHeaderLen])
+		if length > maxFrameSize {
+			return frames, utils.Errorf("grpc frame too large: %d", length)
+		}
+		if uint32(len(body)-frameHeaderLen) < length {
+			return frames, utils.Errorf("grpc frame truncated: want %d bytes, got %d", length, len(body)-frameHeaderLen)
+		}
+		payload := body[frameHeaderLen : frameHeaderLen+int(length)]
+		body = body[frameHeaderLen+int(length):]
+
+		frame := &Frame{
+			Compressed: flag&flagCompressed != 0,
+			Trailer:    flag&flagTrailer != 0 && kind != KindGRPC,
+			Payload:    payload,
+		}
+		if frame.Compressed {
+			decompressed, err := decompress(payload, encoding)
+			if err != nil {
+				return frames, err
+			}
+			frame.Payload = decompressed
+		}
+		frames = append(frames, frame)
+	}
+	return frames, nil
+}
+
+// MarshalFrames is the reverse of ParseFrames, compressed frames are compressed again with encoding
+func MarshalFrames(frames []*Frame, kind Kind, encoding string) ([]byte, error) {
+	var buf bytes.Buffer
+	for _, frame := range frames {
+		payload := frame.Payload
+		var flag byte
+		if frame.Compressed {
+			compressed, err := compress(payload, encoding)
+			if err != nil {
+				return nil, err
+			}
+			payload = compressed
+			flag |= flagCompressed
+		}
+		if frame.Trailer {
+			flag |= flagTrailer
+		}
+		var header [frameHeaderLen]byte
+		header[0] = flag
+		binary.BigEndian.PutUint32(header[1:], uint32(len(payload)))
+		buf.Write(header[:])
+		buf.Write(payload)
+	}
+	if kind == KindGRPCWebText {
+		return []byte(base64.StdEncoding.EncodeToString(buf.Bytes())), nil
+	}
+	return buf.Bytes(), nil
+}
+
+// decodeWebText decodes grpc-web-text body, server may send several padded base64 chunks
+func decodeWebText(body []byte) ([]byte, error) {
+	text := strings.Join(strings.Fields(string(body)), "")
+	var result []byte
+	for len(text) > 0 {
+		end := len(text)
+		if idx := strings.Index(text, "="); idx >= 0 {
+			end = idx
+			for end < len(text) && text[end] == '=' {
+				end++
+			}
+		}
+		chunk := text[:end]
+		text = text[end:]
+		decoded, err := base64.StdEncoding.DecodeString(chunk)
+		if err != nil {
+			decoded, err = base64.RawStdEncoding.DecodeString(strings.TrimRight(chunk, "="))
+			if err != nil {
+				return nil, utils.Wrap(err, "decode grpc-web-text body failed")
+			}
+		}
+		result = append(result, decoded...)
+	}
+	return result, nil
+}
+
+func decompress(payload []byte, encoding string) ([]byte, error) {
+	var r io.Reader
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "gzip", "":
+		// compressed flag without grpc-encoding is gzip in practice
+		gr, err := gzip.NewReader(bytes.NewReader(payload))
+		if err != nil {
+			return nil, utils.Wrap(err, "grpc gzip decompress failed")
+		}
+		r = gr
+	case "deflate":
+		r = flate.NewReader(bytes.NewReader(payload))
+	case "identity":
+		return payload, nil
+	default:
+		return nil, utils.Errorf("unsupported grpc-encoding: %v", encoding)
+	}
+	return io.ReadAll(io.LimitReader(r, maxFrameSize))
+}
+
+func compress(payload []byte, encoding string) ([]byte, error) {
+	var buf bytes.Buffer
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "gzip", "":
+		w := gzip.NewWriter(&buf)
+		_, _ = w.Write(payload)
+		_ = w.Close()
+	case "deflate":
+		w, _ := flate.NewWriter(&buf, flate.DefaultCompression)
+		_, _ = w.Write(payload)
+		_ = w.Close()
+	case "identity":
+		return payload, nil
+	default:
+		return nil, utils.Errorf("unsupported grpc-encoding: %v", encoding)
+	}
+	return buf.Bytes(), nil
+}
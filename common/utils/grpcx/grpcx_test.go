@@ -0,0 +1,189 @@
+package grpcx
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const testProto = `
+syntax = "proto3";
+
+// comment
+package demo.v1;
+
+import "google/protobuf/empty.proto";
+
+option go_package = "example.com/demo;demo";
+
+message User {
+  string name = 1;
+  int64 id = 2 [json_name = "uid"];
+  repeated string tags = 3;
+  Profile profile = 4;
+  map<string, int32> scores = 5;
+  Role role = 6;
+  optional bool admin = 7;
+  oneof contact {
+    string email = 8;
+    string phone = 9;
+  }
+
+  message Profile {
+    string city = 1;
+  }
+  reserved 10 to 12;
+}
+
+enum Role {
+  ROLE_UNKNOWN = 0;
+  ROLE_ADMIN = 1;
+}
+
+service UserService {
+  rpc GetUser(User) returns (User);
+  rpc Watch(stream User) returns (stream google.protobuf.Empty) {
+    option deprecated = true;
+  }
+}
+`
+
+func testUserMessage() []byte {
+	var profile []byte
+	profile = protowire.AppendTag(profile, 1, protowire.BytesType)
+	profile = protowire.AppendString(profile, "beijing")
+
+	var b []byte
+	b = protowire.AppendTag(b, 1, protowire.BytesType)
+	b = protowire.AppendString(b, "admin")
+	b = protowire.AppendTag(b, 2, protowire.VarintType)
+	b = protowire.AppendVarint(b, 42)
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendString(b, "a")
+	b = protowire.AppendTag(b, 3, protowire.BytesType)
+	b = protowire.AppendString(b, "b")
+	b = protowire.AppendTag(b, 4, protowire.BytesType)
+	b = protowire.AppendBytes(b, profile)
+	return b
+}
+
+func TestFrames_RoundTrip(t *testing.T) {
+	for _, kind := range []Kind{KindGRPC, KindGRPCWeb, KindGRPCWebText} {
+		frames := []*Frame{
+			{Payload: testUserMessage()},
+			{Compressed: true, Payload: []byte("compressed")},
+		}
+		if kind != KindGRPC {
+			frames = append(frames, &Frame{Trailer: true, Payload: []byte("grpc-status: 0\r\n")})
+		}
+		body, err := MarshalFrames(frames, kind, "gzip")
+		require.NoError(t, err)
+		parsed, err := ParseFrames(body, kind, "gzip")
+		require.NoError(t, err)
+		require.Equal(t, frames, parsed)
+	}
+
+	require.Equal(t, KindGRPCWebText, KindFromContentType("application/grpc-web-text+proto"))
+	require.Equal(t, KindGRPCWeb, KindFromContentType("application/grpc-web; charset=utf-8"))
+	require.Equal(t, KindGRPC, KindFromContentType("application/grpc+proto"))
+	require.Equal(t, KindNone, KindFromContentType("application/json"))
+
+	_, err := ParseFrames([]byte{0, 0, 0, 0, 10, 1}, KindGRPC, "")
+	require.Error(t, err)
+}
+
+func TestRawMessage(t *testing.T) {
+	msg, err := ParseMessage(testUserMessage())
+	require.NoError(t, err)
+	require.Equal(t, testUserMessage(), msg.Marshal())
+	require.Equal(t, `{"1":"admin","2":42,"3":["a","b"],"4":{"1":"beijing"}}`, msg.JSON())
+
+	var paths []string
+	for _, leaf := range msg.Leaves() {
+		paths = append(paths, leaf.Path)
+	}
+	require.Equal(t, []string{"1", "2", "3", "3[1]", "4.1"}, paths)
+
+	require.NoError(t, msg.SetField("4.1", "shanghai"))
+	require.NoError(t, msg.SetField("3[1]", "c"))
+	require.NoError(t, msg.SetField("2", "not-a-number"))
+	require.Error(t, msg.SetField("5", "x"))
+
+	reparsed, err := ParseMessage(msg.Marshal())
+	require.NoError(t, err)
+	require.Equal(t, `{"1":"admin","2":"not-a-number","3":["a","c"],"4":{"1":"shanghai"}}`, reparsed.JSON())
+}
+
+func TestProtoSource_Decode(t *testing.T) {
+	r := NewRegistry()
+	require.NoError(t, r.RegisterProtoSource("demo.proto", testProto))
+	require.Equal(t, []string{"demo.v1.UserService"}, r.Services())
+
+	method, ok := r.FindMethod("/demo.v1.UserService/Watch")
+	require.True(t, ok)
+	require.True(t, method.IsStreamingClient())
+	require.Equal(t, "google.protobuf.Empty", string(method.Output().FullName()))
+
+	method, ok = r.FindMethod("/demo.v1.UserService/GetUser?x=1")
+	require.True(t, ok)
+	md := method.Input()
+	require.True(t, md.Fields().ByName("scores").IsMap())
+	require.True(t, md.Fields().ByName("admin").HasPresence())
+	require.Equal(t, "Role", string(md.Fields().ByName("role").Enum().Name()))
+
+	result, err := DecodeMessageJSON(md, testUserMessage())
+	require.NoError(t, err)
+	var decoded map[string]any
+	require.NoError(t, json.Unmarshal([]byte(result), &decoded))
+	require.Equal(t, "admin", decoded["name"])
+	require.Equal(t, "42", decoded["id"])
+	require.Equal(t, map[string]any{"city": "beijing"}, decoded["profile"])
+
+	encoded, err := EncodeMessageJSON(md, result)
+	require.NoError(t, err)
+	require.Equal(t, testUserMessage(), encoded)
+
+	require.Equal(t, "profile.city", FieldPathName(md, "4.1"))
+	require.Equal(t, "tags[1]", FieldPathName(md, "3[1]"))
+	require.Equal(t, "99.1", FieldPathName(md, "99.1"))
+
+	_, err = ParseProtoSource("bad.proto", "message A { string a = ; }")
+	require.Error(t, err)
+}
+
+func TestPacket_Params(t *testing.T) {
+	body, err := MarshalFrames([]*Frame{{Payload: testUserMessage()}}, KindGRPCWebText, "")
+	require.NoError(t, err)
+	request := lowhttp.ReplaceHTTPPacketBodyFast([]byte("POST /demo.v1.UserService/GetUser HTTP/1.1\r\n"+
+		"Host: www.example.com\r\nContent-Type: application/grpc-web-text\r\n\r\n"), body)
+
+	require.Equal(t, []string{`{"1":"admin","2":42,"3":["a","b"],"4":{"1":"beijing"}}`}, DecodeRequestMessages(request))
+
+	p, err := ParsePacket(request)
+	require.NoError(t, err)
+	params := p.Params(nil)
+	require.Len(t, params, 5)
+	require.Equal(t, "0/4.1", params[4].Path)
+	require.Equal(t, "beijing", params[4].Value)
+
+	require.NoError(t, p.SetParams(map[string]string{"0/1": "root", "0/4.1": "shanghai"}))
+	body, err = p.MarshalBody()
+	require.NoError(t, err)
+	p, err = ParsePacket(lowhttp.ReplaceHTTPPacketBodyFast(request, body))
+	require.NoError(t, err)
+	msg, err := ParseMessage(p.Messages()[0].Payload)
+	require.NoError(t, err)
+	require.Equal(t, `{"1":"root","2":42,"3":["a","b"],"4":{"1":"shanghai"}}`, msg.JSON())
+
+	origin := DefaultRegistry
+	DefaultRegistry = NewRegistry()
+	defer func() {
+		DefaultRegistry = origin
+	}()
+	require.NoError(t, DefaultRegistry.RegisterProtoSource("demo.proto", testProto))
+	require.Contains(t, DecodeRequestMessages(request)[0], `"name":"admin"`)
+	require.Equal(t, "profile.city", p.Params(MessageDescriptor(request, false))[4].Name)
+}
@@ -0,0 +1,165 @@
+package grpcx
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Packet is the gRPC body of a http request or response
+type Packet struct {
+	Kind     Kind
+	Encoding string
+	Frames   []*Frame
+}
+
+// ParsePacket parses the gRPC frames in http packet, Transfer-Encoding and Content-Encoding are removed first
+func ParsePacket(packet []byte) (*Packet, error) {
+	kind := KindFromContentType(lowhttp.GetHTTPPacketHeader(packet, "Content-Type"))
+	if kind == KindNone {
+		return nil, utils.Error("not a grpc packet")
+	}
+	encoding := lowhttp.GetHTTPPacketHeader(packet, "grpc-encoding")
+	body := lowhttp.GetHTTPPacketBody(lowhttp.DeletePacketEncoding(packet))
+	frames, err := ParseFrames(body, kind, encoding)
+	if err != nil && len(frames) == 0 {
+		return nil, err
+	}
+	return &Packet{Kind: kind, Encoding: encoding, Frames: frames}, nil
+}
+
+// MarshalBody encodes the frames into http body
+func (p *Packet) MarshalBody() ([]byte, error) {
+	return MarshalFrames(p.Frames, p.Kind, p.Encoding)
+}
+
+// Messages returns the message frames, grpc-web trailer is excluded
+func (p *Packet) Messages() []*Frame {
+	var messages []*Frame
+	for _, frame := range p.Frames {
+		if !frame.Trailer {
+			messages = append(messages, frame)
+		}
+	}
+	return messages
+}
+
+// MessageDescriptor finds the registered input (or output) message type by the request path
+func MessageDescriptor(request []byte, output bool) protoreflect.MessageDescriptor {
+	method, ok := DefaultRegistry.FindMethod(lowhttp.GetHTTPRequestPathWithoutQuery(request))
+	if !ok {
+		return nil
+	}
+	if output {
+		return method.Output()
+	}
+	return method.Input()
+}
+
+// DecodeRequestMessages decodes the messages of gRPC request into json,
+// messages of registered method are decoded with field names, others are decoded without schema
+func DecodeRequestMessages(request []byte) []string {
+	return decodeMessages(request, MessageDescriptor(request, false))
+}
+
+// DecodeResponseMessages decodes the messages of gRPC response into json, request is used to find the method
+func DecodeResponseMessages(request []byte, response []byte) []string {
+	return decodeMessages(response, MessageDescriptor(request, true))
+}
+
+func decodeMessages(packet []byte, md protoreflect.MessageDescriptor) []string {
+	p, err := ParsePacket(packet)
+	if err != nil {
+		return nil
+	}
+	var results []string
+	for _, frame := range p.Messages() {
+		if md != nil {
+			if result, err := DecodeMessageJSON(md, frame.Payload); err == nil {
+				results = append(results, result)
+				continue
+			}
+		}
+		msg, err := ParseMessage(frame.Payload)
+		if err != nil {
+			continue
+		}
+		results = append(results, msg.JSON())
+	}
+	return results
+}
+
+// Param is a fuzzable scalar field of gRPC messages
+type Param struct {
+	// Path is `<message index>/<field path>`, eg. `0/1.2`
+	Path string
+	// Name is the readable field path, field names are used if descriptor is known
+	Name  string
+	Value string
+}
+
+// Params lists scalar fields of all messages, md can be nil
+func (p *Packet) Params(md protoreflect.MessageDescriptor) []*Param {
+	messages := p.Messages()
+	var params []*Param
+	for i, frame := range messages {
+		msg, err := ParseMessage(frame.Payload)
+		if err != nil {
+			continue
+		}
+		for _, leaf := range msg.Leaves() {
+			name := FieldPathName(md, leaf.Path)
+			if len(messages) > 1 {
+				name = "[" + strconv.Itoa(i) + "]." + name
+			}
+			params = append(params, &Param{
+				Path:  strconv.Itoa(i) + "/" + leaf.Path,
+				Name:  name,
+				Value: leaf.Field.ValueString(),
+			})
+		}
+	}
+	return params
+}
+
+// SetParam sets the field by Param.Path and re-encodes the message
+func (p *Packet) SetParam(path string, value string) error {
+	return p.SetParams(map[string]string{path: value})
+}
+
+// SetParams sets several fields at once, each message is parsed only once so the paths keep stable
+func (p *Packet) SetParams(values map[string]string) error {
+	messages := p.Messages()
+	parsed := make(map[int]*Message)
+	for path, value := range values {
+		indexStr, fieldPath, ok := strings.Cut(path, "/")
+		if !ok {
+			indexStr, fieldPath = "0", path
+		}
+		index, err := strconv.Atoi(indexStr)
+		if err != nil {
+			return utils.Errorf("invalid grpc param path: %v", path)
+		}
+		if index < 0 || index >= len(messages) {
+			return utils.Errorf("grpc message %d not found", index)
+		}
+		msg, ok := parsed[index]
+		if !ok {
+			msg, err = ParseMessage(messages[index].Payload)
+			if err != nil {
+				return err
+			}
+			parsed[index] = msg
+		}
+		if err := msg.SetField(fieldPath, value); err != nil {
+			return err
+		}
+	}
+	for index, msg := range parsed {
+		messages[index].Payload = msg.Marshal()
+	}
+	return nil
+}
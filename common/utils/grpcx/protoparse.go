@@ -0,0 +1,632 @@
+package grpcx
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+)
+
+// ParseProtoSource parses the common subset of proto2/proto3 files into FileDescriptorProto:
+// package, import, message (nested, oneof, map), enum and service.
+// Options, extensions and reserved ranges are skipped since they are not needed for decoding.
+func ParseProtoSource(filename string, source string) (*descriptorpb.FileDescriptorProto, error) {
+	p := &protoParser{tokens: tokenizeProto(source)}
+	fdp := &descriptorpb.FileDescriptorProto{Name: proto.String(filename)}
+	if err := p.parseFile(fdp); err != nil {
+		return nil, utils.Wrapf(err, "parse %v failed", filename)
+	}
+	return fdp, nil
+}
+
+type protoToken struct {
+	text   string
+	quoted bool
+	line   int
+}
+
+func tokenizeProto(src string) []protoToken {
+	var tokens []protoToken
+	line := 1
+	for i := 0; i < len(src); {
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+		case c == ' ' || c == '\t' || c == '\r':
+			i++
+		case c == '/' && i+1 < len(src) && src[i+1] == '/':
+			for i < len(src) && src[i] != '\n' {
+				i++
+			}
+		case c == '/' && i+1 < len(src) && src[i+1] == '*':
+			end := strings.Index(src[i+2:], "*/")
+			if end < 0 {
+				end = len(src) - i - 2
+			}
+			line += strings.Count(src[i:i+2+end], "\n")
+			i += end + 4
+		case c == '"' || c == '\'':
+			j := i + 1
+			var sb strings.Builder
+			for j < len(src) && src[j] != c {
+				if src[j] == '\\' && j+1 < len(src) {
+					j++
+				}
+				sb.WriteByte(src[j])
+				j++
+			}
+			tokens = append(tokens, protoToken{text: sb.String(), quoted: true, line: line})
+			i = j + 1
+		case isProtoIdentChar(rune(c)):
+			j := i
+			for j < len(src) && (isProtoIdentChar(rune(src[j])) || src[j] == '.') {
+				j++
+			}
+			tokens = append(tokens, protoToken{text: src[i:j], line: line})
+			i = j
+		default:
+			tokens = append(tokens, protoToken{text: string(c), line: line})
+			i++
+		}
+	}
+	return tokens
+}
+
+func isProtoIdentChar(r rune) bool {
+	return r == '_' || r == '-' || r == '+' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+type protoParser struct {
+	tokens []protoToken
+	pos    int
+	proto3 bool
+}
+
+func (p *protoParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *protoParser) peek() string {
+	if p.eof() {
+		return ""
+	}
+	return p.tokens[p.pos].text
+}
+
+func (p *protoParser) next() (protoToken, error) {
+	if p.eof() {
+		return protoToken{}, utils.Error("unexpected end of file")
+	}
+	t := p.tokens[p.pos]
+	p.pos++
+	return t, nil
+}
+
+func (p *protoParser) expect(text string) error {
+	t, err := p.next()
+	if err != nil {
+		return err
+	}
+	if t.quoted || t.text != text {
+		return utils.Errorf("line %d: expect %q but got %q", t.line, text, t.text)
+	}
+	return nil
+}
+
+func (p *protoParser) ident() (string, error) {
+	t, err := p.next()
+	if err != nil {
+		return "", err
+	}
+	if t.quoted || t.text == "" || !isProtoIdentChar(rune(t.text[0])) {
+		return "", utils.Errorf("line %d: expect identifier but got %q", t.line, t.text)
+	}
+	return t.text, nil
+}
+
+// skipStatement skips until `;` or a balanced `{...}` block
+func (p *protoParser) skipStatement() error {
+	depth := 0
+	for !p.eof() {
+		t, _ := p.next()
+		if t.quoted {
+			continue
+		}
+		switch t.text {
+		case "{":
+			depth++
+		case "}":
+			depth--
+			if depth <= 0 {
+				if p.peek() == ";" {
+					p.pos++
+				}
+				return nil
+			}
+		case ";":
+			if depth == 0 {
+				return nil
+			}
+		}
+	}
+	if depth > 0 {
+		return utils.Error("unexpected end of file in block")
+	}
+	return nil
+}
+
+// skipFieldOptions skips `[ ... ]`
+func (p *protoParser) skipFieldOptions() {
+	if p.peek() != "[" {
+		return
+	}
+	depth := 0
+	for !p.eof() {
+		t, _ := p.next()
+		if t.quoted {
+			continue
+		}
+		if t.text == "[" {
+			depth++
+		} else if t.text == "]" {
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+func (p *protoParser) parseFile(fdp *descriptorpb.FileDescriptorProto) error {
+	for !p.eof() {
+		t, _ := p.next()
+		switch t.text {
+		case ";":
+		case "syntax", "edition":
+			if err := p.expect("="); err != nil {
+				return err
+			}
+			v, err := p.next()
+			if err != nil {
+				return err
+			}
+			p.proto3 = v.text == "proto3"
+			if p.proto3 {
+				fdp.Syntax = proto.String("proto3")
+			}
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "package":
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			fdp.Package = proto.String(name)
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "import":
+			if p.peek() == "public" || p.peek() == "weak" {
+				p.pos++
+			}
+			v, err := p.next()
+			if err != nil {
+				return err
+			}
+			fdp.Dependency = append(fdp.Dependency, v.text)
+			if err := p.expect(";"); err != nil {
+				return err
+			}
+		case "message":
+			msg, err := p.parseMessage()
+			if err != nil {
+				return err
+			}
+			fdp.MessageType = append(fdp.MessageType, msg)
+		case "enum":
+			enum, err := p.parseEnum()
+			if err != nil {
+				return err
+			}
+			fdp.EnumType = append(fdp.EnumType, enum)
+		case "service":
+			svc, err := p.parseService()
+			if err != nil {
+				return err
+			}
+			fdp.Service = append(fdp.Service, svc)
+		case "option", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			return utils.Errorf("line %d: unexpected %q", t.line, t.text)
+		}
+	}
+	return nil
+}
+
+func (p *protoParser) parseMessage() (*descriptorpb.DescriptorProto, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	msg := &descriptorpb.DescriptorProto{Name: proto.String(name)}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	if err := p.parseMessageBody(msg, nil); err != nil {
+		return nil, err
+	}
+	for _, field := range msg.Field {
+		if field.GetProto3Optional() {
+			field.OneofIndex = proto.Int32(int32(len(msg.OneofDecl)))
+			msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String("_" + field.GetName())})
+		}
+	}
+	return msg, nil
+}
+
+// parseMessageBody parses until `}`, oneofIndex is set for fields in oneof block
+func (p *protoParser) parseMessageBody(msg *descriptorpb.DescriptorProto, oneofIndex *int32) error {
+	for {
+		if p.eof() {
+			return utils.Errorf("message %v not closed", msg.GetName())
+		}
+		switch p.peek() {
+		case "}":
+			p.pos++
+			if p.peek() == ";" {
+				p.pos++
+			}
+			return nil
+		case ";":
+			p.pos++
+		case "message":
+			p.pos++
+			nested, err := p.parseMessage()
+			if err != nil {
+				return err
+			}
+			msg.NestedType = append(msg.NestedType, nested)
+		case "enum":
+			p.pos++
+			enum, err := p.parseEnum()
+			if err != nil {
+				return err
+			}
+			msg.EnumType = append(msg.EnumType, enum)
+		case "oneof":
+			p.pos++
+			name, err := p.ident()
+			if err != nil {
+				return err
+			}
+			if err := p.expect("{"); err != nil {
+				return err
+			}
+			idx := int32(len(msg.OneofDecl))
+			msg.OneofDecl = append(msg.OneofDecl, &descriptorpb.OneofDescriptorProto{Name: proto.String(name)})
+			if err := p.parseMessageBody(msg, &idx); err != nil {
+				return err
+			}
+		case "option", "reserved", "extensions", "extend":
+			if err := p.skipStatement(); err != nil {
+				return err
+			}
+		default:
+			if err := p.parseField(msg, oneofIndex); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+var protoScalarTypes = map[string]descriptorpb.FieldDescriptorProto_Type{
+	"double":   descriptorpb.FieldDescriptorProto_TYPE_DOUBLE,
+	"float":    descriptorpb.FieldDescriptorProto_TYPE_FLOAT,
+	"int64":    descriptorpb.FieldDescriptorProto_TYPE_INT64,
+	"uint64":   descriptorpb.FieldDescriptorProto_TYPE_UINT64,
+	"int32":    descriptorpb.FieldDescriptorProto_TYPE_INT32,
+	"fixed64":  descriptorpb.FieldDescriptorProto_TYPE_FIXED64,
+	"fixed32":  descriptorpb.FieldDescriptorProto_TYPE_FIXED32,
+	"bool":     descriptorpb.FieldDescriptorProto_TYPE_BOOL,
+	"string":   descriptorpb.FieldDescriptorProto_TYPE_STRING,
+	"bytes":    descriptorpb.FieldDescriptorProto_TYPE_BYTES,
+	"uint32":   descriptorpb.FieldDescriptorProto_TYPE_UINT32,
+	"sfixed32": descriptorpb.FieldDescriptorProto_TYPE_SFIXED32,
+	"sfixed64": descriptorpb.FieldDescriptorProto_TYPE_SFIXED64,
+	"sint32":   descriptorpb.FieldDescriptorProto_TYPE_SINT32,
+	"sint64":   descriptorpb.FieldDescriptorProto_TYPE_SINT64,
+}
+
+func (p *protoParser) parseField(msg *descriptorpb.DescriptorProto, oneofIndex *int32) error {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	proto3Optional := false
+	switch p.peek() {
+	case "repeated":
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+		p.pos++
+	case "optional":
+		proto3Optional = p.proto3
+		p.pos++
+	case "required":
+		label = descriptorpb.FieldDescriptorProto_LABEL_REQUIRED
+		p.pos++
+	}
+
+	typeName, err := p.ident()
+	if err != nil {
+		return err
+	}
+	field := &descriptorpb.FieldDescriptorProto{Label: label.Enum()}
+
+	if typeName == "map" {
+		entry, err := p.parseMapEntryType()
+		if err != nil {
+			return err
+		}
+		field.Label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum()
+		defer func() {
+			entry.Name = proto.String(mapEntryName(field.GetName()))
+			field.TypeName = proto.String(entry.GetName())
+			msg.NestedType = append(msg.NestedType, entry)
+		}()
+	} else if typeName == "group" {
+		return utils.Errorf("proto2 group field in %v is not supported", msg.GetName())
+	} else if scalar, ok := protoScalarTypes[typeName]; ok {
+		field.Type = scalar.Enum()
+	} else {
+		// message or enum, resolved later by protodesc
+		field.TypeName = proto.String(typeName)
+	}
+
+	name, err := p.ident()
+	if err != nil {
+		return err
+	}
+	field.Name = proto.String(name)
+	if err := p.expect("="); err != nil {
+		return err
+	}
+	numToken, err := p.next()
+	if err != nil {
+		return err
+	}
+	num, err := strconv.ParseInt(numToken.text, 0, 32)
+	if err != nil {
+		return utils.Errorf("line %d: invalid field number %q", numToken.line, numToken.text)
+	}
+	field.Number = proto.Int32(int32(num))
+	p.skipFieldOptions()
+	if err := p.expect(";"); err != nil {
+		return err
+	}
+
+	if oneofIndex != nil {
+		field.OneofIndex = proto.Int32(*oneofIndex)
+	} else if proto3Optional {
+		// the synthetic oneof is declared after the real ones in parseMessage
+		field.Proto3Optional = proto.Bool(true)
+	}
+	msg.Field = append(msg.Field, field)
+	return nil
+}
+
+// parseMapEntryType parses `<K, V>` into the synthetic map entry message
+func (p *protoParser) parseMapEntryType() (*descriptorpb.DescriptorProto, error) {
+	if err := p.expect("<"); err != nil {
+		return nil, err
+	}
+	keyType, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(","); err != nil {
+		return nil, err
+	}
+	valueType, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(">"); err != nil {
+		return nil, err
+	}
+	entry := &descriptorpb.DescriptorProto{
+		Options: &descriptorpb.MessageOptions{MapEntry: proto.Bool(true)},
+	}
+	for i, t := range []string{keyType, valueType} {
+		name := "key"
+		if i == 1 {
+			name = "value"
+		}
+		f := &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(int32(i + 1)),
+			Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+		}
+		if scalar, ok := protoScalarTypes[t]; ok {
+			f.Type = scalar.Enum()
+		} else {
+			f.TypeName = proto.String(t)
+		}
+		entry.Field = append(entry.Field, f)
+	}
+	return entry, nil
+}
+
+// mapEntryName follows protoc: foo_bar -> FooBarEntry
+func mapEntryName(field string) string {
+	var sb strings.Builder
+	upper := true
+	for _, r := range field {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			sb.WriteRune(unicode.ToUpper(r))
+			upper = false
+		} else {
+			sb.WriteRune(r)
+		}
+	}
+	sb.WriteString("Entry")
+	return sb.String()
+}
+
+func (p *protoParser) parseEnum() (*descriptorpb.EnumDescriptorProto, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	enum := &descriptorpb.EnumDescriptorProto{Name: proto.String(name)}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for {
+		if p.eof() {
+			return nil, utils.Errorf("enum %v not closed", name)
+		}
+		switch p.peek() {
+		case "}":
+			p.pos++
+			if p.peek() == ";" {
+				p.pos++
+			}
+			return enum, nil
+		case ";":
+			p.pos++
+		case "option", "reserved":
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		default:
+			valueName, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			numText := ""
+			if p.peek() == "-" {
+				p.pos++
+				numText = "-"
+			}
+			numToken, err := p.next()
+			if err != nil {
+				return nil, err
+			}
+			num, err := strconv.ParseInt(numText+numToken.text, 0, 32)
+			if err != nil {
+				return nil, utils.Errorf("line %d: invalid enum value %q", numToken.line, numToken.text)
+			}
+			p.skipFieldOptions()
+			if err := p.expect(";"); err != nil {
+				return nil, err
+			}
+			enum.Value = append(enum.Value, &descriptorpb.EnumValueDescriptorProto{
+				Name:   proto.String(valueName),
+				Number: proto.Int32(int32(num)),
+			})
+		}
+	}
+}
+
+func (p *protoParser) parseService() (*descriptorpb.ServiceDescriptorProto, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	svc := &descriptorpb.ServiceDescriptorProto{Name: proto.String(name)}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	for {
+		if p.eof() {
+			return nil, utils.Errorf("service %v not closed", name)
+		}
+		switch p.peek() {
+		case "}":
+			p.pos++
+			if p.peek() == ";" {
+				p.pos++
+			}
+			return svc, nil
+		case ";":
+			p.pos++
+		case "rpc":
+			p.pos++
+			method, err := p.parseRPC()
+			if err != nil {
+				return nil, err
+			}
+			svc.Method = append(svc.Method, method)
+		default:
+			if err := p.skipStatement(); err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+func (p *protoParser) parseRPC() (*descriptorpb.MethodDescriptorProto, error) {
+	name, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	method := &descriptorpb.MethodDescriptorProto{Name: proto.String(name)}
+	parseType := func() (string, bool, error) {
+		if err := p.expect("("); err != nil {
+			return "", false, err
+		}
+		stream := false
+		if p.peek() == "stream" && p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].text != ")" {
+			stream = true
+			p.pos++
+		}
+		typ, err := p.ident()
+		if err != nil {
+			return "", false, err
+		}
+		return typ, stream, p.expect(")")
+	}
+	input, clientStream, err := parseType()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("returns"); err != nil {
+		return nil, err
+	}
+	output, serverStream, err := parseType()
+	if err != nil {
+		return nil, err
+	}
+	method.InputType = proto.String(input)
+	method.OutputType = proto.String(output)
+	if clientStream {
+		method.ClientStreaming = proto.Bool(true)
+	}
+	if serverStream {
+		method.ServerStreaming = proto.Bool(true)
+	}
+	switch p.peek() {
+	case "{":
+		if err := p.skipStatement(); err != nil {
+			return nil, err
+		}
+	default:
+		if err := p.expect(";"); err != nil {
+			return nil, err
+		}
+	}
+	return method, nil
+}
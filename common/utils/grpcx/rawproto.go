@@ -0,0 +1,359 @@
+package grpcx
+
+import (
+	"bytes"
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+const maxNestedDepth = 64
+
+// Field is one field of a schema-less protobuf message
+type Field struct {
+	Number   protowire.Number
+	WireType protowire.Type
+	// Varint holds the value of varint, fixed32 and fixed64 fields
+	Varint uint64
+	// Bytes is the raw value of length-delimited field
+	Bytes []byte
+	// Message is set when Bytes (or the group) is a valid nested message
+	Message *Message
+}
+
+// Message is a protobuf message decoded without schema, fields keep the wire order
+type Message struct {
+	Fields []*Field
+}
+
+// ParseMessage decodes protobuf bytes without schema,
+// length-delimited field is a string if it is printable utf8, or nested message if it can be parsed
+func ParseMessage(b []byte) (*Message, error) {
+	return parseMessage(b, 0)
+}
+
+func parseMessage(b []byte, depth int) (*Message, error) {
+	if depth > maxNestedDepth {
+		return nil, utils.Error("protobuf message nested too deep")
+	}
+	m := &Message{}
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		f := &Field{Number: num, WireType: typ}
+		switch typ {
+		case protowire.VarintType:
+			f.Varint, n = protowire.ConsumeVarint(b)
+		case protowire.Fixed32Type:
+			var v uint32
+			v, n = protowire.ConsumeFixed32(b)
+			f.Varint = uint64(v)
+		case protowire.Fixed64Type:
+			f.Varint, n = protowire.ConsumeFixed64(b)
+		case protowire.BytesType:
+			f.Bytes, n = protowire.ConsumeBytes(b)
+			if n >= 0 && len(f.Bytes) > 0 && !isPrintable(f.Bytes) {
+				if nested, err := parseMessage(f.Bytes, depth+1); err == nil {
+					f.Message = nested
+				}
+			}
+		case protowire.StartGroupType:
+			var group []byte
+			group, n = protowire.ConsumeGroup(num, b)
+			if n >= 0 {
+				nested, err := parseMessage(group, depth+1)
+				if err != nil {
+					return nil, err
+				}
+				f.Message = nested
+			}
+		default:
+			return nil, utils.Errorf("invalid protobuf wire type %d of field %d", typ, num)
+		}
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		m.Fields = append(m.Fields, f)
+	}
+	return m, nil
+}
+
+func isPrintable(b []byte) bool {
+	if !utf8.Valid(b) {
+		return false
+	}
+	for _, r := range string(b) {
+		if r < 0x20 && r != '\t' && r != '\n' && r != '\r' {
+			return false
+		}
+	}
+	return true
+}
+
+// Marshal encodes the message, nested messages are encoded again so changes of leaves are kept
+func (m *Message) Marshal() []byte {
+	var b []byte
+	for _, f := range m.Fields {
+		b = protowire.AppendTag(b, f.Number, f.WireType)
+		switch f.WireType {
+		case protowire.VarintType:
+			b = protowire.AppendVarint(b, f.Varint)
+		case protowire.Fixed32Type:
+			b = protowire.AppendFixed32(b, uint32(f.Varint))
+		case protowire.Fixed64Type:
+			b = protowire.AppendFixed64(b, f.Varint)
+		case protowire.BytesType:
+			if f.Message != nil {
+				b = protowire.AppendBytes(b, f.Message.Marshal())
+			} else {
+				b = protowire.AppendBytes(b, f.Bytes)
+			}
+		case protowire.StartGroupType:
+			if f.Message != nil {
+				b = append(b, f.Message.Marshal()...)
+			}
+			b = protowire.AppendTag(b, f.Number, protowire.EndGroupType)
+		}
+	}
+	return b
+}
+
+// ValueString is the display value of a leaf field
+func (f *Field) ValueString() string {
+	switch f.WireType {
+	case protowire.VarintType:
+		return strconv.FormatUint(f.Varint, 10)
+	case protowire.Fixed32Type:
+		return strconv.FormatUint(f.Varint, 10)
+	case protowire.Fixed64Type:
+		return strconv.FormatUint(f.Varint, 10)
+	}
+	return string(f.Bytes)
+}
+
+// SetValue sets a leaf field from string, a non-numeric value for numeric field turns it into a string field
+func (f *Field) SetValue(value string) {
+	f.Message = nil
+	switch f.WireType {
+	case protowire.VarintType:
+		if v, ok := parseInteger(value); ok {
+			f.Varint = v
+			return
+		}
+	case protowire.Fixed32Type:
+		if v, ok := parseInteger(value); ok && v <= math.MaxUint32 {
+			f.Varint = v
+			return
+		}
+		if v, err := strconv.ParseFloat(value, 32); err == nil {
+			f.Varint = uint64(math.Float32bits(float32(v)))
+			return
+		}
+	case protowire.Fixed64Type:
+		if v, ok := parseInteger(value); ok {
+			f.Varint = v
+			return
+		}
+		if v, err := strconv.ParseFloat(value, 64); err == nil {
+			f.Varint = math.Float64bits(v)
+			return
+		}
+	}
+	f.WireType = protowire.BytesType
+	f.Varint = 0
+	f.Bytes = []byte(value)
+}
+
+func parseInteger(s string) (uint64, bool) {
+	s = strings.TrimSpace(s)
+	if v, err := strconv.ParseUint(s, 0, 64); err == nil {
+		return v, true
+	}
+	if v, err := strconv.ParseInt(s, 0, 64); err == nil {
+		return uint64(v), true
+	}
+	if b, err := strconv.ParseBool(s); err == nil {
+		if b {
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// Leaf is a scalar field of message with its path, path looks like `1.2.3` and `2[1]` for the second field 2
+type Leaf struct {
+	Path  string
+	Field *Field
+}
+
+// Leaves walks all scalar fields in wire order
+func (m *Message) Leaves() []*Leaf {
+	var leaves []*Leaf
+	m.walk("", func(path string, f *Field) {
+		leaves = append(leaves, &Leaf{Path: path, Field: f})
+	})
+	return leaves
+}
+
+func (m *Message) walk(prefix string, handle func(path string, f *Field)) {
+	counter := make(map[protowire.Number]int)
+	for _, f := range m.Fields {
+		idx := counter[f.Number]
+		counter[f.Number] = idx + 1
+		path := fieldPathSegment(f.Number, idx)
+		if prefix != "" {
+			path = prefix + "." + path
+		}
+		if f.Message != nil {
+			f.Message.walk(path, handle)
+			continue
+		}
+		handle(path, f)
+	}
+}
+
+func fieldPathSegment(num protowire.Number, idx int) string {
+	if idx == 0 {
+		return strconv.Itoa(int(num))
+	}
+	return strconv.Itoa(int(num)) + "[" + strconv.Itoa(idx) + "]"
+}
+
+// GetField finds the field by path
+func (m *Message) GetField(path string) (*Field, error) {
+	current := m
+	segments := strings.Split(strings.TrimSpace(path), ".")
+	for i, seg := range segments {
+		num, idx, err := parsePathSegment(seg)
+		if err != nil {
+			return nil, err
+		}
+		var found *Field
+		count := 0
+		for _, f := range current.Fields {
+			if f.Number != num {
+				continue
+			}
+			if count == idx {
+				found = f
+				break
+			}
+			count++
+		}
+		if found == nil {
+			return nil, utils.Errorf("protobuf field %v not found", path)
+		}
+		if i == len(segments)-1 {
+			return found, nil
+		}
+		if found.Message == nil {
+			return nil, utils.Errorf("protobuf field %v is not a message", strings.Join(segments[:i+1], "."))
+		}
+		current = found.Message
+	}
+	return nil, utils.Errorf("protobuf field %v not found", path)
+}
+
+// SetField sets the leaf value by path
+func (m *Message) SetField(path string, value string) error {
+	f, err := m.GetField(path)
+	if err != nil {
+		return err
+	}
+	f.SetValue(value)
+	return nil
+}
+
+func parsePathSegment(seg string) (protowire.Number, int, error) {
+	name, idxStr, hasIdx := strings.Cut(seg, "[")
+	num, err := strconv.Atoi(name)
+	if err != nil || num <= 0 {
+		return 0, 0, utils.Errorf("invalid protobuf field path segment: %v", seg)
+	}
+	idx := 0
+	if hasIdx {
+		idx, err = strconv.Atoi(strings.TrimSuffix(idxStr, "]"))
+		if err != nil || idx < 0 {
+			return 0, 0, utils.Errorf("invalid protobuf field path segment: %v", seg)
+		}
+	}
+	return protowire.Number(num), idx, nil
+}
+
+// Clone deep copies the message
+func (m *Message) Clone() *Message {
+	c := &Message{Fields: make([]*Field, len(m.Fields))}
+	for i, f := range m.Fields {
+		nf := *f
+		nf.Bytes = append([]byte(nil), f.Bytes...)
+		if f.Message != nil {
+			nf.Message = f.Message.Clone()
+		}
+		c.Fields[i] = &nf
+	}
+	return c
+}
+
+// JSON renders the message like `protoc --decode_raw` but in json, repeated fields become arrays
+func (m *Message) JSON() string {
+	var buf bytes.Buffer
+	m.writeJSON(&buf)
+	return buf.String()
+}
+
+func (m *Message) writeJSON(buf *bytes.Buffer) {
+	var order []protowire.Number
+	grouped := make(map[protowire.Number][]*Field)
+	for _, f := range m.Fields {
+		if _, ok := grouped[f.Number]; !ok {
+			order = append(order, f.Number)
+		}
+		grouped[f.Number] = append(grouped[f.Number], f)
+	}
+	buf.WriteByte('{')
+	for i, num := range order {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.WriteString(strconv.Quote(strconv.Itoa(int(num))))
+		buf.WriteByte(':')
+		fields := grouped[num]
+		if len(fields) > 1 {
+			buf.WriteByte('[')
+		}
+		for j, f := range fields {
+			if j > 0 {
+				buf.WriteByte(',')
+			}
+			f.writeJSON(buf)
+		}
+		if len(fields) > 1 {
+			buf.WriteByte(']')
+		}
+	}
+	buf.WriteByte('}')
+}
+
+func (f *Field) writeJSON(buf *bytes.Buffer) {
+	if f.Message != nil {
+		f.Message.writeJSON(buf)
+		return
+	}
+	switch f.WireType {
+	case protowire.BytesType:
+		raw, _ := json.Marshal(utils.EscapeInvalidUTF8Byte(f.Bytes))
+		buf.Write(raw)
+	default:
+		buf.WriteString(f.ValueString())
+	}
+}
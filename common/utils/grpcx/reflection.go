@@ -0,0 +1,85 @@
+package grpcx
+
+import (
+	"context"
+	"crypto/tls"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	rpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+)
+
+// FetchReflectionDescriptors fetches the file descriptors of all services by gRPC server reflection
+func FetchReflectionDescriptors(ctx context.Context, target string, useTLS bool) ([][]byte, error) {
+	creds := insecure.NewCredentials()
+	if useTLS {
+		creds = credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})
+	}
+	conn, err := grpc.DialContext(ctx, target, grpc.WithTransportCredentials(creds), grpc.WithBlock())
+	if err != nil {
+		return nil, utils.Wrapf(err, "dial grpc server %v failed", target)
+	}
+	defer conn.Close()
+
+	stream, err := rpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, utils.Wrap(err, "grpc server reflection is not available")
+	}
+	defer stream.CloseSend()
+
+	request := func(req *rpb.ServerReflectionRequest) (*rpb.ServerReflectionResponse, error) {
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+		rsp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if e := rsp.GetErrorResponse(); e != nil {
+			return nil, utils.Errorf("grpc reflection error(%d): %v", e.GetErrorCode(), e.GetErrorMessage())
+		}
+		return rsp, nil
+	}
+
+	rsp, err := request(&rpb.ServerReflectionRequest{
+		MessageRequest: &rpb.ServerReflectionRequest_ListServices{ListServices: "*"},
+	})
+	if err != nil {
+		return nil, utils.Wrap(err, "list grpc services failed")
+	}
+
+	var results [][]byte
+	seen := make(map[string]bool)
+	for _, svc := range rsp.GetListServicesResponse().GetService() {
+		if svc.GetName() == "grpc.reflection.v1alpha.ServerReflection" || svc.GetName() == "grpc.reflection.v1.ServerReflection" {
+			continue
+		}
+		rsp, err := request(&rpb.ServerReflectionRequest{
+			MessageRequest: &rpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: svc.GetName()},
+		})
+		if err != nil {
+			return nil, utils.Wrapf(err, "fetch descriptor of %v failed", svc.GetName())
+		}
+		// dependencies are returned together with the file
+		for _, raw := range rsp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			key := string(raw)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			results = append(results, raw)
+		}
+	}
+	return results, nil
+}
+
+// RegisterReflection fetches descriptors by server reflection and registers them
+func (r *Registry) RegisterReflection(ctx context.Context, target string, useTLS bool) error {
+	raws, err := FetchReflectionDescriptors(ctx, target, useTLS)
+	if err != nil {
+		return err
+	}
+	return r.RegisterFileDescriptorBytes(raws...)
+}
@@ -0,0 +1,153 @@
+package lowhttp
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// AltSvc is one alternative service of the Alt-Svc header (RFC 7838)
+type AltSvc struct {
+	ProtocolID string
+	Host       string
+	Port       int
+	MaxAge     time.Duration
+}
+
+const defaultAltSvcMaxAge = 24 * time.Hour
+
+// ParseAltSvc parses the value of Alt-Svc header, "clear" returns nil
+// eg. h3=":443"; ma=86400, h3-29="alt.example.com:8443"
+func ParseAltSvc(value string) []*AltSvc {
+	value = strings.TrimSpace(value)
+	if value == "" || value == "clear" {
+		return nil
+	}
+	var result []*AltSvc
+	for _, item := range splitAltSvcValue(value, ',') {
+		params := splitAltSvcValue(item, ';')
+		if len(params) == 0 {
+			continue
+		}
+		protocol, authority, ok := strings.Cut(params[0], "=")
+		if !ok {
+			continue
+		}
+		authority = strings.Trim(strings.TrimSpace(authority), `"`)
+		host, portStr, err := net.SplitHostPort(authority)
+		if err != nil {
+			continue
+		}
+		port, err := strconv.Atoi(portStr)
+		if err != nil || port <= 0 || port > 65535 {
+			continue
+		}
+		svc := &AltSvc{
+			ProtocolID: strings.TrimSpace(protocol),
+			Host:       host,
+			Port:       port,
+			MaxAge:     defaultAltSvcMaxAge,
+		}
+		for _, param := range params[1:] {
+			k, v, _ := strings.Cut(param, "=")
+			if strings.TrimSpace(strings.ToLower(k)) == "ma" {
+				if seconds, err := strconv.Atoi(strings.Trim(strings.TrimSpace(v), `"`)); err == nil {
+					svc.MaxAge = time.Duration(seconds) * time.Second
+				}
+			}
+		}
+		result = append(result, svc)
+	}
+	return result
+}
+
+// splitAltSvcValue splits by sep outside of quoted string
+func splitAltSvcValue(s string, sep byte) []string {
+	var result []string
+	var quoted bool
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '"':
+			quoted = !quoted
+		case sep:
+			if !quoted {
+				if item := strings.TrimSpace(s[start:i]); item != "" {
+					result = append(result, item)
+				}
+				start = i + 1
+			}
+		}
+	}
+	if item := strings.TrimSpace(s[start:]); item != "" {
+		result = append(result, item)
+	}
+	return result
+}
+
+type altSvcEntry struct {
+	addr    string
+	expires time.Time
+}
+
+// altSvcCache records the h3 endpoint announced by origin(host:port)
+var altSvcCache = struct {
+	sync.Mutex
+	entries map[string]*altSvcEntry
+}{entries: make(map[string]*altSvcEntry)}
+
+// RecordAltSvc records the h3 alternative service from the response of origin(host:port)
+func RecordAltSvc(origin string, responsePacket []byte) {
+	value := GetHTTPPacketHeader(responsePacket, "Alt-Svc")
+	if value == "" {
+		return
+	}
+	host, _, err := utils.ParseStringToHostPort(origin)
+	if err != nil {
+		return
+	}
+
+	altSvcCache.Lock()
+	defer altSvcCache.Unlock()
+	if strings.TrimSpace(value) == "clear" {
+		delete(altSvcCache.entries, origin)
+		return
+	}
+	for _, svc := range ParseAltSvc(value) {
+		if svc.ProtocolID != H3 {
+			continue
+		}
+		if svc.MaxAge <= 0 {
+			delete(altSvcCache.entries, origin)
+			return
+		}
+		altHost := svc.Host
+		if altHost == "" {
+			altHost = host
+		}
+		altSvcCache.entries[origin] = &altSvcEntry{
+			addr:    utils.HostPort(altHost, svc.Port),
+			expires: time.Now().Add(svc.MaxAge),
+		}
+		return
+	}
+}
+
+// LookupHTTP3AltSvc returns the h3 endpoint announced by origin(host:port) via Alt-Svc
+func LookupHTTP3AltSvc(origin string) (string, bool) {
+	altSvcCache.Lock()
+	defer altSvcCache.Unlock()
+	entry, ok := altSvcCache.entries[origin]
+	if !ok {
+		return "", false
+	}
+	if time.Now().After(entry.expires) {
+		delete(altSvcCache.entries, origin)
+		return "", false
+	}
+	return entry.addr, true
+}
@@ -13,6 +13,7 @@ import (
 	utls "github.com/refraction-networking/utls"
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/utils"
 )
 
@@ -29,6 +30,7 @@ type LowhttpExecConfig struct {
 	Https                            bool
 	ResponseCallback                 func(response *LowhttpResponse)
 	Http2                            bool
+	Http3                            bool
 	GmTLS                            bool
 	GmTLSOnly                        bool
 	GmTLSPrefer                      bool
@@ -44,10 +46,13 @@ type LowhttpExecConfig struct {
 	RetryMaxWaitTime                 time.Duration
 	JsRedirect                       bool
 	Proxy                            []string
+	ProxyRouter                      *netx.ProxyRouter
 	ForceLegacyProxy                 bool
 	NoFixContentLength               bool
 	RedirectHandler                  func(bool, []byte, []byte) bool
 	Session                          interface{}
+	SessionHandler                   *SessionHandler
+	sessionHandled                   bool
 	BeforeDoRequest                  func([]byte) []byte
 	Ctx                              context.Context
 	SaveHTTPFlow                     bool
@@ -99,6 +104,7 @@ type LowhttpResponse struct {
 	Proxy                  string
 	Https                  bool
 	Http2                  bool
+	Http3                  bool
 	RawRequest             []byte
 	Source                 string // 请求源
 	RuntimeId              string
@@ -437,6 +443,14 @@ func WithHttp2(Http2 bool) LowhttpOpt {
 	}
 }
 
+// WithHttp3 sends the request over QUIC, the h3 port announced by Alt-Svc is used if cached
+// QUIC cannot pass through proxies, the request falls back to http2/http1.1 when proxy is set
+func WithHttp3(Http3 bool) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.Http3 = Http3
+	}
+}
+
 func WithTimeout(timeout time.Duration) LowhttpOpt {
 	return func(o *LowhttpExecConfig) {
 		o.Timeout = timeout
@@ -521,6 +535,13 @@ func WithProxyGetter(getter func() []string) LowhttpOpt {
 	}
 }
 
+// WithProxyRouter 在没有指定代理时按路由表（规则 / PAC）为目标选择代理链
+func WithProxyRouter(r *netx.ProxyRouter) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.ProxyRouter = r
+	}
+}
+
 func WithForceLegacyProxy(b bool) LowhttpOpt {
 	return func(o *LowhttpExecConfig) {
 		o.ForceLegacyProxy = b
@@ -563,6 +584,20 @@ func WithSession(session interface{}) LowhttpOpt {
 	}
 }
 
+// WithSessionHandler 为请求设置会话处理规则（宏请求、登出检测与自动重新登录）
+func WithSessionHandler(h *SessionHandler) LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.SessionHandler = h
+	}
+}
+
+// withSessionHandled 标记请求已经经过会话处理，避免宏请求与重发请求再次进入会话处理
+func withSessionHandled() LowhttpOpt {
+	return func(o *LowhttpExecConfig) {
+		o.sessionHandled = true
+	}
+}
+
 func ConnPool(p *LowHttpConnPool) LowhttpOpt {
 	return func(o *LowhttpExecConfig) {
 		o.ConnPool = p
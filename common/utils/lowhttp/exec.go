@@ -54,6 +54,15 @@ func HTTP(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 	if option.WithConnPool && option.ConnPool == nil {
 		option.ConnPool = DefaultLowHttpConnPool
 	}
+	if !option.sessionHandled {
+		handler := option.SessionHandler
+		if handler == nil {
+			handler = GetSessionHandler(option.Session)
+		}
+		if handler != nil {
+			return handler.execute(option, opts)
+		}
+	}
 
 	var (
 		forceHttps         = option.Https
@@ -167,6 +176,7 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 	var (
 		https                = option.Https
 		forceHttp2           = option.Http2
+		forceHttp3           = option.Http3
 		gmTLS                = option.GmTLS
 		onlyGMTLS            = option.GmTLSOnly
 		preferGMTLS          = option.GmTLSPrefer
@@ -296,6 +306,15 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 		https = true
 	}
 
+	if !forceHttp3 {
+		_, _, proto := GetHTTPPacketFirstLine(requestPacket)
+		forceHttp3 = strings.HasPrefix(proto, "HTTP/3")
+	}
+	if forceHttp3 {
+		// HTTP/3 is always over TLS
+		https = true
+	}
+
 	/*
 	   extract url
 	*/
@@ -476,6 +495,86 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 		traceInfo.TotalTime = time.Since(totalTimeStart)
 	}()
 
+	// h3
+	if forceHttp3 && len(proxy) > 0 {
+		// QUIC 基于 udp 无法通过代理发送，降级为 HTTP/2，服务端不支持时再降级为 HTTP/1.1
+		log.Warnf("http3(quic) cannot be sent via proxy, fallback to http2/http1.1")
+		forceHttp3 = false
+		enableHttp2 = true
+		response.Http2 = true
+		if method, uri, proto := GetHTTPPacketFirstLine(requestPacket); strings.HasPrefix(proto, "HTTP/3") {
+			requestPacket = ReplaceHTTPPacketFirstLine(requestPacket, method+" "+uri+" HTTP/1.1")
+			response.RawRequest = requestPacket
+		}
+	}
+	if forceHttp3 {
+		response.Http2 = false
+		response.Http3 = true
+		response.Https = true
+		if reqIns != nil {
+			httpctx.SetRequestHTTPS(reqIns, true)
+		}
+
+		h3Addr := originAddr
+		if altAddr, ok := LookupHTTP3AltSvc(originAddr); ok {
+			h3Addr = altAddr
+		}
+		serverName := host
+		if sni != "" {
+			serverName = sni
+		}
+		dnsStart := time.Now()
+		dnsEnd := time.Now()
+		h3Opts := []netx.DialXOption{
+			netx.DialX_WithTimeout(connectTimeout),
+			netx.DialX_WithTLSConfig(&tls.Config{
+				ServerName:         serverName,
+				InsecureSkipVerify: !option.VerifyCertificate,
+			}),
+			netx.DialX_WithDNSOptions(
+				netx.WithDNSOnFinished(func() {
+					dnsEnd = time.Now()
+				}),
+				netx.WithDNSServers(dnsServers...),
+				netx.WithTemporaryHosts(dnsHosts),
+			),
+		}
+		if option.BeforeDoRequest != nil {
+			requestPacket = option.BeforeDoRequest(requestPacket)
+		}
+
+		var responsePacket []byte
+		for retry := 0; ; retry++ {
+			h3Conn, err := http3ConnPool.get(ctx, h3Addr, serverName, h3Opts...)
+			traceInfo.DNSTime = dnsEnd.Sub(dnsStart)
+			if err != nil {
+				return response, err
+			}
+			response.RemoteAddr = h3Conn.RemoteAddr().String()
+			response.PortIsOpen = true
+			if reqIns != nil {
+				httpctx.SetRemoteAddr(reqIns, response.RemoteAddr)
+			}
+			serverStart := time.Now()
+			_, responsePacket, err = sendHTTP3Request(h3Conn, requestPacket, true, timeout)
+			traceInfo.ServerTime = time.Since(serverStart)
+			if err == nil {
+				break
+			}
+			// the pooled connection may be closed by peer (idle timeout / GOAWAY), retry with a new one
+			if h3Conn.Context().Err() != nil && retry < maxRetryTimes+1 {
+				continue
+			}
+			return response, err
+		}
+		if reqIns != nil {
+			httpctx.SetBareResponseBytes(reqIns, responsePacket)
+		}
+		response.BareResponse = responsePacket
+		response.RawPacket = responsePacket
+		return response, nil
+	}
+
 	// h2
 	var nextProto []string
 	reqSchema := H1
@@ -535,6 +634,8 @@ func HTTPWithoutRedirect(opts ...LowhttpOpt) (*LowhttpResponse, error) {
 
 	if len(proxy) > 0 {
 		dialopts = append(dialopts, netx.DialX_WithProxy(proxy...))
+	} else if option.ProxyRouter != nil {
+		dialopts = append(dialopts, netx.DialX_WithProxyRouter(option.ProxyRouter))
 	}
 
 	// 初次连接需要的
@@ -632,6 +733,7 @@ RECONNECT:
 
 	if enableHttp2 && conn.(*persistConn).cacheKey.scheme != H2 {
 		enableHttp2 = false
+		response.Http2 = false
 		method, uri, _ := GetHTTPPacketFirstLine(requestPacket)
 		requestPacket = ReplaceHTTPPacketFirstLine(requestPacket, strings.Join([]string{method, uri, "HTTP/1.1"}, " "))
 	}
@@ -658,7 +760,7 @@ RECONNECT:
 			return nil, utils.Error("conn h2 Processor is nil")
 		}
 
-		h2Stream := h2Conn.newStream(reqIns, requestPacket)
+		h2Stream := h2Conn.newStream(reqIns, requestPacket, noFixContentLength)
 
 		if err := h2Stream.doRequest(); err != nil {
 			if h2Stream.ID == 1 { // first stream
@@ -677,6 +779,9 @@ RECONNECT:
 			}
 		}
 		httpctx.SetBareResponseBytes(reqIns, responsePacket)
+		if https {
+			RecordAltSvc(originAddr, responsePacket)
+		}
 		response.RawPacket = responsePacket
 		return response, nil
 	}
@@ -996,6 +1101,9 @@ STATUSCODERETRY:
 	}
 
 	response.BareResponse = rawBytes
+	if https {
+		RecordAltSvc(originAddr, rawBytes)
+	}
 	/*
 		FixHTTPResponse will be executed when:
 		1. SMUGGLE: noFixContentLength is false
@@ -65,6 +65,9 @@ type http2ClientStream struct {
 	req       *http.Request
 	reqPacket []byte
 
+	// noFixContentLength 为 true 时保留请求中的 content-length / transfer-encoding，用于 H2 降级走私
+	noFixContentLength bool
+
 	resp       *http.Response
 	bodyBuffer *bytes.Buffer
 	respPacket []byte
@@ -157,7 +160,7 @@ func (h2Conn *http2ClientConn) setPreface() {
 }
 
 // new stream
-func (h2Conn *http2ClientConn) newStream(req *http.Request, packet []byte) *http2ClientStream {
+func (h2Conn *http2ClientConn) newStream(req *http.Request, packet []byte, noFixContentLength bool) *http2ClientStream {
 	if h2Conn.readGoAway {
 		log.Error("h2 conn can not create new stream, because read go away flag")
 		return nil
@@ -177,6 +180,7 @@ func (h2Conn *http2ClientConn) newStream(req *http.Request, packet []byte) *http
 	cs.readEndStreamSignal = make(chan struct{}, 1)
 	cs.req = req
 	cs.reqPacket = packet
+	cs.noFixContentLength = noFixContentLength
 	cs.resp.Header = make(http.Header) // init header
 
 	h2Conn.mu.Lock()
@@ -301,8 +305,12 @@ func (cs *http2ClientStream) doRequest() error {
 					}
 				}
 
-			case "content-length", "connection", "proxy-connection", // todo cl问题是否处理
-				"transfer-encoding", "upgrade",
+			case "content-length", "transfer-encoding":
+				// H2 降级（H2.CL / H2.TE）走私需要原样发送这两个头
+				if cs.noFixContentLength {
+					addH2Header(key, value)
+				}
+			case "connection", "proxy-connection", "upgrade",
 				"keep-alive": // H2不应该存在的头
 			default:
 				addH2Header(key, value)
@@ -0,0 +1,237 @@
+package lowhttp
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/quicvarint"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+	"golang.org/x/net/http2/hpack"
+)
+
+const (
+	H3 = "h3"
+
+	http3FrameData        = 0x00
+	http3FrameHeaders     = 0x01
+	http3FrameCancelPush  = 0x03
+	http3FrameSettings    = 0x04
+	http3FramePushPromise = 0x05
+	http3FrameGoAway      = 0x07
+	http3FrameMaxPushID   = 0x0d
+
+	http3StreamControl      = 0x00
+	http3StreamPush         = 0x01
+	http3StreamQPACKEncoder = 0x02
+	http3StreamQPACKDecoder = 0x03
+
+	http3SettingQPACKMaxTableCapacity = 0x01
+	http3SettingMaxFieldSectionSize   = 0x06
+	http3SettingQPACKBlockedStreams   = 0x07
+
+	http3ErrNoError          = 0x100
+	http3ErrGeneralProtocol  = 0x101
+	http3ErrInternal         = 0x102
+	http3ErrFrameUnexpected  = 0x105
+	http3ErrRequestCancelled = 0x10c
+	http3ErrStreamCreation   = 0x103
+
+	http3MaxFrameSize = 16 << 20
+	// http3DataChunkSize is the max payload of one DATA frame we write
+	http3DataChunkSize = 16 << 10
+)
+
+func appendHTTP3Frame(b []byte, typ uint64, payload []byte) []byte {
+	b = quicvarint.Append(b, typ)
+	b = quicvarint.Append(b, uint64(len(payload)))
+	return append(b, payload...)
+}
+
+// readHTTP3Frame reads one frame, unknown frame types are returned to the caller to be ignored
+func readHTTP3Frame(r *bufio.Reader) (uint64, []byte, error) {
+	typ, err := quicvarint.Read(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	length, err := quicvarint.Read(r)
+	if err != nil {
+		return 0, nil, err
+	}
+	if length > http3MaxFrameSize {
+		return 0, nil, utils.Errorf("http3 frame too large: %d", length)
+	}
+	payload := make([]byte, length)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return 0, nil, err
+	}
+	return typ, payload, nil
+}
+
+// http3SettingsFrame is the control stream preface: stream type + SETTINGS without dynamic qpack table
+func http3ControlStreamPreface() []byte {
+	var settings []byte
+	settings = quicvarint.Append(settings, http3SettingQPACKMaxTableCapacity)
+	settings = quicvarint.Append(settings, 0)
+	settings = quicvarint.Append(settings, http3SettingQPACKBlockedStreams)
+	settings = quicvarint.Append(settings, 0)
+	b := quicvarint.Append(nil, http3StreamControl)
+	return appendHTTP3Frame(b, http3FrameSettings, settings)
+}
+
+// handleHTTP3UniStream consumes the unidirectional stream opened by peer
+// control and qpack streams are drained, push streams are refused since we never send MAX_PUSH_ID
+func handleHTTP3UniStream(s quic.ReceiveStream) {
+	r := bufio.NewReader(s)
+	typ, err := quicvarint.Read(r)
+	if err != nil {
+		return
+	}
+	switch typ {
+	case http3StreamControl:
+		for {
+			if _, _, err := readHTTP3Frame(r); err != nil {
+				return
+			}
+		}
+	case http3StreamQPACKEncoder, http3StreamQPACKDecoder:
+		_, _ = io.Copy(io.Discard, r)
+	default:
+		s.CancelRead(http3ErrStreamCreation)
+	}
+}
+
+// http3HeaderFieldsFromRequest converts the raw request packet into HTTP/3 header fields and body
+func http3HeaderFieldsFromRequest(packet []byte, https bool) ([]hpack.HeaderField, []byte) {
+	var fields []hpack.HeaderField
+	add := func(k, v string) {
+		fields = append(fields, hpack.HeaderField{Name: k, Value: v})
+	}
+	scheme := "https"
+	if !https {
+		scheme = "http"
+	}
+	add(":authority", "")
+	var chunked bool
+	_, body := SplitHTTPHeadersAndBodyFromPacketEx(packet, func(method string, requestUri string, proto string) error {
+		if method == "" {
+			method = http.MethodGet
+		}
+		add(":method", method)
+		if utils.AsciiEqualFold(method, "CONNECT") {
+			return nil
+		}
+		if utils.IsHttpOrHttpsUrl(requestUri) {
+			// absolute uri from proxy style request
+			if u := utils.ParseStringToUrl(requestUri); u != nil {
+				requestUri = u.RequestURI()
+				if fields[0].Value == "" {
+					fields[0].Value = u.Host
+				}
+			}
+		}
+		if requestUri == "" {
+			requestUri = "/"
+		}
+		add(":scheme", scheme)
+		add(":path", requestUri)
+		return nil
+	}, func(line string) {
+		key, value := SplitHTTPHeader(line)
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimLeft(value, " ")
+		switch key {
+		case "host":
+			fields[0].Value = value
+		case "transfer-encoding":
+			chunked = utils.IContains(value, "chunked")
+		case "connection", "proxy-connection", "upgrade", "keep-alive":
+			// connection specific header is not allowed in HTTP/3
+		default:
+			add(key, value)
+		}
+	})
+	if chunked {
+		// HTTP/3 has its own framing, chunked body is sent decoded
+		if decoded, err := codec.HTTPChunkedDecode(body); err == nil {
+			body = decoded
+		}
+	}
+	return fields, body
+}
+
+// http3HeadersToRequestPacket builds the HTTP/1.1 style request header block for a decoded HTTP/3 request
+func http3HeadersToRequestPacket(fields []hpack.HeaderField) ([]byte, error) {
+	var method, path, authority string
+	var buf bytes.Buffer
+	var headers bytes.Buffer
+	for _, f := range fields {
+		switch f.Name {
+		case ":method":
+			method = f.Value
+		case ":path":
+			path = f.Value
+		case ":authority":
+			authority = f.Value
+		case ":scheme", ":protocol":
+		default:
+			if strings.HasPrefix(f.Name, ":") {
+				continue
+			}
+			if f.Name == "host" && authority == "" {
+				authority = f.Value
+				continue
+			}
+			headers.WriteString(f.Name)
+			headers.WriteString(": ")
+			headers.WriteString(f.Value)
+			headers.WriteString(CRLF)
+		}
+	}
+	if method == "" {
+		return nil, utils.Error("http3 request without :method")
+	}
+	if path == "" {
+		if method != http.MethodConnect {
+			return nil, utils.Error("http3 request without :path")
+		}
+		path = authority
+	}
+	buf.WriteString(method + " " + path + " HTTP/3" + CRLF)
+	if authority != "" {
+		buf.WriteString("Host: " + authority + CRLF)
+	}
+	buf.Write(headers.Bytes())
+	buf.WriteString(CRLF)
+	return buf.Bytes(), nil
+}
+
+// http3ResponseFields converts the response header block into HTTP/3 header fields
+func http3ResponseFields(header []byte) []hpack.HeaderField {
+	var fields []hpack.HeaderField
+	SplitHTTPPacket(header, nil, func(proto string, code int, codeMsg string) error {
+		if code <= 0 {
+			code = 200
+		}
+		fields = append(fields, hpack.HeaderField{Name: ":status", Value: strconv.Itoa(code)})
+		return nil
+	}, func(line string) string {
+		key, value := SplitHTTPHeader(line)
+		key = strings.ToLower(strings.TrimSpace(key))
+		switch key {
+		case "connection", "proxy-connection", "transfer-encoding", "upgrade", "keep-alive", "":
+		default:
+			fields = append(fields, hpack.HeaderField{Name: key, Value: strings.TrimLeft(value, " ")})
+		}
+		return line
+	})
+	if len(fields) == 0 || fields[0].Name != ":status" {
+		fields = append([]hpack.HeaderField{{Name: ":status", Value: "200"}}, fields...)
+	}
+	return fields
+}
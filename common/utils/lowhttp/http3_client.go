@@ -0,0 +1,198 @@
+package lowhttp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// http3ConnPool keeps one QUIC connection per addr and sni, requests are multiplexed as streams
+var http3ConnPool = &http3ClientConnPool{conns: make(map[string]quic.Connection)}
+
+type http3ClientConnPool struct {
+	mu    sync.Mutex
+	conns map[string]quic.Connection
+}
+
+func (p *http3ClientConnPool) get(ctx context.Context, addr, sni string, opts ...netx.DialXOption) (quic.Connection, error) {
+	key := addr + "|" + sni
+	p.mu.Lock()
+	conn, ok := p.conns[key]
+	p.mu.Unlock()
+	if ok {
+		select {
+		case <-conn.Context().Done():
+		default:
+			return conn, nil
+		}
+	}
+
+	conn, err := netx.DialQUICX(ctx, addr, []string{H3}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err := startHTTP3Conn(ctx, conn); err != nil {
+		_ = conn.CloseWithError(http3ErrInternal, "")
+		return nil, err
+	}
+
+	p.mu.Lock()
+	if old, ok := p.conns[key]; ok && old != conn {
+		go func() { _ = old.CloseWithError(http3ErrNoError, "") }()
+	}
+	p.conns[key] = conn
+	p.mu.Unlock()
+	go func() {
+		<-conn.Context().Done()
+		p.mu.Lock()
+		if p.conns[key] == conn {
+			delete(p.conns, key)
+		}
+		p.mu.Unlock()
+	}()
+	return conn, nil
+}
+
+// startHTTP3Conn opens the control stream and drains the unidirectional streams opened by peer
+func startHTTP3Conn(ctx context.Context, conn quic.Connection) error {
+	control, err := conn.OpenUniStreamSync(ctx)
+	if err != nil {
+		return err
+	}
+	if _, err := control.Write(http3ControlStreamPreface()); err != nil {
+		return err
+	}
+	go func() {
+		for {
+			s, err := conn.AcceptUniStream(context.Background())
+			if err != nil {
+				return
+			}
+			go handleHTTP3UniStream(s)
+		}
+	}()
+	return nil
+}
+
+// sendHTTP3Request sends the request packet on a new stream and returns the response as HTTP/1.1 packet
+func sendHTTP3Request(conn quic.Connection, packet []byte, https bool, timeout time.Duration) (*http.Response, []byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	stream, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		return nil, nil, utils.Wrapf(err, "h3 open stream failed")
+	}
+	_ = stream.SetDeadline(time.Now().Add(timeout))
+	defer stream.CancelRead(http3ErrNoError)
+
+	fields, body := http3HeaderFieldsFromRequest(packet, https)
+	frames := appendHTTP3Frame(nil, http3FrameHeaders, qpackEncode(fields))
+	for len(body) > 0 {
+		n := len(body)
+		if n > http3DataChunkSize {
+			n = http3DataChunkSize
+		}
+		frames = appendHTTP3Frame(frames, http3FrameData, body[:n])
+		body = body[n:]
+	}
+	if _, err := stream.Write(frames); err != nil {
+		return nil, nil, utils.Wrapf(err, "h3 stream-id %v write request failed", stream.StreamID())
+	}
+	if err := stream.Close(); err != nil {
+		return nil, nil, err
+	}
+
+	rsp, err := readHTTP3Response(bufio.NewReader(stream))
+	if err != nil {
+		return nil, nil, utils.Wrapf(err, "h3 stream-id %v read response failed", stream.StreamID())
+	}
+	raw, err := utils.DumpHTTPResponse(rsp, true)
+	if err != nil {
+		return nil, nil, err
+	}
+	return rsp, raw, nil
+}
+
+func readHTTP3Response(r *bufio.Reader) (*http.Response, error) {
+	rsp := &http.Response{
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+	}
+	var body bytes.Buffer
+	var headerReceived bool
+	for {
+		typ, payload, err := readHTTP3Frame(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if headerReceived {
+				// keep the partial response like h1 does
+				log.Debugf("h3 read body failed: %v", err)
+				break
+			}
+			return nil, err
+		}
+		switch typ {
+		case http3FrameHeaders:
+			fields, err := qpackDecode(payload)
+			if err != nil {
+				return nil, err
+			}
+			if headerReceived {
+				// trailers
+				for _, f := range fields {
+					rsp.Header.Add(f.Name, f.Value)
+				}
+				continue
+			}
+			status := 0
+			header := make(http.Header)
+			for _, f := range fields {
+				if f.IsPseudo() {
+					if f.Name == ":status" {
+						status, _ = strconv.Atoi(f.Value)
+					}
+					continue
+				}
+				header.Add(f.Name, f.Value)
+			}
+			if status >= 100 && status < 200 {
+				// informational response
+				continue
+			}
+			rsp.StatusCode = status
+			rsp.Status = strconv.Itoa(status) + " " + http.StatusText(status)
+			rsp.Header = header
+			headerReceived = true
+		case http3FrameData:
+			if !headerReceived {
+				return nil, utils.Error("h3 DATA frame before HEADERS")
+			}
+			body.Write(payload)
+		case http3FrameSettings, http3FrameGoAway, http3FrameMaxPushID, http3FrameCancelPush:
+			return nil, utils.Errorf("h3 frame type %#x is not allowed on request stream", typ)
+		default:
+			// unknown and reserved frame types are ignored
+		}
+	}
+	if !headerReceived {
+		return nil, utils.Error("h3 stream closed without response headers")
+	}
+	rsp.ContentLength = int64(body.Len())
+	rsp.Body = io.NopCloser(&body)
+	return rsp, nil
+}
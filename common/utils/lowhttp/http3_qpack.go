@@ -0,0 +1,33 @@
+package lowhttp
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/quic-go/qpack"
+	"golang.org/x/net/http2/hpack"
+)
+
+// qpackEncode encodes a field section without dynamic table references
+// we advertise QPACK_MAX_TABLE_CAPACITY = 0 so peer only sends static references and literals too
+func qpackEncode(fields []hpack.HeaderField) []byte {
+	var buf bytes.Buffer
+	encoder := qpack.NewEncoder(&buf)
+	for _, f := range fields {
+		_ = encoder.WriteField(qpack.HeaderField{Name: f.Name, Value: f.Value})
+	}
+	return buf.Bytes()
+}
+
+// qpackDecode decodes a field section, dynamic table references are rejected
+func qpackDecode(block []byte) ([]hpack.HeaderField, error) {
+	decoded, err := qpack.NewDecoder(nil).DecodeFull(block)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]hpack.HeaderField, 0, len(decoded))
+	for _, f := range decoded {
+		fields = append(fields, hpack.HeaderField{Name: strings.ToLower(f.Name), Value: f.Value})
+	}
+	return fields, nil
+}
@@ -0,0 +1,138 @@
+package lowhttp
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http/httputil"
+
+	"github.com/quic-go/quic-go"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// ServeHTTP3 accepts QUIC connections from l and serves HTTP/3 on them until ctx is done or l is closed
+// handler is the same as ServeHTTP2Connection: the request header is in HTTP/1.1 style with `HTTP/3` proto
+func ServeHTTP3(ctx context.Context, l *quic.Listener, handler func(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error)) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	for {
+		conn, err := l.Accept(ctx)
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := ServeHTTP3Connection(ctx, conn, handler); err != nil {
+				log.Debugf("serve h3 connection %v failed: %v", conn.RemoteAddr(), err)
+			}
+		}()
+	}
+}
+
+// ServeHTTP3Connection serves the request streams of one QUIC connection
+func ServeHTTP3Connection(ctx context.Context, conn quic.Connection, handler func(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error)) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	defer conn.CloseWithError(http3ErrNoError, "")
+
+	if err := startHTTP3Conn(ctx, conn); err != nil {
+		return err
+	}
+	for {
+		stream, err := conn.AcceptStream(ctx)
+		if err != nil {
+			return err
+		}
+		go func() {
+			if err := serveHTTP3Stream(stream, handler); err != nil {
+				log.Debugf("h3 stream-id %v serve failed: %v", stream.StreamID(), err)
+				stream.CancelWrite(http3ErrInternal)
+				stream.CancelRead(http3ErrRequestCancelled)
+			}
+		}()
+	}
+}
+
+func serveHTTP3Stream(stream quic.Stream, handler func(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error)) error {
+	r := bufio.NewReader(stream)
+	var header []byte
+	for header == nil {
+		typ, payload, err := readHTTP3Frame(r)
+		if err != nil {
+			return err
+		}
+		switch typ {
+		case http3FrameHeaders:
+			fields, err := qpackDecode(payload)
+			if err != nil {
+				return err
+			}
+			header, err = http3HeadersToRequestPacket(fields)
+			if err != nil {
+				return err
+			}
+		case http3FrameData:
+			return utils.Error("h3 DATA frame before HEADERS")
+		}
+	}
+
+	// request body is streamed to handler
+	bodyReader, bodyWriter := io.Pipe()
+	go func() {
+		for {
+			typ, payload, err := readHTTP3Frame(r)
+			if err == io.EOF {
+				_ = bodyWriter.Close()
+				return
+			}
+			if err != nil {
+				_ = bodyWriter.CloseWithError(err)
+				return
+			}
+			if typ != http3FrameData {
+				// trailers and unknown frames
+				continue
+			}
+			if _, err := bodyWriter.Write(payload); err != nil {
+				return
+			}
+		}
+	}()
+
+	rspHeader, rspBody, err := handler(header, bodyReader)
+	_ = bodyReader.Close()
+	if err != nil {
+		return err
+	}
+	if rspBody != nil {
+		defer rspBody.Close()
+	}
+
+	var body io.Reader = rspBody
+	if body != nil && utils.IContains(GetHTTPPacketHeader(rspHeader, "Transfer-Encoding"), "chunked") {
+		body = httputil.NewChunkedReader(body)
+	}
+	if _, err := stream.Write(appendHTTP3Frame(nil, http3FrameHeaders, qpackEncode(http3ResponseFields(rspHeader)))); err != nil {
+		return err
+	}
+	if body != nil {
+		buf := make([]byte, http3DataChunkSize)
+		for {
+			n, err := body.Read(buf)
+			if n > 0 {
+				if _, werr := stream.Write(appendHTTP3Frame(nil, http3FrameData, buf[:n])); werr != nil {
+					return werr
+				}
+			}
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return stream.Close()
+}
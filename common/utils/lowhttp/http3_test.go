@@ -0,0 +1,155 @@
+package lowhttp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+	"golang.org/x/net/http2/hpack"
+)
+
+func TestQPACK_RoundTrip(t *testing.T) {
+	fields := []hpack.HeaderField{
+		{Name: ":method", Value: "GET"},
+		{Name: ":scheme", Value: "https"},
+		{Name: ":authority", Value: "www.example.com"},
+		{Name: ":path", Value: "/index.php?a=1"},
+		{Name: "user-agent", Value: "yaklang"},
+		{Name: "x-custom-header", Value: strings.Repeat("abc", 100)},
+		{Name: "accept", Value: "*/*"},
+	}
+	decoded, err := qpackDecode(qpackEncode(fields))
+	require.NoError(t, err)
+	require.Equal(t, fields, decoded)
+
+	// dynamic table reference is rejected
+	_, err = qpackDecode([]byte{0x01, 0x00, 0x80})
+	require.Error(t, err)
+}
+
+func TestParseAltSvc(t *testing.T) {
+	svcs := ParseAltSvc(`h3=":443"; ma=86400, h3-29="alt.example.com:8443", h2=":443"`)
+	require.Len(t, svcs, 3)
+	require.Equal(t, "h3", svcs[0].ProtocolID)
+	require.Equal(t, "", svcs[0].Host)
+	require.Equal(t, 443, svcs[0].Port)
+	require.Equal(t, 86400*time.Second, svcs[0].MaxAge)
+	require.Equal(t, "alt.example.com", svcs[1].Host)
+	require.Equal(t, 8443, svcs[1].Port)
+	require.Nil(t, ParseAltSvc("clear"))
+
+	RecordAltSvc("www.example.com:443", []byte("HTTP/1.1 200 OK\r\nAlt-Svc: h3=\":8443\"; ma=60\r\n\r\n"))
+	addr, ok := LookupHTTP3AltSvc("www.example.com:443")
+	require.True(t, ok)
+	require.Equal(t, "www.example.com:8443", addr)
+	RecordAltSvc("www.example.com:443", []byte("HTTP/1.1 200 OK\r\nAlt-Svc: clear\r\n\r\n"))
+	_, ok = LookupHTTP3AltSvc("www.example.com:443")
+	require.False(t, ok)
+}
+
+func TestHTTP3_Serve(t *testing.T) {
+	ca, key, err := tlsutils.GenerateSelfSignedCertKey("127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(ca, key)
+	require.NoError(t, err)
+
+	port := utils.GetRandomAvailableUDPPort()
+	l, err := quic.ListenAddr(utils.HostPort("127.0.0.1", port), &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{H3},
+	}, nil)
+	require.NoError(t, err)
+	defer l.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	token1, token2 := utils.RandStringBytes(20), utils.RandStringBytes(40000)
+	requests := make(chan [2][]byte, 2)
+	go ServeHTTP3(ctx, l, func(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+		requestBody, _ := io.ReadAll(body)
+		requests <- [2][]byte{header, requestBody}
+		return []byte("HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\nTransfer-Encoding: chunked\r\n\r\n"),
+			io.NopCloser(bytes.NewBufferString("7\r\nchunked\r\n0\r\n\r\n" + token2)), nil
+	})
+
+	rsp, err := HTTPWithoutRedirect(WithHttp3(true), WithPacketBytes([]byte("POST /"+token1+" HTTP/1.1\r\nHost: www.example.com\r\nContent-Length: 3\r\n\r\nabc")),
+		WithHost("127.0.0.1"), WithPort(port), WithTimeout(10*time.Second))
+	require.NoError(t, err)
+	require.True(t, rsp.Http3)
+	request := <-requests
+	require.Contains(t, string(request[0]), "POST /"+token1+" HTTP/3")
+	require.Contains(t, string(request[0]), "Host: www.example.com")
+	require.Equal(t, "abc", string(request[1]))
+	require.Equal(t, 200, GetStatusCodeFromResponse(rsp.RawPacket))
+	_, body := SplitHTTPHeadersAndBodyFromPacket(rsp.RawPacket)
+	require.Equal(t, "chunked", string(body))
+
+	// the pooled connection is reused
+	rsp, err = HTTPWithoutRedirect(WithPacketBytes([]byte("GET /second HTTP/3\r\nHost: www.example.com\r\n\r\n")),
+		WithHost("127.0.0.1"), WithPort(port), WithTimeout(10*time.Second))
+	require.NoError(t, err)
+	require.True(t, rsp.Http3)
+	request = <-requests
+	require.Contains(t, string(request[0]), "GET /second HTTP/3")
+}
+
+func TestHTTP3_ProxyFallback(t *testing.T) {
+	var request []byte
+	host, port := utils.DebugMockHTTPSEx(func(req []byte) []byte {
+		request = req
+		return []byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok")
+	})
+
+	// a minimal CONNECT proxy, udp can never pass through it
+	var connected int64
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer lis.Close()
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				reader := bufio.NewReader(conn)
+				req, err := http.ReadRequest(reader)
+				if err != nil || req.Method != http.MethodConnect {
+					return
+				}
+				upstream, err := net.Dial("tcp", req.Host)
+				if err != nil {
+					return
+				}
+				defer upstream.Close()
+				atomic.AddInt64(&connected, 1)
+				conn.Write([]byte("HTTP/1.1 200 Connection established\r\n\r\n"))
+				go io.Copy(upstream, reader)
+				io.Copy(conn, upstream)
+			}()
+		}
+	}()
+
+	rsp, err := HTTPWithoutRedirect(WithHttp3(true), WithProxy("http://"+lis.Addr().String()),
+		WithPacketBytes([]byte("GET /fallback HTTP/3\r\nHost: www.example.com\r\n\r\n")),
+		WithHost(host), WithPort(port), WithTimeout(10*time.Second))
+	require.NoError(t, err)
+	require.False(t, rsp.Http3)
+	require.Positive(t, atomic.LoadInt64(&connected))
+	require.Equal(t, "ok", string(GetHTTPPacketBody(rsp.RawPacket)))
+	require.Contains(t, string(request), "GET /fallback HTTP/1.1")
+}
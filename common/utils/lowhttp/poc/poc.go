@@ -37,6 +37,7 @@ type PocConfig struct {
 	Port                 int
 	ForceHttps           *bool
 	ForceHttp2           *bool
+	ForceHttp3           *bool
 	SNI                  *string
 	Timeout              *time.Duration
 	ConnectTimeout       *time.Duration
@@ -53,6 +54,7 @@ type PocConfig struct {
 	JsRedirect           *bool
 	RedirectHandler      func(bool, []byte, []byte) bool
 	Session              interface{} // session的标识符，可以用任意对象
+	SessionHandler       *lowhttp.SessionHandler
 	SaveHTTPFlow         *bool
 	SaveHTTPFlowHandler  func(*lowhttp.LowhttpResponse)
 	SaveHTTPFlowSync     *bool
@@ -115,6 +117,9 @@ func (c *PocConfig) ToLowhttpOptions() []lowhttp.LowhttpOpt {
 	if c.ForceHttp2 != nil {
 		opts = append(opts, lowhttp.WithHttp2(*c.ForceHttp2))
 	}
+	if c.ForceHttp3 != nil {
+		opts = append(opts, lowhttp.WithHttp3(*c.ForceHttp3))
+	}
 	if c.SNI != nil {
 		opts = append(opts, lowhttp.WithSNI(*c.SNI))
 	}
@@ -171,6 +176,9 @@ func (c *PocConfig) ToLowhttpOptions() []lowhttp.LowhttpOpt {
 	if c.Session != nil {
 		opts = append(opts, lowhttp.WithSession(c.Session))
 	}
+	if c.SessionHandler != nil {
+		opts = append(opts, lowhttp.WithSessionHandler(c.SessionHandler))
+	}
 	if c.Source != "" {
 		opts = append(opts, lowhttp.WithSource(c.Source))
 	}
@@ -398,6 +406,17 @@ func WithForceHTTP2(isHttp2 bool) PocConfigOption {
 	}
 }
 
+// http3 是一个请求选项参数，用于指定是否使用 http3(QUIC) 协议，默认为 false，如果目标通过 Alt-Svc 声明过 h3 端口则优先使用该端口，设置了代理时降级为 http2/http1.1 发送
+// Example:
+// ```
+// poc.Get("https://www.example.com", poc.http3(true)) // 向 www.example.com 发起请求，使用 http3 协议
+// ```
+func WithForceHTTP3(isHttp3 bool) PocConfigOption {
+	return func(c *PocConfig) {
+		c.ForceHttp3 = &isHttp3
+	}
+}
+
 // sni 是一个请求选项参数，用于指定使用 tls(https) 协议时的 服务器名称指示(SNI)
 // Example:
 // ```
@@ -627,6 +646,51 @@ func WithSession(i interface{}) PocConfigOption {
 	}
 }
 
+// sessionHandler 是一个请求选项参数，用于指定请求的会话处理规则，可以在每个请求前执行宏请求（例如获取 CSRF Token），并在检测到会话失效时自动重新登录后重发请求
+// Example:
+// ```
+// h = poc.NewSessionHandler()
+// h.AddLoginMacro("POST /login HTTP/1.1\r\nHost: example.com\r\nContent-Type: application/x-www-form-urlencoded\r\n\r\nuser=admin&pass=admin", false)
+// h.AddMacro("GET /csrf HTTP/1.1\r\nHost: example.com\r\n\r\n", false).Extract("csrf", "input", "csrf_token")
+// h.Inject("csrf", "post", "csrf_token").LogoutOnRedirect("/login")
+// rsp, req = poc.HTTP(packet, poc.sessionHandler(h))~
+// ```
+func WithSessionHandler(h *lowhttp.SessionHandler) PocConfigOption {
+	return func(c *PocConfig) {
+		c.SessionHandler = h
+	}
+}
+
+// NewSessionHandler 创建一个会话处理规则，可以添加宏请求、登录宏、变量注入与登出检测规则，配合 poc.sessionHandler 或 poc.AttachSessionHandler 使用
+// Example:
+// ```
+// h = poc.NewSessionHandler()
+// h.AddLoginMacro(loginPacket, false).Extract("token", "json", "$.data.token")
+// h.Inject("token", "header", "X-Token").LogoutOnStatusCode(401)
+// ```
+func NewSessionHandler() *lowhttp.SessionHandler {
+	return lowhttp.NewSessionHandler()
+}
+
+// ParseSessionHandler 从 JSON 中解析会话处理规则，字段与 Web Fuzzer 中的会话处理配置一致
+// Example:
+// ```
+// h = poc.ParseSessionHandler(`{"LoginMacros":[{"Packet":"..."}],"Logout":{"StatusCodes":[401]}}`)~
+// ```
+func ParseSessionHandler(raw any) (*lowhttp.SessionHandler, error) {
+	return lowhttp.ParseSessionHandler(utils.InterfaceToBytes(raw))
+}
+
+// AttachSessionHandler 将会话处理规则挂载到 session 上，之后所有使用 poc.session 指定该 session 的请求（包括 MITM 插件中的请求）都会经过它处理
+// Example:
+// ```
+// poc.AttachSessionHandler("admin", h)
+// poc.Get("http://example.com/admin", poc.session("admin"))~
+// ```
+func AttachSessionHandler(session any, h *lowhttp.SessionHandler) {
+	lowhttp.AttachSessionHandler(session, h)
+}
+
 // save 是一个请求选项参数，用于指定是否将此次请求的记录保存在数据库中，默认为true即会保存到数据库
 // Example:
 // ```
@@ -1683,6 +1747,11 @@ var PoCExports = map[string]interface{}{
 	// websocket，可以直接复用 HTTP 参数
 	"Websocket": DoWebSocket,
 
+	// session handler
+	"NewSessionHandler":    NewSessionHandler,
+	"ParseSessionHandler":  ParseSessionHandler,
+	"AttachSessionHandler": AttachSessionHandler,
+
 	// options
 	"host":                 WithHost,
 	"port":                 WithPort,
@@ -1697,6 +1766,7 @@ var PoCExports = map[string]interface{}{
 	"redirectHandler":      WithRedirectHandler,
 	"https":                WithForceHTTPS,
 	"http2":                WithForceHTTP2,
+	"http3":                WithForceHTTP3,
 	"sni":                  WithSNI,
 	"params":               WithParams,
 	"proxy":                WithProxy,
@@ -1708,6 +1778,7 @@ var PoCExports = map[string]interface{}{
 	"dnsNoCache":           WithDNSNoCache,
 	"noFixContentLength":   WithNoFixContentLength,
 	"session":              WithSession,
+	"sessionHandler":       WithSessionHandler,
 	"save":                 WithSave,
 	"saveSync":             WithSaveSync,
 	"saveHandler":          WithSaveHandler,
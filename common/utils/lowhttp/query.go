@@ -20,18 +20,26 @@ var (
 	PosGetQueryBase64      HttpParamPositionType = "get-query-base64"
 	PosGetQueryJson        HttpParamPositionType = "get-query-json"
 	PosGetQueryBase64Json  HttpParamPositionType = "get-query-base64-json"
+	PosGetQueryEncoded     HttpParamPositionType = "get-query-encoded"
 	PosPath                HttpParamPositionType = "path"
 	PosHeader              HttpParamPositionType = "header"
+	PosHeaderEncoded       HttpParamPositionType = "header-encoded"
 	PosPostQuery           HttpParamPositionType = "post-query"
 	PosPostXML             HttpParamPositionType = "post-xml"
 	PosPostQueryBase64     HttpParamPositionType = "post-query-base64"
 	PosPostQueryJson       HttpParamPositionType = "post-query-json"
 	PosPostQueryBase64Json HttpParamPositionType = "post-query-base64-json"
+	PosPostQueryEncoded    HttpParamPositionType = "post-query-encoded"
 	PosPostJson            HttpParamPositionType = "post-json"
+	PosPostJsonEncoded     HttpParamPositionType = "post-json-encoded"
+	PosPostMultipart       HttpParamPositionType = "post-multipart"
+	PosPostGRPC            HttpParamPositionType = "post-grpc"
+	PosPostGraphQL         HttpParamPositionType = "post-graphql"
 	PosCookie              HttpParamPositionType = "cookie"
 	PosCookieBase64        HttpParamPositionType = "cookie-base64"
 	PosCookieJson          HttpParamPositionType = "cookie-json"
 	PosCookieBase64Json    HttpParamPositionType = "cookie-base64-json"
+	PosCookieEncoded       HttpParamPositionType = "cookie-encoded"
 	PosPathAppend          HttpParamPositionType = "path-append"
 	PosPathBlock           HttpParamPositionType = "path-block"
 )
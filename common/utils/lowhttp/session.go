@@ -0,0 +1,449 @@
+package lowhttp
+
+import (
+	"bytes"
+	"encoding/json"
+	"regexp"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/jsonpath"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+const (
+	SessionExtractorRegexp = "regexp"
+	SessionExtractorHeader = "header"
+	SessionExtractorCookie = "cookie"
+	SessionExtractorJSON   = "json"
+	SessionExtractorInput  = "input"
+
+	SessionInjectHeader = "header"
+	SessionInjectCookie = "cookie"
+	SessionInjectQuery  = "query"
+	SessionInjectPost   = "post"
+	SessionInjectJSON   = "json"
+)
+
+// SessionExtractor 从宏请求的响应中提取变量
+type SessionExtractor struct {
+	Name string `json:"Name"`
+	// regexp / header / cookie / json / input(HTML 表单中的 input value，常用于 CSRF Token)
+	Type  string `json:"Type"`
+	Rule  string `json:"Rule"`
+	Group int    `json:"Group"`
+}
+
+// SessionMacro 是一个宏请求，报文中的 {{name}} 会被替换为已经提取到的变量
+type SessionMacro struct {
+	Packet     string              `json:"Packet"`
+	Https      bool                `json:"Https"`
+	Extractors []*SessionExtractor `json:"Extractors"`
+}
+
+// SessionInjection 把变量写入每一个正式请求中，正式请求报文中的 {{name}} 同样会被替换
+type SessionInjection struct {
+	Variable string `json:"Variable"`
+	// header / cookie / query / post / json(Key 为 JSONPath)
+	Position string `json:"Position"`
+	Key      string `json:"Key"`
+}
+
+// SessionLogoutRule 描述会话失效时的响应特征，满足任意一条即认为已经登出
+type SessionLogoutRule struct {
+	StatusCodes []int    `json:"StatusCodes"`
+	Regexps     []string `json:"Regexps"`
+	// 重定向的 Location 中包含这些字符串，例如 /login
+	RedirectTo []string `json:"RedirectTo"`
+}
+
+// SessionHandler 是挂载在 lowhttp 会话上的会话处理规则：
+// 每个请求之前执行 Macros（例如获取一次性的 CSRF Token），检测到登出后执行 LoginMacros 重新登录并重发请求
+type SessionHandler struct {
+	Macros      []*SessionMacro     `json:"Macros"`
+	LoginMacros []*SessionMacro     `json:"LoginMacros"`
+	Injections  []*SessionInjection `json:"Injections"`
+	Logout      *SessionLogoutRule  `json:"Logout"`
+	// LoginFirst 为 true 时在第一次请求前就执行登录
+	LoginFirst bool `json:"LoginFirst"`
+	// MaxRelogin 为单个请求最多重新登录的次数，默认 1
+	MaxRelogin int `json:"MaxRelogin"`
+
+	// LogoutMatcher 自定义登出检测，与 Logout 规则为或的关系
+	LogoutMatcher func(rsp []byte) bool `json:"-"`
+
+	mu         sync.Mutex
+	loginMu    sync.Mutex
+	generation int
+	vars       map[string]string
+	cookies    map[string]string
+	regexps    map[string]*regexp.Regexp
+}
+
+// SessionHandlerPool 保存挂载在 session 上的会话处理规则，与 CookiejarPool 使用相同的 session 标识
+var SessionHandlerPool sync.Map
+
+func NewSessionHandler() *SessionHandler {
+	return &SessionHandler{}
+}
+
+// ParseSessionHandler 从 JSON 中解析会话处理规则
+func ParseSessionHandler(raw []byte) (*SessionHandler, error) {
+	h := NewSessionHandler()
+	if err := json.Unmarshal(raw, h); err != nil {
+		return nil, utils.Wrap(err, "parse session handler failed")
+	}
+	return h, nil
+}
+
+// AttachSessionHandler 把会话处理规则挂载到 session 上，之后所有使用该 session 的请求都会经过它处理
+func AttachSessionHandler(session any, h *SessionHandler) {
+	if h == nil {
+		SessionHandlerPool.Delete(session)
+		return
+	}
+	SessionHandlerPool.Store(session, h)
+}
+
+func GetSessionHandler(session any) *SessionHandler {
+	if session == nil {
+		return nil
+	}
+	if h, ok := SessionHandlerPool.Load(session); ok {
+		return h.(*SessionHandler)
+	}
+	return nil
+}
+
+// AddMacro 添加一个在每次请求前执行的宏请求
+func (h *SessionHandler) AddMacro(packet any, https bool) *SessionMacro {
+	m := &SessionMacro{Packet: utils.InterfaceToString(packet), Https: https}
+	h.Macros = append(h.Macros, m)
+	return m
+}
+
+// AddLoginMacro 添加一个登录宏请求，多个登录宏按顺序执行
+func (h *SessionHandler) AddLoginMacro(packet any, https bool) *SessionMacro {
+	m := &SessionMacro{Packet: utils.InterfaceToString(packet), Https: https}
+	h.LoginMacros = append(h.LoginMacros, m)
+	return m
+}
+
+func (h *SessionHandler) Inject(variable, position, key string) *SessionHandler {
+	h.Injections = append(h.Injections, &SessionInjection{Variable: variable, Position: position, Key: key})
+	return h
+}
+
+func (h *SessionHandler) logoutRule() *SessionLogoutRule {
+	if h.Logout == nil {
+		h.Logout = &SessionLogoutRule{}
+	}
+	return h.Logout
+}
+
+func (h *SessionHandler) LogoutOnStatusCode(codes ...int) *SessionHandler {
+	h.logoutRule().StatusCodes = append(h.logoutRule().StatusCodes, codes...)
+	return h
+}
+
+func (h *SessionHandler) LogoutOnRegexp(rules ...string) *SessionHandler {
+	h.logoutRule().Regexps = append(h.logoutRule().Regexps, rules...)
+	return h
+}
+
+func (h *SessionHandler) LogoutOnRedirect(locations ...string) *SessionHandler {
+	h.logoutRule().RedirectTo = append(h.logoutRule().RedirectTo, locations...)
+	return h
+}
+
+// Extract 为宏请求添加变量提取规则
+func (m *SessionMacro) Extract(name, typ, rule string) *SessionMacro {
+	m.Extractors = append(m.Extractors, &SessionExtractor{Name: name, Type: typ, Rule: rule})
+	return m
+}
+
+// Variables 返回登录宏提取到的变量
+func (h *SessionHandler) Variables() map[string]string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	vars := make(map[string]string, len(h.vars))
+	for k, v := range h.vars {
+		vars[k] = v
+	}
+	return vars
+}
+
+func (h *SessionHandler) compile(rule string) (*regexp.Regexp, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if re, ok := h.regexps[rule]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(rule)
+	if err != nil {
+		return nil, err
+	}
+	if h.regexps == nil {
+		h.regexps = make(map[string]*regexp.Regexp)
+	}
+	h.regexps[rule] = re
+	return re, nil
+}
+
+// IsLoggedOut 判断响应是否表示会话已经失效
+func (h *SessionHandler) IsLoggedOut(rsp []byte) bool {
+	if len(rsp) == 0 {
+		return false
+	}
+	if h.LogoutMatcher != nil && h.LogoutMatcher(rsp) {
+		return true
+	}
+	rule := h.Logout
+	if rule == nil {
+		return false
+	}
+	if len(rule.StatusCodes) > 0 {
+		code := GetStatusCodeFromResponse(rsp)
+		for _, c := range rule.StatusCodes {
+			if c == code {
+				return true
+			}
+		}
+	}
+	if len(rule.RedirectTo) > 0 {
+		if location := GetHTTPPacketHeader(rsp, "Location"); location != "" {
+			for _, r := range rule.RedirectTo {
+				if strings.Contains(location, r) {
+					return true
+				}
+			}
+		}
+	}
+	for _, r := range rule.Regexps {
+		re, err := h.compile(r)
+		if err != nil {
+			log.Warnf("session logout regexp %#v is invalid: %v", r, err)
+			continue
+		}
+		if re.Match(rsp) {
+			return true
+		}
+	}
+	return false
+}
+
+func (h *SessionHandler) extract(e *SessionExtractor, rsp []byte) (string, bool) {
+	switch strings.ToLower(e.Type) {
+	case SessionExtractorHeader:
+		v := GetHTTPPacketHeader(rsp, e.Rule)
+		return v, v != ""
+	case SessionExtractorCookie:
+		for _, c := range ExtractCookieJarFromHTTPResponse(rsp) {
+			if c.Name == e.Rule {
+				return c.Value, true
+			}
+		}
+		return "", false
+	case SessionExtractorJSON:
+		v := jsonpath.Find(GetHTTPPacketBody(rsp), e.Rule)
+		if v == nil {
+			return "", false
+		}
+		return utils.InterfaceToString(v), true
+	case SessionExtractorInput:
+		name := regexp.QuoteMeta(e.Rule)
+		for _, rule := range []string{
+			`(?is)<input[^>]*?name\s*=\s*["']?` + name + `["'\s][^>]*?value\s*=\s*["']([^"']*)`,
+			`(?is)<input[^>]*?value\s*=\s*["']([^"']*)["'][^>]*?name\s*=\s*["']?` + name + `["'\s/>]`,
+		} {
+			re, err := h.compile(rule)
+			if err != nil {
+				continue
+			}
+			if matched := re.FindSubmatch(GetHTTPPacketBody(rsp)); len(matched) > 1 {
+				return string(matched[1]), true
+			}
+		}
+		return "", false
+	case "", SessionExtractorRegexp:
+		re, err := h.compile(e.Rule)
+		if err != nil {
+			log.Warnf("session extractor regexp %#v is invalid: %v", e.Rule, err)
+			return "", false
+		}
+		matched := re.FindSubmatch(rsp)
+		if matched == nil {
+			return "", false
+		}
+		group := e.Group
+		if group == 0 && len(matched) > 1 {
+			group = 1
+		}
+		if group >= len(matched) {
+			return "", false
+		}
+		return string(matched[group]), true
+	default:
+		log.Warnf("unsupported session extractor type: %v", e.Type)
+		return "", false
+	}
+}
+
+func renderSessionPacket(packet []byte, vars map[string]string) []byte {
+	for k, v := range vars {
+		packet = bytes.ReplaceAll(packet, []byte("{{"+k+"}}"), []byte(v))
+	}
+	return packet
+}
+
+// runMacros 依次发送宏请求，提取到的变量与响应中设置的 Cookie 写入 vars 与 cookies
+func (h *SessionHandler) runMacros(macros []*SessionMacro, opts []LowhttpOpt, vars, cookies map[string]string) error {
+	for i, m := range macros {
+		packet := FixHTTPRequest(renderSessionPacket([]byte(m.Packet), vars))
+		for k, v := range cookies {
+			packet = ReplaceHTTPPacketCookie(packet, k, v)
+		}
+		macroOpts := append(opts[:len(opts):len(opts)], WithHost(""), WithPort(0), WithHttps(m.Https), WithPacketBytes(packet))
+		rsp, err := HTTP(macroOpts...)
+		if err != nil {
+			return utils.Wrapf(err, "session macro[%d] failed", i)
+		}
+		for _, r := range rsp.RedirectRawPackets {
+			for _, c := range ExtractCookieJarFromHTTPResponse(r.Response) {
+				cookies[c.Name] = c.Value
+			}
+		}
+		for _, c := range ExtractCookieJarFromHTTPResponse(rsp.RawPacket) {
+			cookies[c.Name] = c.Value
+		}
+		for _, e := range m.Extractors {
+			if v, ok := h.extract(e, rsp.RawPacket); ok {
+				vars[e.Name] = v
+			} else {
+				log.Warnf("session macro[%d] extract %v failed", i, e.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// relogin 执行登录宏，如果其他请求已经在 generation 之后重新登录过则直接返回
+func (h *SessionHandler) relogin(opts []LowhttpOpt, generation int) error {
+	h.loginMu.Lock()
+	defer h.loginMu.Unlock()
+
+	h.mu.Lock()
+	if h.generation != generation {
+		h.mu.Unlock()
+		return nil
+	}
+	vars, cookies := make(map[string]string), make(map[string]string)
+	for k, v := range h.vars {
+		vars[k] = v
+	}
+	h.mu.Unlock()
+
+	if err := h.runMacros(h.LoginMacros, opts, vars, cookies); err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.cookies == nil {
+		h.cookies = make(map[string]string)
+	}
+	for k, v := range cookies {
+		h.cookies[k] = v
+	}
+	h.vars = vars
+	h.generation++
+	return nil
+}
+
+func (h *SessionHandler) snapshot() (int, map[string]string, map[string]string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	vars, cookies := make(map[string]string, len(h.vars)), make(map[string]string, len(h.cookies))
+	for k, v := range h.vars {
+		vars[k] = v
+	}
+	for k, v := range h.cookies {
+		cookies[k] = v
+	}
+	return h.generation, vars, cookies
+}
+
+// prepare 执行每个请求之前的宏并把变量注入请求报文
+func (h *SessionHandler) prepare(packet []byte, opts []LowhttpOpt) (int, []byte, error) {
+	generation, vars, cookies := h.snapshot()
+	if err := h.runMacros(h.Macros, opts, vars, cookies); err != nil {
+		return generation, packet, err
+	}
+	packet = renderSessionPacket(packet, vars)
+	for k, v := range cookies {
+		packet = ReplaceHTTPPacketCookie(packet, k, v)
+	}
+	for _, inj := range h.Injections {
+		v, ok := vars[inj.Variable]
+		if !ok {
+			continue
+		}
+		switch strings.ToLower(inj.Position) {
+		case SessionInjectHeader:
+			packet = ReplaceHTTPPacketHeader(packet, inj.Key, v)
+		case SessionInjectCookie:
+			packet = ReplaceHTTPPacketCookie(packet, inj.Key, v)
+		case SessionInjectQuery:
+			packet = ReplaceHTTPPacketQueryParam(packet, inj.Key, v)
+		case SessionInjectPost:
+			packet = ReplaceHTTPPacketPostParam(packet, inj.Key, v)
+		case SessionInjectJSON:
+			body := jsonpath.ReplaceString(string(GetHTTPPacketBody(packet)), inj.Key, v)
+			packet = ReplaceHTTPPacketBody(packet, []byte(body), false)
+		default:
+			log.Warnf("unsupported session injection position: %v", inj.Position)
+		}
+	}
+	return generation, packet, nil
+}
+
+func (h *SessionHandler) isLoggedOutResponse(rsp *LowhttpResponse) bool {
+	if rsp == nil {
+		return false
+	}
+	if len(rsp.RedirectRawPackets) > 0 && h.IsLoggedOut(rsp.RedirectRawPackets[0].Response) {
+		return true
+	}
+	return h.IsLoggedOut(rsp.RawPacket)
+}
+
+// execute 在会话处理规则下发送请求，检测到登出后重新登录并重发
+func (h *SessionHandler) execute(option *LowhttpExecConfig, opts []LowhttpOpt) (*LowhttpResponse, error) {
+	opts = append(opts[:len(opts):len(opts)], withSessionHandled())
+	if h.LoginFirst && len(h.LoginMacros) > 0 {
+		// generation 为 0 说明还没有登录成功过，relogin 在 loginMu 下再次检查，登录失败时后续请求会重试
+		if err := h.relogin(opts, 0); err != nil {
+			return nil, utils.Wrap(err, "session login failed")
+		}
+	}
+
+	maxRelogin := h.MaxRelogin
+	if maxRelogin <= 0 {
+		maxRelogin = 1
+	}
+	for retry := 0; ; retry++ {
+		generation, packet, err := h.prepare(option.Packet, opts)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := HTTP(append(opts, WithPacketBytes(packet))...)
+		if err != nil || len(h.LoginMacros) == 0 || retry >= maxRelogin || !h.isLoggedOutResponse(rsp) {
+			return rsp, err
+		}
+		log.Infof("session logout detected in %v, relogin and retry", rsp.Url)
+		if err := h.relogin(opts, generation); err != nil {
+			return rsp, utils.Wrap(err, "session relogin failed")
+		}
+	}
+}
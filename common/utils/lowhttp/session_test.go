@@ -0,0 +1,171 @@
+package lowhttp
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+type mockSessionServer struct {
+	mu       sync.Mutex
+	logins   int
+	sessions map[string]int
+	once     map[string]bool
+}
+
+func (s *mockSessionServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	switch r.URL.Path {
+	case "/login":
+		if r.Method == http.MethodGet {
+			http.SetCookie(w, &http.Cookie{Name: "pre", Value: "1"})
+			w.Write([]byte(`<form method="post"><input type="hidden" name="csrf" value="login-csrf"><input name="user"></form>`))
+			return
+		}
+		pre, _ := r.Cookie("pre")
+		if pre == nil || r.PostFormValue("csrf") != "login-csrf" || r.PostFormValue("user") != "admin" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		s.logins++
+		sid := fmt.Sprintf("sid%d", s.logins)
+		s.sessions[sid] = 0
+		http.SetCookie(w, &http.Cookie{Name: "sid", Value: sid})
+		w.Write([]byte(`{"token":"bearer-` + sid + `"}`))
+	case "/csrf":
+		token := utils.RandStringBytes(8)
+		s.once[token] = true
+		w.Write([]byte(`{"csrf":"` + token + `"}`))
+	case "/api":
+		sid, _ := r.Cookie("sid")
+		if sid == nil {
+			http.Redirect(w, r, "/login?expired", http.StatusFound)
+			return
+		}
+		used, ok := s.sessions[sid.Value]
+		// 每个会话只能使用 3 次
+		if !ok || used >= 3 {
+			http.Redirect(w, r, "/login?expired", http.StatusFound)
+			return
+		}
+		if !s.once[r.Header.Get("X-CSRF")] || r.Header.Get("Authorization") != "Bearer bearer-"+sid.Value {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delete(s.once, r.Header.Get("X-CSRF"))
+		s.sessions[sid.Value]++
+		w.Write([]byte("ok"))
+	}
+}
+
+func TestSessionHandler(t *testing.T) {
+	server := &mockSessionServer{sessions: make(map[string]int), once: make(map[string]bool)}
+	host, port := utils.DebugMockHTTPHandlerFunc(server.ServeHTTP)
+	addr := utils.HostPort(host, port)
+
+	h := NewSessionHandler()
+	h.AddLoginMacro("GET /login HTTP/1.1\r\nHost: "+addr+"\r\n\r\n", false).Extract("loginCSRF", SessionExtractorInput, "csrf")
+	h.AddLoginMacro("POST /login HTTP/1.1\r\nHost: "+addr+"\r\nContent-Type: application/x-www-form-urlencoded\r\n\r\ncsrf={{loginCSRF}}&user=admin", false).
+		Extract("token", SessionExtractorJSON, "$.token")
+	h.AddMacro("GET /csrf HTTP/1.1\r\nHost: "+addr+"\r\n\r\n", false).Extract("csrf", SessionExtractorRegexp, `"csrf":"(\w+)"`)
+	h.Inject("csrf", SessionInjectHeader, "X-CSRF").LogoutOnRedirect("/login")
+
+	// 报文中携带的是过期的 sid，第一次请求就会触发登录
+	packet := []byte("GET /api HTTP/1.1\r\nHost: " + addr + "\r\nAuthorization: Bearer {{token}}\r\nCookie: sid=expired\r\n\r\n")
+	for i := 0; i < 7; i++ {
+		rsp, err := HTTP(WithPacketBytes(packet), WithSessionHandler(h))
+		require.NoError(t, err)
+		require.Equal(t, 200, GetStatusCodeFromResponse(rsp.RawPacket), string(rsp.RawRequest))
+		require.Equal(t, "ok", string(GetHTTPPacketBody(rsp.RawPacket)))
+	}
+	require.Equal(t, 3, server.logins)
+	require.Equal(t, "bearer-sid3", h.Variables()["token"])
+}
+
+func TestSessionHandler_AttachToSession(t *testing.T) {
+	server := &mockSessionServer{sessions: make(map[string]int), once: make(map[string]bool)}
+	host, port := utils.DebugMockHTTPHandlerFunc(server.ServeHTTP)
+	addr := utils.HostPort(host, port)
+
+	h, err := ParseSessionHandler([]byte(`{
+"LoginFirst": true,
+"LoginMacros": [
+  {"Packet": "GET /login HTTP/1.1\nHost: ` + addr + `\n\n"},
+  {"Packet": "POST /login HTTP/1.1\nHost: ` + addr + `\nContent-Type: application/x-www-form-urlencoded\n\ncsrf=login-csrf&user=admin",
+   "Extractors": [{"Name": "token", "Type": "json", "Rule": "$.token"}]}
+],
+"Macros": [{"Packet": "GET /csrf HTTP/1.1\nHost: ` + addr + `\n\n", "Extractors": [{"Name": "csrf", "Type": "json", "Rule": "$.csrf"}]}],
+"Injections": [{"Variable": "csrf", "Position": "header", "Key": "X-CSRF"}],
+"Logout": {"StatusCodes": [302]}
+}`))
+	require.NoError(t, err)
+	session := utils.RandStringBytes(10)
+	AttachSessionHandler(session, h)
+	defer AttachSessionHandler(session, nil)
+
+	packet := []byte("GET /api HTTP/1.1\r\nHost: " + addr + "\r\nAuthorization: Bearer {{token}}\r\n\r\n")
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			rsp, err := HTTP(WithPacketBytes(packet), WithSession(session))
+			require.NoError(t, err)
+			require.Equal(t, 200, GetStatusCodeFromResponse(rsp.RawPacket))
+		}()
+	}
+	wg.Wait()
+	// 并发的请求同时发现会话失效时只会重新登录一次
+	require.Equal(t, 2, server.logins)
+
+	rsp, err := HTTP(WithPacketBytes(packet), WithRedirectTimes(0))
+	require.NoError(t, err)
+	require.Equal(t, 302, GetStatusCodeFromResponse(rsp.RawPacket))
+}
+
+func TestSessionHandler_LoginFirstRetry(t *testing.T) {
+	var logins int
+	host, port := utils.DebugMockHTTPHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/login":
+			logins++
+			if logins == 1 {
+				// 第一次登录时连接异常断开
+				conn, _, _ := w.(http.Hijacker).Hijack()
+				conn.Close()
+				return
+			}
+			http.SetCookie(w, &http.Cookie{Name: "sid", Value: "ok"})
+		case "/api":
+			if sid, _ := r.Cookie("sid"); sid == nil || sid.Value != "ok" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte("ok"))
+		}
+	})
+	addr := utils.HostPort(host, port)
+
+	h := NewSessionHandler()
+	h.LoginFirst = true
+	h.AddLoginMacro("GET /login HTTP/1.1\r\nHost: "+addr+"\r\n\r\n", false)
+
+	packet := []byte("GET /api HTTP/1.1\r\nHost: " + addr + "\r\n\r\n")
+	_, err := HTTP(WithPacketBytes(packet), WithSessionHandler(h))
+	require.Error(t, err)
+
+	// 登录失败后下一个请求会重新登录
+	rsp, err := HTTP(WithPacketBytes(packet), WithSessionHandler(h))
+	require.NoError(t, err)
+	require.Equal(t, "ok", string(GetHTTPPacketBody(rsp.RawPacket)))
+	require.Equal(t, 2, logins)
+
+	_, err = HTTP(WithPacketBytes(packet), WithSessionHandler(h))
+	require.NoError(t, err)
+	require.Equal(t, 2, logins)
+}
@@ -11,6 +11,8 @@ var Exports = map[string]any{
 	"Checkout":      checkout,
 	"IterateCommit": EveryCommit,
 
+	"FileSystemFromCommit": FileSystemFromCommit,
+
 	"auth":           WithUsernamePassword,
 	"context":        WithContext,
 	"depth":          WithDepth,
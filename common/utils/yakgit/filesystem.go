@@ -0,0 +1,57 @@
+package yakgit
+
+import (
+	"io"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+)
+
+// FileSystemFromCommit 用于读取本地仓库中指定修订版本(分支、标签、提交哈希或 HEAD~1 等表达式)的文件树，返回一个虚拟文件系统，不会修改工作区
+// Example:
+// ```
+// fs, err := git.FileSystemFromCommit("C:/Users/xxx/Desktop/yaklang", "main")
+// ```
+func FileSystemFromCommit(localPath string, ref string) (*filesys.VirtualFS, error) {
+	repos, err := git.PlainOpen(localPath)
+	if err != nil {
+		return nil, utils.Errorf("git.PlainOpen failed: %s", err)
+	}
+	if ref == "" {
+		ref = "HEAD"
+	}
+	hash, err := repos.ResolveRevision(plumbing.Revision(ref))
+	if err != nil {
+		return nil, utils.Errorf("git revision %s not found: %s", ref, err)
+	}
+	commit, err := repos.CommitObject(*hash)
+	if err != nil {
+		return nil, utils.Errorf("git commit %s not found: %s", hash, err)
+	}
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, utils.Errorf("git tree of commit %s failed: %s", hash, err)
+	}
+
+	vfs := filesys.NewVirtualFs()
+	err = tree.Files().ForEach(func(f *object.File) error {
+		reader, err := f.Reader()
+		if err != nil {
+			return utils.Errorf("read git file %s failed: %s", f.Name, err)
+		}
+		defer reader.Close()
+		raw, err := io.ReadAll(reader)
+		if err != nil {
+			return utils.Errorf("read git file %s failed: %s", f.Name, err)
+		}
+		vfs.AddFile(f.Name, string(raw))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return vfs, nil
+}
@@ -0,0 +1,219 @@
+package c2ssa
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	cparser "github.com/yaklang/yaklang/common/yak/c/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	ssa.DummyPreHandler
+}
+
+var Builder = &SSABuilder{}
+
+func (s *SSABuilder) Create() ssa.Builder {
+	return &SSABuilder{}
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return isSourceFile(path)
+}
+
+func (*SSABuilder) FilterPreHandlerFile(path string) bool {
+	return isSourceFile(path)
+}
+
+func isSourceFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".c", ".cc", ".cpp", ".cxx", ".c++":
+		return true
+	}
+	return false
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.C
+}
+
+// PreHandlerProject declare the functions of file before compiling the project,
+// so the function defined in other file can be called.
+func (s *SSABuilder) PreHandlerProject(fileSystem fi.FileSystem, builder *ssa.FunctionBuilder, path string) error {
+	prog := builder.GetProgram()
+	if prog == nil {
+		log.Errorf("program is nil")
+		return nil
+	}
+	if !s.FilterFile(path) {
+		return nil
+	}
+	raw, err := fileSystem.ReadFile(path)
+	if err != nil {
+		log.Errorf("read file %s error: %v", path, err)
+		return nil
+	}
+	builder.PreHandler = true
+	defer func() {
+		builder.PreHandler = false
+	}()
+	prog.Build(path, memedit.NewMemEditor(string(raw)), builder)
+	return nil
+}
+
+func (s *SSABuilder) PreHandlerFile(editor *memedit.MemEditor, builder *ssa.FunctionBuilder) {
+	builder.PreHandler = true
+	builder.GetProgram().GetApplication().Build("", editor, builder)
+	builder.PreHandler = false
+}
+
+func (*SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	editor := b.GetEditor()
+	var filename string
+	if editor != nil {
+		filename = editor.GetFilename()
+	}
+	cpp := cparser.IsCPP(filename, src)
+	ast, err := Frontend(filename, src, cpp, force, newIncludeLoader(b.GetProgram()))
+	if err != nil {
+		return err
+	}
+	b.SupportClosure = true
+	b.SupportClass = true
+	astBuilder := &astbuilder{
+		FunctionBuilder: b,
+		root:            b,
+		editor:          editor,
+		cpp:             cpp,
+		structs:         make(map[string]*cparser.StructDecl),
+		typedefs:        make(map[string]string),
+		defined:         make(map[*cparser.FuncDef]*ssa.Function),
+		globals:         make(map[string]*varInfo),
+	}
+	if b.PreHandler {
+		astBuilder.declare(ast.Decls)
+		return nil
+	}
+	astBuilder.build(ast)
+	return nil
+}
+
+type astbuilder struct {
+	*ssa.FunctionBuilder
+
+	// root is the builder of file scope, the functions and global variables are declared in it
+	root *ssa.FunctionBuilder
+	// editor is the source file, the function may be built when compiling other file
+	editor *memedit.MemEditor
+	cpp    bool
+
+	// structs is the struct (and c++ class) definitions by name
+	structs map[string]*cparser.StructDecl
+	// typedefs map the alias to struct name, `typedef struct node node_t`
+	typedefs map[string]string
+	// funcs is the functions defined in this file
+	funcs []*ssa.Function
+	// defined is the function of definition, the definition is built in order of source
+	defined map[*cparser.FuncDef]*ssa.Function
+
+	// globals and vars is the variables declared in file and current function,
+	// used to check whether the argument is an output buffer
+	globals map[string]*varInfo
+	vars    map[string]*varInfo
+
+	// class is the c++ class of current method, the field can be used without `this`
+	class     *ssa.ClassBluePrint
+	classDecl *cparser.StructDecl
+}
+
+type varInfo struct {
+	spec *cparser.TypeSpec
+	decl *cparser.Declarator
+}
+
+// isBuffer means the variable is pointer or array, its content may be written by callee
+func (v *varInfo) isBuffer() bool {
+	if v == nil || v.decl == nil || !v.decl.IsPointer() {
+		return false
+	}
+	return v.spec == nil || !v.spec.Const
+}
+
+// IncludeLoader find the header of `#include` in the current file
+type IncludeLoader func(current, name string, system bool) (path string, src string, ok bool)
+
+// newIncludeLoader find the header in project file system, the header not in
+// project (such as system header) is ignored.
+func newIncludeLoader(prog *ssa.Program) IncludeLoader {
+	if prog == nil || prog.Loader == nil {
+		return nil
+	}
+	fs := prog.Loader.GetFilesysFileSystem()
+	base := prog.Loader.GetBasePath()
+	return func(current, name string, system bool) (string, string, bool) {
+		if fs == nil || current == "" {
+			return "", "", false
+		}
+		var candidates []string
+		if !system {
+			dir, _ := fs.PathSplit(current)
+			candidates = append(candidates, fs.Join(dir, name))
+		}
+		candidates = append(candidates, fs.Join(base, name), fs.Join(base, "include", name))
+		for _, path := range candidates {
+			if raw, err := fs.ReadFile(path); err == nil {
+				return path, string(raw), true
+			}
+		}
+		return "", "", false
+	}
+}
+
+// Frontend preprocess and parse the source code, the loader is used to find
+// the header of `#include`.
+func Frontend(path, src string, cpp, force bool, loader IncludeLoader) (*cparser.TranslationUnit, error) {
+	var pp *cparser.Preprocessor
+	var resolver cparser.IncludeResolver
+	if loader != nil {
+		resolver = func(name string, system bool) (string, string, bool) {
+			return loader(pp.CurrentFile(), name, system)
+		}
+	}
+	pp = cparser.NewPreprocessor(resolver)
+	if cpp {
+		pp.Define("__cplusplus", "201703L")
+	}
+	tokens := pp.Process(path, src)
+	ast, errs := cparser.ParseTokens(tokens, cpp)
+	errs = append(pp.Errors(), errs...)
+	if force || len(errs) == 0 {
+		return ast, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error : %v", errs)
+}
+
+// SetRange set the current range by c ast node
+func (b *astbuilder) SetRange(node cparser.Node) func() {
+	editor := b.GetEditor()
+	if editor == nil || node == nil {
+		return func() {}
+	}
+	start, stop := node.GetStart(), node.GetStop()
+	if start.Line <= 0 || stop.Line <= 0 {
+		return func() {}
+	}
+	backup := b.CurrentRange
+	b.SetRangeByRangeIf(editor.GetRangeByPosition(
+		editor.GetPositionByLine(start.Line, start.Column+1),
+		editor.GetPositionByLine(stop.Line, stop.Column+1),
+	))
+	return func() {
+		b.CurrentRange = backup
+	}
+}
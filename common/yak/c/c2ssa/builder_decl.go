@@ -0,0 +1,692 @@
+package c2ssa
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	cparser "github.com/yaklang/yaklang/common/yak/c/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *astbuilder) build(unit *cparser.TranslationUnit) {
+	recoverRange := b.SetRange(unit)
+	defer recoverRange()
+
+	b.declare(unit.Decls)
+	b.buildDecls(unit.Decls)
+
+	// the function not called in this file is built at last, it is lazy built
+	// so the function called before definition can be handled
+	for _, f := range b.funcs {
+		f.Build()
+		f.FixSpinUdChain()
+	}
+}
+
+// declare the struct and function of file, the function body is built lazily
+func (b *astbuilder) declare(decls []cparser.Decl) {
+	b.declareTypes(decls)
+	b.declareFunctions(decls)
+}
+
+func (b *astbuilder) declareTypes(decls []cparser.Decl) {
+	for _, decl := range decls {
+		switch d := decl.(type) {
+		case *cparser.Namespace:
+			b.declareTypes(d.Decls)
+		case *cparser.StructDecl:
+			b.declareStruct(d.Name, d)
+		case *cparser.VarDecl:
+			b.declareType(d)
+		}
+	}
+}
+
+// declareType handle the struct definition and typedef in declaration,
+// such as `typedef struct { ... } ctx_t;` and `struct S { ... } s;`
+func (b *astbuilder) declareType(d *cparser.VarDecl) {
+	spec := d.Type
+	if spec == nil {
+		return
+	}
+	name := structName(spec)
+	if s := spec.Struct; s != nil && s.Complete {
+		if name == "" && spec.Typedef && len(d.Declarators) > 0 {
+			// anonymous struct use the typedef name
+			name = d.Declarators[0].Name
+		}
+		b.declareStruct(name, s)
+	}
+	if !spec.Typedef || name == "" {
+		return
+	}
+	for _, declarator := range d.Declarators {
+		if declarator.Name != "" && declarator.Name != name && !declarator.IsFunction() {
+			b.typedefs[declarator.Name] = name
+		}
+	}
+}
+
+func structName(spec *cparser.TypeSpec) string {
+	if spec.Struct != nil && spec.Struct.Name != "" {
+		return spec.Struct.Name
+	}
+	switch spec.Kind {
+	case "struct", "union", "class":
+		return spec.Name
+	}
+	return ""
+}
+
+// declareStruct create class blueprint for struct, the field is the normal member
+// and the c++ member function is the method.
+func (b *astbuilder) declareStruct(name string, s *cparser.StructDecl) {
+	if name == "" || !s.Complete {
+		return
+	}
+	name = shortName(name)
+	if _, ok := b.structs[name]; ok {
+		return
+	}
+	b.structs[name] = s
+
+	recoverRange := b.SetRange(s)
+	defer recoverRange()
+
+	class := b.GetClassBluePrint(name)
+	if class != nil {
+		// declared by pre-handler or other file, only the method need to build
+		for _, method := range class.Method {
+			b.funcs = append(b.funcs, method)
+		}
+		for _, method := range class.StaticMethod {
+			b.funcs = append(b.funcs, method)
+		}
+		if f, ok := ssa.ToFunction(class.Constructor); ok {
+			b.funcs = append(b.funcs, f)
+		}
+		return
+	}
+
+	class = b.root.CreateClassBluePrint(name)
+	for _, base := range s.Bases {
+		if parent := b.GetClassBluePrint(shortName(base)); parent != nil {
+			class.AddParentClass(parent)
+		}
+	}
+	for _, field := range s.Fields {
+		if field.Type != nil && field.Type.Struct != nil {
+			// nested struct definition
+			b.declareType(field)
+		}
+		for _, declarator := range field.Declarators {
+			if declarator.Name == "" || declarator.IsFunction() {
+				continue
+			}
+			class.AddNormalMemberOnlyType(declarator.Name, b.typeOf(field.Type, declarator))
+		}
+	}
+	for _, method := range s.Methods {
+		b.declareMethod(class, s, method)
+	}
+}
+
+// declareMethod add the c++ member function to class, the constructor is the
+// method with same name as class.
+func (b *astbuilder) declareMethod(class *ssa.ClassBluePrint, s *cparser.StructDecl, fd *cparser.FuncDef) *ssa.Function {
+	recoverRange := b.SetRange(fd)
+	defer recoverRange()
+
+	name := shortName(fd.Name)
+	f := b.root.NewFunc(name)
+	static := fd.Type != nil && fd.Type.Static
+	switch {
+	case name == class.Name:
+		class.Constructor = f
+	case strings.HasPrefix(name, "~"):
+		class.Destructor = f
+	case static:
+		class.AddStaticMethod(name, f)
+	default:
+		class.AddMethod(name, f)
+	}
+	if static {
+		b.lazyBuild(f, fd, nil, s)
+	} else {
+		b.lazyBuild(f, fd, class, s)
+	}
+	return f
+}
+
+func (b *astbuilder) declareFunctions(decls []cparser.Decl) {
+	for _, decl := range decls {
+		switch d := decl.(type) {
+		case *cparser.Namespace:
+			b.declareFunctions(d.Decls)
+		case *cparser.FuncDef:
+			if d.Body == nil {
+				continue
+			}
+			if d.Owner != "" {
+				b.declareOutOfClassMethod(d)
+				continue
+			}
+			b.declareFunction(d)
+		}
+	}
+}
+
+func (b *astbuilder) declareFunction(fd *cparser.FuncDef) {
+	recoverRange := b.SetRange(fd)
+	defer recoverRange()
+
+	name := shortName(fd.Name)
+	if f := b.getFunction(name); f != nil && b.isSameFile(f) {
+		// declared in pre-handler, reuse the function so the call in other file is kept
+		b.lazyBuild(f, fd, nil, nil)
+		b.defined[fd] = f
+		return
+	}
+	f := b.root.NewFunc(name)
+	variable := b.root.CreateVariable(name)
+	b.root.AssignVariable(variable, f)
+	b.lazyBuild(f, fd, nil, nil)
+	b.defined[fd] = f
+}
+
+// declareOutOfClassMethod handle the method defined out of class `void A::run() {...}`
+func (b *astbuilder) declareOutOfClassMethod(fd *cparser.FuncDef) {
+	owner := shortName(fd.Owner)
+	class := b.GetClassBluePrint(owner)
+	s := b.structs[owner]
+	if class == nil || s == nil {
+		// the class is not found, treat it as normal function
+		b.declareFunction(fd)
+		return
+	}
+	name := shortName(fd.Name)
+	if f := b.getMethod(class, name); f != nil {
+		// declared in pre-handler
+		b.defined[fd] = f
+		return
+	}
+
+	recoverRange := b.SetRange(fd)
+	defer recoverRange()
+	f := b.root.NewFunc(name)
+	static := b.isStaticMethod(s, name)
+	switch {
+	case name == class.Name:
+		class.Constructor = f
+	case strings.HasPrefix(name, "~"):
+		class.Destructor = f
+	case static:
+		class.AddStaticMethod(name, f)
+	default:
+		class.AddMethod(name, f)
+	}
+	if static {
+		b.lazyBuild(f, fd, nil, s)
+	} else {
+		b.lazyBuild(f, fd, class, s)
+	}
+	b.defined[fd] = f
+}
+
+// getMethod get the method declared in class, include the constructor and destructor
+func (b *astbuilder) getMethod(class *ssa.ClassBluePrint, name string) *ssa.Function {
+	var value ssa.Value
+	switch {
+	case name == class.Name:
+		value = class.Constructor
+	case strings.HasPrefix(name, "~"):
+		value = class.Destructor
+	default:
+		if f, ok := class.Method[name]; ok {
+			return f
+		}
+		if f, ok := class.StaticMethod[name]; ok {
+			return f
+		}
+	}
+	if f, ok := ssa.ToFunction(value); ok {
+		return f
+	}
+	return nil
+}
+
+func (b *astbuilder) isStaticMethod(s *cparser.StructDecl, name string) bool {
+	for _, field := range s.Fields {
+		if field.Type == nil || !field.Type.Static {
+			continue
+		}
+		for _, declarator := range field.Declarators {
+			if declarator.IsFunction() && declarator.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getFunction get the function defined in file scope
+func (b *astbuilder) getFunction(name string) *ssa.Function {
+	value := b.root.PeekValueInThisFunction(name)
+	if utils.IsNil(value) {
+		return nil
+	}
+	if f, ok := ssa.ToFunction(value); ok && !f.IsExtern() {
+		return f
+	}
+	return nil
+}
+
+func (b *astbuilder) isSameFile(f *ssa.Function) bool {
+	r := f.GetRange()
+	if r == nil || r.GetEditor() == nil || b.editor == nil {
+		return false
+	}
+	return r.GetEditor().GetFilename() == b.editor.GetFilename()
+}
+
+// lazyBuild set the builder of function, the function is built when it is called
+// or at the end of file.
+func (b *astbuilder) lazyBuild(f *ssa.Function, fd *cparser.FuncDef, class *ssa.ClassBluePrint, s *cparser.StructDecl) {
+	b.funcs = append(b.funcs, f)
+	f.SetOrdinalBuild(func() ssa.Value {
+		b.buildFunction(f, fd, class, s)
+		return f
+	})
+}
+
+func (b *astbuilder) buildFunction(f *ssa.Function, fd *cparser.FuncDef, class *ssa.ClassBluePrint, s *cparser.StructDecl) {
+	// the function may be built when other function or other file is building,
+	// switch to the file scope of this file
+	current := b.FunctionBuilder
+	editor := b.root.GetEditor()
+	vars, currentClass, currentClassDecl := b.vars, b.class, b.classDecl
+	b.FunctionBuilder = b.root
+	b.root.SetEditor(b.editor)
+	b.class, b.classDecl = class, s
+	if class == nil && s != nil {
+		// static method can use the static member without class name
+		b.classDecl = s
+	}
+	defer func() {
+		b.FunctionBuilder = current
+		b.root.SetEditor(editor)
+		b.vars, b.class, b.classDecl = vars, currentClass, currentClassDecl
+	}()
+
+	recoverRange := b.SetRange(fd)
+	defer recoverRange()
+
+	var self ssa.Type
+	if class != nil {
+		self = class
+	}
+	b.buildFunctionBody(f, fd.Params(), self, func() {
+		for _, init := range fd.Inits {
+			b.buildMemberInit(init)
+		}
+		if fd.Body != nil {
+			b.buildStatements(fd.Body.Items)
+		}
+	})
+}
+
+// buildMemberInit build the c++ constructor member initializer `A(int x) : x_(x) {}`
+func (b *astbuilder) buildMemberInit(init *cparser.MemberInit) {
+	recoverRange := b.SetRange(init)
+	defer recoverRange()
+
+	args := b.buildExprList(init.Args)
+	if b.class == nil {
+		return
+	}
+	if parent := b.GetClassBluePrint(shortName(init.Name)); parent != nil {
+		// base class constructor
+		if constructor := parent.GetConstructOrDestruct("constructor"); !utils.IsNil(constructor) {
+			b.buildLazyFunction(constructor)
+			b.EmitCall(b.NewCall(constructor, append([]ssa.Value{b.ReadValue("this")}, args...)))
+		}
+		return
+	}
+	var value ssa.Value
+	switch len(args) {
+	case 0:
+		value = b.EmitValueOnlyDeclare(init.Name)
+	case 1:
+		value = args[0]
+	default:
+		value = b.EmitMakeWithoutType(nil, nil)
+		b.fillContainer(value, args)
+	}
+	variable := b.CreateMemberCallVariable(b.ReadValue("this"), b.EmitConstInst(init.Name))
+	b.AssignVariable(variable, value)
+}
+
+// buildFunctionBody build parameters and body for function, if the self is not nil,
+// the first parameter is `this` of the class.
+func (b *astbuilder) buildFunctionBody(newFunc *ssa.Function, params []*cparser.Param, self ssa.Type, body func()) {
+	// default argument is evaluated in the caller scope
+	defaults := make(map[*cparser.Param]ssa.Value)
+	for _, param := range params {
+		if param.Default != nil {
+			defaults[param] = b.buildExpr(param.Default)
+		}
+	}
+
+	b.FunctionBuilder = b.PushFunction(newFunc)
+	{
+		b.vars = make(map[string]*varInfo)
+		if self != nil {
+			p := b.NewParam("this")
+			p.SetType(self)
+			b.vars["this"] = &varInfo{}
+		}
+		for i, param := range params {
+			if len(params) == 1 && param.Name() == "" && param.Type != nil &&
+				param.Type.Name == "void" && !param.Decl.IsPointer() {
+				// `int f(void)`
+				break
+			}
+			b.buildParam(i, param, defaults[param])
+		}
+		body()
+		b.Finish()
+	}
+	b.FunctionBuilder = b.PopFunction()
+}
+
+func (b *astbuilder) buildParam(index int, param *cparser.Param, defaultValue ssa.Value) {
+	recoverRange := b.SetRange(param)
+	defer recoverRange()
+
+	name := param.Name()
+	if name == "" {
+		// unnamed parameter is still counted for argument position
+		name = fmt.Sprintf("$param%d", index)
+	}
+	p := b.NewParam(name)
+	if typ := b.typeOf(param.Type, param.Decl); typ.GetTypeKind() == ssa.ClassBluePrintTypeKind {
+		// the method of object is called by class type
+		p.SetType(typ)
+	}
+	if !utils.IsNil(defaultValue) {
+		p.SetDefault(defaultValue)
+	}
+	b.vars[name] = &varInfo{spec: param.Type, decl: param.Decl}
+}
+
+// buildLazyFunction build the function defined in source before it is called,
+// so the side effect and return of function is known.
+func (b *astbuilder) buildLazyFunction(value ssa.Value) {
+	if f, ok := ssa.ToFunction(value); ok && !f.IsExtern() {
+		f.Build()
+	}
+}
+
+// ================================ declaration ================================
+
+func (b *astbuilder) buildDecls(decls []cparser.Decl) {
+	for _, decl := range decls {
+		switch d := decl.(type) {
+		case *cparser.Namespace:
+			b.buildDecls(d.Decls)
+		case *cparser.FuncDef:
+			if f, ok := b.defined[d]; ok {
+				f.Build()
+			}
+		case *cparser.VarDecl:
+			b.buildVarDecl(d, true)
+		case *cparser.EnumDecl:
+			b.buildEnum(d, true)
+		}
+	}
+}
+
+func (b *astbuilder) buildVarDecl(d *cparser.VarDecl, global bool) {
+	recoverRange := b.SetRange(d)
+	defer recoverRange()
+
+	spec := d.Type
+	if spec == nil {
+		return
+	}
+	if spec.Enum != nil {
+		b.buildEnum(spec.Enum, global)
+	}
+	if spec.Typedef {
+		return
+	}
+	for _, declarator := range d.Declarators {
+		if declarator.Name == "" {
+			continue
+		}
+		if declarator.IsFunction() {
+			// function prototype
+			continue
+		}
+		if spec.Extern && declarator.Init == nil {
+			// the variable is defined in other file
+			continue
+		}
+		b.buildVariable(spec, declarator, global)
+	}
+}
+
+func (b *astbuilder) buildVariable(spec *cparser.TypeSpec, declarator *cparser.Declarator, global bool) {
+	recoverRange := b.SetRange(declarator)
+	defer recoverRange()
+
+	value := b.buildInitValue(spec, declarator)
+	info := &varInfo{spec: spec, decl: declarator}
+	var variable *ssa.Variable
+	if global {
+		variable = b.CreateVariable(declarator.Name)
+		b.globals[declarator.Name] = info
+	} else {
+		variable = b.CreateLocalVariable(declarator.Name)
+		b.vars[declarator.Name] = info
+	}
+	b.AssignVariable(variable, value)
+}
+
+// buildInitValue build the initial value of variable, the variable without
+// initializer is uninitialized, the struct and array is an empty object.
+func (b *astbuilder) buildInitValue(spec *cparser.TypeSpec, declarator *cparser.Declarator) ssa.Value {
+	class := b.getClass(spec)
+	for _, dim := range declarator.Array {
+		if dim != nil {
+			b.buildExpr(dim)
+		}
+	}
+	switch {
+	case declarator.Init != nil:
+		if list, ok := declarator.Init.(*cparser.InitList); ok {
+			var fields []string
+			if class != nil && declarator.Pointer == 0 && len(declarator.Array) == 0 {
+				fields = b.structFields(class.Name)
+			}
+			var elemFields []string
+			if class != nil && len(declarator.Array) > 0 {
+				// array of struct
+				elemFields = b.structFields(class.Name)
+			}
+			obj := b.buildInitList(list, fields, elemFields)
+			if fields != nil {
+				obj.SetType(class)
+			}
+			return obj
+		}
+		return b.buildExpr(declarator.Init)
+	case declarator.HasArgs:
+		args := b.buildExprList(declarator.Args)
+		if class != nil && !declarator.IsPointer() {
+			return b.newInstance(class, args)
+		}
+		switch len(args) {
+		case 0:
+			return b.EmitValueOnlyDeclare(declarator.Name)
+		case 1:
+			return args[0]
+		default:
+			// `std::vector<std::string> args(argv, argv + argc)`
+			obj := b.EmitMakeWithoutType(nil, nil)
+			b.fillContainer(obj, args)
+			return obj
+		}
+	case class != nil && !declarator.IsPointer():
+		return b.newInstance(class, nil)
+	case len(declarator.Array) > 0:
+		return b.EmitEmptyContainer()
+	default:
+		return b.EmitValueOnlyDeclare(declarator.Name)
+	}
+}
+
+func (b *astbuilder) buildEnum(d *cparser.EnumDecl, global bool) {
+	recoverRange := b.SetRange(d)
+	defer recoverRange()
+
+	var next int64
+	for _, item := range d.Items {
+		var value ssa.Value
+		if item.Value != nil {
+			value = b.buildExpr(item.Value)
+			if c, ok := ssa.ToConst(value); ok && c.IsNumber() {
+				next = c.Number()
+			}
+		} else {
+			value = b.EmitConstInst(next)
+		}
+		next++
+		var variable *ssa.Variable
+		if global {
+			variable = b.CreateVariable(item.Name)
+		} else {
+			variable = b.CreateLocalVariable(item.Name)
+			b.vars[item.Name] = &varInfo{}
+		}
+		b.AssignVariable(variable, value)
+	}
+}
+
+// ================================ type ================================
+
+func shortName(name string) string {
+	if index := strings.LastIndex(name, "::"); index >= 0 {
+		return name[index+2:]
+	}
+	return name
+}
+
+// getClass get the class blueprint of type, the typedef name is resolved
+func (b *astbuilder) getClass(spec *cparser.TypeSpec) *ssa.ClassBluePrint {
+	if spec == nil {
+		return nil
+	}
+	name := structName(spec)
+	if name == "" {
+		name = spec.Name
+	}
+	name = shortName(name)
+	if alias, ok := b.typedefs[name]; ok {
+		name = alias
+	}
+	if name == "" {
+		return nil
+	}
+	if _, ok := b.structs[name]; !ok {
+		return nil
+	}
+	return b.GetClassBluePrint(name)
+}
+
+// structFields return the field names of struct in order, used by initializer list
+func (b *astbuilder) structFields(name string) []string {
+	s, ok := b.structs[name]
+	if !ok {
+		return nil
+	}
+	fields := make([]string, 0, len(s.Fields))
+	for _, field := range s.Fields {
+		if field.Type != nil && field.Type.Static {
+			continue
+		}
+		for _, declarator := range field.Declarators {
+			if declarator.Name != "" && !declarator.IsFunction() {
+				fields = append(fields, declarator.Name)
+			}
+		}
+	}
+	return fields
+}
+
+// isBufferField check the struct field is char array or pointer, it may be
+// written by the external function such as `strcpy(req->path, src)`
+func (b *astbuilder) isBufferField(name string) bool {
+	for _, s := range b.structs {
+		for _, field := range s.Fields {
+			for _, declarator := range field.Declarators {
+				if declarator.Name != name {
+					continue
+				}
+				info := &varInfo{spec: field.Type, decl: declarator}
+				if info.isBuffer() && b.getClass(field.Type) == nil {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (b *astbuilder) typeOf(spec *cparser.TypeSpec, declarator *cparser.Declarator) ssa.Type {
+	if spec == nil {
+		return ssa.CreateAnyType()
+	}
+	level := 0
+	if declarator != nil {
+		level = declarator.Pointer + len(declarator.Array)
+	}
+	if class := b.getClass(spec); class != nil {
+		if level <= 1 {
+			return class
+		}
+		return ssa.CreateAnyType()
+	}
+	name := spec.Name
+	switch name {
+	case "std::string", "string", "std::wstring", "wstring":
+		if level == 0 {
+			return ssa.CreateStringType()
+		}
+	}
+	words := strings.Fields(name)
+	if len(words) == 0 {
+		return ssa.CreateAnyType()
+	}
+	switch words[len(words)-1] {
+	case "char", "wchar_t", "char16_t", "char32_t":
+		if level == 1 {
+			return ssa.CreateStringType()
+		}
+		if level == 0 {
+			return ssa.CreateNumberType()
+		}
+	case "bool", "_Bool":
+		if level == 0 {
+			return ssa.CreateBooleanType()
+		}
+	case "int", "short", "long", "signed", "unsigned", "float", "double",
+		"size_t", "ssize_t", "int8_t", "int16_t", "int32_t", "int64_t",
+		"uint8_t", "uint16_t", "uint32_t", "uint64_t":
+		if level == 0 {
+			return ssa.CreateNumberType()
+		}
+	}
+	return ssa.CreateAnyType()
+}
@@ -0,0 +1,643 @@
+package c2ssa
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/utils"
+	cparser "github.com/yaklang/yaklang/common/yak/c/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func binaryOpcode(op string) (ssa.BinaryOpcode, bool) {
+	switch op {
+	case "+":
+		return ssa.OpAdd, true
+	case "-":
+		return ssa.OpSub, true
+	case "*":
+		return ssa.OpMul, true
+	case "/":
+		return ssa.OpDiv, true
+	case "%":
+		return ssa.OpMod, true
+	case "<<":
+		return ssa.OpShl, true
+	case ">>":
+		return ssa.OpShr, true
+	case "&":
+		return ssa.OpAnd, true
+	case "|":
+		return ssa.OpOr, true
+	case "^":
+		return ssa.OpXor, true
+	case "&&":
+		return ssa.OpLogicAnd, true
+	case "||":
+		return ssa.OpLogicOr, true
+	case "<":
+		return ssa.OpLt, true
+	case ">":
+		return ssa.OpGt, true
+	case "<=":
+		return ssa.OpLtEq, true
+	case ">=":
+		return ssa.OpGtEq, true
+	case "==":
+		return ssa.OpEq, true
+	case "!=":
+		return ssa.OpNotEq, true
+	}
+	return "", false
+}
+
+func (b *astbuilder) buildExpr(expr cparser.Expr) ssa.Value {
+	if expr == nil {
+		return b.EmitUndefined("")
+	}
+	recoverRange := b.SetRange(expr)
+	defer recoverRange()
+
+	var ret ssa.Value
+	switch e := expr.(type) {
+	case *cparser.Ident:
+		ret = b.buildIdent(e.Name)
+	case *cparser.Literal:
+		ret = b.buildLiteral(e)
+	case *cparser.Unary:
+		ret = b.buildUnary(e)
+	case *cparser.Postfix:
+		value := b.buildExpr(e.X)
+		b.assignExpr(e.X, b.EmitBinOp(incOpcode(e.Op), value, b.EmitConstInst(1)))
+		ret = value
+	case *cparser.Binary:
+		ret = b.buildBinary(e)
+	case *cparser.Assign:
+		ret = b.buildAssign(e)
+	case *cparser.Cond:
+		ret = b.buildCond(e)
+	case *cparser.Call:
+		ret = b.buildCall(e)
+	case *cparser.Index:
+		obj := b.buildExpr(e.X)
+		ret = b.ReadMemberCallVariable(obj, b.buildExpr(e.Index))
+	case *cparser.Member:
+		obj := b.buildExpr(e.X)
+		ret = b.ReadMemberCallVariable(obj, b.EmitConstInst(e.Name))
+	case *cparser.Cast:
+		if list, ok := e.X.(*cparser.InitList); ok {
+			// compound literal `(struct point){1, 2}`
+			ret = b.buildCompoundLiteral(e.Type, e.Pointer, list)
+		} else {
+			ret = b.buildExpr(e.X)
+		}
+	case *cparser.Sizeof:
+		// sizeof is compile time constant, the operand is not evaluated
+		ret = b.EmitUndefined("sizeof")
+	case *cparser.InitList:
+		ret = b.buildInitList(e, nil, nil)
+	case *cparser.New:
+		ret = b.buildNew(e)
+	case *cparser.Delete:
+		b.buildExpr(e.X)
+		ret = b.EmitUndefined("")
+	case *cparser.Throw:
+		if e.X != nil {
+			ret = b.buildExpr(e.X)
+		} else {
+			// rethrow
+			ret = b.EmitUndefined("")
+		}
+		b.EmitPanic(ret)
+	case *cparser.Lambda:
+		ret = b.buildLambda(e)
+	case *cparser.StmtExpr:
+		ret = b.buildStmtExpr(e)
+	default:
+		b.NewError(ssa.Error, TAG, ExpressionNotSupport(nodeName(expr)))
+	}
+	if utils.IsNil(ret) {
+		ret = b.EmitUndefined("")
+	}
+	return ret
+}
+
+// nodeName is the ast node type name for error message, such as `Lambda`
+func nodeName(node cparser.Node) string {
+	name := fmt.Sprintf("%T", node)
+	if index := strings.LastIndex(name, "."); index >= 0 {
+		name = name[index+1:]
+	}
+	return name
+}
+
+func (b *astbuilder) buildExprList(exprs []cparser.Expr) []ssa.Value {
+	ret := make([]ssa.Value, 0, len(exprs))
+	for _, expr := range exprs {
+		ret = append(ret, b.buildExpr(expr))
+	}
+	return ret
+}
+
+func (b *astbuilder) buildIdent(name string) ssa.Value {
+	switch name {
+	case "NULL", "nullptr":
+		return b.EmitConstInstNil()
+	case "true":
+		return b.EmitConstInst(true)
+	case "false":
+		return b.EmitConstInst(false)
+	}
+	if _, ok := b.vars[name]; ok {
+		return b.ReadValue(name)
+	}
+	if b.isField(name) {
+		// the field of class used without `this`
+		return b.ReadMemberCallVariable(b.ReadValue("this"), b.EmitConstInst(name))
+	}
+	name = shortName(name)
+	if f := b.getFunction(name); f != nil {
+		// the function may be used as callback, build it before use
+		b.buildLazyFunction(f)
+		return f
+	}
+	if value := b.PeekValue(name); !utils.IsNil(value) {
+		return value
+	}
+	// the symbol defined out of project, such as libc function, is not the
+	// free value captured from file scope
+	undefined := b.EmitUndefined(name)
+	b.AssignVariable(b.CreateVariable(name), undefined)
+	return undefined
+}
+
+// isField check the name is the field of current class
+func (b *astbuilder) isField(name string) bool {
+	if b.class == nil {
+		return false
+	}
+	return hasMember(b.class, name)
+}
+
+func hasMember(class *ssa.ClassBluePrint, name string) bool {
+	if _, ok := class.NormalMember[name]; ok {
+		return true
+	}
+	for _, parent := range class.ParentClass {
+		if hasMember(parent, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// isMethod check the name is the method of current class
+func (b *astbuilder) isMethod(name string) bool {
+	if b.class == nil {
+		return false
+	}
+	return b.class.GetMethodAndStaticMethod(name, true) != nil
+}
+
+func (b *astbuilder) buildLiteral(e *cparser.Literal) ssa.Value {
+	switch e.Kind {
+	case cparser.NUMBER:
+		return b.EmitConstInst(parseNumber(e.Value))
+	case cparser.CHAR, cparser.STRING:
+		return b.EmitConstInst(unquote(e.Value))
+	}
+	return b.EmitConstInst(e.Value)
+}
+
+// parseNumber parse c number literal, the suffix such as `u`, `l`, `f` is ignored
+func parseNumber(s string) any {
+	s = strings.ReplaceAll(s, "'", "")
+	lower := strings.ToLower(s)
+	isHex := strings.HasPrefix(lower, "0x")
+	trimmed := strings.TrimRight(lower, "ul")
+	if !isHex {
+		trimmed = strings.TrimRight(trimmed, "f")
+	}
+	if i, err := strconv.ParseInt(trimmed, 0, 64); err == nil {
+		return i
+	}
+	if u, err := strconv.ParseUint(trimmed, 0, 64); err == nil {
+		return int64(u)
+	}
+	if f, err := strconv.ParseFloat(trimmed, 64); err == nil {
+		return f
+	}
+	return s
+}
+
+// unquote get the content of char and string literal, with encoding prefix
+// `L"..."`, `u8"..."` and raw string `R"delim(...)delim"`
+func unquote(s string) string {
+	index := strings.IndexAny(s, "\"'")
+	if index < 0 || len(s) < index+2 {
+		return s
+	}
+	prefix, body := s[:index], s[index:]
+	quote := body[0]
+	if strings.HasSuffix(prefix, "R") && quote == '"' {
+		body = body[1 : len(body)-1]
+		if open := strings.Index(body, "("); open >= 0 {
+			delim := body[:open]
+			return strings.TrimSuffix(body[open+1:], ")"+delim)
+		}
+		return body
+	}
+	if body[len(body)-1] != quote {
+		return body[1:]
+	}
+	content := body[1 : len(body)-1]
+	if ret, err := strconv.Unquote(`"` + strings.ReplaceAll(content, `\'`, `'`) + `"`); err == nil {
+		return ret
+	}
+	return content
+}
+
+func incOpcode(op string) ssa.BinaryOpcode {
+	if op == "--" {
+		return ssa.OpSub
+	}
+	return ssa.OpAdd
+}
+
+func (b *astbuilder) buildUnary(e *cparser.Unary) ssa.Value {
+	switch e.Op {
+	case "-":
+		return b.EmitUnOp(ssa.OpNeg, b.buildExpr(e.X))
+	case "+":
+		return b.EmitUnOp(ssa.OpPlus, b.buildExpr(e.X))
+	case "!":
+		return b.EmitUnOp(ssa.OpNot, b.buildExpr(e.X))
+	case "~":
+		return b.EmitUnOp(ssa.OpBitwiseNot, b.buildExpr(e.X))
+	case "*":
+		// `*p` is the first element of pointer
+		return b.ReadMemberCallVariable(b.buildExpr(e.X), b.EmitConstInst(0))
+	case "&":
+		// the address of variable is the variable self, data flow through
+		// the pointer is kept by the member and output argument of call
+		return b.buildExpr(e.X)
+	case "++", "--":
+		value := b.EmitBinOp(incOpcode(e.Op), b.buildExpr(e.X), b.EmitConstInst(1))
+		b.assignExpr(e.X, value)
+		return value
+	}
+	b.NewError(ssa.Error, TAG, UnaryOperatorNotSupport(e.Op))
+	return b.buildExpr(e.X)
+}
+
+func (b *astbuilder) buildBinary(e *cparser.Binary) ssa.Value {
+	if e.Op == "," {
+		b.buildExpr(e.X)
+		return b.buildExpr(e.Y)
+	}
+	x := b.buildExpr(e.X)
+	y := b.buildExpr(e.Y)
+	opcode, ok := binaryOpcode(e.Op)
+	if !ok {
+		b.NewError(ssa.Error, TAG, BinaryOperatorNotSupport(e.Op))
+		return x
+	}
+	return b.EmitBinOp(opcode, x, y)
+}
+
+func (b *astbuilder) buildAssign(e *cparser.Assign) ssa.Value {
+	if e.Op == "" {
+		value := b.buildExpr(e.Y)
+		b.assignExpr(e.X, value)
+		return value
+	}
+	x := b.buildExpr(e.X)
+	y := b.buildExpr(e.Y)
+	opcode, ok := binaryOpcode(e.Op)
+	if !ok {
+		b.NewError(ssa.Error, TAG, BinaryOperatorNotSupport(e.Op+"="))
+		return y
+	}
+	value := b.EmitBinOp(opcode, x, y)
+	b.assignExpr(e.X, value)
+	return value
+}
+
+// assignExpr bind the value to assignment target, the pointer dereference
+// `*p = v` is the first element of pointer.
+func (b *astbuilder) assignExpr(target cparser.Expr, value ssa.Value) {
+	recoverRange := b.SetRange(target)
+	defer recoverRange()
+
+	switch t := target.(type) {
+	case *cparser.Ident:
+		name := t.Name
+		if _, ok := b.vars[name]; !ok && b.isField(name) {
+			variable := b.CreateMemberCallVariable(b.ReadValue("this"), b.EmitConstInst(name))
+			b.AssignVariable(variable, value)
+			return
+		}
+		variable := b.CreateVariable(shortName(name))
+		b.AssignVariable(variable, value)
+	case *cparser.Member:
+		obj := b.buildExpr(t.X)
+		variable := b.CreateMemberCallVariable(obj, b.EmitConstInst(t.Name))
+		b.AssignVariable(variable, value)
+	case *cparser.Index:
+		obj := b.buildExpr(t.X)
+		variable := b.CreateMemberCallVariable(obj, b.buildExpr(t.Index))
+		b.AssignVariable(variable, value)
+	case *cparser.Unary:
+		switch t.Op {
+		case "*":
+			obj := b.buildExpr(t.X)
+			variable := b.CreateMemberCallVariable(obj, b.EmitConstInst(0))
+			b.AssignVariable(variable, value)
+		case "&":
+			b.assignExpr(t.X, value)
+		default:
+			b.NewError(ssa.Error, TAG, AssignTargetNotSupport(t.Op))
+		}
+	case *cparser.Cast:
+		b.assignExpr(t.X, value)
+	case *cparser.Binary:
+		if t.Op == "," {
+			b.buildExpr(t.X)
+			b.assignExpr(t.Y, value)
+			return
+		}
+		b.NewError(ssa.Error, TAG, AssignTargetNotSupport(nodeName(target)))
+	case *cparser.Cond:
+		// `(c ? a : b) = v`, both branch may be assigned
+		b.buildExpr(t.Cond)
+		if t.Then != nil {
+			b.assignExpr(t.Then, value)
+		}
+		b.assignExpr(t.Else, value)
+	default:
+		b.NewError(ssa.Error, TAG, AssignTargetNotSupport(nodeName(target)))
+	}
+}
+
+func (b *astbuilder) buildCond(e *cparser.Cond) ssa.Value {
+	if e.Then == nil {
+		// gnu `a ?: b`, same as `a ? a : b`
+		cond := b.buildExpr(e.Cond)
+		return b.handlerJumpExpression(
+			func() ssa.Value { return cond },
+			func() ssa.Value { return cond },
+			func() ssa.Value { return b.buildExpr(e.Else) },
+		)
+	}
+	return b.handlerJumpExpression(
+		func() ssa.Value { return b.buildExpr(e.Cond) },
+		func() ssa.Value { return b.buildExpr(e.Then) },
+		func() ssa.Value { return b.buildExpr(e.Else) },
+	)
+}
+
+/*
+handlerJumpExpression build conditional expression with phi
+
+	if cond {
+		id = trueExpr
+	} else {
+		id = falseExpr
+	}
+	id = phi[trueExpr, falseExpr]
+*/
+func (b *astbuilder) handlerJumpExpression(cond, trueExpr, falseExpr func() ssa.Value) ssa.Value {
+	id := uuid.NewString()
+	variable := b.CreateVariable(id)
+	b.AssignVariable(variable, b.EmitValueOnlyDeclare(id))
+	ifb := b.CreateIfBuilder()
+	ifb.AppendItem(
+		cond,
+		func() {
+			variable := b.CreateVariable(id)
+			b.AssignVariable(variable, trueExpr())
+		},
+	)
+	ifb.SetElse(func() {
+		variable := b.CreateVariable(id)
+		b.AssignVariable(variable, falseExpr())
+	})
+	ifb.Build()
+	return b.ReadValue(id)
+}
+
+func (b *astbuilder) buildCall(e *cparser.Call) ssa.Value {
+	fn := e.Func
+	if u, ok := fn.(*cparser.Unary); ok && u.Op == "*" {
+		// `(*fp)(args)` is same as `fp(args)`
+		fn = u.X
+	}
+	args := b.buildExprList(e.Args)
+
+	var target ssa.Value
+	switch f := fn.(type) {
+	case *cparser.Ident:
+		if _, ok := b.vars[f.Name]; ok {
+			break
+		}
+		if b.isMethod(f.Name) {
+			// method of class called without `this`
+			target = b.ReadMemberCallMethodVariable(b.ReadValue("this"), b.EmitConstInst(f.Name))
+			break
+		}
+		name := shortName(f.Name)
+		if _, ok := b.structs[name]; ok {
+			// c++ functional cast and temporary object `Request(path)`
+			if class := b.GetClassBluePrint(name); class != nil {
+				return b.newInstance(class, args)
+			}
+		}
+		if function := b.getFunction(name); function != nil {
+			target = function
+		}
+	case *cparser.Member:
+		// `obj.method(...)`, the method of class need the object as first argument
+		obj := b.buildExpr(f.X)
+		key := b.EmitConstInst(f.Name)
+		if typ := obj.GetType(); typ != nil && typ.GetTypeKind() == ssa.ClassBluePrintTypeKind {
+			target = b.ReadMemberCallMethodVariable(obj, key)
+		} else {
+			target = b.ReadMemberCallVariable(obj, key)
+		}
+	}
+	if utils.IsNil(target) {
+		target = b.buildExpr(fn)
+	}
+	b.buildLazyFunction(target)
+
+	ret := b.EmitCall(b.NewCall(target, args))
+	if _, ok := ssa.ToFunction(target); !ok {
+		b.assignOutputArgs(e.Args, ret)
+	}
+	return ret
+}
+
+// assignOutputArgs assign the result of external call to the buffer argument,
+// the buffer is written by callee in c, such as `strcpy(dst, src)`,
+// `recv(fd, buf, len, 0)` and `scanf("%d", &n)`.
+func (b *astbuilder) assignOutputArgs(args []cparser.Expr, ret ssa.Value) {
+	for _, arg := range args {
+		switch a := arg.(type) {
+		case *cparser.Unary:
+			if a.Op != "&" {
+				continue
+			}
+			switch x := a.X.(type) {
+			case *cparser.Ident:
+				// the struct passed by address keep its member
+				if info := b.getVar(x.Name); info != nil && b.getClass(info.spec) == nil {
+					b.assignExpr(x, ret)
+				}
+			case *cparser.Member, *cparser.Index:
+				b.assignExpr(x, ret)
+			}
+		case *cparser.Ident:
+			if info := b.getVar(a.Name); info.isBuffer() && b.getClass(info.spec) == nil {
+				b.assignExpr(a, ret)
+			}
+		case *cparser.Member:
+			if b.isBufferField(a.Name) {
+				b.assignExpr(a, ret)
+			}
+		}
+	}
+}
+
+func (b *astbuilder) getVar(name string) *varInfo {
+	if info, ok := b.vars[name]; ok {
+		return info
+	}
+	return b.globals[name]
+}
+
+// newInstance create object of class and call the constructor
+func (b *astbuilder) newInstance(class *ssa.ClassBluePrint, args []ssa.Value) ssa.Value {
+	obj := b.EmitMakeWithoutType(nil, nil)
+	obj.SetType(class)
+	constructor := class.GetConstructOrDestruct("constructor")
+	if utils.IsNil(constructor) {
+		return obj
+	}
+	b.buildLazyFunction(constructor)
+	b.EmitCall(b.NewCall(constructor, append([]ssa.Value{obj}, args...)))
+	return obj
+}
+
+func (b *astbuilder) buildNew(e *cparser.New) ssa.Value {
+	args := b.buildExprList(e.Args)
+	if e.Array != nil {
+		b.buildExpr(e.Array)
+		return b.EmitEmptyContainer()
+	}
+	if class := b.getClass(e.Type); class != nil {
+		return b.newInstance(class, args)
+	}
+	if len(args) == 1 {
+		// `new int(x)`, `new std::string(s)`
+		return args[0]
+	}
+	return b.EmitEmptyContainer()
+}
+
+func (b *astbuilder) buildCompoundLiteral(spec *cparser.TypeSpec, pointer int, list *cparser.InitList) ssa.Value {
+	class := b.getClass(spec)
+	if class == nil || pointer > 0 {
+		return b.buildInitList(list, nil, nil)
+	}
+	obj := b.buildInitList(list, b.structFields(class.Name), nil)
+	obj.SetType(class)
+	return obj
+}
+
+// buildInitList build the initializer list `{...}`, the fields is the struct
+// field names by position, and elemFields is for the element of struct array.
+func (b *astbuilder) buildInitList(list *cparser.InitList, fields []string, elemFields []string) ssa.Value {
+	keys := make([]ssa.Value, 0, len(list.Elems))
+	values := make([]ssa.Value, 0, len(list.Elems))
+	position := 0
+	for _, elem := range list.Elems {
+		recoverRange := b.SetRange(elem)
+		var key ssa.Value
+		switch {
+		case elem.Field != "":
+			key = b.EmitConstInst(elem.Field)
+			for i, field := range fields {
+				if field == elem.Field {
+					position = i
+				}
+			}
+		case elem.Index != nil:
+			key = b.buildExpr(elem.Index)
+			if c, ok := ssa.ToConst(key); ok && c.IsNumber() {
+				position = int(c.Number())
+			}
+		case position < len(fields):
+			key = b.EmitConstInst(fields[position])
+		default:
+			key = b.EmitConstInst(int64(position))
+		}
+		position++
+
+		var value ssa.Value
+		if sub, ok := elem.Value.(*cparser.InitList); ok && elemFields != nil {
+			value = b.buildInitList(sub, elemFields, nil)
+		} else {
+			value = b.buildExpr(elem.Value)
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+		recoverRange()
+	}
+	return b.InterfaceAddFieldBuild(len(values),
+		func(i int) ssa.Value { return keys[i] },
+		func(i int) ssa.Value { return values[i] },
+	)
+}
+
+// fillContainer assign the values to container by index
+func (b *astbuilder) fillContainer(container ssa.Value, values []ssa.Value) {
+	for i, value := range values {
+		variable := b.CreateMemberCallVariable(container, b.EmitConstInst(int64(i)))
+		b.AssignVariable(variable, value)
+	}
+}
+
+func (b *astbuilder) buildLambda(e *cparser.Lambda) ssa.Value {
+	newFunc := b.NewFunc("")
+	vars := b.vars
+	b.buildFunctionBody(newFunc, e.Params, nil, func() {
+		// the variable captured by lambda
+		for name, info := range vars {
+			b.vars[name] = info
+		}
+		b.buildStatements(e.Body.Items)
+	})
+	b.vars = vars
+	return newFunc
+}
+
+// buildStmtExpr build gnu statement expression, the value is the last expression
+func (b *astbuilder) buildStmtExpr(e *cparser.StmtExpr) ssa.Value {
+	var ret ssa.Value
+	b.BuildSyntaxBlock(func() {
+		items := e.Body.Items
+		if n := len(items); n > 0 {
+			if last, ok := items[n-1].(*cparser.ExprStmt); ok && last.X != nil {
+				b.buildStatements(items[:n-1])
+				if !b.IsBlockFinish() {
+					ret = b.buildExpr(last.X)
+				}
+				return
+			}
+		}
+		b.buildStatements(items)
+	})
+	return ret
+}
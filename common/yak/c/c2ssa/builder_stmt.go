@@ -0,0 +1,267 @@
+package c2ssa
+
+import (
+	cparser "github.com/yaklang/yaklang/common/yak/c/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *astbuilder) buildStatements(stmts []cparser.Stmt) {
+	for _, stmt := range stmts {
+		if b.IsBlockFinish() {
+			// unreachable code after return / break / continue
+			return
+		}
+		b.buildStatement(stmt)
+	}
+}
+
+func (b *astbuilder) buildStatement(stmt cparser.Stmt) {
+	if stmt == nil {
+		return
+	}
+	recoverRange := b.SetRange(stmt)
+	defer recoverRange()
+
+	switch s := stmt.(type) {
+	case *cparser.Compound:
+		b.BuildSyntaxBlock(func() {
+			b.buildStatements(s.Items)
+		})
+	case *cparser.ExprStmt:
+		if s.X != nil {
+			b.buildExpr(s.X)
+		}
+	case *cparser.DeclStmt:
+		b.buildDeclStmt(s)
+	case *cparser.If:
+		b.buildIf(s)
+	case *cparser.While:
+		b.buildWhile(s)
+	case *cparser.DoWhile:
+		b.buildDoWhile(s)
+	case *cparser.For:
+		b.buildFor(s)
+	case *cparser.RangeFor:
+		b.buildRangeFor(s)
+	case *cparser.Switch:
+		b.buildSwitch(s)
+	case *cparser.Case:
+		// `case` label out of switch body (duff's device), only the body is kept
+		b.buildStatement(s.Body)
+	case *cparser.Return:
+		if s.X != nil {
+			b.EmitReturn([]ssa.Value{b.buildExpr(s.X)})
+		} else {
+			b.EmitReturn(nil)
+		}
+	case *cparser.Break:
+		if !b.Break() {
+			b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+		}
+	case *cparser.Continue:
+		if !b.Continue() {
+			b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+		}
+	case *cparser.Goto:
+		// goto is ignored, the statements after label are built in order,
+		// the data flow of jump back is missed
+	case *cparser.Labeled:
+		b.buildStatement(s.Body)
+	case *cparser.Try:
+		b.buildTry(s)
+	}
+}
+
+func (b *astbuilder) buildDeclStmt(stmt *cparser.DeclStmt) {
+	switch d := stmt.Decl.(type) {
+	case *cparser.VarDecl:
+		b.declareType(d)
+		b.buildVarDecl(d, false)
+	case *cparser.StructDecl:
+		b.declareStruct(d.Name, d)
+	case *cparser.EnumDecl:
+		b.buildEnum(d, false)
+	}
+}
+
+// buildCondition build the condition of statement, the empty condition is true
+func (b *astbuilder) buildCondition(cond cparser.Expr) ssa.Value {
+	if cond == nil {
+		return b.EmitConstInst(true)
+	}
+	recoverRange := b.SetRange(cond)
+	defer recoverRange()
+	return b.buildExpr(cond)
+}
+
+func (b *astbuilder) buildIf(stmt *cparser.If) {
+	build := func() {
+		builder := b.CreateIfBuilder()
+		builder.AppendItem(
+			func() ssa.Value {
+				recoverRange := b.SetRange(stmt.Cond)
+				b.AppendBlockRange()
+				recoverRange()
+				return b.buildCondition(stmt.Cond)
+			},
+			func() {
+				b.buildStatement(stmt.Then)
+			},
+		)
+		if stmt.Else != nil {
+			builder.SetElse(func() {
+				b.buildStatement(stmt.Else)
+			})
+		}
+		builder.Build()
+	}
+	if stmt.Init == nil {
+		build()
+		return
+	}
+	// `if (init; cond)`, the variable of init is only visible in if statement
+	b.BuildSyntaxBlock(func() {
+		b.buildStatement(stmt.Init)
+		build()
+	})
+}
+
+func (b *astbuilder) buildWhile(stmt *cparser.While) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		return b.buildCondition(stmt.Cond)
+	})
+	loop.SetBody(func() {
+		b.buildStatement(stmt.Body)
+	})
+	loop.Finish()
+}
+
+// buildDoWhile build `do { body } while (cond)` as
+//
+//	for { body; if !cond { break } }
+func (b *astbuilder) buildDoWhile(stmt *cparser.DoWhile) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		return b.EmitConstInst(true)
+	})
+	loop.SetBody(func() {
+		b.buildStatement(stmt.Body)
+		if b.IsBlockFinish() {
+			return
+		}
+		builder := b.CreateIfBuilder()
+		builder.AppendItem(
+			func() ssa.Value {
+				return b.EmitUnOp(ssa.OpNot, b.buildCondition(stmt.Cond))
+			},
+			func() {
+				b.Break()
+			},
+		)
+		builder.Build()
+	})
+	loop.Finish()
+}
+
+func (b *astbuilder) buildFor(stmt *cparser.For) {
+	loop := b.CreateLoopBuilder()
+	if stmt.Init != nil {
+		loop.SetFirst(func() []ssa.Value {
+			b.buildStatement(stmt.Init)
+			return nil
+		})
+	}
+	loop.SetCondition(func() ssa.Value {
+		return b.buildCondition(stmt.Cond)
+	})
+	if stmt.Post != nil {
+		loop.SetThird(func() []ssa.Value {
+			return []ssa.Value{b.buildExpr(stmt.Post)}
+		})
+	}
+	loop.SetBody(func() {
+		b.buildStatement(stmt.Body)
+	})
+	loop.Finish()
+}
+
+func (b *astbuilder) buildRangeFor(stmt *cparser.RangeFor) {
+	loop := b.CreateLoopBuilder()
+	var iter ssa.Value
+	loop.SetFirst(func() []ssa.Value {
+		iter = b.buildExpr(stmt.Range)
+		return []ssa.Value{iter}
+	})
+	loop.SetCondition(func() ssa.Value {
+		_, field, ok := b.EmitNext(iter, false)
+		if stmt.Decl != nil {
+			for _, declarator := range stmt.Decl.Declarators {
+				variable := b.CreateLocalVariable(declarator.Name)
+				b.AssignVariable(variable, field)
+				b.vars[declarator.Name] = &varInfo{spec: stmt.Decl.Type, decl: declarator}
+			}
+		}
+		return ok
+	})
+	loop.SetBody(func() {
+		b.buildStatement(stmt.Body)
+	})
+	loop.Finish()
+}
+
+func (b *astbuilder) buildSwitch(stmt *cparser.Switch) {
+	var cases []*cparser.CaseClause
+	var defaultCase *cparser.CaseClause
+	for _, clause := range stmt.Cases {
+		if clause.IsDefault && len(clause.Values) == 0 {
+			defaultCase = clause
+			continue
+		}
+		cases = append(cases, clause)
+	}
+
+	switchBuilder := b.BuildSwitch()
+	// case fall through to next case without break
+	switchBuilder.AutoBreak = false
+	switchBuilder.BuildCondition(func() ssa.Value {
+		return b.buildCondition(stmt.Cond)
+	})
+	switchBuilder.BuildCaseSize(len(cases))
+	switchBuilder.SetCase(func(i int) []ssa.Value {
+		return b.buildExprList(cases[i].Values)
+	})
+	switchBuilder.BuildBody(func(i int) {
+		b.buildStatements(cases[i].Body)
+	})
+	if defaultCase != nil {
+		switchBuilder.BuildDefault(func() {
+			b.buildStatements(defaultCase.Body)
+		})
+	}
+	switchBuilder.Finish()
+}
+
+func (b *astbuilder) buildTry(stmt *cparser.Try) {
+	tryBuilder := b.BuildTry()
+	tryBuilder.BuildTryBlock(func() {
+		b.buildStatements(stmt.Body.Items)
+	})
+	for _, handler := range stmt.Handlers {
+		handler := handler
+		tryBuilder.BuildErrorCatch(func() string {
+			if handler.Param == nil {
+				// `catch (...)`
+				return ""
+			}
+			name := handler.Param.Name()
+			if name != "" {
+				b.vars[name] = &varInfo{spec: handler.Param.Type, decl: handler.Param.Decl}
+			}
+			return name
+		}, func() {
+			b.buildStatements(handler.Body.Items)
+		})
+	}
+	tryBuilder.Finish()
+}
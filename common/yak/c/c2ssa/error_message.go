@@ -0,0 +1,33 @@
+package c2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "cast"
+
+func UnaryOperatorNotSupport(op string) string {
+	return fmt.Sprintf("unary operator not support: %s", op)
+}
+
+func BinaryOperatorNotSupport(op string) string {
+	return fmt.Sprintf("binary operator not support: %s", op)
+}
+
+func AssignTargetNotSupport(target string) string {
+	return fmt.Sprintf("cannot assign to %s", target)
+}
+
+func UnexpectedBreakStmt() string {
+	return "break statement not within loop or switch"
+}
+
+func UnexpectedContinueStmt() string {
+	return "continue statement not within a loop"
+}
+
+func ExpressionNotSupport(expr string) string {
+	return fmt.Sprintf("expression not support: %s", expr)
+}
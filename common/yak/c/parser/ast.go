@@ -0,0 +1,430 @@
+package cparser
+
+// Node is the common interface of c/c++ ast nodes, the tree only keeps the
+// information used by ssa builder, such as the type of declaration is kept as
+// name and pointer level rather than full c type.
+type Node interface {
+	GetStart() Pos
+	GetStop() Pos
+}
+
+type Decl interface {
+	Node
+	declNode()
+}
+
+type Stmt interface {
+	Node
+	stmtNode()
+}
+
+type Expr interface {
+	Node
+	exprNode()
+}
+
+type node struct {
+	Start Pos
+	Stop  Pos
+}
+
+func (n *node) GetStart() Pos { return n.Start }
+func (n *node) GetStop() Pos  { return n.Stop }
+
+func (n *node) setRange(start, stop Pos) {
+	n.Start = start
+	n.Stop = stop
+}
+
+type decl struct{ node }
+
+func (*decl) declNode() {}
+
+type stmt struct{ node }
+
+func (*stmt) stmtNode() {}
+
+type expr struct{ node }
+
+func (*expr) exprNode() {}
+
+// TranslationUnit is a preprocessed source file
+type TranslationUnit struct {
+	node
+	Decls []Decl
+}
+
+// ================================ types ================================
+
+// TypeSpec is the declaration specifiers, Name is the type name such as
+// "unsigned int", "FILE", "std::string", and Struct / Enum is the definition
+// appear in specifiers, such as `struct S { int a; } s;`.
+type TypeSpec struct {
+	Name   string
+	Kind   string // struct / union / class / enum, empty for other type
+	Struct *StructDecl
+	Enum   *EnumDecl
+
+	Typedef bool
+	Static  bool
+	Extern  bool
+	Const   bool
+}
+
+// Declarator is the name and derived type of declaration, `*p`, `a[10]`, `(*fp)(int)`
+type Declarator struct {
+	node
+	Name string
+	// Pointer is the level of pointer (and c++ reference)
+	Pointer int
+	// Array is the dimensions of array, the element is nil for `[]`
+	Array []Expr
+	// Func is not nil for function declarator
+	Func *FuncType
+	// FuncPointer means the declarator is pointer to function `(*fp)(int)`,
+	// not the prototype of function returning pointer
+	FuncPointer bool
+
+	Init Expr
+	// Args is c++ direct initialization `A a(1, 2)`
+	Args    []Expr
+	HasArgs bool
+}
+
+func (d *Declarator) IsPointer() bool {
+	return d != nil && (d.Pointer > 0 || len(d.Array) > 0)
+}
+
+// IsFunction means the declarator is function prototype or definition
+func (d *Declarator) IsFunction() bool {
+	return d != nil && d.Func != nil && !d.FuncPointer
+}
+
+type FuncType struct {
+	Params   []*Param
+	Variadic bool
+}
+
+type Param struct {
+	node
+	Type *TypeSpec
+	Decl *Declarator
+	// Default is c++ default argument
+	Default Expr
+}
+
+func (p *Param) Name() string {
+	if p.Decl == nil {
+		return ""
+	}
+	return p.Decl.Name
+}
+
+// ================================ declarations ================================
+
+type (
+	// VarDecl is declaration of variables, typedef and function prototype
+	VarDecl struct {
+		decl
+		Type        *TypeSpec
+		Declarators []*Declarator
+	}
+
+	FuncDef struct {
+		decl
+		Type *TypeSpec
+		// Decl is the declarator of function, Decl.Func is not nil
+		Decl *Declarator
+		Name string
+		// Owner is the class name of c++ method defined out of class `A::f`
+		Owner string
+		// Inits is the c++ constructor member initializer list
+		Inits []*MemberInit
+		Body  *Compound
+	}
+
+	StructDecl struct {
+		decl
+		Kind    string // struct / union / class
+		Name    string
+		Bases   []string
+		Fields  []*VarDecl
+		Methods []*FuncDef
+		// Complete means the body `{...}` is defined
+		Complete bool
+	}
+
+	EnumDecl struct {
+		decl
+		Name  string
+		Items []*Enumerator
+	}
+
+	Namespace struct {
+		decl
+		Name  string
+		Decls []Decl
+	}
+
+	// DeclStmt is declaration in block
+	DeclStmt struct {
+		stmt
+		Decl Decl
+	}
+)
+
+type MemberInit struct {
+	node
+	Name string
+	Args []Expr
+}
+
+type Enumerator struct {
+	node
+	Name  string
+	Value Expr // may be nil
+}
+
+func (f *FuncDef) Params() []*Param {
+	if f.Decl == nil || f.Decl.Func == nil {
+		return nil
+	}
+	return f.Decl.Func.Params
+}
+
+// ================================ statements ================================
+
+type (
+	Compound struct {
+		stmt
+		Items []Stmt
+	}
+
+	// ExprStmt is expression statement, X is nil for empty statement `;`
+	ExprStmt struct {
+		stmt
+		X Expr
+	}
+
+	If struct {
+		stmt
+		Init Stmt // c++17 `if (init; cond)`, may be nil
+		Cond Expr
+		Then Stmt
+		Else Stmt // may be nil
+	}
+
+	While struct {
+		stmt
+		Cond Expr
+		Body Stmt
+	}
+
+	DoWhile struct {
+		stmt
+		Body Stmt
+		Cond Expr
+	}
+
+	For struct {
+		stmt
+		Init Stmt // may be nil
+		Cond Expr // may be nil
+		Post Expr // may be nil
+		Body Stmt
+	}
+
+	// RangeFor is c++ `for (auto x : range)`
+	RangeFor struct {
+		stmt
+		Decl  *VarDecl
+		Range Expr
+		Body  Stmt
+	}
+
+	Switch struct {
+		stmt
+		Cond  Expr
+		Cases []*CaseClause
+	}
+
+	// Case is the `case` label not directly in switch body, such as duff's device
+	Case struct {
+		stmt
+		Value Expr // nil for default
+		Body  Stmt
+	}
+
+	Return struct {
+		stmt
+		X Expr // may be nil
+	}
+
+	Break struct {
+		stmt
+	}
+
+	Continue struct {
+		stmt
+	}
+
+	Goto struct {
+		stmt
+		Label string
+	}
+
+	Labeled struct {
+		stmt
+		Label string
+		Body  Stmt
+	}
+
+	Try struct {
+		stmt
+		Body     *Compound
+		Handlers []*Catch
+	}
+)
+
+// CaseClause is the statements after `case` labels, Values is empty for `default`
+type CaseClause struct {
+	node
+	Values    []Expr
+	IsDefault bool
+	Body      []Stmt
+}
+
+type Catch struct {
+	node
+	Param *Param // nil for `catch (...)`
+	Body  *Compound
+}
+
+// ================================ expressions ================================
+
+type (
+	// Ident is identifier, the qualified name `std::cout` is also Ident
+	Ident struct {
+		expr
+		Name string
+	}
+
+	// Literal is number, char and string literal, the adjacent strings are concatenated
+	Literal struct {
+		expr
+		Kind  TokenType
+		Value string
+	}
+
+	// Unary is prefix operation: - + ! ~ * & ++ --
+	Unary struct {
+		expr
+		Op string
+		X  Expr
+	}
+
+	// Postfix is `x++` and `x--`
+	Postfix struct {
+		expr
+		Op string
+		X  Expr
+	}
+
+	Binary struct {
+		expr
+		Op string
+		X  Expr
+		Y  Expr
+	}
+
+	// Assign is `x = y` and compound assignment, Op is the operator without '='
+	Assign struct {
+		expr
+		Op string
+		X  Expr
+		Y  Expr
+	}
+
+	Cond struct {
+		expr
+		Cond Expr
+		Then Expr // may be nil for gnu `a ?: b`
+		Else Expr
+	}
+
+	Call struct {
+		expr
+		Func Expr
+		Args []Expr
+	}
+
+	Index struct {
+		expr
+		X     Expr
+		Index Expr
+	}
+
+	// Member is `x.name` and `x->name`
+	Member struct {
+		expr
+		X     Expr
+		Name  string
+		Arrow bool
+	}
+
+	// Cast is `(type)x`, compound literal `(type){...}` and c++ named cast
+	Cast struct {
+		expr
+		Type    *TypeSpec
+		Pointer int
+		X       Expr
+	}
+
+	// Sizeof is `sizeof x`, `sizeof(type)` and `alignof`, X is nil for type
+	Sizeof struct {
+		expr
+		X Expr
+	}
+
+	InitList struct {
+		expr
+		Elems []*InitElem
+	}
+
+	New struct {
+		expr
+		Type  *TypeSpec
+		Args  []Expr
+		Array Expr // `new T[n]`
+	}
+
+	Delete struct {
+		expr
+		X Expr
+	}
+
+	Throw struct {
+		expr
+		X Expr // may be nil
+	}
+
+	Lambda struct {
+		expr
+		Params []*Param
+		Body   *Compound
+	}
+
+	// StmtExpr is gnu statement expression `({ ... })`
+	StmtExpr struct {
+		expr
+		Body *Compound
+	}
+)
+
+// InitElem is element of initializer list, with optional designator `.field = ` or `[index] = `
+type InitElem struct {
+	node
+	Field string
+	Index Expr
+	Value Expr
+}
@@ -0,0 +1,296 @@
+package cparser
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+var punctuators = []string{
+	"...", "<<=", ">>=", "->*", "<=>",
+	"->", "++", "--", "<<", ">>", "<=", ">=", "==", "!=", "&&", "||",
+	"*=", "/=", "%=", "+=", "-=", "&=", "^=", "|=", "##", "::", ".*",
+	"+", "-", "*", "/", "%", "&", "|", "^", "~", "!", "<", ">", "=", "?", ":",
+	"(", ")", "[", "]", "{", "}", ",", ";", ".", "#",
+}
+
+// Lexer splits c source into preprocessing tokens, comments are skipped and the
+// line splicing (backslash newline) is treated as whitespace.
+type Lexer struct {
+	src    []rune
+	offset int
+	line   int
+	column int
+
+	bol   bool
+	space bool
+
+	tokens []*Token
+	errors []string
+}
+
+func NewLexer(src string) *Lexer {
+	return &Lexer{
+		src:  []rune(src),
+		line: 1,
+		bol:  true,
+	}
+}
+
+func (l *Lexer) Errors() []string {
+	return l.errors
+}
+
+func (l *Lexer) pos() Pos {
+	return Pos{Line: l.line, Column: l.column}
+}
+
+func (l *Lexer) peek(n int) rune {
+	if l.offset+n >= len(l.src) {
+		return 0
+	}
+	return l.src[l.offset+n]
+}
+
+func (l *Lexer) advance() rune {
+	r := l.src[l.offset]
+	l.offset++
+	if r == '\n' {
+		l.line++
+		l.column = 0
+	} else {
+		l.column++
+	}
+	return r
+}
+
+func (l *Lexer) errorf(p Pos, format string, args ...any) {
+	l.errors = append(l.errors, fmt.Sprintf("line %d:%d %s", p.Line, p.Column, fmt.Sprintf(format, args...)))
+}
+
+func (l *Lexer) emit(typ TokenType, value string, start Pos) {
+	l.tokens = append(l.tokens, &Token{
+		Type: typ, Value: value, Start: start, End: l.pos(),
+		BOL: l.bol, Space: l.space,
+	})
+	l.bol = false
+	l.space = false
+}
+
+func (l *Lexer) isSplice() bool {
+	return l.peek(0) == '\\' && (l.peek(1) == '\n' || (l.peek(1) == '\r' && l.peek(2) == '\n'))
+}
+
+func (l *Lexer) skipSplice() {
+	l.advance()
+	if l.peek(0) == '\r' {
+		l.advance()
+	}
+	l.advance()
+}
+
+// Tokenize returns all tokens of source, the last token is always EOF.
+func (l *Lexer) Tokenize() []*Token {
+	for l.offset < len(l.src) {
+		r := l.peek(0)
+		switch {
+		case r == '\n':
+			l.advance()
+			l.bol = true
+			l.space = false
+		case r == ' ' || r == '\t' || r == '\r' || r == '\f' || r == '\v':
+			l.advance()
+			l.space = true
+		case l.isSplice():
+			l.skipSplice()
+			l.space = true
+		case r == '/' && l.peek(1) == '/':
+			for l.offset < len(l.src) && l.peek(0) != '\n' {
+				if l.isSplice() {
+					// line comment continue to next line
+					l.skipSplice()
+					continue
+				}
+				l.advance()
+			}
+			l.space = true
+		case r == '/' && l.peek(1) == '*':
+			start := l.pos()
+			l.advance()
+			l.advance()
+			closed := false
+			for l.offset < len(l.src) {
+				if l.peek(0) == '*' && l.peek(1) == '/' {
+					l.advance()
+					l.advance()
+					closed = true
+					break
+				}
+				l.advance()
+			}
+			if !closed {
+				l.errorf(start, "unterminated comment")
+			}
+			l.space = true
+		case l.isStringStart():
+			l.lexString()
+		case isIdentStart(r):
+			l.lexIdent()
+		case isDigit(r) || (r == '.' && isDigit(l.peek(1))):
+			l.lexNumber()
+		default:
+			l.lexPunct()
+		}
+	}
+	l.tokens = append(l.tokens, &Token{Type: EOF, Start: l.pos(), End: l.pos(), BOL: true})
+	return l.tokens
+}
+
+func isIdentStart(r rune) bool {
+	return r == '_' || r == '$' || unicode.IsLetter(r)
+}
+
+func isIdentChar(r rune) bool {
+	return isIdentStart(r) || unicode.IsDigit(r)
+}
+
+func isDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+// isStringStart check string or char literal with optional encoding prefix: L u U u8 R
+func (l *Lexer) isStringStart() bool {
+	i := 0
+	switch l.peek(0) {
+	case 'L', 'U':
+		i = 1
+	case 'u':
+		i = 1
+		if l.peek(1) == '8' {
+			i = 2
+		}
+	}
+	if l.peek(i) == 'R' && l.peek(i+1) == '"' {
+		return true
+	}
+	return l.peek(i) == '"' || l.peek(i) == '\''
+}
+
+func (l *Lexer) lexString() {
+	start := l.pos()
+	var buf strings.Builder
+	for l.peek(0) != '"' && l.peek(0) != '\'' {
+		if l.peek(0) == 'R' && l.peek(1) == '"' {
+			l.lexRawString(start, &buf)
+			return
+		}
+		buf.WriteRune(l.advance())
+	}
+	quote := l.advance()
+	buf.WriteRune(quote)
+	typ := STRING
+	if quote == '\'' {
+		typ = CHAR
+	}
+	for {
+		if l.offset >= len(l.src) || l.peek(0) == '\n' {
+			l.errorf(start, "unterminated literal")
+			break
+		}
+		if l.isSplice() {
+			l.skipSplice()
+			continue
+		}
+		r := l.advance()
+		buf.WriteRune(r)
+		if r == '\\' && l.offset < len(l.src) {
+			buf.WriteRune(l.advance())
+			continue
+		}
+		if r == quote {
+			break
+		}
+	}
+	l.emit(typ, buf.String(), start)
+}
+
+// lexRawString lex c++ raw string `R"delim(...)delim"`
+func (l *Lexer) lexRawString(start Pos, buf *strings.Builder) {
+	buf.WriteRune(l.advance()) // R
+	buf.WriteRune(l.advance()) // "
+	var delim strings.Builder
+	for l.offset < len(l.src) && l.peek(0) != '(' {
+		delim.WriteRune(l.advance())
+	}
+	buf.WriteString(delim.String())
+	end := ")" + delim.String() + "\""
+	for l.offset < len(l.src) {
+		if l.hasPrefix(end) {
+			for range end {
+				buf.WriteRune(l.advance())
+			}
+			l.emit(STRING, buf.String(), start)
+			return
+		}
+		buf.WriteRune(l.advance())
+	}
+	l.errorf(start, "unterminated raw string")
+	l.emit(STRING, buf.String(), start)
+}
+
+func (l *Lexer) lexIdent() {
+	start := l.pos()
+	var buf strings.Builder
+	for l.offset < len(l.src) && isIdentChar(l.peek(0)) {
+		buf.WriteRune(l.advance())
+	}
+	l.emit(IDENT, buf.String(), start)
+}
+
+// lexNumber lex preprocessing number, include hex float, suffix and c++14 digit separator
+func (l *Lexer) lexNumber() {
+	start := l.pos()
+	var buf strings.Builder
+	for l.offset < len(l.src) {
+		r := l.peek(0)
+		switch {
+		case (r == 'e' || r == 'E' || r == 'p' || r == 'P') && (l.peek(1) == '+' || l.peek(1) == '-'):
+			buf.WriteRune(l.advance())
+			buf.WriteRune(l.advance())
+		case r == '\'' && isIdentChar(l.peek(1)):
+			l.advance()
+		case r == '.' || isIdentChar(r):
+			buf.WriteRune(l.advance())
+		default:
+			l.emit(NUMBER, buf.String(), start)
+			return
+		}
+	}
+	l.emit(NUMBER, buf.String(), start)
+}
+
+func (l *Lexer) lexPunct() {
+	start := l.pos()
+	for _, p := range punctuators {
+		if l.hasPrefix(p) {
+			for range p {
+				l.advance()
+			}
+			l.emit(PUNCT, p, start)
+			return
+		}
+	}
+	r := l.advance()
+	l.errorf(start, "unexpected character %q", r)
+}
+
+func (l *Lexer) hasPrefix(s string) bool {
+	i := 0
+	for _, r := range s {
+		if l.peek(i) != r {
+			return false
+		}
+		i++
+	}
+	return true
+}
@@ -0,0 +1,1677 @@
+package cparser
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Parser is a recursive descent parser for preprocessed c and the common subset
+// of c++ (class, namespace, reference, new/delete, try/catch, lambda). c is not
+// context free, the parser track typedef names and use lookahead heuristic for
+// unknown type names from headers not in project, such as `SSL *ssl;`.
+// Syntax errors are collected and the parser recovers at the next statement.
+type Parser struct {
+	tokens  []*Token
+	index   int
+	lastEnd Pos
+	errors  []string
+
+	cpp bool
+	// typeNames is typedef names and c++ class names
+	typeNames map[string]struct{}
+	// className is the class being parsed, used to find constructor
+	className string
+	// funcDepth > 0 means parsing function body
+	funcDepth int
+}
+
+type syntaxError struct {
+	msg string
+	pos Pos
+}
+
+func (e *syntaxError) Error() string {
+	return fmt.Sprintf("line %d:%d %s", e.pos.Line, e.pos.Column, e.msg)
+}
+
+// defaultTypeNames is the typedef names from standard and common system headers,
+// the system headers are not preprocessed, so these names should be known.
+var defaultTypeNames = []string{
+	"size_t", "ssize_t", "ptrdiff_t", "wchar_t", "wint_t", "max_align_t", "va_list", "__builtin_va_list",
+	"int8_t", "int16_t", "int32_t", "int64_t", "uint8_t", "uint16_t", "uint32_t", "uint64_t",
+	"intptr_t", "uintptr_t", "intmax_t", "uintmax_t", "off_t", "off64_t", "pid_t", "uid_t", "gid_t",
+	"mode_t", "time_t", "clock_t", "socklen_t", "sa_family_t", "in_addr_t", "in_port_t", "fd_set",
+	"FILE", "DIR", "jmp_buf", "sig_atomic_t", "sigset_t", "pthread_t", "pthread_mutex_t",
+	"pthread_attr_t", "pthread_cond_t", "u_char", "u_short", "u_int", "u_long",
+	"BOOL", "BYTE", "WORD", "DWORD", "HANDLE", "LPSTR", "LPCSTR", "LPVOID", "SOCKET",
+}
+
+// Parse parses the preprocessed tokens, the translation unit is always returned
+// and the error contains all syntax errors separated by newline.
+func Parse(src string, cpp bool) (*TranslationUnit, error) {
+	unit, errs := ParseEx(src, cpp)
+	if len(errs) > 0 {
+		return unit, fmt.Errorf("%s", strings.Join(errs, "\n"))
+	}
+	return unit, nil
+}
+
+// ParseEx preprocess (without include) and parse the source code, return the
+// translation unit with all errors.
+func ParseEx(src string, cpp bool) (*TranslationUnit, []string) {
+	pp := NewPreprocessor(nil)
+	if cpp {
+		pp.Define("__cplusplus", "201703L")
+	}
+	tokens := pp.Process("", src)
+	unit, errs := ParseTokens(tokens, cpp)
+	return unit, append(pp.Errors(), errs...)
+}
+
+// ParseTokens parses the tokens from Preprocessor, the last token should be EOF.
+func ParseTokens(tokens []*Token, cpp bool) (*TranslationUnit, []string) {
+	if len(tokens) == 0 || tokens[len(tokens)-1].Type != EOF {
+		tokens = append(tokens, &Token{Type: EOF})
+	}
+	p := &Parser{
+		tokens:    tokens,
+		cpp:       cpp,
+		typeNames: make(map[string]struct{}),
+	}
+	for _, name := range defaultTypeNames {
+		p.typeNames[name] = struct{}{}
+	}
+	unit := p.parseTranslationUnit()
+	return unit, p.errors
+}
+
+var cppHint = regexp.MustCompile(`(?m)^\s*(class\s+\w+|namespace\s+\w*|template\s*<|using\s+namespace|#include\s*<(iostream|string|vector|map|memory|fstream|sstream)>)|std::|\bnew\s+\w+|\bthis->`)
+
+// IsCPP check the file is c++ source by extension, the content is checked for header.
+func IsCPP(path, src string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".cpp", ".cc", ".cxx", ".c++", ".hpp", ".hh", ".hxx":
+		return true
+	case ".c":
+		return false
+	}
+	return cppHint.MatchString(src)
+}
+
+// ============================ token helpers ============================
+
+func (p *Parser) cur() *Token {
+	return p.tokens[p.index]
+}
+
+func (p *Parser) peekToken(n int) *Token {
+	if p.index+n >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[p.index+n]
+}
+
+func (p *Parser) tokenAt(i int) *Token {
+	if i >= len(p.tokens) {
+		return p.tokens[len(p.tokens)-1]
+	}
+	return p.tokens[i]
+}
+
+func (p *Parser) next() *Token {
+	t := p.tokens[p.index]
+	if t.Type != EOF {
+		p.index++
+		p.lastEnd = t.End
+	}
+	return t
+}
+
+func (p *Parser) at(typ TokenType) bool {
+	return p.cur().Type == typ
+}
+
+func (p *Parser) atPunct(ops ...string) bool {
+	t := p.cur()
+	if t.Type != PUNCT {
+		return false
+	}
+	for _, op := range ops {
+		if t.Value == op {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) atKeyword(kws ...string) bool {
+	t := p.cur()
+	if t.Type != IDENT {
+		return false
+	}
+	for _, kw := range kws {
+		if t.Value == kw {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Parser) acceptPunct(op string) bool {
+	if p.atPunct(op) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *Parser) acceptKeyword(kw string) bool {
+	if p.atKeyword(kw) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *Parser) fail(format string, args ...any) {
+	panic(&syntaxError{msg: fmt.Sprintf(format, args...), pos: p.cur().Start})
+}
+
+func (p *Parser) describe(t *Token) string {
+	if t.Type == EOF {
+		return "EOF"
+	}
+	return fmt.Sprintf("'%s'", t.Value)
+}
+
+func (p *Parser) expectPunct(op string) *Token {
+	if !p.atPunct(op) {
+		p.fail("expect '%s' but got %s", op, p.describe(p.cur()))
+	}
+	return p.next()
+}
+
+func (p *Parser) expectIdent() *Token {
+	t := p.cur()
+	if t.Type != IDENT || p.isKeyword(t.Value) {
+		p.fail("expect identifier but got %s", p.describe(t))
+	}
+	return p.next()
+}
+
+func (p *Parser) isKeyword(name string) bool {
+	return IsKeyword(name, p.cpp)
+}
+
+func (p *Parser) isTypeName(name string) bool {
+	_, ok := p.typeNames[name]
+	return ok
+}
+
+func (p *Parser) addTypeName(name string) {
+	if name == "" {
+		return
+	}
+	p.typeNames[name] = struct{}{}
+	if idx := strings.LastIndex(name, "::"); idx >= 0 {
+		p.typeNames[name[idx+2:]] = struct{}{}
+	}
+}
+
+// skipBalanced skip the balanced group start at current token, such as `(...)`
+func (p *Parser) skipBalanced() {
+	open := p.cur().Value
+	var close string
+	switch open {
+	case "(":
+		close = ")"
+	case "[":
+		close = "]"
+	case "{":
+		close = "}"
+	default:
+		return
+	}
+	depth := 0
+	for !p.at(EOF) {
+		t := p.next()
+		if t.is(PUNCT, open) {
+			depth++
+		} else if t.is(PUNCT, close) {
+			depth--
+			if depth == 0 {
+				return
+			}
+		}
+	}
+}
+
+// skipUntil skip tokens until the punctuator at depth 0, the punctuator is consumed
+func (p *Parser) skipUntil(op string) {
+	for !p.at(EOF) && !p.atPunct(op) {
+		if p.atPunct("(", "[", "{") {
+			p.skipBalanced()
+			continue
+		}
+		if p.atPunct("}") {
+			return
+		}
+		p.next()
+	}
+	p.acceptPunct(op)
+}
+
+// ============================ translation unit ============================
+
+func (p *Parser) parseTranslationUnit() *TranslationUnit {
+	unit := &TranslationUnit{}
+	start := p.cur().Start
+	for !p.at(EOF) {
+		if p.atPunct("}") {
+			// unbalanced brace
+			p.errors = append(p.errors, (&syntaxError{msg: "unexpected '}'", pos: p.cur().Start}).Error())
+			p.next()
+			continue
+		}
+		unit.Decls = append(unit.Decls, p.parseExternalDeclRecover()...)
+	}
+	unit.setRange(start, p.lastEnd)
+	return unit
+}
+
+func (p *Parser) recoverError(startIndex int) {
+	if r := recover(); r != nil {
+		se, ok := r.(*syntaxError)
+		if !ok {
+			panic(r)
+		}
+		p.errors = append(p.errors, se.Error())
+		p.synchronize(startIndex)
+	}
+}
+
+// synchronize skip to the end of broken statement or declaration
+func (p *Parser) synchronize(startIndex int) {
+	if p.index == startIndex && !p.at(EOF) && !p.atPunct("}") {
+		// make progress
+		p.next()
+	}
+	for !p.at(EOF) {
+		switch {
+		case p.atPunct(";"):
+			p.next()
+			return
+		case p.atPunct("}"):
+			return
+		case p.atPunct("{"):
+			p.skipBalanced()
+			return
+		case p.atPunct("(", "["):
+			p.skipBalanced()
+		default:
+			p.next()
+		}
+	}
+}
+
+func (p *Parser) parseExternalDeclRecover() (ret []Decl) {
+	startIndex := p.index
+	funcDepth, className := p.funcDepth, p.className
+	defer func() { p.funcDepth, p.className = funcDepth, className }()
+	defer p.recoverError(startIndex)
+	return p.parseExternalDecl()
+}
+
+func (p *Parser) parseExternalDecl() []Decl {
+	switch {
+	case p.atPunct(";"):
+		p.next()
+		return nil
+	case p.atKeyword("namespace") && p.cpp:
+		return []Decl{p.parseNamespace()}
+	case p.atKeyword("using") && p.cpp:
+		p.parseUsing()
+		return nil
+	case p.atKeyword("template") && p.cpp:
+		p.skipTemplateHeader()
+		return p.parseExternalDecl()
+	case p.atKeyword("extern") && p.peekToken(1).Type == STRING:
+		// extern "C"
+		p.next()
+		p.next()
+		if !p.atPunct("{") {
+			return p.parseExternalDecl()
+		}
+		p.next()
+		var decls []Decl
+		for !p.at(EOF) && !p.atPunct("}") {
+			decls = append(decls, p.parseExternalDeclRecover()...)
+		}
+		p.expectPunct("}")
+		return decls
+	case p.atKeyword("_Static_assert", "static_assert", "asm", "__asm__", "__asm"):
+		p.skipUntil(";")
+		return nil
+	}
+	if d := p.parseDeclaration(); d != nil {
+		return []Decl{d}
+	}
+	return nil
+}
+
+func (p *Parser) parseNamespace() Decl {
+	start := p.next().Start // namespace
+	ns := &Namespace{}
+	if p.at(IDENT) {
+		ns.Name = p.parseQualifiedName()
+	}
+	if p.acceptPunct("=") {
+		// namespace alias
+		p.skipUntil(";")
+		ns.setRange(start, p.lastEnd)
+		return ns
+	}
+	p.expectPunct("{")
+	for !p.at(EOF) && !p.atPunct("}") {
+		ns.Decls = append(ns.Decls, p.parseExternalDeclRecover()...)
+	}
+	p.expectPunct("}")
+	ns.setRange(start, p.lastEnd)
+	return ns
+}
+
+// parseUsing parse `using namespace x;` `using x::y;` and type alias `using T = int;`
+func (p *Parser) parseUsing() {
+	p.next() // using
+	if p.at(IDENT) && p.peekToken(1).is(PUNCT, "=") {
+		p.addTypeName(p.next().Value)
+	}
+	p.skipUntil(";")
+}
+
+// skipTemplateHeader skip `template <typename T, int N>`, the type parameters are type names
+func (p *Parser) skipTemplateHeader() {
+	p.next() // template
+	if !p.atPunct("<") {
+		return
+	}
+	depth := 0
+	for !p.at(EOF) {
+		t := p.next()
+		switch {
+		case t.is(PUNCT, "<"):
+			depth++
+		case t.is(PUNCT, ">"):
+			depth--
+		case t.is(PUNCT, ">>"):
+			depth -= 2
+		case t.is(IDENT, "typename") || t.is(IDENT, "class"):
+			if p.at(IDENT) {
+				p.addTypeName(p.cur().Value)
+			}
+		}
+		if depth <= 0 {
+			return
+		}
+	}
+}
+
+// ============================ declaration ============================
+
+var builtinTypes = map[string]struct{}{
+	"void": {}, "char": {}, "short": {}, "int": {}, "long": {}, "float": {}, "double": {},
+	"signed": {}, "unsigned": {}, "_Bool": {}, "bool": {}, "_Complex": {}, "auto": {},
+	"wchar_t": {}, "char8_t": {}, "char16_t": {}, "char32_t": {}, "__int128": {}, "__int64": {},
+	"__signed__": {}, "__unsigned__": {},
+}
+
+var typeQualifiers = map[string]struct{}{
+	"const": {}, "volatile": {}, "restrict": {}, "__restrict": {}, "__restrict__": {}, "__const": {},
+	"__volatile__": {}, "register": {}, "inline": {}, "__inline": {}, "__inline__": {}, "_Noreturn": {},
+	"_Thread_local": {}, "thread_local": {}, "__thread": {}, "_Atomic": {}, "constexpr": {},
+	"consteval": {}, "constinit": {}, "virtual": {}, "explicit": {}, "friend": {}, "mutable": {},
+	"__extension__": {}, "typename": {}, "static": {}, "extern": {}, "typedef": {},
+}
+
+// attributeKeywords is followed by parenthesized group which should be skipped
+var attributeKeywords = map[string]struct{}{
+	"__attribute__": {}, "__attribute": {}, "__declspec": {}, "_Alignas": {}, "alignas": {},
+	"__asm__": {}, "__asm": {}, "asm": {}, "noexcept": {}, "throw": {},
+}
+
+func (p *Parser) skipAttributes() {
+	for {
+		switch {
+		case p.atPunct("[") && p.peekToken(1).is(PUNCT, "["):
+			p.skipBalanced()
+		case p.at(IDENT) && isAttributeKeyword(p.cur().Value):
+			p.next()
+			if p.atPunct("(") {
+				p.skipBalanced()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isAttributeKeyword(name string) bool {
+	_, ok := attributeKeywords[name]
+	return ok && name != "throw" && name != "noexcept"
+}
+
+// isTypeSpecifierKeyword check the identifier is the start of declaration specifiers
+func (p *Parser) isTypeSpecifierKeyword(name string) bool {
+	if _, ok := builtinTypes[name]; ok {
+		return true
+	}
+	if _, ok := typeQualifiers[name]; ok {
+		return name != "typename" || p.cpp
+	}
+	if isAttributeKeyword(name) {
+		return true
+	}
+	switch name {
+	case "struct", "union", "enum", "typeof", "__typeof__", "__typeof":
+		return true
+	case "class", "decltype":
+		return p.cpp
+	}
+	return false
+}
+
+// scanTypeName scan the (qualified, template) name start at index i, return the
+// index after the name, -1 means not a name.
+func (p *Parser) scanTypeName(i int) int {
+	if p.tokenAt(i).is(PUNCT, "::") {
+		i++
+	}
+	for {
+		t := p.tokenAt(i)
+		if t.Type != IDENT || (p.isKeyword(t.Value) && !p.isTypeSpecifierKeyword(t.Value)) {
+			return -1
+		}
+		i++
+		if p.cpp && p.tokenAt(i).is(PUNCT, "<") {
+			j := p.scanTemplateArgs(i)
+			if j < 0 {
+				return i
+			}
+			i = j
+		}
+		if p.tokenAt(i).is(PUNCT, "::") && p.tokenAt(i+1).Type == IDENT {
+			i++
+			continue
+		}
+		return i
+	}
+}
+
+// scanTemplateArgs scan template arguments `<...>` start at index i, return the index after `>`
+func (p *Parser) scanTemplateArgs(i int) int {
+	depth := 0
+	for ; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		switch {
+		case t.is(PUNCT, "<"):
+			depth++
+		case t.is(PUNCT, ">"):
+			depth--
+		case t.is(PUNCT, ">>"):
+			depth -= 2
+		case t.Type == IDENT, t.Type == NUMBER:
+		case t.is(PUNCT, "::"), t.is(PUNCT, ","), t.is(PUNCT, "*"), t.is(PUNCT, "&"), t.is(PUNCT, "&&"):
+		case t.is(PUNCT, "("), t.is(PUNCT, ")"), t.is(PUNCT, "["), t.is(PUNCT, "]"), t.is(PUNCT, "..."):
+		default:
+			return -1
+		}
+		if depth <= 0 {
+			if depth < 0 {
+				// `>>` close the outer template
+				return -1
+			}
+			return i + 1
+		}
+	}
+	return -1
+}
+
+func (p *Parser) parseQualifiedName() string {
+	var buf strings.Builder
+	if p.atPunct("::") {
+		buf.WriteString(p.next().Value)
+	}
+	for {
+		t := p.expectIdent()
+		buf.WriteString(t.Value)
+		if p.cpp && p.atPunct("<") {
+			if end := p.scanTemplateArgs(p.index); end > 0 {
+				for p.index < end {
+					buf.WriteString(p.next().Value)
+				}
+			}
+		}
+		if p.atPunct("::") && p.peekToken(1).Type == IDENT {
+			buf.WriteString(p.next().Value)
+			continue
+		}
+		return buf.String()
+	}
+}
+
+// isDeclStart check the statement is a declaration
+func (p *Parser) isDeclStart() bool {
+	t := p.cur()
+	if t.is(PUNCT, "[") && p.peekToken(1).is(PUNCT, "[") {
+		return true
+	}
+	if t.is(PUNCT, "::") && p.cpp {
+		return p.isUnknownTypeDecl()
+	}
+	if t.Type != IDENT {
+		return false
+	}
+	if p.isTypeSpecifierKeyword(t.Value) {
+		return true
+	}
+	switch t.Value {
+	case "_Static_assert", "static_assert", "using", "template":
+		return true
+	}
+	if p.isKeyword(t.Value) {
+		return false
+	}
+	if p.isTypeName(t.Value) {
+		n := p.peekToken(1)
+		if n.Type == IDENT || n.is(PUNCT, "*") || n.is(PUNCT, "&") || n.is(PUNCT, "&&") || n.is(PUNCT, "<") || n.is(PUNCT, "::") {
+			return p.isUnknownTypeDecl() || n.Type == IDENT || n.is(PUNCT, "*")
+		}
+		if n.is(PUNCT, "(") {
+			// `T (*fp)(...)`
+			return p.peekToken(2).is(PUNCT, "*")
+		}
+		return false
+	}
+	return p.isUnknownTypeDecl()
+}
+
+// isUnknownTypeDecl check `T x`, `T *x =`, `ns::T<int> &x;` with unknown type name T
+func (p *Parser) isUnknownTypeDecl() bool {
+	j := p.scanTypeName(p.index)
+	if j < 0 {
+		return false
+	}
+	direct := true
+	for {
+		t := p.tokenAt(j)
+		if t.is(PUNCT, "*") || (p.cpp && (t.is(PUNCT, "&") || t.is(PUNCT, "&&"))) {
+			direct = false
+			j++
+			continue
+		}
+		if t.is(IDENT, "const") || t.is(IDENT, "volatile") || t.is(IDENT, "restrict") || t.is(IDENT, "__restrict") {
+			j++
+			continue
+		}
+		break
+	}
+	name := p.tokenAt(j)
+	if name.Type != IDENT || (p.isKeyword(name.Value) && !p.isTypeSpecifierKeyword(name.Value)) {
+		return false
+	}
+	if direct {
+		return true
+	}
+	after := p.tokenAt(j + 1)
+	return after.Type == PUNCT && (after.Value == "=" || after.Value == ";" || after.Value == "," || after.Value == "[" || after.Value == ")" || after.Value == ":")
+}
+
+// isTypeStart check the tokens at index i is a type name, used for cast and sizeof
+func (p *Parser) isTypeStart(i int) bool {
+	t := p.tokenAt(i)
+	if t.Type != IDENT {
+		return p.cpp && t.is(PUNCT, "::")
+	}
+	if p.isTypeSpecifierKeyword(t.Value) {
+		return true
+	}
+	if p.isKeyword(t.Value) {
+		return false
+	}
+	return p.isTypeName(t.Value)
+}
+
+type specMode int
+
+const (
+	// specDecl is declaration, unknown identifier is type name when followed by declarator
+	specDecl specMode = iota
+	// specType is type name (cast, parameter, new), the first identifier is always type name
+	specType
+)
+
+func (p *Parser) parseDeclSpecifiers(mode specMode) *TypeSpec {
+	spec := &TypeSpec{}
+	var names []string
+	sawType := false
+	for {
+		t := p.cur()
+		if t.Type == PUNCT {
+			if t.is(PUNCT, "[") && p.peekToken(1).is(PUNCT, "[") {
+				p.skipBalanced()
+				continue
+			}
+			if t.is(PUNCT, "::") && p.cpp && !sawType {
+				names = append(names, p.parseQualifiedName())
+				sawType = true
+				continue
+			}
+			break
+		}
+		if t.Type != IDENT {
+			break
+		}
+
+		v := t.Value
+		if _, ok := typeQualifiers[v]; ok && (v != "typename" || p.cpp) {
+			switch v {
+			case "typedef":
+				spec.Typedef = true
+			case "static":
+				spec.Static = true
+			case "extern":
+				spec.Extern = true
+			case "const", "__const":
+				spec.Const = true
+			}
+			p.next()
+			continue
+		}
+		if isAttributeKeyword(v) {
+			p.skipAttributes()
+			continue
+		}
+		if _, ok := builtinTypes[v]; ok {
+			names = append(names, v)
+			sawType = true
+			p.next()
+			continue
+		}
+		switch {
+		case v == "struct" || v == "union" || (v == "class" && p.cpp):
+			s := p.parseStruct()
+			spec.Kind = s.Kind
+			spec.Struct = s
+			names = append(names, s.Name)
+			sawType = true
+			continue
+		case v == "enum":
+			e := p.parseEnum()
+			spec.Kind = "enum"
+			spec.Enum = e
+			names = append(names, e.Name)
+			sawType = true
+			continue
+		case v == "typeof" || v == "__typeof__" || v == "__typeof" || (v == "decltype" && p.cpp):
+			p.next()
+			if p.atPunct("(") {
+				p.skipBalanced()
+			}
+			names = append(names, v)
+			sawType = true
+			continue
+		}
+		if sawType || p.isKeyword(v) {
+			break
+		}
+
+		// identifier as type name
+		end := p.scanTypeName(p.index)
+		if end < 0 {
+			break
+		}
+		after := p.tokenAt(end)
+		isType := false
+		switch {
+		case mode == specType:
+			isType = true
+		case after.is(PUNCT, "("):
+			// declarator name `main(`, constructor `A::A(`, or function pointer `T (*fp)`
+			isType = p.tokenAt(end+1).is(PUNCT, "*") || p.tokenAt(end+1).is(PUNCT, "^")
+		case p.isTypeName(v):
+			isType = v != p.className || !after.is(PUNCT, "(")
+		default:
+			isType = after.Type == IDENT || after.is(PUNCT, "*") || after.is(PUNCT, "&") ||
+				after.is(PUNCT, "&&") || after.is(PUNCT, "[") && p.peekToken(1).is(PUNCT, "[")
+		}
+		if !isType {
+			break
+		}
+		names = append(names, p.parseQualifiedName())
+		sawType = true
+	}
+	spec.Name = strings.Join(names, " ")
+	return spec
+}
+
+func (p *Parser) parseStruct() *StructDecl {
+	kw := p.next()
+	s := &StructDecl{Kind: kw.Value}
+	p.skipAttributes()
+	if p.at(IDENT) && !p.isKeyword(p.cur().Value) {
+		s.Name = p.parseQualifiedName()
+		if p.cpp {
+			p.addTypeName(s.Name)
+		}
+	}
+	if p.cpp {
+		p.acceptKeyword("final")
+		if p.atPunct(":") {
+			p.next()
+			for {
+				for p.atKeyword("public", "private", "protected", "virtual") {
+					p.next()
+				}
+				s.Bases = append(s.Bases, p.parseQualifiedName())
+				if !p.acceptPunct(",") {
+					break
+				}
+			}
+		}
+	}
+	if p.atPunct("{") {
+		p.parseStructBody(s)
+	}
+	s.setRange(kw.Start, p.lastEnd)
+	p.skipAttributes()
+	return s
+}
+
+func (p *Parser) parseStructBody(s *StructDecl) {
+	p.expectPunct("{")
+	s.Complete = true
+	className := p.className
+	p.className = s.Name
+	if idx := strings.LastIndex(s.Name, "::"); idx >= 0 {
+		p.className = s.Name[idx+2:]
+	}
+	defer func() { p.className = className }()
+
+	for !p.at(EOF) && !p.atPunct("}") {
+		startIndex := p.index
+		func() {
+			defer p.recoverError(startIndex)
+			switch {
+			case p.atPunct(";"):
+				p.next()
+			case p.cpp && p.atKeyword("public", "private", "protected") && p.peekToken(1).is(PUNCT, ":"):
+				p.next()
+				p.next()
+			case p.cpp && p.atKeyword("template"):
+				p.skipTemplateHeader()
+			case p.cpp && p.atKeyword("using"):
+				p.parseUsing()
+			case p.atKeyword("_Static_assert", "static_assert"):
+				p.skipUntil(";")
+			default:
+				p.parseMember(s)
+			}
+		}()
+	}
+	p.expectPunct("}")
+}
+
+func (p *Parser) parseMember(s *StructDecl) {
+	start := p.cur().Start
+	spec := p.parseDeclSpecifiers(specDecl)
+	field := &VarDecl{Type: spec}
+	if !p.atPunct(";") {
+		for {
+			d := p.parseDeclarator()
+			if d.Func != nil && len(field.Declarators) == 0 && p.atFuncBody() {
+				fn := p.parseFuncDef(spec, d, start)
+				fn.Owner = s.Name
+				s.Methods = append(s.Methods, fn)
+				p.acceptPunct(";")
+				return
+			}
+			if p.acceptPunct(":") {
+				// bit field
+				p.parseCond()
+			}
+			if p.acceptPunct("=") {
+				// c++ default member initializer, or pure virtual `= 0`
+				if p.atKeyword("default", "delete") {
+					p.next()
+				} else if p.atPunct("{") {
+					d.Init = p.parseInitList()
+				} else {
+					d.Init = p.parseAssign()
+				}
+			}
+			field.Declarators = append(field.Declarators, d)
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+	}
+	p.expectPunct(";")
+	field.setRange(start, p.lastEnd)
+	if spec.Typedef {
+		for _, d := range field.Declarators {
+			p.addTypeName(d.Name)
+		}
+		return
+	}
+	s.Fields = append(s.Fields, field)
+}
+
+func (p *Parser) parseEnum() *EnumDecl {
+	kw := p.next()
+	e := &EnumDecl{}
+	if p.cpp && p.atKeyword("class", "struct") {
+		p.next()
+	}
+	p.skipAttributes()
+	if p.at(IDENT) && !p.isKeyword(p.cur().Value) {
+		e.Name = p.parseQualifiedName()
+		if p.cpp {
+			p.addTypeName(e.Name)
+		}
+	}
+	if p.acceptPunct(":") {
+		p.parseDeclSpecifiers(specType)
+	}
+	if p.acceptPunct("{") {
+		for !p.at(EOF) && !p.atPunct("}") {
+			name := p.expectIdent()
+			item := &Enumerator{Name: name.Value}
+			p.skipAttributes()
+			if p.acceptPunct("=") {
+				item.Value = p.parseCond()
+			}
+			item.setRange(name.Start, p.lastEnd)
+			e.Items = append(e.Items, item)
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+		p.expectPunct("}")
+	}
+	e.setRange(kw.Start, p.lastEnd)
+	return e
+}
+
+// parseDeclaration parse declaration and function definition, return nil for empty declaration
+func (p *Parser) parseDeclaration() Decl {
+	start := p.cur().Start
+	if p.atKeyword("_Static_assert", "static_assert") {
+		p.skipUntil(";")
+		return nil
+	}
+	if p.atKeyword("using") {
+		p.parseUsing()
+		return nil
+	}
+	if p.atKeyword("template") {
+		p.skipTemplateHeader()
+	}
+
+	spec := p.parseDeclSpecifiers(specDecl)
+	if p.acceptPunct(";") {
+		switch {
+		case spec.Struct != nil:
+			return spec.Struct
+		case spec.Enum != nil:
+			return spec.Enum
+		}
+		return nil
+	}
+
+	decl := &VarDecl{Type: spec}
+	for {
+		d := p.parseDeclarator()
+		if d.Func != nil && len(decl.Declarators) == 0 && p.atFuncBody() {
+			return p.parseFuncDef(spec, d, start)
+		}
+		if d.Name == "" && d.Func == nil && !d.HasArgs {
+			p.fail("expect declarator but got %s", p.describe(p.cur()))
+		}
+		decl.Declarators = append(decl.Declarators, d)
+		if spec.Typedef {
+			p.addTypeName(d.Name)
+		}
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+	p.expectPunct(";")
+	decl.setRange(start, p.lastEnd)
+	return decl
+}
+
+// atFuncBody check the function body `{`, c++ constructor initializer `:`, function
+// try block, or old style parameter declaration `int f(a) int a; {}`
+func (p *Parser) atFuncBody() bool {
+	switch {
+	case p.atPunct("{"):
+		return true
+	case p.cpp && (p.atPunct(":") || p.atKeyword("try")):
+		return true
+	case p.funcDepth == 0 && p.at(IDENT) && !p.atKeyword("__attribute__", "__asm__", "asm"):
+		// K&R style, scan to `{` before `;`
+		i := p.index
+		for ; i < len(p.tokens) && p.tokens[i].Type != EOF; i++ {
+			t := p.tokens[i]
+			if t.is(PUNCT, "{") {
+				return true
+			}
+			if t.is(PUNCT, "}") || t.is(PUNCT, "(") || t.is(PUNCT, "=") {
+				return false
+			}
+			if t.is(PUNCT, ";") && (i+1 >= len(p.tokens) || !p.isTypeStart(i+1)) {
+				return p.tokenAt(i+1).is(PUNCT, "{")
+			}
+		}
+	}
+	return false
+}
+
+func (p *Parser) parseFuncDef(spec *TypeSpec, d *Declarator, start Pos) *FuncDef {
+	fn := &FuncDef{Type: spec, Decl: d, Name: d.Name}
+	if idx := strings.LastIndex(d.Name, "::"); idx >= 0 {
+		fn.Owner = d.Name[:idx]
+		fn.Name = d.Name[idx+2:]
+	}
+
+	// K&R parameter declarations
+	for !p.at(EOF) && !p.atPunct("{", ":") && !p.atKeyword("try") {
+		decl, ok := p.parseDeclaration().(*VarDecl)
+		if !ok {
+			continue
+		}
+		for _, declarator := range decl.Declarators {
+			for _, param := range d.Func.Params {
+				if param.Name() == declarator.Name {
+					param.Type = decl.Type
+					param.Decl = declarator
+				}
+			}
+		}
+	}
+
+	if p.acceptPunct(":") {
+		for {
+			t := p.cur()
+			init := &MemberInit{Name: p.parseQualifiedName()}
+			if p.atPunct("{") {
+				for _, elem := range p.parseInitList().(*InitList).Elems {
+					init.Args = append(init.Args, elem.Value)
+				}
+			} else {
+				init.Args = p.parseArgs()
+			}
+			init.setRange(t.Start, p.lastEnd)
+			fn.Inits = append(fn.Inits, init)
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+	}
+
+	isTry := p.acceptKeyword("try")
+	p.funcDepth++
+	fn.Body = p.parseCompound()
+	if isTry {
+		p.parseCatches()
+	}
+	p.funcDepth--
+	fn.setRange(start, p.lastEnd)
+	return fn
+}
+
+// parseDeclarator parse declarator (may be abstract) with initializer
+func (p *Parser) parseDeclarator() *Declarator {
+	d := &Declarator{}
+	start := p.cur().Start
+	p.parsePointers(d)
+
+	var inner *Declarator
+	t := p.cur()
+	switch {
+	case t.Type == IDENT && (!p.isKeyword(t.Value) || t.Value == "operator" || t.Value == "this"):
+		d.Name = p.parseDeclaratorName()
+	case p.cpp && (t.is(PUNCT, "~") || t.is(PUNCT, "::")):
+		d.Name = p.parseDeclaratorName()
+	case t.is(PUNCT, "(") && p.isNestedDeclarator():
+		p.next()
+		inner = p.parseDeclarator()
+		p.expectPunct(")")
+	}
+
+	p.parseDeclaratorSuffix(d)
+	if inner != nil {
+		if inner.Func == nil && d.Func != nil && inner.Pointer > 0 {
+			inner.FuncPointer = true
+		}
+		inner.Pointer += d.Pointer
+		if inner.Func == nil {
+			inner.Func = d.Func
+		}
+		inner.Array = append(inner.Array, d.Array...)
+		if d.HasArgs {
+			inner.Args, inner.HasArgs = d.Args, true
+		}
+		d = inner
+	}
+
+	p.skipAttributes()
+	if p.acceptPunct("=") {
+		switch {
+		case p.atPunct("{"):
+			d.Init = p.parseInitList()
+		case p.atKeyword("default", "delete"):
+			p.next()
+		default:
+			d.Init = p.parseAssign()
+		}
+	} else if p.cpp && p.atPunct("{") && d.Func == nil && d.Name != "" {
+		d.Init = p.parseInitList()
+	}
+	d.setRange(start, p.lastEnd)
+	return d
+}
+
+func (p *Parser) parsePointers(d *Declarator) {
+	for {
+		p.skipAttributes()
+		switch {
+		case p.atPunct("*"), p.atPunct("^"):
+			d.Pointer++
+			p.next()
+		case p.cpp && (p.atPunct("&") || p.atPunct("&&")):
+			d.Pointer++
+			p.next()
+		case p.atKeyword("const", "volatile", "restrict", "__restrict", "__restrict__", "__const", "_Nonnull", "_Nullable", "__ptr32", "__ptr64"):
+			p.next()
+		default:
+			return
+		}
+	}
+}
+
+// isNestedDeclarator check the `(` is nested declarator `(*fp)` rather than parameters
+func (p *Parser) isNestedDeclarator() bool {
+	n := p.peekToken(1)
+	if n.is(PUNCT, "*") || n.is(PUNCT, "^") || (p.cpp && (n.is(PUNCT, "&") || n.is(PUNCT, "&&"))) {
+		return true
+	}
+	if n.is(PUNCT, "(") {
+		return true
+	}
+	// `void (f)(int)`
+	return n.Type == IDENT && !p.isKeyword(n.Value) && !p.isTypeName(n.Value) && p.peekToken(2).is(PUNCT, ")") && p.peekToken(3).is(PUNCT, "(")
+}
+
+func (p *Parser) parseDeclaratorName() string {
+	var buf strings.Builder
+	for {
+		switch {
+		case p.atPunct("::"):
+			buf.WriteString(p.next().Value)
+			continue
+		case p.atPunct("~"):
+			buf.WriteString(p.next().Value)
+			continue
+		case p.atKeyword("operator"):
+			buf.WriteString(p.next().Value)
+			// operator symbol or conversion type
+			switch {
+			case p.atPunct("(") && p.peekToken(1).is(PUNCT, ")"):
+				buf.WriteString("()")
+				p.next()
+				p.next()
+			case p.atPunct("[") && p.peekToken(1).is(PUNCT, "]"):
+				buf.WriteString("[]")
+				p.next()
+				p.next()
+			default:
+				for !p.at(EOF) && !p.atPunct("(") {
+					buf.WriteString(p.next().Value)
+				}
+			}
+			return buf.String()
+		}
+		t := p.cur()
+		if t.Type != IDENT {
+			return buf.String()
+		}
+		buf.WriteString(p.next().Value)
+		if p.cpp && p.atPunct("<") {
+			if end := p.scanTemplateArgs(p.index); end > 0 && p.tokenAt(end).is(PUNCT, "::") {
+				for p.index < end {
+					buf.WriteString(p.next().Value)
+				}
+			}
+		}
+		if !p.atPunct("::") {
+			return buf.String()
+		}
+	}
+}
+
+func (p *Parser) parseDeclaratorSuffix(d *Declarator) {
+	for {
+		p.skipAttributes()
+		switch {
+		case p.atPunct("["):
+			p.next()
+			var dim Expr
+			if !p.atPunct("]") {
+				for p.atKeyword("static", "const", "restrict") {
+					p.next()
+				}
+				if p.atPunct("*") && p.peekToken(1).is(PUNCT, "]") {
+					p.next()
+				} else {
+					dim = p.parseAssign()
+				}
+			}
+			p.expectPunct("]")
+			d.Array = append(d.Array, dim)
+		case p.atPunct("(") && !d.HasArgs:
+			if !p.isParamList() {
+				// c++ direct initialization `A a(1, 2)`
+				d.Args = p.parseArgs()
+				d.HasArgs = true
+				return
+			}
+			ft := p.parseParams()
+			if d.Func == nil {
+				d.Func = ft
+			}
+			p.skipFuncQualifiers()
+		default:
+			return
+		}
+	}
+}
+
+// skipFuncQualifiers skip `const`, `noexcept`, `override`, trailing return type of function declarator
+func (p *Parser) skipFuncQualifiers() {
+	for {
+		switch {
+		case p.atKeyword("const", "volatile", "override", "final", "__restrict"):
+			p.next()
+		case p.cpp && (p.atPunct("&") || p.atPunct("&&")):
+			p.next()
+		case p.atKeyword("noexcept", "throw"):
+			p.next()
+			if p.atPunct("(") {
+				p.skipBalanced()
+			}
+		case p.cpp && p.atPunct("->"):
+			p.next()
+			p.parseDeclSpecifiers(specType)
+			p.parsePointers(&Declarator{})
+		case p.atKeyword("__attribute__", "__attribute", "__asm__", "__asm", "asm") || (p.atPunct("[") && p.peekToken(1).is(PUNCT, "[")):
+			p.skipAttributes()
+		default:
+			return
+		}
+	}
+}
+
+// isParamList check the `(` after declarator name is parameters rather than constructor arguments
+func (p *Parser) isParamList() bool {
+	n := p.peekToken(1)
+	switch {
+	case n.is(PUNCT, ")"), n.is(PUNCT, "..."):
+		return true
+	case n.Type != IDENT:
+		return p.cpp && n.is(PUNCT, "::") && p.funcDepth == 0
+	case p.isTypeSpecifierKeyword(n.Value) || p.isTypeName(n.Value):
+		return true
+	case p.isKeyword(n.Value):
+		return false
+	case p.funcDepth == 0:
+		// K&R identifier list, parameter with unknown type, or macro invocation `DEFINE(name, "value");`
+		return !p.hasLiteralArg()
+	}
+	saved := p.index
+	p.index++
+	defer func() { p.index = saved }()
+	return p.isUnknownTypeDecl()
+}
+
+// hasLiteralArg check the literal in parentheses, which should be arguments rather than parameters
+func (p *Parser) hasLiteralArg() bool {
+	depth := 0
+	for i := p.index; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		switch {
+		case t.is(PUNCT, "("), t.is(PUNCT, "["):
+			depth++
+		case t.is(PUNCT, ")"), t.is(PUNCT, "]"):
+			depth--
+			if depth == 0 {
+				return false
+			}
+		case t.Type == STRING || t.Type == CHAR:
+			return true
+		case t.Type == NUMBER && depth == 1:
+			return true
+		case t.Type == EOF || t.is(PUNCT, ";") || t.is(PUNCT, "{"):
+			return false
+		}
+	}
+	return false
+}
+
+func (p *Parser) parseParams() *FuncType {
+	p.expectPunct("(")
+	ft := &FuncType{}
+	if p.atKeyword("void") && p.peekToken(1).is(PUNCT, ")") {
+		p.next()
+	}
+	for !p.at(EOF) && !p.atPunct(")") {
+		if p.acceptPunct("...") {
+			ft.Variadic = true
+			break
+		}
+		ft.Params = append(ft.Params, p.parseParam())
+		if p.acceptPunct("...") {
+			// c++ parameter pack
+			ft.Variadic = true
+		}
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+	p.expectPunct(")")
+	return ft
+}
+
+func (p *Parser) parseParam() *Param {
+	start := p.cur().Start
+	param := &Param{}
+	t := p.cur()
+	n := p.peekToken(1)
+	if t.Type == IDENT && !p.isKeyword(t.Value) && !p.isTypeName(t.Value) && (n.is(PUNCT, ",") || n.is(PUNCT, ")")) {
+		// K&R identifier list
+		p.next()
+		param.Type = &TypeSpec{Name: "int"}
+		param.Decl = &Declarator{Name: t.Value}
+		param.Decl.setRange(t.Start, t.End)
+	} else {
+		param.Type = p.parseDeclSpecifiers(specType)
+		param.Decl = p.parseDeclarator()
+		if param.Decl.Init != nil {
+			param.Default = param.Decl.Init
+			param.Decl.Init = nil
+		}
+	}
+	param.setRange(start, p.lastEnd)
+	return param
+}
+
+// ============================ statement ============================
+
+func (p *Parser) parseStatementRecover() (ret Stmt) {
+	startIndex := p.index
+	defer func() {
+		if ret == nil {
+			ret = &ExprStmt{}
+		}
+	}()
+	defer p.recoverError(startIndex)
+	return p.parseStatement()
+}
+
+func (p *Parser) parseCompound() *Compound {
+	start := p.expectPunct("{").Start
+	block := &Compound{}
+	for !p.at(EOF) && !p.atPunct("}") {
+		block.Items = append(block.Items, p.parseStatementRecover())
+	}
+	p.expectPunct("}")
+	block.setRange(start, p.lastEnd)
+	return block
+}
+
+func (p *Parser) parseStatement() Stmt {
+	t := p.cur()
+	start := t.Start
+	if t.is(PUNCT, "{") {
+		return p.parseCompound()
+	}
+	if t.is(PUNCT, ";") {
+		p.next()
+		s := &ExprStmt{}
+		s.setRange(start, p.lastEnd)
+		return s
+	}
+
+	if t.Type == IDENT {
+		var ret Stmt
+		switch t.Value {
+		case "if":
+			ret = p.parseIf()
+		case "while":
+			ret = p.parseWhile()
+		case "do":
+			ret = p.parseDoWhile()
+		case "for":
+			ret = p.parseFor()
+		case "switch":
+			ret = p.parseSwitch()
+		case "return":
+			p.next()
+			s := &Return{}
+			if !p.atPunct(";") {
+				if p.atPunct("{") {
+					s.X = p.parseInitList()
+				} else {
+					s.X = p.parseExpr()
+				}
+			}
+			p.expectPunct(";")
+			ret = s
+		case "break":
+			p.next()
+			p.expectPunct(";")
+			ret = &Break{}
+		case "continue":
+			p.next()
+			p.expectPunct(";")
+			ret = &Continue{}
+		case "goto":
+			p.next()
+			s := &Goto{}
+			if p.at(IDENT) {
+				s.Label = p.next().Value
+			} else {
+				// gnu computed goto
+				p.parseExpr()
+			}
+			p.expectPunct(";")
+			ret = s
+		case "case", "default":
+			if t.Value == "default" && !p.peekToken(1).is(PUNCT, ":") {
+				break
+			}
+			p.next()
+			s := &Case{}
+			if t.Value == "case" {
+				s.Value = p.parseCond()
+				if p.acceptPunct("...") {
+					p.parseCond()
+				}
+			}
+			p.expectPunct(":")
+			if !p.atPunct("}") {
+				s.Body = p.parseStatement()
+			}
+			ret = s
+		case "try":
+			if p.cpp {
+				ret = p.parseTry()
+			}
+		case "asm", "__asm__", "__asm":
+			p.skipUntil(";")
+			ret = &ExprStmt{}
+		}
+		if ret != nil {
+			if n, ok := ret.(interface{ setRange(Pos, Pos) }); ok {
+				n.setRange(start, p.lastEnd)
+			}
+			return ret
+		}
+
+		// label
+		if !p.isKeyword(t.Value) && p.peekToken(1).is(PUNCT, ":") {
+			p.next()
+			p.next()
+			s := &Labeled{Label: t.Value}
+			if !p.atPunct("}") {
+				s.Body = p.parseStatement()
+			}
+			s.setRange(start, p.lastEnd)
+			return s
+		}
+	}
+
+	if p.isDeclStart() {
+		d := p.parseDeclaration()
+		s := &DeclStmt{Decl: d}
+		s.setRange(start, p.lastEnd)
+		return s
+	}
+
+	s := &ExprStmt{X: p.parseExpr()}
+	p.expectPunct(";")
+	s.setRange(start, p.lastEnd)
+	return s
+}
+
+// parseCondition parse condition of `if` `switch` `while`, c++ allow declaration in condition
+func (p *Parser) parseCondition() (Stmt, Expr) {
+	p.expectPunct("(")
+	defer p.expectPunct(")")
+
+	var init Stmt
+	if p.cpp && p.isDeclStart() {
+		start := p.cur().Start
+		spec := p.parseDeclSpecifiers(specDecl)
+		d := p.parseDeclarator()
+		decl := &VarDecl{Type: spec, Declarators: []*Declarator{d}}
+		decl.setRange(start, p.lastEnd)
+		init = &DeclStmt{Decl: decl}
+		if !p.acceptPunct(";") {
+			id := &Ident{Name: d.Name}
+			id.setRange(d.Start, d.Stop)
+			return init, id
+		}
+	} else if p.cpp && p.hasInitStatement() {
+		init = p.parseStatement()
+	}
+	return init, p.parseExpr()
+}
+
+// hasInitStatement check `if (x = f(); x)`
+func (p *Parser) hasInitStatement() bool {
+	depth := 0
+	for i := p.index; i < len(p.tokens); i++ {
+		t := p.tokens[i]
+		switch {
+		case t.is(PUNCT, "("), t.is(PUNCT, "["), t.is(PUNCT, "{"):
+			depth++
+		case t.is(PUNCT, ")"), t.is(PUNCT, "]"), t.is(PUNCT, "}"):
+			depth--
+			if depth < 0 {
+				return false
+			}
+		case t.is(PUNCT, ";"):
+			return depth == 0
+		case t.Type == EOF:
+			return false
+		}
+	}
+	return false
+}
+
+func (p *Parser) parseIf() Stmt {
+	p.next() // if
+	p.acceptKeyword("constexpr")
+	s := &If{}
+	s.Init, s.Cond = p.parseCondition()
+	s.Then = p.parseStatement()
+	if p.acceptKeyword("else") {
+		s.Else = p.parseStatement()
+	}
+	return s
+}
+
+func (p *Parser) parseWhile() Stmt {
+	p.next() // while
+	s := &While{}
+	init, cond := p.parseCondition()
+	s.Cond = cond
+	s.Body = p.parseStatement()
+	if init != nil {
+		block := &Compound{Items: []Stmt{init, s}}
+		return block
+	}
+	return s
+}
+
+func (p *Parser) parseDoWhile() Stmt {
+	p.next() // do
+	s := &DoWhile{}
+	s.Body = p.parseStatement()
+	if !p.acceptKeyword("while") {
+		p.fail("expect 'while' but got %s", p.describe(p.cur()))
+	}
+	p.expectPunct("(")
+	s.Cond = p.parseExpr()
+	p.expectPunct(")")
+	p.expectPunct(";")
+	return s
+}
+
+func (p *Parser) parseFor() Stmt {
+	p.next() // for
+	p.expectPunct("(")
+	s := &For{}
+	initStart := p.cur().Start
+	switch {
+	case p.acceptPunct(";"):
+	case p.isDeclStart():
+		spec := p.parseDeclSpecifiers(specDecl)
+		decl := &VarDecl{Type: spec}
+		for {
+			d := p.parseDeclarator()
+			decl.Declarators = append(decl.Declarators, d)
+			if p.cpp && len(decl.Declarators) == 1 && p.atPunct(":") {
+				// range for
+				p.next()
+				decl.setRange(initStart, p.lastEnd)
+				rf := &RangeFor{Decl: decl}
+				if p.atPunct("{") {
+					rf.Range = p.parseInitList()
+				} else {
+					rf.Range = p.parseExpr()
+				}
+				p.expectPunct(")")
+				rf.Body = p.parseStatement()
+				return rf
+			}
+			if !p.acceptPunct(",") {
+				break
+			}
+		}
+		p.expectPunct(";")
+		decl.setRange(initStart, p.lastEnd)
+		s.Init = &DeclStmt{Decl: decl}
+		s.Init.(*DeclStmt).setRange(initStart, p.lastEnd)
+	default:
+		init := &ExprStmt{X: p.parseExpr()}
+		p.expectPunct(";")
+		init.setRange(initStart, p.lastEnd)
+		s.Init = init
+	}
+	if !p.atPunct(";") {
+		s.Cond = p.parseExpr()
+	}
+	p.expectPunct(";")
+	if !p.atPunct(")") {
+		s.Post = p.parseExpr()
+	}
+	p.expectPunct(")")
+	s.Body = p.parseStatement()
+	return s
+}
+
+func (p *Parser) parseSwitch() Stmt {
+	p.next() // switch
+	s := &Switch{}
+	init, cond := p.parseCondition()
+	s.Cond = cond
+	if !p.atPunct("{") {
+		body := p.parseStatement()
+		clause := &CaseClause{Body: []Stmt{body}}
+		clause.setRange(body.GetStart(), body.GetStop())
+		s.Cases = append(s.Cases, clause)
+		return s
+	}
+	p.expectPunct("{")
+	var clause *CaseClause
+	newClause := func(start Pos) {
+		if clause != nil && len(clause.Body) == 0 {
+			// fallthrough labels share the body
+			return
+		}
+		clause = &CaseClause{}
+		clause.setRange(start, start)
+		s.Cases = append(s.Cases, clause)
+	}
+	for !p.at(EOF) && !p.atPunct("}") {
+		t := p.cur()
+		switch {
+		case t.is(IDENT, "case"):
+			p.next()
+			newClause(t.Start)
+			clause.Values = append(clause.Values, p.parseCond())
+			if p.acceptPunct("...") {
+				// gnu case range
+				p.parseCond()
+			}
+			p.expectPunct(":")
+			continue
+		case t.is(IDENT, "default") && p.peekToken(1).is(PUNCT, ":"):
+			p.next()
+			p.next()
+			newClause(t.Start)
+			clause.IsDefault = true
+			continue
+		}
+		stmt := p.parseStatementRecover()
+		if clause == nil {
+			// unreachable statements before the first label
+			continue
+		}
+		clause.Body = append(clause.Body, stmt)
+		clause.Stop = p.lastEnd
+	}
+	p.expectPunct("}")
+	if init != nil {
+		return &Compound{Items: []Stmt{init, s}}
+	}
+	return s
+}
+
+func (p *Parser) parseTry() Stmt {
+	p.next() // try
+	s := &Try{Body: p.parseCompound()}
+	s.Handlers = p.parseCatches()
+	return s
+}
+
+func (p *Parser) parseCatches() []*Catch {
+	var handlers []*Catch
+	for p.atKeyword("catch") {
+		start := p.next().Start
+		c := &Catch{}
+		p.expectPunct("(")
+		if !p.acceptPunct("...") {
+			c.Param = p.parseParam()
+		}
+		p.expectPunct(")")
+		c.Body = p.parseCompound()
+		c.setRange(start, p.lastEnd)
+		handlers = append(handlers, c)
+	}
+	return handlers
+}
@@ -0,0 +1,635 @@
+package cparser
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ============================ expression ============================
+
+// parseExpr parse comma expression
+func (p *Parser) parseExpr() Expr {
+	start := p.cur().Start
+	x := p.parseAssign()
+	for p.atPunct(",") {
+		p.next()
+		y := p.parseAssign()
+		b := &Binary{Op: ",", X: x, Y: y}
+		b.setRange(start, p.lastEnd)
+		x = b
+	}
+	return x
+}
+
+var assignOps = map[string]string{
+	"=": "", "+=": "+", "-=": "-", "*=": "*", "/=": "/", "%=": "%",
+	"<<=": "<<", ">>=": ">>", "&=": "&", "^=": "^", "|=": "|",
+}
+
+func (p *Parser) parseAssign() Expr {
+	start := p.cur().Start
+	if p.cpp && p.atKeyword("throw") {
+		p.next()
+		t := &Throw{}
+		if !p.atPunct(";", ")", ",", "}", ":") {
+			t.X = p.parseAssign()
+		}
+		t.setRange(start, p.lastEnd)
+		return t
+	}
+	x := p.parseCond()
+	if t := p.cur(); t.Type == PUNCT {
+		if op, ok := assignOps[t.Value]; ok {
+			p.next()
+			var y Expr
+			if p.atPunct("{") {
+				y = p.parseInitList()
+			} else {
+				y = p.parseAssign()
+			}
+			a := &Assign{Op: op, X: x, Y: y}
+			a.setRange(start, p.lastEnd)
+			return a
+		}
+	}
+	return x
+}
+
+func (p *Parser) parseCond() Expr {
+	start := p.cur().Start
+	x := p.parseBinary(1)
+	if !p.acceptPunct("?") {
+		return x
+	}
+	c := &Cond{Cond: x}
+	if !p.atPunct(":") {
+		c.Then = p.parseExpr()
+	}
+	p.expectPunct(":")
+	c.Else = p.parseAssign()
+	c.setRange(start, p.lastEnd)
+	return c
+}
+
+var binaryPrec = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7, "<=>": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+	".*": 11, "->*": 11,
+}
+
+func (p *Parser) parseBinary(minPrec int) Expr {
+	start := p.cur().Start
+	x := p.parseUnary()
+	for {
+		t := p.cur()
+		if t.Type != PUNCT {
+			return x
+		}
+		prec, ok := binaryPrec[t.Value]
+		if !ok || prec < minPrec {
+			return x
+		}
+		p.next()
+		y := p.parseBinary(prec + 1)
+		b := &Binary{Op: t.Value, X: x, Y: y}
+		b.setRange(start, p.lastEnd)
+		x = b
+	}
+}
+
+func (p *Parser) parseUnary() Expr {
+	t := p.cur()
+	start := t.Start
+	switch {
+	case t.Type == PUNCT:
+		switch t.Value {
+		case "++", "--", "+", "-", "!", "~", "*", "&", "&&":
+			p.next()
+			u := &Unary{Op: t.Value, X: p.parseCastExpr()}
+			u.setRange(start, p.lastEnd)
+			return u
+		case "(":
+			if p.isCastStart() {
+				return p.parseCast()
+			}
+		}
+	case t.Type == IDENT:
+		switch t.Value {
+		case "sizeof", "_Alignof", "alignof", "__alignof__", "__alignof":
+			return p.parseSizeof()
+		case "__extension__", "__real__", "__imag__":
+			p.next()
+			return p.parseCastExpr()
+		case "new":
+			if p.cpp {
+				return p.parseNew()
+			}
+		case "delete":
+			if p.cpp {
+				p.next()
+				if p.atPunct("[") && p.peekToken(1).is(PUNCT, "]") {
+					p.next()
+					p.next()
+				}
+				d := &Delete{X: p.parseCastExpr()}
+				d.setRange(start, p.lastEnd)
+				return d
+			}
+		}
+	}
+	return p.parsePostfix(p.parsePrimary())
+}
+
+func (p *Parser) parseCastExpr() Expr {
+	if p.atPunct("(") && p.isCastStart() {
+		return p.parseCast()
+	}
+	return p.parseUnary()
+}
+
+// isCastStart check `(type)`, the unknown type name is guessed by the tokens after `)`
+func (p *Parser) isCastStart() bool {
+	i := p.index + 1
+	t := p.tokenAt(i)
+	if t.Type != IDENT && !(p.cpp && t.is(PUNCT, "::")) {
+		return false
+	}
+	if p.isTypeSpecifierKeyword(t.Value) {
+		return true
+	}
+	if p.isKeyword(t.Value) {
+		return false
+	}
+	j := p.scanTypeName(i)
+	if j < 0 {
+		return false
+	}
+	known := p.isTypeName(t.Value)
+	pointer := false
+	for p.tokenAt(j).is(PUNCT, "*") || p.tokenAt(j).is(IDENT, "const") || p.tokenAt(j).is(IDENT, "volatile") || (p.cpp && p.tokenAt(j).is(PUNCT, "&")) {
+		pointer = true
+		j++
+	}
+	if !p.tokenAt(j).is(PUNCT, ")") {
+		// `(T (*)(int))`, `(T[2])`
+		return known && (p.tokenAt(j).is(PUNCT, "(") || p.tokenAt(j).is(PUNCT, "["))
+	}
+	if known || pointer {
+		return true
+	}
+	after := p.tokenAt(j + 1)
+	switch after.Type {
+	case IDENT:
+		return !p.isKeyword(after.Value) || after.Value == "sizeof"
+	case NUMBER, STRING, CHAR:
+		return true
+	}
+	return false
+}
+
+func (p *Parser) parseTypeName() (*TypeSpec, int) {
+	spec := p.parseDeclSpecifiers(specType)
+	d := p.parseDeclarator()
+	return spec, d.Pointer + len(d.Array)
+}
+
+func (p *Parser) parseCast() Expr {
+	start := p.expectPunct("(").Start
+	c := &Cast{}
+	c.Type, c.Pointer = p.parseTypeName()
+	p.expectPunct(")")
+	if p.atPunct("{") {
+		// compound literal
+		c.X = p.parsePostfix(p.parseInitList())
+	} else {
+		c.X = p.parseCastExpr()
+	}
+	c.setRange(start, p.lastEnd)
+	return c
+}
+
+func (p *Parser) parseSizeof() Expr {
+	start := p.next().Start
+	s := &Sizeof{}
+	if p.acceptPunct("...") {
+		// sizeof...(pack)
+		p.skipBalanced()
+	} else if p.atPunct("(") && p.isTypeStart(p.index+1) && !p.isTypeInParenExpr() {
+		p.next()
+		p.parseTypeName()
+		p.expectPunct(")")
+	} else {
+		s.X = p.parseUnary()
+	}
+	s.setRange(start, p.lastEnd)
+	return s
+}
+
+// isTypeInParenExpr check `sizeof (T)` where T is typedef name but used as variable, such as `sizeof(len) - 1`
+func (p *Parser) isTypeInParenExpr() bool {
+	t := p.tokenAt(p.index + 1)
+	if p.isTypeSpecifierKeyword(t.Value) {
+		return false
+	}
+	return p.tokenAt(p.index+2).is(PUNCT, ".") || p.tokenAt(p.index+2).is(PUNCT, "->") || p.tokenAt(p.index+2).is(PUNCT, "[")
+}
+
+func (p *Parser) parseNew() Expr {
+	start := p.next().Start // new
+	n := &New{}
+	if p.atPunct("(") {
+		// placement new
+		p.skipBalanced()
+	}
+	if p.atPunct("(") {
+		p.next()
+		n.Type, _ = p.parseTypeName()
+		p.expectPunct(")")
+	} else {
+		n.Type = p.parseDeclSpecifiers(specType)
+		p.parsePointers(&Declarator{})
+	}
+	if p.atPunct("[") {
+		p.next()
+		n.Array = p.parseExpr()
+		p.expectPunct("]")
+		for p.atPunct("[") {
+			p.skipBalanced()
+		}
+	}
+	switch {
+	case p.atPunct("("):
+		n.Args = p.parseArgs()
+	case p.atPunct("{"):
+		for _, elem := range p.parseInitList().(*InitList).Elems {
+			n.Args = append(n.Args, elem.Value)
+		}
+	}
+	n.setRange(start, p.lastEnd)
+	return n
+}
+
+func (p *Parser) parseArgs() []Expr {
+	p.expectPunct("(")
+	var args []Expr
+	for !p.at(EOF) && !p.atPunct(")") {
+		if p.atPunct("{") {
+			args = append(args, p.parseInitList())
+		} else {
+			args = append(args, p.parseAssign())
+		}
+		p.acceptPunct("...")
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+	p.expectPunct(")")
+	return args
+}
+
+func (p *Parser) parseInitList() Expr {
+	start := p.expectPunct("{").Start
+	list := &InitList{}
+	for !p.at(EOF) && !p.atPunct("}") {
+		elemStart := p.cur().Start
+		elem := &InitElem{}
+		// designator `.a.b[1] =`
+		designated := false
+		for p.atPunct(".") || p.atPunct("[") {
+			designated = true
+			if p.acceptPunct(".") {
+				name := p.expectIdent().Value
+				if elem.Field == "" && elem.Index == nil {
+					elem.Field = name
+				}
+				continue
+			}
+			p.next()
+			index := p.parseCond()
+			if p.acceptPunct("...") {
+				p.parseCond()
+			}
+			p.expectPunct("]")
+			if elem.Field == "" && elem.Index == nil {
+				elem.Index = index
+			}
+		}
+		if designated {
+			p.acceptPunct("=")
+		} else if p.at(IDENT) && p.peekToken(1).is(PUNCT, ":") && !p.isKeyword(p.cur().Value) {
+			// gnu old style designator `field: value`
+			elem.Field = p.next().Value
+			p.next()
+		}
+		if p.atPunct("{") {
+			elem.Value = p.parseInitList()
+		} else {
+			elem.Value = p.parseAssign()
+		}
+		elem.setRange(elemStart, p.lastEnd)
+		list.Elems = append(list.Elems, elem)
+		if !p.acceptPunct(",") {
+			break
+		}
+	}
+	p.expectPunct("}")
+	list.setRange(start, p.lastEnd)
+	return list
+}
+
+func (p *Parser) parsePostfix(x Expr) Expr {
+	start := x.GetStart()
+	for {
+		t := p.cur()
+		switch {
+		case t.is(PUNCT, "["):
+			p.next()
+			var index Expr
+			if p.atPunct("{") {
+				index = p.parseInitList()
+			} else {
+				index = p.parseExpr()
+			}
+			p.expectPunct("]")
+			e := &Index{X: x, Index: index}
+			e.setRange(start, p.lastEnd)
+			x = e
+		case t.is(PUNCT, "("):
+			e := &Call{Func: x, Args: p.parseArgs()}
+			e.setRange(start, p.lastEnd)
+			x = e
+		case t.is(PUNCT, ".") || t.is(PUNCT, "->"):
+			p.next()
+			p.acceptKeyword("template")
+			var name string
+			switch {
+			case p.atPunct("~"):
+				p.next()
+				name = "~" + p.expectIdent().Value
+			default:
+				name = p.parseDeclaratorName()
+				if name == "" {
+					p.fail("expect member name but got %s", p.describe(p.cur()))
+				}
+			}
+			e := &Member{X: x, Name: name, Arrow: t.Value == "->"}
+			e.setRange(start, p.lastEnd)
+			x = e
+		case t.is(PUNCT, "++") || t.is(PUNCT, "--"):
+			p.next()
+			e := &Postfix{Op: t.Value, X: x}
+			e.setRange(start, p.lastEnd)
+			x = e
+		default:
+			return x
+		}
+	}
+}
+
+// formatMacro is the format macros from <inttypes.h>, such as "%" PRIu64
+var formatMacro = regexp.MustCompile(`^(PRI|SCN)[diouxXn]\w*$`)
+
+// specialCalls is builtin functions with type argument
+var specialCalls = map[string]struct{}{
+	"va_arg": {}, "__builtin_va_arg": {}, "offsetof": {}, "__builtin_offsetof": {},
+	"__builtin_types_compatible_p": {}, "_Generic": {}, "va_start": {}, "__builtin_va_start": {},
+}
+
+func (p *Parser) parsePrimary() Expr {
+	t := p.cur()
+	start := t.Start
+	switch t.Type {
+	case NUMBER, CHAR:
+		p.next()
+		l := &Literal{Kind: t.Type, Value: t.Value}
+		l.setRange(start, p.lastEnd)
+		return l
+	case STRING:
+		return p.parseString()
+	case IDENT:
+		return p.parseIdentExpr()
+	case PUNCT:
+		switch t.Value {
+		case "(":
+			p.next()
+			var x Expr
+			if p.atPunct("{") {
+				// gnu statement expression
+				p.funcDepth++
+				e := &StmtExpr{Body: p.parseCompound()}
+				p.funcDepth--
+				x = e
+			} else {
+				x = p.parseExpr()
+			}
+			p.expectPunct(")")
+			if n, ok := x.(*StmtExpr); ok {
+				n.setRange(start, p.lastEnd)
+			}
+			return x
+		case "{":
+			return p.parseInitList()
+		case "[":
+			if p.cpp {
+				return p.parseLambda()
+			}
+		case "::":
+			if p.cpp {
+				return p.parseIdentExpr()
+			}
+		}
+	}
+	p.fail("unexpected %s", p.describe(t))
+	return nil
+}
+
+func (p *Parser) parseString() Expr {
+	t := p.cur()
+	l := &Literal{Kind: STRING}
+	var buf strings.Builder
+	prefix := ""
+	for {
+		switch {
+		case p.at(STRING):
+			v := p.next().Value
+			idx := strings.IndexByte(v, '"')
+			if idx > 0 && prefix == "" {
+				prefix = v[:idx]
+			}
+			if strings.HasPrefix(v[idx:], `"`) && strings.HasSuffix(v, `"`) && len(v)-idx >= 2 {
+				if strings.Contains(v[:idx], "R") {
+					// raw string is kept as is
+					buf.WriteString(v[idx:])
+					continue
+				}
+				buf.WriteString(v[idx+1 : len(v)-1])
+			}
+			continue
+		case p.at(IDENT) && formatMacro.MatchString(p.cur().Value):
+			p.next()
+			continue
+		}
+		break
+	}
+	l.Value = prefix + `"` + buf.String() + `"`
+	l.setRange(t.Start, p.lastEnd)
+	return l
+}
+
+func (p *Parser) parseIdentExpr() Expr {
+	t := p.cur()
+	start := t.Start
+	if p.cpp {
+		switch t.Value {
+		case "static_cast", "dynamic_cast", "reinterpret_cast", "const_cast":
+			p.next()
+			c := &Cast{}
+			p.expectPunct("<")
+			c.Type, c.Pointer = p.parseTypeName()
+			if p.atPunct(">>") {
+				// `static_cast<A<int>>(x)` is not supported, split is not needed for the common case
+				p.fail("unexpected '>>'")
+			}
+			p.expectPunct(">")
+			p.expectPunct("(")
+			c.X = p.parseExpr()
+			p.expectPunct(")")
+			c.setRange(start, p.lastEnd)
+			return c
+		case "typeid":
+			p.next()
+			p.skipBalanced()
+			l := &Literal{Kind: STRING, Value: `""`}
+			l.setRange(start, p.lastEnd)
+			return l
+		}
+	}
+
+	if _, ok := specialCalls[t.Value]; ok && p.peekToken(1).is(PUNCT, "(") {
+		p.next()
+		call := &Call{}
+		id := &Ident{Name: t.Value}
+		id.setRange(t.Start, t.End)
+		call.Func = id
+		p.next() // (
+		if t.Value != "offsetof" && t.Value != "__builtin_offsetof" && !p.atPunct(")") {
+			call.Args = append(call.Args, p.parseAssign())
+		}
+		// the rest arguments contain type
+		depth := 0
+		for !p.at(EOF) {
+			if p.atPunct("(") {
+				depth++
+			} else if p.atPunct(")") {
+				if depth == 0 {
+					break
+				}
+				depth--
+			}
+			p.next()
+		}
+		p.expectPunct(")")
+		call.setRange(start, p.lastEnd)
+		return call
+	}
+
+	// builtin type functional cast `int(x)`
+	if _, ok := builtinTypes[t.Value]; ok && p.cpp {
+		p.next()
+		c := &Cast{Type: &TypeSpec{Name: t.Value}}
+		if p.atPunct("{") {
+			c.X = p.parseInitList()
+		} else {
+			p.expectPunct("(")
+			if !p.atPunct(")") {
+				c.X = p.parseExpr()
+			}
+			p.expectPunct(")")
+		}
+		c.setRange(start, p.lastEnd)
+		return c
+	}
+
+	if p.isKeyword(t.Value) {
+		switch t.Value {
+		case "this", "nullptr", "true", "false":
+		default:
+			p.fail("unexpected %s", p.describe(t))
+		}
+	}
+
+	var buf strings.Builder
+	if p.atPunct("::") {
+		buf.WriteString(p.next().Value)
+	}
+	for {
+		if p.atKeyword("operator") {
+			buf.WriteString(p.parseDeclaratorName())
+			break
+		}
+		if p.atPunct("~") {
+			buf.WriteString(p.next().Value)
+		}
+		buf.WriteString(p.expectIdent2().Value)
+		if p.cpp && p.atPunct("<") {
+			if end := p.scanTemplateArgs(p.index); end > 0 {
+				after := p.tokenAt(end)
+				if after.is(PUNCT, "(") || after.is(PUNCT, "::") || after.is(PUNCT, "{") {
+					for p.index < end {
+						buf.WriteString(p.next().Value)
+					}
+				}
+			}
+		}
+		if p.cpp && p.atPunct("::") {
+			buf.WriteString(p.next().Value)
+			continue
+		}
+		break
+	}
+	id := &Ident{Name: buf.String()}
+	id.setRange(start, p.lastEnd)
+	if p.cpp && p.atPunct("{") && p.isTypeName(id.Name) {
+		// c++ list initialization `T{...}`
+		c := &Cast{Type: &TypeSpec{Name: id.Name}, X: p.parseInitList()}
+		c.setRange(start, p.lastEnd)
+		return c
+	}
+	return id
+}
+
+// expectIdent2 expect identifier, `this`, `nullptr`, `true` and `false` are allowed
+func (p *Parser) expectIdent2() *Token {
+	t := p.cur()
+	if t.Type == IDENT {
+		switch t.Value {
+		case "this", "nullptr", "true", "false":
+			return p.next()
+		}
+	}
+	return p.expectIdent()
+}
+
+func (p *Parser) parseLambda() Expr {
+	start := p.cur().Start
+	p.skipBalanced() // capture
+	l := &Lambda{}
+	if p.atPunct("(") {
+		l.Params = p.parseParams().Params
+	}
+	for p.atKeyword("mutable", "constexpr", "noexcept", "static") {
+		p.next()
+		if p.atPunct("(") {
+			p.skipBalanced()
+		}
+	}
+	if p.acceptPunct("->") {
+		p.parseTypeName()
+	}
+	p.funcDepth++
+	l.Body = p.parseCompound()
+	p.funcDepth--
+	l.setRange(start, p.lastEnd)
+	return l
+}
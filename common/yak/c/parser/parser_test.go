@@ -0,0 +1,274 @@
+package cparser
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func mustParse(t *testing.T, code string, cpp bool) *TranslationUnit {
+	t.Helper()
+	unit, err := Parse(code, cpp)
+	require.NoError(t, err)
+	require.NotNil(t, unit)
+	return unit
+}
+
+func values(tokens []*Token) string {
+	var list []string
+	for _, t := range tokens {
+		if t.Type != EOF {
+			list = append(list, t.Value)
+		}
+	}
+	return strings.Join(list, " ")
+}
+
+func TestPreprocess_Macro(t *testing.T) {
+	pp := NewPreprocessor(nil)
+	tokens := pp.Process("a.c", `
+#define N 10
+#define ADD(a, b) ((a) + (b))
+#define STR(x) #x
+#define CAT(a, b) a ## b
+#define LOG(fmt, ...) printf(fmt, ##__VA_ARGS__)
+#define SELF SELF + 1
+int x = ADD(N, 2);
+char *s = STR(hello world);
+int CAT(var, 1) = 0;
+LOG("a");
+LOG("%d", x);
+int y = SELF;
+#undef N
+int z = N;
+`)
+	require.Empty(t, pp.Errors())
+	require.Equal(t, strings.Join([]string{
+		"int x = ( ( 10 ) + ( 2 ) ) ;",
+		`char * s = "hello world" ;`,
+		"int var1 = 0 ;",
+		`printf ( "a" ) ;`,
+		`printf ( "%d" , x ) ;`,
+		"int y = SELF + 1 ;",
+		"int z = N ;",
+	}, " "), values(tokens))
+}
+
+func TestPreprocess_Conditional(t *testing.T) {
+	pp := NewPreprocessor(nil)
+	pp.Define("LINUX", "1")
+	tokens := pp.Process("a.c", `
+#if defined(LINUX) && VERSION >= 2
+a
+#elif LINUX
+b
+#else
+c
+#endif
+#ifndef LINUX
+d
+#else
+#  ifdef WIN32
+e
+#  endif
+f
+#endif
+#if 0
+#error unreachable
+#endif
+`)
+	require.Empty(t, pp.Errors())
+	require.Equal(t, "b f", values(tokens))
+}
+
+func TestPreprocess_Include(t *testing.T) {
+	headers := map[string]string{
+		"util.h": "#pragma once\n#define BUF_SIZE 64\nint util(char *s);\n",
+	}
+	pp := NewPreprocessor(func(name string, system bool) (string, string, bool) {
+		src, ok := headers[name]
+		return name, src, ok
+	})
+	tokens := pp.Process("main.c", `#include <stdio.h>
+#include "util.h"
+#include "util.h"
+char buf[BUF_SIZE];
+`)
+	require.Empty(t, pp.Errors())
+	require.Equal(t, "int util ( char * s ) ; char buf [ 64 ] ;", values(tokens))
+	// tokens from header are located at the include directive
+	require.Equal(t, 2, tokens[0].Start.Line)
+	// tokens from macro are located at the macro invocation
+	require.Equal(t, 4, tokens[11].Start.Line)
+	require.Equal(t, 9, tokens[11].Start.Column)
+}
+
+func TestParse_C(t *testing.T) {
+	unit := mustParse(t, `
+typedef struct node {
+	int value;
+	struct node *next;
+	char name[32];
+} node_t;
+
+enum color { RED, GREEN = 2, BLUE };
+
+static int count = 0, *ptr;
+int (*handler)(int, char **);
+SSL *ssl;
+
+int main(int argc, char *argv[]) {
+	node_t n = {.value = 1, .next = NULL};
+	char buf[64];
+	unsigned long len = sizeof(buf) - 1;
+	if (argc > 1) {
+		strcpy(buf, argv[1]);
+	} else if (argc == 0) {
+		return -1;
+	}
+	for (int i = 0; i < argc; i++) {
+		count += (int) len;
+	}
+	switch (count) {
+	case 0:
+	case 1:
+		break;
+	default:
+		count = count > 10 ? 10 : count;
+	}
+	do {
+		n.next = &n;
+	} while (0);
+	system(buf);
+	return 0;
+}
+
+int old(a, b)
+	int a;
+	char *b;
+{
+	return a;
+}
+`, false)
+	require.Len(t, unit.Decls, 7)
+
+	s := unit.Decls[0].(*VarDecl)
+	require.True(t, s.Type.Typedef)
+	require.Equal(t, "node", s.Type.Struct.Name)
+	require.Len(t, s.Type.Struct.Fields, 3)
+	require.Equal(t, 1, s.Type.Struct.Fields[1].Declarators[0].Pointer)
+
+	e := unit.Decls[1].(*EnumDecl)
+	require.Len(t, e.Items, 3)
+
+	v := unit.Decls[2].(*VarDecl)
+	require.True(t, v.Type.Static)
+	require.Len(t, v.Declarators, 2)
+
+	fp := unit.Decls[3].(*VarDecl).Declarators[0]
+	require.Equal(t, "handler", fp.Name)
+	require.NotNil(t, fp.Func)
+	require.Equal(t, 1, fp.Pointer)
+	require.True(t, fp.FuncPointer)
+	require.False(t, fp.IsFunction())
+
+	require.Equal(t, "SSL", unit.Decls[4].(*VarDecl).Type.Name)
+
+	main := unit.Decls[5].(*FuncDef)
+	require.Equal(t, "main", main.Name)
+	require.Len(t, main.Params(), 2)
+	require.True(t, main.Params()[1].Decl.IsPointer())
+	require.Len(t, main.Body.Items, 9)
+	sw := main.Body.Items[5].(*Switch)
+	require.Len(t, sw.Cases, 2)
+	require.Len(t, sw.Cases[0].Values, 2)
+	require.True(t, sw.Cases[1].IsDefault)
+
+	old := unit.Decls[6].(*FuncDef)
+	require.Equal(t, "char", old.Params()[1].Type.Name)
+}
+
+func TestParse_CPP(t *testing.T) {
+	unit := mustParse(t, `
+namespace app {
+class Base {};
+class Server : public Base {
+public:
+	Server(int port) : port_(port) {}
+	virtual ~Server() {}
+	void run(const std::string &cmd) {
+		system(cmd.c_str());
+	}
+	static Server *create();
+private:
+	int port_;
+};
+}
+
+Server *Server::create() {
+	return new Server(8080);
+}
+
+int main() {
+	std::string cmd = "ls";
+	std::vector<int> list{1, 2};
+	Server s(80);
+	auto *p = new Server(1);
+	p->run(cmd);
+	for (auto &x : list) {
+		std::cout << x << std::endl;
+	}
+	try {
+		throw 1;
+	} catch (const std::exception &e) {
+	} catch (...) {
+	}
+	auto f = [&](int a) { return a + 1; };
+	delete p;
+	return static_cast<int>(f(1));
+}
+`, true)
+	require.Len(t, unit.Decls, 3)
+	ns := unit.Decls[0].(*Namespace)
+	require.Len(t, ns.Decls, 2)
+	class := ns.Decls[1].(*StructDecl)
+	require.Equal(t, []string{"Base"}, class.Bases)
+	require.Len(t, class.Methods, 3)
+	require.Equal(t, "Server", class.Methods[0].Name)
+	require.Len(t, class.Methods[0].Inits, 1)
+	require.Len(t, class.Fields, 2)
+
+	create := unit.Decls[1].(*FuncDef)
+	require.Equal(t, "Server", create.Owner)
+	require.Equal(t, "create", create.Name)
+
+	main := unit.Decls[2].(*FuncDef)
+	require.Len(t, main.Body.Items, 10)
+	s := main.Body.Items[2].(*DeclStmt).Decl.(*VarDecl).Declarators[0]
+	require.True(t, s.HasArgs)
+	require.IsType(t, &RangeFor{}, main.Body.Items[5])
+	require.IsType(t, &Try{}, main.Body.Items[6])
+}
+
+func TestParse_Position(t *testing.T) {
+	unit := mustParse(t, "int a;\nint f() {\n  return a;\n}\n", false)
+	fn := unit.Decls[1].(*FuncDef)
+	require.Equal(t, Pos{Line: 2, Column: 0}, fn.GetStart())
+	ret := fn.Body.Items[0].(*Return)
+	require.Equal(t, Pos{Line: 3, Column: 2}, ret.GetStart())
+	require.Equal(t, Pos{Line: 3, Column: 11}, ret.GetStop())
+}
+
+func TestParse_ErrorRecover(t *testing.T) {
+	unit, errs := ParseEx(`
+int f() {
+	int a = ;
+	return 1;
+}
+int g() { return 2; }
+`, false)
+	require.NotEmpty(t, errs)
+	require.Len(t, unit.Decls, 2)
+	require.Len(t, unit.Decls[0].(*FuncDef).Body.Items, 2)
+}
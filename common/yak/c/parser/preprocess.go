@@ -0,0 +1,563 @@
+package cparser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// IncludeResolver find the header file for `#include`, the path is used to identify
+// the header (for `#pragma once`), system means `#include <name>`.
+type IncludeResolver func(name string, system bool) (path string, src string, ok bool)
+
+type Macro struct {
+	Name     string
+	FuncLike bool
+	Params   []string
+	Variadic bool
+	Body     []*Token
+}
+
+// Preprocessor handles `#include`, `#define` and conditional compilation, the
+// output is the token stream for parser. Tokens from included file and macro
+// expansion use the position of `#include` directive and macro invocation, so
+// every token can be located in the file being compiled.
+type Preprocessor struct {
+	macros   map[string]*Macro
+	resolver IncludeResolver
+
+	// once is the headers should not be included again (`#pragma once`)
+	once map[string]struct{}
+	// files is the include stack
+	files []string
+
+	errors []string
+}
+
+const maxIncludeDepth = 64
+
+func NewPreprocessor(resolver IncludeResolver) *Preprocessor {
+	pp := &Preprocessor{
+		macros:   make(map[string]*Macro),
+		resolver: resolver,
+		once:     make(map[string]struct{}),
+	}
+	pp.Define("__STDC__", "1")
+	pp.Define("__STDC_VERSION__", "201112L")
+	pp.Define("__STDC_HOSTED__", "1")
+	return pp
+}
+
+func (pp *Preprocessor) Errors() []string {
+	return pp.errors
+}
+
+func (pp *Preprocessor) errorf(p Pos, format string, args ...any) {
+	pp.errors = append(pp.errors, fmt.Sprintf("line %d:%d %s", p.Line, p.Column, fmt.Sprintf(format, args...)))
+}
+
+// Define define object-like macro, value is the replacement text.
+func (pp *Preprocessor) Define(name, value string) {
+	body := NewLexer(value).Tokenize()
+	pp.macros[name] = &Macro{Name: name, Body: body[:len(body)-1]}
+}
+
+func (pp *Preprocessor) Undef(name string) {
+	delete(pp.macros, name)
+}
+
+func (pp *Preprocessor) IsDefined(name string) bool {
+	_, ok := pp.macros[name]
+	return ok
+}
+
+// CurrentFile is the path of file being processed, the resolver can use it to
+// find header relative to the including file.
+func (pp *Preprocessor) CurrentFile() string {
+	if len(pp.files) == 0 {
+		return ""
+	}
+	return pp.files[len(pp.files)-1]
+}
+
+// Process preprocess the source code of file, the last token is always EOF.
+func (pp *Preprocessor) Process(path, src string) []*Token {
+	lexer := NewLexer(src)
+	tokens := lexer.Tokenize()
+	pp.errors = append(pp.errors, lexer.Errors()...)
+	ret := pp.process(path, tokens[:len(tokens)-1])
+	return append(ret, tokens[len(tokens)-1])
+}
+
+type condState struct {
+	// active means the current branch is compiled
+	active bool
+	// taken means one of the branch is already compiled
+	taken bool
+	// parent means the enclosing conditional is active
+	parent bool
+	// hasElse means `#else` is seen
+	hasElse bool
+}
+
+func (pp *Preprocessor) process(path string, tokens []*Token) []*Token {
+	pp.files = append(pp.files, path)
+	defer func() { pp.files = pp.files[:len(pp.files)-1] }()
+
+	var out, text []*Token
+	var conds []*condState
+	active := func() bool {
+		return len(conds) == 0 || conds[len(conds)-1].active
+	}
+	flush := func() {
+		if len(text) > 0 {
+			out = append(out, pp.expand(text)...)
+			text = nil
+		}
+	}
+
+	for i := 0; i < len(tokens); {
+		t := tokens[i]
+		if !(t.BOL && t.is(PUNCT, "#")) {
+			// text line
+			j := i + 1
+			for j < len(tokens) && !tokens[j].BOL {
+				j++
+			}
+			if active() {
+				text = append(text, tokens[i:j]...)
+			}
+			i = j
+			continue
+		}
+
+		// directive line
+		j := i + 1
+		for j < len(tokens) && !tokens[j].BOL {
+			j++
+		}
+		line := tokens[i+1 : j]
+		hash := t
+		i = j
+		if len(line) == 0 {
+			// null directive
+			continue
+		}
+		name := line[0].Value
+		args := line[1:]
+
+		switch name {
+		case "if", "ifdef", "ifndef":
+			parent := active()
+			cond := false
+			if parent {
+				switch name {
+				case "if":
+					cond = pp.evalCondition(args)
+				case "ifdef":
+					cond = len(args) > 0 && pp.IsDefined(args[0].Value)
+				case "ifndef":
+					cond = len(args) > 0 && !pp.IsDefined(args[0].Value)
+				}
+			}
+			conds = append(conds, &condState{active: parent && cond, taken: cond, parent: parent})
+			continue
+		case "elif", "elifdef", "elifndef":
+			if len(conds) == 0 {
+				pp.errorf(hash.Start, "#%s without #if", name)
+				continue
+			}
+			c := conds[len(conds)-1]
+			if !c.parent || c.taken {
+				c.active = false
+				continue
+			}
+			cond := false
+			switch name {
+			case "elif":
+				cond = pp.evalCondition(args)
+			case "elifdef":
+				cond = len(args) > 0 && pp.IsDefined(args[0].Value)
+			case "elifndef":
+				cond = len(args) > 0 && !pp.IsDefined(args[0].Value)
+			}
+			c.active = cond
+			c.taken = cond
+			continue
+		case "else":
+			if len(conds) == 0 {
+				pp.errorf(hash.Start, "#else without #if")
+				continue
+			}
+			c := conds[len(conds)-1]
+			if c.hasElse {
+				pp.errorf(hash.Start, "#else after #else")
+			}
+			c.hasElse = true
+			c.active = c.parent && !c.taken
+			c.taken = true
+			continue
+		case "endif":
+			if len(conds) == 0 {
+				pp.errorf(hash.Start, "#endif without #if")
+				continue
+			}
+			conds = conds[:len(conds)-1]
+			continue
+		}
+
+		if !active() {
+			continue
+		}
+		flush()
+
+		switch name {
+		case "define":
+			pp.define(args)
+		case "undef":
+			if len(args) > 0 {
+				pp.Undef(args[0].Value)
+			}
+		case "include", "include_next", "import":
+			out = append(out, pp.include(hash, args)...)
+		case "pragma":
+			if len(args) > 0 && args[0].Value == "once" {
+				pp.once[path] = struct{}{}
+			}
+		case "error", "warning", "line", "ident", "sccs", "assert", "unassert":
+		default:
+			pp.errorf(hash.Start, "unknown preprocessor directive #%s", name)
+		}
+	}
+	flush()
+	if len(conds) > 0 {
+		pp.errorf(Pos{}, "unterminated conditional directive in %s", path)
+	}
+	return out
+}
+
+func (pp *Preprocessor) define(args []*Token) {
+	if len(args) == 0 || args[0].Type != IDENT {
+		if len(args) > 0 {
+			pp.errorf(args[0].Start, "macro name must be an identifier")
+		}
+		return
+	}
+	m := &Macro{Name: args[0].Value}
+	body := args[1:]
+	if len(body) > 0 && body[0].is(PUNCT, "(") && !body[0].Space {
+		// function-like macro
+		m.FuncLike = true
+		i := 1
+		for ; i < len(body); i++ {
+			t := body[i]
+			if t.is(PUNCT, ")") {
+				break
+			}
+			switch {
+			case t.is(PUNCT, ","):
+			case t.is(PUNCT, "..."):
+				m.Variadic = true
+				m.Params = append(m.Params, "__VA_ARGS__")
+			case t.Type == IDENT:
+				if i+1 < len(body) && body[i+1].is(PUNCT, "...") {
+					// gnu named variadic `args...`
+					m.Variadic = true
+					i++
+				}
+				m.Params = append(m.Params, t.Value)
+			default:
+				pp.errorf(t.Start, "invalid macro parameter %s", t.Value)
+			}
+		}
+		if i < len(body) {
+			body = body[i+1:]
+		} else {
+			body = nil
+		}
+	}
+	m.Body = body
+	pp.macros[m.Name] = m
+}
+
+func (pp *Preprocessor) include(hash *Token, args []*Token) []*Token {
+	if len(args) == 0 {
+		return nil
+	}
+	if args[0].Type != STRING && !args[0].is(PUNCT, "<") {
+		// computed include `#include MACRO`
+		args = pp.expand(args)
+		if len(args) == 0 {
+			return nil
+		}
+	}
+
+	var name string
+	system := false
+	switch {
+	case args[0].Type == STRING:
+		name = strings.Trim(args[0].Value, "\"")
+	case args[0].is(PUNCT, "<"):
+		system = true
+		var buf strings.Builder
+		for _, t := range args[1:] {
+			if t.is(PUNCT, ">") {
+				break
+			}
+			buf.WriteString(t.Value)
+		}
+		name = buf.String()
+	default:
+		pp.errorf(hash.Start, "invalid include")
+		return nil
+	}
+
+	if pp.resolver == nil || len(pp.files) >= maxIncludeDepth {
+		return nil
+	}
+	path, src, ok := pp.resolver(name, system)
+	if !ok {
+		// header not in project, such as system header
+		return nil
+	}
+	if _, ok := pp.once[path]; ok {
+		return nil
+	}
+
+	lexer := NewLexer(src)
+	tokens := lexer.Tokenize()
+	ret := pp.process(path, tokens[:len(tokens)-1])
+	end := args[len(args)-1].End
+	for _, t := range ret {
+		t.Start = hash.Start
+		t.End = end
+	}
+	return ret
+}
+
+// ============================ macro expansion ============================
+
+func (pp *Preprocessor) expand(input []*Token) []*Token {
+	var out []*Token
+	for len(input) > 0 {
+		t := input[0]
+		input = input[1:]
+		if t.Type != IDENT || t.hidden(t.Value) {
+			out = append(out, t)
+			continue
+		}
+
+		switch t.Value {
+		case "__LINE__":
+			out = append(out, pp.newToken(t, NUMBER, strconv.Itoa(t.Start.Line)))
+			continue
+		case "__FILE__":
+			file := ""
+			if len(pp.files) > 0 {
+				file = pp.files[len(pp.files)-1]
+			}
+			out = append(out, pp.newToken(t, STRING, strconv.Quote(file)))
+			continue
+		}
+
+		m, ok := pp.macros[t.Value]
+		if !ok {
+			out = append(out, t)
+			continue
+		}
+		if !m.FuncLike {
+			body := pp.substitute(m, nil, t, t)
+			input = append(body, input...)
+			continue
+		}
+
+		// function-like macro name without `(` is normal identifier
+		if len(input) == 0 || !input[0].is(PUNCT, "(") {
+			out = append(out, t)
+			continue
+		}
+		args, rparen, rest, ok := pp.collectArgs(m, input)
+		if !ok {
+			pp.errorf(t.Start, "unterminated argument list invoking macro %s", m.Name)
+			out = append(out, t)
+			continue
+		}
+		body := pp.substitute(m, args, t, rparen)
+		input = append(body, rest...)
+	}
+	return out
+}
+
+func (pp *Preprocessor) newToken(at *Token, typ TokenType, value string) *Token {
+	return &Token{Type: typ, Value: value, Start: at.Start, End: at.End, Space: at.Space}
+}
+
+// collectArgs collect arguments of function-like macro, input[0] is `(`
+func (pp *Preprocessor) collectArgs(m *Macro, input []*Token) (args [][]*Token, rparen *Token, rest []*Token, ok bool) {
+	depth := 0
+	var current []*Token
+	for i := 1; i < len(input); i++ {
+		t := input[i]
+		switch {
+		case t.is(PUNCT, "(") || t.is(PUNCT, "[") || t.is(PUNCT, "{"):
+			depth++
+		case t.is(PUNCT, ")") && depth == 0:
+			args = append(args, current)
+			if len(m.Params) == 0 && len(args) == 1 && len(args[0]) == 0 {
+				args = nil
+			}
+			// variadic arguments are merged with comma
+			if m.Variadic && len(args) > len(m.Params) {
+				last := len(m.Params) - 1
+				merged := args[last]
+				for _, arg := range args[last+1:] {
+					merged = append(merged, &Token{Type: PUNCT, Value: ","})
+					merged = append(merged, arg...)
+				}
+				args = append(args[:last], merged)
+			}
+			for len(args) < len(m.Params) {
+				args = append(args, nil)
+			}
+			return args, t, input[i+1:], true
+		case t.is(PUNCT, ")") || t.is(PUNCT, "]") || t.is(PUNCT, "}"):
+			depth--
+		case t.is(PUNCT, ",") && depth == 0:
+			args = append(args, current)
+			current = nil
+			continue
+		}
+		current = append(current, t)
+	}
+	return nil, nil, nil, false
+}
+
+func (pp *Preprocessor) substitute(m *Macro, args [][]*Token, name, end *Token) []*Token {
+	paramIndex := func(t *Token) int {
+		if !m.FuncLike || t.Type != IDENT {
+			return -1
+		}
+		for i, p := range m.Params {
+			if p == t.Value && i < len(args) {
+				return i
+			}
+		}
+		return -1
+	}
+
+	var res []*Token
+	body := m.Body
+	for i := 0; i < len(body); i++ {
+		b := body[i]
+
+		// stringify `#param`
+		if m.FuncLike && b.is(PUNCT, "#") && i+1 < len(body) {
+			if idx := paramIndex(body[i+1]); idx >= 0 {
+				res = append(res, pp.newToken(b, STRING, stringify(args[idx])))
+				i++
+				continue
+			}
+		}
+
+		// paste `a ## b`
+		if b.is(PUNCT, "##") && i+1 < len(body) {
+			next := body[i+1]
+			i++
+			var rhs []*Token
+			if idx := paramIndex(next); idx >= 0 {
+				rhs = copyTokens(args[idx])
+				if len(rhs) == 0 && next.Value == "__VA_ARGS__" && len(res) > 0 && res[len(res)-1].is(PUNCT, ",") {
+					// gnu extension `, ## __VA_ARGS__` remove the comma
+					res = res[:len(res)-1]
+				}
+			} else {
+				rhs = []*Token{next.copy()}
+			}
+			if len(rhs) == 0 {
+				continue
+			}
+			if len(res) == 0 {
+				res = append(res, rhs...)
+				continue
+			}
+			left := res[len(res)-1]
+			res = append(res[:len(res)-1], pasteToken(left, rhs[0])...)
+			res = append(res, rhs[1:]...)
+			continue
+		}
+
+		if idx := paramIndex(b); idx >= 0 {
+			if i+1 < len(body) && body[i+1].is(PUNCT, "##") {
+				// operand of `##` is not expanded
+				res = append(res, copyTokens(args[idx])...)
+			} else {
+				res = append(res, pp.expand(copyTokens(args[idx]))...)
+			}
+			continue
+		}
+		res = append(res, b.copy())
+	}
+
+	hide := make(map[string]struct{}, len(name.hide)+1)
+	for k := range name.hide {
+		hide[k] = struct{}{}
+	}
+	hide[m.Name] = struct{}{}
+	for i, t := range res {
+		t.Start = name.Start
+		t.End = end.End
+		t.BOL = false
+		if i == 0 {
+			t.Space = name.Space
+		}
+		if t.hide == nil {
+			t.hide = hide
+		} else {
+			merged := make(map[string]struct{}, len(t.hide)+len(hide))
+			for k := range t.hide {
+				merged[k] = struct{}{}
+			}
+			for k := range hide {
+				merged[k] = struct{}{}
+			}
+			t.hide = merged
+		}
+	}
+	return res
+}
+
+func copyTokens(tokens []*Token) []*Token {
+	ret := make([]*Token, 0, len(tokens))
+	for _, t := range tokens {
+		ret = append(ret, t.copy())
+	}
+	return ret
+}
+
+func stringify(tokens []*Token) string {
+	var buf strings.Builder
+	for i, t := range tokens {
+		if i > 0 && t.Space {
+			buf.WriteByte(' ')
+		}
+		if t.Type == STRING || t.Type == CHAR {
+			buf.WriteString(strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(t.Value))
+		} else {
+			buf.WriteString(t.Value)
+		}
+	}
+	return `"` + buf.String() + `"`
+}
+
+func pasteToken(left, right *Token) []*Token {
+	tokens := NewLexer(left.Value + right.Value).Tokenize()
+	tokens = tokens[:len(tokens)-1]
+	if len(tokens) != 1 {
+		// invalid paste, keep both tokens
+		return []*Token{left, right}
+	}
+	ret := left.copy()
+	ret.Type = tokens[0].Type
+	ret.Value = tokens[0].Value
+	return []*Token{ret}
+}
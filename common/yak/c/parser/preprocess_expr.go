@@ -0,0 +1,321 @@
+package cparser
+
+import (
+	"strconv"
+	"strings"
+)
+
+// evalCondition evaluate the constant expression of `#if` and `#elif`
+func (pp *Preprocessor) evalCondition(tokens []*Token) bool {
+	var pre []*Token
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.is(IDENT, "defined"):
+			// defined X, defined(X)
+			var name string
+			if i+1 < len(tokens) && tokens[i+1].is(PUNCT, "(") {
+				if i+2 < len(tokens) {
+					name = tokens[i+2].Value
+				}
+				i += 3
+			} else if i+1 < len(tokens) {
+				name = tokens[i+1].Value
+				i++
+			}
+			pre = append(pre, pp.newToken(t, NUMBER, boolNumber(pp.IsDefined(name))))
+		case t.Type == IDENT && strings.HasPrefix(t.Value, "__has_") && i+1 < len(tokens) && tokens[i+1].is(PUNCT, "("):
+			// __has_include(<x.h>) __has_attribute(x) ...
+			depth := 0
+			j := i + 1
+			var arg []*Token
+			for ; j < len(tokens); j++ {
+				if tokens[j].is(PUNCT, "(") {
+					depth++
+				} else if tokens[j].is(PUNCT, ")") {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+				if j > i+1 {
+					arg = append(arg, tokens[j])
+				}
+			}
+			value := false
+			if t.Value == "__has_include" || t.Value == "__has_include_next" {
+				value = pp.hasInclude(arg)
+			}
+			pre = append(pre, pp.newToken(t, NUMBER, boolNumber(value)))
+			i = j
+		default:
+			pre = append(pre, t)
+		}
+	}
+
+	p := &condParser{tokens: pp.expand(pre)}
+	v := p.ternary()
+	if !p.ok || p.pos < len(p.tokens) {
+		if len(tokens) > 0 {
+			pp.errorf(tokens[0].Start, "invalid preprocessor expression")
+		}
+		return false
+	}
+	return v != 0
+}
+
+func (pp *Preprocessor) hasInclude(arg []*Token) bool {
+	if pp.resolver == nil || len(arg) == 0 {
+		return false
+	}
+	if arg[0].Type == STRING {
+		_, _, ok := pp.resolver(strings.Trim(arg[0].Value, "\""), false)
+		return ok
+	}
+	var buf strings.Builder
+	for _, t := range arg[1:] {
+		if t.is(PUNCT, ">") {
+			break
+		}
+		buf.WriteString(t.Value)
+	}
+	_, _, ok := pp.resolver(buf.String(), true)
+	return ok
+}
+
+func boolNumber(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// condParser is a precedence climbing parser for integer constant expression,
+// the identifiers remaining after macro expansion are 0.
+type condParser struct {
+	tokens []*Token
+	pos    int
+	ok     bool
+}
+
+func (p *condParser) peek() *Token {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos]
+	}
+	return &Token{Type: EOF}
+}
+
+func (p *condParser) accept(op string) bool {
+	if p.peek().is(PUNCT, op) {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+var condBinaryPrec = map[string]int{
+	"||": 1, "&&": 2, "|": 3, "^": 4, "&": 5,
+	"==": 6, "!=": 6, "<": 7, ">": 7, "<=": 7, ">=": 7,
+	"<<": 8, ">>": 8, "+": 9, "-": 9, "*": 10, "/": 10, "%": 10,
+}
+
+func (p *condParser) ternary() int64 {
+	if p.pos == 0 {
+		p.ok = true
+	}
+	cond := p.binary(1)
+	if !p.accept("?") {
+		return cond
+	}
+	a := p.ternary()
+	if !p.accept(":") {
+		p.ok = false
+		return 0
+	}
+	b := p.ternary()
+	if cond != 0 {
+		return a
+	}
+	return b
+}
+
+func (p *condParser) binary(minPrec int) int64 {
+	left := p.unary()
+	for {
+		t := p.peek()
+		if t.Type != PUNCT {
+			return left
+		}
+		prec, ok := condBinaryPrec[t.Value]
+		if !ok || prec < minPrec {
+			return left
+		}
+		p.pos++
+		right := p.binary(prec + 1)
+		left = evalBinary(t.Value, left, right)
+	}
+}
+
+func evalBinary(op string, a, b int64) int64 {
+	toInt := func(v bool) int64 {
+		if v {
+			return 1
+		}
+		return 0
+	}
+	switch op {
+	case "||":
+		return toInt(a != 0 || b != 0)
+	case "&&":
+		return toInt(a != 0 && b != 0)
+	case "|":
+		return a | b
+	case "^":
+		return a ^ b
+	case "&":
+		return a & b
+	case "==":
+		return toInt(a == b)
+	case "!=":
+		return toInt(a != b)
+	case "<":
+		return toInt(a < b)
+	case ">":
+		return toInt(a > b)
+	case "<=":
+		return toInt(a <= b)
+	case ">=":
+		return toInt(a >= b)
+	case "<<":
+		return a << uint64(b&63)
+	case ">>":
+		return a >> uint64(b&63)
+	case "+":
+		return a + b
+	case "-":
+		return a - b
+	case "*":
+		return a * b
+	case "/":
+		if b == 0 {
+			return 0
+		}
+		return a / b
+	case "%":
+		if b == 0 {
+			return 0
+		}
+		return a % b
+	}
+	return 0
+}
+
+func (p *condParser) unary() int64 {
+	t := p.peek()
+	switch {
+	case t.is(PUNCT, "!"):
+		p.pos++
+		if p.unary() == 0 {
+			return 1
+		}
+		return 0
+	case t.is(PUNCT, "-"):
+		p.pos++
+		return -p.unary()
+	case t.is(PUNCT, "+"):
+		p.pos++
+		return p.unary()
+	case t.is(PUNCT, "~"):
+		p.pos++
+		return ^p.unary()
+	case t.is(PUNCT, "("):
+		p.pos++
+		v := p.ternary()
+		if !p.accept(")") {
+			p.ok = false
+		}
+		return v
+	case t.Type == NUMBER:
+		p.pos++
+		return parseIntLiteral(t.Value)
+	case t.Type == CHAR:
+		p.pos++
+		return parseCharLiteral(t.Value)
+	case t.Type == IDENT:
+		p.pos++
+		if t.Value == "true" {
+			return 1
+		}
+		return 0
+	default:
+		p.ok = false
+		p.pos++
+		return 0
+	}
+}
+
+// parseIntLiteral parse c integer literal with suffix, such as 0x10UL 0777 0b11
+func parseIntLiteral(s string) int64 {
+	s = strings.TrimRight(s, "uUlLzZ")
+	var v uint64
+	var err error
+	switch {
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"):
+		v, err = strconv.ParseUint(s[2:], 16, 64)
+	case strings.HasPrefix(s, "0b") || strings.HasPrefix(s, "0B"):
+		v, err = strconv.ParseUint(s[2:], 2, 64)
+	case len(s) > 1 && s[0] == '0':
+		v, err = strconv.ParseUint(s[1:], 8, 64)
+	default:
+		v, err = strconv.ParseUint(s, 10, 64)
+	}
+	if err != nil {
+		if f, err := strconv.ParseFloat(s, 64); err == nil {
+			return int64(f)
+		}
+		return 0
+	}
+	return int64(v)
+}
+
+// parseCharLiteral parse the value of char literal such as 'a' '\n' L'x'
+func parseCharLiteral(s string) int64 {
+	idx := strings.IndexByte(s, '\'')
+	if idx < 0 {
+		return 0
+	}
+	body := strings.TrimSuffix(s[idx+1:], "'")
+	if body == "" {
+		return 0
+	}
+	if body[0] != '\\' {
+		return int64([]rune(body)[0])
+	}
+	if len(body) < 2 {
+		return 0
+	}
+	switch body[1] {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	case '0', '1', '2', '3', '4', '5', '6', '7':
+		v, _ := strconv.ParseInt(body[1:], 8, 64)
+		return v
+	case 'x':
+		v, _ := strconv.ParseInt(body[2:], 16, 64)
+		return v
+	case 'a':
+		return 7
+	case 'b':
+		return 8
+	case 'f':
+		return 12
+	case 'v':
+		return 11
+	default:
+		return int64(body[1])
+	}
+}
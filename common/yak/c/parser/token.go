@@ -0,0 +1,114 @@
+package cparser
+
+import "fmt"
+
+type TokenType int
+
+const (
+	EOF TokenType = iota
+	IDENT
+	NUMBER
+	CHAR
+	STRING
+	PUNCT
+)
+
+func (t TokenType) String() string {
+	switch t {
+	case EOF:
+		return "EOF"
+	case IDENT:
+		return "IDENT"
+	case NUMBER:
+		return "NUMBER"
+	case CHAR:
+		return "CHAR"
+	case STRING:
+		return "STRING"
+	case PUNCT:
+		return "PUNCT"
+	default:
+		return fmt.Sprintf("TokenType(%d)", int(t))
+	}
+}
+
+// Pos is a position in source code, Line is 1-based and Column is 0-based (in runes),
+// the same convention as antlr tokens so the ssa builder can share range helpers.
+type Pos struct {
+	Line   int
+	Column int
+}
+
+func (p Pos) String() string {
+	return fmt.Sprintf("%d:%d", p.Line, p.Column)
+}
+
+type Token struct {
+	Type  TokenType
+	Value string
+	Start Pos
+	End   Pos
+
+	// BOL means the token is the first token of a line, used to find preprocessor directive
+	BOL bool
+	// Space means there is whitespace before the token, used by macro stringify
+	Space bool
+
+	// hide is the macro names already expanded for this token (hide set), so
+	// recursive macro will not be expanded again.
+	hide map[string]struct{}
+}
+
+func (t *Token) String() string {
+	return fmt.Sprintf("%s(%q)@%s", t.Type, t.Value, t.Start)
+}
+
+func (t *Token) copy() *Token {
+	ret := *t
+	return &ret
+}
+
+func (t *Token) hidden(name string) bool {
+	if t.hide == nil {
+		return false
+	}
+	_, ok := t.hide[name]
+	return ok
+}
+
+func (t *Token) is(typ TokenType, value string) bool {
+	return t.Type == typ && t.Value == value
+}
+
+var keywords = map[string]struct{}{
+	"auto": {}, "break": {}, "case": {}, "char": {}, "const": {}, "continue": {},
+	"default": {}, "do": {}, "double": {}, "else": {}, "enum": {}, "extern": {},
+	"float": {}, "for": {}, "goto": {}, "if": {}, "inline": {}, "int": {}, "long": {},
+	"register": {}, "restrict": {}, "return": {}, "short": {}, "signed": {},
+	"sizeof": {}, "static": {}, "struct": {}, "switch": {}, "typedef": {},
+	"union": {}, "unsigned": {}, "void": {}, "volatile": {}, "while": {},
+	"_Bool": {}, "_Complex": {}, "_Atomic": {}, "_Noreturn": {}, "_Thread_local": {},
+	"_Alignas": {}, "_Alignof": {}, "_Static_assert": {}, "_Generic": {},
+}
+
+// cppKeywords are keywords only in c++, they are normal identifiers in c code,
+// such as `struct node *new;`
+var cppKeywords = map[string]struct{}{
+	"class": {}, "namespace": {}, "using": {}, "template": {}, "typename": {},
+	"public": {}, "private": {}, "protected": {}, "virtual": {}, "friend": {},
+	"new": {}, "delete": {}, "this": {}, "operator": {}, "mutable": {},
+	"try": {}, "catch": {}, "throw": {}, "nullptr": {}, "true": {}, "false": {},
+	"bool": {}, "explicit": {}, "constexpr": {}, "noexcept": {},
+	"static_cast": {}, "dynamic_cast": {}, "reinterpret_cast": {}, "const_cast": {},
+}
+
+func IsKeyword(name string, cpp bool) bool {
+	if _, ok := keywords[name]; ok {
+		return true
+	}
+	if cpp {
+		_, ok := cppKeywords[name]
+		return ok
+	}
+	return false
+}
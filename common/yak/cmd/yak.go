@@ -747,6 +747,7 @@ func main() {
 		&tunnelServerCommand,
 		&mirrorGRPCServerCommand,
 		&yakcmds.UpgradeCommand,
+		&yakcmds.LSPCommand,
 	}
 
 	app.Commands = []cli.Command{}
@@ -794,12 +795,35 @@ func main() {
 			Usage:  "Force Set Network Proxy for yak.netx",
 			EnvVar: "NETX_PROXY",
 		},
+		cli.StringSliceFlag{
+			Name:  "netx-proxy-route",
+			Usage: "Proxy Route for yak.netx, e.g. '*.corp.com,10.0.0.0/8 => socks5://127.0.0.1:1080 -> http://10.1.1.1:8080 | direct'",
+		},
+		cli.StringFlag{
+			Name:  "netx-proxy-pac",
+			Usage: "PAC File for yak.netx (used when no proxy route matched)",
+		},
 	}
 
 	app.Action = func(c *cli.Context) error {
 		if proxy := c.String("netx-proxy"); proxy != "" {
 			netx.SetDefaultDialXConfig(netx.DialX_WithProxy(proxy))
 		}
+		if routes, pacFile := c.StringSlice("netx-proxy-route"), c.String("netx-proxy-pac"); len(routes) > 0 || pacFile != "" {
+			var pac []byte
+			if pacFile != "" {
+				raw, err := ioutil.ReadFile(pacFile)
+				if err != nil {
+					return utils.Errorf("read pac file %v failed: %s", pacFile, err)
+				}
+				pac = raw
+			}
+			router, err := netx.NewProxyRouterFromRules(routes, string(pac))
+			if err != nil {
+				return err
+			}
+			netx.SetDefaultProxyRouter(router)
+		}
 		var (
 			err error
 			key []byte
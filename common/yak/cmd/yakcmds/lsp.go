@@ -0,0 +1,43 @@
+package yakcmds
+
+import (
+	"context"
+	"os"
+
+	"github.com/urfave/cli"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/lsp"
+)
+
+var LSPCommand = cli.Command{
+	Name:  "lsp",
+	Usage: "Start language server (LSP) for yak(.yak) and syntaxflow(.sf), use it in VS Code/Neovim",
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "stdio",
+			Usage: "communicate with client by stdin/stdout (default)",
+		},
+		cli.StringFlag{
+			Name:  "log-level",
+			Usage: "log level of language server, logs are written to stderr",
+			Value: "warning",
+		},
+	},
+	Action: func(c *cli.Context) error {
+		level, err := log.ParseLevel(c.String("log-level"))
+		if err != nil {
+			return utils.Errorf("invalid log level: %v", err)
+		}
+		log.SetLevel(level)
+
+		// stdout is the channel of language server protocol, keep logs and prints away from it
+		stdout := os.Stdout
+		os.Stdout = os.Stderr
+		log.SetOutput(os.Stderr)
+		defer func() {
+			os.Stdout = stdout
+		}()
+		return lsp.ServeStdio(context.Background(), os.Stdin, stdout)
+	},
+}
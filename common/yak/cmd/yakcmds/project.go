@@ -1,13 +1,18 @@
 package yakcmds
 
 import (
+	"context"
+	"os"
+	"strconv"
 	"strings"
 
 	"github.com/urfave/cli"
 	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/httparchive"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 )
 
 var ProjectCommands = []*cli.Command{
@@ -39,4 +44,83 @@ var ProjectCommands = []*cli.Command{
 			cli.StringFlag{Name: "output"},
 			cli.StringFlag{Name: "type"},
 		}},
+	{
+		Name:  "http-flow-export",
+		Usage: "Export HTTP History to HAR 1.2 / Burp Saved Items XML / Postman Collection",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "output,o", Usage: "output file"},
+			cli.StringFlag{Name: "format", Usage: "har / burp / postman", Value: "har"},
+			cli.StringFlag{Name: "keyword,k", Usage: "fuzz search keyword"},
+			cli.StringFlag{Name: "ids", Usage: "http flow ids, split by comma"},
+			cli.StringFlag{Name: "source-type", Usage: "source type, like mitm,scan"},
+			cli.StringFlag{Name: "tags", Usage: "tags filter, split by comma"},
+			cli.StringFlag{Name: "name", Usage: "postman collection name"},
+		},
+		Action: func(c *cli.Context) error {
+			format, err := httparchive.ParseFormat(c.String("format"))
+			if err != nil {
+				return err
+			}
+			output := c.String("output")
+			if output == "" {
+				return utils.Error("output file cannot be empty")
+			}
+			if utils.GetFirstExistedPath(output) != "" {
+				return utils.Errorf("path[%s] is existed", output)
+			}
+			filter := &ypb.QueryHTTPFlowRequest{
+				Keyword:    c.String("keyword"),
+				SourceType: c.String("source-type"),
+				Tags:       utils.PrettifyListFromStringSplitEx(c.String("tags"), ","),
+			}
+			for _, id := range utils.PrettifyListFromStringSplitEx(c.String("ids"), ",") {
+				i, err := strconv.ParseInt(id, 10, 64)
+				if err != nil {
+					return utils.Errorf("invalid http flow id: %v", id)
+				}
+				filter.IncludeId = append(filter.IncludeId, i)
+			}
+			raw, count, err := yakit.ExportHTTPFlowsToArchive(context.Background(), consts.GetGormProjectDatabase(), filter, format, c.String("name"))
+			if err != nil {
+				return err
+			}
+			if err := os.WriteFile(output, raw, 0o644); err != nil {
+				return err
+			}
+			log.Infof("export %v http flows to %v", count, output)
+			return nil
+		},
+	},
+	{
+		Name:  "http-flow-import",
+		Usage: "Import HAR 1.2 / Burp Saved Items XML / Postman Collection into HTTP History",
+		Flags: []cli.Flag{
+			cli.StringFlag{Name: "input,i", Usage: "input file"},
+			cli.StringFlag{Name: "format", Usage: "har / burp / postman, auto detect if empty"},
+			cli.StringFlag{Name: "source-type", Usage: "source type of imported http flows", Value: "import"},
+			cli.StringFlag{Name: "tags", Usage: "extra tags for imported http flows, split by comma"},
+		},
+		Action: func(c *cli.Context) error {
+			raw, err := os.ReadFile(c.String("input"))
+			if err != nil {
+				return utils.Errorf("read input file failed: %s", err)
+			}
+			var format httparchive.Format
+			if c.String("format") != "" {
+				format, err = httparchive.ParseFormat(c.String("format"))
+				if err != nil {
+					return err
+				}
+			}
+			flows, format, err := yakit.ImportHTTPFlowsFromArchive(
+				consts.GetGormProjectDatabase(), raw, format, c.String("source-type"),
+				utils.PrettifyListFromStringSplitEx(c.String("tags"), ",")...,
+			)
+			if err != nil {
+				return err
+			}
+			log.Infof("import %v http flows from %v(%v)", len(flows), c.String("input"), format)
+			return nil
+		},
+	},
 }
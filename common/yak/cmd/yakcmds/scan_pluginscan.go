@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -17,6 +18,7 @@ import (
 	"github.com/yaklang/yaklang/common/filter"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/smuggle"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/bizhelper"
 	"github.com/yaklang/yaklang/common/utils/omap"
@@ -45,13 +47,17 @@ var hybridScanCommand = &cli.Command{
 			Name:  "templates,t",
 			Usage: "plugin-file templates (file / dir), split by 'comma', like './templates/1.yaml,./templates/vulns/'",
 		},
+		cli.StringFlag{
+			Name:  "workflows,w",
+			Usage: "nuclei workflow templates (file / dir), split by 'comma'; subtemplates are looked up in --templates and local plugins by path or tags",
+		},
 		cli.StringFlag{
 			Name:  "target,host",
 			Usage: "Target Hosts, separated by comma, like (example.com, http://www2.example.com, 192.168.1.2/24)",
 		},
 		cli.StringFlag{
 			Name:  "target-file,f",
-			Usage: "Target Hosts File, one host per line",
+			Usage: "Target Hosts File, one host per line, or http archive (*.har / burp *.xml / *.postman_collection.json) whose requests are scanned directly",
 		},
 		cli.StringFlag{
 			Name:  "raw-packet-file,raw",
@@ -73,7 +79,10 @@ var hybridScanCommand = &cli.Command{
 			Name: "plugin", Usage: "Exec Single Plugin by Name",
 		},
 		cli.StringFlag{
-			Name: "type", Usage: `Type of Plugins in Yakit, port-scan / mitm / yaml-poc`,
+			Name: "type", Usage: `Type of Plugins in Yakit, port-scan / mitm / yaml-poc / smuggle(built-in desync scanner)`,
+		},
+		cli.BoolFlag{
+			Name: "smuggle", Usage: "Enable built-in HTTP Request Smuggling / Desync detection (CL.TE, TE.CL, TE.TE, H2.CL, H2.TE, Client-Side Desync)",
 		},
 		cli.StringFlag{Name: "proxy", Usage: "Proxy Server, like http://127.0.0.1:8083"},
 		cli.IntFlag{Name: "concurrent,thread", Usage: "(Thread)Concurrent Scan Number", Value: 50},
@@ -95,9 +104,15 @@ var hybridScanCommand = &cli.Command{
 		}
 
 		// fix plugin
+		runSmuggle := c.Bool("smuggle")
 		plugins := utils.PrettifyListFromStringSplitEx(c.String("type"), ",", "|", "\n")
 		for i := 0; i < len(plugins); i++ {
 			switch ret := strings.ToLower(plugins[i]); ret {
+			case "smuggle", "desync", "request-smuggling":
+				// built-in scanner, not a plugin type
+				plugins[i] = ""
+				runSmuggle = true
+				continue
 			case "port-scan", "mitm", "nuclei":
 				plugins[i] = ret
 				continue
@@ -111,13 +126,17 @@ var hybridScanCommand = &cli.Command{
 				log.Errorf("unsupported plugin type: %s", ret)
 			}
 		}
+		typeSpecified := len(plugins) > 0
+		plugins = utils.StringArrayFilterEmpty(plugins)
 		if len(plugins) > 0 {
 			plugins = utils.RemoveRepeatStringSlice(plugins)
 		}
 
 		pluginName := c.String("plugin")
 
-		if len(plugins) == 0 || len(pluginName) != 0 {
+		// only `--type smuggle` is set, no plugin will be loaded
+		smuggleOnly := typeSpecified && runSmuggle && len(plugins) == 0 && pluginName == "" && c.String("templates") == "" && c.String("workflows") == ""
+		if !smuggleOnly && (len(plugins) == 0 || len(pluginName) != 0) {
 			plugins = []string{"mitm", "nuclei", "port-scan"}
 		}
 
@@ -127,21 +146,40 @@ var hybridScanCommand = &cli.Command{
 		if c.String("templates") != "" {
 			log.Infof("start to load templates: %v", c.String("templates"))
 		}
+		if c.String("workflows") != "" {
+			log.Infof("start to load workflows: %v", c.String("workflows"))
+		}
 		uid := ksuid.New().String()
-		var templatesCodes []string
+		// workflows 指定时，templates 中的模板只作为 workflow 的子模板，不单独执行
+		dependencyUid := ksuid.New().String()
+		type templateFile struct {
+			Code string
+			// LocalPath 相对于模板目录的路径，workflows 通过该路径查找子模板
+			LocalPath string
+			Workflow  bool
+		}
+		var templatesCodes []*templateFile
 		var yakMITM []string
 		var portScan []string
-		handleTempFileTemplate := func(filename string) error {
+		handleTempFileTemplate := func(root, filename string, workflow bool) error {
 			log.Infof("start to handle file: %s", filename)
 			if strings.HasSuffix(filename, ".yaml") || strings.HasSuffix(filename, ".yml") {
 				raw, err := os.ReadFile(filename)
 				if err != nil {
 					return err
 				}
+				localPath := filepath.Base(filename)
+				if rel, err := filepath.Rel(root, filename); err == nil && root != filename {
+					localPath = rel
+				}
 				log.Infof("handle yaml template finished: %s", filename)
-				templatesCodes = append(templatesCodes, string(raw))
+				templatesCodes = append(templatesCodes, &templateFile{
+					Code:      string(raw),
+					LocalPath: filepath.ToSlash(localPath),
+					Workflow:  workflow,
+				})
 				return nil
-			} else if strings.HasSuffix(filename, ".yak") {
+			} else if strings.HasSuffix(filename, ".yak") && !workflow {
 				raw, err := os.ReadFile(filename)
 				if err != nil {
 					return err
@@ -152,44 +190,69 @@ var hybridScanCommand = &cli.Command{
 			log.Fatalf("unsupported file type: %s", filename)
 			return nil
 		}
-		for _, file := range utils.PrettifyListFromStringSplitEx(c.String("templates"), ",", "|", "\n") {
-			if file == "" {
-				continue
-			}
-
-			if utils.IsDir(file) {
-				files, err := utils.ReadFilesRecursively(file)
-				if err != nil {
-					return utils.Errorf("handle path(dir) %v failed: %s", file, err.Error())
+		handleTemplatePaths := func(paths string, workflow bool) error {
+			for _, file := range utils.PrettifyListFromStringSplitEx(paths, ",", "|", "\n") {
+				if file == "" {
+					continue
 				}
-				for _, f := range files {
-					if f.IsDir {
-						continue
+
+				if utils.IsDir(file) {
+					files, err := utils.ReadFilesRecursively(file)
+					if err != nil {
+						return utils.Errorf("handle path(dir) %v failed: %s", file, err.Error())
 					}
-					if strings.HasSuffix(f.Path, ".yaml") || strings.HasSuffix(f.Path, ".yml") || strings.HasSuffix(f.Path, ".yak") {
-						err := handleTempFileTemplate(f.Path)
-						if err != nil {
-							return err
+					for _, f := range files {
+						if f.IsDir {
+							continue
+						}
+						if strings.HasSuffix(f.Path, ".yaml") || strings.HasSuffix(f.Path, ".yml") || (strings.HasSuffix(f.Path, ".yak") && !workflow) {
+							err := handleTempFileTemplate(file, f.Path, workflow)
+							if err != nil {
+								return err
+							}
 						}
 					}
-				}
-			} else {
-				err := handleTempFileTemplate(file)
-				if err != nil {
-					return err
+				} else {
+					err := handleTempFileTemplate(file, file, workflow)
+					if err != nil {
+						return err
+					}
 				}
 			}
+			return nil
+		}
+		if err := handleTemplatePaths(c.String("templates"), false); err != nil {
+			return err
+		}
+		if err := handleTemplatePaths(c.String("workflows"), true); err != nil {
+			return err
+		}
+		hasWorkflows := false
+		for _, temp := range templatesCodes {
+			hasWorkflows = hasWorkflows || temp.Workflow
 		}
 
 		handledUUID := false
 		clearFuncs := make([]func(), 0, len(templatesCodes))
 		if len(templatesCodes) > 0 {
 			for _, temp := range templatesCodes {
-				pluginName, clearFunc, err := yakit.CreateTemporaryYakScriptEx(`nuclei`, temp, uid)
+				suffix := uid
+				if hasWorkflows && !temp.Workflow {
+					suffix = dependencyUid
+				}
+				script, err := yakit.NewTemporaryYakScript(`nuclei`, temp.Code, suffix)
+				if err != nil {
+					return utils.Errorf("create temporary nuclei template failed: %s", err)
+				}
+				script.LocalPath = temp.LocalPath
+				pluginName := script.ScriptName
+				err = yakit.CreateOrUpdateYakScriptByName(consts.GetGormProfileDatabase(), pluginName, script)
 				if err != nil {
 					return utils.Errorf("create temporary nuclei template failed: %s", err)
 				}
-				clearFuncs = append(clearFuncs, clearFunc)
+				clearFuncs = append(clearFuncs, func() {
+					yakit.DeleteYakScriptByName(consts.GetGormProfileDatabase(), pluginName)
+				})
 				handledUUID = true
 				if pluginName != "" {
 					log.Infof("Generate Temporary Yaml PoC Plugin: %s", pluginName)
@@ -226,14 +289,16 @@ var hybridScanCommand = &cli.Command{
 		db = db.Order("updated_at desc")
 
 		pluginList := omap.NewOrderedMap(map[string]*schema.YakScript{})
-		for result := range yakit.YieldYakScripts(db, context.Background()) {
-			if pluginName != "" && pluginName != result.ScriptName {
-				continue
-			}
-			if !c.Bool("list") {
-				log.Infof("start to load plugin: %s", result.ScriptName)
+		if !smuggleOnly {
+			for result := range yakit.YieldYakScripts(db, context.Background()) {
+				if pluginName != "" && pluginName != result.ScriptName {
+					continue
+				}
+				if !c.Bool("list") {
+					log.Infof("start to load plugin: %s", result.ScriptName)
+				}
+				pluginList.Set(result.ScriptName, result)
 			}
-			pluginList.Set(result.ScriptName, result)
 		}
 
 		// handle --list command
@@ -335,6 +400,33 @@ var hybridScanCommand = &cli.Command{
 		}
 		for target := range gen {
 			log.Infof("start to scan target: %v with plugins list cap: %v", target.Url, pluginList.Len())
+			if runSmuggle {
+				swg.Add()
+				target := target
+				go func() {
+					defer swg.Done()
+					defer func() {
+						if err := recover(); err != nil {
+							log.Errorf("smuggle scan target failed(panic): %s", err)
+						}
+					}()
+					results, err := smuggle.Scan(
+						target.Url,
+						smuggle.WithContext(ctx),
+						smuggle.WithHttps(target.IsHttps),
+						smuggle.WithRequest(target.Request),
+						smuggle.WithProxy(c.String("proxy")),
+						smuggle.WithRuntimeID(runtimeId),
+					)
+					if err != nil {
+						log.Errorf("smuggle scan target %v failed: %s", target.Url, err)
+						return
+					}
+					for _, r := range results {
+						log.Infof("smuggle: %v %s", target.Url, r.String())
+					}
+				}()
+			}
 			for _, plugin := range pluginList.Values() {
 				log.Debugf("prepare target: %p in: %v", target, plugin.ScriptName)
 				swg.Add()
@@ -3,6 +3,7 @@ package yakcmds
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"io/fs"
@@ -12,7 +13,6 @@ import (
 
 	"github.com/yaklang/yaklang/common/syntaxflow/sfdb"
 	"github.com/yaklang/yaklang/common/utils/bizhelper"
-	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
 	"golang.org/x/exp/slices"
 
 	"github.com/segmentio/ksuid"
@@ -22,6 +22,7 @@ import (
 	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/utils/yakgit"
 	"github.com/yaklang/yaklang/common/yak/ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
 	"github.com/yaklang/yaklang/common/yak/ssaapi"
@@ -92,6 +93,12 @@ var SSACompilerCommands = []*cli.Command{
 			cli.BoolFlag{
 				Name: "re-compile", Usage: "re-compile existed database program",
 			},
+			cli.BoolFlag{
+				Name: "incremental,inc", Usage: "only re-compile the changed files of existed database program",
+			},
+			cli.StringFlag{
+				Name: "git-ref", Usage: "compile the revision (branch/tag/commit) of the git repository in target instead of the working tree",
+			},
 			cli.BoolFlag{
 				Name: "dot", Usage: "dot graph text for result",
 			},
@@ -111,6 +118,7 @@ var SSACompilerCommands = []*cli.Command{
 
 			programName := c.String("program")
 			reCompile := c.Bool("re-compile")
+			incremental := c.Bool("incremental")
 			if programName != "" {
 				defer func() {
 					ssa.ShowDatabaseCacheCost()
@@ -132,7 +140,7 @@ var SSACompilerCommands = []*cli.Command{
 
 			// check program name duplicate
 			if slices.Contains(ssadb.AllPrograms(ssadb.GetDB()), programName) {
-				if !reCompile {
+				if !reCompile && !incremental {
 					return utils.Errorf(
 						"program name %v existed in this database, please use `re-compile` flag to re-compile or change program name",
 						programName,
@@ -141,7 +149,7 @@ var SSACompilerCommands = []*cli.Command{
 			}
 
 			if saveProfile && slices.Contains(ssadb.GetProfileSSAProgram(consts.GetGormProfileDatabase()), programName) {
-				if !reCompile {
+				if !reCompile && !incremental {
 					return utils.Errorf(
 						"program name %v existed in other database, please use `re-compile` flag to re-compile or change program name",
 						programName,
@@ -168,6 +176,7 @@ var SSACompilerCommands = []*cli.Command{
 			log.Infof("start to compile file: %v ", target)
 			opt = append(opt, ssaapi.WithRawLanguage(input_language))
 			opt = append(opt, ssaapi.WithReCompile(reCompile))
+			opt = append(opt, ssaapi.WithIncrementalCompile(incremental))
 			opt = append(opt, ssaapi.WithSaveToProfile(saveProfile))
 			if entry != "" {
 				log.Infof("start to use entry file: %v", entry)
@@ -184,13 +193,26 @@ var SSACompilerCommands = []*cli.Command{
 				opt = append(opt, ssaapi.WithProgramName(programName))
 			}
 
-			if !noOverride {
+			if incremental {
+				log.Infof("incremental flag is set, only changed files of program %v will be re-compiled", programName)
+			} else if !noOverride {
 				ssadb.DeleteProgram(ssadb.GetDB(), programName)
 			} else {
 				log.Warnf("no-override flag is set, will not delete existed program: %v", programName)
 			}
 
-			proj, err := ssaapi.ParseProjectFromPath(target, opt...)
+			var proj ssaapi.Programs
+			var err error
+			if gitRef := c.String("git-ref"); gitRef != "" {
+				log.Infof("compile git revision %v of %v", gitRef, target)
+				revisionFS, gitErr := yakgit.FileSystemFromCommit(target, gitRef)
+				if gitErr != nil {
+					return utils.Errorf("read git revision [%v] failed: %v", gitRef, gitErr)
+				}
+				proj, err = ssaapi.ParseProject(revisionFS, opt...)
+			} else {
+				proj, err = ssaapi.ParseProjectFromPath(target, opt...)
+			}
 			if err != nil {
 				return utils.Errorf("parse project [%v] failed: %v", target, err)
 			}
@@ -266,55 +288,102 @@ var SSACompilerCommands = []*cli.Command{
 	{
 		Name:    "syntaxflow-test",
 		Aliases: []string{"sftest", "sf-test"},
+		Usage:   "test SyntaxFlow rule with the fixtures in desc (file:// and safefile://)",
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "builtin,b",
+				Usage: "test all rules in database",
+			},
+			cli.StringFlag{
+				Name:  "rule,r",
+				Usage: "test the rules in database by rule name (glob)",
+			},
+			cli.StringFlag{
+				Name:  "json",
+				Usage: "save the test report as json file",
+			},
+		},
 		Action: func(c *cli.Context) error {
-			testingTInstance := utils.AssertTestingT(func(msg string, args ...any) {
-				log.Errorf(msg, args...)
-			})
 			fsi := filesys.NewLocalFs()
-
+			var reports []*ssaapi.SyntaxFlowRuleTestReport
+			addReport := func(report *ssaapi.SyntaxFlowRuleTestReport) {
+				fmt.Println(report.String())
+				reports = append(reports, report)
+			}
+			checkViaFile := func(filename string) error {
+				raw, err := fsi.ReadFile(filename)
+				if err != nil {
+					return err
+				}
+				report := ssaapi.RunSyntaxFlowRuleTestContent(string(raw))
+				if report.RuleName == "" {
+					report.RuleName = filename
+				}
+				addReport(report)
+				return nil
+			}
 			checkViaPath := func(pathRaw string) error {
-				err := filesys.Recursive(pathRaw, filesys.WithFileSystem(fsi), filesys.WithFileStat(func(s string, info fs.FileInfo) error {
+				return filesys.Recursive(pathRaw, filesys.WithFileSystem(fsi), filesys.WithFileStat(func(s string, info fs.FileInfo) error {
 					if fsi.Ext(s) == ".sf" || fsi.Ext(s) == "sf" {
-						raw, err := fsi.ReadFile(s)
-						if err != nil {
-							return err
-						}
-						err = ssatest.EvaluateVerifyFilesystem(string(raw), testingTInstance)
-						if err != nil {
-							return err
-						}
-						return nil
+						return checkViaFile(s)
 					}
 					return nil
 				}))
+			}
+
+			switch {
+			case c.Bool("builtin"):
+				for rule := range sfdb.YieldSyntaxFlowRules(consts.GetGormProfileDatabase(), context.Background()) {
+					addReport(ssaapi.RunSyntaxFlowRuleTest(rule))
+				}
+			case c.String("rule") != "":
+				rules, err := sfdb.GetRules(c.String("rule"))
 				if err != nil {
 					return err
 				}
-				return nil
-			}
-
-			empty := len(c.Args()) <= 0
-			if empty {
-				return checkViaPath(".")
+				for _, rule := range rules {
+					addReport(ssaapi.RunSyntaxFlowRuleTest(rule))
+				}
+			case len(c.Args()) <= 0:
+				if err := checkViaPath("."); err != nil {
+					return err
+				}
 			}
 			for _, i := range c.Args() {
 				if utils.IsDir(i) {
-					err := checkViaPath(i)
-					if err != nil {
+					if err := checkViaPath(i); err != nil {
 						return err
 					}
-				}
-				if ret := utils.GetFirstExistedFile(i); ret != "" {
-					raw, err := fsi.ReadFile(ret)
-					if err != nil {
-						return err
-					}
-					err = ssatest.EvaluateVerifyFilesystem(string(raw), testingTInstance)
-					if err != nil {
+				} else if ret := utils.GetFirstExistedFile(i); ret != "" {
+					if err := checkViaFile(ret); err != nil {
 						return err
 					}
 				}
+			}
 
+			type jsonReport struct {
+				Status string
+				*ssaapi.SyntaxFlowRuleTestReport
+			}
+			var jsonReports []*jsonReport
+			count := make(map[string]int)
+			for _, report := range reports {
+				count[report.Status()]++
+				jsonReports = append(jsonReports, &jsonReport{Status: report.Status(), SyntaxFlowRuleTestReport: report})
+			}
+			failed := count["fail"]
+			fmt.Printf("\nSyntaxFlow rule test: %d passed, %d failed, %d skipped\n", count["pass"], failed, count["skip"])
+			if filename := c.String("json"); filename != "" {
+				raw, err := json.MarshalIndent(jsonReports, "", "  ")
+				if err != nil {
+					return err
+				}
+				if err := os.WriteFile(filename, raw, 0o666); err != nil {
+					return err
+				}
+			}
+			if failed > 0 {
+				return utils.Errorf("%d SyntaxFlow rules test failed", failed)
 			}
 			return nil
 		},
@@ -473,6 +542,39 @@ var SSACompilerCommands = []*cli.Command{
 			cli.StringFlag{
 				Name: "sarif,sarif-export,o", Usage: "export SARIF format to files",
 			},
+			cli.StringFlag{
+				Name:  "baseline",
+				Usage: "baseline program name, run the same rules on it and classify risks as new/fixed/unchanged",
+			},
+			cli.BoolFlag{
+				Name:  "fail-on-new",
+				Usage: "return error when new risk found compared with baseline program",
+			},
+			cli.BoolFlag{
+				Name:  "callgraph",
+				Usage: "export the call graph of program instead of running syntaxflow",
+			},
+			cli.BoolFlag{
+				Name:  "depgraph,deps",
+				Usage: "export the package dependency graph of program instead of running syntaxflow",
+			},
+			cli.StringFlag{
+				Name:  "graph-format",
+				Usage: "format of exported graph: dot, graphml or json",
+				Value: "dot",
+			},
+			cli.StringFlag{
+				Name:  "package-prefix",
+				Usage: "only keep the packages with these prefixes in graph, split by comma, e.g. com.example.service,com.example.dao",
+			},
+			cli.StringFlag{
+				Name:  "graph-output",
+				Usage: "write the graph to file, default is stdout",
+			},
+			cli.BoolFlag{
+				Name:  "with-extern",
+				Usage: "keep the callee not defined in program (library or builtin) in call graph",
+			},
 		},
 		Action: func(c *cli.Context) error {
 			if ret, err := log.ParseLevel(c.String("log")); err == nil {
@@ -480,6 +582,14 @@ var SSACompilerCommands = []*cli.Command{
 			}
 			programName := c.String("program")
 			databaseFileRaw := c.String("database")
+
+			if c.Bool("callgraph") || c.Bool("depgraph") {
+				return exportCodeGraph(
+					programName, c.Bool("depgraph"), c.String("graph-format"), c.String("graph-output"),
+					ssaapi.WithCodeGraphPackagePrefix(utils.PrettifyListFromStringSplitEx(c.String("package-prefix"), ",")...),
+					ssaapi.WithCodeGraphExtern(c.Bool("with-extern")),
+				)
+			}
 			dbDebug := c.Bool("database-debug")
 			sfDebug := c.Bool("syntaxflow-debug")
 			syntaxFlow := c.String("syntaxflow")
@@ -493,6 +603,9 @@ var SSACompilerCommands = []*cli.Command{
 				}
 			}
 
+			baseline := c.String("baseline")
+			failOnNew := c.Bool("fail-on-new")
+
 			haveSarifRequired := false
 			if sarifFile != "" {
 				haveSarifRequired = true
@@ -509,6 +622,19 @@ var SSACompilerCommands = []*cli.Command{
 				}
 			}
 
+			var baselineResults, headResults []*ssaapi.SyntaxFlowResult
+			checkBaseline := func() error {
+				if baseline == "" {
+					return nil
+				}
+				diff := ssaapi.DiffSyntaxFlowResults(baselineResults, headResults)
+				showSyntaxFlowBaselineDiff(programName, baseline, diff)
+				if failOnNew && len(diff.New) > 0 {
+					return utils.Errorf("found %d new risks compared with baseline program %v", len(diff.New), baseline)
+				}
+				return nil
+			}
+
 			defer func() {
 				if len(results) > 0 && sarifFile != "" {
 					log.Infof("fetch result: %v, exports sarif to %v", len(results), sarifFile)
@@ -529,21 +655,41 @@ var SSACompilerCommands = []*cli.Command{
 				}
 			}()
 
-			if syntaxFlow != "" {
-				return SyntaxFlowQuery(programName, databaseFileRaw, syntaxFlow, dbDebug, sfDebug, showDot, withCode, sarifCallback)
-			}
-
-			var dirChecking []string
-
 			handleBySyntaxFlowContent := func(syntaxFlow string) error {
-				err := SyntaxFlowQuery(programName, databaseFileRaw, syntaxFlow, dbDebug, sfDebug, showDot, withCode, sarifCallback)
+				// a rule failed on either side must be skipped on both sides,
+				// otherwise all its risks would be reported as new or fixed
+				var baselineResult *ssaapi.SyntaxFlowResult
+				if baseline != "" {
+					result, err := syntaxFlowBaselineQuery(baseline, syntaxFlow)
+					if err != nil {
+						return utils.Wrapf(err, "query baseline program [%v] failed", baseline)
+					}
+					baselineResult = result
+				}
+				var headResult *ssaapi.SyntaxFlowResult
+				err := SyntaxFlowQuery(programName, databaseFileRaw, syntaxFlow, dbDebug, sfDebug, showDot, withCode, sarifCallback, func(result *ssaapi.SyntaxFlowResult) {
+					headResult = result
+				})
 				if err != nil {
 					return err
 				}
+				if baselineResult != nil && headResult != nil {
+					baselineResults = append(baselineResults, baselineResult)
+					headResults = append(headResults, headResult)
+				}
 				fmt.Println()
 				return nil
 			}
 
+			if syntaxFlow != "" {
+				if err := handleBySyntaxFlowContent(syntaxFlow); err != nil {
+					return err
+				}
+				return checkBaseline()
+			}
+
+			var dirChecking []string
+
 			handleByFilename := func(filename string) error {
 				log.Infof("start to use SyntaxFlow rule: %v", filename)
 				raw, err := os.ReadFile(filename)
@@ -634,11 +780,65 @@ var SSACompilerCommands = []*cli.Command{
 				}
 				return utils.Errorf("many error happened: \n%v", buf.String())
 			}
-			return nil
+			return checkBaseline()
 		},
 	},
 }
 
+func exportCodeGraph(programName string, dependency bool, format, output string, opts ...ssaapi.CodeGraphOption) error {
+	if programName == "" {
+		return utils.Error("program name is required when exporting graph")
+	}
+	prog, err := ssaapi.FromDatabase(programName)
+	if err != nil {
+		return utils.Wrapf(err, "load program [%v] from database failed", programName)
+	}
+	var graph *ssaapi.CodeGraph
+	if dependency {
+		graph = prog.GetPackageDependencyGraph(opts...)
+	} else {
+		graph = prog.GetCallGraph(opts...)
+	}
+	raw, err := graph.Export(format)
+	if err != nil {
+		return err
+	}
+	log.Infof("%v graph of program %v: %d nodes, %d edges", graph.Kind, programName, len(graph.Nodes), len(graph.Edges))
+	if output == "" {
+		fmt.Println(string(raw))
+		return nil
+	}
+	if err := os.WriteFile(output, raw, 0o666); err != nil {
+		return utils.Wrapf(err, "write graph to %v failed", output)
+	}
+	log.Infof("graph is saved to %v", output)
+	return nil
+}
+
+func syntaxFlowBaselineQuery(programName, syntaxFlow string) (*ssaapi.SyntaxFlowResult, error) {
+	prog, err := ssaapi.FromDatabase(programName)
+	if err != nil {
+		return nil, utils.Wrapf(err, "load program [%v] from database failed", programName)
+	}
+	return prog.SyntaxFlowWithError(syntaxFlow)
+}
+
+func showSyntaxFlowBaselineDiff(programName, baseline string, diff *ssaapi.SyntaxFlowBaselineDiff) {
+	fmt.Printf("baseline compare: %v -> %v, new: %d, fixed: %d, unchanged: %d\n",
+		baseline, programName, len(diff.New), len(diff.Fixed), len(diff.Unchanged))
+	show := func(title string, risks []*ssaapi.SyntaxFlowRisk) {
+		if len(risks) == 0 {
+			return
+		}
+		fmt.Printf("%v:\n", title)
+		for _, risk := range risks {
+			fmt.Printf("  [%v] $%v: %v\n", risk.Result.Name(), risk.Variable, risk.String())
+		}
+	}
+	show("new risks", diff.New)
+	show("fixed risks", diff.Fixed)
+}
+
 func SyntaxFlowQuery(
 	programName, databaseFileRaw string,
 	syntaxFlow string,
@@ -45,3 +45,31 @@ func TestRecompileWarn(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "please use `re-compile` flag to re-compile or change program name")
 }
+
+func TestSyntaxFlowQuery_BaselineFailed(t *testing.T) {
+	tmpDir := t.TempDir()
+	err := os.WriteFile(tmpDir+"/test.yak", []byte(`
+	a = 1
+	println(a)
+	`), 0o644)
+	require.NoError(t, err)
+
+	programName := uuid.NewString()
+	app := cli.NewApp()
+	addCommands(app, yakcmds.SSACompilerCommands...)
+	err = app.Run([]string{"yak", "ssa-compile", "-t", tmpDir, "-p", programName})
+	require.NoError(t, err)
+	defer ssadb.DeleteProgram(ssadb.GetDB(), programName)
+
+	rule := `println(* #-> as $param); alert $param`
+
+	// same program as baseline, nothing new
+	err = app.Run([]string{"yak", "ssa-query", "-p", programName, "--baseline", programName, "--fail-on-new", "-sf", rule})
+	require.NoError(t, err)
+
+	// baseline can't be queried, the rule must not be treated as all new
+	err = app.Run([]string{"yak", "ssa-query", "-p", programName, "--baseline", uuid.NewString(), "--fail-on-new", "-sf", rule})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "query baseline program")
+	require.NotContains(t, err.Error(), "new risks")
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/bizhelper"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 )
@@ -20,6 +21,8 @@ type (
 	ResultCallback     func(y *YakTemplate, reqBulk any /**YakRequestBulkConfig / YakNetworkBulkConfig*/, rsp any /*[]*lowhttp.LowhttpResponse / [][]byte*/, result bool, extractor map[string]interface{})
 	HTTPResultCallback func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, result bool, extractor map[string]interface{})
 	TCPResultCallback  func(y *YakTemplate, reqBulk *YakNetworkBulkConfig, rsp []*NucleiTcpResponse, result bool, extractor map[string]interface{})
+	// ProtocolResultCallback dns / ssl / file / headless 的结果回调，reqBulk 为对应协议的 Yak*BulkConfig
+	ProtocolResultCallback func(y *YakTemplate, reqBulk any, rsp []*NucleiProtocolResponse, result bool, extractor map[string]interface{})
 )
 
 func HTTPResultCallbackWrapper(callback HTTPResultCallback) ResultCallback {
@@ -54,6 +57,17 @@ func TCPResultCallbackWrapper(callback TCPResultCallback) ResultCallback {
 	}
 }
 
+func ProtocolResultCallbackWrapper(callback ProtocolResultCallback) ResultCallback {
+	return func(y *YakTemplate, reqBulk any, rsp any, result bool, extractor map[string]interface{}) {
+		results, ok := rsp.([]*NucleiProtocolResponse)
+		if !ok {
+			return
+		}
+
+		callback(y, reqBulk, results, result, extractor)
+	}
+}
+
 type ConfigOption func(*Config)
 
 type Config struct {
@@ -95,10 +109,29 @@ type Config struct {
 	OOBRequireCallback        func(...float64) (string, string, error)
 	OOBRequireCheckingTrigger func(string, string, ...float64) (string, []byte)
 
+	// EnableHeadless 是否执行 headless 模板，需要启动浏览器，默认关闭
+	EnableHeadless bool
+	// FileSystem file 协议扫描的文件系统，默认为本地文件系统
+	FileSystem fi.FileSystem
+
 	// onTempalteLoaded
 	OnTemplateLoaded  func(*YakTemplate) bool
 	BeforeSendPackage func(data []byte, isHttps bool) []byte
 	defaultFilter     filter.Filterable
+
+	// onMatcherName 由 workflows 设置，记录命中的具名 matcher
+	onMatcherName func(name string)
+}
+
+func (c *Config) isRecordingMatcherName() bool {
+	return c != nil && c.onMatcherName != nil
+}
+
+func (c *Config) recordMatcherName(name string, matched bool) {
+	if !matched || name == "" || !c.isRecordingMatcherName() {
+		return
+	}
+	c.onMatcherName(name)
 }
 
 func WithCustomVulnFilter(f filter.Filterable) ConfigOption {
@@ -128,6 +161,18 @@ func WithOOBRequireCheckingTrigger(f func(string, string, ...float64) (string, [
 	}
 }
 
+func WithHeadless(b bool) ConfigOption {
+	return func(config *Config) {
+		config.EnableHeadless = b
+	}
+}
+
+func WithFileSystem(fs fi.FileSystem) ConfigOption {
+	return func(config *Config) {
+		config.FileSystem = fs
+	}
+}
+
 func WithDebug(b bool) ConfigOption {
 	return func(config *Config) {
 		config.Debug = b
@@ -286,6 +331,12 @@ func WithTCPResultCallback(f TCPResultCallback) ConfigOption {
 	}
 }
 
+func WithProtocolResultCallback(f ProtocolResultCallback) ConfigOption {
+	return func(config *Config) {
+		config.AppendProtocolResultCallback(f)
+	}
+}
+
 func (c *Config) ExecuteResultCallback(y *YakTemplate, bulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, result bool, extractor map[string]interface{}) {
 	if c == nil {
 		return
@@ -317,6 +368,21 @@ func (c *Config) ExecuteTCPResultCallback(y *YakTemplate, bulk *YakNetworkBulkCo
 	}
 }
 
+func (c *Config) ExecuteProtocolResultCallback(y *YakTemplate, bulk any, rsp []*NucleiProtocolResponse, result bool, extractor map[string]interface{}) {
+	if c == nil {
+		return
+	}
+	defer func() {
+		if err := recover(); err != nil {
+			log.Errorf("httptpl execute result callback failed: %v", err)
+			utils.PrintCurrentGoroutineRuntimeStack()
+		}
+	}()
+	if c.Callback != nil {
+		c.Callback(y, bulk, rsp, result, extractor)
+	}
+}
+
 // NewConfig 创建一个默认的配置
 var defaultFilter = filter.NewFilter()
 
@@ -382,6 +448,20 @@ func (c *Config) AppendTCPResultCallback(handler TCPResultCallback) {
 	}
 }
 
+func (c *Config) AppendProtocolResultCallback(handler ProtocolResultCallback) {
+	handlerRaw := ProtocolResultCallbackWrapper(handler)
+	if c.Callback == nil {
+		c.Callback = handlerRaw
+		return
+	}
+
+	origin := c.Callback
+	c.Callback = func(y *YakTemplate, reqBulk any, rsp any, result bool, extractor map[string]interface{}) {
+		origin(y, reqBulk, rsp, result, extractor)
+		handlerRaw(y, reqBulk, rsp, result, extractor)
+	}
+}
+
 func (c *Config) GenerateYakTemplate() (chan *YakTemplate, error) {
 	if c.IsNuclei() {
 		ch := make(chan *YakTemplate)
@@ -92,6 +92,28 @@ func FuzzCalcExpr() map[string]any {
 
 func ScanPacket(req []byte, opts ...interface{}) (count uint64) {
 	config, lowhttpConfig, lowhttpOpts := toConfig(opts...)
+	var urlStr string
+	u, _ := lowhttp.ExtractURLFromHTTPRequestRaw(req, lowhttpConfig.Https)
+	if u != nil {
+		urlStr = u.String()
+	}
+	return scanWithTemplates(config, lowhttpConfig, urlStr, func(tpl *YakTemplate) (int, error) {
+		return tpl.Exec(config, lowhttpConfig.Https, req, lowhttpOpts...)
+	})
+}
+
+// ScanFile 使用 file 协议的模板扫描本地（或 WithFileSystem 指定的文件系统中的）文件或目录
+func ScanFile(path string, opts ...interface{}) (count uint64) {
+	config, lowhttpConfig, lowhttpOpts := toConfig(opts...)
+	return scanWithTemplates(config, lowhttpConfig, path, func(tpl *YakTemplate) (int, error) {
+		if len(tpl.FileRequestSequences) <= 0 {
+			return 0, nil
+		}
+		return tpl.ExecWithUrl(path, config, lowhttpOpts...)
+	})
+}
+
+func scanWithTemplates(config *Config, lowhttpConfig *lowhttp.LowhttpExecConfig, target string, exec func(tpl *YakTemplate) (int, error)) (count uint64) {
 	ctx := context.Background()
 	if config.Ctx != nil {
 		ctx = config.Ctx
@@ -103,12 +125,6 @@ func ScanPacket(req []byte, opts ...interface{}) (count uint64) {
 		lowhttpConfig.Ctx = baseContext
 	}
 
-	var urlStr string
-	u, _ := lowhttp.ExtractURLFromHTTPRequestRaw(req, lowhttpConfig.Https)
-	if u != nil {
-		urlStr = u.String()
-	}
-
 	switch strings.ToLower(strings.TrimSpace(config.Mode)) {
 	case "nuclei":
 		templateConcurrent := config.ConcurrentTemplates
@@ -146,7 +162,7 @@ func ScanPacket(req []byte, opts ...interface{}) (count uint64) {
 			}
 
 			if config.Verbose {
-				log.Infof("start to execute [%v] for url[%v]", tpl.Name, urlStr)
+				log.Infof("start to execute [%v] for url[%v]", tpl.Name, target)
 			}
 
 			go func() {
@@ -158,19 +174,19 @@ func ScanPacket(req []byte, opts ...interface{}) (count uint64) {
 					}
 
 					if config.Verbose {
-						log.Infof("finished executing [%v] for url[%v]", tpl.Name, urlStr)
+						log.Infof("finished executing [%v] for url[%v]", tpl.Name, target)
 					}
 				}()
 
-				_, err := tpl.Exec(config, lowhttpConfig.Https, req, lowhttpOpts...)
+				_, err := exec(tpl)
 				if err != nil {
 					log.Errorf("execute template failed: %s", err)
 				}
 			}()
 		}
-		log.Debugf("waiting for all templates finished [%v]", urlStr)
+		log.Debugf("waiting for all templates finished [%v]", target)
 		swg.Wait()
-		log.Debugf("all templates finished for url[%v]", urlStr)
+		log.Debugf("all templates finished for url[%v]", target)
 
 		return
 	case "xray":
@@ -242,6 +258,8 @@ func ScanAuto(items any, opt ...interface{}) {
 	_scanStream(ch, opt...)
 }
 
+const fileProtocolPrefix = "file://"
+
 func _scanStream(ch chan any, opt ...interface{}) {
 	config, _, _ := toConfig(opt...)
 
@@ -281,6 +299,19 @@ func _scanStream(ch chan any, opt ...interface{}) {
 			return
 		}
 
+		// file 协议只扫描显式指定的 file:// 目标，避免把恰好存在于本地的路径当作扫描目标
+		if strings.HasPrefix(rawStr, fileProtocolPrefix) {
+			path := strings.TrimPrefix(rawStr, fileProtocolPrefix)
+			swg.Add()
+			go func() {
+				defer func() {
+					swg.Done()
+				}()
+				atomic.AddUint64(&tplCount, ScanFile(path, opt...))
+			}()
+			return
+		}
+
 		addrs := utils.ParseStringToUrlsWith3W(rawStr)
 		for _, u := range addrs {
 			if !utils.IsHttpOrHttpsUrl(u) {
@@ -340,6 +371,7 @@ func WithOnRisk(target string, onRisk func(i *schema.Risk)) ConfigOption {
 	return func(config *Config) {
 		_callback(i)(config)
 		_tcpCallback(i)(config)
+		_protocolCallback(i)(config)
 		go func() {
 			defer filterVul.Close()
 			<-vCh
@@ -359,8 +391,12 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 			)
 			details := make(map[string]interface{}, 2)
 			runtimeId := utils.MapGetString(i, "runtimeId")
-			if len(tpl.HTTPRequestSequences) > 0 {
-				resp := i["responses"].([]*lowhttp.LowhttpResponse)
+			// flow 中的模板会同时包含多种协议，因此按响应的类型区分
+			switch resp := i["responses"].(type) {
+			case []*lowhttp.LowhttpResponse:
+				if len(resp) <= 0 {
+					return
+				}
 				reqBulk := i["requests"].(*YakRequestBulkConfig)
 				if runtimeId != "" {
 					runtimeId = resp[0].RuntimeId
@@ -385,10 +421,10 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 				if err != nil {
 					log.Errorf("httpPayloadsToString failed: %v", err)
 				}
-			}
-
-			if len(tpl.TCPRequestSequences) > 0 {
-				resp := i["responses"].([]*NucleiTcpResponse)
+			case []*NucleiTcpResponse:
+				if len(resp) <= 0 {
+					return
+				}
 				calcSha1 = utils.CalcSha1(tpl.Name, resp[0].RawRequest, target)
 
 				currTarget = resp[0].RemoteAddr
@@ -401,6 +437,22 @@ func processVulnerability(target any, filterVul filter.Filterable, vCh chan *too
 						details[fmt.Sprintf("response_%d", idx+1)] = spew.Sdump(r.RawPacket)
 					}
 				}
+			case []*NucleiProtocolResponse:
+				if len(resp) <= 0 {
+					return
+				}
+				calcSha1 = utils.CalcSha1(tpl.Name, resp[0].Protocol, resp[0].RemoteAddr, target)
+				var addrs []string
+				for idx, r := range resp {
+					addrs = append(addrs, r.RemoteAddr)
+					suffix := ""
+					if len(resp) > 1 {
+						suffix = fmt.Sprintf("_%d", idx+1)
+					}
+					details["request"+suffix] = string(r.RawRequest)
+					details["response"+suffix] = string(r.RawPacket)
+				}
+				currTarget = strings.Join(lo.Uniq(addrs), ",")
 			}
 
 			pv := &tools.PocVul{
@@ -459,6 +511,7 @@ func ScanLegacyWithFilter(target any, filterVul filter.Filterable, opt ...interf
 	i := processVulnerability(target, filterVul, vCh)
 	opt = append(opt, _callback(i))
 	opt = append(opt, _tcpCallback(i))
+	opt = append(opt, _protocolCallback(i))
 
 	c, _, _ := toConfig(opt...)
 	if strings.TrimSpace(c.SingleTemplateRaw) != "" {
@@ -482,6 +535,7 @@ func ScanLegacyWithFilter(target any, filterVul filter.Filterable, opt ...interf
 var Exports = map[string]interface{}{
 	"Scan":     ScanLegacy,
 	"ScanAuto": ScanAuto,
+	"ScanFile": ScanFile,
 
 	// params
 	"customVulnFilter":        WithCustomVulnFilter,
@@ -504,7 +558,7 @@ var Exports = map[string]interface{}{
 	"pageTimeout":             _timeout,
 	"retry":                   lowhttp.WithRetryTimes,
 	"rateLimit":               rateLimit,
-	"headless":                nucleiOptionDummy("headless"),
+	"headless":                WithHeadless,
 	"showBrowser":             nucleiOptionDummy("showBrowser"),
 	"dnsResolver":             lowhttp.WithDNSServers,
 	"systemDnsResolver":       nucleiOptionDummy("systemDnsResolver"),
@@ -536,10 +590,13 @@ var Exports = map[string]interface{}{
 	"mode":              WithMode,
 	"resultCallback":    _callback,
 	"tcpResultCallback": _tcpCallback,
-	"https":             lowhttp.WithHttps,
-	"http2":             lowhttp.WithHttp2,
-	"fromPlugin":        lowhttp.WithFromPlugin,
-	"context":           WithContext,
+	// dns / ssl / file / headless
+	"protocolResultCallback": _protocolCallback,
+	"https":                  lowhttp.WithHttps,
+	"http2":                  lowhttp.WithHttp2,
+	"http3":                  lowhttp.WithHttp3,
+	"fromPlugin":             lowhttp.WithFromPlugin,
+	"context":                WithContext,
 }
 
 func WithHttpTplRuntimeId(id string) ConfigOption {
@@ -584,6 +641,24 @@ func _tcpCallback(handler func(i map[string]interface{})) ConfigOption {
 	})
 }
 
+func _protocolCallback(handler func(i map[string]interface{})) ConfigOption {
+	return WithProtocolResultCallback(func(y *YakTemplate, reqBulk any, rsp []*NucleiProtocolResponse, result bool, extractor map[string]interface{}) {
+		var runtimeId string
+		if len(rsp) > 0 {
+			runtimeId = rsp[0].RuntimeId
+		}
+		handler(map[string]interface{}{
+			"template":  y,
+			"requests":  reqBulk,
+			"responses": rsp,
+			"response":  rsp,
+			"match":     result,
+			"extractor": extractor,
+			"runtimeId": runtimeId,
+		})
+	})
+}
+
 func noInteractsh(b bool) ConfigOption {
 	return WithEnableReverseConnectionFeature(!b)
 }
@@ -157,24 +157,32 @@ func CreateYakTemplateFromNucleiTemplateRaw(tplRaw string) (*YakTemplate, error)
 	yakTemp.CVE = nodeGetString(cveInfo, "cve-id")
 
 	yakTemp.Variables = generateYakVariables(rootNode)
+	yakTemp.Flow = nodeGetString(rootNode, "flow")
+	yakTemp.Workflows = parseWorkflows(nodeGetRaw(rootNode, "workflows"))
+
+	// dns / ssl / file / headless
+	if err := parseProtocolSequences(yakTemp, rootNode); err != nil {
+		return nil, err
+	}
+	if networkNode := nodeGetFirstRaw(rootNode, "network", "tcp"); networkNode != nil {
+		if networkNode.Kind != yaml.SequenceNode {
+			return nil, utils.Error("nuclei template network is not slice")
+		}
+		// network means tcp packets...
+		yakTemp.TCPRequestSequences, err = parseNetworkBulk(networkNode.Content, yakTemp.ReverseConnectionNeed)
+		if err != nil {
+			return nil, utils.Errorf("parse network bulk failed: %v", err)
+		}
+	}
 
 	reqs := nodeGetFirstRaw(rootNode, "requests", "http")
 	if reqs == nil || reqs.Kind != yaml.SequenceNode {
-		if networkNode := nodeGetFirstRaw(rootNode, "network", "tcp"); networkNode != nil {
-			if networkNode.Kind != yaml.SequenceNode {
-				return nil, utils.Error("nuclei template network is not slice")
-			}
-			// network means tcp packets...
-			yakTemp.TCPRequestSequences, err = parseNetworkBulk(networkNode.Content, yakTemp.ReverseConnectionNeed)
-			if err != nil {
-				return nil, utils.Errorf("parse network bulk failed: %v", err)
-			}
-
+		if len(yakTemp.TCPRequestSequences) > 0 || yakTemp.HasProtocolSequences() || len(yakTemp.Workflows) > 0 {
 			return yakTemp, nil
 		} else if nodeGetFirstRaw(rootNode, "workflows") != nil {
-			return nil, utils.Error("yakit nuclei cannot support workflows now~")
-		} else if nodeGetFirstRaw(rootNode, "headless") != nil {
-			return nil, utils.Errorf("nuclei template `headless(crawler)` is not supported (*)")
+			return nil, utils.Errorf("nuclei template workflows is empty: %s[%s]", yakTemp.Id, yakTemp.Name)
+		} else if protocol := nodeGetFirstRaw(rootNode, "code", "javascript", "websocket", "whois"); protocol != nil {
+			return nil, utils.Errorf("nuclei template `code/javascript/websocket/whois` is not supported (*)")
 		} else {
 			// log.Warnf("-----------------NUCLEI FORMATTER CANNOT FIX--------------------")
 			// fmt.Println(tplRaw)
@@ -226,6 +234,7 @@ func CreateYakTemplateFromNucleiTemplateRaw(tplRaw string) (*YakTemplate, error)
 	for _, node := range reqs.Content {
 
 		reqIns := &YakRequestBulkConfig{
+			Id:      nodeGetString(node, "id"),
 			Headers: map[string]string{},
 		}
 		matcher, err := generateYakMatcher(node)
@@ -411,6 +420,11 @@ func generateYakExtractors(rootNode *yaml.Node) ([]*YakExtractor, error) {
 }
 
 func generateYakMatcher(rootNode *yaml.Node) (*YakMatcher, error) {
+	return generateYakMatcherEx(rootNode, false)
+}
+
+// generateYakMatcherEx keepPart 为 true 时保留 http 之外的 part（如 dns 的 answer、ssl 的 subject_cn）
+func generateYakMatcherEx(rootNode *yaml.Node, keepPart bool) (*YakMatcher, error) {
 	matchersNode := nodeGetRaw(rootNode, "matchers")
 	if matchersNode == nil {
 		return nil, utils.Errorf("nuclei template matchers is nil")
@@ -430,6 +444,7 @@ func generateYakMatcher(rootNode *yaml.Node) (*YakMatcher, error) {
 		match.Negative = nodeGetBool(node, "negative")
 		match.Condition = nodeGetString(node, "condition")
 		match.Id = int(nodeGetFloat64(node, "id"))
+		match.Name = nodeGetString(node, "name")
 
 		switch nodeGetString(node, "part") {
 		case "body":
@@ -442,6 +457,10 @@ func generateYakMatcher(rootNode *yaml.Node) (*YakMatcher, error) {
 			match.Scope = "raw"
 		case "interactsh_protocol", "oob_protocol":
 			match.Scope = "interactsh_protocol"
+		default:
+			if keepPart {
+				match.Scope = strings.ToLower(nodeGetString(node, "part"))
+			}
 		}
 		typ := nodeGetString(node, "type")
 		switch typ {
@@ -38,6 +38,7 @@ func parseNetworkBulk(ret []*yaml.Node, ReverseConnectionNeed bool) ([]*YakNetwo
 		inputs := parseNetworkInputs(node)
 		hosts := nodeToStringSlice(nodeGetFirstRaw(node, "host", "hosts"))
 		network := &YakNetworkBulkConfig{
+			Id:                    nodeGetString(node, "id"),
 			Inputs:                inputs,
 			Hosts:                 hosts,
 			ReadSize:              2048,
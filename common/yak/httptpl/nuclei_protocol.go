@@ -0,0 +1,236 @@
+package httptpl
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/crawlerx"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// parseProtocolOperators 解析 dns / ssl / file / headless 中的 matchers 与 extractors，保留协议特有的 part
+func parseProtocolOperators(protocol string, node *yaml.Node) (*YakMatcher, []*YakExtractor, error) {
+	var matcher *YakMatcher
+	if nodeGetRaw(node, "matchers") != nil {
+		var err error
+		matcher, err = generateYakMatcherEx(node, true)
+		if err != nil {
+			log.Warnf("build %v matcher failed: %s", protocol, err)
+		}
+	}
+	extractors, err := generateYakExtractors(node)
+	if err != nil {
+		log.Warnf("build %v extractor failed: %s", protocol, err)
+	}
+	if matcher == nil && len(extractors) <= 0 {
+		return nil, nil, utils.Errorf("%v request has no matcher and extractor", protocol)
+	}
+	return matcher, extractors, nil
+}
+
+func parseDNSBulk(nodes []*yaml.Node) ([]*YakDNSBulkConfig, error) {
+	var confs []*YakDNSBulkConfig
+	for _, node := range nodes {
+		matcher, extractors, err := parseProtocolOperators(ProtocolDNS, node)
+		if err != nil {
+			log.Warn(err)
+			continue
+		}
+		payloads, err := generateYakPayloads(node)
+		if err != nil {
+			log.Debugf("extractYakPayloads failed: %v", err)
+		}
+		conf := &YakDNSBulkConfig{
+			Id:         nodeGetString(node, "id"),
+			Name:       nodeGetString(node, "name"),
+			Type:       nodeGetString(node, "type"),
+			Class:      nodeGetString(node, "class"),
+			Recursion:  true,
+			Retries:    int(nodeGetInt64(node, "retries")),
+			Resolvers:  nodeGetStringSliceFallback(node, "resolvers"),
+			Payloads:   payloads,
+			AttackMode: "cartesian-product",
+			Matcher:    matcher,
+			Extractor:  extractors,
+		}
+		if recursion := nodeGetRaw(node, "recursion"); recursion != nil {
+			conf.Recursion = nodeToBool(recursion)
+		}
+		if strings.ToLower(strings.TrimSpace(nodeGetString(node, "attack"))) == "pitchfork" {
+			conf.AttackMode = "sync"
+		}
+		if conf.Name == "" {
+			conf.Name = "{{FQDN}}"
+		}
+		confs = append(confs, conf)
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty dns bulk config")
+	}
+	return confs, nil
+}
+
+func parseSSLBulk(nodes []*yaml.Node) ([]*YakSSLBulkConfig, error) {
+	var confs []*YakSSLBulkConfig
+	for _, node := range nodes {
+		matcher, extractors, err := parseProtocolOperators(ProtocolSSL, node)
+		if err != nil {
+			log.Warn(err)
+			continue
+		}
+		confs = append(confs, &YakSSLBulkConfig{
+			Id:             nodeGetString(node, "id"),
+			Address:        nodeGetString(node, "address"),
+			MinVersion:     nodeGetFirstString(node, "min_version", "min-version"),
+			MaxVersion:     nodeGetFirstString(node, "max_version", "max-version"),
+			CipherSuites:   nodeToStringSlice(nodeGetFirstRaw(node, "cipher_suites", "cipher-suites")),
+			TLSVersionEnum: nodeToBool(nodeGetFirstRaw(node, "tls_version_enum", "tls-version-enum")),
+			Matcher:        matcher,
+			Extractor:      extractors,
+		})
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty ssl bulk config")
+	}
+	return confs, nil
+}
+
+func parseFileBulk(nodes []*yaml.Node) ([]*YakFileBulkConfig, error) {
+	var confs []*YakFileBulkConfig
+	for _, node := range nodes {
+		matcher, extractors, err := parseProtocolOperators(ProtocolFile, node)
+		if err != nil {
+			log.Warn(err)
+			continue
+		}
+		confs = append(confs, &YakFileBulkConfig{
+			Id:          nodeGetString(node, "id"),
+			Extensions:  nodeGetStringSliceFallback(node, "extensions"),
+			DenyList:    nodeGetStringSliceFallback(node, "denylist"),
+			MaxSize:     parseFileMaxSize(nodeGetFirstString(node, "max-size", "max_size")),
+			NoRecursive: nodeToBool(nodeGetFirstRaw(node, "no-recursive", "no_recursive")),
+			Matcher:     matcher,
+			Extractor:   extractors,
+		})
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty file bulk config")
+	}
+	return confs, nil
+}
+
+// parseFileMaxSize 解析 nuclei 中 max-size 的取值，例如 1024 / 20Kb / 5Mb / 1Gb
+func parseFileMaxSize(raw string) int64 {
+	raw = strings.ToLower(strings.TrimSpace(raw))
+	if raw == "" {
+		return 0
+	}
+	unit := int64(1)
+	for _, u := range []struct {
+		Suffix string
+		Size   int64
+	}{
+		{"gb", 1 << 30}, {"mb", 1 << 20}, {"kb", 1 << 10}, {"g", 1 << 30}, {"m", 1 << 20}, {"k", 1 << 10}, {"b", 1},
+	} {
+		if strings.HasSuffix(raw, u.Suffix) {
+			raw, unit = strings.TrimSpace(strings.TrimSuffix(raw, u.Suffix)), u.Size
+			break
+		}
+	}
+	size, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		log.Warnf("parse file max-size %v failed: %s", raw, err)
+		return 0
+	}
+	return int64(size * float64(unit))
+}
+
+func parseHeadlessBulk(nodes []*yaml.Node) ([]*YakHeadlessBulkConfig, error) {
+	var confs []*YakHeadlessBulkConfig
+	for _, node := range nodes {
+		matcher, extractors, err := parseProtocolOperators(ProtocolHeadless, node)
+		if err != nil {
+			log.Warn(err)
+			continue
+		}
+		conf := &YakHeadlessBulkConfig{
+			Id:        nodeGetString(node, "id"),
+			UserAgent: nodeGetFirstString(node, "user_agent", "user-agent"),
+			Matcher:   matcher,
+			Extractor: extractors,
+		}
+		sequenceNodeForEach(nodeGetRaw(node, "steps"), func(stepNode *yaml.Node) error {
+			step := &crawlerx.HeadlessAction{
+				Action: nodeGetString(stepNode, "action"),
+				Name:   nodeGetString(stepNode, "name"),
+				Args:   make(map[string]string),
+			}
+			mappingNodeForEach(nodeGetRaw(stepNode, "args"), func(key string, value *yaml.Node) error {
+				step.Args[key] = value.Value
+				return nil
+			})
+			conf.Steps = append(conf.Steps, step)
+			return nil
+		})
+		if len(conf.Steps) <= 0 {
+			log.Warn("headless request has no steps")
+			continue
+		}
+		confs = append(confs, conf)
+	}
+	if len(confs) <= 0 {
+		return nil, utils.Error("empty headless bulk config")
+	}
+	return confs, nil
+}
+
+// parseProtocolSequences 解析 http / tcp 之外的协议，并记录各协议在模板中出现的顺序
+func parseProtocolSequences(yakTemp *YakTemplate, rootNode *yaml.Node) error {
+	var err error
+	yakTemp.protocolOrder = nucleiRootProtocols(rootNode)
+	for _, protocol := range yakTemp.protocolOrder {
+		if protocol == ProtocolHTTP || protocol == ProtocolTCP {
+			continue
+		}
+		node := nodeGetRaw(rootNode, protocol)
+		if node == nil || node.Kind != yaml.SequenceNode {
+			return utils.Errorf("nuclei template %v is not slice", protocol)
+		}
+		switch protocol {
+		case ProtocolDNS:
+			yakTemp.DNSRequestSequences, err = parseDNSBulk(node.Content)
+		case ProtocolSSL:
+			yakTemp.SSLRequestSequences, err = parseSSLBulk(node.Content)
+		case ProtocolFile:
+			yakTemp.FileRequestSequences, err = parseFileBulk(node.Content)
+		case ProtocolHeadless:
+			yakTemp.HeadlessRequestSequences, err = parseHeadlessBulk(node.Content)
+		}
+		if err != nil {
+			return utils.Errorf("parse %v bulk failed: %v", protocol, err)
+		}
+	}
+	return nil
+}
+
+// nucleiRootProtocols 按模板中的书写顺序返回出现的协议，requests / network 会统一为 http / tcp
+func nucleiRootProtocols(rootNode *yaml.Node) []string {
+	if rootNode != nil && rootNode.Kind == yaml.DocumentNode && len(rootNode.Content) > 0 {
+		rootNode = rootNode.Content[0]
+	}
+	var protocols []string
+	mappingNodeForEach(rootNode, func(key string, _ *yaml.Node) error {
+		switch key {
+		case "requests", "http":
+			protocols = append(protocols, ProtocolHTTP)
+		case "network", "tcp":
+			protocols = append(protocols, ProtocolTCP)
+		case ProtocolDNS, ProtocolSSL, ProtocolFile, ProtocolHeadless:
+			protocols = append(protocols, key)
+		}
+		return nil
+	})
+	return protocols
+}
@@ -0,0 +1,46 @@
+package httptpl
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// parseWorkflows 解析 workflows / subtemplates，每个节点通过 template 路径或 tags 引用本地插件库中的模板
+func parseWorkflows(node *yaml.Node) []*YakWorkflow {
+	var workflows []*YakWorkflow
+	sequenceNodeForEach(node, func(workflowNode *yaml.Node) error {
+		workflow := &YakWorkflow{
+			Template:     strings.TrimSpace(nodeGetString(workflowNode, "template")),
+			Tags:         parseWorkflowTags(workflowNode),
+			Subtemplates: parseWorkflows(nodeGetRaw(workflowNode, "subtemplates")),
+		}
+		sequenceNodeForEach(nodeGetRaw(workflowNode, "matchers"), func(matcherNode *yaml.Node) error {
+			matcher := &YakWorkflowMatcher{
+				Name:         utils.StringArrayFilterEmpty(nodeGetStringSliceFallback(matcherNode, "name")),
+				Condition:    strings.ToLower(strings.TrimSpace(nodeGetString(matcherNode, "condition"))),
+				Subtemplates: parseWorkflows(nodeGetRaw(matcherNode, "subtemplates")),
+			}
+			if len(matcher.Name) <= 0 {
+				log.Warn("workflow matcher has no name")
+				return nil
+			}
+			workflow.Matchers = append(workflow.Matchers, matcher)
+			return nil
+		})
+		if workflow.Template == "" && len(workflow.Tags) <= 0 {
+			log.Warn("workflow has no template or tags")
+			return nil
+		}
+		workflows = append(workflows, workflow)
+		return nil
+	})
+	return workflows
+}
+
+// parseWorkflowTags tags 既可以是 "a,b" 也可以是列表
+func parseWorkflowTags(node *yaml.Node) []string {
+	return utils.PrettifyListFromStringSplitEx(strings.Join(nodeGetStringSliceFallback(node, "tags"), ","), ",")
+}
@@ -61,8 +61,19 @@ type YakTemplate struct {
 	// interactsh
 	ReverseConnectionNeed bool `json:"reverseConnectionNeed"`
 
-	TCPRequestSequences  []*YakNetworkBulkConfig
-	HTTPRequestSequences []*YakRequestBulkConfig
+	TCPRequestSequences      []*YakNetworkBulkConfig
+	HTTPRequestSequences     []*YakRequestBulkConfig
+	DNSRequestSequences      []*YakDNSBulkConfig
+	SSLRequestSequences      []*YakSSLBulkConfig
+	FileRequestSequences     []*YakFileBulkConfig
+	HeadlessRequestSequences []*YakHeadlessBulkConfig
+
+	// Flow 使用 javascript 编排各协议请求的执行顺序，例如 dns() && http(1)
+	Flow          string
+	protocolOrder []string
+
+	// Workflows 按 matcher name 等条件依次执行本地插件库中的其他模板
+	Workflows []*YakWorkflow
 
 	// placeHolderMap
 	PlaceHolderMap map[string]string
@@ -95,7 +106,21 @@ func (y *YakTemplate) NoMatcherAndExtractor() bool {
 		}
 	}
 
-	return true
+	if len(y.Workflows) > 0 {
+		return false
+	}
+
+	// dns / ssl / file / headless 在解析时已经丢弃了没有 matcher 与 extractor 的请求
+	return !y.HasProtocolSequences()
+}
+
+// HasProtocolSequences 模板中是否包含 dns / ssl / file / headless 请求
+func (y *YakTemplate) HasProtocolSequences() bool {
+	if y == nil {
+		return false
+	}
+	return len(y.DNSRequestSequences) > 0 || len(y.SSLRequestSequences) > 0 ||
+		len(y.FileRequestSequences) > 0 || len(y.HeadlessRequestSequences) > 0
 }
 
 // SignMainParams 对 method, paths, headers, body、raw、matcher、extractor、payloads 签名
@@ -192,6 +217,7 @@ func (y *YakTemplate) CheckTemplateRisks() error {
 
 type YakRequestBulkConfig struct {
 	//RequestConfig
+	Id string
 
 	Matcher   *YakMatcher
 	Extractor []*YakExtractor
@@ -0,0 +1,139 @@
+package httptpl
+
+import (
+	"strings"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type YakDNSBulkConfig struct {
+	// Id flow 中可以通过 dns("id") 执行指定的请求
+	Id string
+	// Name 查询的域名，支持 {{FQDN}} 等变量与 payloads
+	Name string
+	// A / AAAA / CNAME / NS / TXT / MX / SOA / PTR / CAA / SRV / DS / TLSA / ANY
+	Type string
+	// inet / csnet / chaos / hesiod / none / any
+	Class     string
+	Recursion bool
+	Retries   int
+	Resolvers []string
+
+	Payloads   *YakPayloads
+	AttackMode string
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+func (c *YakDNSBulkConfig) queryType() uint16 {
+	t := strings.ToUpper(strings.TrimSpace(c.Type))
+	if t == "" {
+		return dns.TypeA
+	}
+	if ret, ok := dns.StringToType[t]; ok {
+		return ret
+	}
+	log.Warnf("unknown dns query type: %v, use A", c.Type)
+	return dns.TypeA
+}
+
+func (c *YakDNSBulkConfig) queryClass() uint16 {
+	switch strings.ToLower(strings.TrimSpace(c.Class)) {
+	case "csnet":
+		return dns.ClassCSNET
+	case "chaos":
+		return dns.ClassCHAOS
+	case "hesiod":
+		return dns.ClassHESIOD
+	case "none":
+		return dns.ClassNONE
+	case "any":
+		return dns.ClassANY
+	default:
+		return dns.ClassINET
+	}
+}
+
+func dnsRRsToString(rrs []dns.RR) string {
+	var lines []string
+	for _, rr := range rrs {
+		lines = append(lines, rr.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Execute 渲染域名并通过 netx 的 DNS 配置发送查询，每个域名对应一个响应
+func (c *YakDNSBulkConfig) Execute(config *Config, vars map[string]any, lowhttpConfig *lowhttp.LowhttpExecConfig) ([]*NucleiProtocolResponse, error) {
+	var payloads map[string][]string
+	if c.Payloads != nil {
+		payloads = c.Payloads.GetData()
+	}
+	names, err := FuzzNucleiTag(c.Name, vars, payloads, c.AttackMode)
+	if err != nil {
+		return nil, utils.Errorf("render dns name failed: %s", err)
+	}
+
+	timeout := 5 * time.Second
+	if lowhttpConfig.Timeout > 0 {
+		timeout = lowhttpConfig.Timeout
+	}
+	opts := []netx.DNSOption{netx.WithTimeout(timeout), netx.WithDNSRetryTimes(c.Retries), netx.WithDNSFallbackTCP(true)}
+	resolvers := c.Resolvers
+	if len(resolvers) <= 0 {
+		resolvers = lowhttpConfig.DNSServers
+	}
+	if len(resolvers) > 0 {
+		opts = append(opts, netx.WithDNSServers(resolvers...))
+	}
+	if lowhttpConfig.Ctx != nil {
+		opts = append(opts, netx.WithDNSContext(lowhttpConfig.Ctx))
+	}
+
+	var rsps []*NucleiProtocolResponse
+	for _, nameRaw := range names {
+		name := strings.TrimSpace(string(nameRaw))
+		if name == "" {
+			continue
+		}
+		msg := new(dns.Msg)
+		msg.Id = dns.Id()
+		msg.RecursionDesired = c.Recursion
+		msg.Question = []dns.Question{{Name: dns.Fqdn(name), Qtype: c.queryType(), Qclass: c.queryClass()}}
+		if config.Debug || config.DebugRequest {
+			log.Infof("dns request:\n%v", msg.String())
+		}
+
+		reply, server, err := netx.ExchangeDNSMsg(msg, opts...)
+		if err != nil {
+			log.Warnf("dns query %v failed: %s", name, err)
+			continue
+		}
+		if config.Debug || config.DebugResponse {
+			log.Infof("dns response from %v:\n%v", server, reply.String())
+		}
+
+		rsp := newProtocolResponse(ProtocolDNS, config)
+		rsp.RawRequest = []byte(msg.String())
+		rsp.RawPacket = []byte(reply.String())
+		rsp.RemoteAddr = server
+		rsp.Parts["host"] = name
+		rsp.Parts["rcode"] = reply.Rcode
+		rsp.Parts["question"] = ""
+		if len(reply.Question) > 0 {
+			rsp.Parts["question"] = reply.Question[0].String()
+		}
+		rsp.Parts["answer"] = dnsRRsToString(reply.Answer)
+		rsp.Parts["ns"] = dnsRRsToString(reply.Ns)
+		rsp.Parts["authority"] = rsp.Parts["ns"]
+		rsp.Parts["extra"] = dnsRRsToString(reply.Extra)
+		rsp.Parts["additional"] = rsp.Parts["extra"]
+		rsps = append(rsps, rsp)
+	}
+	return rsps, nil
+}
@@ -37,6 +37,14 @@ type RequestBulk struct {
 
 func (y *YakTemplate) GenerateRequestSequences(u string, renderPayload bool) []*RequestBulk {
 	vars := utils.InterfaceToMapInterface(utils2.ExtractorVarsFromUrl(u))
+	// 模板变量（包括 flow 中前序请求提取到的变量）在 path 中也可以使用，否则会被 url 编码
+	if y.Variables != nil {
+		for k, v := range y.Variables.ToMap() {
+			if _, ok := vars[k]; !ok {
+				vars[k] = v
+			}
+		}
+	}
 	result := []*RequestBulk{}
 	for _, sequenceCfg := range y.HTTPRequestSequences {
 		var payloads map[string][]string
@@ -129,6 +137,10 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 		config = NewConfig()
 	}
 
+	if len(y.Workflows) > 0 {
+		return newWorkflowExecutor(y, u, config, opts...).run()
+	}
+
 	var count int64 = 0
 	if y.ReverseConnectionNeed {
 		var err error
@@ -149,6 +161,11 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 		}
 	}
 
+	// flow 或包含 dns / ssl / file / headless 的模板按顺序执行，各协议之间共享变量
+	if y.Flow != "" || y.HasProtocolSequences() {
+		return newFlowExecutor(y, u, config, opts...).run()
+	}
+
 	tplConcurrent := config.ConcurrentInTemplates
 	if len(y.HTTPRequestSequences) > 0 {
 		swg := utils.NewSizedWaitGroup(tplConcurrent)
@@ -156,48 +173,7 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 			swg.Add()
 			go func(ret *RequestBulk, payload map[string][]string) {
 				defer swg.Done()
-				rsps, allResult, extracted, reqCount := y.handleRequestSequences(config, ret.RequestConfig, ret.Requests, payload, func(raw []byte, req *requestRaw) (*lowhttp.LowhttpResponse, error) {
-					if config.BeforeSendPackage != nil {
-						raw = config.BeforeSendPackage(raw, req.IsHttps)
-					}
-					packetOpt := opts
-					redictTimes := 0
-					if ret.RequestConfig.EnableRedirect {
-						redictTimes = ret.RequestConfig.MaxRedirects
-					}
-					packetOpt = append(
-						packetOpt,
-						lowhttp.WithPacketBytes(raw),
-						lowhttp.WithHttps(req.IsHttps),
-						lowhttp.WithSource(y.Name),
-						lowhttp.WithNoFixContentLength(ret.RequestConfig.NoFixContentLength),
-						lowhttp.WithRedirectTimes(redictTimes),
-						lowhttp.WithTimeout(req.Timeout),
-					)
-					if req.OverrideHost != "" {
-						packetOpt = append(packetOpt, lowhttp.WithHost(req.OverrideHost))
-					}
-
-					if config.Debug && config.DebugRequest {
-						fmt.Printf("--------------REQ---------------\n")
-						fmt.Println(string(raw))
-					}
-
-					utils.Debug(func() {
-						log.Info("nuclei lowhttp.Exec! ")
-						spew.Dump(raw)
-					})
-					rsp, err := lowhttp.HTTP(packetOpt...)
-					if err != nil {
-						// log.Error(err)
-						return nil, err
-					}
-					if config.Debug && config.DebugResponse {
-						fmt.Printf("--------------RSP---------------\n")
-						fmt.Println(string(rsp.RawPacket))
-					}
-					return rsp, nil
-				})
+				rsps, allResult, extracted, reqCount := y.handleRequestSequences(config, ret.RequestConfig, ret.Requests, payload, y.httpSender(config, ret.RequestConfig, opts...))
 				result := false
 				for _, b := range allResult {
 					result = result || b
@@ -257,7 +233,53 @@ func (y *YakTemplate) ExecWithUrl(u string, config *Config, opts ...lowhttp.Lowh
 		swg.Wait()
 		return int(count), nil
 	} else {
-		return 0, utils.Errorf("[%s] tcp/http is all empty!", y.Name)
+		return 0, utils.Errorf("[%s] tcp/http/dns/ssl/file/headless is all empty!", y.Name)
+	}
+}
+
+// httpSender 为 http 请求序列构造发包函数，flow 与普通执行共用
+func (y *YakTemplate) httpSender(config *Config, bulk *YakRequestBulkConfig, opts ...lowhttp.LowhttpOpt) func(raw []byte, req *requestRaw) (*lowhttp.LowhttpResponse, error) {
+	return func(raw []byte, req *requestRaw) (*lowhttp.LowhttpResponse, error) {
+		if config.BeforeSendPackage != nil {
+			raw = config.BeforeSendPackage(raw, req.IsHttps)
+		}
+		packetOpt := opts
+		redictTimes := 0
+		if bulk.EnableRedirect {
+			redictTimes = bulk.MaxRedirects
+		}
+		packetOpt = append(
+			packetOpt,
+			lowhttp.WithPacketBytes(raw),
+			lowhttp.WithHttps(req.IsHttps),
+			lowhttp.WithSource(y.Name),
+			lowhttp.WithNoFixContentLength(bulk.NoFixContentLength),
+			lowhttp.WithRedirectTimes(redictTimes),
+			lowhttp.WithTimeout(req.Timeout),
+		)
+		if req.OverrideHost != "" {
+			packetOpt = append(packetOpt, lowhttp.WithHost(req.OverrideHost))
+		}
+
+		if config.Debug && config.DebugRequest {
+			fmt.Printf("--------------REQ---------------\n")
+			fmt.Println(string(raw))
+		}
+
+		utils.Debug(func() {
+			log.Info("nuclei lowhttp.Exec! ")
+			spew.Dump(raw)
+		})
+		rsp, err := lowhttp.HTTP(packetOpt...)
+		if err != nil {
+			// log.Error(err)
+			return nil, err
+		}
+		if config.Debug && config.DebugResponse {
+			fmt.Printf("--------------RSP---------------\n")
+			fmt.Println(string(rsp.RawPacket))
+		}
+		return rsp, nil
 	}
 }
 
@@ -0,0 +1,137 @@
+package httptpl
+
+import (
+	"io/fs"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+// 默认不扫描的文件后缀，与 nuclei file 协议保持一致
+var defaultFileDenyList = []string{
+	".3g2", ".3gp", ".7z", ".apk", ".arj", ".avi", ".axd", ".bmp", ".css", ".csv", ".deb", ".dll", ".doc", ".drv",
+	".eot", ".exe", ".flv", ".gif", ".gifv", ".gz", ".h264", ".ico", ".iso", ".jar", ".jpeg", ".jpg", ".lock", ".m4a",
+	".m4v", ".map", ".mkv", ".mov", ".mp3", ".mp4", ".mpeg", ".mpg", ".msi", ".ogg", ".ogm", ".ogv", ".otf", ".pdf",
+	".pkg", ".png", ".ppt", ".psd", ".rar", ".rm", ".rpm", ".svg", ".swf", ".sys", ".tar.gz", ".tar", ".tif", ".tiff",
+	".ttf", ".txt", ".vob", ".wav", ".webm", ".webp", ".wmv", ".woff", ".woff2", ".xcf", ".xls", ".xlsx", ".zip",
+}
+
+const defaultFileMaxSize = 1 * 1024 * 1024 * 1024
+
+type YakFileBulkConfig struct {
+	Id string
+	// Extensions 需要扫描的文件后缀，all 表示所有文件
+	Extensions  []string
+	DenyList    []string
+	MaxSize     int64
+	NoRecursive bool
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+func normalizeFileExtension(ext string) string {
+	ext = strings.ToLower(strings.TrimSpace(ext))
+	if ext == "" || ext == "all" || ext == "*" {
+		return ext
+	}
+	if !strings.HasPrefix(ext, ".") {
+		ext = "." + ext
+	}
+	return ext
+}
+
+func (c *YakFileBulkConfig) allowed(name string) bool {
+	name = strings.ToLower(name)
+	all := len(c.Extensions) <= 0
+	for _, ext := range c.Extensions {
+		ext = normalizeFileExtension(ext)
+		if ext == "all" || ext == "*" {
+			all = true
+			continue
+		}
+		// 明确指定的后缀不受黑名单影响
+		if ext != "" && strings.HasSuffix(name, ext) {
+			return true
+		}
+	}
+	if !all {
+		return false
+	}
+	for _, deny := range append(defaultFileDenyList, c.DenyList...) {
+		if deny = normalizeFileExtension(deny); deny != "" && strings.HasSuffix(name, deny) {
+			return false
+		}
+	}
+	return true
+}
+
+// Execute 遍历文件系统中 root 下的文件，每个文件对应一个响应
+func (c *YakFileBulkConfig) Execute(config *Config, root string, fileSystem fi.FileSystem) ([]*NucleiProtocolResponse, error) {
+	if fileSystem == nil {
+		fileSystem = filesys.NewLocalFs()
+	}
+	maxSize := c.MaxSize
+	if maxSize <= 0 {
+		maxSize = defaultFileMaxSize
+	}
+
+	var rsps []*NucleiProtocolResponse
+	handleFile := func(pathname string, info fs.FileInfo) error {
+		if info != nil && info.Size() > maxSize {
+			log.Debugf("file %v is too large(%v), skipped", pathname, info.Size())
+			return nil
+		}
+		if !c.allowed(pathname) {
+			return nil
+		}
+		raw, err := fileSystem.ReadFile(pathname)
+		if err != nil {
+			log.Warnf("read file %v failed: %s", pathname, err)
+			return nil
+		}
+		rsp := newProtocolResponse(ProtocolFile, config)
+		rsp.RawRequest = []byte(pathname)
+		rsp.RawPacket = raw
+		rsp.RemoteAddr = pathname
+		rsp.Parts["path"] = pathname
+		rsp.Parts["data"] = string(raw)
+		rsps = append(rsps, rsp)
+		return nil
+	}
+
+	info, err := fileSystem.Stat(root)
+	if err != nil {
+		return nil, utils.Errorf("stat %v failed: %s", root, err)
+	}
+	if !info.IsDir() {
+		handleFile(root, info)
+		return rsps, nil
+	}
+
+	if c.NoRecursive {
+		entries, err := fileSystem.ReadDir(root)
+		if err != nil {
+			return nil, utils.Errorf("read dir %v failed: %s", root, err)
+		}
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+			info, err := entry.Info()
+			if err != nil {
+				continue
+			}
+			handleFile(fileSystem.Join(root, entry.Name()), info)
+		}
+		return rsps, nil
+	}
+	err = filesys.Recursive(root, filesys.WithFileSystem(fileSystem), filesys.WithFileStat(handleFile))
+	if err != nil {
+		return rsps, utils.Errorf("walk %v failed: %s", root, err)
+	}
+	return rsps, nil
+}
@@ -0,0 +1,383 @@
+package httptpl
+
+import (
+	"strings"
+	"sync/atomic"
+
+	"github.com/dop251/goja"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	utils2 "github.com/yaklang/yaklang/common/yak/httptpl/utils"
+)
+
+// flowStepResult flow 中一次协议请求的执行结果，等 flow 结束后统一回调
+type flowStepResult struct {
+	Protocol  string
+	Bulk      any
+	Responses any
+	Matched   bool
+	Extracted map[string]any
+}
+
+type flowExecutor struct {
+	template      *YakTemplate
+	url           string
+	config        *Config
+	opts          []lowhttp.LowhttpOpt
+	lowhttpConfig *lowhttp.LowhttpExecConfig
+
+	count   int64
+	results []*flowStepResult
+}
+
+func newFlowExecutor(y *YakTemplate, u string, config *Config, opts ...lowhttp.LowhttpOpt) *flowExecutor {
+	lowhttpConfig := lowhttp.NewLowhttpOption()
+	for _, opt := range opts {
+		opt(lowhttpConfig)
+	}
+	if lowhttpConfig.Ctx == nil {
+		lowhttpConfig.Ctx = config.Ctx
+	}
+	return &flowExecutor{
+		template:      y,
+		url:           u,
+		config:        config,
+		opts:          opts,
+		lowhttpConfig: lowhttpConfig,
+	}
+}
+
+// protocolIds 返回协议中每个请求的 id，下标即请求的序号
+func (f *flowExecutor) protocolIds(protocol string) []string {
+	y := f.template
+	var ids []string
+	switch protocol {
+	case ProtocolHTTP:
+		for _, c := range y.HTTPRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	case ProtocolTCP:
+		for _, c := range y.TCPRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	case ProtocolDNS:
+		for _, c := range y.DNSRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	case ProtocolSSL:
+		for _, c := range y.SSLRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	case ProtocolFile:
+		for _, c := range y.FileRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	case ProtocolHeadless:
+		for _, c := range y.HeadlessRequestSequences {
+			ids = append(ids, c.Id)
+		}
+	}
+	return ids
+}
+
+// selectIndexes 根据参数选择请求：不传参数时执行全部，数字为从 1 开始的序号，字符串为请求的 id
+func (f *flowExecutor) selectIndexes(protocol string, selectors ...any) []int {
+	ids := f.protocolIds(protocol)
+	if len(selectors) <= 0 {
+		indexes := make([]int, len(ids))
+		for i := range ids {
+			indexes[i] = i
+		}
+		return indexes
+	}
+	var indexes []int
+	for _, selector := range selectors {
+		switch ret := selector.(type) {
+		case int64, int, float64:
+			index := utils.InterfaceToInt(ret) - 1
+			if index >= 0 && index < len(ids) {
+				indexes = append(indexes, index)
+			} else {
+				log.Warnf("[%v] flow %v(%v) out of range", f.template.Name, protocol, ret)
+			}
+		default:
+			name := utils.InterfaceToString(ret)
+			found := false
+			for i, id := range ids {
+				if id != "" && id == name {
+					indexes = append(indexes, i)
+					found = true
+				}
+			}
+			if !found {
+				log.Warnf("[%v] flow %v(%#v) not found", f.template.Name, protocol, name)
+			}
+		}
+	}
+	return indexes
+}
+
+// execute 执行某个协议中选中的请求，只要有一个请求命中即返回 true
+func (f *flowExecutor) execute(protocol string, selectors ...any) bool {
+	matched := false
+	for _, index := range f.selectIndexes(protocol, selectors...) {
+		if f.config.Ctx != nil && f.config.Ctx.Err() != nil {
+			return matched
+		}
+		var step *flowStepResult
+		switch protocol {
+		case ProtocolHTTP:
+			step = f.executeHTTP(f.template.HTTPRequestSequences[index])
+		case ProtocolTCP:
+			step = f.executeTCP(f.template.TCPRequestSequences[index])
+		default:
+			step = f.executeProtocol(protocol, index)
+		}
+		if step == nil {
+			continue
+		}
+		f.results = append(f.results, step)
+		matched = matched || step.Matched
+	}
+	return matched
+}
+
+func (f *flowExecutor) executeHTTP(bulk *YakRequestBulkConfig) *flowStepResult {
+	y := f.template
+	step := &flowStepResult{Protocol: ProtocolHTTP, Bulk: bulk, Extracted: make(map[string]any)}
+	var responses []*lowhttp.LowhttpResponse
+	for _, ret := range y.GenerateRequestSequences(f.url, true) {
+		if ret.RequestConfig != bulk {
+			continue
+		}
+		rsps, allResult, extracted, reqCount := y.handleRequestSequences(f.config, ret.RequestConfig, ret.Requests, ret.RequestConfig.Payloads.GetData(), y.httpSender(f.config, ret.RequestConfig, f.opts...))
+		atomic.AddInt64(&f.count, reqCount)
+		responses = append(responses, rsps...)
+		for _, b := range allResult {
+			step.Matched = step.Matched || b
+		}
+		for k, v := range extracted {
+			step.Extracted[k] = v
+		}
+	}
+	step.Responses = responses
+	return step
+}
+
+func (f *flowExecutor) executeTCP(bulk *YakNetworkBulkConfig) *flowStepResult {
+	y := f.template
+	step := &flowStepResult{Protocol: ProtocolTCP, Bulk: bulk, Extracted: make(map[string]any)}
+	var responses []*NucleiTcpResponse
+	err := bulk.Execute(f.config, y.Variables.ToMap(), utils2.ExtractorVarsFromUrl(f.url), f.lowhttpConfig, func(rsp []*NucleiTcpResponse, matched bool, extractorResults map[string]any) {
+		atomic.AddInt64(&f.count, 1)
+		responses = append(responses, rsp...)
+		step.Matched = step.Matched || matched
+		for k, v := range extractorResults {
+			v := ExtractResultToString(v)
+			y.Variables.Set(k, v)
+			step.Extracted[k] = v
+		}
+	})
+	if err != nil {
+		log.Errorf("tcpReq.Execute failed: %s", err)
+	}
+	step.Responses = responses
+	return step
+}
+
+func (f *flowExecutor) executeProtocol(protocol string, index int) *flowStepResult {
+	y := f.template
+	var (
+		bulk       any
+		matcher    *YakMatcher
+		extractors []*YakExtractor
+		rsps       []*NucleiProtocolResponse
+		err        error
+	)
+	vars := y.protocolRenderVars(f.url)
+	switch protocol {
+	case ProtocolDNS:
+		c := y.DNSRequestSequences[index]
+		bulk, matcher, extractors = c, c.Matcher, c.Extractor
+		rsps, err = c.Execute(f.config, vars, f.lowhttpConfig)
+	case ProtocolSSL:
+		c := y.SSLRequestSequences[index]
+		bulk, matcher, extractors = c, c.Matcher, c.Extractor
+		rsps, err = c.Execute(f.config, vars, f.lowhttpConfig)
+	case ProtocolFile:
+		c := y.FileRequestSequences[index]
+		bulk, matcher, extractors = c, c.Matcher, c.Extractor
+		if utils.IsHttpOrHttpsUrl(f.url) {
+			log.Debugf("[%v] file request need a path target, skip %v", y.Name, f.url)
+			return nil
+		}
+		rsps, err = c.Execute(f.config, f.url, f.config.FileSystem)
+	case ProtocolHeadless:
+		c := y.HeadlessRequestSequences[index]
+		bulk, matcher, extractors = c, c.Matcher, c.Extractor
+		if !f.config.EnableHeadless {
+			log.Infof("[%v] headless request skipped, use headless(true) to enable it", y.Name)
+			return nil
+		}
+		rsps, err = c.Execute(f.config, vars, f.lowhttpConfig)
+	default:
+		log.Warnf("[%v] unsupported flow protocol: %v", y.Name, protocol)
+		return nil
+	}
+	if err != nil {
+		log.Warnf("[%v] %v request failed: %s", y.Name, protocol, err)
+	}
+	atomic.AddInt64(&f.count, int64(len(rsps)))
+	step := &flowStepResult{Protocol: protocol, Bulk: bulk, Responses: rsps}
+	if len(rsps) > 0 {
+		result := y.matchProtocolResponses(f.config, matcher, extractors, rsps, vars)
+		step.Matched, step.Extracted = result.Matched, result.Extracted
+	}
+	return step
+}
+
+// protocols 没有 flow 时按模板中的书写顺序执行全部协议
+func (f *flowExecutor) protocols() []string {
+	y := f.template
+	if len(y.protocolOrder) > 0 {
+		return y.protocolOrder
+	}
+	var protocols []string
+	for _, p := range []struct {
+		Name string
+		Size int
+	}{
+		{ProtocolHTTP, len(y.HTTPRequestSequences)},
+		{ProtocolTCP, len(y.TCPRequestSequences)},
+		{ProtocolDNS, len(y.DNSRequestSequences)},
+		{ProtocolSSL, len(y.SSLRequestSequences)},
+		{ProtocolFile, len(y.FileRequestSequences)},
+		{ProtocolHeadless, len(y.HeadlessRequestSequences)},
+	} {
+		if p.Size > 0 {
+			protocols = append(protocols, p.Name)
+		}
+	}
+	return protocols
+}
+
+// runScript 在 goja 中执行 flow，返回 flow 的最终结果；flow 的值不是 bool 时由各个请求的结果决定
+func (f *flowExecutor) runScript(flow string) (*bool, error) {
+	y := f.template
+	vm := goja.New()
+	// template 中的变量在每次协议执行后刷新，extractor 的结果可以直接读取
+	refresh := func() {
+		templateVars := vm.NewObject()
+		for k, v := range y.Variables.ToMap() {
+			_ = templateVars.Set(k, v)
+		}
+		_ = vm.Set("template", templateVars)
+	}
+	refresh()
+	for name, protocol := range map[string]string{
+		"http":     ProtocolHTTP,
+		"tcp":      ProtocolTCP,
+		"network":  ProtocolTCP,
+		"dns":      ProtocolDNS,
+		"ssl":      ProtocolSSL,
+		"file":     ProtocolFile,
+		"headless": ProtocolHeadless,
+	} {
+		protocol := protocol
+		_ = vm.Set(name, func(call goja.FunctionCall) goja.Value {
+			var selectors []any
+			for _, arg := range call.Arguments {
+				selectors = append(selectors, arg.Export())
+			}
+			matched := f.execute(protocol, selectors...)
+			refresh()
+			return vm.ToValue(matched)
+		})
+	}
+	_ = vm.Set("set", func(key string, value goja.Value) {
+		y.Variables.Set(key, utils.InterfaceToString(value.Export()))
+		refresh()
+	})
+	_ = vm.Set("log", func(call goja.FunctionCall) goja.Value {
+		var items []string
+		for _, arg := range call.Arguments {
+			items = append(items, arg.String())
+		}
+		log.Infof("[%v] flow: %v", y.Name, strings.Join(items, " "))
+		return goja.Undefined()
+	})
+	// iterate 展开数组并过滤空值，常用于 for (let v of iterate(template["names"]))
+	_ = vm.Set("iterate", func(call goja.FunctionCall) goja.Value {
+		var items []any
+		for _, arg := range call.Arguments {
+			for _, item := range utils.InterfaceToSliceInterface(arg.Export()) {
+				if item != nil && utils.InterfaceToString(item) != "" {
+					items = append(items, item)
+				}
+			}
+		}
+		return vm.ToValue(items)
+	})
+
+	if f.config.Ctx != nil {
+		stop := make(chan struct{})
+		defer close(stop)
+		go func() {
+			select {
+			case <-f.config.Ctx.Done():
+				vm.Interrupt("context canceled")
+			case <-stop:
+			}
+		}()
+	}
+	value, err := vm.RunString(flow)
+	if err != nil {
+		return nil, utils.Errorf("execute flow failed: %v", err)
+	}
+	if value != nil {
+		if b, ok := value.Export().(bool); ok {
+			return &b, nil
+		}
+	}
+	return nil, nil
+}
+
+// run 执行 flow（没有 flow 时按顺序执行全部协议）并回调所有请求的结果
+func (f *flowExecutor) run() (int, error) {
+	y := f.template
+	var overall *bool
+	if strings.TrimSpace(y.Flow) != "" {
+		var err error
+		overall, err = f.runScript(y.Flow)
+		if err != nil {
+			log.Errorf("[%v] %s", y.Name, err)
+		}
+	} else {
+		for _, protocol := range f.protocols() {
+			f.execute(protocol)
+		}
+	}
+
+	for _, step := range f.results {
+		matched := step.Matched
+		if overall != nil {
+			matched = matched && *overall
+		}
+		if matched {
+			log.Infof("[%v]-[%v] %v matched", y.Name, y.Id, step.Protocol)
+		}
+		switch bulk := step.Bulk.(type) {
+		case *YakRequestBulkConfig:
+			rsps, _ := step.Responses.([]*lowhttp.LowhttpResponse)
+			f.config.ExecuteResultCallback(y, bulk, rsps, matched, step.Extracted)
+		case *YakNetworkBulkConfig:
+			rsps, _ := step.Responses.([]*NucleiTcpResponse)
+			f.config.ExecuteTCPResultCallback(y, bulk, rsps, matched, step.Extracted)
+		default:
+			rsps, _ := step.Responses.([]*NucleiProtocolResponse)
+			f.config.ExecuteProtocolResultCallback(y, bulk, rsps, matched, step.Extracted)
+		}
+	}
+	return int(f.count), nil
+}
@@ -0,0 +1,93 @@
+package httptpl
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/yaklang/yaklang/common/crawlerx"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type YakHeadlessBulkConfig struct {
+	Id string
+	// Steps 页面动作，参数中的 {{BaseURL}} 等变量在执行前渲染
+	Steps     []*crawlerx.HeadlessAction
+	UserAgent string
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+func (c *YakHeadlessBulkConfig) renderSteps(vars map[string]any) []*crawlerx.HeadlessAction {
+	steps := make([]*crawlerx.HeadlessAction, 0, len(c.Steps))
+	for _, step := range c.Steps {
+		rendered := &crawlerx.HeadlessAction{
+			Action: step.Action,
+			Name:   step.Name,
+			Args:   make(map[string]string, len(step.Args)),
+		}
+		for k, v := range step.Args {
+			value, err := QuickFuzzNucleiTag(v, vars)
+			if err != nil {
+				log.Warnf("render headless action %v arg %v failed: %s", step.Action, k, err)
+				value = v
+			}
+			rendered.Args[k] = value
+		}
+		steps = append(steps, rendered)
+	}
+	return steps
+}
+
+// Execute 通过 crawlerx 启动浏览器依次执行页面动作，最终页面与具名动作的输出作为响应
+func (c *YakHeadlessBulkConfig) Execute(config *Config, vars map[string]any, lowhttpConfig *lowhttp.LowhttpExecConfig) ([]*NucleiProtocolResponse, error) {
+	steps := c.renderSteps(vars)
+	if len(steps) <= 0 {
+		return nil, utils.Error("headless steps is empty")
+	}
+
+	timeout := 30 * time.Second
+	if lowhttpConfig.Timeout > 0 {
+		timeout = lowhttpConfig.Timeout
+	}
+	opts := []crawlerx.ConfigOpt{crawlerx.WithPageTimeout(int(timeout.Seconds()))}
+	if lowhttpConfig.Ctx != nil {
+		opts = append(opts, crawlerx.WithContext(lowhttpConfig.Ctx))
+	}
+	if len(lowhttpConfig.Proxy) > 0 {
+		proxy, err := url.Parse(lowhttpConfig.Proxy[0])
+		if err != nil {
+			log.Warnf("parse headless proxy %v failed: %s", lowhttpConfig.Proxy[0], err)
+		} else {
+			opts = append(opts, crawlerx.WithBrowserData(crawlerx.NewBrowserConfig("", "", proxy)))
+		}
+	}
+	if c.UserAgent != "" {
+		opts = append(opts, crawlerx.WithHeaders(map[string]string{"User-Agent": c.UserAgent}))
+	}
+
+	result, err := crawlerx.RunHeadlessActions(steps, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if config.Debug || config.DebugResponse {
+		log.Infof("headless response from %v:\n%v", result.URL, result.HTML)
+	}
+
+	rsp := newProtocolResponse(ProtocolHeadless, config)
+	for _, step := range steps {
+		rsp.RawRequest = append(rsp.RawRequest, []byte(step.Action+" "+utils.InterfaceToString(step.Args)+"\n")...)
+	}
+	rsp.RawPacket = []byte(result.HTML)
+	rsp.RemoteAddr = result.URL
+	rsp.Parts["url"] = result.URL
+	rsp.Parts["resp"] = result.HTML
+	rsp.Parts["body"] = result.HTML
+	rsp.Parts["data"] = result.HTML
+	for name, output := range result.Outputs {
+		rsp.Parts[name] = output
+	}
+	return []*NucleiProtocolResponse{rsp}, nil
+}
@@ -92,6 +92,9 @@ type YakMatcher struct {
 
 	Negative bool
 
+	// Name nuclei 中具名的 matcher，workflows 根据命中的 name 决定执行哪些子模板
+	Name string
+
 	// or / and
 	SubMatcherCondition string
 	SubMatchers         []*YakMatcher
@@ -117,12 +120,17 @@ func (y *YakMatcher) ExecuteRaw(rsp []byte, vars map[string]interface{}, suf ...
 func (y *YakMatcher) ExecuteRawWithConfig(config *Config, rsp []byte, vars map[string]interface{}, suf ...string) (bool, error) {
 	if len(y.SubMatchers) > 0 {
 		if strings.TrimSpace(strings.ToLower(y.SubMatcherCondition)) == "or" {
+			matched := false
 			for _, matcher := range y.SubMatchers {
 				if b, _ := matcher.ExecuteRawWithConfig(config, rsp, vars, suf...); b {
-					return true, nil
+					matched = true
+					// workflows 需要收集所有命中的 matcher name，此时不能短路
+					if !config.isRecordingMatcherName() {
+						break
+					}
 				}
 			}
-			return false, nil
+			return matched, nil
 		} else {
 			for _, matcher := range y.SubMatchers {
 				if b, _ := matcher.ExecuteRawWithConfig(config, rsp, vars, suf...); !b {
@@ -133,14 +141,17 @@ func (y *YakMatcher) ExecuteRawWithConfig(config *Config, rsp []byte, vars map[s
 		}
 	}
 
+	res, err := y.executeRaw(y.TemplateName, config, rsp, 0, vars, suf...)
 	if y.Negative {
-		res, err := y.executeRaw(y.TemplateName, config, rsp, 0, vars, suf...)
 		if err != nil {
 			return false, err
 		}
-		return !res, err
+		res = !res
+	}
+	if err == nil {
+		config.recordMatcherName(y.Name, res)
 	}
-	return y.executeRaw(y.TemplateName, config, rsp, 0, vars, suf...)
+	return res, err
 }
 
 type RespForMatch struct {
@@ -155,12 +166,17 @@ func (y *YakMatcher) Execute(rsp *RespForMatch, vars map[string]interface{}, suf
 func (y *YakMatcher) ExecuteWithConfig(config *Config, rsp *RespForMatch, vars map[string]interface{}, suf ...string) (bool, error) {
 	if len(y.SubMatchers) > 0 {
 		if strings.TrimSpace(strings.ToLower(y.SubMatcherCondition)) == "or" {
+			matched := false
 			for _, matcher := range y.SubMatchers {
 				if b, _ := matcher.ExecuteWithConfig(config, rsp, vars, suf...); b {
-					return true, nil
+					matched = true
+					// workflows 需要收集所有命中的 matcher name，此时不能短路
+					if !config.isRecordingMatcherName() {
+						break
+					}
 				}
 			}
-			return false, nil
+			return matched, nil
 		} else {
 			for _, matcher := range y.SubMatchers {
 				if b, _ := matcher.ExecuteWithConfig(config, rsp, vars, suf...); !b {
@@ -171,14 +187,17 @@ func (y *YakMatcher) ExecuteWithConfig(config *Config, rsp *RespForMatch, vars m
 		}
 	}
 
+	res, err := y.execute(config, rsp, vars, suf...)
 	if y.Negative {
-		res, err := y.execute(config, rsp, vars, suf...)
 		if err != nil {
 			return false, err
 		}
-		return !res, err
+		res = !res
+	}
+	if err == nil {
+		config.recordMatcherName(y.Name, res)
 	}
-	return y.execute(config, rsp, vars, suf...)
+	return res, err
 }
 
 func (y *YakMatcher) executeRaw(name string, config *Config, rsp []byte, duration float64, vars map[string]any, sufs ...string) (bool, error) {
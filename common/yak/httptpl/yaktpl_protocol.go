@@ -0,0 +1,215 @@
+package httptpl
+
+import (
+	"net"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	utils2 "github.com/yaklang/yaklang/common/yak/httptpl/utils"
+	"golang.org/x/net/publicsuffix"
+)
+
+const (
+	ProtocolHTTP     = "http"
+	ProtocolTCP      = "tcp"
+	ProtocolDNS      = "dns"
+	ProtocolSSL      = "ssl"
+	ProtocolFile     = "file"
+	ProtocolHeadless = "headless"
+)
+
+// NucleiProtocolResponse dns / ssl / file / headless 协议的响应
+type NucleiProtocolResponse struct {
+	Protocol   string
+	RawRequest []byte
+	RawPacket  []byte
+	RemoteAddr string
+	RuntimeId  string
+
+	// Parts 协议特有的字段，matcher / extractor 通过 part 读取，dsl 中也可以直接作为变量使用
+	Parts map[string]any
+}
+
+func newProtocolResponse(protocol string, config *Config) *NucleiProtocolResponse {
+	rsp := &NucleiProtocolResponse{
+		Protocol: protocol,
+		Parts:    make(map[string]any),
+	}
+	if config != nil {
+		rsp.RuntimeId = config.RuntimeId
+	}
+	return rsp
+}
+
+// Part 按 nuclei 的 part 名称取出响应中的内容，未指定 part 时为完整响应
+func (r *NucleiProtocolResponse) Part(name string) []byte {
+	if r == nil {
+		return nil
+	}
+	name = strings.ToLower(strings.TrimSpace(name))
+	switch name {
+	case "", "raw", "all", "response":
+		return r.RawPacket
+	case "request":
+		return r.RawRequest
+	}
+	if v, ok := r.Parts[name]; ok {
+		return toBytes(v)
+	}
+	return nil
+}
+
+// vars 将响应中的各个 part 作为 dsl 变量
+func (r *NucleiProtocolResponse) vars() map[string]any {
+	vars := make(map[string]any, len(r.Parts)+2)
+	for k, v := range r.Parts {
+		vars[k] = v
+	}
+	vars["raw"] = string(r.RawPacket)
+	vars["request"] = string(r.RawRequest)
+	return vars
+}
+
+// ExecuteProtocolResponseWithConfig 对非 HTTP 协议的响应执行 matcher，
+// 这些响应没有 header / body 之分，每个子 matcher 按自己的 part 取出内容后再匹配
+func (y *YakMatcher) ExecuteProtocolResponseWithConfig(config *Config, rsp *NucleiProtocolResponse, vars map[string]any) (bool, error) {
+	if len(y.SubMatchers) > 0 {
+		if strings.TrimSpace(strings.ToLower(y.SubMatcherCondition)) == "or" {
+			matched := false
+			for _, matcher := range y.SubMatchers {
+				if b, _ := matcher.ExecuteProtocolResponseWithConfig(config, rsp, vars); b {
+					matched = true
+					if !config.isRecordingMatcherName() {
+						break
+					}
+				}
+			}
+			return matched, nil
+		}
+		for _, matcher := range y.SubMatchers {
+			if b, _ := matcher.ExecuteProtocolResponseWithConfig(config, rsp, vars); !b {
+				return false, nil
+			}
+		}
+		return true, nil
+	}
+
+	matcher := *y
+	switch strings.ToLower(y.Scope) {
+	case "interactsh_protocol", "oob_protocol", "interactsh_request":
+	default:
+		matcher.Scope = "raw"
+	}
+	mergedVars := utils.MergeGeneralMap(vars, rsp.vars())
+	res, err := matcher.executeRaw(y.TemplateName, config, rsp.Part(y.Scope), 0, mergedVars)
+	if err != nil {
+		return false, err
+	}
+	if y.Negative {
+		res = !res
+	}
+	config.recordMatcherName(y.Name, res)
+	return res, nil
+}
+
+// ExecuteProtocolResponse 对非 HTTP 协议的响应执行 extractor
+func (y *YakExtractor) ExecuteProtocolResponse(rsp *NucleiProtocolResponse, previous ...map[string]any) (map[string]any, error) {
+	extractor := *y
+	extractor.Scope = "raw"
+	return extractor.Execute(rsp.Part(y.Scope), append([]map[string]any{rsp.vars()}, previous...)...)
+}
+
+// protocolMatchResult 协议响应的匹配与提取结果
+type protocolMatchResult struct {
+	Matched   bool
+	Extracted map[string]any
+}
+
+// matchProtocolResponses 对一组响应依次执行 extractor 与 matcher，
+// 提取到的变量会写回模板变量，供后续请求（或 flow 中的后续协议）使用
+func (y *YakTemplate) matchProtocolResponses(config *Config, matcher *YakMatcher, extractors []*YakExtractor, rsps []*NucleiProtocolResponse, vars map[string]any) *protocolMatchResult {
+	result := &protocolMatchResult{Extracted: make(map[string]any)}
+	if vars == nil {
+		vars = make(map[string]any)
+	}
+	for index, rsp := range rsps {
+		for _, extractor := range extractors {
+			if extractor.Id != 0 && extractor.Id != index+1 {
+				continue
+			}
+			extracted, err := extractor.ExecuteProtocolResponse(rsp, vars)
+			if err != nil {
+				log.Warnf("[%v] %v extractor execute failed: %s", y.Name, rsp.Protocol, err)
+				continue
+			}
+			for k, v := range extracted {
+				if values, ok := v.([]string); ok && len(values) == 0 {
+					continue
+				}
+				v := ExtractResultToString(v)
+				y.Variables.Set(k, v)
+				vars[k] = v
+				result.Extracted[k] = v
+			}
+		}
+	}
+
+	if matcher == nil {
+		// 只有 extractor 时，提取到数据即视为命中
+		result.Matched = len(result.Extracted) > 0
+		return result
+	}
+	for _, rsp := range rsps {
+		matched, err := matcher.ExecuteProtocolResponseWithConfig(config, rsp, vars)
+		if err != nil {
+			log.Warnf("[%v] %v matcher execute failed: %s", y.Name, rsp.Protocol, err)
+		}
+		if matched {
+			result.Matched = true
+			break
+		}
+	}
+	return result
+}
+
+// protocolRenderVars 非 HTTP 协议渲染请求时使用的变量：目标相关变量 + 模板变量
+func (y *YakTemplate) protocolRenderVars(u string) map[string]any {
+	vars := make(map[string]any)
+	if u != "" {
+		vars = utils.InterfaceToMapInterface(utils2.ExtractorVarsFromUrl(u))
+		for k, v := range domainVars(utils.MapGetString(vars, "Host")) {
+			vars[k] = v
+		}
+	}
+	for k, v := range y.Variables.ToMap() {
+		vars[k] = v
+	}
+	return vars
+}
+
+// domainVars nuclei 中与域名相关的变量
+// {{FQDN}} - 完整域名 {{RDN}} - 注册域名 {{DN}} - 去掉后缀的注册域名 {{TLD}} - 顶级域名 {{SD}} - 子域名部分
+func domainVars(host string) map[string]any {
+	host = strings.TrimSuffix(strings.TrimSpace(host), ".")
+	vars := map[string]any{
+		"FQDN": host,
+		"RDN":  host,
+		"DN":   host,
+		"TLD":  "",
+		"SD":   "",
+	}
+	if host == "" || net.ParseIP(host) != nil {
+		return vars
+	}
+	rdn, err := publicsuffix.EffectiveTLDPlusOne(host)
+	if err != nil {
+		return vars
+	}
+	tld, _ := publicsuffix.PublicSuffix(host)
+	vars["RDN"] = rdn
+	vars["TLD"] = tld
+	vars["DN"] = strings.TrimSuffix(rdn, "."+tld)
+	vars["SD"] = strings.TrimSuffix(strings.TrimSuffix(host, rdn), ".")
+	return vars
+}
@@ -0,0 +1,285 @@
+package httptpl
+
+import (
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/facades"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type protocolResult struct {
+	Protocol  string
+	Matched   bool
+	Extracted map[string]any
+}
+
+func collectProtocolResults(results *[]*protocolResult) ConfigOption {
+	var m sync.Mutex
+	return func(config *Config) {
+		config.AppendResultCallback(func(y *YakTemplate, reqBulk any, rsp any, result bool, extractor map[string]interface{}) {
+			protocol := ProtocolHTTP
+			switch ret := rsp.(type) {
+			case []*NucleiTcpResponse:
+				protocol = ProtocolTCP
+			case []*NucleiProtocolResponse:
+				if len(ret) > 0 {
+					protocol = ret[0].Protocol
+				}
+			}
+			m.Lock()
+			defer m.Unlock()
+			*results = append(*results, &protocolResult{Protocol: protocol, Matched: result, Extracted: extractor})
+		})
+	}
+}
+
+func TestNucleiProtocol_DNS(t *testing.T) {
+	token := utils.RandStringBytes(16)
+	server := facades.MockDNSServerDefault("", func(record string, domain string) string {
+		if record == "TXT" {
+			return "v=yaklang " + token
+		}
+		return "127.0.0.1"
+	})
+	require.NotEmpty(t, server)
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: dns-txt
+info:
+  name: dns-txt
+  author: v1ll4n
+dns:
+  - name: "{{FQDN}}"
+    type: TXT
+    matchers:
+      - type: word
+        part: answer
+        words:
+          - "v=yaklang"
+    extractors:
+      - type: regex
+        name: token
+        part: answer
+        group: 1
+        regex:
+          - 'v=yaklang ([a-zA-Z0-9]+)'
+`)
+	require.NoError(t, err)
+	require.Len(t, tpl.DNSRequestSequences, 1)
+
+	var results []*protocolResult
+	_, err = tpl.ExecWithUrl("http://txt.example.com", NewConfig(collectProtocolResults(&results)), lowhttp.WithDNSServers([]string{server}))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, ProtocolDNS, results[0].Protocol)
+	require.True(t, results[0].Matched)
+	require.Equal(t, token, results[0].Extracted["token"])
+}
+
+func TestNucleiProtocol_SSL(t *testing.T) {
+	host, port := utils.DebugMockHTTPS([]byte("HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n"))
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: ssl-dsl
+info:
+  name: ssl-dsl
+  author: v1ll4n
+ssl:
+  - address: "{{Host}}:{{Port}}"
+    matchers:
+      - type: dsl
+        dsl:
+          - "probe_status == true"
+          - "len(fingerprint_hash) > 0"
+        condition: and
+    extractors:
+      - type: regex
+        name: version
+        part: tls_version
+        regex:
+          - 'tls1[0-3]'
+`)
+	require.NoError(t, err)
+	require.Len(t, tpl.SSLRequestSequences, 1)
+
+	var results []*protocolResult
+	_, err = tpl.ExecWithUrl("https://"+utils.HostPort(host, port), NewConfig(collectProtocolResults(&results)))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Matched)
+	require.True(t, strings.HasPrefix(utils.InterfaceToString(results[0].Extracted["version"]), "tls1"))
+}
+
+func TestNucleiProtocol_File(t *testing.T) {
+	vfs := filesys.NewVirtualFs()
+	vfs.AddFile("src/config/.env", "AWS_SECRET_ACCESS_KEY=abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+	vfs.AddFile("src/logo.png", "AWS_SECRET_ACCESS_KEY=abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+	vfs.AddFile("src/main.go", "package main")
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: aws-secret
+info:
+  name: aws-secret
+  author: v1ll4n
+file:
+  - extensions:
+      - all
+    max-size: 1Mb
+    extractors:
+      - type: regex
+        name: secret
+        group: 1
+        regex:
+          - 'AWS_SECRET_ACCESS_KEY=([a-zA-Z0-9]{40})'
+`)
+	require.NoError(t, err)
+	require.Len(t, tpl.FileRequestSequences, 1)
+	require.EqualValues(t, 1<<20, tpl.FileRequestSequences[0].MaxSize)
+
+	var results []*protocolResult
+	_, err = tpl.ExecWithUrl("src", NewConfig(collectProtocolResults(&results), WithFileSystem(vfs)))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.True(t, results[0].Matched)
+	require.Equal(t, "abcdefghijklmnopqrstuvwxyz0123456789ABCD", results[0].Extracted["secret"])
+
+	rsps, err := tpl.FileRequestSequences[0].Execute(NewConfig(), "src", vfs)
+	require.NoError(t, err)
+	// png 在默认的黑名单中
+	require.Len(t, rsps, 2)
+}
+
+func TestNucleiProtocol_FileScanNeedFileScheme(t *testing.T) {
+	vfs := filesys.NewVirtualFs()
+	vfs.AddFile("src/config/.env", "AWS_SECRET_ACCESS_KEY=abcdefghijklmnopqrstuvwxyz0123456789ABCD")
+
+	tpl := `id: aws-secret
+info:
+  name: aws-secret
+  author: v1ll4n
+file:
+  - extensions:
+      - all
+    extractors:
+      - type: regex
+        name: secret
+        group: 1
+        regex:
+          - 'AWS_SECRET_ACCESS_KEY=([a-zA-Z0-9]{40})'
+`
+	scan := func(target string) []*protocolResult {
+		var results []*protocolResult
+		ScanAuto(target, WithTemplateRaw(tpl), WithFileSystem(vfs), collectProtocolResults(&results))
+		return results
+	}
+
+	// 本地存在的路径不会被当作 file 协议的目标
+	require.Empty(t, scan("src"))
+
+	results := scan("file://src")
+	require.Len(t, results, 1)
+	require.Equal(t, ProtocolFile, results[0].Protocol)
+	require.Equal(t, "abcdefghijklmnopqrstuvwxyz0123456789ABCD", results[0].Extracted["secret"])
+}
+
+func TestNucleiProtocol_Flow(t *testing.T) {
+	token := utils.RandStringBytes(16)
+	dnsServer := facades.MockDNSServerDefault("", func(record string, domain string) string {
+		if record == "TXT" {
+			return "path=" + token
+		}
+		return "127.0.0.1"
+	})
+	host, port := utils.DebugMockHTTPEx(func(req []byte) []byte {
+		if strings.Contains(string(req), "/"+token) {
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 6\r\n\r\nflowed")
+		}
+		return []byte("HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+	})
+
+	raw := `id: flow
+info:
+  name: flow
+  author: v1ll4n
+flow: dns() && http(1)
+dns:
+  - name: "flow.example.com"
+    type: TXT
+    extractors:
+      - type: regex
+        name: path
+        part: answer
+        group: 1
+        regex:
+          - 'path=([a-zA-Z0-9]+)'
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/{{path}}"
+    matchers:
+      - type: word
+        words:
+          - flowed
+`
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(raw)
+	require.NoError(t, err)
+	require.Equal(t, []string{ProtocolDNS, ProtocolHTTP}, tpl.protocolOrder)
+
+	var results []*protocolResult
+	_, err = tpl.ExecWithUrl("http://"+utils.HostPort(host, port), NewConfig(collectProtocolResults(&results)), lowhttp.WithDNSServers([]string{dnsServer}))
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+	require.Equal(t, ProtocolDNS, results[0].Protocol)
+	require.Equal(t, ProtocolHTTP, results[1].Protocol)
+	require.True(t, results[1].Matched)
+
+	// dns 没有命中时 http 不会执行
+	tpl, err = CreateYakTemplateFromNucleiTemplateRaw(strings.Replace(raw, "path=", "nopath=", 1))
+	require.NoError(t, err)
+	results = nil
+	_, err = tpl.ExecWithUrl("http://"+utils.HostPort(host, port), NewConfig(collectProtocolResults(&results)), lowhttp.WithDNSServers([]string{dnsServer}))
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	require.Equal(t, ProtocolDNS, results[0].Protocol)
+	require.False(t, results[0].Matched)
+}
+
+func TestNucleiProtocol_ParseHeadless(t *testing.T) {
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: headless-title
+info:
+  name: headless-title
+  author: v1ll4n
+headless:
+  - steps:
+      - args:
+          url: "{{BaseURL}}"
+        action: navigate
+      - action: waitload
+      - action: script
+        name: title
+        args:
+          code: document.title
+    matchers:
+      - type: word
+        part: title
+        words:
+          - "Admin"
+`)
+	require.NoError(t, err)
+	require.Len(t, tpl.HeadlessRequestSequences, 1)
+	bulk := tpl.HeadlessRequestSequences[0]
+	require.Len(t, bulk.Steps, 3)
+	require.Equal(t, "title", bulk.Matcher.Scope)
+
+	steps := bulk.renderSteps(tpl.protocolRenderVars("http://example.com/admin"))
+	require.Equal(t, "http://example.com/admin", steps[0].Args["url"])
+
+	// 默认不启动浏览器
+	var results []*protocolResult
+	count, err := tpl.ExecWithUrl("http://example.com", NewConfig(collectProtocolResults(&results)))
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+	require.Len(t, results, 0)
+}
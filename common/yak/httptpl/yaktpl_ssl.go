@@ -0,0 +1,251 @@
+package httptpl
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/netx"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+)
+
+type YakSSLBulkConfig struct {
+	Id string
+	// Address 默认为 {{Host}}:{{Port}}
+	Address string
+	// sslv3 / tls10 / tls11 / tls12 / tls13
+	MinVersion   string
+	MaxVersion   string
+	CipherSuites []string
+	// TLSVersionEnum 枚举服务端支持的 TLS 版本，结果保存在 tls_version_enum 中
+	TLSVersionEnum bool
+
+	Matcher   *YakMatcher
+	Extractor []*YakExtractor
+}
+
+var sslVersions = []struct {
+	Name    string
+	Version uint16
+}{
+	{"sslv3", tls.VersionSSL30}, // nolint[:staticcheck]
+	{"tls10", tls.VersionTLS10},
+	{"tls11", tls.VersionTLS11},
+	{"tls12", tls.VersionTLS12},
+	{"tls13", tls.VersionTLS13},
+}
+
+func sslVersionByName(name string) uint16 {
+	name = strings.ToLower(strings.NewReplacer(".", "", "_", "", " ", "", "v1", "1").Replace(name))
+	for _, v := range sslVersions {
+		if v.Name == name {
+			return v.Version
+		}
+	}
+	return 0
+}
+
+func sslVersionName(version uint16) string {
+	for _, v := range sslVersions {
+		if v.Version == version {
+			return v.Name
+		}
+	}
+	return ""
+}
+
+func sslCipherSuites(names []string) []uint16 {
+	var ret []uint16
+	for _, name := range names {
+		for _, suite := range append(tls.CipherSuites(), tls.InsecureCipherSuites()...) {
+			if strings.EqualFold(suite.Name, strings.TrimSpace(name)) {
+				ret = append(ret, suite.ID)
+			}
+		}
+	}
+	return ret
+}
+
+// sslResponse 与 nuclei(tlsx) 的 ssl 响应字段保持一致，matcher / extractor 可以直接使用这些字段
+type sslResponse struct {
+	Host               string            `json:"host"`
+	IP                 string            `json:"ip"`
+	Port               string            `json:"port"`
+	ProbeStatus        bool              `json:"probe_status"`
+	TLSVersion         string            `json:"tls_version"`
+	Cipher             string            `json:"cipher"`
+	ALPN               string            `json:"alpn,omitempty"`
+	SNI                string            `json:"sni,omitempty"`
+	NotBefore          string            `json:"not_before"`
+	NotAfter           string            `json:"not_after"`
+	SubjectDN          string            `json:"subject_dn"`
+	SubjectCN          string            `json:"subject_cn"`
+	SubjectOrg         []string          `json:"subject_org"`
+	SubjectAN          []string          `json:"subject_an"`
+	IssuerDN           string            `json:"issuer_dn"`
+	IssuerCN           string            `json:"issuer_cn"`
+	IssuerOrg          []string          `json:"issuer_org"`
+	Serial             string            `json:"serial"`
+	Expired            bool              `json:"expired"`
+	SelfSigned         bool              `json:"self_signed"`
+	Mismatched         bool              `json:"mismatched"`
+	WildcardCert       bool              `json:"wildcard_certificate"`
+	FingerprintHash    map[string]string `json:"fingerprint_hash"`
+	TLSVersionEnum     []string          `json:"tls_version_enum,omitempty"`
+	CertificateChainCN []string          `json:"chain_cn,omitempty"`
+}
+
+func (c *YakSSLBulkConfig) tlsConfig(sni string, min, max uint16) *tls.Config {
+	config := &tls.Config{
+		ServerName:         sni,
+		InsecureSkipVerify: true,
+		MinVersion:         tls.VersionSSL30, // nolint[:staticcheck]
+		MaxVersion:         tls.VersionTLS13,
+		CipherSuites:       sslCipherSuites(c.CipherSuites),
+	}
+	if min > 0 {
+		config.MinVersion = min
+	}
+	if max > 0 {
+		config.MaxVersion = max
+	}
+	return config
+}
+
+func (c *YakSSLBulkConfig) handshake(addr, sni string, min, max uint16, lowhttpConfig *lowhttp.LowhttpExecConfig) (*tls.ConnectionState, net.Addr, error) {
+	timeout := 10 * time.Second
+	if lowhttpConfig.Timeout > 0 {
+		timeout = lowhttpConfig.Timeout
+	}
+	conn, err := netx.DialTLSTimeout(timeout, addr, c.tlsConfig(sni, min, max), lowhttpConfig.Proxy...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer conn.Close()
+	tlsConn, ok := conn.(*tls.Conn)
+	if !ok {
+		return nil, nil, utils.Errorf("unexpected tls conn type: %T", conn)
+	}
+	state := tlsConn.ConnectionState()
+	return &state, conn.RemoteAddr(), nil
+}
+
+// Execute 与目标进行 TLS 握手，并将协商结果与证书信息整理成 json 响应
+func (c *YakSSLBulkConfig) Execute(config *Config, vars map[string]any, lowhttpConfig *lowhttp.LowhttpExecConfig) ([]*NucleiProtocolResponse, error) {
+	address := c.Address
+	if address == "" {
+		address = "{{Host}}:{{Port}}"
+	}
+	address, err := QuickFuzzNucleiTag(address, vars)
+	if err != nil {
+		return nil, utils.Errorf("render ssl address failed: %s", err)
+	}
+	host, port, err := utils.ParseStringToHostPort(address)
+	if err != nil {
+		host, port = address, 443
+	}
+	if lowhttpConfig.Host != "" {
+		host = lowhttpConfig.Host
+	}
+	if lowhttpConfig.Port > 0 {
+		port = lowhttpConfig.Port
+	}
+	addr := utils.HostPort(host, port)
+	sni := host
+	if net.ParseIP(host) != nil {
+		sni = ""
+	}
+
+	state, remoteAddr, err := c.handshake(addr, sni, sslVersionByName(c.MinVersion), sslVersionByName(c.MaxVersion), lowhttpConfig)
+	if err != nil {
+		return nil, utils.Errorf("ssl handshake with %v failed: %s", addr, err)
+	}
+
+	result := &sslResponse{
+		Host:            host,
+		IP:              utils.ExtractHost(remoteAddr.String()),
+		Port:            utils.InterfaceToString(port),
+		ProbeStatus:     true,
+		TLSVersion:      sslVersionName(state.Version),
+		Cipher:          tls.CipherSuiteName(state.CipherSuite),
+		ALPN:            state.NegotiatedProtocol,
+		SNI:             sni,
+		FingerprintHash: make(map[string]string),
+	}
+	if len(state.PeerCertificates) > 0 {
+		fillSSLCertificate(result, host, state.PeerCertificates)
+	}
+	if c.TLSVersionEnum {
+		for _, v := range sslVersions {
+			if _, _, err := c.handshake(addr, sni, v.Version, v.Version, lowhttpConfig); err == nil {
+				result.TLSVersionEnum = append(result.TLSVersionEnum, v.Name)
+			}
+		}
+	}
+
+	raw, err := json.Marshal(result)
+	if err != nil {
+		return nil, err
+	}
+	if config.Debug || config.DebugResponse {
+		log.Infof("ssl response from %v: %s", addr, raw)
+	}
+
+	rsp := newProtocolResponse(ProtocolSSL, config)
+	rsp.RawRequest = []byte(addr)
+	rsp.RawPacket = raw
+	rsp.RemoteAddr = addr
+	// json 中的每个字段都作为一个 part
+	var parts map[string]any
+	if err := json.Unmarshal(raw, &parts); err == nil {
+		for k, v := range parts {
+			if v != nil {
+				rsp.Parts[k] = v
+			}
+		}
+	}
+	return []*NucleiProtocolResponse{rsp}, nil
+}
+
+func fillSSLCertificate(result *sslResponse, host string, certs []*x509.Certificate) {
+	leaf := certs[0]
+	result.NotBefore = leaf.NotBefore.Format(time.RFC3339)
+	result.NotAfter = leaf.NotAfter.Format(time.RFC3339)
+	result.SubjectDN = leaf.Subject.String()
+	result.SubjectCN = leaf.Subject.CommonName
+	result.SubjectOrg = leaf.Subject.Organization
+	result.SubjectAN = append(result.SubjectAN, leaf.DNSNames...)
+	for _, ip := range leaf.IPAddresses {
+		result.SubjectAN = append(result.SubjectAN, ip.String())
+	}
+	result.IssuerDN = leaf.Issuer.String()
+	result.IssuerCN = leaf.Issuer.CommonName
+	result.IssuerOrg = leaf.Issuer.Organization
+	result.Serial = leaf.SerialNumber.String()
+	now := time.Now()
+	result.Expired = now.After(leaf.NotAfter) || now.Before(leaf.NotBefore)
+	result.SelfSigned = bytes.Equal(leaf.RawIssuer, leaf.RawSubject) && leaf.CheckSignature(leaf.SignatureAlgorithm, leaf.RawTBSCertificate, leaf.Signature) == nil
+	result.Mismatched = host != "" && leaf.VerifyHostname(host) != nil
+	for _, name := range append([]string{leaf.Subject.CommonName}, leaf.DNSNames...) {
+		if strings.HasPrefix(name, "*.") {
+			result.WildcardCert = true
+		}
+	}
+	md5Sum, sha1Sum, sha256Sum := md5.Sum(leaf.Raw), sha1.Sum(leaf.Raw), sha256.Sum256(leaf.Raw)
+	result.FingerprintHash["md5"] = hex.EncodeToString(md5Sum[:])
+	result.FingerprintHash["sha1"] = hex.EncodeToString(sha1Sum[:])
+	result.FingerprintHash["sha256"] = hex.EncodeToString(sha256Sum[:])
+	for _, cert := range certs {
+		result.CertificateChainCN = append(result.CertificateChainCN, cert.Subject.CommonName)
+	}
+}
@@ -11,6 +11,7 @@ type TCPRequestBulk struct {
 }
 
 type YakNetworkBulkConfig struct {
+	Id       string
 	Inputs   []*YakTcpInput
 	Hosts    []string
 	ReadSize int
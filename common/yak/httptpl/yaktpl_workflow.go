@@ -0,0 +1,259 @@
+package httptpl
+
+import (
+	"context"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/bizhelper"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+// YakWorkflow nuclei workflows 中的一个节点
+// 命中后执行 Subtemplates，Matchers 则按命中的 matcher name 选择要执行的子模板
+type YakWorkflow struct {
+	// Template 模板在 nuclei-templates 中的路径（即插件的 local_path），也可以是目录
+	Template string
+	// Tags 按标签选择模板，与 Template 同时存在时在目录中筛选
+	Tags []string
+
+	Matchers     []*YakWorkflowMatcher
+	Subtemplates []*YakWorkflow
+}
+
+type YakWorkflowMatcher struct {
+	Name []string
+	// and 要求所有 name 都命中，默认 or
+	Condition    string
+	Subtemplates []*YakWorkflow
+}
+
+func (m *YakWorkflowMatcher) match(names map[string]struct{}) bool {
+	if m.Condition == "and" {
+		for _, name := range m.Name {
+			if _, ok := names[name]; !ok {
+				return false
+			}
+		}
+		return true
+	}
+	for _, name := range m.Name {
+		if _, ok := names[name]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// workflowStepResult workflow 中一个模板的执行结果
+type workflowStepResult struct {
+	Matched   bool
+	Names     map[string]struct{}
+	Extracted map[string]any
+}
+
+type workflowExecutor struct {
+	workflow *YakTemplate
+	url      string
+	config   *Config
+	opts     []lowhttp.LowhttpOpt
+
+	count int
+	// vars 前面模板提取到的变量，会设置到后续执行的模板中
+	vars map[string]any
+	// executed 已执行模板的结果，同一模板在多个 workflow 节点中只发包一次，后续复用结果
+	executed map[string]*workflowStepResult
+}
+
+func newWorkflowExecutor(y *YakTemplate, u string, config *Config, opts ...lowhttp.LowhttpOpt) *workflowExecutor {
+	vars := make(map[string]any)
+	if y.Variables != nil && y.Variables.Len() > 0 {
+		for k, v := range y.Variables.ToMap() {
+			vars[k] = v
+		}
+	}
+	return &workflowExecutor{
+		workflow: y,
+		url:      u,
+		config:   config,
+		opts:     opts,
+		vars:     vars,
+		executed: make(map[string]*workflowStepResult),
+	}
+}
+
+func (w *workflowExecutor) context() context.Context {
+	if w.config.Ctx != nil {
+		return w.config.Ctx
+	}
+	return context.Background()
+}
+
+func (w *workflowExecutor) run() (int, error) {
+	for _, workflow := range w.workflow.Workflows {
+		if w.context().Err() != nil {
+			break
+		}
+		w.execute(workflow)
+	}
+	return w.count, nil
+}
+
+func (w *workflowExecutor) execute(workflow *YakWorkflow) {
+	templates, err := loadWorkflowTemplates(w.context(), workflow)
+	if err != nil {
+		log.Warnf("[%v] load workflow template %v failed: %s", w.workflow.Name, workflow.Template, err)
+		return
+	}
+	if len(templates) <= 0 {
+		log.Warnf("[%v] workflow template %v (tags: %v) not found in local plugins", w.workflow.Name, workflow.Template, workflow.Tags)
+		return
+	}
+
+	matched := false
+	names := make(map[string]struct{})
+	for _, tpl := range templates {
+		if w.context().Err() != nil {
+			return
+		}
+		result := w.executeTemplate(tpl)
+		if result == nil {
+			continue
+		}
+		matched = matched || result.Matched
+		for name := range result.Names {
+			names[name] = struct{}{}
+		}
+	}
+
+	if matched {
+		for _, sub := range workflow.Subtemplates {
+			w.execute(sub)
+		}
+	}
+	for _, matcher := range workflow.Matchers {
+		if !matcher.match(names) {
+			continue
+		}
+		for _, sub := range matcher.Subtemplates {
+			w.execute(sub)
+		}
+	}
+}
+
+// executeTemplate 执行单个模板，收集是否命中、命中的 matcher name 与提取到的变量
+func (w *workflowExecutor) executeTemplate(tpl *YakTemplate) *workflowStepResult {
+	if len(tpl.Workflows) > 0 {
+		log.Warnf("[%v] skip nested workflow: %v", w.workflow.Name, tpl.Name)
+		return nil
+	}
+	key := tpl.ScriptName
+	if key == "" {
+		key = tpl.Id
+	}
+	if result, ok := w.executed[key]; ok {
+		return result
+	}
+
+	if tpl.Variables == nil {
+		tpl.Variables = NewVars()
+	}
+	for k, v := range w.vars {
+		tpl.Variables.Set(k, utils.InterfaceToString(v))
+	}
+
+	var m sync.Mutex
+	result := &workflowStepResult{
+		Names:     make(map[string]struct{}),
+		Extracted: make(map[string]any),
+	}
+	w.executed[key] = result
+	stepConfig := *w.config
+	stepConfig.onMatcherName = func(name string) {
+		m.Lock()
+		defer m.Unlock()
+		result.Names[name] = struct{}{}
+	}
+	stepConfig.AppendResultCallback(func(y *YakTemplate, reqBulk any, rsp any, matched bool, extractor map[string]interface{}) {
+		m.Lock()
+		defer m.Unlock()
+		result.Matched = result.Matched || matched
+		for k, v := range extractor {
+			result.Extracted[k] = v
+		}
+	})
+
+	count, err := tpl.ExecWithUrl(w.url, &stepConfig, w.opts...)
+	if err != nil {
+		log.Warnf("[%v] workflow execute %v failed: %s", w.workflow.Name, tpl.Name, err)
+	}
+	w.count += count
+	for k, v := range result.Extracted {
+		w.vars[k] = v
+	}
+	return result
+}
+
+// loadWorkflowTemplates 从本地插件库中查找 workflow 节点引用的模板
+// template 为文件时按 local_path 精确（或后缀）匹配，为目录时匹配目录下的全部模板
+func loadWorkflowTemplates(ctx context.Context, workflow *YakWorkflow) ([]*YakTemplate, error) {
+	db := consts.GetGormProfileDatabase()
+	if db == nil {
+		return nil, utils.Error("profile database is nil")
+	}
+	db = db.Model(&schema.YakScript{}).Where("type = 'nuclei'")
+	if workflow.Template != "" {
+		path := strings.TrimPrefix(strings.ReplaceAll(workflow.Template, "\\", "/"), "./")
+		if strings.HasSuffix(path, ".yaml") || strings.HasSuffix(path, ".yml") {
+			db = db.Where("local_path = ? OR local_path LIKE ?", path, "%/"+path)
+		} else {
+			dir := strings.TrimSuffix(path, "/") + "/"
+			db = db.Where("local_path LIKE ? OR local_path LIKE ?", dir+"%", "%/"+dir+"%")
+		}
+	}
+	if len(workflow.Tags) > 0 {
+		db = bizhelper.FuzzSearchWithStringArrayOrEx(db, []string{"tags"}, workflow.Tags, false)
+	}
+	db = db.Order("updated_at desc")
+
+	var templates []*YakTemplate
+	filter := make(map[string]struct{})
+	for script := range yakit.YieldYakScripts(db, ctx) {
+		tpl, err := CreateYakTemplateFromYakScript(script)
+		if err != nil {
+			log.Warnf("create workflow template %v failed: %s", script.ScriptName, err)
+			continue
+		}
+		// tags 为模糊查询，这里再精确筛选一次
+		if len(workflow.Tags) > 0 && !workflowTagsMatch(tpl.Tags, workflow.Tags) {
+			continue
+		}
+		// 同一个模板可能被多次导入，只保留最新的
+		key := tpl.Id
+		if key == "" {
+			key = script.ScriptName
+		}
+		if _, ok := filter[key]; ok {
+			continue
+		}
+		filter[key] = struct{}{}
+		templates = append(templates, tpl)
+	}
+	return templates, nil
+}
+
+func workflowTagsMatch(tags []string, expected []string) bool {
+	for _, tag := range tags {
+		for _, e := range expected {
+			if strings.EqualFold(strings.TrimSpace(tag), e) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,253 @@
+package httptpl
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+)
+
+func createWorkflowTestTemplate(t *testing.T, localPath string, code string) {
+	script, err := yakit.NewTemporaryYakScript("nuclei", code)
+	require.NoError(t, err)
+	script.LocalPath = localPath
+	require.NoError(t, yakit.CreateOrUpdateYakScriptByName(consts.GetGormProfileDatabase(), script.ScriptName, script))
+	t.Cleanup(func() {
+		yakit.DeleteYakScriptByName(consts.GetGormProfileDatabase(), script.ScriptName)
+	})
+}
+
+func TestNucleiWorkflow_Parse(t *testing.T) {
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: wf
+info:
+  name: wf
+  author: v1ll4n
+workflows:
+  - template: http/technologies/tech-detect.yaml
+    matchers:
+      - name: wordpress
+        subtemplates:
+          - tags: wordpress
+      - name:
+          - php
+          - apache
+        condition: and
+        subtemplates:
+          - template: http/cves/
+  - template: http/exposures/
+    subtemplates:
+      - template: http/misconfiguration/a.yaml
+`)
+	require.NoError(t, err)
+	require.False(t, tpl.NoMatcherAndExtractor())
+	require.Len(t, tpl.Workflows, 2)
+	require.Len(t, tpl.Workflows[0].Matchers, 2)
+	require.Equal(t, []string{"wordpress"}, tpl.Workflows[0].Matchers[0].Subtemplates[0].Tags)
+	require.Equal(t, []string{"php", "apache"}, tpl.Workflows[0].Matchers[1].Name)
+	require.True(t, tpl.Workflows[0].Matchers[1].match(map[string]struct{}{"php": {}, "apache": {}}))
+	require.False(t, tpl.Workflows[0].Matchers[1].match(map[string]struct{}{"php": {}}))
+	require.Equal(t, "http/misconfiguration/a.yaml", tpl.Workflows[1].Subtemplates[0].Template)
+}
+
+func TestNucleiWorkflow_Exec(t *testing.T) {
+	token := utils.RandStringBytes(16)
+	tag := "workflow-" + strings.ToLower(utils.RandStringBytes(8))
+	var m sync.Mutex
+	var paths []string
+	host, port := utils.DebugMockHTTPEx(func(req []byte) []byte {
+		path := strings.SplitN(string(req), " ", 3)[1]
+		m.Lock()
+		paths = append(paths, path)
+		m.Unlock()
+		switch path {
+		case "/":
+			body := "Powered by WordPress build=" + token
+			return []byte(fmt.Sprintf("HTTP/1.1 200 OK\r\nContent-Length: %d\r\n\r\n%s", len(body), body))
+		case "/wp/" + token:
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nwp-ok")
+		}
+		return []byte("HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+	})
+
+	createWorkflowTestTemplate(t, token+"/technologies/tech-detect.yaml", `id: tech-detect-`+token+`
+info:
+  name: tech-detect
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/"
+    matchers-condition: or
+    matchers:
+      - type: word
+        name: wordpress
+        words:
+          - WordPress
+      - type: word
+        name: drupal
+        words:
+          - Drupal
+    extractors:
+      - type: regex
+        name: build
+        group: 1
+        regex:
+          - 'build=([a-zA-Z0-9]+)'
+`)
+	createWorkflowTestTemplate(t, token+"/wordpress/wp-build.yaml", `id: wp-build-`+token+`
+info:
+  name: wp-build
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/wp/{{build}}"
+    matchers:
+      - type: word
+        words:
+          - wp-ok
+`)
+	createWorkflowTestTemplate(t, token+"/drupal/drupal-login.yaml", `id: drupal-login-`+token+`
+info:
+  name: drupal-login
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/drupal"
+    matchers:
+      - type: status
+        status:
+          - 200
+`)
+	createWorkflowTestTemplate(t, token+"/tagged/tagged.yaml", `id: tagged-`+token+`
+info:
+  name: tagged
+  author: v1ll4n
+  tags: `+tag+`,misc
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/tagged"
+    matchers:
+      - type: status
+        status:
+          - 200
+`)
+
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: wf
+info:
+  name: wf
+  author: v1ll4n
+workflows:
+  - template: ` + token + `/technologies/tech-detect.yaml
+    matchers:
+      - name: wordpress
+        subtemplates:
+          - template: ` + token + `/wordpress/
+      - name: drupal
+        subtemplates:
+          - template: ` + token + `/drupal/drupal-login.yaml
+  - tags: ` + tag + `
+`)
+	require.NoError(t, err)
+
+	matched := make(map[string]bool)
+	_, err = tpl.ExecWithUrl("http://"+utils.HostPort(host, port), NewConfig(WithResultCallback(func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, result bool, extractor map[string]interface{}) {
+		m.Lock()
+		defer m.Unlock()
+		matched[y.Name] = matched[y.Name] || result
+	})))
+	require.NoError(t, err)
+
+	require.True(t, matched["tech-detect"])
+	// build 由 tech-detect 提取后共享给 wp-build
+	require.True(t, matched["wp-build"])
+	require.False(t, matched["tagged"])
+	require.Contains(t, matched, "tagged")
+	require.NotContains(t, matched, "drupal-login")
+	require.NotContains(t, paths, "/drupal")
+}
+
+func TestNucleiWorkflow_SameRootTemplate(t *testing.T) {
+	token := utils.RandStringBytes(16)
+	var m sync.Mutex
+	var paths []string
+	host, port := utils.DebugMockHTTPEx(func(req []byte) []byte {
+		path := strings.SplitN(string(req), " ", 3)[1]
+		m.Lock()
+		paths = append(paths, path)
+		m.Unlock()
+		switch path {
+		case "/":
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 20\r\n\r\nPowered by WordPress")
+		case "/wp-login.php":
+			return []byte("HTTP/1.1 200 OK\r\nContent-Length: 0\r\n\r\n")
+		}
+		return []byte("HTTP/1.1 404 Not Found\r\nContent-Length: 0\r\n\r\n")
+	})
+
+	createWorkflowTestTemplate(t, token+"/technologies/tech-detect.yaml", `id: tech-detect-`+token+`
+info:
+  name: tech-detect
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/"
+    matchers:
+      - type: word
+        name: wordpress
+        words:
+          - WordPress
+`)
+	createWorkflowTestTemplate(t, token+"/wordpress/wp-login.yaml", `id: wp-login-`+token+`
+info:
+  name: wp-login
+  author: v1ll4n
+http:
+  - method: GET
+    path:
+      - "{{BaseURL}}/wp-login.php"
+    matchers:
+      - type: status
+        status:
+          - 200
+`)
+
+	// 第二个 workflow 复用同一个根模板，需要拿到第一次执行的 matcher name
+	tpl, err := CreateYakTemplateFromNucleiTemplateRaw(`id: wf
+info:
+  name: wf
+  author: v1ll4n
+workflows:
+  - template: ` + token + `/technologies/tech-detect.yaml
+    matchers:
+      - name: drupal
+        subtemplates:
+          - template: ` + token + `/drupal/
+  - template: ` + token + `/technologies/tech-detect.yaml
+    matchers:
+      - name: wordpress
+        subtemplates:
+          - template: ` + token + `/wordpress/wp-login.yaml
+`)
+	require.NoError(t, err)
+
+	matched := make(map[string]bool)
+	_, err = tpl.ExecWithUrl("http://"+utils.HostPort(host, port), NewConfig(WithResultCallback(func(y *YakTemplate, reqBulk *YakRequestBulkConfig, rsp []*lowhttp.LowhttpResponse, result bool, extractor map[string]interface{}) {
+		m.Lock()
+		defer m.Unlock()
+		matched[y.Name] = matched[y.Name] || result
+	})))
+	require.NoError(t, err)
+	require.True(t, matched["wp-login"])
+	// 根模板只发包一次
+	require.Equal(t, []string{"/", "/wp-login.php"}, paths)
+}
@@ -141,3 +141,11 @@ func nodeGetStringSliceFallback(node *yaml.Node, keys ...string) []string {
 	}
 	return groups
 }
+
+func nodeGetFirstString(node *yaml.Node, keys ...string) string {
+	subNode := nodeGetFirstRaw(node, keys...)
+	if subNode == nil {
+		return ""
+	}
+	return subNode.Value
+}
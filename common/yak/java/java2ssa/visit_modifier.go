@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
 	javaparser "github.com/yaklang/yaklang/common/yak/java/parser"
 	"github.com/yaklang/yaklang/common/yak/ssa"
 )
@@ -231,9 +232,46 @@ func (y *builder) VisitElementValue(raw javaparser.IElementValueContext) (v ssa.
 		//TODO: handler element value
 
 	} else if ret := i.ElementValueArrayInitializer(); ret != nil {
+		return y.VisitElementValueArrayInitializer(ret)
 	} else {
 		// log.Errorf("")
 	}
 
 	return
 }
+
+// VisitElementValueArrayInitializer build slice for annotation array value, like:
+//
+//	@RequestMapping(value = {"/a", "/b"})
+func (y *builder) VisitElementValueArrayInitializer(raw javaparser.IElementValueArrayInitializerContext) ssa.Value {
+	if y == nil || raw == nil || y.IsStop() {
+		return nil
+	}
+	recoverRange := y.SetRange(raw)
+	defer recoverRange()
+	i, _ := raw.(*javaparser.ElementValueArrayInitializerContext)
+	if i == nil {
+		return nil
+	}
+
+	allElementValue := i.AllElementValue()
+	if len(allElementValue) == 0 {
+		return y.EmitMakeBuildWithType(
+			ssa.NewSliceType(ssa.BasicTypes[ssa.AnyTypeKind]),
+			y.EmitConstInst(0), y.EmitConstInst(0),
+		)
+	}
+	obj := y.InterfaceAddFieldBuild(len(allElementValue),
+		func(i int) ssa.Value { return y.EmitConstInst(i) },
+		func(i int) ssa.Value {
+			if v := y.VisitElementValue(allElementValue[i]); v != nil {
+				return v
+			}
+			return y.EmitUndefined(allElementValue[i].GetText())
+		})
+	if utils.IsNil(obj) {
+		return nil
+	}
+	obj.GetType().(*ssa.ObjectType).Kind = ssa.SliceTypeKind
+	return obj
+}
@@ -0,0 +1,227 @@
+package lsp
+
+import "encoding/json"
+
+// the subset of language server protocol 3.17 used by yak language server,
+// see https://microsoft.github.io/language-server-protocol/specifications/lsp/3.17/specification/
+
+const (
+	MethodInitialize         = "initialize"
+	MethodInitialized        = "initialized"
+	MethodShutdown           = "shutdown"
+	MethodExit               = "exit"
+	MethodDidOpen            = "textDocument/didOpen"
+	MethodDidChange          = "textDocument/didChange"
+	MethodDidSave            = "textDocument/didSave"
+	MethodDidClose           = "textDocument/didClose"
+	MethodCompletion         = "textDocument/completion"
+	MethodHover              = "textDocument/hover"
+	MethodDefinition         = "textDocument/definition"
+	MethodReferences         = "textDocument/references"
+	MethodRename             = "textDocument/rename"
+	MethodPublishDiagnostics = "textDocument/publishDiagnostics"
+)
+
+const (
+	TextDocumentSyncKindFull = 1
+
+	DiagnosticSeverityError       = 1
+	DiagnosticSeverityWarning     = 2
+	DiagnosticSeverityInformation = 3
+	DiagnosticSeverityHint        = 4
+
+	InsertTextFormatPlainText = 1
+	InsertTextFormatSnippet   = 2
+
+	MarkupKindMarkdown = "markdown"
+)
+
+// CompletionItemKind in lsp, the key is the kind name used by yakit(monaco)
+var completionItemKinds = map[string]int{
+	"Text":          1,
+	"Method":        2,
+	"Function":      3,
+	"Constructor":   4,
+	"Field":         5,
+	"Variable":      6,
+	"Class":         7,
+	"Interface":     8,
+	"Module":        9,
+	"Property":      10,
+	"Unit":          11,
+	"Value":         12,
+	"Enum":          13,
+	"Keyword":       14,
+	"Snippet":       15,
+	"Color":         16,
+	"File":          17,
+	"Reference":     18,
+	"Folder":        19,
+	"EnumMember":    20,
+	"Constant":      21,
+	"Struct":        22,
+	"Event":         23,
+	"Operator":      24,
+	"TypeParameter": 25,
+}
+
+// JSON-RPC 2.0 error codes
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+type Position struct {
+	Line      int `json:"line"`
+	Character int `json:"character"`
+}
+
+type Range struct {
+	Start Position `json:"start"`
+	End   Position `json:"end"`
+}
+
+type Location struct {
+	URI   string `json:"uri"`
+	Range Range  `json:"range"`
+}
+
+type TextDocumentIdentifier struct {
+	URI string `json:"uri"`
+}
+
+type VersionedTextDocumentIdentifier struct {
+	URI     string `json:"uri"`
+	Version int    `json:"version"`
+}
+
+type TextDocumentItem struct {
+	URI        string `json:"uri"`
+	LanguageID string `json:"languageId"`
+	Version    int    `json:"version"`
+	Text       string `json:"text"`
+}
+
+type TextDocumentPositionParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Position     Position               `json:"position"`
+}
+
+type InitializeParams struct {
+	ProcessID             int             `json:"processId"`
+	RootURI               string          `json:"rootUri"`
+	InitializationOptions json.RawMessage `json:"initializationOptions,omitempty"`
+}
+
+// InitializationOptions is the custom options in initialize request
+type InitializationOptions struct {
+	// PluginType is the yak script type used by static analyzer and completion: yak/mitm/port-scan/codec
+	PluginType string `json:"pluginType"`
+}
+
+type CompletionOptions struct {
+	TriggerCharacters []string `json:"triggerCharacters,omitempty"`
+}
+
+type ServerCapabilities struct {
+	TextDocumentSync   int                `json:"textDocumentSync"`
+	CompletionProvider *CompletionOptions `json:"completionProvider,omitempty"`
+	HoverProvider      bool               `json:"hoverProvider"`
+	DefinitionProvider bool               `json:"definitionProvider"`
+	ReferencesProvider bool               `json:"referencesProvider"`
+	RenameProvider     bool               `json:"renameProvider"`
+}
+
+type ServerInfo struct {
+	Name    string `json:"name"`
+	Version string `json:"version,omitempty"`
+}
+
+type InitializeResult struct {
+	Capabilities ServerCapabilities `json:"capabilities"`
+	ServerInfo   *ServerInfo        `json:"serverInfo,omitempty"`
+}
+
+type DidOpenTextDocumentParams struct {
+	TextDocument TextDocumentItem `json:"textDocument"`
+}
+
+type TextDocumentContentChangeEvent struct {
+	Range *Range `json:"range,omitempty"`
+	Text  string `json:"text"`
+}
+
+type DidChangeTextDocumentParams struct {
+	TextDocument   VersionedTextDocumentIdentifier  `json:"textDocument"`
+	ContentChanges []TextDocumentContentChangeEvent `json:"contentChanges"`
+}
+
+type DidSaveTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+	Text         *string                `json:"text,omitempty"`
+}
+
+type DidCloseTextDocumentParams struct {
+	TextDocument TextDocumentIdentifier `json:"textDocument"`
+}
+
+type Diagnostic struct {
+	Range    Range  `json:"range"`
+	Severity int    `json:"severity,omitempty"`
+	Source   string `json:"source,omitempty"`
+	Message  string `json:"message"`
+}
+
+type PublishDiagnosticsParams struct {
+	URI         string       `json:"uri"`
+	Version     int          `json:"version,omitempty"`
+	Diagnostics []Diagnostic `json:"diagnostics"`
+}
+
+type MarkupContent struct {
+	Kind  string `json:"kind"`
+	Value string `json:"value"`
+}
+
+type CompletionItem struct {
+	Label            string         `json:"label"`
+	Kind             int            `json:"kind,omitempty"`
+	Detail           string         `json:"detail,omitempty"`
+	Documentation    *MarkupContent `json:"documentation,omitempty"`
+	InsertText       string         `json:"insertText,omitempty"`
+	InsertTextFormat int            `json:"insertTextFormat,omitempty"`
+}
+
+type CompletionList struct {
+	IsIncomplete bool             `json:"isIncomplete"`
+	Items        []CompletionItem `json:"items"`
+}
+
+type Hover struct {
+	Contents MarkupContent `json:"contents"`
+	Range    *Range        `json:"range,omitempty"`
+}
+
+type ReferenceParams struct {
+	TextDocumentPositionParams
+	Context struct {
+		IncludeDeclaration bool `json:"includeDeclaration"`
+	} `json:"context"`
+}
+
+type RenameParams struct {
+	TextDocumentPositionParams
+	NewName string `json:"newName"`
+}
+
+type TextEdit struct {
+	Range   Range  `json:"range"`
+	NewText string `json:"newText"`
+}
+
+type WorkspaceEdit struct {
+	Changes map[string][]TextEdit `json:"changes"`
+}
@@ -0,0 +1,392 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"path"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+)
+
+const (
+	LanguageYak        = "yak"
+	LanguageSyntaxFlow = "syntaxflow"
+)
+
+// language provides the language features for one kind of document
+type language interface {
+	diagnostics(doc *document) []Diagnostic
+	completion(doc *document, pos Position) []CompletionItem
+	hover(doc *document, pos Position) *Hover
+	definition(doc *document, pos Position) []Location
+	references(doc *document, pos Position, includeDeclaration bool) []Location
+	rename(doc *document, pos Position, newName string) (*WorkspaceEdit, error)
+}
+
+type document struct {
+	URI      string
+	Language string
+	Version  int
+	Text     string
+	editor   *memedit.MemEditor
+}
+
+func newDocument(uri, languageID string, version int, text string) *document {
+	return &document{
+		URI:      uri,
+		Language: detectLanguage(uri, languageID),
+		Version:  version,
+		Text:     text,
+		editor:   memedit.NewMemEditor(text),
+	}
+}
+
+func detectLanguage(uri, languageID string) string {
+	switch strings.ToLower(languageID) {
+	case "yak", "yaklang":
+		return LanguageYak
+	case "sf", "syntaxflow":
+		return LanguageSyntaxFlow
+	}
+	switch strings.ToLower(path.Ext(uri)) {
+	case ".yak":
+		return LanguageYak
+	case ".sf", ".syntaxflow":
+		return LanguageSyntaxFlow
+	}
+	return ""
+}
+
+// offset convert the lsp position(0-based line and character) to the rune offset of document
+func (d *document) offset(pos Position) int {
+	return d.editor.GetOffsetByPositionRaw(pos.Line+1, pos.Character+1)
+}
+
+func (d *document) position(offset int) Position {
+	return toPosition(d.editor.GetPositionByOffset(offset))
+}
+
+func (d *document) rangeOffset(start, end int) Range {
+	return Range{Start: d.position(start), End: d.position(end)}
+}
+
+func toPosition(p memedit.PositionIf) Position {
+	if p == nil {
+		return Position{}
+	}
+	return Position{Line: utils.Max(p.GetLine()-1, 0), Character: utils.Max(p.GetColumn()-1, 0)}
+}
+
+func toRange(rng memedit.RangeIf) Range {
+	return Range{Start: toPosition(rng.GetStart()), End: toPosition(rng.GetEnd())}
+}
+
+type Server struct {
+	conn       *conn
+	mu         sync.Mutex
+	documents  map[string]*document
+	pluginType string
+	shutdown   bool
+
+	yak        *yakLanguage
+	syntaxFlow *syntaxFlowLanguage
+}
+
+func NewServer() *Server {
+	s := &Server{
+		documents:  make(map[string]*document),
+		pluginType: "yak",
+	}
+	s.yak = &yakLanguage{server: s}
+	s.syntaxFlow = &syntaxFlowLanguage{server: s}
+	return s
+}
+
+// ServeStdio serve language server protocol on stdin/stdout
+func ServeStdio(ctx context.Context, stdin io.Reader, stdout io.Writer) error {
+	return NewServer().Serve(ctx, stdin, stdout)
+}
+
+// Serve read the requests from r and write the responses/notifications to w until the `exit` notification or EOF
+func (s *Server) Serve(ctx context.Context, r io.Reader, w io.Writer) error {
+	s.conn = newConn(r, w)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		msg, err := s.conn.read()
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return nil
+			}
+			var respErr *ResponseError
+			if errors.As(err, &respErr) {
+				_ = s.conn.reply(nil, nil, respErr)
+				continue
+			}
+			return err
+		}
+		if msg.Method == "" {
+			// response of server to client request, ignore it
+			continue
+		}
+		if msg.Method == MethodExit {
+			return nil
+		}
+
+		result, err := s.handle(ctx, msg)
+		if msg.isNotification() {
+			if err != nil {
+				log.Errorf("lsp handle notification %v failed: %v", msg.Method, err)
+			}
+			continue
+		}
+		if err := s.conn.reply(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(ctx context.Context, msg *message) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("lsp handle %v panic: %v", msg.Method, r)
+			utils.PrintCurrentGoroutineRuntimeStack()
+			result, err = nil, &ResponseError{Code: codeInternalError, Message: utils.InterfaceToString(r)}
+		}
+	}()
+
+	if s.shutdown && msg.Method != MethodExit {
+		return nil, &ResponseError{Code: codeInvalidRequest, Message: "server is shutting down"}
+	}
+
+	switch msg.Method {
+	case MethodInitialize:
+		var params InitializeParams
+		if err := unmarshalParams(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.initialize(&params), nil
+	case MethodInitialized:
+		return nil, nil
+	case MethodShutdown:
+		s.shutdown = true
+		return nil, nil
+	case MethodDidOpen:
+		var params DidOpenTextDocumentParams
+		if err := unmarshalParams(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		item := params.TextDocument
+		s.updateDocument(newDocument(item.URI, item.LanguageID, item.Version, item.Text))
+		return nil, nil
+	case MethodDidChange:
+		var params DidChangeTextDocumentParams
+		if err := unmarshalParams(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := s.getDocument(params.TextDocument.URI)
+		if doc == nil || len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// only full text document sync is supported, the last change is the whole document
+		text := params.ContentChanges[len(params.ContentChanges)-1].Text
+		s.updateDocument(newDocument(doc.URI, doc.Language, params.TextDocument.Version, text))
+		return nil, nil
+	case MethodDidSave:
+		var params DidSaveTextDocumentParams
+		if err := unmarshalParams(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if doc := s.getDocument(params.TextDocument.URI); doc != nil && params.Text != nil {
+			s.updateDocument(newDocument(doc.URI, doc.Language, doc.Version, *params.Text))
+		}
+		return nil, nil
+	case MethodDidClose:
+		var params DidCloseTextDocumentParams
+		if err := unmarshalParams(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.mu.Lock()
+		delete(s.documents, params.TextDocument.URI)
+		s.mu.Unlock()
+		// clear the diagnostics of closed document
+		return nil, s.conn.notify(MethodPublishDiagnostics, &PublishDiagnosticsParams{
+			URI:         params.TextDocument.URI,
+			Diagnostics: []Diagnostic{},
+		})
+	case MethodCompletion:
+		var params TextDocumentPositionParams
+		doc, lang, err := s.prepare(msg.Params, &params, &params.TextDocument)
+		if err != nil || lang == nil {
+			return nil, err
+		}
+		items := lang.completion(doc, params.Position)
+		if items == nil {
+			items = []CompletionItem{}
+		}
+		return &CompletionList{Items: items}, nil
+	case MethodHover:
+		var params TextDocumentPositionParams
+		doc, lang, err := s.prepare(msg.Params, &params, &params.TextDocument)
+		if err != nil || lang == nil {
+			return nil, err
+		}
+		if hover := lang.hover(doc, params.Position); hover != nil {
+			return hover, nil
+		}
+		return nil, nil
+	case MethodDefinition:
+		var params TextDocumentPositionParams
+		doc, lang, err := s.prepare(msg.Params, &params, &params.TextDocument)
+		if err != nil || lang == nil {
+			return nil, err
+		}
+		return nonNilLocations(lang.definition(doc, params.Position)), nil
+	case MethodReferences:
+		var params ReferenceParams
+		doc, lang, err := s.prepare(msg.Params, &params, &params.TextDocument)
+		if err != nil || lang == nil {
+			return nil, err
+		}
+		return nonNilLocations(lang.references(doc, params.Position, params.Context.IncludeDeclaration)), nil
+	case MethodRename:
+		var params RenameParams
+		doc, lang, err := s.prepare(msg.Params, &params, &params.TextDocument)
+		if err != nil || lang == nil {
+			return nil, err
+		}
+		edit, err := lang.rename(doc, params.Position, params.NewName)
+		if err != nil {
+			return nil, &ResponseError{Code: codeInvalidRequest, Message: err.Error()}
+		}
+		return edit, nil
+	default:
+		if strings.HasPrefix(msg.Method, "$/") {
+			// optional notifications and requests, such as $/cancelRequest and $/setTrace
+			return nil, nil
+		}
+		return nil, &ResponseError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+}
+
+func (s *Server) initialize(params *InitializeParams) *InitializeResult {
+	if len(params.InitializationOptions) > 0 {
+		var opts InitializationOptions
+		if err := json.Unmarshal(params.InitializationOptions, &opts); err == nil && opts.PluginType != "" {
+			s.pluginType = opts.PluginType
+		}
+	}
+	return &InitializeResult{
+		Capabilities: ServerCapabilities{
+			TextDocumentSync: TextDocumentSyncKindFull,
+			CompletionProvider: &CompletionOptions{
+				TriggerCharacters: []string{".", "<", "$"},
+			},
+			HoverProvider:      true,
+			DefinitionProvider: true,
+			ReferencesProvider: true,
+			RenameProvider:     true,
+		},
+		ServerInfo: &ServerInfo{Name: "yak-lsp"},
+	}
+}
+
+func (s *Server) getDocument(uri string) *document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.documents[uri]
+}
+
+func (s *Server) getDocuments() []*document {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	docs := make([]*document, 0, len(s.documents))
+	for _, doc := range s.documents {
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+func (s *Server) updateDocument(doc *document) {
+	s.mu.Lock()
+	s.documents[doc.URI] = doc
+	s.mu.Unlock()
+	go s.publishDiagnostics(doc)
+}
+
+func (s *Server) language(doc *document) language {
+	switch doc.Language {
+	case LanguageYak:
+		return s.yak
+	case LanguageSyntaxFlow:
+		return s.syntaxFlow
+	}
+	return nil
+}
+
+// prepare unmarshal params and return the document and its language of request
+func (s *Server) prepare(raw json.RawMessage, params any, textDocument *TextDocumentIdentifier) (*document, language, error) {
+	if err := unmarshalParams(raw, params); err != nil {
+		return nil, nil, err
+	}
+	doc := s.getDocument(textDocument.URI)
+	if doc == nil {
+		return nil, nil, &ResponseError{Code: codeInvalidParams, Message: "document not opened: " + textDocument.URI}
+	}
+	return doc, s.language(doc), nil
+}
+
+func (s *Server) publishDiagnostics(doc *document) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Errorf("lsp publish diagnostics for %v panic: %v", doc.URI, r)
+		}
+	}()
+	lang := s.language(doc)
+	if lang == nil {
+		return
+	}
+	diagnostics := lang.diagnostics(doc)
+	if diagnostics == nil {
+		diagnostics = []Diagnostic{}
+	}
+	// the document has been changed or closed, drop the outdated diagnostics
+	if current := s.getDocument(doc.URI); current != doc {
+		return
+	}
+	err := s.conn.notify(MethodPublishDiagnostics, &PublishDiagnosticsParams{
+		URI:         doc.URI,
+		Version:     doc.Version,
+		Diagnostics: diagnostics,
+	})
+	if err != nil {
+		log.Errorf("lsp publish diagnostics for %v failed: %v", doc.URI, err)
+	}
+}
+
+func unmarshalParams(raw json.RawMessage, params any) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(raw, params); err != nil {
+		return &ResponseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+func nonNilLocations(locations []Location) []Location {
+	if locations == nil {
+		return []Location{}
+	}
+	return locations
+}
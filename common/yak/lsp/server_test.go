@@ -0,0 +1,320 @@
+package lsp
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type testClient struct {
+	t             *testing.T
+	conn          *conn
+	id            int
+	responses     chan *message
+	notifications chan *message
+}
+
+func newTestClient(t *testing.T) *testClient {
+	clientReader, serverWriter := io.Pipe()
+	serverReader, clientWriter := io.Pipe()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- NewServer().Serve(ctx, serverReader, serverWriter)
+	}()
+
+	c := &testClient{
+		t:             t,
+		conn:          newConn(clientReader, clientWriter),
+		responses:     make(chan *message, 16),
+		notifications: make(chan *message, 128),
+	}
+	go func() {
+		for {
+			msg, err := c.conn.read()
+			if err != nil {
+				return
+			}
+			if msg.Method != "" {
+				c.notifications <- msg
+			} else {
+				c.responses <- msg
+			}
+		}
+	}()
+	t.Cleanup(func() {
+		c.notify(MethodExit, nil)
+		select {
+		case err := <-done:
+			require.NoError(t, err)
+		case <-time.After(5 * time.Second):
+			t.Error("server not exit")
+		}
+		cancel()
+		clientWriter.Close()
+		serverWriter.Close()
+	})
+
+	c.call(MethodInitialize, &InitializeParams{}, nil)
+	c.notify(MethodInitialized, struct{}{})
+	return c
+}
+
+func (c *testClient) call(method string, params any, result any) *ResponseError {
+	c.t.Helper()
+	c.id++
+	id := json.RawMessage(fmt.Sprint(c.id))
+	raw, err := json.Marshal(params)
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.conn.write(&message{ID: &id, Method: method, Params: raw}))
+	select {
+	case msg := <-c.responses:
+		require.Equal(c.t, string(id), string(*msg.ID))
+		if msg.Error != nil {
+			return msg.Error
+		}
+		if result != nil {
+			require.NoError(c.t, json.Unmarshal(msg.Result, result))
+		}
+	case <-time.After(time.Minute):
+		c.t.Fatalf("call %v timeout", method)
+	}
+	return nil
+}
+
+func (c *testClient) notify(method string, params any) {
+	c.t.Helper()
+	raw, err := json.Marshal(params)
+	require.NoError(c.t, err)
+	require.NoError(c.t, c.conn.write(&message{Method: method, Params: raw}))
+}
+
+func (c *testClient) open(uri, text string) {
+	c.notify(MethodDidOpen, &DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, Version: 1, Text: text},
+	})
+}
+
+func (c *testClient) diagnostics(uri string) []Diagnostic {
+	c.t.Helper()
+	for {
+		select {
+		case msg := <-c.notifications:
+			if msg.Method != MethodPublishDiagnostics {
+				continue
+			}
+			var params PublishDiagnosticsParams
+			require.NoError(c.t, json.Unmarshal(msg.Params, &params))
+			if params.URI == uri {
+				return params.Diagnostics
+			}
+		case <-time.After(time.Minute):
+			c.t.Fatalf("wait diagnostics of %v timeout", uri)
+		}
+	}
+}
+
+func position(line, character int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{Position: Position{Line: line, Character: character}}
+}
+
+func (c *testClient) at(uri string, line, character int) TextDocumentPositionParams {
+	p := position(line, character)
+	p.TextDocument.URI = uri
+	return p
+}
+
+func completionLabels(list *CompletionList) []string {
+	labels := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		labels = append(labels, item.Label)
+	}
+	return labels
+}
+
+func TestLSP_Initialize(t *testing.T) {
+	c := newTestClient(t)
+	var result InitializeResult
+	require.Nil(t, c.call(MethodInitialize, &InitializeParams{}, &result))
+	require.Equal(t, TextDocumentSyncKindFull, result.Capabilities.TextDocumentSync)
+	require.True(t, result.Capabilities.RenameProvider)
+
+	rspErr := c.call("textDocument/noSuchMethod", struct{}{}, nil)
+	require.NotNil(t, rspErr)
+	require.Equal(t, codeMethodNotFound, rspErr.Code)
+
+	rspErr = c.call(MethodHover, c.at("file:///not-opened.yak", 0, 0), nil)
+	require.NotNil(t, rspErr)
+	require.Equal(t, codeInvalidParams, rspErr.Code)
+}
+
+func TestLSP_Yak(t *testing.T) {
+	c := newTestClient(t)
+	uri := "file:///a.yak"
+	c.open(uri, `a = 1
+b = a + 1
+println(a)
+c = codec.EncodeBase64("abc")
+`)
+	require.Empty(t, c.diagnostics(uri))
+
+	t.Run("diagnostics", func(t *testing.T) {
+		errURI := "file:///error.yak"
+		c.open(errURI, "a = (1\n")
+		diagnostics := c.diagnostics(errURI)
+		require.NotEmpty(t, diagnostics)
+		require.Equal(t, DiagnosticSeverityError, diagnostics[0].Severity)
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		completionURI := "file:///completion.yak"
+		c.open(completionURI, "codec.")
+		var list CompletionList
+		require.Nil(t, c.call(MethodCompletion, c.at(completionURI, 0, 6), &list))
+		require.Contains(t, completionLabels(&list), "EncodeBase64")
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		var hover Hover
+		require.Nil(t, c.call(MethodHover, c.at(uri, 1, 0), &hover))
+		require.Contains(t, hover.Contents.Value, "type b number")
+		require.Equal(t, Range{Start: Position{1, 0}, End: Position{1, 1}}, *hover.Range)
+
+		hover = Hover{}
+		require.Nil(t, c.call(MethodHover, c.at(uri, 3, 12), &hover))
+		require.Contains(t, hover.Contents.Value, "EncodeBase64")
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		var locations []Location
+		require.Nil(t, c.call(MethodDefinition, c.at(uri, 2, 8), &locations))
+		require.Len(t, locations, 1)
+		require.Equal(t, uri, locations[0].URI)
+		require.Equal(t, Range{Start: Position{0, 0}, End: Position{0, 1}}, locations[0].Range)
+	})
+
+	t.Run("references", func(t *testing.T) {
+		var locations []Location
+		params := ReferenceParams{TextDocumentPositionParams: c.at(uri, 0, 0)}
+		params.Context.IncludeDeclaration = true
+		require.Nil(t, c.call(MethodReferences, params, &locations))
+		require.Len(t, locations, 3)
+
+		params.Context.IncludeDeclaration = false
+		require.Nil(t, c.call(MethodReferences, params, &locations))
+		require.Len(t, locations, 2)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		var edit WorkspaceEdit
+		require.Nil(t, c.call(MethodRename, RenameParams{TextDocumentPositionParams: c.at(uri, 1, 4), NewName: "count"}, &edit))
+		edits := edit.Changes[uri]
+		require.Len(t, edits, 3)
+		for i, line := range []int{0, 1, 2} {
+			require.Equal(t, line, edits[i].Range.Start.Line)
+			require.Equal(t, "count", edits[i].NewText)
+		}
+
+		require.NotNil(t, c.call(MethodRename, RenameParams{TextDocumentPositionParams: c.at(uri, 1, 4), NewName: "1a"}, nil))
+	})
+}
+
+const testSyntaxFlowRule = `desc(
+    title: "test",
+    lib: 'test-lib'
+)
+
+exec.System(* as $sink);
+$sink<typeName> as $typ;
+check $sink;
+alert $sink;
+`
+
+func TestLSP_SyntaxFlow(t *testing.T) {
+	c := newTestClient(t)
+	uri := "file:///a.sf"
+	c.open(uri, testSyntaxFlowRule)
+	require.Empty(t, c.diagnostics(uri))
+
+	t.Run("diagnostics", func(t *testing.T) {
+		errURI := "file:///error.sf"
+		c.open(errURI, "exec.System(* as $sink\n")
+		diagnostics := c.diagnostics(errURI)
+		require.NotEmpty(t, diagnostics)
+		require.Equal(t, DiagnosticSeverityError, diagnostics[0].Severity)
+
+		nativeURI := "file:///native.sf"
+		c.open(nativeURI, "exec.System() as $a;\n$a<noSuchNativeCall> as $b;\n")
+		diagnostics = c.diagnostics(nativeURI)
+		require.Len(t, diagnostics, 1)
+		require.Contains(t, diagnostics[0].Message, "noSuchNativeCall")
+		require.Equal(t, Range{Start: Position{1, 3}, End: Position{1, 19}}, diagnostics[0].Range)
+	})
+
+	t.Run("completion", func(t *testing.T) {
+		completionURI := "file:///completion.sf"
+		c.open(completionURI, "exec.System(* as $sink);\n$sink<")
+		var list CompletionList
+		require.Nil(t, c.call(MethodCompletion, c.at(completionURI, 1, 6), &list))
+		require.Contains(t, completionLabels(&list), "typeName")
+		require.Contains(t, completionLabels(&list), "taint")
+
+		c.notify(MethodDidChange, &DidChangeTextDocumentParams{
+			TextDocument:   VersionedTextDocumentIdentifier{URI: completionURI, Version: 2},
+			ContentChanges: []TextDocumentContentChangeEvent{{Text: "exec.System(* as $sink);\n$"}},
+		})
+		require.Nil(t, c.call(MethodCompletion, c.at(completionURI, 1, 1), &list))
+		require.Equal(t, []string{"sink"}, completionLabels(&list))
+	})
+
+	t.Run("hover", func(t *testing.T) {
+		var hover Hover
+		require.Nil(t, c.call(MethodHover, c.at(uri, 6, 8), &hover))
+		require.Contains(t, hover.Contents.Value, "<typeName>")
+
+		hover = Hover{}
+		require.Nil(t, c.call(MethodHover, c.at(uri, 8, 7), &hover))
+		require.Contains(t, hover.Contents.Value, "defined at line 6")
+		require.Equal(t, Range{Start: Position{8, 7}, End: Position{8, 11}}, *hover.Range)
+	})
+
+	t.Run("definition", func(t *testing.T) {
+		var locations []Location
+		require.Nil(t, c.call(MethodDefinition, c.at(uri, 7, 8), &locations))
+		require.Equal(t, []Location{{URI: uri, Range: Range{Start: Position{5, 18}, End: Position{5, 22}}}}, locations)
+
+		includeURI := "file:///include.sf"
+		c.open(includeURI, "<include('test-lib')> as $a;\n")
+		require.Nil(t, c.call(MethodDefinition, c.at(includeURI, 0, 12), &locations))
+		require.Len(t, locations, 1)
+		require.Equal(t, uri, locations[0].URI)
+		require.Equal(t, 2, locations[0].Range.Start.Line)
+	})
+
+	t.Run("references", func(t *testing.T) {
+		var locations []Location
+		params := ReferenceParams{TextDocumentPositionParams: c.at(uri, 5, 19)}
+		params.Context.IncludeDeclaration = true
+		require.Nil(t, c.call(MethodReferences, params, &locations))
+		require.Len(t, locations, 4)
+
+		params.Context.IncludeDeclaration = false
+		require.Nil(t, c.call(MethodReferences, params, &locations))
+		require.Len(t, locations, 3)
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		var edit WorkspaceEdit
+		require.Nil(t, c.call(MethodRename, RenameParams{TextDocumentPositionParams: c.at(uri, 8, 8), NewName: "$output"}, &edit))
+		edits := edit.Changes[uri]
+		require.Len(t, edits, 4)
+		for _, e := range edits {
+			require.Equal(t, "output", e.NewText)
+		}
+	})
+}
@@ -0,0 +1,372 @@
+package lsp
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/syntaxflow/sf"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+var (
+	syntaxFlowKeywords = []string{
+		"as", "alert", "check", "then", "else", "desc", "for", "in", "version_in",
+		"opcode", "have", "any", "not", "type",
+		"call", "function", "const", "phi", "param", "return",
+	}
+
+	sfVariableNameRegexp    = regexp.MustCompile(`^[a-zA-Z_*][a-zA-Z0-9_*]*$`)
+	sfNativeCallPrefix      = regexp.MustCompile(`<\s*([a-zA-Z_][a-zA-Z0-9_]*)?$`)
+	sfVariablePrefix        = regexp.MustCompile(`\$\(?([a-zA-Z0-9_]*)$`)
+	sfIncludeLibRegexpTempl = `lib\s*:\s*['"]%s['"]`
+)
+
+// syntaxFlowLanguage provides the language features for .sf rule file by the lexer/parser/compiler of syntaxflow
+type syntaxFlowLanguage struct {
+	server *Server
+}
+
+// sfVariable is an occurrence of $variable in rule
+type sfVariable struct {
+	Name       string
+	Start, End int // rune offset of the name (without `$`)
+	Define     bool
+}
+
+// sfNativeCall is an occurrence of <nativeCall> in rule
+type sfNativeCall struct {
+	Name       string
+	Start, End int
+}
+
+type sfSymbols struct {
+	tokens      []antlr.Token
+	variables   []*sfVariable
+	nativeCalls []*sfNativeCall
+}
+
+func parseSyntaxFlowSymbols(text string) *sfSymbols {
+	lexer := sf.NewSyntaxFlowLexer(antlr.NewInputStream(text))
+	lexer.RemoveErrorListeners()
+	ret := &sfSymbols{}
+	for _, token := range lexer.GetAllTokens() {
+		if token.GetTokenType() == sf.SyntaxFlowLexerBreakLine {
+			continue
+		}
+		ret.tokens = append(ret.tokens, token)
+	}
+
+	tokenAt := func(i int) antlr.Token {
+		if i < 0 || i >= len(ret.tokens) {
+			return nil
+		}
+		return ret.tokens[i]
+	}
+	for i, token := range ret.tokens {
+		switch token.GetTokenType() {
+		case sf.SyntaxFlowLexerDollarOutput:
+			// $name or $(name)
+			name := tokenAt(i + 1)
+			if name != nil && name.GetTokenType() == sf.SyntaxFlowLexerOpenParen {
+				name = tokenAt(i + 2)
+			}
+			if name == nil || !sfVariableNameRegexp.MatchString(name.GetText()) {
+				continue
+			}
+			prev := tokenAt(i - 1)
+			ret.variables = append(ret.variables, &sfVariable{
+				Name:   name.GetText(),
+				Start:  name.GetStart(),
+				End:    name.GetStop() + 1,
+				Define: prev != nil && prev.GetTokenType() == sf.SyntaxFlowLexerAs,
+			})
+		case sf.SyntaxFlowLexerLt:
+			name, next := tokenAt(i+1), tokenAt(i+2)
+			if name == nil || name.GetTokenType() != sf.SyntaxFlowLexerIdentifier || next == nil {
+				continue
+			}
+			if next.GetTokenType() != sf.SyntaxFlowLexerGt && next.GetTokenType() != sf.SyntaxFlowLexerOpenParen {
+				continue
+			}
+			ret.nativeCalls = append(ret.nativeCalls, &sfNativeCall{
+				Name:  name.GetText(),
+				Start: name.GetStart(),
+				End:   name.GetStop() + 1,
+			})
+		}
+	}
+	return ret
+}
+
+func (s *sfSymbols) variableAt(offset int) *sfVariable {
+	for _, v := range s.variables {
+		if v.Start <= offset && offset <= v.End {
+			return v
+		}
+	}
+	// the cursor is at `$`
+	for _, v := range s.variables {
+		if v.Start-1 == offset {
+			return v
+		}
+	}
+	return nil
+}
+
+func (s *sfSymbols) nativeCallAt(offset int) *sfNativeCall {
+	for _, n := range s.nativeCalls {
+		if n.Start <= offset && offset <= n.End {
+			return n
+		}
+	}
+	return nil
+}
+
+func (s *sfSymbols) tokenAt(offset int) (int, antlr.Token) {
+	for i, token := range s.tokens {
+		if token.GetStart() <= offset && offset <= token.GetStop()+1 {
+			return i, token
+		}
+	}
+	return -1, nil
+}
+
+func (s *sfSymbols) variableNames() []string {
+	var names []string
+	for _, v := range s.variables {
+		if !utils.StringArrayContains(names, v.Name) {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
+
+type sfErrorListener struct {
+	*antlr.DefaultErrorListener
+	diagnostics []Diagnostic
+}
+
+func (l *sfErrorListener) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	start := Position{Line: utils.Max(line-1, 0), Character: utils.Max(column, 0)}
+	end := Position{Line: start.Line, Character: start.Character + 1}
+	if token, ok := offendingSymbol.(antlr.Token); ok && token.GetTokenType() != antlr.TokenEOF {
+		if length := token.GetStop() - token.GetStart() + 1; length > 0 && !strings.Contains(token.GetText(), "\n") {
+			end.Character = start.Character + length
+		}
+	}
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Range:    Range{Start: start, End: end},
+		Severity: DiagnosticSeverityError,
+		Source:   "syntaxflow",
+		Message:  msg,
+	})
+}
+
+func (l *syntaxFlowLanguage) diagnostics(doc *document) []Diagnostic {
+	errLis := &sfErrorListener{DefaultErrorListener: antlr.NewDefaultErrorListener()}
+	lexer := sf.NewSyntaxFlowLexer(antlr.NewInputStream(doc.Text))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errLis)
+	parser := sf.NewSyntaxFlowParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errLis)
+	parser.Flow()
+	if len(errLis.diagnostics) > 0 {
+		return errLis.diagnostics
+	}
+
+	var diagnostics []Diagnostic
+	if _, err := sfvm.NewSyntaxFlowVirtualMachine().Compile(doc.Text); err != nil {
+		diagnostics = append(diagnostics, Diagnostic{
+			Severity: DiagnosticSeverityError,
+			Source:   "syntaxflow",
+			Message:  err.Error(),
+		})
+	}
+
+	symbols := parseSyntaxFlowSymbols(doc.Text)
+	for _, nativeCall := range symbols.nativeCalls {
+		if _, ok := ssaapi.NativeCallDocuments[nativeCall.Name]; ok {
+			continue
+		}
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    doc.rangeOffset(nativeCall.Start, nativeCall.End),
+			Severity: DiagnosticSeverityError,
+			Source:   "syntaxflow",
+			Message:  "native call not found: " + nativeCall.Name,
+		})
+	}
+	return diagnostics
+}
+
+func (l *syntaxFlowLanguage) completion(doc *document, pos Position) []CompletionItem {
+	offset := doc.offset(pos)
+	lineStart := doc.offset(Position{Line: pos.Line})
+	prefix := doc.editor.GetTextFromOffset(lineStart, offset)
+
+	var items []CompletionItem
+	switch {
+	case sfNativeCallPrefix.MatchString(prefix):
+		names := make([]string, 0, len(ssaapi.NativeCallDocuments))
+		for name := range ssaapi.NativeCallDocuments {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			item := CompletionItem{
+				Label:  name,
+				Kind:   completionItemKinds["Function"],
+				Detail: "native call",
+			}
+			if desc := ssaapi.NativeCallDocuments[name].Description; desc != "" {
+				item.Documentation = &MarkupContent{Kind: MarkupKindMarkdown, Value: desc}
+			}
+			items = append(items, item)
+		}
+	case sfVariablePrefix.MatchString(prefix):
+		for _, name := range parseSyntaxFlowSymbols(doc.Text).variableNames() {
+			items = append(items, CompletionItem{
+				Label:  name,
+				Kind:   completionItemKinds["Variable"],
+				Detail: "$" + name,
+			})
+		}
+	default:
+		for _, keyword := range syntaxFlowKeywords {
+			items = append(items, CompletionItem{
+				Label: keyword,
+				Kind:  completionItemKinds["Keyword"],
+			})
+		}
+		for _, name := range parseSyntaxFlowSymbols(doc.Text).variableNames() {
+			items = append(items, CompletionItem{
+				Label:      "$" + name,
+				Kind:       completionItemKinds["Variable"],
+				InsertText: "$" + name,
+			})
+		}
+	}
+	return items
+}
+
+func (l *syntaxFlowLanguage) hover(doc *document, pos Position) *Hover {
+	offset := doc.offset(pos)
+	symbols := parseSyntaxFlowSymbols(doc.Text)
+	if nativeCall := symbols.nativeCallAt(offset); nativeCall != nil {
+		content := fmt.Sprintf("```syntaxflow\n<%s>\n```", nativeCall.Name)
+		if document, ok := ssaapi.NativeCallDocuments[nativeCall.Name]; ok && document.Description != "" {
+			content += "\n\n" + document.Description
+		}
+		rng := doc.rangeOffset(nativeCall.Start, nativeCall.End)
+		return &Hover{Contents: MarkupContent{Kind: MarkupKindMarkdown, Value: content}, Range: &rng}
+	}
+	if variable := symbols.variableAt(offset); variable != nil {
+		var defines, uses []string
+		for _, v := range symbols.variables {
+			if v.Name != variable.Name {
+				continue
+			}
+			line := fmt.Sprint(doc.position(v.Start).Line + 1)
+			if v.Define {
+				defines = append(defines, line)
+			} else {
+				uses = append(uses, line)
+			}
+		}
+		content := fmt.Sprintf("```syntaxflow\n$%s\n```\n\n", variable.Name)
+		if len(defines) > 0 {
+			content += "defined at line " + strings.Join(defines, ", ")
+		} else {
+			content += "not defined in this rule"
+		}
+		if len(uses) > 0 {
+			content += "; used at line " + strings.Join(uses, ", ")
+		}
+		rng := doc.rangeOffset(variable.Start, variable.End)
+		return &Hover{Contents: MarkupContent{Kind: MarkupKindMarkdown, Value: content}, Range: &rng}
+	}
+	return nil
+}
+
+func (l *syntaxFlowLanguage) definition(doc *document, pos Position) []Location {
+	offset := doc.offset(pos)
+	symbols := parseSyntaxFlowSymbols(doc.Text)
+	if variable := symbols.variableAt(offset); variable != nil {
+		var locations []Location
+		for _, v := range symbols.variables {
+			if v.Define && v.Name == variable.Name {
+				locations = append(locations, Location{URI: doc.URI, Range: doc.rangeOffset(v.Start, v.End)})
+			}
+		}
+		return locations
+	}
+	// <include('lib-name')> jump to the opened rule which declares `lib: 'lib-name'`
+	if i, token := symbols.tokenAt(offset); token != nil && token.GetTokenType() == sf.SyntaxFlowLexerQuotedStringLiteral && i >= 3 {
+		if symbols.tokens[i-1].GetTokenType() != sf.SyntaxFlowLexerOpenParen || symbols.tokens[i-2].GetText() != "include" {
+			return nil
+		}
+		return l.findLib(strings.Trim(token.GetText(), `'"`))
+	}
+	return nil
+}
+
+func (l *syntaxFlowLanguage) findLib(name string) []Location {
+	libRegexp, err := regexp.Compile(fmt.Sprintf(sfIncludeLibRegexpTempl, regexp.QuoteMeta(name)))
+	if err != nil {
+		return nil
+	}
+	var locations []Location
+	for _, doc := range l.server.getDocuments() {
+		if doc.Language != LanguageSyntaxFlow {
+			continue
+		}
+		runes := []rune(doc.Text)
+		for _, match := range libRegexp.FindAllStringIndex(doc.Text, -1) {
+			// byte index to rune offset
+			start := len([]rune(doc.Text[:match[0]]))
+			end := utils.Min(start+len([]rune(doc.Text[match[0]:match[1]])), len(runes))
+			locations = append(locations, Location{URI: doc.URI, Range: doc.rangeOffset(start, end)})
+		}
+	}
+	return locations
+}
+
+func (l *syntaxFlowLanguage) references(doc *document, pos Position, includeDeclaration bool) []Location {
+	symbols := parseSyntaxFlowSymbols(doc.Text)
+	variable := symbols.variableAt(doc.offset(pos))
+	if variable == nil {
+		return nil
+	}
+	var locations []Location
+	for _, v := range symbols.variables {
+		if v.Name != variable.Name || (v.Define && !includeDeclaration) {
+			continue
+		}
+		locations = append(locations, Location{URI: doc.URI, Range: doc.rangeOffset(v.Start, v.End)})
+	}
+	return locations
+}
+
+func (l *syntaxFlowLanguage) rename(doc *document, pos Position, newName string) (*WorkspaceEdit, error) {
+	newName = strings.TrimPrefix(newName, "$")
+	if !identifierRegexp.MatchString(newName) {
+		return nil, utils.Errorf("invalid new name: %v", newName)
+	}
+	symbols := parseSyntaxFlowSymbols(doc.Text)
+	variable := symbols.variableAt(doc.offset(pos))
+	if variable == nil {
+		return nil, utils.Errorf("no variable found at %d:%d", pos.Line+1, pos.Character+1)
+	}
+	var edits []TextEdit
+	for _, v := range symbols.variables {
+		if v.Name == variable.Name {
+			edits = append(edits, TextEdit{Range: doc.rangeOffset(v.Start, v.End), NewText: newName})
+		}
+	}
+	return &WorkspaceEdit{Changes: map[string][]TextEdit{doc.URI: edits}}, nil
+}
@@ -0,0 +1,239 @@
+package lsp
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	"github.com/yaklang/yaklang/common/yak"
+	"github.com/yaklang/yaklang/common/yak/static_analyzer/result"
+	"github.com/yaklang/yaklang/common/yakgrpc"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+var identifierRegexp = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+// yakLanguage reuse the static analyzer and the language server of yakit(yakgrpc) for .yak file
+type yakLanguage struct {
+	server *Server
+}
+
+func (y *yakLanguage) diagnostics(doc *document) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, res := range yak.StaticAnalyzeYaklang(doc.Text, yak.WithStaticAnalyzePluginType(y.server.pluginType)) {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range: Range{
+				Start: Position{Line: utils.Max(int(res.StartLineNumber)-1, 0), Character: utils.Max(int(res.StartColumn)-1, 0)},
+				End:   Position{Line: utils.Max(int(res.EndLineNumber)-1, 0), Character: utils.Max(int(res.EndColumn)-1, 0)},
+			},
+			Severity: diagnosticSeverity(res.Severity),
+			Source:   "yak " + res.From,
+			Message:  res.Message,
+		})
+	}
+	return diagnostics
+}
+
+func diagnosticSeverity(severity result.MarkerSeverity) int {
+	switch severity {
+	case result.Error:
+		return DiagnosticSeverityError
+	case result.Warn:
+		return DiagnosticSeverityWarning
+	case result.Info:
+		return DiagnosticSeverityInformation
+	default:
+		return DiagnosticSeverityHint
+	}
+}
+
+// analyze the word in [start, end) of document
+func (y *yakLanguage) analyze(doc *document, inspectType string, start, end int) (*yakgrpc.LanguageServerAnalyzerResult, error) {
+	startPos, endPos := doc.editor.GetPositionByOffset(start), doc.editor.GetPositionByOffset(end)
+	return yakgrpc.LanguageServerAnalyzeProgram(&ypb.YaklangLanguageSuggestionRequest{
+		InspectType:   inspectType,
+		YakScriptType: y.server.pluginType,
+		YakScriptCode: doc.Text,
+		ModelID:       doc.URI,
+		Range: &ypb.Range{
+			Code:        doc.editor.GetTextFromOffset(start, end),
+			StartLine:   int64(startPos.GetLine()),
+			StartColumn: int64(startPos.GetColumn()),
+			EndLine:     int64(endPos.GetLine()),
+			EndColumn:   int64(endPos.GetColumn()),
+		},
+	})
+}
+
+func (y *yakLanguage) completion(doc *document, pos Position) []CompletionItem {
+	end := doc.offset(pos)
+	start := scanWordStart(doc, end, true)
+	res, err := y.analyze(doc, yakgrpc.COMPLETION, start, end)
+	if err != nil || res.Value == nil {
+		return nil
+	}
+	var items []CompletionItem
+	for _, suggestion := range yakgrpc.OnCompletion(res.Program, res.Word, res.ContainPoint, res.Range, res.Value) {
+		item := CompletionItem{
+			Label:      suggestion.GetLabel(),
+			Kind:       completionItemKinds[suggestion.GetKind()],
+			Detail:     suggestion.GetDefinitionVerbose(),
+			InsertText: suggestion.GetInsertText(),
+		}
+		if desc := suggestion.GetDescription(); desc != "" {
+			item.Documentation = &MarkupContent{Kind: MarkupKindMarkdown, Value: desc}
+		}
+		if strings.Contains(item.InsertText, "${") {
+			item.InsertTextFormat = InsertTextFormatSnippet
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+func (y *yakLanguage) hover(doc *document, pos Position) *Hover {
+	offset := doc.offset(pos)
+	start, end := scanWordStart(doc, offset, true), scanWordEnd(doc, offset)
+	if start >= end {
+		return nil
+	}
+	res, err := y.analyze(doc, yakgrpc.HOVER, start, end)
+	if err != nil || res.Value == nil {
+		return nil
+	}
+	var contents []string
+	for _, suggestion := range yakgrpc.OnHover(res.Program, res.Word, res.ContainPoint, res.Range, res.Value) {
+		if label := suggestion.GetLabel(); label != "" {
+			contents = append(contents, label)
+		}
+	}
+	if len(contents) == 0 {
+		return nil
+	}
+	rng := doc.rangeOffset(start, end)
+	return &Hover{
+		Contents: MarkupContent{Kind: MarkupKindMarkdown, Value: strings.Join(contents, "\n\n")},
+		Range:    &rng,
+	}
+}
+
+func (y *yakLanguage) find(doc *document, pos Position, inspectType string) []memedit.RangeIf {
+	offset := doc.offset(pos)
+	start, end := scanWordStart(doc, offset, true), scanWordEnd(doc, offset)
+	if start >= end {
+		return nil
+	}
+	res, err := y.analyze(doc, inspectType, start, end)
+	if err != nil || res.Value == nil {
+		return nil
+	}
+	if inspectType == yakgrpc.DEFINITION {
+		return yakgrpc.OnFindDefinition(res.Program, res.Word, res.ContainPoint, res.Range, res.Value)
+	}
+	return yakgrpc.OnFindReferences(res.Program, res.Word, res.ContainPoint, res.Range, res.Value)
+}
+
+func (y *yakLanguage) definition(doc *document, pos Position) []Location {
+	return y.toLocations(doc, y.find(doc, pos, yakgrpc.DEFINITION))
+}
+
+func (y *yakLanguage) references(doc *document, pos Position, includeDeclaration bool) []Location {
+	locations := y.toLocations(doc, y.find(doc, pos, yakgrpc.REFERENCES))
+	if includeDeclaration {
+		return locations
+	}
+	definitions := y.definition(doc, pos)
+	var ret []Location
+	for _, location := range locations {
+		if !containsLocation(definitions, location) {
+			ret = append(ret, location)
+		}
+	}
+	return ret
+}
+
+func (y *yakLanguage) rename(doc *document, pos Position, newName string) (*WorkspaceEdit, error) {
+	if !identifierRegexp.MatchString(newName) {
+		return nil, utils.Errorf("invalid new name: %v", newName)
+	}
+	offset := doc.offset(pos)
+	start, end := scanWordStart(doc, offset, false), scanWordEnd(doc, offset)
+	oldName := doc.editor.GetTextFromOffset(start, end)
+	if !identifierRegexp.MatchString(oldName) {
+		return nil, utils.Errorf("no variable found at %d:%d", pos.Line+1, pos.Character+1)
+	}
+	if start > 0 && doc.editor.GetTextFromOffset(start-1, start) == "." {
+		return nil, utils.Errorf("member %v cannot be renamed", oldName)
+	}
+
+	var edits []TextEdit
+	for _, location := range y.references(doc, pos, true) {
+		// only rename the exactly same name
+		if doc.editor.GetTextFromOffset(doc.offset(location.Range.Start), doc.offset(location.Range.End)) != oldName {
+			continue
+		}
+		edits = append(edits, TextEdit{Range: location.Range, NewText: newName})
+	}
+	if len(edits) == 0 {
+		return nil, utils.Errorf("no reference of %v found", oldName)
+	}
+	return &WorkspaceEdit{Changes: map[string][]TextEdit{doc.URI: edits}}, nil
+}
+
+func (y *yakLanguage) toLocations(doc *document, ranges []memedit.RangeIf) []Location {
+	var locations []Location
+	for _, rng := range ranges {
+		if rng == nil {
+			continue
+		}
+		location := Location{URI: doc.URI, Range: toRange(rng)}
+		if !containsLocation(locations, location) {
+			locations = append(locations, location)
+		}
+	}
+	sort.SliceStable(locations, func(i, j int) bool {
+		a, b := locations[i].Range.Start, locations[j].Range.Start
+		return a.Line < b.Line || (a.Line == b.Line && a.Character < b.Character)
+	})
+	return locations
+}
+
+func containsLocation(locations []Location, location Location) bool {
+	for _, l := range locations {
+		if l == location {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdentifierRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// scanWordStart return the start offset of word before offset, the member access chain (a.b.c) is included if withMember
+func scanWordStart(doc *document, offset int, withMember bool) int {
+	runes := []rune(doc.Text)
+	start := utils.Min(offset, len(runes))
+	for start > 0 {
+		r := runes[start-1]
+		if !isIdentifierRune(r) && !(withMember && r == '.') {
+			break
+		}
+		start--
+	}
+	return start
+}
+
+// scanWordEnd return the end offset of identifier after offset
+func scanWordEnd(doc *document, offset int) int {
+	runes := []rune(doc.Text)
+	end := utils.Min(offset, len(runes))
+	for end < len(runes) && isIdentifierRune(runes[end]) {
+		end++
+	}
+	return end
+}
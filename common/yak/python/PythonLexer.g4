@@ -0,0 +1,183 @@
+/*
+Python 3 lexer.
+
+Based on the python3 grammar of https://github.com/antlr/grammars-v4 (MIT License),
+Copyright (c) 2014 by Bart Kiers, updated for python 3.10 (match statement,
+positional-only parameters, assignment expression).
+
+INDENT, DEDENT and the NEWLINE inside brackets are handled by PythonLexerBase
+(parser/python_lexer_base.go).
+*/
+
+// $antlr-format alignTrailingComments true, columnLimit 150, maxEmptyLinesToKeep 1, reflowComments false, useTab false
+// $antlr-format allowShortRulesOnASingleLine true, allowShortBlocksOnASingleLine true, minEmptyLines 0, alignSemicolons ownLine
+// $antlr-format alignColons trailing, singleLineOverrulesHangingColon true, alignLexerCommands true, alignLabels true, alignTrailers true
+
+lexer grammar PythonLexer;
+
+options {
+    superClass = PythonLexerBase;
+}
+
+tokens {
+    INDENT,
+    DEDENT
+}
+
+// Keywords
+
+FALSE    : 'False';
+NONE     : 'None';
+TRUE     : 'True';
+AND      : 'and';
+AS       : 'as';
+ASSERT   : 'assert';
+ASYNC    : 'async';
+AWAIT    : 'await';
+BREAK    : 'break';
+CLASS    : 'class';
+CONTINUE : 'continue';
+DEF      : 'def';
+DEL      : 'del';
+ELIF     : 'elif';
+ELSE     : 'else';
+EXCEPT   : 'except';
+FINALLY  : 'finally';
+FOR      : 'for';
+FROM     : 'from';
+GLOBAL   : 'global';
+IF       : 'if';
+IMPORT   : 'import';
+IN       : 'in';
+IS       : 'is';
+LAMBDA   : 'lambda';
+NONLOCAL : 'nonlocal';
+NOT      : 'not';
+OR       : 'or';
+PASS     : 'pass';
+RAISE    : 'raise';
+RETURN   : 'return';
+TRY      : 'try';
+WHILE    : 'while';
+WITH     : 'with';
+YIELD    : 'yield';
+
+// Soft keywords, they are still names out of match statement
+
+MATCH : 'match';
+CASE  : 'case';
+
+// Literals
+
+STRING: STRING_PREFIX? (SHORT_STRING | LONG_STRING);
+
+NUMBER: INTEGER | FLOAT_NUMBER | IMAG_NUMBER;
+
+// Operators
+
+ELLIPSIS           : '...';
+DOT                : '.';
+STAR               : '*';
+OPEN_PAREN         : '(';
+CLOSE_PAREN        : ')';
+COMMA              : ',';
+WALRUS             : ':=';
+COLON              : ':';
+SEMI_COLON         : ';';
+POWER              : '**';
+ASSIGN             : '=';
+OPEN_BRACKET       : '[';
+CLOSE_BRACKET      : ']';
+OR_OP              : '|';
+XOR                : '^';
+AND_OP             : '&';
+LEFT_SHIFT         : '<<';
+RIGHT_SHIFT        : '>>';
+ADD                : '+';
+MINUS              : '-';
+DIV                : '/';
+MOD                : '%';
+IDIV               : '//';
+NOT_OP             : '~';
+OPEN_BRACE         : '{';
+CLOSE_BRACE        : '}';
+LESS_THAN          : '<';
+GREATER_THAN       : '>';
+EQUALS             : '==';
+GT_EQ              : '>=';
+LT_EQ              : '<=';
+NOT_EQ_1           : '<>';
+NOT_EQ_2           : '!=';
+AT                 : '@';
+ARROW              : '->';
+ADD_ASSIGN         : '+=';
+SUB_ASSIGN         : '-=';
+MULT_ASSIGN        : '*=';
+AT_ASSIGN          : '@=';
+DIV_ASSIGN         : '/=';
+MOD_ASSIGN         : '%=';
+AND_ASSIGN         : '&=';
+OR_ASSIGN          : '|=';
+XOR_ASSIGN         : '^=';
+LEFT_SHIFT_ASSIGN  : '<<=';
+RIGHT_SHIFT_ASSIGN : '>>=';
+POWER_ASSIGN       : '**=';
+IDIV_ASSIGN        : '//=';
+
+// the spaces after line break is the indentation of next line
+NEWLINE: ('\r'? '\n' | '\r' | '\f') [ \t\f]*;
+
+NAME: ID_START ID_CONTINUE*;
+
+// Whitespace and comments
+
+WS           : [ \t\f]+                          -> channel(HIDDEN);
+LINE_JOINING : '\\' [ \t]* ('\r'? '\n' | '\r')   -> channel(HIDDEN);
+COMMENT      : '#' ~[\r\n\f]*                    -> channel(HIDDEN);
+
+ERROR_CHAR: .;
+
+// Fragments
+
+fragment STRING_PREFIX: [rRuUbBfF] | [rR] [bBfF] | [bBfF] [rR];
+
+fragment SHORT_STRING:
+    '\'' (STRING_ESCAPE_SEQ | ~[\\\r\n\f'])* '\''
+    | '"' ( STRING_ESCAPE_SEQ | ~[\\\r\n\f"])* '"'
+;
+
+fragment LONG_STRING: '\'\'\'' LONG_STRING_ITEM*? '\'\'\'' | '"""' LONG_STRING_ITEM*? '"""';
+
+fragment LONG_STRING_ITEM: ~'\\' | STRING_ESCAPE_SEQ;
+
+fragment STRING_ESCAPE_SEQ: '\\' '\r' '\n' | '\\' .;
+
+fragment INTEGER: DECIMAL_INTEGER | OCT_INTEGER | HEX_INTEGER | BIN_INTEGER;
+
+fragment DECIMAL_INTEGER: [1-9] ('_'? DIGIT)* | '0'+ ('_'? '0')*;
+
+fragment OCT_INTEGER: '0' [oO] ('_'? [0-7])+;
+
+fragment HEX_INTEGER: '0' [xX] ('_'? [0-9a-fA-F])+;
+
+fragment BIN_INTEGER: '0' [bB] ('_'? [01])+;
+
+fragment FLOAT_NUMBER: POINT_FLOAT | EXPONENT_FLOAT;
+
+fragment POINT_FLOAT: INT_PART? FRACTION | INT_PART '.';
+
+fragment EXPONENT_FLOAT: (INT_PART | POINT_FLOAT) EXPONENT;
+
+fragment IMAG_NUMBER: (FLOAT_NUMBER | INT_PART) [jJ];
+
+fragment INT_PART: DIGIT ('_'? DIGIT)*;
+
+fragment FRACTION: '.' DIGIT ('_'? DIGIT)*;
+
+fragment EXPONENT: [eE] [+-]? DIGIT ('_'? DIGIT)*;
+
+fragment DIGIT: [0-9];
+
+fragment ID_START: [\p{L}\p{Nl}_];
+
+fragment ID_CONTINUE: [\p{L}\p{Nl}\p{Mn}\p{Mc}\p{Nd}\p{Pc}];
@@ -0,0 +1,396 @@
+/*
+Python 3 parser.
+
+Based on the python3 grammar of https://github.com/antlr/grammars-v4 (MIT License),
+Copyright (c) 2014 by Bart Kiers, updated for python 3.10 (match statement,
+positional-only parameters, assignment expression).
+*/
+
+// $antlr-format alignTrailingComments true, columnLimit 150, minEmptyLines 1, maxEmptyLinesToKeep 1, reflowComments false, useTab false
+// $antlr-format allowShortRulesOnASingleLine false, allowShortBlocksOnASingleLine true, alignSemicolons hanging, alignColons hanging
+
+parser grammar PythonParser;
+
+options {
+    tokenVocab = PythonLexer;
+}
+
+fileInput
+    : (NEWLINE | statement)* EOF
+    ;
+
+statement
+    : simpleStatements
+    | compoundStatement
+    ;
+
+simpleStatements
+    : simpleStatement (SEMI_COLON simpleStatement)* SEMI_COLON? NEWLINE
+    ;
+
+simpleStatement
+    : testlistStarExpr assignPart?           # ExpressionStatement
+    | yieldExpr                              # YieldStatement
+    | DEL exprlist                           # DelStatement
+    | PASS                                   # PassStatement
+    | BREAK                                  # BreakStatement
+    | CONTINUE                               # ContinueStatement
+    | RETURN testlistStarExpr?               # ReturnStatement
+    | RAISE (test (FROM test)?)?             # RaiseStatement
+    | IMPORT dottedAsNames                   # ImportStatement
+    | FROM importFromModule IMPORT importTargets # ImportFromStatement
+    | GLOBAL name (COMMA name)*              # GlobalStatement
+    | NONLOCAL name (COMMA name)*            # NonlocalStatement
+    | ASSERT test (COMMA test)?              # AssertStatement
+    ;
+
+assignPart
+    : COLON test (ASSIGN (yieldExpr | testlistStarExpr))? # AnnotatedAssign
+    | augAssign (yieldExpr | testlist)                    # AugmentedAssign
+    | (ASSIGN (yieldExpr | testlistStarExpr))+            # NormalAssign
+    ;
+
+augAssign
+    : ADD_ASSIGN
+    | SUB_ASSIGN
+    | MULT_ASSIGN
+    | AT_ASSIGN
+    | DIV_ASSIGN
+    | MOD_ASSIGN
+    | AND_ASSIGN
+    | OR_ASSIGN
+    | XOR_ASSIGN
+    | LEFT_SHIFT_ASSIGN
+    | RIGHT_SHIFT_ASSIGN
+    | POWER_ASSIGN
+    | IDIV_ASSIGN
+    ;
+
+// import
+
+importFromModule
+    : (DOT | ELLIPSIS)* dottedName
+    | (DOT | ELLIPSIS)+
+    ;
+
+importTargets
+    : STAR
+    | OPEN_PAREN importAsNames COMMA? CLOSE_PAREN
+    | importAsNames COMMA?
+    ;
+
+importAsNames
+    : importAsName (COMMA importAsName)*
+    ;
+
+importAsName
+    : name (AS name)?
+    ;
+
+dottedAsNames
+    : dottedAsName (COMMA dottedAsName)*
+    ;
+
+dottedAsName
+    : dottedName (AS name)?
+    ;
+
+dottedName
+    : name (DOT name)*
+    ;
+
+// compound statement
+
+compoundStatement
+    : IF namedExprTest COLON block elifClause* elseClause?                             # IfStatement
+    | WHILE namedExprTest COLON block elseClause?                                      # WhileStatement
+    | ASYNC? FOR exprlist IN testlist COLON block elseClause?                          # ForStatement
+    | TRY COLON block (exceptClause+ elseClause? finallyClause? | finallyClause)        # TryStatement
+    | ASYNC? WITH withItems COLON block                                                # WithStatement
+    | decorator* (classDef | funcDef)                                                  # DefinitionStatement
+    | MATCH subjectExpr COLON NEWLINE INDENT caseBlock+ DEDENT                         # MatchStatement
+    ;
+
+block
+    : simpleStatements
+    | NEWLINE INDENT statement+ DEDENT
+    ;
+
+elifClause
+    : ELIF namedExprTest COLON block
+    ;
+
+elseClause
+    : ELSE COLON block
+    ;
+
+exceptClause
+    : EXCEPT STAR? (test (AS name)?)? COLON block
+    ;
+
+finallyClause
+    : FINALLY COLON block
+    ;
+
+withItems
+    : OPEN_PAREN withItem (COMMA withItem)* COMMA? CLOSE_PAREN
+    | withItem (COMMA withItem)*
+    ;
+
+withItem
+    : test (AS expr)?
+    ;
+
+decorator
+    : AT namedExprTest NEWLINE
+    ;
+
+funcDef
+    : ASYNC? DEF name OPEN_PAREN typedArgsList? CLOSE_PAREN (ARROW test)? COLON block
+    ;
+
+classDef
+    : CLASS name (OPEN_PAREN arglist? CLOSE_PAREN)? COLON block
+    ;
+
+// parameter
+
+typedArgsList
+    : typedArg (COMMA typedArg)* COMMA?
+    ;
+
+typedArg
+    : name (COLON test)? (ASSIGN test)? # NamedParameter
+    | STAR (name (COLON test)?)?        # VarParameter
+    | POWER name (COLON test)?          # KwParameter
+    | DIV                               # PosOnlyMarker
+    ;
+
+varArgsList
+    : varArg (COMMA varArg)* COMMA?
+    ;
+
+varArg
+    : name (ASSIGN test)? # LambdaNamedParameter
+    | STAR name?          # LambdaVarParameter
+    | POWER name          # LambdaKwParameter
+    | DIV                 # LambdaPosOnlyMarker
+    ;
+
+// match statement
+
+subjectExpr
+    : (namedExprTest | starExpr) COMMA ((namedExprTest | starExpr) (COMMA (namedExprTest | starExpr))* COMMA?)?
+    | namedExprTest
+    ;
+
+caseBlock
+    : CASE casePatterns (IF namedExprTest)? COLON block
+    ;
+
+casePatterns
+    : casePattern (COMMA casePattern)* COMMA?
+    ;
+
+casePattern
+    : STAR name                  # StarPattern
+    | orPattern (AS name)?       # AsPattern
+    ;
+
+orPattern
+    : closedPattern (OR_OP closedPattern)*
+    ;
+
+closedPattern
+    : literalPattern                                         # LiteralClosedPattern
+    | name                                                   # CapturePattern
+    | name (DOT name)+                                       # ValuePattern
+    | OPEN_PAREN casePattern CLOSE_PAREN                     # GroupPattern
+    | OPEN_PAREN (casePattern COMMA casePatterns?)? CLOSE_PAREN # TuplePattern
+    | OPEN_BRACKET casePatterns? CLOSE_BRACKET               # ListPattern
+    | OPEN_BRACE (mappingPattern (COMMA mappingPattern)* COMMA?)? CLOSE_BRACE # MappingPatterns
+    | dottedName OPEN_PAREN (classPatternArg (COMMA classPatternArg)* COMMA?)? CLOSE_PAREN # ClassPattern
+    ;
+
+literalPattern
+    : MINUS? NUMBER ((ADD | MINUS) NUMBER)?
+    | STRING+
+    | NONE
+    | TRUE
+    | FALSE
+    ;
+
+mappingPattern
+    : (literalPattern | name (DOT name)+) COLON casePattern
+    | POWER name
+    ;
+
+classPatternArg
+    : name ASSIGN casePattern
+    | casePattern
+    ;
+
+// expression
+
+namedExprTest
+    : (name WALRUS)? test
+    ;
+
+test
+    : orTest (IF orTest ELSE test)?
+    | lambdef
+    ;
+
+testNoCond
+    : orTest
+    | lambdefNoCond
+    ;
+
+lambdef
+    : LAMBDA varArgsList? COLON test
+    ;
+
+lambdefNoCond
+    : LAMBDA varArgsList? COLON testNoCond
+    ;
+
+orTest
+    : andTest (OR andTest)*
+    ;
+
+andTest
+    : notTest (AND notTest)*
+    ;
+
+notTest
+    : NOT notTest
+    | comparison
+    ;
+
+comparison
+    : expr (compOp expr)*
+    ;
+
+compOp
+    : LESS_THAN
+    | GREATER_THAN
+    | EQUALS
+    | GT_EQ
+    | LT_EQ
+    | NOT_EQ_1
+    | NOT_EQ_2
+    | IN
+    | NOT IN
+    | IS
+    | IS NOT
+    ;
+
+starExpr
+    : STAR expr
+    ;
+
+expr
+    : atomExpr                                      # PrimaryExpr
+    | <assoc = right> expr POWER expr               # PowerExpr
+    | op = (ADD | MINUS | NOT_OP) expr              # UnaryExpr
+    | expr op = (STAR | AT | DIV | MOD | IDIV) expr # BinaryExpr
+    | expr op = (ADD | MINUS) expr                  # BinaryExpr
+    | expr op = (LEFT_SHIFT | RIGHT_SHIFT) expr     # BinaryExpr
+    | expr op = AND_OP expr                         # BinaryExpr
+    | expr op = XOR expr                            # BinaryExpr
+    | expr op = OR_OP expr                          # BinaryExpr
+    ;
+
+atomExpr
+    : AWAIT? atom trailer*
+    ;
+
+atom
+    : OPEN_PAREN (yieldExpr | testlistComp)? CLOSE_PAREN # ParenAtom
+    | OPEN_BRACKET testlistComp? CLOSE_BRACKET           # ListAtom
+    | OPEN_BRACE dictOrSetMaker? CLOSE_BRACE             # DictOrSetAtom
+    | name                                               # NameAtom
+    | NUMBER                                             # NumberAtom
+    | STRING+                                            # StringAtom
+    | ELLIPSIS                                           # EllipsisAtom
+    | NONE                                               # NoneAtom
+    | TRUE                                               # TrueAtom
+    | FALSE                                              # FalseAtom
+    ;
+
+name
+    : NAME
+    | MATCH
+    | CASE
+    ;
+
+testlistComp
+    : (namedExprTest | starExpr) (compFor | (COMMA (namedExprTest | starExpr))* COMMA?)
+    ;
+
+trailer
+    : OPEN_PAREN arglist? CLOSE_PAREN         # CallTrailer
+    | OPEN_BRACKET subscriptList CLOSE_BRACKET # SubscriptTrailer
+    | DOT name                                 # AttributeTrailer
+    ;
+
+subscriptList
+    : subscript (COMMA subscript)* COMMA?
+    ;
+
+subscript
+    : test                                  # IndexSubscript
+    | test? COLON test? (COLON test?)?      # SliceSubscript
+    | starExpr                              # StarSubscript
+    ;
+
+exprlist
+    : (expr | starExpr) (COMMA (expr | starExpr))* COMMA?
+    ;
+
+testlist
+    : test (COMMA test)* COMMA?
+    ;
+
+testlistStarExpr
+    : (test | starExpr) (COMMA (test | starExpr))* COMMA?
+    ;
+
+dictOrSetMaker
+    : dictItem (compFor | (COMMA dictItem)* COMMA?)                                   # DictMaker
+    | (namedExprTest | starExpr) (compFor | (COMMA (namedExprTest | starExpr))* COMMA?) # SetMaker
+    ;
+
+dictItem
+    : test COLON test
+    | POWER expr
+    ;
+
+arglist
+    : argument (COMMA argument)* COMMA?
+    ;
+
+argument
+    : test compFor?      # PositionalArgument
+    | name WALRUS test   # NamedExprArgument
+    | name ASSIGN test   # KeywordArgument
+    | STAR test          # StarArgument
+    | POWER test         # KwArgument
+    ;
+
+compFor
+    : ASYNC? FOR exprlist IN orTest compIter?
+    ;
+
+compIter
+    : compFor
+    | compIf
+    ;
+
+compIf
+    : IF testNoCond compIter?
+    ;
+
+yieldExpr
+    : YIELD (FROM test | testlistStarExpr)?
+    ;
@@ -0,0 +1,855 @@
+// Code generated from java-escape by ANTLR 4.11.1. DO NOT EDIT.
+
+package pythonparser
+
+import (
+	"fmt"
+	"sync"
+	"unicode"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+// Suppress unused import error
+var _ = fmt.Printf
+var _ = sync.Once{}
+var _ = unicode.IsLetter
+
+type PythonLexer struct {
+	PythonLexerBase
+	channelNames []string
+	modeNames    []string
+	// TODO: EOF string
+}
+
+var pythonlexerLexerStaticData struct {
+	once                   sync.Once
+	serializedATN          []int32
+	channelNames           []string
+	modeNames              []string
+	literalNames           []string
+	symbolicNames          []string
+	ruleNames              []string
+	predictionContextCache *antlr.PredictionContextCache
+	atn                    *antlr.ATN
+	decisionToDFA          []*antlr.DFA
+}
+
+func pythonlexerLexerInit() {
+	staticData := &pythonlexerLexerStaticData
+	staticData.channelNames = []string{
+		"DEFAULT_TOKEN_CHANNEL", "HIDDEN",
+	}
+	staticData.modeNames = []string{
+		"DEFAULT_MODE",
+	}
+	staticData.literalNames = []string{
+		"", "", "", "'False'", "'None'", "'True'", "'and'", "'as'", "'assert'",
+		"'async'", "'await'", "'break'", "'class'", "'continue'", "'def'", "'del'",
+		"'elif'", "'else'", "'except'", "'finally'", "'for'", "'from'", "'global'",
+		"'if'", "'import'", "'in'", "'is'", "'lambda'", "'nonlocal'", "'not'",
+		"'or'", "'pass'", "'raise'", "'return'", "'try'", "'while'", "'with'",
+		"'yield'", "'match'", "'case'", "", "", "'...'", "'.'", "'*'", "'('",
+		"')'", "','", "':='", "':'", "';'", "'**'", "'='", "'['", "']'", "'|'",
+		"'^'", "'&'", "'<<'", "'>>'", "'+'", "'-'", "'/'", "'%'", "'//'", "'~'",
+		"'{'", "'}'", "'<'", "'>'", "'=='", "'>='", "'<='", "'<>'", "'!='",
+		"'@'", "'->'", "'+='", "'-='", "'*='", "'@='", "'/='", "'%='", "'&='",
+		"'|='", "'^='", "'<<='", "'>>='", "'**='", "'//='",
+	}
+	staticData.symbolicNames = []string{
+		"", "INDENT", "DEDENT", "FALSE", "NONE", "TRUE", "AND", "AS", "ASSERT",
+		"ASYNC", "AWAIT", "BREAK", "CLASS", "CONTINUE", "DEF", "DEL", "ELIF",
+		"ELSE", "EXCEPT", "FINALLY", "FOR", "FROM", "GLOBAL", "IF", "IMPORT",
+		"IN", "IS", "LAMBDA", "NONLOCAL", "NOT", "OR", "PASS", "RAISE", "RETURN",
+		"TRY", "WHILE", "WITH", "YIELD", "MATCH", "CASE", "STRING", "NUMBER",
+		"ELLIPSIS", "DOT", "STAR", "OPEN_PAREN", "CLOSE_PAREN", "COMMA", "WALRUS",
+		"COLON", "SEMI_COLON", "POWER", "ASSIGN", "OPEN_BRACKET", "CLOSE_BRACKET",
+		"OR_OP", "XOR", "AND_OP", "LEFT_SHIFT", "RIGHT_SHIFT", "ADD", "MINUS",
+		"DIV", "MOD", "IDIV", "NOT_OP", "OPEN_BRACE", "CLOSE_BRACE", "LESS_THAN",
+		"GREATER_THAN", "EQUALS", "GT_EQ", "LT_EQ", "NOT_EQ_1", "NOT_EQ_2",
+		"AT", "ARROW", "ADD_ASSIGN", "SUB_ASSIGN", "MULT_ASSIGN", "AT_ASSIGN",
+		"DIV_ASSIGN", "MOD_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN",
+		"LEFT_SHIFT_ASSIGN", "RIGHT_SHIFT_ASSIGN", "POWER_ASSIGN", "IDIV_ASSIGN",
+		"NEWLINE", "NAME", "WS", "LINE_JOINING", "COMMENT", "ERROR_CHAR",
+	}
+	staticData.ruleNames = []string{
+		"FALSE", "NONE", "TRUE", "AND", "AS", "ASSERT", "ASYNC", "AWAIT", "BREAK",
+		"CLASS", "CONTINUE", "DEF", "DEL", "ELIF", "ELSE", "EXCEPT", "FINALLY",
+		"FOR", "FROM", "GLOBAL", "IF", "IMPORT", "IN", "IS", "LAMBDA", "NONLOCAL",
+		"NOT", "OR", "PASS", "RAISE", "RETURN", "TRY", "WHILE", "WITH", "YIELD",
+		"MATCH", "CASE", "STRING", "NUMBER", "ELLIPSIS", "DOT", "STAR", "OPEN_PAREN",
+		"CLOSE_PAREN", "COMMA", "WALRUS", "COLON", "SEMI_COLON", "POWER", "ASSIGN",
+		"OPEN_BRACKET", "CLOSE_BRACKET", "OR_OP", "XOR", "AND_OP", "LEFT_SHIFT",
+		"RIGHT_SHIFT", "ADD", "MINUS", "DIV", "MOD", "IDIV", "NOT_OP", "OPEN_BRACE",
+		"CLOSE_BRACE", "LESS_THAN", "GREATER_THAN", "EQUALS", "GT_EQ", "LT_EQ",
+		"NOT_EQ_1", "NOT_EQ_2", "AT", "ARROW", "ADD_ASSIGN", "SUB_ASSIGN", "MULT_ASSIGN",
+		"AT_ASSIGN", "DIV_ASSIGN", "MOD_ASSIGN", "AND_ASSIGN", "OR_ASSIGN",
+		"XOR_ASSIGN", "LEFT_SHIFT_ASSIGN", "RIGHT_SHIFT_ASSIGN", "POWER_ASSIGN",
+		"IDIV_ASSIGN", "NEWLINE", "NAME", "WS", "LINE_JOINING", "COMMENT", "ERROR_CHAR",
+		"STRING_PREFIX", "SHORT_STRING", "LONG_STRING", "LONG_STRING_ITEM",
+		"STRING_ESCAPE_SEQ", "INTEGER", "DECIMAL_INTEGER", "OCT_INTEGER", "HEX_INTEGER",
+		"BIN_INTEGER", "FLOAT_NUMBER", "POINT_FLOAT", "EXPONENT_FLOAT", "IMAG_NUMBER",
+		"INT_PART", "FRACTION", "EXPONENT", "DIGIT", "ID_START", "ID_CONTINUE",
+	}
+	staticData.predictionContextCache = antlr.NewPredictionContextCache()
+	staticData.serializedATN = []int32{
+		4, 0, 95, 814, 6, -1, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2,
+		4, 7, 4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2,
+		10, 7, 10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15,
+		7, 15, 2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7,
+		20, 2, 21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25,
+		2, 26, 7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2,
+		31, 7, 31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36,
+		7, 36, 2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7,
+		41, 2, 42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46,
+		2, 47, 7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2,
+		52, 7, 52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57,
+		7, 57, 2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7,
+		62, 2, 63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 2, 67, 7, 67,
+		2, 68, 7, 68, 2, 69, 7, 69, 2, 70, 7, 70, 2, 71, 7, 71, 2, 72, 7, 72, 2,
+		73, 7, 73, 2, 74, 7, 74, 2, 75, 7, 75, 2, 76, 7, 76, 2, 77, 7, 77, 2, 78,
+		7, 78, 2, 79, 7, 79, 2, 80, 7, 80, 2, 81, 7, 81, 2, 82, 7, 82, 2, 83, 7,
+		83, 2, 84, 7, 84, 2, 85, 7, 85, 2, 86, 7, 86, 2, 87, 7, 87, 2, 88, 7, 88,
+		2, 89, 7, 89, 2, 90, 7, 90, 2, 91, 7, 91, 2, 92, 7, 92, 2, 93, 7, 93, 2,
+		94, 7, 94, 2, 95, 7, 95, 2, 96, 7, 96, 2, 97, 7, 97, 2, 98, 7, 98, 2, 99,
+		7, 99, 2, 100, 7, 100, 2, 101, 7, 101, 2, 102, 7, 102, 2, 103, 7, 103,
+		2, 104, 7, 104, 2, 105, 7, 105, 2, 106, 7, 106, 2, 107, 7, 107, 2, 108,
+		7, 108, 2, 109, 7, 109, 2, 110, 7, 110, 2, 111, 7, 111, 2, 112, 7, 112,
+		1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 0, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 2,
+		1, 2, 1, 2, 1, 2, 1, 2, 1, 3, 1, 3, 1, 3, 1, 3, 1, 4, 1, 4, 1, 4, 1, 5,
+		1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 5, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6, 1, 6,
+		1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 7, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8, 1, 8,
+		1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 9, 1, 10, 1, 10, 1, 10, 1, 10, 1, 10,
+		1, 10, 1, 10, 1, 10, 1, 10, 1, 11, 1, 11, 1, 11, 1, 11, 1, 12, 1, 12, 1,
+		12, 1, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 14, 1, 14, 1, 14, 1, 14,
+		1, 14, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 15, 1, 16, 1, 16, 1,
+		16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 1, 17, 1, 17, 1, 18,
+		1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1, 19, 1,
+		19, 1, 20, 1, 20, 1, 20, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21, 1, 21,
+		1, 22, 1, 22, 1, 22, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 1, 24, 1,
+		24, 1, 24, 1, 24, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 1, 26, 1, 26, 1, 26, 1, 26, 1, 27, 1, 27, 1, 27, 1, 28, 1, 28, 1,
+		28, 1, 28, 1, 28, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30,
+		1, 30, 1, 30, 1, 30, 1, 30, 1, 30, 1, 31, 1, 31, 1, 31, 1, 31, 1, 32, 1,
+		32, 1, 32, 1, 32, 1, 32, 1, 32, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 34,
+		1, 34, 1, 34, 1, 34, 1, 34, 1, 34, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 1,
+		35, 1, 36, 1, 36, 1, 36, 1, 36, 1, 36, 1, 37, 3, 37, 430, 8, 37, 1, 37,
+		1, 37, 3, 37, 434, 8, 37, 1, 38, 1, 38, 1, 38, 3, 38, 439, 8, 38, 1, 39,
+		1, 39, 1, 39, 1, 39, 1, 40, 1, 40, 1, 41, 1, 41, 1, 42, 1, 42, 1, 43, 1,
+		43, 1, 44, 1, 44, 1, 45, 1, 45, 1, 45, 1, 46, 1, 46, 1, 47, 1, 47, 1, 48,
+		1, 48, 1, 48, 1, 49, 1, 49, 1, 50, 1, 50, 1, 51, 1, 51, 1, 52, 1, 52, 1,
+		53, 1, 53, 1, 54, 1, 54, 1, 55, 1, 55, 1, 55, 1, 56, 1, 56, 1, 56, 1, 57,
+		1, 57, 1, 58, 1, 58, 1, 59, 1, 59, 1, 60, 1, 60, 1, 61, 1, 61, 1, 61, 1,
+		62, 1, 62, 1, 63, 1, 63, 1, 64, 1, 64, 1, 65, 1, 65, 1, 66, 1, 66, 1, 67,
+		1, 67, 1, 67, 1, 68, 1, 68, 1, 68, 1, 69, 1, 69, 1, 69, 1, 70, 1, 70, 1,
+		70, 1, 71, 1, 71, 1, 71, 1, 72, 1, 72, 1, 73, 1, 73, 1, 73, 1, 74, 1, 74,
+		1, 74, 1, 75, 1, 75, 1, 75, 1, 76, 1, 76, 1, 76, 1, 77, 1, 77, 1, 77, 1,
+		78, 1, 78, 1, 78, 1, 79, 1, 79, 1, 79, 1, 80, 1, 80, 1, 80, 1, 81, 1, 81,
+		1, 81, 1, 82, 1, 82, 1, 82, 1, 83, 1, 83, 1, 83, 1, 83, 1, 84, 1, 84, 1,
+		84, 1, 84, 1, 85, 1, 85, 1, 85, 1, 85, 1, 86, 1, 86, 1, 86, 1, 86, 1, 87,
+		3, 87, 568, 8, 87, 1, 87, 1, 87, 3, 87, 572, 8, 87, 1, 87, 5, 87, 575,
+		8, 87, 10, 87, 12, 87, 578, 9, 87, 1, 88, 1, 88, 5, 88, 582, 8, 88, 10,
+		88, 12, 88, 585, 9, 88, 1, 89, 4, 89, 588, 8, 89, 11, 89, 12, 89, 589,
+		1, 89, 1, 89, 1, 90, 1, 90, 5, 90, 596, 8, 90, 10, 90, 12, 90, 599, 9,
+		90, 1, 90, 3, 90, 602, 8, 90, 1, 90, 1, 90, 3, 90, 606, 8, 90, 1, 90, 1,
+		90, 1, 91, 1, 91, 5, 91, 612, 8, 91, 10, 91, 12, 91, 615, 9, 91, 1, 91,
+		1, 91, 1, 92, 1, 92, 1, 93, 1, 93, 1, 93, 1, 93, 1, 93, 3, 93, 626, 8,
+		93, 1, 94, 1, 94, 1, 94, 5, 94, 631, 8, 94, 10, 94, 12, 94, 634, 9, 94,
+		1, 94, 1, 94, 1, 94, 1, 94, 5, 94, 640, 8, 94, 10, 94, 12, 94, 643, 9,
+		94, 1, 94, 3, 94, 646, 8, 94, 1, 95, 1, 95, 1, 95, 1, 95, 1, 95, 5, 95,
+		653, 8, 95, 10, 95, 12, 95, 656, 9, 95, 1, 95, 1, 95, 1, 95, 1, 95, 1,
+		95, 1, 95, 1, 95, 1, 95, 5, 95, 666, 8, 95, 10, 95, 12, 95, 669, 9, 95,
+		1, 95, 1, 95, 1, 95, 3, 95, 674, 8, 95, 1, 96, 1, 96, 3, 96, 678, 8, 96,
+		1, 97, 1, 97, 1, 97, 1, 97, 1, 97, 3, 97, 685, 8, 97, 1, 98, 1, 98, 1,
+		98, 1, 98, 3, 98, 691, 8, 98, 1, 99, 1, 99, 3, 99, 695, 8, 99, 1, 99, 5,
+		99, 698, 8, 99, 10, 99, 12, 99, 701, 9, 99, 1, 99, 4, 99, 704, 8, 99, 11,
+		99, 12, 99, 705, 1, 99, 3, 99, 709, 8, 99, 1, 99, 5, 99, 712, 8, 99, 10,
+		99, 12, 99, 715, 9, 99, 3, 99, 717, 8, 99, 1, 100, 1, 100, 1, 100, 3, 100,
+		722, 8, 100, 1, 100, 4, 100, 725, 8, 100, 11, 100, 12, 100, 726, 1, 101,
+		1, 101, 1, 101, 3, 101, 732, 8, 101, 1, 101, 4, 101, 735, 8, 101, 11, 101,
+		12, 101, 736, 1, 102, 1, 102, 1, 102, 3, 102, 742, 8, 102, 1, 102, 4, 102,
+		745, 8, 102, 11, 102, 12, 102, 746, 1, 103, 1, 103, 3, 103, 751, 8, 103,
+		1, 104, 3, 104, 754, 8, 104, 1, 104, 1, 104, 1, 104, 1, 104, 3, 104, 760,
+		8, 104, 1, 105, 1, 105, 3, 105, 764, 8, 105, 1, 105, 1, 105, 1, 106, 1,
+		106, 3, 106, 770, 8, 106, 1, 106, 1, 106, 1, 107, 1, 107, 3, 107, 776,
+		8, 107, 1, 107, 5, 107, 779, 8, 107, 10, 107, 12, 107, 782, 9, 107, 1,
+		108, 1, 108, 1, 108, 3, 108, 787, 8, 108, 1, 108, 5, 108, 790, 8, 108,
+		10, 108, 12, 108, 793, 9, 108, 1, 109, 1, 109, 3, 109, 797, 8, 109, 1,
+		109, 1, 109, 3, 109, 801, 8, 109, 1, 109, 5, 109, 804, 8, 109, 10, 109,
+		12, 109, 807, 9, 109, 1, 110, 1, 110, 1, 111, 1, 111, 1, 112, 1, 112, 2,
+		654, 667, 0, 113, 1, 3, 3, 4, 5, 5, 7, 6, 9, 7, 11, 8, 13, 9, 15, 10, 17,
+		11, 19, 12, 21, 13, 23, 14, 25, 15, 27, 16, 29, 17, 31, 18, 33, 19, 35,
+		20, 37, 21, 39, 22, 41, 23, 43, 24, 45, 25, 47, 26, 49, 27, 51, 28, 53,
+		29, 55, 30, 57, 31, 59, 32, 61, 33, 63, 34, 65, 35, 67, 36, 69, 37, 71,
+		38, 73, 39, 75, 40, 77, 41, 79, 42, 81, 43, 83, 44, 85, 45, 87, 46, 89,
+		47, 91, 48, 93, 49, 95, 50, 97, 51, 99, 52, 101, 53, 103, 54, 105, 55,
+		107, 56, 109, 57, 111, 58, 113, 59, 115, 60, 117, 61, 119, 62, 121, 63,
+		123, 64, 125, 65, 127, 66, 129, 67, 131, 68, 133, 69, 135, 70, 137, 71,
+		139, 72, 141, 73, 143, 74, 145, 75, 147, 76, 149, 77, 151, 78, 153, 79,
+		155, 80, 157, 81, 159, 82, 161, 83, 163, 84, 165, 85, 167, 86, 169, 87,
+		171, 88, 173, 89, 175, 90, 177, 91, 179, 92, 181, 93, 183, 94, 185, 95,
+		187, 0, 189, 0, 191, 0, 193, 0, 195, 0, 197, 0, 199, 0, 201, 0, 203, 0,
+		205, 0, 207, 0, 209, 0, 211, 0, 213, 0, 215, 0, 217, 0, 219, 0, 221, 0,
+		223, 0, 225, 0, 1, 0, 22, 3, 0, 9, 9, 12, 12, 32, 32, 2, 0, 9, 9, 32, 32,
+		2, 0, 10, 10, 12, 13, 8, 0, 66, 66, 70, 70, 82, 82, 85, 85, 98, 98, 102,
+		102, 114, 114, 117, 117, 2, 0, 82, 82, 114, 114, 4, 0, 66, 66, 70, 70,
+		98, 98, 102, 102, 4, 0, 10, 10, 12, 13, 39, 39, 92, 92, 4, 0, 10, 10, 12,
+		13, 34, 34, 92, 92, 1, 0, 92, 92, 1, 0, 49, 57, 2, 0, 79, 79, 111, 111,
+		1, 0, 48, 55, 2, 0, 88, 88, 120, 120, 3, 0, 48, 57, 65, 70, 97, 102, 2,
+		0, 66, 66, 98, 98, 1, 0, 48, 49, 2, 0, 74, 74, 106, 106, 2, 0, 69, 69,
+		101, 101, 2, 0, 43, 43, 45, 45, 1, 0, 48, 57, 652, 0, 65, 90, 95, 95, 97,
+		122, 170, 170, 181, 181, 186, 186, 192, 214, 216, 246, 248, 705, 710, 721,
+		736, 740, 748, 748, 750, 750, 880, 884, 886, 887, 890, 893, 895, 895, 902,
+		902, 904, 906, 908, 908, 910, 929, 931, 1013, 1015, 1153, 1162, 1327, 1329,
+		1366, 1369, 1369, 1376, 1416, 1488, 1514, 1519, 1522, 1568, 1610, 1646,
+		1647, 1649, 1747, 1749, 1749, 1765, 1766, 1774, 1775, 1786, 1788, 1791,
+		1791, 1808, 1808, 1810, 1839, 1869, 1957, 1969, 1969, 1994, 2026, 2036,
+		2037, 2042, 2042, 2048, 2069, 2074, 2074, 2084, 2084, 2088, 2088, 2112,
+		2136, 2144, 2154, 2160, 2183, 2185, 2190, 2208, 2249, 2308, 2361, 2365,
+		2365, 2384, 2384, 2392, 2401, 2417, 2432, 2437, 2444, 2447, 2448, 2451,
+		2472, 2474, 2480, 2482, 2482, 2486, 2489, 2493, 2493, 2510, 2510, 2524,
+		2525, 2527, 2529, 2544, 2545, 2556, 2556, 2565, 2570, 2575, 2576, 2579,
+		2600, 2602, 2608, 2610, 2611, 2613, 2614, 2616, 2617, 2649, 2652, 2654,
+		2654, 2674, 2676, 2693, 2701, 2703, 2705, 2707, 2728, 2730, 2736, 2738,
+		2739, 2741, 2745, 2749, 2749, 2768, 2768, 2784, 2785, 2809, 2809, 2821,
+		2828, 2831, 2832, 2835, 2856, 2858, 2864, 2866, 2867, 2869, 2873, 2877,
+		2877, 2908, 2909, 2911, 2913, 2929, 2929, 2947, 2947, 2949, 2954, 2958,
+		2960, 2962, 2965, 2969, 2970, 2972, 2972, 2974, 2975, 2979, 2980, 2984,
+		2986, 2990, 3001, 3024, 3024, 3077, 3084, 3086, 3088, 3090, 3112, 3114,
+		3129, 3133, 3133, 3160, 3162, 3165, 3165, 3168, 3169, 3200, 3200, 3205,
+		3212, 3214, 3216, 3218, 3240, 3242, 3251, 3253, 3257, 3261, 3261, 3293,
+		3294, 3296, 3297, 3313, 3314, 3332, 3340, 3342, 3344, 3346, 3386, 3389,
+		3389, 3406, 3406, 3412, 3414, 3423, 3425, 3450, 3455, 3461, 3478, 3482,
+		3505, 3507, 3515, 3517, 3517, 3520, 3526, 3585, 3632, 3634, 3635, 3648,
+		3654, 3713, 3714, 3716, 3716, 3718, 3722, 3724, 3747, 3749, 3749, 3751,
+		3760, 3762, 3763, 3773, 3773, 3776, 3780, 3782, 3782, 3804, 3807, 3840,
+		3840, 3904, 3911, 3913, 3948, 3976, 3980, 4096, 4138, 4159, 4159, 4176,
+		4181, 4186, 4189, 4193, 4193, 4197, 4198, 4206, 4208, 4213, 4225, 4238,
+		4238, 4256, 4293, 4295, 4295, 4301, 4301, 4304, 4346, 4348, 4680, 4682,
+		4685, 4688, 4694, 4696, 4696, 4698, 4701, 4704, 4744, 4746, 4749, 4752,
+		4784, 4786, 4789, 4792, 4798, 4800, 4800, 4802, 4805, 4808, 4822, 4824,
+		4880, 4882, 4885, 4888, 4954, 4992, 5007, 5024, 5109, 5112, 5117, 5121,
+		5740, 5743, 5759, 5761, 5786, 5792, 5866, 5870, 5880, 5888, 5905, 5919,
+		5937, 5952, 5969, 5984, 5996, 5998, 6000, 6016, 6067, 6103, 6103, 6108,
+		6108, 6176, 6264, 6272, 6276, 6279, 6312, 6314, 6314, 6320, 6389, 6400,
+		6430, 6480, 6509, 6512, 6516, 6528, 6571, 6576, 6601, 6656, 6678, 6688,
+		6740, 6823, 6823, 6917, 6963, 6981, 6988, 7043, 7072, 7086, 7087, 7098,
+		7141, 7168, 7203, 7245, 7247, 7258, 7293, 7296, 7304, 7312, 7354, 7357,
+		7359, 7401, 7404, 7406, 7411, 7413, 7414, 7418, 7418, 7424, 7615, 7680,
+		7957, 7960, 7965, 7968, 8005, 8008, 8013, 8016, 8023, 8025, 8025, 8027,
+		8027, 8029, 8029, 8031, 8061, 8064, 8116, 8118, 8124, 8126, 8126, 8130,
+		8132, 8134, 8140, 8144, 8147, 8150, 8155, 8160, 8172, 8178, 8180, 8182,
+		8188, 8305, 8305, 8319, 8319, 8336, 8348, 8450, 8450, 8455, 8455, 8458,
+		8467, 8469, 8469, 8473, 8477, 8484, 8484, 8486, 8486, 8488, 8488, 8490,
+		8493, 8495, 8505, 8508, 8511, 8517, 8521, 8526, 8526, 8544, 8584, 11264,
+		11492, 11499, 11502, 11506, 11507, 11520, 11557, 11559, 11559, 11565, 11565,
+		11568, 11623, 11631, 11631, 11648, 11670, 11680, 11686, 11688, 11694, 11696,
+		11702, 11704, 11710, 11712, 11718, 11720, 11726, 11728, 11734, 11736, 11742,
+		11823, 11823, 12293, 12295, 12321, 12329, 12337, 12341, 12344, 12348, 12353,
+		12438, 12445, 12447, 12449, 12538, 12540, 12543, 12549, 12591, 12593, 12686,
+		12704, 12735, 12784, 12799, 13312, 19903, 19968, 42124, 42192, 42237, 42240,
+		42508, 42512, 42527, 42538, 42539, 42560, 42606, 42623, 42653, 42656, 42735,
+		42775, 42783, 42786, 42888, 42891, 42954, 42960, 42961, 42963, 42963, 42965,
+		42969, 42994, 43009, 43011, 43013, 43015, 43018, 43020, 43042, 43072, 43123,
+		43138, 43187, 43250, 43255, 43259, 43259, 43261, 43262, 43274, 43301, 43312,
+		43334, 43360, 43388, 43396, 43442, 43471, 43471, 43488, 43492, 43494, 43503,
+		43514, 43518, 43520, 43560, 43584, 43586, 43588, 43595, 43616, 43638, 43642,
+		43642, 43646, 43695, 43697, 43697, 43701, 43702, 43705, 43709, 43712, 43712,
+		43714, 43714, 43739, 43741, 43744, 43754, 43762, 43764, 43777, 43782, 43785,
+		43790, 43793, 43798, 43808, 43814, 43816, 43822, 43824, 43866, 43868, 43881,
+		43888, 44002, 44032, 55203, 55216, 55238, 55243, 55291, 63744, 64109, 64112,
+		64217, 64256, 64262, 64275, 64279, 64285, 64285, 64287, 64296, 64298, 64310,
+		64312, 64316, 64318, 64318, 64320, 64321, 64323, 64324, 64326, 64433, 64467,
+		64829, 64848, 64911, 64914, 64967, 65008, 65019, 65136, 65140, 65142, 65276,
+		65313, 65338, 65345, 65370, 65382, 65470, 65474, 65479, 65482, 65487, 65490,
+		65495, 65498, 65500, 65536, 65547, 65549, 65574, 65576, 65594, 65596, 65597,
+		65599, 65613, 65616, 65629, 65664, 65786, 65856, 65908, 66176, 66204, 66208,
+		66256, 66304, 66335, 66349, 66378, 66384, 66421, 66432, 66461, 66464, 66499,
+		66504, 66511, 66513, 66517, 66560, 66717, 66736, 66771, 66776, 66811, 66816,
+		66855, 66864, 66915, 66928, 66938, 66940, 66954, 66956, 66962, 66964, 66965,
+		66967, 66977, 66979, 66993, 66995, 67001, 67003, 67004, 67072, 67382, 67392,
+		67413, 67424, 67431, 67456, 67461, 67463, 67504, 67506, 67514, 67584, 67589,
+		67592, 67592, 67594, 67637, 67639, 67640, 67644, 67644, 67647, 67669, 67680,
+		67702, 67712, 67742, 67808, 67826, 67828, 67829, 67840, 67861, 67872, 67897,
+		67968, 68023, 68030, 68031, 68096, 68096, 68112, 68115, 68117, 68119, 68121,
+		68149, 68192, 68220, 68224, 68252, 68288, 68295, 68297, 68324, 68352, 68405,
+		68416, 68437, 68448, 68466, 68480, 68497, 68608, 68680, 68736, 68786, 68800,
+		68850, 68864, 68899, 69248, 69289, 69296, 69297, 69376, 69404, 69415, 69415,
+		69424, 69445, 69488, 69505, 69552, 69572, 69600, 69622, 69635, 69687, 69745,
+		69746, 69749, 69749, 69763, 69807, 69840, 69864, 69891, 69926, 69956, 69956,
+		69959, 69959, 69968, 70002, 70006, 70006, 70019, 70066, 70081, 70084, 70106,
+		70106, 70108, 70108, 70144, 70161, 70163, 70187, 70272, 70278, 70280, 70280,
+		70282, 70285, 70287, 70301, 70303, 70312, 70320, 70366, 70405, 70412, 70415,
+		70416, 70419, 70440, 70442, 70448, 70450, 70451, 70453, 70457, 70461, 70461,
+		70480, 70480, 70493, 70497, 70656, 70708, 70727, 70730, 70751, 70753, 70784,
+		70831, 70852, 70853, 70855, 70855, 71040, 71086, 71128, 71131, 71168, 71215,
+		71236, 71236, 71296, 71338, 71352, 71352, 71424, 71450, 71488, 71494, 71680,
+		71723, 71840, 71903, 71935, 71942, 71945, 71945, 71948, 71955, 71957, 71958,
+		71960, 71983, 71999, 71999, 72001, 72001, 72096, 72103, 72106, 72144, 72161,
+		72161, 72163, 72163, 72192, 72192, 72203, 72242, 72250, 72250, 72272, 72272,
+		72284, 72329, 72349, 72349, 72368, 72440, 72704, 72712, 72714, 72750, 72768,
+		72768, 72818, 72847, 72960, 72966, 72968, 72969, 72971, 73008, 73030, 73030,
+		73056, 73061, 73063, 73064, 73066, 73097, 73112, 73112, 73440, 73458, 73648,
+		73648, 73728, 74649, 74752, 74862, 74880, 75075, 77712, 77808, 77824, 78894,
+		82944, 83526, 92160, 92728, 92736, 92766, 92784, 92862, 92880, 92909, 92928,
+		92975, 92992, 92995, 93027, 93047, 93053, 93071, 93760, 93823, 93952, 94026,
+		94032, 94032, 94099, 94111, 94176, 94177, 94179, 94179, 94208, 100343,
+		100352, 101589, 101632, 101640, 110576, 110579, 110581, 110587, 110589,
+		110590, 110592, 110882, 110928, 110930, 110948, 110951, 110960, 111355,
+		113664, 113770, 113776, 113788, 113792, 113800, 113808, 113817, 119808,
+		119892, 119894, 119964, 119966, 119967, 119970, 119970, 119973, 119974,
+		119977, 119980, 119982, 119993, 119995, 119995, 119997, 120003, 120005,
+		120069, 120071, 120074, 120077, 120084, 120086, 120092, 120094, 120121,
+		120123, 120126, 120128, 120132, 120134, 120134, 120138, 120144, 120146,
+		120485, 120488, 120512, 120514, 120538, 120540, 120570, 120572, 120596,
+		120598, 120628, 120630, 120654, 120656, 120686, 120688, 120712, 120714,
+		120744, 120746, 120770, 120772, 120779, 122624, 122654, 123136, 123180,
+		123191, 123197, 123214, 123214, 123536, 123565, 123584, 123627, 124896,
+		124902, 124904, 124907, 124909, 124910, 124912, 124926, 124928, 125124,
+		125184, 125251, 125259, 125259, 126464, 126467, 126469, 126495, 126497,
+		126498, 126500, 126500, 126503, 126503, 126505, 126514, 126516, 126519,
+		126521, 126521, 126523, 126523, 126530, 126530, 126535, 126535, 126537,
+		126537, 126539, 126539, 126541, 126543, 126545, 126546, 126548, 126548,
+		126551, 126551, 126553, 126553, 126555, 126555, 126557, 126557, 126559,
+		126559, 126561, 126562, 126564, 126564, 126567, 126570, 126572, 126578,
+		126580, 126583, 126585, 126588, 126590, 126590, 126592, 126601, 126603,
+		126619, 126625, 126627, 126629, 126633, 126635, 126651, 131072, 173791,
+		173824, 177976, 177984, 178205, 178208, 183969, 183984, 191456, 194560,
+		195101, 196608, 201546, 758, 0, 48, 57, 65, 90, 95, 95, 97, 122, 170, 170,
+		181, 181, 186, 186, 192, 214, 216, 246, 248, 705, 710, 721, 736, 740, 748,
+		748, 750, 750, 768, 884, 886, 887, 890, 893, 895, 895, 902, 902, 904, 906,
+		908, 908, 910, 929, 931, 1013, 1015, 1153, 1155, 1159, 1162, 1327, 1329,
+		1366, 1369, 1369, 1376, 1416, 1425, 1469, 1471, 1471, 1473, 1474, 1476,
+		1477, 1479, 1479, 1488, 1514, 1519, 1522, 1552, 1562, 1568, 1641, 1646,
+		1747, 1749, 1756, 1759, 1768, 1770, 1788, 1791, 1791, 1808, 1866, 1869,
+		1969, 1984, 2037, 2042, 2042, 2045, 2045, 2048, 2093, 2112, 2139, 2144,
+		2154, 2160, 2183, 2185, 2190, 2200, 2273, 2275, 2403, 2406, 2415, 2417,
+		2435, 2437, 2444, 2447, 2448, 2451, 2472, 2474, 2480, 2482, 2482, 2486,
+		2489, 2492, 2500, 2503, 2504, 2507, 2510, 2519, 2519, 2524, 2525, 2527,
+		2531, 2534, 2545, 2556, 2556, 2558, 2558, 2561, 2563, 2565, 2570, 2575,
+		2576, 2579, 2600, 2602, 2608, 2610, 2611, 2613, 2614, 2616, 2617, 2620,
+		2620, 2622, 2626, 2631, 2632, 2635, 2637, 2641, 2641, 2649, 2652, 2654,
+		2654, 2662, 2677, 2689, 2691, 2693, 2701, 2703, 2705, 2707, 2728, 2730,
+		2736, 2738, 2739, 2741, 2745, 2748, 2757, 2759, 2761, 2763, 2765, 2768,
+		2768, 2784, 2787, 2790, 2799, 2809, 2815, 2817, 2819, 2821, 2828, 2831,
+		2832, 2835, 2856, 2858, 2864, 2866, 2867, 2869, 2873, 2876, 2884, 2887,
+		2888, 2891, 2893, 2901, 2903, 2908, 2909, 2911, 2915, 2918, 2927, 2929,
+		2929, 2946, 2947, 2949, 2954, 2958, 2960, 2962, 2965, 2969, 2970, 2972,
+		2972, 2974, 2975, 2979, 2980, 2984, 2986, 2990, 3001, 3006, 3010, 3014,
+		3016, 3018, 3021, 3024, 3024, 3031, 3031, 3046, 3055, 3072, 3084, 3086,
+		3088, 3090, 3112, 3114, 3129, 3132, 3140, 3142, 3144, 3146, 3149, 3157,
+		3158, 3160, 3162, 3165, 3165, 3168, 3171, 3174, 3183, 3200, 3203, 3205,
+		3212, 3214, 3216, 3218, 3240, 3242, 3251, 3253, 3257, 3260, 3268, 3270,
+		3272, 3274, 3277, 3285, 3286, 3293, 3294, 3296, 3299, 3302, 3311, 3313,
+		3314, 3328, 3340, 3342, 3344, 3346, 3396, 3398, 3400, 3402, 3406, 3412,
+		3415, 3423, 3427, 3430, 3439, 3450, 3455, 3457, 3459, 3461, 3478, 3482,
+		3505, 3507, 3515, 3517, 3517, 3520, 3526, 3530, 3530, 3535, 3540, 3542,
+		3542, 3544, 3551, 3558, 3567, 3570, 3571, 3585, 3642, 3648, 3662, 3664,
+		3673, 3713, 3714, 3716, 3716, 3718, 3722, 3724, 3747, 3749, 3749, 3751,
+		3773, 3776, 3780, 3782, 3782, 3784, 3789, 3792, 3801, 3804, 3807, 3840,
+		3840, 3864, 3865, 3872, 3881, 3893, 3893, 3895, 3895, 3897, 3897, 3902,
+		3911, 3913, 3948, 3953, 3972, 3974, 3991, 3993, 4028, 4038, 4038, 4096,
+		4169, 4176, 4253, 4256, 4293, 4295, 4295, 4301, 4301, 4304, 4346, 4348,
+		4680, 4682, 4685, 4688, 4694, 4696, 4696, 4698, 4701, 4704, 4744, 4746,
+		4749, 4752, 4784, 4786, 4789, 4792, 4798, 4800, 4800, 4802, 4805, 4808,
+		4822, 4824, 4880, 4882, 4885, 4888, 4954, 4957, 4959, 4992, 5007, 5024,
+		5109, 5112, 5117, 5121, 5740, 5743, 5759, 5761, 5786, 5792, 5866, 5870,
+		5880, 5888, 5909, 5919, 5940, 5952, 5971, 5984, 5996, 5998, 6000, 6002,
+		6003, 6016, 6099, 6103, 6103, 6108, 6109, 6112, 6121, 6155, 6157, 6159,
+		6169, 6176, 6264, 6272, 6314, 6320, 6389, 6400, 6430, 6432, 6443, 6448,
+		6459, 6470, 6509, 6512, 6516, 6528, 6571, 6576, 6601, 6608, 6617, 6656,
+		6683, 6688, 6750, 6752, 6780, 6783, 6793, 6800, 6809, 6823, 6823, 6832,
+		6845, 6847, 6862, 6912, 6988, 6992, 7001, 7019, 7027, 7040, 7155, 7168,
+		7223, 7232, 7241, 7245, 7293, 7296, 7304, 7312, 7354, 7357, 7359, 7376,
+		7378, 7380, 7418, 7424, 7957, 7960, 7965, 7968, 8005, 8008, 8013, 8016,
+		8023, 8025, 8025, 8027, 8027, 8029, 8029, 8031, 8061, 8064, 8116, 8118,
+		8124, 8126, 8126, 8130, 8132, 8134, 8140, 8144, 8147, 8150, 8155, 8160,
+		8172, 8178, 8180, 8182, 8188, 8255, 8256, 8276, 8276, 8305, 8305, 8319,
+		8319, 8336, 8348, 8400, 8412, 8417, 8417, 8421, 8432, 8450, 8450, 8455,
+		8455, 8458, 8467, 8469, 8469, 8473, 8477, 8484, 8484, 8486, 8486, 8488,
+		8488, 8490, 8493, 8495, 8505, 8508, 8511, 8517, 8521, 8526, 8526, 8544,
+		8584, 11264, 11492, 11499, 11507, 11520, 11557, 11559, 11559, 11565, 11565,
+		11568, 11623, 11631, 11631, 11647, 11670, 11680, 11686, 11688, 11694, 11696,
+		11702, 11704, 11710, 11712, 11718, 11720, 11726, 11728, 11734, 11736, 11742,
+		11744, 11775, 11823, 11823, 12293, 12295, 12321, 12335, 12337, 12341, 12344,
+		12348, 12353, 12438, 12441, 12442, 12445, 12447, 12449, 12538, 12540, 12543,
+		12549, 12591, 12593, 12686, 12704, 12735, 12784, 12799, 13312, 19903, 19968,
+		42124, 42192, 42237, 42240, 42508, 42512, 42539, 42560, 42607, 42612, 42621,
+		42623, 42737, 42775, 42783, 42786, 42888, 42891, 42954, 42960, 42961, 42963,
+		42963, 42965, 42969, 42994, 43047, 43052, 43052, 43072, 43123, 43136, 43205,
+		43216, 43225, 43232, 43255, 43259, 43259, 43261, 43309, 43312, 43347, 43360,
+		43388, 43392, 43456, 43471, 43481, 43488, 43518, 43520, 43574, 43584, 43597,
+		43600, 43609, 43616, 43638, 43642, 43714, 43739, 43741, 43744, 43759, 43762,
+		43766, 43777, 43782, 43785, 43790, 43793, 43798, 43808, 43814, 43816, 43822,
+		43824, 43866, 43868, 43881, 43888, 44010, 44012, 44013, 44016, 44025, 44032,
+		55203, 55216, 55238, 55243, 55291, 63744, 64109, 64112, 64217, 64256, 64262,
+		64275, 64279, 64285, 64296, 64298, 64310, 64312, 64316, 64318, 64318, 64320,
+		64321, 64323, 64324, 64326, 64433, 64467, 64829, 64848, 64911, 64914, 64967,
+		65008, 65019, 65024, 65039, 65056, 65071, 65075, 65076, 65101, 65103, 65136,
+		65140, 65142, 65276, 65296, 65305, 65313, 65338, 65343, 65343, 65345, 65370,
+		65382, 65470, 65474, 65479, 65482, 65487, 65490, 65495, 65498, 65500, 65536,
+		65547, 65549, 65574, 65576, 65594, 65596, 65597, 65599, 65613, 65616, 65629,
+		65664, 65786, 65856, 65908, 66045, 66045, 66176, 66204, 66208, 66256, 66272,
+		66272, 66304, 66335, 66349, 66378, 66384, 66426, 66432, 66461, 66464, 66499,
+		66504, 66511, 66513, 66517, 66560, 66717, 66720, 66729, 66736, 66771, 66776,
+		66811, 66816, 66855, 66864, 66915, 66928, 66938, 66940, 66954, 66956, 66962,
+		66964, 66965, 66967, 66977, 66979, 66993, 66995, 67001, 67003, 67004, 67072,
+		67382, 67392, 67413, 67424, 67431, 67456, 67461, 67463, 67504, 67506, 67514,
+		67584, 67589, 67592, 67592, 67594, 67637, 67639, 67640, 67644, 67644, 67647,
+		67669, 67680, 67702, 67712, 67742, 67808, 67826, 67828, 67829, 67840, 67861,
+		67872, 67897, 67968, 68023, 68030, 68031, 68096, 68099, 68101, 68102, 68108,
+		68115, 68117, 68119, 68121, 68149, 68152, 68154, 68159, 68159, 68192, 68220,
+		68224, 68252, 68288, 68295, 68297, 68326, 68352, 68405, 68416, 68437, 68448,
+		68466, 68480, 68497, 68608, 68680, 68736, 68786, 68800, 68850, 68864, 68903,
+		68912, 68921, 69248, 69289, 69291, 69292, 69296, 69297, 69376, 69404, 69415,
+		69415, 69424, 69456, 69488, 69509, 69552, 69572, 69600, 69622, 69632, 69702,
+		69734, 69749, 69759, 69818, 69826, 69826, 69840, 69864, 69872, 69881, 69888,
+		69940, 69942, 69951, 69956, 69959, 69968, 70003, 70006, 70006, 70016, 70084,
+		70089, 70092, 70094, 70106, 70108, 70108, 70144, 70161, 70163, 70199, 70206,
+		70206, 70272, 70278, 70280, 70280, 70282, 70285, 70287, 70301, 70303, 70312,
+		70320, 70378, 70384, 70393, 70400, 70403, 70405, 70412, 70415, 70416, 70419,
+		70440, 70442, 70448, 70450, 70451, 70453, 70457, 70459, 70468, 70471, 70472,
+		70475, 70477, 70480, 70480, 70487, 70487, 70493, 70499, 70502, 70508, 70512,
+		70516, 70656, 70730, 70736, 70745, 70750, 70753, 70784, 70853, 70855, 70855,
+		70864, 70873, 71040, 71093, 71096, 71104, 71128, 71133, 71168, 71232, 71236,
+		71236, 71248, 71257, 71296, 71352, 71360, 71369, 71424, 71450, 71453, 71467,
+		71472, 71481, 71488, 71494, 71680, 71738, 71840, 71913, 71935, 71942, 71945,
+		71945, 71948, 71955, 71957, 71958, 71960, 71989, 71991, 71992, 71995, 72003,
+		72016, 72025, 72096, 72103, 72106, 72151, 72154, 72161, 72163, 72164, 72192,
+		72254, 72263, 72263, 72272, 72345, 72349, 72349, 72368, 72440, 72704, 72712,
+		72714, 72758, 72760, 72768, 72784, 72793, 72818, 72847, 72850, 72871, 72873,
+		72886, 72960, 72966, 72968, 72969, 72971, 73014, 73018, 73018, 73020, 73021,
+		73023, 73031, 73040, 73049, 73056, 73061, 73063, 73064, 73066, 73102, 73104,
+		73105, 73107, 73112, 73120, 73129, 73440, 73462, 73648, 73648, 73728, 74649,
+		74752, 74862, 74880, 75075, 77712, 77808, 77824, 78894, 82944, 83526, 92160,
+		92728, 92736, 92766, 92768, 92777, 92784, 92862, 92864, 92873, 92880, 92909,
+		92912, 92916, 92928, 92982, 92992, 92995, 93008, 93017, 93027, 93047, 93053,
+		93071, 93760, 93823, 93952, 94026, 94031, 94087, 94095, 94111, 94176, 94177,
+		94179, 94180, 94192, 94193, 94208, 100343, 100352, 101589, 101632, 101640,
+		110576, 110579, 110581, 110587, 110589, 110590, 110592, 110882, 110928,
+		110930, 110948, 110951, 110960, 111355, 113664, 113770, 113776, 113788,
+		113792, 113800, 113808, 113817, 113821, 113822, 118528, 118573, 118576,
+		118598, 119141, 119145, 119149, 119154, 119163, 119170, 119173, 119179,
+		119210, 119213, 119362, 119364, 119808, 119892, 119894, 119964, 119966,
+		119967, 119970, 119970, 119973, 119974, 119977, 119980, 119982, 119993,
+		119995, 119995, 119997, 120003, 120005, 120069, 120071, 120074, 120077,
+		120084, 120086, 120092, 120094, 120121, 120123, 120126, 120128, 120132,
+		120134, 120134, 120138, 120144, 120146, 120485, 120488, 120512, 120514,
+		120538, 120540, 120570, 120572, 120596, 120598, 120628, 120630, 120654,
+		120656, 120686, 120688, 120712, 120714, 120744, 120746, 120770, 120772,
+		120779, 120782, 120831, 121344, 121398, 121403, 121452, 121461, 121461,
+		121476, 121476, 121499, 121503, 121505, 121519, 122624, 122654, 122880,
+		122886, 122888, 122904, 122907, 122913, 122915, 122916, 122918, 122922,
+		123136, 123180, 123184, 123197, 123200, 123209, 123214, 123214, 123536,
+		123566, 123584, 123641, 124896, 124902, 124904, 124907, 124909, 124910,
+		124912, 124926, 124928, 125124, 125136, 125142, 125184, 125259, 125264,
+		125273, 126464, 126467, 126469, 126495, 126497, 126498, 126500, 126500,
+		126503, 126503, 126505, 126514, 126516, 126519, 126521, 126521, 126523,
+		126523, 126530, 126530, 126535, 126535, 126537, 126537, 126539, 126539,
+		126541, 126543, 126545, 126546, 126548, 126548, 126551, 126551, 126553,
+		126553, 126555, 126555, 126557, 126557, 126559, 126559, 126561, 126562,
+		126564, 126564, 126567, 126570, 126572, 126578, 126580, 126583, 126585,
+		126588, 126590, 126590, 126592, 126601, 126603, 126619, 126625, 126627,
+		126629, 126633, 126635, 126651, 130032, 130041, 131072, 173791, 173824,
+		177976, 177984, 178205, 178208, 183969, 183984, 191456, 194560, 195101,
+		196608, 201546, 917760, 917999, 845, 0, 1, 1, 0, 0, 0, 0, 3, 1, 0, 0, 0,
+		0, 5, 1, 0, 0, 0, 0, 7, 1, 0, 0, 0, 0, 9, 1, 0, 0, 0, 0, 11, 1, 0, 0, 0,
+		0, 13, 1, 0, 0, 0, 0, 15, 1, 0, 0, 0, 0, 17, 1, 0, 0, 0, 0, 19, 1, 0, 0,
+		0, 0, 21, 1, 0, 0, 0, 0, 23, 1, 0, 0, 0, 0, 25, 1, 0, 0, 0, 0, 27, 1, 0,
+		0, 0, 0, 29, 1, 0, 0, 0, 0, 31, 1, 0, 0, 0, 0, 33, 1, 0, 0, 0, 0, 35, 1,
+		0, 0, 0, 0, 37, 1, 0, 0, 0, 0, 39, 1, 0, 0, 0, 0, 41, 1, 0, 0, 0, 0, 43,
+		1, 0, 0, 0, 0, 45, 1, 0, 0, 0, 0, 47, 1, 0, 0, 0, 0, 49, 1, 0, 0, 0, 0,
+		51, 1, 0, 0, 0, 0, 53, 1, 0, 0, 0, 0, 55, 1, 0, 0, 0, 0, 57, 1, 0, 0, 0,
+		0, 59, 1, 0, 0, 0, 0, 61, 1, 0, 0, 0, 0, 63, 1, 0, 0, 0, 0, 65, 1, 0, 0,
+		0, 0, 67, 1, 0, 0, 0, 0, 69, 1, 0, 0, 0, 0, 71, 1, 0, 0, 0, 0, 73, 1, 0,
+		0, 0, 0, 75, 1, 0, 0, 0, 0, 77, 1, 0, 0, 0, 0, 79, 1, 0, 0, 0, 0, 81, 1,
+		0, 0, 0, 0, 83, 1, 0, 0, 0, 0, 85, 1, 0, 0, 0, 0, 87, 1, 0, 0, 0, 0, 89,
+		1, 0, 0, 0, 0, 91, 1, 0, 0, 0, 0, 93, 1, 0, 0, 0, 0, 95, 1, 0, 0, 0, 0,
+		97, 1, 0, 0, 0, 0, 99, 1, 0, 0, 0, 0, 101, 1, 0, 0, 0, 0, 103, 1, 0, 0,
+		0, 0, 105, 1, 0, 0, 0, 0, 107, 1, 0, 0, 0, 0, 109, 1, 0, 0, 0, 0, 111,
+		1, 0, 0, 0, 0, 113, 1, 0, 0, 0, 0, 115, 1, 0, 0, 0, 0, 117, 1, 0, 0, 0,
+		0, 119, 1, 0, 0, 0, 0, 121, 1, 0, 0, 0, 0, 123, 1, 0, 0, 0, 0, 125, 1,
+		0, 0, 0, 0, 127, 1, 0, 0, 0, 0, 129, 1, 0, 0, 0, 0, 131, 1, 0, 0, 0, 0,
+		133, 1, 0, 0, 0, 0, 135, 1, 0, 0, 0, 0, 137, 1, 0, 0, 0, 0, 139, 1, 0,
+		0, 0, 0, 141, 1, 0, 0, 0, 0, 143, 1, 0, 0, 0, 0, 145, 1, 0, 0, 0, 0, 147,
+		1, 0, 0, 0, 0, 149, 1, 0, 0, 0, 0, 151, 1, 0, 0, 0, 0, 153, 1, 0, 0, 0,
+		0, 155, 1, 0, 0, 0, 0, 157, 1, 0, 0, 0, 0, 159, 1, 0, 0, 0, 0, 161, 1,
+		0, 0, 0, 0, 163, 1, 0, 0, 0, 0, 165, 1, 0, 0, 0, 0, 167, 1, 0, 0, 0, 0,
+		169, 1, 0, 0, 0, 0, 171, 1, 0, 0, 0, 0, 173, 1, 0, 0, 0, 0, 175, 1, 0,
+		0, 0, 0, 177, 1, 0, 0, 0, 0, 179, 1, 0, 0, 0, 0, 181, 1, 0, 0, 0, 0, 183,
+		1, 0, 0, 0, 0, 185, 1, 0, 0, 0, 1, 227, 1, 0, 0, 0, 3, 233, 1, 0, 0, 0,
+		5, 238, 1, 0, 0, 0, 7, 243, 1, 0, 0, 0, 9, 247, 1, 0, 0, 0, 11, 250, 1,
+		0, 0, 0, 13, 257, 1, 0, 0, 0, 15, 263, 1, 0, 0, 0, 17, 269, 1, 0, 0, 0,
+		19, 275, 1, 0, 0, 0, 21, 281, 1, 0, 0, 0, 23, 290, 1, 0, 0, 0, 25, 294,
+		1, 0, 0, 0, 27, 298, 1, 0, 0, 0, 29, 303, 1, 0, 0, 0, 31, 308, 1, 0, 0,
+		0, 33, 315, 1, 0, 0, 0, 35, 323, 1, 0, 0, 0, 37, 327, 1, 0, 0, 0, 39, 332,
+		1, 0, 0, 0, 41, 339, 1, 0, 0, 0, 43, 342, 1, 0, 0, 0, 45, 349, 1, 0, 0,
+		0, 47, 352, 1, 0, 0, 0, 49, 355, 1, 0, 0, 0, 51, 362, 1, 0, 0, 0, 53, 371,
+		1, 0, 0, 0, 55, 375, 1, 0, 0, 0, 57, 378, 1, 0, 0, 0, 59, 383, 1, 0, 0,
+		0, 61, 389, 1, 0, 0, 0, 63, 396, 1, 0, 0, 0, 65, 400, 1, 0, 0, 0, 67, 406,
+		1, 0, 0, 0, 69, 411, 1, 0, 0, 0, 71, 417, 1, 0, 0, 0, 73, 423, 1, 0, 0,
+		0, 75, 429, 1, 0, 0, 0, 77, 438, 1, 0, 0, 0, 79, 440, 1, 0, 0, 0, 81, 444,
+		1, 0, 0, 0, 83, 446, 1, 0, 0, 0, 85, 448, 1, 0, 0, 0, 87, 450, 1, 0, 0,
+		0, 89, 452, 1, 0, 0, 0, 91, 454, 1, 0, 0, 0, 93, 457, 1, 0, 0, 0, 95, 459,
+		1, 0, 0, 0, 97, 461, 1, 0, 0, 0, 99, 464, 1, 0, 0, 0, 101, 466, 1, 0, 0,
+		0, 103, 468, 1, 0, 0, 0, 105, 470, 1, 0, 0, 0, 107, 472, 1, 0, 0, 0, 109,
+		474, 1, 0, 0, 0, 111, 476, 1, 0, 0, 0, 113, 479, 1, 0, 0, 0, 115, 482,
+		1, 0, 0, 0, 117, 484, 1, 0, 0, 0, 119, 486, 1, 0, 0, 0, 121, 488, 1, 0,
+		0, 0, 123, 490, 1, 0, 0, 0, 125, 493, 1, 0, 0, 0, 127, 495, 1, 0, 0, 0,
+		129, 497, 1, 0, 0, 0, 131, 499, 1, 0, 0, 0, 133, 501, 1, 0, 0, 0, 135,
+		503, 1, 0, 0, 0, 137, 506, 1, 0, 0, 0, 139, 509, 1, 0, 0, 0, 141, 512,
+		1, 0, 0, 0, 143, 515, 1, 0, 0, 0, 145, 518, 1, 0, 0, 0, 147, 520, 1, 0,
+		0, 0, 149, 523, 1, 0, 0, 0, 151, 526, 1, 0, 0, 0, 153, 529, 1, 0, 0, 0,
+		155, 532, 1, 0, 0, 0, 157, 535, 1, 0, 0, 0, 159, 538, 1, 0, 0, 0, 161,
+		541, 1, 0, 0, 0, 163, 544, 1, 0, 0, 0, 165, 547, 1, 0, 0, 0, 167, 550,
+		1, 0, 0, 0, 169, 554, 1, 0, 0, 0, 171, 558, 1, 0, 0, 0, 173, 562, 1, 0,
+		0, 0, 175, 571, 1, 0, 0, 0, 177, 579, 1, 0, 0, 0, 179, 587, 1, 0, 0, 0,
+		181, 593, 1, 0, 0, 0, 183, 609, 1, 0, 0, 0, 185, 618, 1, 0, 0, 0, 187,
+		625, 1, 0, 0, 0, 189, 645, 1, 0, 0, 0, 191, 673, 1, 0, 0, 0, 193, 677,
+		1, 0, 0, 0, 195, 684, 1, 0, 0, 0, 197, 690, 1, 0, 0, 0, 199, 716, 1, 0,
+		0, 0, 201, 718, 1, 0, 0, 0, 203, 728, 1, 0, 0, 0, 205, 738, 1, 0, 0, 0,
+		207, 750, 1, 0, 0, 0, 209, 759, 1, 0, 0, 0, 211, 763, 1, 0, 0, 0, 213,
+		769, 1, 0, 0, 0, 215, 773, 1, 0, 0, 0, 217, 783, 1, 0, 0, 0, 219, 794,
+		1, 0, 0, 0, 221, 808, 1, 0, 0, 0, 223, 810, 1, 0, 0, 0, 225, 812, 1, 0,
+		0, 0, 227, 228, 5, 70, 0, 0, 228, 229, 5, 97, 0, 0, 229, 230, 5, 108, 0,
+		0, 230, 231, 5, 115, 0, 0, 231, 232, 5, 101, 0, 0, 232, 2, 1, 0, 0, 0,
+		233, 234, 5, 78, 0, 0, 234, 235, 5, 111, 0, 0, 235, 236, 5, 110, 0, 0,
+		236, 237, 5, 101, 0, 0, 237, 4, 1, 0, 0, 0, 238, 239, 5, 84, 0, 0, 239,
+		240, 5, 114, 0, 0, 240, 241, 5, 117, 0, 0, 241, 242, 5, 101, 0, 0, 242,
+		6, 1, 0, 0, 0, 243, 244, 5, 97, 0, 0, 244, 245, 5, 110, 0, 0, 245, 246,
+		5, 100, 0, 0, 246, 8, 1, 0, 0, 0, 247, 248, 5, 97, 0, 0, 248, 249, 5, 115,
+		0, 0, 249, 10, 1, 0, 0, 0, 250, 251, 5, 97, 0, 0, 251, 252, 5, 115, 0,
+		0, 252, 253, 5, 115, 0, 0, 253, 254, 5, 101, 0, 0, 254, 255, 5, 114, 0,
+		0, 255, 256, 5, 116, 0, 0, 256, 12, 1, 0, 0, 0, 257, 258, 5, 97, 0, 0,
+		258, 259, 5, 115, 0, 0, 259, 260, 5, 121, 0, 0, 260, 261, 5, 110, 0, 0,
+		261, 262, 5, 99, 0, 0, 262, 14, 1, 0, 0, 0, 263, 264, 5, 97, 0, 0, 264,
+		265, 5, 119, 0, 0, 265, 266, 5, 97, 0, 0, 266, 267, 5, 105, 0, 0, 267,
+		268, 5, 116, 0, 0, 268, 16, 1, 0, 0, 0, 269, 270, 5, 98, 0, 0, 270, 271,
+		5, 114, 0, 0, 271, 272, 5, 101, 0, 0, 272, 273, 5, 97, 0, 0, 273, 274,
+		5, 107, 0, 0, 274, 18, 1, 0, 0, 0, 275, 276, 5, 99, 0, 0, 276, 277, 5,
+		108, 0, 0, 277, 278, 5, 97, 0, 0, 278, 279, 5, 115, 0, 0, 279, 280, 5,
+		115, 0, 0, 280, 20, 1, 0, 0, 0, 281, 282, 5, 99, 0, 0, 282, 283, 5, 111,
+		0, 0, 283, 284, 5, 110, 0, 0, 284, 285, 5, 116, 0, 0, 285, 286, 5, 105,
+		0, 0, 286, 287, 5, 110, 0, 0, 287, 288, 5, 117, 0, 0, 288, 289, 5, 101,
+		0, 0, 289, 22, 1, 0, 0, 0, 290, 291, 5, 100, 0, 0, 291, 292, 5, 101, 0,
+		0, 292, 293, 5, 102, 0, 0, 293, 24, 1, 0, 0, 0, 294, 295, 5, 100, 0, 0,
+		295, 296, 5, 101, 0, 0, 296, 297, 5, 108, 0, 0, 297, 26, 1, 0, 0, 0, 298,
+		299, 5, 101, 0, 0, 299, 300, 5, 108, 0, 0, 300, 301, 5, 105, 0, 0, 301,
+		302, 5, 102, 0, 0, 302, 28, 1, 0, 0, 0, 303, 304, 5, 101, 0, 0, 304, 305,
+		5, 108, 0, 0, 305, 306, 5, 115, 0, 0, 306, 307, 5, 101, 0, 0, 307, 30,
+		1, 0, 0, 0, 308, 309, 5, 101, 0, 0, 309, 310, 5, 120, 0, 0, 310, 311, 5,
+		99, 0, 0, 311, 312, 5, 101, 0, 0, 312, 313, 5, 112, 0, 0, 313, 314, 5,
+		116, 0, 0, 314, 32, 1, 0, 0, 0, 315, 316, 5, 102, 0, 0, 316, 317, 5, 105,
+		0, 0, 317, 318, 5, 110, 0, 0, 318, 319, 5, 97, 0, 0, 319, 320, 5, 108,
+		0, 0, 320, 321, 5, 108, 0, 0, 321, 322, 5, 121, 0, 0, 322, 34, 1, 0, 0,
+		0, 323, 324, 5, 102, 0, 0, 324, 325, 5, 111, 0, 0, 325, 326, 5, 114, 0,
+		0, 326, 36, 1, 0, 0, 0, 327, 328, 5, 102, 0, 0, 328, 329, 5, 114, 0, 0,
+		329, 330, 5, 111, 0, 0, 330, 331, 5, 109, 0, 0, 331, 38, 1, 0, 0, 0, 332,
+		333, 5, 103, 0, 0, 333, 334, 5, 108, 0, 0, 334, 335, 5, 111, 0, 0, 335,
+		336, 5, 98, 0, 0, 336, 337, 5, 97, 0, 0, 337, 338, 5, 108, 0, 0, 338, 40,
+		1, 0, 0, 0, 339, 340, 5, 105, 0, 0, 340, 341, 5, 102, 0, 0, 341, 42, 1,
+		0, 0, 0, 342, 343, 5, 105, 0, 0, 343, 344, 5, 109, 0, 0, 344, 345, 5, 112,
+		0, 0, 345, 346, 5, 111, 0, 0, 346, 347, 5, 114, 0, 0, 347, 348, 5, 116,
+		0, 0, 348, 44, 1, 0, 0, 0, 349, 350, 5, 105, 0, 0, 350, 351, 5, 110, 0,
+		0, 351, 46, 1, 0, 0, 0, 352, 353, 5, 105, 0, 0, 353, 354, 5, 115, 0, 0,
+		354, 48, 1, 0, 0, 0, 355, 356, 5, 108, 0, 0, 356, 357, 5, 97, 0, 0, 357,
+		358, 5, 109, 0, 0, 358, 359, 5, 98, 0, 0, 359, 360, 5, 100, 0, 0, 360,
+		361, 5, 97, 0, 0, 361, 50, 1, 0, 0, 0, 362, 363, 5, 110, 0, 0, 363, 364,
+		5, 111, 0, 0, 364, 365, 5, 110, 0, 0, 365, 366, 5, 108, 0, 0, 366, 367,
+		5, 111, 0, 0, 367, 368, 5, 99, 0, 0, 368, 369, 5, 97, 0, 0, 369, 370, 5,
+		108, 0, 0, 370, 52, 1, 0, 0, 0, 371, 372, 5, 110, 0, 0, 372, 373, 5, 111,
+		0, 0, 373, 374, 5, 116, 0, 0, 374, 54, 1, 0, 0, 0, 375, 376, 5, 111, 0,
+		0, 376, 377, 5, 114, 0, 0, 377, 56, 1, 0, 0, 0, 378, 379, 5, 112, 0, 0,
+		379, 380, 5, 97, 0, 0, 380, 381, 5, 115, 0, 0, 381, 382, 5, 115, 0, 0,
+		382, 58, 1, 0, 0, 0, 383, 384, 5, 114, 0, 0, 384, 385, 5, 97, 0, 0, 385,
+		386, 5, 105, 0, 0, 386, 387, 5, 115, 0, 0, 387, 388, 5, 101, 0, 0, 388,
+		60, 1, 0, 0, 0, 389, 390, 5, 114, 0, 0, 390, 391, 5, 101, 0, 0, 391, 392,
+		5, 116, 0, 0, 392, 393, 5, 117, 0, 0, 393, 394, 5, 114, 0, 0, 394, 395,
+		5, 110, 0, 0, 395, 62, 1, 0, 0, 0, 396, 397, 5, 116, 0, 0, 397, 398, 5,
+		114, 0, 0, 398, 399, 5, 121, 0, 0, 399, 64, 1, 0, 0, 0, 400, 401, 5, 119,
+		0, 0, 401, 402, 5, 104, 0, 0, 402, 403, 5, 105, 0, 0, 403, 404, 5, 108,
+		0, 0, 404, 405, 5, 101, 0, 0, 405, 66, 1, 0, 0, 0, 406, 407, 5, 119, 0,
+		0, 407, 408, 5, 105, 0, 0, 408, 409, 5, 116, 0, 0, 409, 410, 5, 104, 0,
+		0, 410, 68, 1, 0, 0, 0, 411, 412, 5, 121, 0, 0, 412, 413, 5, 105, 0, 0,
+		413, 414, 5, 101, 0, 0, 414, 415, 5, 108, 0, 0, 415, 416, 5, 100, 0, 0,
+		416, 70, 1, 0, 0, 0, 417, 418, 5, 109, 0, 0, 418, 419, 5, 97, 0, 0, 419,
+		420, 5, 116, 0, 0, 420, 421, 5, 99, 0, 0, 421, 422, 5, 104, 0, 0, 422,
+		72, 1, 0, 0, 0, 423, 424, 5, 99, 0, 0, 424, 425, 5, 97, 0, 0, 425, 426,
+		5, 115, 0, 0, 426, 427, 5, 101, 0, 0, 427, 74, 1, 0, 0, 0, 428, 430, 3,
+		187, 93, 0, 429, 428, 1, 0, 0, 0, 429, 430, 1, 0, 0, 0, 430, 433, 1, 0,
+		0, 0, 431, 434, 3, 189, 94, 0, 432, 434, 3, 191, 95, 0, 433, 431, 1, 0,
+		0, 0, 433, 432, 1, 0, 0, 0, 434, 76, 1, 0, 0, 0, 435, 439, 3, 197, 98,
+		0, 436, 439, 3, 207, 103, 0, 437, 439, 3, 213, 106, 0, 438, 435, 1, 0,
+		0, 0, 438, 436, 1, 0, 0, 0, 438, 437, 1, 0, 0, 0, 439, 78, 1, 0, 0, 0,
+		440, 441, 5, 46, 0, 0, 441, 442, 5, 46, 0, 0, 442, 443, 5, 46, 0, 0, 443,
+		80, 1, 0, 0, 0, 444, 445, 5, 46, 0, 0, 445, 82, 1, 0, 0, 0, 446, 447, 5,
+		42, 0, 0, 447, 84, 1, 0, 0, 0, 448, 449, 5, 40, 0, 0, 449, 86, 1, 0, 0,
+		0, 450, 451, 5, 41, 0, 0, 451, 88, 1, 0, 0, 0, 452, 453, 5, 44, 0, 0, 453,
+		90, 1, 0, 0, 0, 454, 455, 5, 58, 0, 0, 455, 456, 5, 61, 0, 0, 456, 92,
+		1, 0, 0, 0, 457, 458, 5, 58, 0, 0, 458, 94, 1, 0, 0, 0, 459, 460, 5, 59,
+		0, 0, 460, 96, 1, 0, 0, 0, 461, 462, 5, 42, 0, 0, 462, 463, 5, 42, 0, 0,
+		463, 98, 1, 0, 0, 0, 464, 465, 5, 61, 0, 0, 465, 100, 1, 0, 0, 0, 466,
+		467, 5, 91, 0, 0, 467, 102, 1, 0, 0, 0, 468, 469, 5, 93, 0, 0, 469, 104,
+		1, 0, 0, 0, 470, 471, 5, 124, 0, 0, 471, 106, 1, 0, 0, 0, 472, 473, 5,
+		94, 0, 0, 473, 108, 1, 0, 0, 0, 474, 475, 5, 38, 0, 0, 475, 110, 1, 0,
+		0, 0, 476, 477, 5, 60, 0, 0, 477, 478, 5, 60, 0, 0, 478, 112, 1, 0, 0,
+		0, 479, 480, 5, 62, 0, 0, 480, 481, 5, 62, 0, 0, 481, 114, 1, 0, 0, 0,
+		482, 483, 5, 43, 0, 0, 483, 116, 1, 0, 0, 0, 484, 485, 5, 45, 0, 0, 485,
+		118, 1, 0, 0, 0, 486, 487, 5, 47, 0, 0, 487, 120, 1, 0, 0, 0, 488, 489,
+		5, 37, 0, 0, 489, 122, 1, 0, 0, 0, 490, 491, 5, 47, 0, 0, 491, 492, 5,
+		47, 0, 0, 492, 124, 1, 0, 0, 0, 493, 494, 5, 126, 0, 0, 494, 126, 1, 0,
+		0, 0, 495, 496, 5, 123, 0, 0, 496, 128, 1, 0, 0, 0, 497, 498, 5, 125, 0,
+		0, 498, 130, 1, 0, 0, 0, 499, 500, 5, 60, 0, 0, 500, 132, 1, 0, 0, 0, 501,
+		502, 5, 62, 0, 0, 502, 134, 1, 0, 0, 0, 503, 504, 5, 61, 0, 0, 504, 505,
+		5, 61, 0, 0, 505, 136, 1, 0, 0, 0, 506, 507, 5, 62, 0, 0, 507, 508, 5,
+		61, 0, 0, 508, 138, 1, 0, 0, 0, 509, 510, 5, 60, 0, 0, 510, 511, 5, 61,
+		0, 0, 511, 140, 1, 0, 0, 0, 512, 513, 5, 60, 0, 0, 513, 514, 5, 62, 0,
+		0, 514, 142, 1, 0, 0, 0, 515, 516, 5, 33, 0, 0, 516, 517, 5, 61, 0, 0,
+		517, 144, 1, 0, 0, 0, 518, 519, 5, 64, 0, 0, 519, 146, 1, 0, 0, 0, 520,
+		521, 5, 45, 0, 0, 521, 522, 5, 62, 0, 0, 522, 148, 1, 0, 0, 0, 523, 524,
+		5, 43, 0, 0, 524, 525, 5, 61, 0, 0, 525, 150, 1, 0, 0, 0, 526, 527, 5,
+		45, 0, 0, 527, 528, 5, 61, 0, 0, 528, 152, 1, 0, 0, 0, 529, 530, 5, 42,
+		0, 0, 530, 531, 5, 61, 0, 0, 531, 154, 1, 0, 0, 0, 532, 533, 5, 64, 0,
+		0, 533, 534, 5, 61, 0, 0, 534, 156, 1, 0, 0, 0, 535, 536, 5, 47, 0, 0,
+		536, 537, 5, 61, 0, 0, 537, 158, 1, 0, 0, 0, 538, 539, 5, 37, 0, 0, 539,
+		540, 5, 61, 0, 0, 540, 160, 1, 0, 0, 0, 541, 542, 5, 38, 0, 0, 542, 543,
+		5, 61, 0, 0, 543, 162, 1, 0, 0, 0, 544, 545, 5, 124, 0, 0, 545, 546, 5,
+		61, 0, 0, 546, 164, 1, 0, 0, 0, 547, 548, 5, 94, 0, 0, 548, 549, 5, 61,
+		0, 0, 549, 166, 1, 0, 0, 0, 550, 551, 5, 60, 0, 0, 551, 552, 5, 60, 0,
+		0, 552, 553, 5, 61, 0, 0, 553, 168, 1, 0, 0, 0, 554, 555, 5, 62, 0, 0,
+		555, 556, 5, 62, 0, 0, 556, 557, 5, 61, 0, 0, 557, 170, 1, 0, 0, 0, 558,
+		559, 5, 42, 0, 0, 559, 560, 5, 42, 0, 0, 560, 561, 5, 61, 0, 0, 561, 172,
+		1, 0, 0, 0, 562, 563, 5, 47, 0, 0, 563, 564, 5, 47, 0, 0, 564, 565, 5,
+		61, 0, 0, 565, 174, 1, 0, 0, 0, 566, 568, 5, 13, 0, 0, 567, 566, 1, 0,
+		0, 0, 567, 568, 1, 0, 0, 0, 568, 569, 1, 0, 0, 0, 569, 572, 5, 10, 0, 0,
+		570, 572, 2, 12, 13, 0, 571, 567, 1, 0, 0, 0, 571, 570, 1, 0, 0, 0, 572,
+		576, 1, 0, 0, 0, 573, 575, 7, 0, 0, 0, 574, 573, 1, 0, 0, 0, 575, 578,
+		1, 0, 0, 0, 576, 574, 1, 0, 0, 0, 576, 577, 1, 0, 0, 0, 577, 176, 1, 0,
+		0, 0, 578, 576, 1, 0, 0, 0, 579, 583, 3, 223, 111, 0, 580, 582, 3, 225,
+		112, 0, 581, 580, 1, 0, 0, 0, 582, 585, 1, 0, 0, 0, 583, 581, 1, 0, 0,
+		0, 583, 584, 1, 0, 0, 0, 584, 178, 1, 0, 0, 0, 585, 583, 1, 0, 0, 0, 586,
+		588, 7, 0, 0, 0, 587, 586, 1, 0, 0, 0, 588, 589, 1, 0, 0, 0, 589, 587,
+		1, 0, 0, 0, 589, 590, 1, 0, 0, 0, 590, 591, 1, 0, 0, 0, 591, 592, 6, 89,
+		0, 0, 592, 180, 1, 0, 0, 0, 593, 597, 5, 92, 0, 0, 594, 596, 7, 1, 0, 0,
+		595, 594, 1, 0, 0, 0, 596, 599, 1, 0, 0, 0, 597, 595, 1, 0, 0, 0, 597,
+		598, 1, 0, 0, 0, 598, 605, 1, 0, 0, 0, 599, 597, 1, 0, 0, 0, 600, 602,
+		5, 13, 0, 0, 601, 600, 1, 0, 0, 0, 601, 602, 1, 0, 0, 0, 602, 603, 1, 0,
+		0, 0, 603, 606, 5, 10, 0, 0, 604, 606, 5, 13, 0, 0, 605, 601, 1, 0, 0,
+		0, 605, 604, 1, 0, 0, 0, 606, 607, 1, 0, 0, 0, 607, 608, 6, 90, 0, 0, 608,
+		182, 1, 0, 0, 0, 609, 613, 5, 35, 0, 0, 610, 612, 8, 2, 0, 0, 611, 610,
+		1, 0, 0, 0, 612, 615, 1, 0, 0, 0, 613, 611, 1, 0, 0, 0, 613, 614, 1, 0,
+		0, 0, 614, 616, 1, 0, 0, 0, 615, 613, 1, 0, 0, 0, 616, 617, 6, 91, 0, 0,
+		617, 184, 1, 0, 0, 0, 618, 619, 9, 0, 0, 0, 619, 186, 1, 0, 0, 0, 620,
+		626, 7, 3, 0, 0, 621, 622, 7, 4, 0, 0, 622, 626, 7, 5, 0, 0, 623, 624,
+		7, 5, 0, 0, 624, 626, 7, 4, 0, 0, 625, 620, 1, 0, 0, 0, 625, 621, 1, 0,
+		0, 0, 625, 623, 1, 0, 0, 0, 626, 188, 1, 0, 0, 0, 627, 632, 5, 39, 0, 0,
+		628, 631, 3, 195, 97, 0, 629, 631, 8, 6, 0, 0, 630, 628, 1, 0, 0, 0, 630,
+		629, 1, 0, 0, 0, 631, 634, 1, 0, 0, 0, 632, 630, 1, 0, 0, 0, 632, 633,
+		1, 0, 0, 0, 633, 635, 1, 0, 0, 0, 634, 632, 1, 0, 0, 0, 635, 646, 5, 39,
+		0, 0, 636, 641, 5, 34, 0, 0, 637, 640, 3, 195, 97, 0, 638, 640, 8, 7, 0,
+		0, 639, 637, 1, 0, 0, 0, 639, 638, 1, 0, 0, 0, 640, 643, 1, 0, 0, 0, 641,
+		639, 1, 0, 0, 0, 641, 642, 1, 0, 0, 0, 642, 644, 1, 0, 0, 0, 643, 641,
+		1, 0, 0, 0, 644, 646, 5, 34, 0, 0, 645, 627, 1, 0, 0, 0, 645, 636, 1, 0,
+		0, 0, 646, 190, 1, 0, 0, 0, 647, 648, 5, 39, 0, 0, 648, 649, 5, 39, 0,
+		0, 649, 650, 5, 39, 0, 0, 650, 654, 1, 0, 0, 0, 651, 653, 3, 193, 96, 0,
+		652, 651, 1, 0, 0, 0, 653, 656, 1, 0, 0, 0, 654, 655, 1, 0, 0, 0, 654,
+		652, 1, 0, 0, 0, 655, 657, 1, 0, 0, 0, 656, 654, 1, 0, 0, 0, 657, 658,
+		5, 39, 0, 0, 658, 659, 5, 39, 0, 0, 659, 674, 5, 39, 0, 0, 660, 661, 5,
+		34, 0, 0, 661, 662, 5, 34, 0, 0, 662, 663, 5, 34, 0, 0, 663, 667, 1, 0,
+		0, 0, 664, 666, 3, 193, 96, 0, 665, 664, 1, 0, 0, 0, 666, 669, 1, 0, 0,
+		0, 667, 668, 1, 0, 0, 0, 667, 665, 1, 0, 0, 0, 668, 670, 1, 0, 0, 0, 669,
+		667, 1, 0, 0, 0, 670, 671, 5, 34, 0, 0, 671, 672, 5, 34, 0, 0, 672, 674,
+		5, 34, 0, 0, 673, 647, 1, 0, 0, 0, 673, 660, 1, 0, 0, 0, 674, 192, 1, 0,
+		0, 0, 675, 678, 8, 8, 0, 0, 676, 678, 3, 195, 97, 0, 677, 675, 1, 0, 0,
+		0, 677, 676, 1, 0, 0, 0, 678, 194, 1, 0, 0, 0, 679, 680, 5, 92, 0, 0, 680,
+		681, 5, 13, 0, 0, 681, 685, 5, 10, 0, 0, 682, 683, 5, 92, 0, 0, 683, 685,
+		9, 0, 0, 0, 684, 679, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 685, 196, 1, 0,
+		0, 0, 686, 691, 3, 199, 99, 0, 687, 691, 3, 201, 100, 0, 688, 691, 3, 203,
+		101, 0, 689, 691, 3, 205, 102, 0, 690, 686, 1, 0, 0, 0, 690, 687, 1, 0,
+		0, 0, 690, 688, 1, 0, 0, 0, 690, 689, 1, 0, 0, 0, 691, 198, 1, 0, 0, 0,
+		692, 699, 7, 9, 0, 0, 693, 695, 5, 95, 0, 0, 694, 693, 1, 0, 0, 0, 694,
+		695, 1, 0, 0, 0, 695, 696, 1, 0, 0, 0, 696, 698, 3, 221, 110, 0, 697, 694,
+		1, 0, 0, 0, 698, 701, 1, 0, 0, 0, 699, 697, 1, 0, 0, 0, 699, 700, 1, 0,
+		0, 0, 700, 717, 1, 0, 0, 0, 701, 699, 1, 0, 0, 0, 702, 704, 5, 48, 0, 0,
+		703, 702, 1, 0, 0, 0, 704, 705, 1, 0, 0, 0, 705, 703, 1, 0, 0, 0, 705,
+		706, 1, 0, 0, 0, 706, 713, 1, 0, 0, 0, 707, 709, 5, 95, 0, 0, 708, 707,
+		1, 0, 0, 0, 708, 709, 1, 0, 0, 0, 709, 710, 1, 0, 0, 0, 710, 712, 5, 48,
+		0, 0, 711, 708, 1, 0, 0, 0, 712, 715, 1, 0, 0, 0, 713, 711, 1, 0, 0, 0,
+		713, 714, 1, 0, 0, 0, 714, 717, 1, 0, 0, 0, 715, 713, 1, 0, 0, 0, 716,
+		692, 1, 0, 0, 0, 716, 703, 1, 0, 0, 0, 717, 200, 1, 0, 0, 0, 718, 719,
+		5, 48, 0, 0, 719, 724, 7, 10, 0, 0, 720, 722, 5, 95, 0, 0, 721, 720, 1,
+		0, 0, 0, 721, 722, 1, 0, 0, 0, 722, 723, 1, 0, 0, 0, 723, 725, 7, 11, 0,
+		0, 724, 721, 1, 0, 0, 0, 725, 726, 1, 0, 0, 0, 726, 724, 1, 0, 0, 0, 726,
+		727, 1, 0, 0, 0, 727, 202, 1, 0, 0, 0, 728, 729, 5, 48, 0, 0, 729, 734,
+		7, 12, 0, 0, 730, 732, 5, 95, 0, 0, 731, 730, 1, 0, 0, 0, 731, 732, 1,
+		0, 0, 0, 732, 733, 1, 0, 0, 0, 733, 735, 7, 13, 0, 0, 734, 731, 1, 0, 0,
+		0, 735, 736, 1, 0, 0, 0, 736, 734, 1, 0, 0, 0, 736, 737, 1, 0, 0, 0, 737,
+		204, 1, 0, 0, 0, 738, 739, 5, 48, 0, 0, 739, 744, 7, 14, 0, 0, 740, 742,
+		5, 95, 0, 0, 741, 740, 1, 0, 0, 0, 741, 742, 1, 0, 0, 0, 742, 743, 1, 0,
+		0, 0, 743, 745, 7, 15, 0, 0, 744, 741, 1, 0, 0, 0, 745, 746, 1, 0, 0, 0,
+		746, 744, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 206, 1, 0, 0, 0, 748,
+		751, 3, 209, 104, 0, 749, 751, 3, 211, 105, 0, 750, 748, 1, 0, 0, 0, 750,
+		749, 1, 0, 0, 0, 751, 208, 1, 0, 0, 0, 752, 754, 3, 215, 107, 0, 753, 752,
+		1, 0, 0, 0, 753, 754, 1, 0, 0, 0, 754, 755, 1, 0, 0, 0, 755, 760, 3, 217,
+		108, 0, 756, 757, 3, 215, 107, 0, 757, 758, 5, 46, 0, 0, 758, 760, 1, 0,
+		0, 0, 759, 753, 1, 0, 0, 0, 759, 756, 1, 0, 0, 0, 760, 210, 1, 0, 0, 0,
+		761, 764, 3, 215, 107, 0, 762, 764, 3, 209, 104, 0, 763, 761, 1, 0, 0,
+		0, 763, 762, 1, 0, 0, 0, 764, 765, 1, 0, 0, 0, 765, 766, 3, 219, 109, 0,
+		766, 212, 1, 0, 0, 0, 767, 770, 3, 207, 103, 0, 768, 770, 3, 215, 107,
+		0, 769, 767, 1, 0, 0, 0, 769, 768, 1, 0, 0, 0, 770, 771, 1, 0, 0, 0, 771,
+		772, 7, 16, 0, 0, 772, 214, 1, 0, 0, 0, 773, 780, 3, 221, 110, 0, 774,
+		776, 5, 95, 0, 0, 775, 774, 1, 0, 0, 0, 775, 776, 1, 0, 0, 0, 776, 777,
+		1, 0, 0, 0, 777, 779, 3, 221, 110, 0, 778, 775, 1, 0, 0, 0, 779, 782, 1,
+		0, 0, 0, 780, 778, 1, 0, 0, 0, 780, 781, 1, 0, 0, 0, 781, 216, 1, 0, 0,
+		0, 782, 780, 1, 0, 0, 0, 783, 784, 5, 46, 0, 0, 784, 791, 3, 221, 110,
+		0, 785, 787, 5, 95, 0, 0, 786, 785, 1, 0, 0, 0, 786, 787, 1, 0, 0, 0, 787,
+		788, 1, 0, 0, 0, 788, 790, 3, 221, 110, 0, 789, 786, 1, 0, 0, 0, 790, 793,
+		1, 0, 0, 0, 791, 789, 1, 0, 0, 0, 791, 792, 1, 0, 0, 0, 792, 218, 1, 0,
+		0, 0, 793, 791, 1, 0, 0, 0, 794, 796, 7, 17, 0, 0, 795, 797, 7, 18, 0,
+		0, 796, 795, 1, 0, 0, 0, 796, 797, 1, 0, 0, 0, 797, 798, 1, 0, 0, 0, 798,
+		805, 3, 221, 110, 0, 799, 801, 5, 95, 0, 0, 800, 799, 1, 0, 0, 0, 800,
+		801, 1, 0, 0, 0, 801, 802, 1, 0, 0, 0, 802, 804, 3, 221, 110, 0, 803, 800,
+		1, 0, 0, 0, 804, 807, 1, 0, 0, 0, 805, 803, 1, 0, 0, 0, 805, 806, 1, 0,
+		0, 0, 806, 220, 1, 0, 0, 0, 807, 805, 1, 0, 0, 0, 808, 809, 7, 19, 0, 0,
+		809, 222, 1, 0, 0, 0, 810, 811, 7, 20, 0, 0, 811, 224, 1, 0, 0, 0, 812,
+		813, 7, 21, 0, 0, 813, 226, 1, 0, 0, 0, 49, 0, 429, 433, 438, 567, 571,
+		576, 583, 589, 597, 601, 605, 613, 625, 630, 632, 639, 641, 645, 654, 667,
+		673, 677, 684, 690, 694, 699, 705, 708, 713, 716, 721, 726, 731, 736, 741,
+		746, 750, 753, 759, 763, 769, 775, 780, 786, 791, 796, 800, 805, 1, 0,
+		1, 0,
+	}
+	deserializer := antlr.NewATNDeserializer(nil)
+	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
+	atn := staticData.atn
+	staticData.decisionToDFA = make([]*antlr.DFA, len(atn.DecisionToState))
+	decisionToDFA := staticData.decisionToDFA
+	for index, state := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(state, index)
+	}
+}
+
+// PythonLexerInit initializes any static state used to implement PythonLexer. By default the
+// static state used to implement the lexer is lazily initialized during the first call to
+// NewPythonLexer(). You can call this function if you wish to initialize the static state ahead
+// of time.
+func PythonLexerInit() {
+	staticData := &pythonlexerLexerStaticData
+	staticData.once.Do(pythonlexerLexerInit)
+}
+
+// NewPythonLexer produces a new lexer instance for the optional input antlr.CharStream.
+func NewPythonLexer(input antlr.CharStream) *PythonLexer {
+	PythonLexerInit()
+	l := new(PythonLexer)
+	l.BaseLexer = antlr.NewBaseLexer(input)
+	staticData := &pythonlexerLexerStaticData
+	l.Interpreter = antlr.NewLexerATNSimulator(l, staticData.atn, staticData.decisionToDFA, staticData.predictionContextCache)
+	l.channelNames = staticData.channelNames
+	l.modeNames = staticData.modeNames
+	l.RuleNames = staticData.ruleNames
+	l.LiteralNames = staticData.literalNames
+	l.SymbolicNames = staticData.symbolicNames
+	l.GrammarFileName = "PythonLexer.g4"
+	// TODO: l.EOF = antlr.TokenEOF
+
+	return l
+}
+
+// PythonLexer tokens.
+const (
+	PythonLexerINDENT             = 1
+	PythonLexerDEDENT             = 2
+	PythonLexerFALSE              = 3
+	PythonLexerNONE               = 4
+	PythonLexerTRUE               = 5
+	PythonLexerAND                = 6
+	PythonLexerAS                 = 7
+	PythonLexerASSERT             = 8
+	PythonLexerASYNC              = 9
+	PythonLexerAWAIT              = 10
+	PythonLexerBREAK              = 11
+	PythonLexerCLASS              = 12
+	PythonLexerCONTINUE           = 13
+	PythonLexerDEF                = 14
+	PythonLexerDEL                = 15
+	PythonLexerELIF               = 16
+	PythonLexerELSE               = 17
+	PythonLexerEXCEPT             = 18
+	PythonLexerFINALLY            = 19
+	PythonLexerFOR                = 20
+	PythonLexerFROM               = 21
+	PythonLexerGLOBAL             = 22
+	PythonLexerIF                 = 23
+	PythonLexerIMPORT             = 24
+	PythonLexerIN                 = 25
+	PythonLexerIS                 = 26
+	PythonLexerLAMBDA             = 27
+	PythonLexerNONLOCAL           = 28
+	PythonLexerNOT                = 29
+	PythonLexerOR                 = 30
+	PythonLexerPASS               = 31
+	PythonLexerRAISE              = 32
+	PythonLexerRETURN             = 33
+	PythonLexerTRY                = 34
+	PythonLexerWHILE              = 35
+	PythonLexerWITH               = 36
+	PythonLexerYIELD              = 37
+	PythonLexerMATCH              = 38
+	PythonLexerCASE               = 39
+	PythonLexerSTRING             = 40
+	PythonLexerNUMBER             = 41
+	PythonLexerELLIPSIS           = 42
+	PythonLexerDOT                = 43
+	PythonLexerSTAR               = 44
+	PythonLexerOPEN_PAREN         = 45
+	PythonLexerCLOSE_PAREN        = 46
+	PythonLexerCOMMA              = 47
+	PythonLexerWALRUS             = 48
+	PythonLexerCOLON              = 49
+	PythonLexerSEMI_COLON         = 50
+	PythonLexerPOWER              = 51
+	PythonLexerASSIGN             = 52
+	PythonLexerOPEN_BRACKET       = 53
+	PythonLexerCLOSE_BRACKET      = 54
+	PythonLexerOR_OP              = 55
+	PythonLexerXOR                = 56
+	PythonLexerAND_OP             = 57
+	PythonLexerLEFT_SHIFT         = 58
+	PythonLexerRIGHT_SHIFT        = 59
+	PythonLexerADD                = 60
+	PythonLexerMINUS              = 61
+	PythonLexerDIV                = 62
+	PythonLexerMOD                = 63
+	PythonLexerIDIV               = 64
+	PythonLexerNOT_OP             = 65
+	PythonLexerOPEN_BRACE         = 66
+	PythonLexerCLOSE_BRACE        = 67
+	PythonLexerLESS_THAN          = 68
+	PythonLexerGREATER_THAN       = 69
+	PythonLexerEQUALS             = 70
+	PythonLexerGT_EQ              = 71
+	PythonLexerLT_EQ              = 72
+	PythonLexerNOT_EQ_1           = 73
+	PythonLexerNOT_EQ_2           = 74
+	PythonLexerAT                 = 75
+	PythonLexerARROW              = 76
+	PythonLexerADD_ASSIGN         = 77
+	PythonLexerSUB_ASSIGN         = 78
+	PythonLexerMULT_ASSIGN        = 79
+	PythonLexerAT_ASSIGN          = 80
+	PythonLexerDIV_ASSIGN         = 81
+	PythonLexerMOD_ASSIGN         = 82
+	PythonLexerAND_ASSIGN         = 83
+	PythonLexerOR_ASSIGN          = 84
+	PythonLexerXOR_ASSIGN         = 85
+	PythonLexerLEFT_SHIFT_ASSIGN  = 86
+	PythonLexerRIGHT_SHIFT_ASSIGN = 87
+	PythonLexerPOWER_ASSIGN       = 88
+	PythonLexerIDIV_ASSIGN        = 89
+	PythonLexerNEWLINE            = 90
+	PythonLexerNAME               = 91
+	PythonLexerWS                 = 92
+	PythonLexerLINE_JOINING       = 93
+	PythonLexerCOMMENT            = 94
+	PythonLexerERROR_CHAR         = 95
+)
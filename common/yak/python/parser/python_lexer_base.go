@@ -0,0 +1,121 @@
+package pythonparser
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+// tabSize is the tab stop of python indentation
+const tabSize = 8
+
+// PythonLexerBase turn the line break of lexer into NEWLINE / INDENT / DEDENT tokens:
+//   - line break inside brackets, blank line and comment line are dropped
+//   - the indentation after NEWLINE is compared with the indent stack to emit INDENT / DEDENT
+//   - NEWLINE and DEDENT are appended before EOF to close the last statement and blocks
+type PythonLexerBase struct {
+	*antlr.BaseLexer
+
+	// opened is the depth of brackets '(' '[' '{'
+	opened int
+	// indents is the stack of indentation of the open blocks
+	indents []int
+	// pending is the tokens emitted by one NEWLINE or EOF
+	pending []antlr.Token
+	// lastType is the type of last token in default channel
+	lastType int
+}
+
+func (p *PythonLexerBase) NextToken() antlr.Token {
+	if len(p.pending) > 0 {
+		token := p.pending[0]
+		p.pending = p.pending[1:]
+		return p.record(token)
+	}
+
+	token := p.BaseLexer.NextToken()
+	switch token.GetTokenType() {
+	case PythonLexerOPEN_PAREN, PythonLexerOPEN_BRACKET, PythonLexerOPEN_BRACE:
+		p.opened++
+	case PythonLexerCLOSE_PAREN, PythonLexerCLOSE_BRACKET, PythonLexerCLOSE_BRACE:
+		if p.opened > 0 {
+			p.opened--
+		}
+	case PythonLexerNEWLINE:
+		if p.opened > 0 || p.lastType == 0 || p.lastType == PythonLexerNEWLINE {
+			// implicit line joining, or nothing before this line
+			return p.NextToken()
+		}
+		switch p.GetInputStream().LA(1) {
+		case '\r', '\n', '\f', '#', antlr.TokenEOF:
+			// blank line or comment line, EOF will close the statement
+			return p.NextToken()
+		}
+		p.onNewLine(token)
+		return p.NextToken()
+	case antlr.TokenEOF:
+		if p.lastType != 0 && p.lastType != PythonLexerNEWLINE {
+			p.pending = append(p.pending, p.newToken(PythonLexerNEWLINE))
+		}
+		for len(p.indents) > 0 {
+			p.indents = p.indents[:len(p.indents)-1]
+			p.pending = append(p.pending, p.newToken(PythonLexerDEDENT))
+		}
+		if len(p.pending) > 0 {
+			p.pending = append(p.pending, token)
+			return p.NextToken()
+		}
+	}
+	return p.record(token)
+}
+
+func (p *PythonLexerBase) record(token antlr.Token) antlr.Token {
+	if token.GetChannel() == antlr.TokenDefaultChannel {
+		p.lastType = token.GetTokenType()
+	}
+	return token
+}
+
+// onNewLine queue NEWLINE and the INDENT / DEDENT by indentation of next line
+func (p *PythonLexerBase) onNewLine(token antlr.Token) {
+	p.pending = append(p.pending, token)
+
+	indent := indentation(token.GetText())
+	previous := 0
+	if len(p.indents) > 0 {
+		previous = p.indents[len(p.indents)-1]
+	}
+	switch {
+	case indent > previous:
+		p.indents = append(p.indents, indent)
+		p.pending = append(p.pending, p.newToken(PythonLexerINDENT))
+	case indent < previous:
+		for len(p.indents) > 0 && p.indents[len(p.indents)-1] > indent {
+			p.indents = p.indents[:len(p.indents)-1]
+			p.pending = append(p.pending, p.newToken(PythonLexerDEDENT))
+		}
+	}
+}
+
+// newToken create an empty token at the current position of lexer
+func (p *PythonLexerBase) newToken(typ int) antlr.Token {
+	index := p.GetInputStream().Index()
+	return antlr.NewCommonToken(p.GetTokenSourceCharStreamPair(), typ, antlr.TokenDefaultChannel, index, index-1)
+}
+
+// indentation calculate the column of the spaces after line break
+func indentation(text string) int {
+	text = strings.TrimLeft(text, "\r\n\f")
+	count := 0
+	for _, c := range text {
+		switch c {
+		case '\t':
+			count += tabSize - count%tabSize
+		case '\f':
+			count = 0
+		default:
+			count++
+		}
+	}
+	return count
+}
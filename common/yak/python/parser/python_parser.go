@@ -0,0 +1,17339 @@
+// Code generated from java-escape by ANTLR 4.11.1. DO NOT EDIT.
+
+package pythonparser // PythonParser
+import (
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+)
+
+// Suppress unused import errors
+var _ = fmt.Printf
+var _ = strconv.Itoa
+var _ = sync.Once{}
+
+type PythonParser struct {
+	*antlr.BaseParser
+}
+
+var pythonparserParserStaticData struct {
+	once                   sync.Once
+	serializedATN          []int32
+	literalNames           []string
+	symbolicNames          []string
+	ruleNames              []string
+	predictionContextCache *antlr.PredictionContextCache
+	atn                    *antlr.ATN
+	decisionToDFA          []*antlr.DFA
+}
+
+func pythonparserParserInit() {
+	staticData := &pythonparserParserStaticData
+	staticData.literalNames = []string{
+		"", "", "", "'False'", "'None'", "'True'", "'and'", "'as'", "'assert'",
+		"'async'", "'await'", "'break'", "'class'", "'continue'", "'def'", "'del'",
+		"'elif'", "'else'", "'except'", "'finally'", "'for'", "'from'", "'global'",
+		"'if'", "'import'", "'in'", "'is'", "'lambda'", "'nonlocal'", "'not'",
+		"'or'", "'pass'", "'raise'", "'return'", "'try'", "'while'", "'with'",
+		"'yield'", "'match'", "'case'", "", "", "'...'", "'.'", "'*'", "'('",
+		"')'", "','", "':='", "':'", "';'", "'**'", "'='", "'['", "']'", "'|'",
+		"'^'", "'&'", "'<<'", "'>>'", "'+'", "'-'", "'/'", "'%'", "'//'", "'~'",
+		"'{'", "'}'", "'<'", "'>'", "'=='", "'>='", "'<='", "'<>'", "'!='",
+		"'@'", "'->'", "'+='", "'-='", "'*='", "'@='", "'/='", "'%='", "'&='",
+		"'|='", "'^='", "'<<='", "'>>='", "'**='", "'//='",
+	}
+	staticData.symbolicNames = []string{
+		"", "INDENT", "DEDENT", "FALSE", "NONE", "TRUE", "AND", "AS", "ASSERT",
+		"ASYNC", "AWAIT", "BREAK", "CLASS", "CONTINUE", "DEF", "DEL", "ELIF",
+		"ELSE", "EXCEPT", "FINALLY", "FOR", "FROM", "GLOBAL", "IF", "IMPORT",
+		"IN", "IS", "LAMBDA", "NONLOCAL", "NOT", "OR", "PASS", "RAISE", "RETURN",
+		"TRY", "WHILE", "WITH", "YIELD", "MATCH", "CASE", "STRING", "NUMBER",
+		"ELLIPSIS", "DOT", "STAR", "OPEN_PAREN", "CLOSE_PAREN", "COMMA", "WALRUS",
+		"COLON", "SEMI_COLON", "POWER", "ASSIGN", "OPEN_BRACKET", "CLOSE_BRACKET",
+		"OR_OP", "XOR", "AND_OP", "LEFT_SHIFT", "RIGHT_SHIFT", "ADD", "MINUS",
+		"DIV", "MOD", "IDIV", "NOT_OP", "OPEN_BRACE", "CLOSE_BRACE", "LESS_THAN",
+		"GREATER_THAN", "EQUALS", "GT_EQ", "LT_EQ", "NOT_EQ_1", "NOT_EQ_2",
+		"AT", "ARROW", "ADD_ASSIGN", "SUB_ASSIGN", "MULT_ASSIGN", "AT_ASSIGN",
+		"DIV_ASSIGN", "MOD_ASSIGN", "AND_ASSIGN", "OR_ASSIGN", "XOR_ASSIGN",
+		"LEFT_SHIFT_ASSIGN", "RIGHT_SHIFT_ASSIGN", "POWER_ASSIGN", "IDIV_ASSIGN",
+		"NEWLINE", "NAME", "WS", "LINE_JOINING", "COMMENT", "ERROR_CHAR",
+	}
+	staticData.ruleNames = []string{
+		"fileInput", "statement", "simpleStatements", "simpleStatement", "assignPart",
+		"augAssign", "importFromModule", "importTargets", "importAsNames", "importAsName",
+		"dottedAsNames", "dottedAsName", "dottedName", "compoundStatement",
+		"block", "elifClause", "elseClause", "exceptClause", "finallyClause",
+		"withItems", "withItem", "decorator", "funcDef", "classDef", "typedArgsList",
+		"typedArg", "varArgsList", "varArg", "subjectExpr", "caseBlock", "casePatterns",
+		"casePattern", "orPattern", "closedPattern", "literalPattern", "mappingPattern",
+		"classPatternArg", "namedExprTest", "test", "testNoCond", "lambdef",
+		"lambdefNoCond", "orTest", "andTest", "notTest", "comparison", "compOp",
+		"starExpr", "expr", "atomExpr", "atom", "name", "testlistComp", "trailer",
+		"subscriptList", "subscript", "exprlist", "testlist", "testlistStarExpr",
+		"dictOrSetMaker", "dictItem", "arglist", "argument", "compFor", "compIter",
+		"compIf", "yieldExpr",
+	}
+	staticData.predictionContextCache = antlr.NewPredictionContextCache()
+	staticData.serializedATN = []int32{
+		4, 1, 95, 1077, 2, 0, 7, 0, 2, 1, 7, 1, 2, 2, 7, 2, 2, 3, 7, 3, 2, 4, 7,
+		4, 2, 5, 7, 5, 2, 6, 7, 6, 2, 7, 7, 7, 2, 8, 7, 8, 2, 9, 7, 9, 2, 10, 7,
+		10, 2, 11, 7, 11, 2, 12, 7, 12, 2, 13, 7, 13, 2, 14, 7, 14, 2, 15, 7, 15,
+		2, 16, 7, 16, 2, 17, 7, 17, 2, 18, 7, 18, 2, 19, 7, 19, 2, 20, 7, 20, 2,
+		21, 7, 21, 2, 22, 7, 22, 2, 23, 7, 23, 2, 24, 7, 24, 2, 25, 7, 25, 2, 26,
+		7, 26, 2, 27, 7, 27, 2, 28, 7, 28, 2, 29, 7, 29, 2, 30, 7, 30, 2, 31, 7,
+		31, 2, 32, 7, 32, 2, 33, 7, 33, 2, 34, 7, 34, 2, 35, 7, 35, 2, 36, 7, 36,
+		2, 37, 7, 37, 2, 38, 7, 38, 2, 39, 7, 39, 2, 40, 7, 40, 2, 41, 7, 41, 2,
+		42, 7, 42, 2, 43, 7, 43, 2, 44, 7, 44, 2, 45, 7, 45, 2, 46, 7, 46, 2, 47,
+		7, 47, 2, 48, 7, 48, 2, 49, 7, 49, 2, 50, 7, 50, 2, 51, 7, 51, 2, 52, 7,
+		52, 2, 53, 7, 53, 2, 54, 7, 54, 2, 55, 7, 55, 2, 56, 7, 56, 2, 57, 7, 57,
+		2, 58, 7, 58, 2, 59, 7, 59, 2, 60, 7, 60, 2, 61, 7, 61, 2, 62, 7, 62, 2,
+		63, 7, 63, 2, 64, 7, 64, 2, 65, 7, 65, 2, 66, 7, 66, 1, 0, 1, 0, 5, 0,
+		137, 8, 0, 10, 0, 12, 0, 140, 9, 0, 1, 0, 1, 0, 1, 1, 1, 1, 3, 1, 146,
+		8, 1, 1, 2, 1, 2, 1, 2, 5, 2, 151, 8, 2, 10, 2, 12, 2, 154, 9, 2, 1, 2,
+		3, 2, 157, 8, 2, 1, 2, 1, 2, 1, 3, 1, 3, 3, 3, 163, 8, 3, 1, 3, 1, 3, 1,
+		3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 3, 3, 173, 8, 3, 1, 3, 1, 3, 1, 3, 1,
+		3, 3, 3, 179, 8, 3, 3, 3, 181, 8, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 194, 8, 3, 10, 3, 12, 3, 197, 9, 3,
+		1, 3, 1, 3, 1, 3, 1, 3, 5, 3, 203, 8, 3, 10, 3, 12, 3, 206, 9, 3, 1, 3,
+		1, 3, 1, 3, 1, 3, 3, 3, 212, 8, 3, 3, 3, 214, 8, 3, 1, 4, 1, 4, 1, 4, 1,
+		4, 1, 4, 3, 4, 221, 8, 4, 3, 4, 223, 8, 4, 1, 4, 1, 4, 1, 4, 3, 4, 228,
+		8, 4, 1, 4, 1, 4, 1, 4, 3, 4, 233, 8, 4, 4, 4, 235, 8, 4, 11, 4, 12, 4,
+		236, 3, 4, 239, 8, 4, 1, 5, 1, 5, 1, 6, 5, 6, 244, 8, 6, 10, 6, 12, 6,
+		247, 9, 6, 1, 6, 1, 6, 4, 6, 251, 8, 6, 11, 6, 12, 6, 252, 3, 6, 255, 8,
+		6, 1, 7, 1, 7, 1, 7, 1, 7, 3, 7, 261, 8, 7, 1, 7, 1, 7, 1, 7, 1, 7, 3,
+		7, 267, 8, 7, 3, 7, 269, 8, 7, 1, 8, 1, 8, 1, 8, 5, 8, 274, 8, 8, 10, 8,
+		12, 8, 277, 9, 8, 1, 9, 1, 9, 1, 9, 3, 9, 282, 8, 9, 1, 10, 1, 10, 1, 10,
+		5, 10, 287, 8, 10, 10, 10, 12, 10, 290, 9, 10, 1, 11, 1, 11, 1, 11, 3,
+		11, 295, 8, 11, 1, 12, 1, 12, 1, 12, 5, 12, 300, 8, 12, 10, 12, 12, 12,
+		303, 9, 12, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 5, 13, 310, 8, 13, 10, 13,
+		12, 13, 313, 9, 13, 1, 13, 3, 13, 316, 8, 13, 1, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 3, 13, 323, 8, 13, 1, 13, 3, 13, 326, 8, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 1, 13, 3, 13, 335, 8, 13, 1, 13, 1, 13, 1, 13, 1,
+		13, 4, 13, 341, 8, 13, 11, 13, 12, 13, 342, 1, 13, 3, 13, 346, 8, 13, 1,
+		13, 3, 13, 349, 8, 13, 1, 13, 3, 13, 352, 8, 13, 1, 13, 3, 13, 355, 8,
+		13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 1, 13, 5, 13, 363, 8, 13, 10, 13,
+		12, 13, 366, 9, 13, 1, 13, 1, 13, 3, 13, 370, 8, 13, 1, 13, 1, 13, 1, 13,
+		1, 13, 1, 13, 1, 13, 4, 13, 378, 8, 13, 11, 13, 12, 13, 379, 1, 13, 1,
+		13, 3, 13, 384, 8, 13, 1, 14, 1, 14, 1, 14, 1, 14, 4, 14, 390, 8, 14, 11,
+		14, 12, 14, 391, 1, 14, 1, 14, 3, 14, 396, 8, 14, 1, 15, 1, 15, 1, 15,
+		1, 15, 1, 15, 1, 16, 1, 16, 1, 16, 1, 16, 1, 17, 1, 17, 3, 17, 409, 8,
+		17, 1, 17, 1, 17, 1, 17, 3, 17, 414, 8, 17, 3, 17, 416, 8, 17, 1, 17, 1,
+		17, 1, 17, 1, 18, 1, 18, 1, 18, 1, 18, 1, 19, 1, 19, 1, 19, 1, 19, 5, 19,
+		429, 8, 19, 10, 19, 12, 19, 432, 9, 19, 1, 19, 3, 19, 435, 8, 19, 1, 19,
+		1, 19, 1, 19, 1, 19, 1, 19, 5, 19, 442, 8, 19, 10, 19, 12, 19, 445, 9,
+		19, 3, 19, 447, 8, 19, 1, 20, 1, 20, 1, 20, 3, 20, 452, 8, 20, 1, 21, 1,
+		21, 1, 21, 1, 21, 1, 22, 3, 22, 459, 8, 22, 1, 22, 1, 22, 1, 22, 1, 22,
+		3, 22, 465, 8, 22, 1, 22, 1, 22, 1, 22, 3, 22, 470, 8, 22, 1, 22, 1, 22,
+		1, 22, 1, 23, 1, 23, 1, 23, 1, 23, 3, 23, 479, 8, 23, 1, 23, 3, 23, 482,
+		8, 23, 1, 23, 1, 23, 1, 23, 1, 24, 1, 24, 1, 24, 5, 24, 490, 8, 24, 10,
+		24, 12, 24, 493, 9, 24, 1, 24, 3, 24, 496, 8, 24, 1, 25, 1, 25, 1, 25,
+		3, 25, 501, 8, 25, 1, 25, 1, 25, 3, 25, 505, 8, 25, 1, 25, 1, 25, 1, 25,
+		1, 25, 3, 25, 511, 8, 25, 3, 25, 513, 8, 25, 1, 25, 1, 25, 1, 25, 1, 25,
+		3, 25, 519, 8, 25, 1, 25, 3, 25, 522, 8, 25, 1, 26, 1, 26, 1, 26, 5, 26,
+		527, 8, 26, 10, 26, 12, 26, 530, 9, 26, 1, 26, 3, 26, 533, 8, 26, 1, 27,
+		1, 27, 1, 27, 3, 27, 538, 8, 27, 1, 27, 1, 27, 3, 27, 542, 8, 27, 1, 27,
+		1, 27, 1, 27, 3, 27, 547, 8, 27, 1, 28, 1, 28, 3, 28, 551, 8, 28, 1, 28,
+		1, 28, 1, 28, 3, 28, 556, 8, 28, 1, 28, 1, 28, 1, 28, 3, 28, 561, 8, 28,
+		5, 28, 563, 8, 28, 10, 28, 12, 28, 566, 9, 28, 1, 28, 3, 28, 569, 8, 28,
+		3, 28, 571, 8, 28, 1, 28, 3, 28, 574, 8, 28, 1, 29, 1, 29, 1, 29, 1, 29,
+		3, 29, 580, 8, 29, 1, 29, 1, 29, 1, 29, 1, 30, 1, 30, 1, 30, 5, 30, 588,
+		8, 30, 10, 30, 12, 30, 591, 9, 30, 1, 30, 3, 30, 594, 8, 30, 1, 31, 1,
+		31, 1, 31, 1, 31, 1, 31, 3, 31, 601, 8, 31, 3, 31, 603, 8, 31, 1, 32, 1,
+		32, 1, 32, 5, 32, 608, 8, 32, 10, 32, 12, 32, 611, 9, 32, 1, 33, 1, 33,
+		1, 33, 1, 33, 1, 33, 4, 33, 618, 8, 33, 11, 33, 12, 33, 619, 1, 33, 1,
+		33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 3, 33, 630, 8, 33, 3, 33,
+		632, 8, 33, 1, 33, 1, 33, 1, 33, 3, 33, 637, 8, 33, 1, 33, 1, 33, 1, 33,
+		1, 33, 1, 33, 5, 33, 644, 8, 33, 10, 33, 12, 33, 647, 9, 33, 1, 33, 3,
+		33, 650, 8, 33, 3, 33, 652, 8, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1, 33, 1,
+		33, 5, 33, 660, 8, 33, 10, 33, 12, 33, 663, 9, 33, 1, 33, 3, 33, 666, 8,
+		33, 3, 33, 668, 8, 33, 1, 33, 1, 33, 3, 33, 672, 8, 33, 1, 34, 3, 34, 675,
+		8, 34, 1, 34, 1, 34, 1, 34, 3, 34, 680, 8, 34, 1, 34, 4, 34, 683, 8, 34,
+		11, 34, 12, 34, 684, 1, 34, 1, 34, 1, 34, 3, 34, 690, 8, 34, 1, 35, 1,
+		35, 1, 35, 1, 35, 4, 35, 696, 8, 35, 11, 35, 12, 35, 697, 3, 35, 700, 8,
+		35, 1, 35, 1, 35, 1, 35, 1, 35, 1, 35, 3, 35, 707, 8, 35, 1, 36, 1, 36,
+		1, 36, 1, 36, 1, 36, 3, 36, 714, 8, 36, 1, 37, 1, 37, 1, 37, 3, 37, 719,
+		8, 37, 1, 37, 1, 37, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 1, 38, 3, 38, 729,
+		8, 38, 1, 38, 3, 38, 732, 8, 38, 1, 39, 1, 39, 3, 39, 736, 8, 39, 1, 40,
+		1, 40, 3, 40, 740, 8, 40, 1, 40, 1, 40, 1, 40, 1, 41, 1, 41, 3, 41, 747,
+		8, 41, 1, 41, 1, 41, 1, 41, 1, 42, 1, 42, 1, 42, 5, 42, 755, 8, 42, 10,
+		42, 12, 42, 758, 9, 42, 1, 43, 1, 43, 1, 43, 5, 43, 763, 8, 43, 10, 43,
+		12, 43, 766, 9, 43, 1, 44, 1, 44, 1, 44, 3, 44, 771, 8, 44, 1, 45, 1, 45,
+		1, 45, 1, 45, 5, 45, 777, 8, 45, 10, 45, 12, 45, 780, 9, 45, 1, 46, 1,
+		46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46, 1, 46,
+		1, 46, 3, 46, 795, 8, 46, 1, 47, 1, 47, 1, 47, 1, 48, 1, 48, 1, 48, 1,
+		48, 3, 48, 804, 8, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48,
+		1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1, 48, 1,
+		48, 1, 48, 1, 48, 1, 48, 5, 48, 827, 8, 48, 10, 48, 12, 48, 830, 9, 48,
+		1, 49, 3, 49, 833, 8, 49, 1, 49, 1, 49, 5, 49, 837, 8, 49, 10, 49, 12,
+		49, 840, 9, 49, 1, 50, 1, 50, 1, 50, 3, 50, 845, 8, 50, 1, 50, 1, 50, 1,
+		50, 3, 50, 850, 8, 50, 1, 50, 1, 50, 1, 50, 3, 50, 855, 8, 50, 1, 50, 1,
+		50, 1, 50, 1, 50, 4, 50, 861, 8, 50, 11, 50, 12, 50, 862, 1, 50, 1, 50,
+		1, 50, 1, 50, 3, 50, 869, 8, 50, 1, 51, 1, 51, 1, 52, 1, 52, 3, 52, 875,
+		8, 52, 1, 52, 1, 52, 1, 52, 1, 52, 3, 52, 881, 8, 52, 5, 52, 883, 8, 52,
+		10, 52, 12, 52, 886, 9, 52, 1, 52, 3, 52, 889, 8, 52, 3, 52, 891, 8, 52,
+		1, 53, 1, 53, 3, 53, 895, 8, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1, 53, 1,
+		53, 1, 53, 3, 53, 904, 8, 53, 1, 54, 1, 54, 1, 54, 5, 54, 909, 8, 54, 10,
+		54, 12, 54, 912, 9, 54, 1, 54, 3, 54, 915, 8, 54, 1, 55, 1, 55, 3, 55,
+		919, 8, 55, 1, 55, 1, 55, 3, 55, 923, 8, 55, 1, 55, 1, 55, 3, 55, 927,
+		8, 55, 3, 55, 929, 8, 55, 1, 55, 3, 55, 932, 8, 55, 1, 56, 1, 56, 3, 56,
+		936, 8, 56, 1, 56, 1, 56, 1, 56, 3, 56, 941, 8, 56, 5, 56, 943, 8, 56,
+		10, 56, 12, 56, 946, 9, 56, 1, 56, 3, 56, 949, 8, 56, 1, 57, 1, 57, 1,
+		57, 5, 57, 954, 8, 57, 10, 57, 12, 57, 957, 9, 57, 1, 57, 3, 57, 960, 8,
+		57, 1, 58, 1, 58, 3, 58, 964, 8, 58, 1, 58, 1, 58, 1, 58, 3, 58, 969, 8,
+		58, 5, 58, 971, 8, 58, 10, 58, 12, 58, 974, 9, 58, 1, 58, 3, 58, 977, 8,
+		58, 1, 59, 1, 59, 1, 59, 1, 59, 5, 59, 983, 8, 59, 10, 59, 12, 59, 986,
+		9, 59, 1, 59, 3, 59, 989, 8, 59, 3, 59, 991, 8, 59, 1, 59, 1, 59, 3, 59,
+		995, 8, 59, 1, 59, 1, 59, 1, 59, 1, 59, 3, 59, 1001, 8, 59, 5, 59, 1003,
+		8, 59, 10, 59, 12, 59, 1006, 9, 59, 1, 59, 3, 59, 1009, 8, 59, 3, 59, 1011,
+		8, 59, 3, 59, 1013, 8, 59, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 1, 60, 3,
+		60, 1021, 8, 60, 1, 61, 1, 61, 1, 61, 5, 61, 1026, 8, 61, 10, 61, 12, 61,
+		1029, 9, 61, 1, 61, 3, 61, 1032, 8, 61, 1, 62, 1, 62, 3, 62, 1036, 8, 62,
+		1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1, 62, 1,
+		62, 1, 62, 3, 62, 1050, 8, 62, 1, 63, 3, 63, 1053, 8, 63, 1, 63, 1, 63,
+		1, 63, 1, 63, 1, 63, 3, 63, 1060, 8, 63, 1, 64, 1, 64, 3, 64, 1064, 8,
+		64, 1, 65, 1, 65, 1, 65, 3, 65, 1069, 8, 65, 1, 66, 1, 66, 1, 66, 1, 66,
+		3, 66, 1075, 8, 66, 1, 66, 0, 1, 96, 67, 0, 2, 4, 6, 8, 10, 12, 14, 16,
+		18, 20, 22, 24, 26, 28, 30, 32, 34, 36, 38, 40, 42, 44, 46, 48, 50, 52,
+		54, 56, 58, 60, 62, 64, 66, 68, 70, 72, 74, 76, 78, 80, 82, 84, 86, 88,
+		90, 92, 94, 96, 98, 100, 102, 104, 106, 108, 110, 112, 114, 116, 118, 120,
+		122, 124, 126, 128, 130, 132, 0, 7, 1, 0, 77, 89, 1, 0, 42, 43, 1, 0, 60,
+		61, 2, 0, 60, 61, 65, 65, 3, 0, 44, 44, 62, 64, 75, 75, 1, 0, 58, 59, 2,
+		0, 38, 39, 91, 91, 1237, 0, 138, 1, 0, 0, 0, 2, 145, 1, 0, 0, 0, 4, 147,
+		1, 0, 0, 0, 6, 213, 1, 0, 0, 0, 8, 238, 1, 0, 0, 0, 10, 240, 1, 0, 0, 0,
+		12, 254, 1, 0, 0, 0, 14, 268, 1, 0, 0, 0, 16, 270, 1, 0, 0, 0, 18, 278,
+		1, 0, 0, 0, 20, 283, 1, 0, 0, 0, 22, 291, 1, 0, 0, 0, 24, 296, 1, 0, 0,
+		0, 26, 383, 1, 0, 0, 0, 28, 395, 1, 0, 0, 0, 30, 397, 1, 0, 0, 0, 32, 402,
+		1, 0, 0, 0, 34, 406, 1, 0, 0, 0, 36, 420, 1, 0, 0, 0, 38, 446, 1, 0, 0,
+		0, 40, 448, 1, 0, 0, 0, 42, 453, 1, 0, 0, 0, 44, 458, 1, 0, 0, 0, 46, 474,
+		1, 0, 0, 0, 48, 486, 1, 0, 0, 0, 50, 521, 1, 0, 0, 0, 52, 523, 1, 0, 0,
+		0, 54, 546, 1, 0, 0, 0, 56, 573, 1, 0, 0, 0, 58, 575, 1, 0, 0, 0, 60, 584,
+		1, 0, 0, 0, 62, 602, 1, 0, 0, 0, 64, 604, 1, 0, 0, 0, 66, 671, 1, 0, 0,
+		0, 68, 689, 1, 0, 0, 0, 70, 706, 1, 0, 0, 0, 72, 713, 1, 0, 0, 0, 74, 718,
+		1, 0, 0, 0, 76, 731, 1, 0, 0, 0, 78, 735, 1, 0, 0, 0, 80, 737, 1, 0, 0,
+		0, 82, 744, 1, 0, 0, 0, 84, 751, 1, 0, 0, 0, 86, 759, 1, 0, 0, 0, 88, 770,
+		1, 0, 0, 0, 90, 772, 1, 0, 0, 0, 92, 794, 1, 0, 0, 0, 94, 796, 1, 0, 0,
+		0, 96, 803, 1, 0, 0, 0, 98, 832, 1, 0, 0, 0, 100, 868, 1, 0, 0, 0, 102,
+		870, 1, 0, 0, 0, 104, 874, 1, 0, 0, 0, 106, 903, 1, 0, 0, 0, 108, 905,
+		1, 0, 0, 0, 110, 931, 1, 0, 0, 0, 112, 935, 1, 0, 0, 0, 114, 950, 1, 0,
+		0, 0, 116, 963, 1, 0, 0, 0, 118, 1012, 1, 0, 0, 0, 120, 1020, 1, 0, 0,
+		0, 122, 1022, 1, 0, 0, 0, 124, 1049, 1, 0, 0, 0, 126, 1052, 1, 0, 0, 0,
+		128, 1063, 1, 0, 0, 0, 130, 1065, 1, 0, 0, 0, 132, 1070, 1, 0, 0, 0, 134,
+		137, 5, 90, 0, 0, 135, 137, 3, 2, 1, 0, 136, 134, 1, 0, 0, 0, 136, 135,
+		1, 0, 0, 0, 137, 140, 1, 0, 0, 0, 138, 136, 1, 0, 0, 0, 138, 139, 1, 0,
+		0, 0, 139, 141, 1, 0, 0, 0, 140, 138, 1, 0, 0, 0, 141, 142, 5, 0, 0, 1,
+		142, 1, 1, 0, 0, 0, 143, 146, 3, 4, 2, 0, 144, 146, 3, 26, 13, 0, 145,
+		143, 1, 0, 0, 0, 145, 144, 1, 0, 0, 0, 146, 3, 1, 0, 0, 0, 147, 152, 3,
+		6, 3, 0, 148, 149, 5, 50, 0, 0, 149, 151, 3, 6, 3, 0, 150, 148, 1, 0, 0,
+		0, 151, 154, 1, 0, 0, 0, 152, 150, 1, 0, 0, 0, 152, 153, 1, 0, 0, 0, 153,
+		156, 1, 0, 0, 0, 154, 152, 1, 0, 0, 0, 155, 157, 5, 50, 0, 0, 156, 155,
+		1, 0, 0, 0, 156, 157, 1, 0, 0, 0, 157, 158, 1, 0, 0, 0, 158, 159, 5, 90,
+		0, 0, 159, 5, 1, 0, 0, 0, 160, 162, 3, 116, 58, 0, 161, 163, 3, 8, 4, 0,
+		162, 161, 1, 0, 0, 0, 162, 163, 1, 0, 0, 0, 163, 214, 1, 0, 0, 0, 164,
+		214, 3, 132, 66, 0, 165, 166, 5, 15, 0, 0, 166, 214, 3, 112, 56, 0, 167,
+		214, 5, 31, 0, 0, 168, 214, 5, 11, 0, 0, 169, 214, 5, 13, 0, 0, 170, 172,
+		5, 33, 0, 0, 171, 173, 3, 116, 58, 0, 172, 171, 1, 0, 0, 0, 172, 173, 1,
+		0, 0, 0, 173, 214, 1, 0, 0, 0, 174, 180, 5, 32, 0, 0, 175, 178, 3, 76,
+		38, 0, 176, 177, 5, 21, 0, 0, 177, 179, 3, 76, 38, 0, 178, 176, 1, 0, 0,
+		0, 178, 179, 1, 0, 0, 0, 179, 181, 1, 0, 0, 0, 180, 175, 1, 0, 0, 0, 180,
+		181, 1, 0, 0, 0, 181, 214, 1, 0, 0, 0, 182, 183, 5, 24, 0, 0, 183, 214,
+		3, 20, 10, 0, 184, 185, 5, 21, 0, 0, 185, 186, 3, 12, 6, 0, 186, 187, 5,
+		24, 0, 0, 187, 188, 3, 14, 7, 0, 188, 214, 1, 0, 0, 0, 189, 190, 5, 22,
+		0, 0, 190, 195, 3, 102, 51, 0, 191, 192, 5, 47, 0, 0, 192, 194, 3, 102,
+		51, 0, 193, 191, 1, 0, 0, 0, 194, 197, 1, 0, 0, 0, 195, 193, 1, 0, 0, 0,
+		195, 196, 1, 0, 0, 0, 196, 214, 1, 0, 0, 0, 197, 195, 1, 0, 0, 0, 198,
+		199, 5, 28, 0, 0, 199, 204, 3, 102, 51, 0, 200, 201, 5, 47, 0, 0, 201,
+		203, 3, 102, 51, 0, 202, 200, 1, 0, 0, 0, 203, 206, 1, 0, 0, 0, 204, 202,
+		1, 0, 0, 0, 204, 205, 1, 0, 0, 0, 205, 214, 1, 0, 0, 0, 206, 204, 1, 0,
+		0, 0, 207, 208, 5, 8, 0, 0, 208, 211, 3, 76, 38, 0, 209, 210, 5, 47, 0,
+		0, 210, 212, 3, 76, 38, 0, 211, 209, 1, 0, 0, 0, 211, 212, 1, 0, 0, 0,
+		212, 214, 1, 0, 0, 0, 213, 160, 1, 0, 0, 0, 213, 164, 1, 0, 0, 0, 213,
+		165, 1, 0, 0, 0, 213, 167, 1, 0, 0, 0, 213, 168, 1, 0, 0, 0, 213, 169,
+		1, 0, 0, 0, 213, 170, 1, 0, 0, 0, 213, 174, 1, 0, 0, 0, 213, 182, 1, 0,
+		0, 0, 213, 184, 1, 0, 0, 0, 213, 189, 1, 0, 0, 0, 213, 198, 1, 0, 0, 0,
+		213, 207, 1, 0, 0, 0, 214, 7, 1, 0, 0, 0, 215, 216, 5, 49, 0, 0, 216, 222,
+		3, 76, 38, 0, 217, 220, 5, 52, 0, 0, 218, 221, 3, 132, 66, 0, 219, 221,
+		3, 116, 58, 0, 220, 218, 1, 0, 0, 0, 220, 219, 1, 0, 0, 0, 221, 223, 1,
+		0, 0, 0, 222, 217, 1, 0, 0, 0, 222, 223, 1, 0, 0, 0, 223, 239, 1, 0, 0,
+		0, 224, 227, 3, 10, 5, 0, 225, 228, 3, 132, 66, 0, 226, 228, 3, 114, 57,
+		0, 227, 225, 1, 0, 0, 0, 227, 226, 1, 0, 0, 0, 228, 239, 1, 0, 0, 0, 229,
+		232, 5, 52, 0, 0, 230, 233, 3, 132, 66, 0, 231, 233, 3, 116, 58, 0, 232,
+		230, 1, 0, 0, 0, 232, 231, 1, 0, 0, 0, 233, 235, 1, 0, 0, 0, 234, 229,
+		1, 0, 0, 0, 235, 236, 1, 0, 0, 0, 236, 234, 1, 0, 0, 0, 236, 237, 1, 0,
+		0, 0, 237, 239, 1, 0, 0, 0, 238, 215, 1, 0, 0, 0, 238, 224, 1, 0, 0, 0,
+		238, 234, 1, 0, 0, 0, 239, 9, 1, 0, 0, 0, 240, 241, 7, 0, 0, 0, 241, 11,
+		1, 0, 0, 0, 242, 244, 7, 1, 0, 0, 243, 242, 1, 0, 0, 0, 244, 247, 1, 0,
+		0, 0, 245, 243, 1, 0, 0, 0, 245, 246, 1, 0, 0, 0, 246, 248, 1, 0, 0, 0,
+		247, 245, 1, 0, 0, 0, 248, 255, 3, 24, 12, 0, 249, 251, 7, 1, 0, 0, 250,
+		249, 1, 0, 0, 0, 251, 252, 1, 0, 0, 0, 252, 250, 1, 0, 0, 0, 252, 253,
+		1, 0, 0, 0, 253, 255, 1, 0, 0, 0, 254, 245, 1, 0, 0, 0, 254, 250, 1, 0,
+		0, 0, 255, 13, 1, 0, 0, 0, 256, 269, 5, 44, 0, 0, 257, 258, 5, 45, 0, 0,
+		258, 260, 3, 16, 8, 0, 259, 261, 5, 47, 0, 0, 260, 259, 1, 0, 0, 0, 260,
+		261, 1, 0, 0, 0, 261, 262, 1, 0, 0, 0, 262, 263, 5, 46, 0, 0, 263, 269,
+		1, 0, 0, 0, 264, 266, 3, 16, 8, 0, 265, 267, 5, 47, 0, 0, 266, 265, 1,
+		0, 0, 0, 266, 267, 1, 0, 0, 0, 267, 269, 1, 0, 0, 0, 268, 256, 1, 0, 0,
+		0, 268, 257, 1, 0, 0, 0, 268, 264, 1, 0, 0, 0, 269, 15, 1, 0, 0, 0, 270,
+		275, 3, 18, 9, 0, 271, 272, 5, 47, 0, 0, 272, 274, 3, 18, 9, 0, 273, 271,
+		1, 0, 0, 0, 274, 277, 1, 0, 0, 0, 275, 273, 1, 0, 0, 0, 275, 276, 1, 0,
+		0, 0, 276, 17, 1, 0, 0, 0, 277, 275, 1, 0, 0, 0, 278, 281, 3, 102, 51,
+		0, 279, 280, 5, 7, 0, 0, 280, 282, 3, 102, 51, 0, 281, 279, 1, 0, 0, 0,
+		281, 282, 1, 0, 0, 0, 282, 19, 1, 0, 0, 0, 283, 288, 3, 22, 11, 0, 284,
+		285, 5, 47, 0, 0, 285, 287, 3, 22, 11, 0, 286, 284, 1, 0, 0, 0, 287, 290,
+		1, 0, 0, 0, 288, 286, 1, 0, 0, 0, 288, 289, 1, 0, 0, 0, 289, 21, 1, 0,
+		0, 0, 290, 288, 1, 0, 0, 0, 291, 294, 3, 24, 12, 0, 292, 293, 5, 7, 0,
+		0, 293, 295, 3, 102, 51, 0, 294, 292, 1, 0, 0, 0, 294, 295, 1, 0, 0, 0,
+		295, 23, 1, 0, 0, 0, 296, 301, 3, 102, 51, 0, 297, 298, 5, 43, 0, 0, 298,
+		300, 3, 102, 51, 0, 299, 297, 1, 0, 0, 0, 300, 303, 1, 0, 0, 0, 301, 299,
+		1, 0, 0, 0, 301, 302, 1, 0, 0, 0, 302, 25, 1, 0, 0, 0, 303, 301, 1, 0,
+		0, 0, 304, 305, 5, 23, 0, 0, 305, 306, 3, 74, 37, 0, 306, 307, 5, 49, 0,
+		0, 307, 311, 3, 28, 14, 0, 308, 310, 3, 30, 15, 0, 309, 308, 1, 0, 0, 0,
+		310, 313, 1, 0, 0, 0, 311, 309, 1, 0, 0, 0, 311, 312, 1, 0, 0, 0, 312,
+		315, 1, 0, 0, 0, 313, 311, 1, 0, 0, 0, 314, 316, 3, 32, 16, 0, 315, 314,
+		1, 0, 0, 0, 315, 316, 1, 0, 0, 0, 316, 384, 1, 0, 0, 0, 317, 318, 5, 35,
+		0, 0, 318, 319, 3, 74, 37, 0, 319, 320, 5, 49, 0, 0, 320, 322, 3, 28, 14,
+		0, 321, 323, 3, 32, 16, 0, 322, 321, 1, 0, 0, 0, 322, 323, 1, 0, 0, 0,
+		323, 384, 1, 0, 0, 0, 324, 326, 5, 9, 0, 0, 325, 324, 1, 0, 0, 0, 325,
+		326, 1, 0, 0, 0, 326, 327, 1, 0, 0, 0, 327, 328, 5, 20, 0, 0, 328, 329,
+		3, 112, 56, 0, 329, 330, 5, 25, 0, 0, 330, 331, 3, 114, 57, 0, 331, 332,
+		5, 49, 0, 0, 332, 334, 3, 28, 14, 0, 333, 335, 3, 32, 16, 0, 334, 333,
+		1, 0, 0, 0, 334, 335, 1, 0, 0, 0, 335, 384, 1, 0, 0, 0, 336, 337, 5, 34,
+		0, 0, 337, 338, 5, 49, 0, 0, 338, 351, 3, 28, 14, 0, 339, 341, 3, 34, 17,
+		0, 340, 339, 1, 0, 0, 0, 341, 342, 1, 0, 0, 0, 342, 340, 1, 0, 0, 0, 342,
+		343, 1, 0, 0, 0, 343, 345, 1, 0, 0, 0, 344, 346, 3, 32, 16, 0, 345, 344,
+		1, 0, 0, 0, 345, 346, 1, 0, 0, 0, 346, 348, 1, 0, 0, 0, 347, 349, 3, 36,
+		18, 0, 348, 347, 1, 0, 0, 0, 348, 349, 1, 0, 0, 0, 349, 352, 1, 0, 0, 0,
+		350, 352, 3, 36, 18, 0, 351, 340, 1, 0, 0, 0, 351, 350, 1, 0, 0, 0, 352,
+		384, 1, 0, 0, 0, 353, 355, 5, 9, 0, 0, 354, 353, 1, 0, 0, 0, 354, 355,
+		1, 0, 0, 0, 355, 356, 1, 0, 0, 0, 356, 357, 5, 36, 0, 0, 357, 358, 3, 38,
+		19, 0, 358, 359, 5, 49, 0, 0, 359, 360, 3, 28, 14, 0, 360, 384, 1, 0, 0,
+		0, 361, 363, 3, 42, 21, 0, 362, 361, 1, 0, 0, 0, 363, 366, 1, 0, 0, 0,
+		364, 362, 1, 0, 0, 0, 364, 365, 1, 0, 0, 0, 365, 369, 1, 0, 0, 0, 366,
+		364, 1, 0, 0, 0, 367, 370, 3, 46, 23, 0, 368, 370, 3, 44, 22, 0, 369, 367,
+		1, 0, 0, 0, 369, 368, 1, 0, 0, 0, 370, 384, 1, 0, 0, 0, 371, 372, 5, 38,
+		0, 0, 372, 373, 3, 56, 28, 0, 373, 374, 5, 49, 0, 0, 374, 375, 5, 90, 0,
+		0, 375, 377, 5, 1, 0, 0, 376, 378, 3, 58, 29, 0, 377, 376, 1, 0, 0, 0,
+		378, 379, 1, 0, 0, 0, 379, 377, 1, 0, 0, 0, 379, 380, 1, 0, 0, 0, 380,
+		381, 1, 0, 0, 0, 381, 382, 5, 2, 0, 0, 382, 384, 1, 0, 0, 0, 383, 304,
+		1, 0, 0, 0, 383, 317, 1, 0, 0, 0, 383, 325, 1, 0, 0, 0, 383, 336, 1, 0,
+		0, 0, 383, 354, 1, 0, 0, 0, 383, 364, 1, 0, 0, 0, 383, 371, 1, 0, 0, 0,
+		384, 27, 1, 0, 0, 0, 385, 396, 3, 4, 2, 0, 386, 387, 5, 90, 0, 0, 387,
+		389, 5, 1, 0, 0, 388, 390, 3, 2, 1, 0, 389, 388, 1, 0, 0, 0, 390, 391,
+		1, 0, 0, 0, 391, 389, 1, 0, 0, 0, 391, 392, 1, 0, 0, 0, 392, 393, 1, 0,
+		0, 0, 393, 394, 5, 2, 0, 0, 394, 396, 1, 0, 0, 0, 395, 385, 1, 0, 0, 0,
+		395, 386, 1, 0, 0, 0, 396, 29, 1, 0, 0, 0, 397, 398, 5, 16, 0, 0, 398,
+		399, 3, 74, 37, 0, 399, 400, 5, 49, 0, 0, 400, 401, 3, 28, 14, 0, 401,
+		31, 1, 0, 0, 0, 402, 403, 5, 17, 0, 0, 403, 404, 5, 49, 0, 0, 404, 405,
+		3, 28, 14, 0, 405, 33, 1, 0, 0, 0, 406, 408, 5, 18, 0, 0, 407, 409, 5,
+		44, 0, 0, 408, 407, 1, 0, 0, 0, 408, 409, 1, 0, 0, 0, 409, 415, 1, 0, 0,
+		0, 410, 413, 3, 76, 38, 0, 411, 412, 5, 7, 0, 0, 412, 414, 3, 102, 51,
+		0, 413, 411, 1, 0, 0, 0, 413, 414, 1, 0, 0, 0, 414, 416, 1, 0, 0, 0, 415,
+		410, 1, 0, 0, 0, 415, 416, 1, 0, 0, 0, 416, 417, 1, 0, 0, 0, 417, 418,
+		5, 49, 0, 0, 418, 419, 3, 28, 14, 0, 419, 35, 1, 0, 0, 0, 420, 421, 5,
+		19, 0, 0, 421, 422, 5, 49, 0, 0, 422, 423, 3, 28, 14, 0, 423, 37, 1, 0,
+		0, 0, 424, 425, 5, 45, 0, 0, 425, 430, 3, 40, 20, 0, 426, 427, 5, 47, 0,
+		0, 427, 429, 3, 40, 20, 0, 428, 426, 1, 0, 0, 0, 429, 432, 1, 0, 0, 0,
+		430, 428, 1, 0, 0, 0, 430, 431, 1, 0, 0, 0, 431, 434, 1, 0, 0, 0, 432,
+		430, 1, 0, 0, 0, 433, 435, 5, 47, 0, 0, 434, 433, 1, 0, 0, 0, 434, 435,
+		1, 0, 0, 0, 435, 436, 1, 0, 0, 0, 436, 437, 5, 46, 0, 0, 437, 447, 1, 0,
+		0, 0, 438, 443, 3, 40, 20, 0, 439, 440, 5, 47, 0, 0, 440, 442, 3, 40, 20,
+		0, 441, 439, 1, 0, 0, 0, 442, 445, 1, 0, 0, 0, 443, 441, 1, 0, 0, 0, 443,
+		444, 1, 0, 0, 0, 444, 447, 1, 0, 0, 0, 445, 443, 1, 0, 0, 0, 446, 424,
+		1, 0, 0, 0, 446, 438, 1, 0, 0, 0, 447, 39, 1, 0, 0, 0, 448, 451, 3, 76,
+		38, 0, 449, 450, 5, 7, 0, 0, 450, 452, 3, 96, 48, 0, 451, 449, 1, 0, 0,
+		0, 451, 452, 1, 0, 0, 0, 452, 41, 1, 0, 0, 0, 453, 454, 5, 75, 0, 0, 454,
+		455, 3, 74, 37, 0, 455, 456, 5, 90, 0, 0, 456, 43, 1, 0, 0, 0, 457, 459,
+		5, 9, 0, 0, 458, 457, 1, 0, 0, 0, 458, 459, 1, 0, 0, 0, 459, 460, 1, 0,
+		0, 0, 460, 461, 5, 14, 0, 0, 461, 462, 3, 102, 51, 0, 462, 464, 5, 45,
+		0, 0, 463, 465, 3, 48, 24, 0, 464, 463, 1, 0, 0, 0, 464, 465, 1, 0, 0,
+		0, 465, 466, 1, 0, 0, 0, 466, 469, 5, 46, 0, 0, 467, 468, 5, 76, 0, 0,
+		468, 470, 3, 76, 38, 0, 469, 467, 1, 0, 0, 0, 469, 470, 1, 0, 0, 0, 470,
+		471, 1, 0, 0, 0, 471, 472, 5, 49, 0, 0, 472, 473, 3, 28, 14, 0, 473, 45,
+		1, 0, 0, 0, 474, 475, 5, 12, 0, 0, 475, 481, 3, 102, 51, 0, 476, 478, 5,
+		45, 0, 0, 477, 479, 3, 122, 61, 0, 478, 477, 1, 0, 0, 0, 478, 479, 1, 0,
+		0, 0, 479, 480, 1, 0, 0, 0, 480, 482, 5, 46, 0, 0, 481, 476, 1, 0, 0, 0,
+		481, 482, 1, 0, 0, 0, 482, 483, 1, 0, 0, 0, 483, 484, 5, 49, 0, 0, 484,
+		485, 3, 28, 14, 0, 485, 47, 1, 0, 0, 0, 486, 491, 3, 50, 25, 0, 487, 488,
+		5, 47, 0, 0, 488, 490, 3, 50, 25, 0, 489, 487, 1, 0, 0, 0, 490, 493, 1,
+		0, 0, 0, 491, 489, 1, 0, 0, 0, 491, 492, 1, 0, 0, 0, 492, 495, 1, 0, 0,
+		0, 493, 491, 1, 0, 0, 0, 494, 496, 5, 47, 0, 0, 495, 494, 1, 0, 0, 0, 495,
+		496, 1, 0, 0, 0, 496, 49, 1, 0, 0, 0, 497, 500, 3, 102, 51, 0, 498, 499,
+		5, 49, 0, 0, 499, 501, 3, 76, 38, 0, 500, 498, 1, 0, 0, 0, 500, 501, 1,
+		0, 0, 0, 501, 504, 1, 0, 0, 0, 502, 503, 5, 52, 0, 0, 503, 505, 3, 76,
+		38, 0, 504, 502, 1, 0, 0, 0, 504, 505, 1, 0, 0, 0, 505, 522, 1, 0, 0, 0,
+		506, 512, 5, 44, 0, 0, 507, 510, 3, 102, 51, 0, 508, 509, 5, 49, 0, 0,
+		509, 511, 3, 76, 38, 0, 510, 508, 1, 0, 0, 0, 510, 511, 1, 0, 0, 0, 511,
+		513, 1, 0, 0, 0, 512, 507, 1, 0, 0, 0, 512, 513, 1, 0, 0, 0, 513, 522,
+		1, 0, 0, 0, 514, 515, 5, 51, 0, 0, 515, 518, 3, 102, 51, 0, 516, 517, 5,
+		49, 0, 0, 517, 519, 3, 76, 38, 0, 518, 516, 1, 0, 0, 0, 518, 519, 1, 0,
+		0, 0, 519, 522, 1, 0, 0, 0, 520, 522, 5, 62, 0, 0, 521, 497, 1, 0, 0, 0,
+		521, 506, 1, 0, 0, 0, 521, 514, 1, 0, 0, 0, 521, 520, 1, 0, 0, 0, 522,
+		51, 1, 0, 0, 0, 523, 528, 3, 54, 27, 0, 524, 525, 5, 47, 0, 0, 525, 527,
+		3, 54, 27, 0, 526, 524, 1, 0, 0, 0, 527, 530, 1, 0, 0, 0, 528, 526, 1,
+		0, 0, 0, 528, 529, 1, 0, 0, 0, 529, 532, 1, 0, 0, 0, 530, 528, 1, 0, 0,
+		0, 531, 533, 5, 47, 0, 0, 532, 531, 1, 0, 0, 0, 532, 533, 1, 0, 0, 0, 533,
+		53, 1, 0, 0, 0, 534, 537, 3, 102, 51, 0, 535, 536, 5, 52, 0, 0, 536, 538,
+		3, 76, 38, 0, 537, 535, 1, 0, 0, 0, 537, 538, 1, 0, 0, 0, 538, 547, 1,
+		0, 0, 0, 539, 541, 5, 44, 0, 0, 540, 542, 3, 102, 51, 0, 541, 540, 1, 0,
+		0, 0, 541, 542, 1, 0, 0, 0, 542, 547, 1, 0, 0, 0, 543, 544, 5, 51, 0, 0,
+		544, 547, 3, 102, 51, 0, 545, 547, 5, 62, 0, 0, 546, 534, 1, 0, 0, 0, 546,
+		539, 1, 0, 0, 0, 546, 543, 1, 0, 0, 0, 546, 545, 1, 0, 0, 0, 547, 55, 1,
+		0, 0, 0, 548, 551, 3, 74, 37, 0, 549, 551, 3, 94, 47, 0, 550, 548, 1, 0,
+		0, 0, 550, 549, 1, 0, 0, 0, 551, 552, 1, 0, 0, 0, 552, 570, 5, 47, 0, 0,
+		553, 556, 3, 74, 37, 0, 554, 556, 3, 94, 47, 0, 555, 553, 1, 0, 0, 0, 555,
+		554, 1, 0, 0, 0, 556, 564, 1, 0, 0, 0, 557, 560, 5, 47, 0, 0, 558, 561,
+		3, 74, 37, 0, 559, 561, 3, 94, 47, 0, 560, 558, 1, 0, 0, 0, 560, 559, 1,
+		0, 0, 0, 561, 563, 1, 0, 0, 0, 562, 557, 1, 0, 0, 0, 563, 566, 1, 0, 0,
+		0, 564, 562, 1, 0, 0, 0, 564, 565, 1, 0, 0, 0, 565, 568, 1, 0, 0, 0, 566,
+		564, 1, 0, 0, 0, 567, 569, 5, 47, 0, 0, 568, 567, 1, 0, 0, 0, 568, 569,
+		1, 0, 0, 0, 569, 571, 1, 0, 0, 0, 570, 555, 1, 0, 0, 0, 570, 571, 1, 0,
+		0, 0, 571, 574, 1, 0, 0, 0, 572, 574, 3, 74, 37, 0, 573, 550, 1, 0, 0,
+		0, 573, 572, 1, 0, 0, 0, 574, 57, 1, 0, 0, 0, 575, 576, 5, 39, 0, 0, 576,
+		579, 3, 60, 30, 0, 577, 578, 5, 23, 0, 0, 578, 580, 3, 74, 37, 0, 579,
+		577, 1, 0, 0, 0, 579, 580, 1, 0, 0, 0, 580, 581, 1, 0, 0, 0, 581, 582,
+		5, 49, 0, 0, 582, 583, 3, 28, 14, 0, 583, 59, 1, 0, 0, 0, 584, 589, 3,
+		62, 31, 0, 585, 586, 5, 47, 0, 0, 586, 588, 3, 62, 31, 0, 587, 585, 1,
+		0, 0, 0, 588, 591, 1, 0, 0, 0, 589, 587, 1, 0, 0, 0, 589, 590, 1, 0, 0,
+		0, 590, 593, 1, 0, 0, 0, 591, 589, 1, 0, 0, 0, 592, 594, 5, 47, 0, 0, 593,
+		592, 1, 0, 0, 0, 593, 594, 1, 0, 0, 0, 594, 61, 1, 0, 0, 0, 595, 596, 5,
+		44, 0, 0, 596, 603, 3, 102, 51, 0, 597, 600, 3, 64, 32, 0, 598, 599, 5,
+		7, 0, 0, 599, 601, 3, 102, 51, 0, 600, 598, 1, 0, 0, 0, 600, 601, 1, 0,
+		0, 0, 601, 603, 1, 0, 0, 0, 602, 595, 1, 0, 0, 0, 602, 597, 1, 0, 0, 0,
+		603, 63, 1, 0, 0, 0, 604, 609, 3, 66, 33, 0, 605, 606, 5, 55, 0, 0, 606,
+		608, 3, 66, 33, 0, 607, 605, 1, 0, 0, 0, 608, 611, 1, 0, 0, 0, 609, 607,
+		1, 0, 0, 0, 609, 610, 1, 0, 0, 0, 610, 65, 1, 0, 0, 0, 611, 609, 1, 0,
+		0, 0, 612, 672, 3, 68, 34, 0, 613, 672, 3, 102, 51, 0, 614, 617, 3, 102,
+		51, 0, 615, 616, 5, 43, 0, 0, 616, 618, 3, 102, 51, 0, 617, 615, 1, 0,
+		0, 0, 618, 619, 1, 0, 0, 0, 619, 617, 1, 0, 0, 0, 619, 620, 1, 0, 0, 0,
+		620, 672, 1, 0, 0, 0, 621, 622, 5, 45, 0, 0, 622, 623, 3, 62, 31, 0, 623,
+		624, 5, 46, 0, 0, 624, 672, 1, 0, 0, 0, 625, 631, 5, 45, 0, 0, 626, 627,
+		3, 62, 31, 0, 627, 629, 5, 47, 0, 0, 628, 630, 3, 60, 30, 0, 629, 628,
+		1, 0, 0, 0, 629, 630, 1, 0, 0, 0, 630, 632, 1, 0, 0, 0, 631, 626, 1, 0,
+		0, 0, 631, 632, 1, 0, 0, 0, 632, 633, 1, 0, 0, 0, 633, 672, 5, 46, 0, 0,
+		634, 636, 5, 53, 0, 0, 635, 637, 3, 60, 30, 0, 636, 635, 1, 0, 0, 0, 636,
+		637, 1, 0, 0, 0, 637, 638, 1, 0, 0, 0, 638, 672, 5, 54, 0, 0, 639, 651,
+		5, 66, 0, 0, 640, 645, 3, 70, 35, 0, 641, 642, 5, 47, 0, 0, 642, 644, 3,
+		70, 35, 0, 643, 641, 1, 0, 0, 0, 644, 647, 1, 0, 0, 0, 645, 643, 1, 0,
+		0, 0, 645, 646, 1, 0, 0, 0, 646, 649, 1, 0, 0, 0, 647, 645, 1, 0, 0, 0,
+		648, 650, 5, 47, 0, 0, 649, 648, 1, 0, 0, 0, 649, 650, 1, 0, 0, 0, 650,
+		652, 1, 0, 0, 0, 651, 640, 1, 0, 0, 0, 651, 652, 1, 0, 0, 0, 652, 653,
+		1, 0, 0, 0, 653, 672, 5, 67, 0, 0, 654, 655, 3, 24, 12, 0, 655, 667, 5,
+		45, 0, 0, 656, 661, 3, 72, 36, 0, 657, 658, 5, 47, 0, 0, 658, 660, 3, 72,
+		36, 0, 659, 657, 1, 0, 0, 0, 660, 663, 1, 0, 0, 0, 661, 659, 1, 0, 0, 0,
+		661, 662, 1, 0, 0, 0, 662, 665, 1, 0, 0, 0, 663, 661, 1, 0, 0, 0, 664,
+		666, 5, 47, 0, 0, 665, 664, 1, 0, 0, 0, 665, 666, 1, 0, 0, 0, 666, 668,
+		1, 0, 0, 0, 667, 656, 1, 0, 0, 0, 667, 668, 1, 0, 0, 0, 668, 669, 1, 0,
+		0, 0, 669, 670, 5, 46, 0, 0, 670, 672, 1, 0, 0, 0, 671, 612, 1, 0, 0, 0,
+		671, 613, 1, 0, 0, 0, 671, 614, 1, 0, 0, 0, 671, 621, 1, 0, 0, 0, 671,
+		625, 1, 0, 0, 0, 671, 634, 1, 0, 0, 0, 671, 639, 1, 0, 0, 0, 671, 654,
+		1, 0, 0, 0, 672, 67, 1, 0, 0, 0, 673, 675, 5, 61, 0, 0, 674, 673, 1, 0,
+		0, 0, 674, 675, 1, 0, 0, 0, 675, 676, 1, 0, 0, 0, 676, 679, 5, 41, 0, 0,
+		677, 678, 7, 2, 0, 0, 678, 680, 5, 41, 0, 0, 679, 677, 1, 0, 0, 0, 679,
+		680, 1, 0, 0, 0, 680, 690, 1, 0, 0, 0, 681, 683, 5, 40, 0, 0, 682, 681,
+		1, 0, 0, 0, 683, 684, 1, 0, 0, 0, 684, 682, 1, 0, 0, 0, 684, 685, 1, 0,
+		0, 0, 685, 690, 1, 0, 0, 0, 686, 690, 5, 4, 0, 0, 687, 690, 5, 5, 0, 0,
+		688, 690, 5, 3, 0, 0, 689, 674, 1, 0, 0, 0, 689, 682, 1, 0, 0, 0, 689,
+		686, 1, 0, 0, 0, 689, 687, 1, 0, 0, 0, 689, 688, 1, 0, 0, 0, 690, 69, 1,
+		0, 0, 0, 691, 700, 3, 68, 34, 0, 692, 695, 3, 102, 51, 0, 693, 694, 5,
+		43, 0, 0, 694, 696, 3, 102, 51, 0, 695, 693, 1, 0, 0, 0, 696, 697, 1, 0,
+		0, 0, 697, 695, 1, 0, 0, 0, 697, 698, 1, 0, 0, 0, 698, 700, 1, 0, 0, 0,
+		699, 691, 1, 0, 0, 0, 699, 692, 1, 0, 0, 0, 700, 701, 1, 0, 0, 0, 701,
+		702, 5, 49, 0, 0, 702, 703, 3, 62, 31, 0, 703, 707, 1, 0, 0, 0, 704, 705,
+		5, 51, 0, 0, 705, 707, 3, 102, 51, 0, 706, 699, 1, 0, 0, 0, 706, 704, 1,
+		0, 0, 0, 707, 71, 1, 0, 0, 0, 708, 709, 3, 102, 51, 0, 709, 710, 5, 52,
+		0, 0, 710, 711, 3, 62, 31, 0, 711, 714, 1, 0, 0, 0, 712, 714, 3, 62, 31,
+		0, 713, 708, 1, 0, 0, 0, 713, 712, 1, 0, 0, 0, 714, 73, 1, 0, 0, 0, 715,
+		716, 3, 102, 51, 0, 716, 717, 5, 48, 0, 0, 717, 719, 1, 0, 0, 0, 718, 715,
+		1, 0, 0, 0, 718, 719, 1, 0, 0, 0, 719, 720, 1, 0, 0, 0, 720, 721, 3, 76,
+		38, 0, 721, 75, 1, 0, 0, 0, 722, 728, 3, 84, 42, 0, 723, 724, 5, 23, 0,
+		0, 724, 725, 3, 84, 42, 0, 725, 726, 5, 17, 0, 0, 726, 727, 3, 76, 38,
+		0, 727, 729, 1, 0, 0, 0, 728, 723, 1, 0, 0, 0, 728, 729, 1, 0, 0, 0, 729,
+		732, 1, 0, 0, 0, 730, 732, 3, 80, 40, 0, 731, 722, 1, 0, 0, 0, 731, 730,
+		1, 0, 0, 0, 732, 77, 1, 0, 0, 0, 733, 736, 3, 84, 42, 0, 734, 736, 3, 82,
+		41, 0, 735, 733, 1, 0, 0, 0, 735, 734, 1, 0, 0, 0, 736, 79, 1, 0, 0, 0,
+		737, 739, 5, 27, 0, 0, 738, 740, 3, 52, 26, 0, 739, 738, 1, 0, 0, 0, 739,
+		740, 1, 0, 0, 0, 740, 741, 1, 0, 0, 0, 741, 742, 5, 49, 0, 0, 742, 743,
+		3, 76, 38, 0, 743, 81, 1, 0, 0, 0, 744, 746, 5, 27, 0, 0, 745, 747, 3,
+		52, 26, 0, 746, 745, 1, 0, 0, 0, 746, 747, 1, 0, 0, 0, 747, 748, 1, 0,
+		0, 0, 748, 749, 5, 49, 0, 0, 749, 750, 3, 78, 39, 0, 750, 83, 1, 0, 0,
+		0, 751, 756, 3, 86, 43, 0, 752, 753, 5, 30, 0, 0, 753, 755, 3, 86, 43,
+		0, 754, 752, 1, 0, 0, 0, 755, 758, 1, 0, 0, 0, 756, 754, 1, 0, 0, 0, 756,
+		757, 1, 0, 0, 0, 757, 85, 1, 0, 0, 0, 758, 756, 1, 0, 0, 0, 759, 764, 3,
+		88, 44, 0, 760, 761, 5, 6, 0, 0, 761, 763, 3, 88, 44, 0, 762, 760, 1, 0,
+		0, 0, 763, 766, 1, 0, 0, 0, 764, 762, 1, 0, 0, 0, 764, 765, 1, 0, 0, 0,
+		765, 87, 1, 0, 0, 0, 766, 764, 1, 0, 0, 0, 767, 768, 5, 29, 0, 0, 768,
+		771, 3, 88, 44, 0, 769, 771, 3, 90, 45, 0, 770, 767, 1, 0, 0, 0, 770, 769,
+		1, 0, 0, 0, 771, 89, 1, 0, 0, 0, 772, 778, 3, 96, 48, 0, 773, 774, 3, 92,
+		46, 0, 774, 775, 3, 96, 48, 0, 775, 777, 1, 0, 0, 0, 776, 773, 1, 0, 0,
+		0, 777, 780, 1, 0, 0, 0, 778, 776, 1, 0, 0, 0, 778, 779, 1, 0, 0, 0, 779,
+		91, 1, 0, 0, 0, 780, 778, 1, 0, 0, 0, 781, 795, 5, 68, 0, 0, 782, 795,
+		5, 69, 0, 0, 783, 795, 5, 70, 0, 0, 784, 795, 5, 71, 0, 0, 785, 795, 5,
+		72, 0, 0, 786, 795, 5, 73, 0, 0, 787, 795, 5, 74, 0, 0, 788, 795, 5, 25,
+		0, 0, 789, 790, 5, 29, 0, 0, 790, 795, 5, 25, 0, 0, 791, 795, 5, 26, 0,
+		0, 792, 793, 5, 26, 0, 0, 793, 795, 5, 29, 0, 0, 794, 781, 1, 0, 0, 0,
+		794, 782, 1, 0, 0, 0, 794, 783, 1, 0, 0, 0, 794, 784, 1, 0, 0, 0, 794,
+		785, 1, 0, 0, 0, 794, 786, 1, 0, 0, 0, 794, 787, 1, 0, 0, 0, 794, 788,
+		1, 0, 0, 0, 794, 789, 1, 0, 0, 0, 794, 791, 1, 0, 0, 0, 794, 792, 1, 0,
+		0, 0, 795, 93, 1, 0, 0, 0, 796, 797, 5, 44, 0, 0, 797, 798, 3, 96, 48,
+		0, 798, 95, 1, 0, 0, 0, 799, 800, 6, 48, -1, 0, 800, 804, 3, 98, 49, 0,
+		801, 802, 7, 3, 0, 0, 802, 804, 3, 96, 48, 7, 803, 799, 1, 0, 0, 0, 803,
+		801, 1, 0, 0, 0, 804, 828, 1, 0, 0, 0, 805, 806, 10, 8, 0, 0, 806, 807,
+		5, 51, 0, 0, 807, 827, 3, 96, 48, 8, 808, 809, 10, 6, 0, 0, 809, 810, 7,
+		4, 0, 0, 810, 827, 3, 96, 48, 7, 811, 812, 10, 5, 0, 0, 812, 813, 7, 2,
+		0, 0, 813, 827, 3, 96, 48, 6, 814, 815, 10, 4, 0, 0, 815, 816, 7, 5, 0,
+		0, 816, 827, 3, 96, 48, 5, 817, 818, 10, 3, 0, 0, 818, 819, 5, 57, 0, 0,
+		819, 827, 3, 96, 48, 4, 820, 821, 10, 2, 0, 0, 821, 822, 5, 56, 0, 0, 822,
+		827, 3, 96, 48, 3, 823, 824, 10, 1, 0, 0, 824, 825, 5, 55, 0, 0, 825, 827,
+		3, 96, 48, 2, 826, 805, 1, 0, 0, 0, 826, 808, 1, 0, 0, 0, 826, 811, 1,
+		0, 0, 0, 826, 814, 1, 0, 0, 0, 826, 817, 1, 0, 0, 0, 826, 820, 1, 0, 0,
+		0, 826, 823, 1, 0, 0, 0, 827, 830, 1, 0, 0, 0, 828, 826, 1, 0, 0, 0, 828,
+		829, 1, 0, 0, 0, 829, 97, 1, 0, 0, 0, 830, 828, 1, 0, 0, 0, 831, 833, 5,
+		10, 0, 0, 832, 831, 1, 0, 0, 0, 832, 833, 1, 0, 0, 0, 833, 834, 1, 0, 0,
+		0, 834, 838, 3, 100, 50, 0, 835, 837, 3, 106, 53, 0, 836, 835, 1, 0, 0,
+		0, 837, 840, 1, 0, 0, 0, 838, 836, 1, 0, 0, 0, 838, 839, 1, 0, 0, 0, 839,
+		99, 1, 0, 0, 0, 840, 838, 1, 0, 0, 0, 841, 844, 5, 45, 0, 0, 842, 845,
+		3, 132, 66, 0, 843, 845, 3, 104, 52, 0, 844, 842, 1, 0, 0, 0, 844, 843,
+		1, 0, 0, 0, 844, 845, 1, 0, 0, 0, 845, 846, 1, 0, 0, 0, 846, 869, 5, 46,
+		0, 0, 847, 849, 5, 53, 0, 0, 848, 850, 3, 104, 52, 0, 849, 848, 1, 0, 0,
+		0, 849, 850, 1, 0, 0, 0, 850, 851, 1, 0, 0, 0, 851, 869, 5, 54, 0, 0, 852,
+		854, 5, 66, 0, 0, 853, 855, 3, 118, 59, 0, 854, 853, 1, 0, 0, 0, 854, 855,
+		1, 0, 0, 0, 855, 856, 1, 0, 0, 0, 856, 869, 5, 67, 0, 0, 857, 869, 3, 102,
+		51, 0, 858, 869, 5, 41, 0, 0, 859, 861, 5, 40, 0, 0, 860, 859, 1, 0, 0,
+		0, 861, 862, 1, 0, 0, 0, 862, 860, 1, 0, 0, 0, 862, 863, 1, 0, 0, 0, 863,
+		869, 1, 0, 0, 0, 864, 869, 5, 42, 0, 0, 865, 869, 5, 4, 0, 0, 866, 869,
+		5, 5, 0, 0, 867, 869, 5, 3, 0, 0, 868, 841, 1, 0, 0, 0, 868, 847, 1, 0,
+		0, 0, 868, 852, 1, 0, 0, 0, 868, 857, 1, 0, 0, 0, 868, 858, 1, 0, 0, 0,
+		868, 860, 1, 0, 0, 0, 868, 864, 1, 0, 0, 0, 868, 865, 1, 0, 0, 0, 868,
+		866, 1, 0, 0, 0, 868, 867, 1, 0, 0, 0, 869, 101, 1, 0, 0, 0, 870, 871,
+		7, 6, 0, 0, 871, 103, 1, 0, 0, 0, 872, 875, 3, 74, 37, 0, 873, 875, 3,
+		94, 47, 0, 874, 872, 1, 0, 0, 0, 874, 873, 1, 0, 0, 0, 875, 890, 1, 0,
+		0, 0, 876, 891, 3, 126, 63, 0, 877, 880, 5, 47, 0, 0, 878, 881, 3, 74,
+		37, 0, 879, 881, 3, 94, 47, 0, 880, 878, 1, 0, 0, 0, 880, 879, 1, 0, 0,
+		0, 881, 883, 1, 0, 0, 0, 882, 877, 1, 0, 0, 0, 883, 886, 1, 0, 0, 0, 884,
+		882, 1, 0, 0, 0, 884, 885, 1, 0, 0, 0, 885, 888, 1, 0, 0, 0, 886, 884,
+		1, 0, 0, 0, 887, 889, 5, 47, 0, 0, 888, 887, 1, 0, 0, 0, 888, 889, 1, 0,
+		0, 0, 889, 891, 1, 0, 0, 0, 890, 876, 1, 0, 0, 0, 890, 884, 1, 0, 0, 0,
+		891, 105, 1, 0, 0, 0, 892, 894, 5, 45, 0, 0, 893, 895, 3, 122, 61, 0, 894,
+		893, 1, 0, 0, 0, 894, 895, 1, 0, 0, 0, 895, 896, 1, 0, 0, 0, 896, 904,
+		5, 46, 0, 0, 897, 898, 5, 53, 0, 0, 898, 899, 3, 108, 54, 0, 899, 900,
+		5, 54, 0, 0, 900, 904, 1, 0, 0, 0, 901, 902, 5, 43, 0, 0, 902, 904, 3,
+		102, 51, 0, 903, 892, 1, 0, 0, 0, 903, 897, 1, 0, 0, 0, 903, 901, 1, 0,
+		0, 0, 904, 107, 1, 0, 0, 0, 905, 910, 3, 110, 55, 0, 906, 907, 5, 47, 0,
+		0, 907, 909, 3, 110, 55, 0, 908, 906, 1, 0, 0, 0, 909, 912, 1, 0, 0, 0,
+		910, 908, 1, 0, 0, 0, 910, 911, 1, 0, 0, 0, 911, 914, 1, 0, 0, 0, 912,
+		910, 1, 0, 0, 0, 913, 915, 5, 47, 0, 0, 914, 913, 1, 0, 0, 0, 914, 915,
+		1, 0, 0, 0, 915, 109, 1, 0, 0, 0, 916, 932, 3, 76, 38, 0, 917, 919, 3,
+		76, 38, 0, 918, 917, 1, 0, 0, 0, 918, 919, 1, 0, 0, 0, 919, 920, 1, 0,
+		0, 0, 920, 922, 5, 49, 0, 0, 921, 923, 3, 76, 38, 0, 922, 921, 1, 0, 0,
+		0, 922, 923, 1, 0, 0, 0, 923, 928, 1, 0, 0, 0, 924, 926, 5, 49, 0, 0, 925,
+		927, 3, 76, 38, 0, 926, 925, 1, 0, 0, 0, 926, 927, 1, 0, 0, 0, 927, 929,
+		1, 0, 0, 0, 928, 924, 1, 0, 0, 0, 928, 929, 1, 0, 0, 0, 929, 932, 1, 0,
+		0, 0, 930, 932, 3, 94, 47, 0, 931, 916, 1, 0, 0, 0, 931, 918, 1, 0, 0,
+		0, 931, 930, 1, 0, 0, 0, 932, 111, 1, 0, 0, 0, 933, 936, 3, 96, 48, 0,
+		934, 936, 3, 94, 47, 0, 935, 933, 1, 0, 0, 0, 935, 934, 1, 0, 0, 0, 936,
+		944, 1, 0, 0, 0, 937, 940, 5, 47, 0, 0, 938, 941, 3, 96, 48, 0, 939, 941,
+		3, 94, 47, 0, 940, 938, 1, 0, 0, 0, 940, 939, 1, 0, 0, 0, 941, 943, 1,
+		0, 0, 0, 942, 937, 1, 0, 0, 0, 943, 946, 1, 0, 0, 0, 944, 942, 1, 0, 0,
+		0, 944, 945, 1, 0, 0, 0, 945, 948, 1, 0, 0, 0, 946, 944, 1, 0, 0, 0, 947,
+		949, 5, 47, 0, 0, 948, 947, 1, 0, 0, 0, 948, 949, 1, 0, 0, 0, 949, 113,
+		1, 0, 0, 0, 950, 955, 3, 76, 38, 0, 951, 952, 5, 47, 0, 0, 952, 954, 3,
+		76, 38, 0, 953, 951, 1, 0, 0, 0, 954, 957, 1, 0, 0, 0, 955, 953, 1, 0,
+		0, 0, 955, 956, 1, 0, 0, 0, 956, 959, 1, 0, 0, 0, 957, 955, 1, 0, 0, 0,
+		958, 960, 5, 47, 0, 0, 959, 958, 1, 0, 0, 0, 959, 960, 1, 0, 0, 0, 960,
+		115, 1, 0, 0, 0, 961, 964, 3, 76, 38, 0, 962, 964, 3, 94, 47, 0, 963, 961,
+		1, 0, 0, 0, 963, 962, 1, 0, 0, 0, 964, 972, 1, 0, 0, 0, 965, 968, 5, 47,
+		0, 0, 966, 969, 3, 76, 38, 0, 967, 969, 3, 94, 47, 0, 968, 966, 1, 0, 0,
+		0, 968, 967, 1, 0, 0, 0, 969, 971, 1, 0, 0, 0, 970, 965, 1, 0, 0, 0, 971,
+		974, 1, 0, 0, 0, 972, 970, 1, 0, 0, 0, 972, 973, 1, 0, 0, 0, 973, 976,
+		1, 0, 0, 0, 974, 972, 1, 0, 0, 0, 975, 977, 5, 47, 0, 0, 976, 975, 1, 0,
+		0, 0, 976, 977, 1, 0, 0, 0, 977, 117, 1, 0, 0, 0, 978, 990, 3, 120, 60,
+		0, 979, 991, 3, 126, 63, 0, 980, 981, 5, 47, 0, 0, 981, 983, 3, 120, 60,
+		0, 982, 980, 1, 0, 0, 0, 983, 986, 1, 0, 0, 0, 984, 982, 1, 0, 0, 0, 984,
+		985, 1, 0, 0, 0, 985, 988, 1, 0, 0, 0, 986, 984, 1, 0, 0, 0, 987, 989,
+		5, 47, 0, 0, 988, 987, 1, 0, 0, 0, 988, 989, 1, 0, 0, 0, 989, 991, 1, 0,
+		0, 0, 990, 979, 1, 0, 0, 0, 990, 984, 1, 0, 0, 0, 991, 1013, 1, 0, 0, 0,
+		992, 995, 3, 74, 37, 0, 993, 995, 3, 94, 47, 0, 994, 992, 1, 0, 0, 0, 994,
+		993, 1, 0, 0, 0, 995, 1010, 1, 0, 0, 0, 996, 1011, 3, 126, 63, 0, 997,
+		1000, 5, 47, 0, 0, 998, 1001, 3, 74, 37, 0, 999, 1001, 3, 94, 47, 0, 1000,
+		998, 1, 0, 0, 0, 1000, 999, 1, 0, 0, 0, 1001, 1003, 1, 0, 0, 0, 1002, 997,
+		1, 0, 0, 0, 1003, 1006, 1, 0, 0, 0, 1004, 1002, 1, 0, 0, 0, 1004, 1005,
+		1, 0, 0, 0, 1005, 1008, 1, 0, 0, 0, 1006, 1004, 1, 0, 0, 0, 1007, 1009,
+		5, 47, 0, 0, 1008, 1007, 1, 0, 0, 0, 1008, 1009, 1, 0, 0, 0, 1009, 1011,
+		1, 0, 0, 0, 1010, 996, 1, 0, 0, 0, 1010, 1004, 1, 0, 0, 0, 1011, 1013,
+		1, 0, 0, 0, 1012, 978, 1, 0, 0, 0, 1012, 994, 1, 0, 0, 0, 1013, 119, 1,
+		0, 0, 0, 1014, 1015, 3, 76, 38, 0, 1015, 1016, 5, 49, 0, 0, 1016, 1017,
+		3, 76, 38, 0, 1017, 1021, 1, 0, 0, 0, 1018, 1019, 5, 51, 0, 0, 1019, 1021,
+		3, 96, 48, 0, 1020, 1014, 1, 0, 0, 0, 1020, 1018, 1, 0, 0, 0, 1021, 121,
+		1, 0, 0, 0, 1022, 1027, 3, 124, 62, 0, 1023, 1024, 5, 47, 0, 0, 1024, 1026,
+		3, 124, 62, 0, 1025, 1023, 1, 0, 0, 0, 1026, 1029, 1, 0, 0, 0, 1027, 1025,
+		1, 0, 0, 0, 1027, 1028, 1, 0, 0, 0, 1028, 1031, 1, 0, 0, 0, 1029, 1027,
+		1, 0, 0, 0, 1030, 1032, 5, 47, 0, 0, 1031, 1030, 1, 0, 0, 0, 1031, 1032,
+		1, 0, 0, 0, 1032, 123, 1, 0, 0, 0, 1033, 1035, 3, 76, 38, 0, 1034, 1036,
+		3, 126, 63, 0, 1035, 1034, 1, 0, 0, 0, 1035, 1036, 1, 0, 0, 0, 1036, 1050,
+		1, 0, 0, 0, 1037, 1038, 3, 102, 51, 0, 1038, 1039, 5, 48, 0, 0, 1039, 1040,
+		3, 76, 38, 0, 1040, 1050, 1, 0, 0, 0, 1041, 1042, 3, 102, 51, 0, 1042,
+		1043, 5, 52, 0, 0, 1043, 1044, 3, 76, 38, 0, 1044, 1050, 1, 0, 0, 0, 1045,
+		1046, 5, 44, 0, 0, 1046, 1050, 3, 76, 38, 0, 1047, 1048, 5, 51, 0, 0, 1048,
+		1050, 3, 76, 38, 0, 1049, 1033, 1, 0, 0, 0, 1049, 1037, 1, 0, 0, 0, 1049,
+		1041, 1, 0, 0, 0, 1049, 1045, 1, 0, 0, 0, 1049, 1047, 1, 0, 0, 0, 1050,
+		125, 1, 0, 0, 0, 1051, 1053, 5, 9, 0, 0, 1052, 1051, 1, 0, 0, 0, 1052,
+		1053, 1, 0, 0, 0, 1053, 1054, 1, 0, 0, 0, 1054, 1055, 5, 20, 0, 0, 1055,
+		1056, 3, 112, 56, 0, 1056, 1057, 5, 25, 0, 0, 1057, 1059, 3, 84, 42, 0,
+		1058, 1060, 3, 128, 64, 0, 1059, 1058, 1, 0, 0, 0, 1059, 1060, 1, 0, 0,
+		0, 1060, 127, 1, 0, 0, 0, 1061, 1064, 3, 126, 63, 0, 1062, 1064, 3, 130,
+		65, 0, 1063, 1061, 1, 0, 0, 0, 1063, 1062, 1, 0, 0, 0, 1064, 129, 1, 0,
+		0, 0, 1065, 1066, 5, 23, 0, 0, 1066, 1068, 3, 78, 39, 0, 1067, 1069, 3,
+		128, 64, 0, 1068, 1067, 1, 0, 0, 0, 1068, 1069, 1, 0, 0, 0, 1069, 131,
+		1, 0, 0, 0, 1070, 1074, 5, 37, 0, 0, 1071, 1072, 5, 21, 0, 0, 1072, 1075,
+		3, 76, 38, 0, 1073, 1075, 3, 116, 58, 0, 1074, 1071, 1, 0, 0, 0, 1074,
+		1073, 1, 0, 0, 0, 1074, 1075, 1, 0, 0, 0, 1075, 133, 1, 0, 0, 0, 168, 136,
+		138, 145, 152, 156, 162, 172, 178, 180, 195, 204, 211, 213, 220, 222, 227,
+		232, 236, 238, 245, 252, 254, 260, 266, 268, 275, 281, 288, 294, 301, 311,
+		315, 322, 325, 334, 342, 345, 348, 351, 354, 364, 369, 379, 383, 391, 395,
+		408, 413, 415, 430, 434, 443, 446, 451, 458, 464, 469, 478, 481, 491, 495,
+		500, 504, 510, 512, 518, 521, 528, 532, 537, 541, 546, 550, 555, 560, 564,
+		568, 570, 573, 579, 589, 593, 600, 602, 609, 619, 629, 631, 636, 645, 649,
+		651, 661, 665, 667, 671, 674, 679, 684, 689, 697, 699, 706, 713, 718, 728,
+		731, 735, 739, 746, 756, 764, 770, 778, 794, 803, 826, 828, 832, 838, 844,
+		849, 854, 862, 868, 874, 880, 884, 888, 890, 894, 903, 910, 914, 918, 922,
+		926, 928, 931, 935, 940, 944, 948, 955, 959, 963, 968, 972, 976, 984, 988,
+		990, 994, 1000, 1004, 1008, 1010, 1012, 1020, 1027, 1031, 1035, 1049, 1052,
+		1059, 1063, 1068, 1074,
+	}
+	deserializer := antlr.NewATNDeserializer(nil)
+	staticData.atn = deserializer.Deserialize(staticData.serializedATN)
+	atn := staticData.atn
+	staticData.decisionToDFA = make([]*antlr.DFA, len(atn.DecisionToState))
+	decisionToDFA := staticData.decisionToDFA
+	for index, state := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(state, index)
+	}
+}
+
+// PythonParserInit initializes any static state used to implement PythonParser. By default the
+// static state used to implement the parser is lazily initialized during the first call to
+// NewPythonParser(). You can call this function if you wish to initialize the static state ahead
+// of time.
+func PythonParserInit() {
+	staticData := &pythonparserParserStaticData
+	staticData.once.Do(pythonparserParserInit)
+}
+
+// NewPythonParser produces a new parser instance for the optional input antlr.TokenStream.
+func NewPythonParser(input antlr.TokenStream) *PythonParser {
+	PythonParserInit()
+	this := new(PythonParser)
+	this.BaseParser = antlr.NewBaseParser(input)
+	staticData := &pythonparserParserStaticData
+	this.Interpreter = antlr.NewParserATNSimulator(this, staticData.atn, staticData.decisionToDFA, staticData.predictionContextCache)
+	this.RuleNames = staticData.ruleNames
+	this.LiteralNames = staticData.literalNames
+	this.SymbolicNames = staticData.symbolicNames
+	this.GrammarFileName = "java-escape"
+
+	return this
+}
+
+// PythonParser tokens.
+const (
+	PythonParserEOF                = antlr.TokenEOF
+	PythonParserINDENT             = 1
+	PythonParserDEDENT             = 2
+	PythonParserFALSE              = 3
+	PythonParserNONE               = 4
+	PythonParserTRUE               = 5
+	PythonParserAND                = 6
+	PythonParserAS                 = 7
+	PythonParserASSERT             = 8
+	PythonParserASYNC              = 9
+	PythonParserAWAIT              = 10
+	PythonParserBREAK              = 11
+	PythonParserCLASS              = 12
+	PythonParserCONTINUE           = 13
+	PythonParserDEF                = 14
+	PythonParserDEL                = 15
+	PythonParserELIF               = 16
+	PythonParserELSE               = 17
+	PythonParserEXCEPT             = 18
+	PythonParserFINALLY            = 19
+	PythonParserFOR                = 20
+	PythonParserFROM               = 21
+	PythonParserGLOBAL             = 22
+	PythonParserIF                 = 23
+	PythonParserIMPORT             = 24
+	PythonParserIN                 = 25
+	PythonParserIS                 = 26
+	PythonParserLAMBDA             = 27
+	PythonParserNONLOCAL           = 28
+	PythonParserNOT                = 29
+	PythonParserOR                 = 30
+	PythonParserPASS               = 31
+	PythonParserRAISE              = 32
+	PythonParserRETURN             = 33
+	PythonParserTRY                = 34
+	PythonParserWHILE              = 35
+	PythonParserWITH               = 36
+	PythonParserYIELD              = 37
+	PythonParserMATCH              = 38
+	PythonParserCASE               = 39
+	PythonParserSTRING             = 40
+	PythonParserNUMBER             = 41
+	PythonParserELLIPSIS           = 42
+	PythonParserDOT                = 43
+	PythonParserSTAR               = 44
+	PythonParserOPEN_PAREN         = 45
+	PythonParserCLOSE_PAREN        = 46
+	PythonParserCOMMA              = 47
+	PythonParserWALRUS             = 48
+	PythonParserCOLON              = 49
+	PythonParserSEMI_COLON         = 50
+	PythonParserPOWER              = 51
+	PythonParserASSIGN             = 52
+	PythonParserOPEN_BRACKET       = 53
+	PythonParserCLOSE_BRACKET      = 54
+	PythonParserOR_OP              = 55
+	PythonParserXOR                = 56
+	PythonParserAND_OP             = 57
+	PythonParserLEFT_SHIFT         = 58
+	PythonParserRIGHT_SHIFT        = 59
+	PythonParserADD                = 60
+	PythonParserMINUS              = 61
+	PythonParserDIV                = 62
+	PythonParserMOD                = 63
+	PythonParserIDIV               = 64
+	PythonParserNOT_OP             = 65
+	PythonParserOPEN_BRACE         = 66
+	PythonParserCLOSE_BRACE        = 67
+	PythonParserLESS_THAN          = 68
+	PythonParserGREATER_THAN       = 69
+	PythonParserEQUALS             = 70
+	PythonParserGT_EQ              = 71
+	PythonParserLT_EQ              = 72
+	PythonParserNOT_EQ_1           = 73
+	PythonParserNOT_EQ_2           = 74
+	PythonParserAT                 = 75
+	PythonParserARROW              = 76
+	PythonParserADD_ASSIGN         = 77
+	PythonParserSUB_ASSIGN         = 78
+	PythonParserMULT_ASSIGN        = 79
+	PythonParserAT_ASSIGN          = 80
+	PythonParserDIV_ASSIGN         = 81
+	PythonParserMOD_ASSIGN         = 82
+	PythonParserAND_ASSIGN         = 83
+	PythonParserOR_ASSIGN          = 84
+	PythonParserXOR_ASSIGN         = 85
+	PythonParserLEFT_SHIFT_ASSIGN  = 86
+	PythonParserRIGHT_SHIFT_ASSIGN = 87
+	PythonParserPOWER_ASSIGN       = 88
+	PythonParserIDIV_ASSIGN        = 89
+	PythonParserNEWLINE            = 90
+	PythonParserNAME               = 91
+	PythonParserWS                 = 92
+	PythonParserLINE_JOINING       = 93
+	PythonParserCOMMENT            = 94
+	PythonParserERROR_CHAR         = 95
+)
+
+// PythonParser rules.
+const (
+	PythonParserRULE_fileInput         = 0
+	PythonParserRULE_statement         = 1
+	PythonParserRULE_simpleStatements  = 2
+	PythonParserRULE_simpleStatement   = 3
+	PythonParserRULE_assignPart        = 4
+	PythonParserRULE_augAssign         = 5
+	PythonParserRULE_importFromModule  = 6
+	PythonParserRULE_importTargets     = 7
+	PythonParserRULE_importAsNames     = 8
+	PythonParserRULE_importAsName      = 9
+	PythonParserRULE_dottedAsNames     = 10
+	PythonParserRULE_dottedAsName      = 11
+	PythonParserRULE_dottedName        = 12
+	PythonParserRULE_compoundStatement = 13
+	PythonParserRULE_block             = 14
+	PythonParserRULE_elifClause        = 15
+	PythonParserRULE_elseClause        = 16
+	PythonParserRULE_exceptClause      = 17
+	PythonParserRULE_finallyClause     = 18
+	PythonParserRULE_withItems         = 19
+	PythonParserRULE_withItem          = 20
+	PythonParserRULE_decorator         = 21
+	PythonParserRULE_funcDef           = 22
+	PythonParserRULE_classDef          = 23
+	PythonParserRULE_typedArgsList     = 24
+	PythonParserRULE_typedArg          = 25
+	PythonParserRULE_varArgsList       = 26
+	PythonParserRULE_varArg            = 27
+	PythonParserRULE_subjectExpr       = 28
+	PythonParserRULE_caseBlock         = 29
+	PythonParserRULE_casePatterns      = 30
+	PythonParserRULE_casePattern       = 31
+	PythonParserRULE_orPattern         = 32
+	PythonParserRULE_closedPattern     = 33
+	PythonParserRULE_literalPattern    = 34
+	PythonParserRULE_mappingPattern    = 35
+	PythonParserRULE_classPatternArg   = 36
+	PythonParserRULE_namedExprTest     = 37
+	PythonParserRULE_test              = 38
+	PythonParserRULE_testNoCond        = 39
+	PythonParserRULE_lambdef           = 40
+	PythonParserRULE_lambdefNoCond     = 41
+	PythonParserRULE_orTest            = 42
+	PythonParserRULE_andTest           = 43
+	PythonParserRULE_notTest           = 44
+	PythonParserRULE_comparison        = 45
+	PythonParserRULE_compOp            = 46
+	PythonParserRULE_starExpr          = 47
+	PythonParserRULE_expr              = 48
+	PythonParserRULE_atomExpr          = 49
+	PythonParserRULE_atom              = 50
+	PythonParserRULE_name              = 51
+	PythonParserRULE_testlistComp      = 52
+	PythonParserRULE_trailer           = 53
+	PythonParserRULE_subscriptList     = 54
+	PythonParserRULE_subscript         = 55
+	PythonParserRULE_exprlist          = 56
+	PythonParserRULE_testlist          = 57
+	PythonParserRULE_testlistStarExpr  = 58
+	PythonParserRULE_dictOrSetMaker    = 59
+	PythonParserRULE_dictItem          = 60
+	PythonParserRULE_arglist           = 61
+	PythonParserRULE_argument          = 62
+	PythonParserRULE_compFor           = 63
+	PythonParserRULE_compIter          = 64
+	PythonParserRULE_compIf            = 65
+	PythonParserRULE_yieldExpr         = 66
+)
+
+// IFileInputContext is an interface to support dynamic dispatch.
+type IFileInputContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFileInputContext differentiates from other interfaces.
+	IsFileInputContext()
+}
+
+type FileInputContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFileInputContext() *FileInputContext {
+	var p = new(FileInputContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_fileInput
+	return p
+}
+
+func (*FileInputContext) IsFileInputContext() {}
+
+func NewFileInputContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FileInputContext {
+	var p = new(FileInputContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_fileInput
+
+	return p
+}
+
+func (s *FileInputContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FileInputContext) EOF() antlr.TerminalNode {
+	return s.GetToken(PythonParserEOF, 0)
+}
+
+func (s *FileInputContext) AllNEWLINE() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserNEWLINE)
+}
+
+func (s *FileInputContext) NEWLINE(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserNEWLINE, i)
+}
+
+func (s *FileInputContext) AllStatement() []IStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatementContext); ok {
+			tst[i] = t.(IStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *FileInputContext) Statement(i int) IStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *FileInputContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FileInputContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FileInputContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitFileInput(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) FileInput() (localctx IFileInputContext) {
+	this := p
+	_ = this
+
+	localctx = NewFileInputContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 0, PythonParserRULE_fileInput)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(138)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467833284551049016) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&100664323) != 0 {
+		p.SetState(136)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserNEWLINE:
+			{
+				p.SetState(134)
+				p.Match(PythonParserNEWLINE)
+			}
+
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserASSERT, PythonParserASYNC, PythonParserAWAIT, PythonParserBREAK, PythonParserCLASS, PythonParserCONTINUE, PythonParserDEF, PythonParserDEL, PythonParserFOR, PythonParserFROM, PythonParserGLOBAL, PythonParserIF, PythonParserIMPORT, PythonParserLAMBDA, PythonParserNONLOCAL, PythonParserNOT, PythonParserPASS, PythonParserRAISE, PythonParserRETURN, PythonParserTRY, PythonParserWHILE, PythonParserWITH, PythonParserYIELD, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserAT, PythonParserNAME:
+			{
+				p.SetState(135)
+				p.Statement()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+		p.SetState(140)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+	{
+		p.SetState(141)
+		p.Match(PythonParserEOF)
+	}
+
+	return localctx
+}
+
+// IStatementContext is an interface to support dynamic dispatch.
+type IStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStatementContext differentiates from other interfaces.
+	IsStatementContext()
+}
+
+type StatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStatementContext() *StatementContext {
+	var p = new(StatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_statement
+	return p
+}
+
+func (*StatementContext) IsStatementContext() {}
+
+func NewStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StatementContext {
+	var p = new(StatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_statement
+
+	return p
+}
+
+func (s *StatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StatementContext) SimpleStatements() ISimpleStatementsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISimpleStatementsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStatementsContext)
+}
+
+func (s *StatementContext) CompoundStatement() ICompoundStatementContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompoundStatementContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompoundStatementContext)
+}
+
+func (s *StatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Statement() (localctx IStatementContext) {
+	this := p
+	_ = this
+
+	localctx = NewStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 2, PythonParserRULE_statement)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(145)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 2, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(143)
+			p.SimpleStatements()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(144)
+			p.CompoundStatement()
+		}
+
+	}
+
+	return localctx
+}
+
+// ISimpleStatementsContext is an interface to support dynamic dispatch.
+type ISimpleStatementsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSimpleStatementsContext differentiates from other interfaces.
+	IsSimpleStatementsContext()
+}
+
+type SimpleStatementsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySimpleStatementsContext() *SimpleStatementsContext {
+	var p = new(SimpleStatementsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_simpleStatements
+	return p
+}
+
+func (*SimpleStatementsContext) IsSimpleStatementsContext() {}
+
+func NewSimpleStatementsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SimpleStatementsContext {
+	var p = new(SimpleStatementsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_simpleStatements
+
+	return p
+}
+
+func (s *SimpleStatementsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SimpleStatementsContext) AllSimpleStatement() []ISimpleStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISimpleStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISimpleStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISimpleStatementContext); ok {
+			tst[i] = t.(ISimpleStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SimpleStatementsContext) SimpleStatement(i int) ISimpleStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISimpleStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStatementContext)
+}
+
+func (s *SimpleStatementsContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNEWLINE, 0)
+}
+
+func (s *SimpleStatementsContext) AllSEMI_COLON() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserSEMI_COLON)
+}
+
+func (s *SimpleStatementsContext) SEMI_COLON(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserSEMI_COLON, i)
+}
+
+func (s *SimpleStatementsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SimpleStatementsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SimpleStatementsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSimpleStatements(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) SimpleStatements() (localctx ISimpleStatementsContext) {
+	this := p
+	_ = this
+
+	localctx = NewSimpleStatementsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 4, PythonParserRULE_simpleStatements)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(147)
+		p.SimpleStatement()
+	}
+	p.SetState(152)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(148)
+				p.Match(PythonParserSEMI_COLON)
+			}
+			{
+				p.SetState(149)
+				p.SimpleStatement()
+			}
+
+		}
+		p.SetState(154)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 3, p.GetParserRuleContext())
+	}
+	p.SetState(156)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserSEMI_COLON {
+		{
+			p.SetState(155)
+			p.Match(PythonParserSEMI_COLON)
+		}
+
+	}
+	{
+		p.SetState(158)
+		p.Match(PythonParserNEWLINE)
+	}
+
+	return localctx
+}
+
+// ISimpleStatementContext is an interface to support dynamic dispatch.
+type ISimpleStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSimpleStatementContext differentiates from other interfaces.
+	IsSimpleStatementContext()
+}
+
+type SimpleStatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySimpleStatementContext() *SimpleStatementContext {
+	var p = new(SimpleStatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_simpleStatement
+	return p
+}
+
+func (*SimpleStatementContext) IsSimpleStatementContext() {}
+
+func NewSimpleStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SimpleStatementContext {
+	var p = new(SimpleStatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_simpleStatement
+
+	return p
+}
+
+func (s *SimpleStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SimpleStatementContext) CopyFrom(ctx *SimpleStatementContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *SimpleStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SimpleStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type YieldStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewYieldStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *YieldStatementContext {
+	var p = new(YieldStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *YieldStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *YieldStatementContext) YieldExpr() IYieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldExprContext)
+}
+
+func (s *YieldStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitYieldStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type GlobalStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewGlobalStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GlobalStatementContext {
+	var p = new(GlobalStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *GlobalStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GlobalStatementContext) GLOBAL() antlr.TerminalNode {
+	return s.GetToken(PythonParserGLOBAL, 0)
+}
+
+func (s *GlobalStatementContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *GlobalStatementContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *GlobalStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *GlobalStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *GlobalStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitGlobalStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DelStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewDelStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DelStatementContext {
+	var p = new(DelStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *DelStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DelStatementContext) DEL() antlr.TerminalNode {
+	return s.GetToken(PythonParserDEL, 0)
+}
+
+func (s *DelStatementContext) Exprlist() IExprlistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprlistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprlistContext)
+}
+
+func (s *DelStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDelStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ImportStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewImportStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ImportStatementContext {
+	var p = new(ImportStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *ImportStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportStatementContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(PythonParserIMPORT, 0)
+}
+
+func (s *ImportStatementContext) DottedAsNames() IDottedAsNamesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDottedAsNamesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDottedAsNamesContext)
+}
+
+func (s *ImportStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type RaiseStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewRaiseStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *RaiseStatementContext {
+	var p = new(RaiseStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *RaiseStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *RaiseStatementContext) RAISE() antlr.TerminalNode {
+	return s.GetToken(PythonParserRAISE, 0)
+}
+
+func (s *RaiseStatementContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *RaiseStatementContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *RaiseStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(PythonParserFROM, 0)
+}
+
+func (s *RaiseStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitRaiseStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BreakStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewBreakStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BreakStatementContext {
+	var p = new(BreakStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *BreakStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BreakStatementContext) BREAK() antlr.TerminalNode {
+	return s.GetToken(PythonParserBREAK, 0)
+}
+
+func (s *BreakStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitBreakStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ExpressionStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewExpressionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ExpressionStatementContext {
+	var p = new(ExpressionStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *ExpressionStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExpressionStatementContext) TestlistStarExpr() ITestlistStarExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistStarExprContext)
+}
+
+func (s *ExpressionStatementContext) AssignPart() IAssignPartContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAssignPartContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAssignPartContext)
+}
+
+func (s *ExpressionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitExpressionStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ReturnStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewReturnStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ReturnStatementContext {
+	var p = new(ReturnStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *ReturnStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ReturnStatementContext) RETURN() antlr.TerminalNode {
+	return s.GetToken(PythonParserRETURN, 0)
+}
+
+func (s *ReturnStatementContext) TestlistStarExpr() ITestlistStarExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistStarExprContext)
+}
+
+func (s *ReturnStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitReturnStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NonlocalStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewNonlocalStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NonlocalStatementContext {
+	var p = new(NonlocalStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *NonlocalStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NonlocalStatementContext) NONLOCAL() antlr.TerminalNode {
+	return s.GetToken(PythonParserNONLOCAL, 0)
+}
+
+func (s *NonlocalStatementContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NonlocalStatementContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *NonlocalStatementContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *NonlocalStatementContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *NonlocalStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNonlocalStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AssertStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewAssertStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AssertStatementContext {
+	var p = new(AssertStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *AssertStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssertStatementContext) ASSERT() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSERT, 0)
+}
+
+func (s *AssertStatementContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AssertStatementContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *AssertStatementContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, 0)
+}
+
+func (s *AssertStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAssertStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type PassStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewPassStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PassStatementContext {
+	var p = new(PassStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *PassStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PassStatementContext) PASS() antlr.TerminalNode {
+	return s.GetToken(PythonParserPASS, 0)
+}
+
+func (s *PassStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitPassStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ContinueStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewContinueStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ContinueStatementContext {
+	var p = new(ContinueStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *ContinueStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ContinueStatementContext) CONTINUE() antlr.TerminalNode {
+	return s.GetToken(PythonParserCONTINUE, 0)
+}
+
+func (s *ContinueStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitContinueStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ImportFromStatementContext struct {
+	*SimpleStatementContext
+}
+
+func NewImportFromStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ImportFromStatementContext {
+	var p = new(ImportFromStatementContext)
+
+	p.SimpleStatementContext = NewEmptySimpleStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SimpleStatementContext))
+
+	return p
+}
+
+func (s *ImportFromStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportFromStatementContext) FROM() antlr.TerminalNode {
+	return s.GetToken(PythonParserFROM, 0)
+}
+
+func (s *ImportFromStatementContext) ImportFromModule() IImportFromModuleContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportFromModuleContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportFromModuleContext)
+}
+
+func (s *ImportFromStatementContext) IMPORT() antlr.TerminalNode {
+	return s.GetToken(PythonParserIMPORT, 0)
+}
+
+func (s *ImportFromStatementContext) ImportTargets() IImportTargetsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportTargetsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportTargetsContext)
+}
+
+func (s *ImportFromStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportFromStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) SimpleStatement() (localctx ISimpleStatementContext) {
+	this := p
+	_ = this
+
+	localctx = NewSimpleStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 6, PythonParserRULE_simpleStatement)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(213)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		localctx = NewExpressionStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(160)
+			p.TestlistStarExpr()
+		}
+		p.SetState(162)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-49)) & ^0x3f) == 0 && ((int64(1)<<(_la-49))&2198754820105) != 0 {
+			{
+				p.SetState(161)
+				p.AssignPart()
+			}
+
+		}
+
+	case PythonParserYIELD:
+		localctx = NewYieldStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(164)
+			p.YieldExpr()
+		}
+
+	case PythonParserDEL:
+		localctx = NewDelStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(165)
+			p.Match(PythonParserDEL)
+		}
+		{
+			p.SetState(166)
+			p.Exprlist()
+		}
+
+	case PythonParserPASS:
+		localctx = NewPassStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(167)
+			p.Match(PythonParserPASS)
+		}
+
+	case PythonParserBREAK:
+		localctx = NewBreakStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(168)
+			p.Match(PythonParserBREAK)
+		}
+
+	case PythonParserCONTINUE:
+		localctx = NewContinueStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(169)
+			p.Match(PythonParserCONTINUE)
+		}
+
+	case PythonParserRETURN:
+		localctx = NewReturnStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(170)
+			p.Match(PythonParserRETURN)
+		}
+		p.SetState(172)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467833011519620152) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(171)
+				p.TestlistStarExpr()
+			}
+
+		}
+
+	case PythonParserRAISE:
+		localctx = NewRaiseStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(174)
+			p.Match(PythonParserRAISE)
+		}
+		p.SetState(180)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467815419333575736) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(175)
+				p.Test()
+			}
+			p.SetState(178)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserFROM {
+				{
+					p.SetState(176)
+					p.Match(PythonParserFROM)
+				}
+				{
+					p.SetState(177)
+					p.Test()
+				}
+
+			}
+
+		}
+
+	case PythonParserIMPORT:
+		localctx = NewImportStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(182)
+			p.Match(PythonParserIMPORT)
+		}
+		{
+			p.SetState(183)
+			p.DottedAsNames()
+		}
+
+	case PythonParserFROM:
+		localctx = NewImportFromStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(184)
+			p.Match(PythonParserFROM)
+		}
+		{
+			p.SetState(185)
+			p.ImportFromModule()
+		}
+		{
+			p.SetState(186)
+			p.Match(PythonParserIMPORT)
+		}
+		{
+			p.SetState(187)
+			p.ImportTargets()
+		}
+
+	case PythonParserGLOBAL:
+		localctx = NewGlobalStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(189)
+			p.Match(PythonParserGLOBAL)
+		}
+		{
+			p.SetState(190)
+			p.Name()
+		}
+		p.SetState(195)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserCOMMA {
+			{
+				p.SetState(191)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(192)
+				p.Name()
+			}
+
+			p.SetState(197)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case PythonParserNONLOCAL:
+		localctx = NewNonlocalStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 12)
+		{
+			p.SetState(198)
+			p.Match(PythonParserNONLOCAL)
+		}
+		{
+			p.SetState(199)
+			p.Name()
+		}
+		p.SetState(204)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserCOMMA {
+			{
+				p.SetState(200)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(201)
+				p.Name()
+			}
+
+			p.SetState(206)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case PythonParserASSERT:
+		localctx = NewAssertStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 13)
+		{
+			p.SetState(207)
+			p.Match(PythonParserASSERT)
+		}
+		{
+			p.SetState(208)
+			p.Test()
+		}
+		p.SetState(211)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOMMA {
+			{
+				p.SetState(209)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(210)
+				p.Test()
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IAssignPartContext is an interface to support dynamic dispatch.
+type IAssignPartContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAssignPartContext differentiates from other interfaces.
+	IsAssignPartContext()
+}
+
+type AssignPartContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAssignPartContext() *AssignPartContext {
+	var p = new(AssignPartContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_assignPart
+	return p
+}
+
+func (*AssignPartContext) IsAssignPartContext() {}
+
+func NewAssignPartContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AssignPartContext {
+	var p = new(AssignPartContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_assignPart
+
+	return p
+}
+
+func (s *AssignPartContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AssignPartContext) CopyFrom(ctx *AssignPartContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *AssignPartContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AssignPartContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type AugmentedAssignContext struct {
+	*AssignPartContext
+}
+
+func NewAugmentedAssignContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AugmentedAssignContext {
+	var p = new(AugmentedAssignContext)
+
+	p.AssignPartContext = NewEmptyAssignPartContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AssignPartContext))
+
+	return p
+}
+
+func (s *AugmentedAssignContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AugmentedAssignContext) AugAssign() IAugAssignContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAugAssignContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAugAssignContext)
+}
+
+func (s *AugmentedAssignContext) YieldExpr() IYieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldExprContext)
+}
+
+func (s *AugmentedAssignContext) Testlist() ITestlistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistContext)
+}
+
+func (s *AugmentedAssignContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAugmentedAssign(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type AnnotatedAssignContext struct {
+	*AssignPartContext
+}
+
+func NewAnnotatedAssignContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AnnotatedAssignContext {
+	var p = new(AnnotatedAssignContext)
+
+	p.AssignPartContext = NewEmptyAssignPartContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AssignPartContext))
+
+	return p
+}
+
+func (s *AnnotatedAssignContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AnnotatedAssignContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *AnnotatedAssignContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *AnnotatedAssignContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, 0)
+}
+
+func (s *AnnotatedAssignContext) YieldExpr() IYieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldExprContext)
+}
+
+func (s *AnnotatedAssignContext) TestlistStarExpr() ITestlistStarExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistStarExprContext)
+}
+
+func (s *AnnotatedAssignContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAnnotatedAssign(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NormalAssignContext struct {
+	*AssignPartContext
+}
+
+func NewNormalAssignContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NormalAssignContext {
+	var p = new(NormalAssignContext)
+
+	p.AssignPartContext = NewEmptyAssignPartContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AssignPartContext))
+
+	return p
+}
+
+func (s *NormalAssignContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NormalAssignContext) AllASSIGN() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserASSIGN)
+}
+
+func (s *NormalAssignContext) ASSIGN(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, i)
+}
+
+func (s *NormalAssignContext) AllYieldExpr() []IYieldExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IYieldExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IYieldExprContext); ok {
+			tst[i] = t.(IYieldExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NormalAssignContext) YieldExpr(i int) IYieldExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldExprContext)
+}
+
+func (s *NormalAssignContext) AllTestlistStarExpr() []ITestlistStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestlistStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestlistStarExprContext); ok {
+			tst[i] = t.(ITestlistStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NormalAssignContext) TestlistStarExpr(i int) ITestlistStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistStarExprContext)
+}
+
+func (s *NormalAssignContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNormalAssign(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) AssignPart() (localctx IAssignPartContext) {
+	this := p
+	_ = this
+
+	localctx = NewAssignPartContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 8, PythonParserRULE_assignPart)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(238)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserCOLON:
+		localctx = NewAnnotatedAssignContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(215)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(216)
+			p.Test()
+		}
+		p.SetState(222)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASSIGN {
+			{
+				p.SetState(217)
+				p.Match(PythonParserASSIGN)
+			}
+			p.SetState(220)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case PythonParserYIELD:
+				{
+					p.SetState(218)
+					p.YieldExpr()
+				}
+
+			case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+				{
+					p.SetState(219)
+					p.TestlistStarExpr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		}
+
+	case PythonParserADD_ASSIGN, PythonParserSUB_ASSIGN, PythonParserMULT_ASSIGN, PythonParserAT_ASSIGN, PythonParserDIV_ASSIGN, PythonParserMOD_ASSIGN, PythonParserAND_ASSIGN, PythonParserOR_ASSIGN, PythonParserXOR_ASSIGN, PythonParserLEFT_SHIFT_ASSIGN, PythonParserRIGHT_SHIFT_ASSIGN, PythonParserPOWER_ASSIGN, PythonParserIDIV_ASSIGN:
+		localctx = NewAugmentedAssignContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(224)
+			p.AugAssign()
+		}
+		p.SetState(227)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserYIELD:
+			{
+				p.SetState(225)
+				p.YieldExpr()
+			}
+
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+			{
+				p.SetState(226)
+				p.Testlist()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	case PythonParserASSIGN:
+		localctx = NewNormalAssignContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(234)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == PythonParserASSIGN {
+			{
+				p.SetState(229)
+				p.Match(PythonParserASSIGN)
+			}
+			p.SetState(232)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case PythonParserYIELD:
+				{
+					p.SetState(230)
+					p.YieldExpr()
+				}
+
+			case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+				{
+					p.SetState(231)
+					p.TestlistStarExpr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(236)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IAugAssignContext is an interface to support dynamic dispatch.
+type IAugAssignContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAugAssignContext differentiates from other interfaces.
+	IsAugAssignContext()
+}
+
+type AugAssignContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAugAssignContext() *AugAssignContext {
+	var p = new(AugAssignContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_augAssign
+	return p
+}
+
+func (*AugAssignContext) IsAugAssignContext() {}
+
+func NewAugAssignContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AugAssignContext {
+	var p = new(AugAssignContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_augAssign
+
+	return p
+}
+
+func (s *AugAssignContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AugAssignContext) ADD_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserADD_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) SUB_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserSUB_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) MULT_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserMULT_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) AT_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserAT_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) DIV_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserDIV_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) MOD_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserMOD_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) AND_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserAND_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) OR_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOR_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) XOR_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserXOR_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) LEFT_SHIFT_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserLEFT_SHIFT_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) RIGHT_SHIFT_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserRIGHT_SHIFT_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) POWER_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) IDIV_ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserIDIV_ASSIGN, 0)
+}
+
+func (s *AugAssignContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AugAssignContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AugAssignContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAugAssign(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) AugAssign() (localctx IAugAssignContext) {
+	this := p
+	_ = this
+
+	localctx = NewAugAssignContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 10, PythonParserRULE_augAssign)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(240)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-77)) & ^0x3f) == 0 && ((int64(1)<<(_la-77))&8191) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// IImportFromModuleContext is an interface to support dynamic dispatch.
+type IImportFromModuleContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsImportFromModuleContext differentiates from other interfaces.
+	IsImportFromModuleContext()
+}
+
+type ImportFromModuleContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyImportFromModuleContext() *ImportFromModuleContext {
+	var p = new(ImportFromModuleContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_importFromModule
+	return p
+}
+
+func (*ImportFromModuleContext) IsImportFromModuleContext() {}
+
+func NewImportFromModuleContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportFromModuleContext {
+	var p = new(ImportFromModuleContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_importFromModule
+
+	return p
+}
+
+func (s *ImportFromModuleContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ImportFromModuleContext) DottedName() IDottedNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDottedNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDottedNameContext)
+}
+
+func (s *ImportFromModuleContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserDOT)
+}
+
+func (s *ImportFromModuleContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserDOT, i)
+}
+
+func (s *ImportFromModuleContext) AllELLIPSIS() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserELLIPSIS)
+}
+
+func (s *ImportFromModuleContext) ELLIPSIS(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserELLIPSIS, i)
+}
+
+func (s *ImportFromModuleContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportFromModuleContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ImportFromModuleContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportFromModule(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ImportFromModule() (localctx IImportFromModuleContext) {
+	this := p
+	_ = this
+
+	localctx = NewImportFromModuleContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 12, PythonParserRULE_importFromModule)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(254)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 21, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(245)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserELLIPSIS || _la == PythonParserDOT {
+			{
+				p.SetState(242)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == PythonParserELLIPSIS || _la == PythonParserDOT) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+			p.SetState(247)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(248)
+			p.DottedName()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(250)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == PythonParserELLIPSIS || _la == PythonParserDOT {
+			{
+				p.SetState(249)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == PythonParserELLIPSIS || _la == PythonParserDOT) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+
+			p.SetState(252)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+
+	return localctx
+}
+
+// IImportTargetsContext is an interface to support dynamic dispatch.
+type IImportTargetsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsImportTargetsContext differentiates from other interfaces.
+	IsImportTargetsContext()
+}
+
+type ImportTargetsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyImportTargetsContext() *ImportTargetsContext {
+	var p = new(ImportTargetsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_importTargets
+	return p
+}
+
+func (*ImportTargetsContext) IsImportTargetsContext() {}
+
+func NewImportTargetsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportTargetsContext {
+	var p = new(ImportTargetsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_importTargets
+
+	return p
+}
+
+func (s *ImportTargetsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ImportTargetsContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *ImportTargetsContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *ImportTargetsContext) ImportAsNames() IImportAsNamesContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportAsNamesContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportAsNamesContext)
+}
+
+func (s *ImportTargetsContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *ImportTargetsContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, 0)
+}
+
+func (s *ImportTargetsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportTargetsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ImportTargetsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportTargets(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ImportTargets() (localctx IImportTargetsContext) {
+	this := p
+	_ = this
+
+	localctx = NewImportTargetsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 14, PythonParserRULE_importTargets)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(268)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserSTAR:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(256)
+			p.Match(PythonParserSTAR)
+		}
+
+	case PythonParserOPEN_PAREN:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(257)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		{
+			p.SetState(258)
+			p.ImportAsNames()
+		}
+		p.SetState(260)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOMMA {
+			{
+				p.SetState(259)
+				p.Match(PythonParserCOMMA)
+			}
+
+		}
+		{
+			p.SetState(262)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case PythonParserMATCH, PythonParserCASE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(264)
+			p.ImportAsNames()
+		}
+		p.SetState(266)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOMMA {
+			{
+				p.SetState(265)
+				p.Match(PythonParserCOMMA)
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IImportAsNamesContext is an interface to support dynamic dispatch.
+type IImportAsNamesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsImportAsNamesContext differentiates from other interfaces.
+	IsImportAsNamesContext()
+}
+
+type ImportAsNamesContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyImportAsNamesContext() *ImportAsNamesContext {
+	var p = new(ImportAsNamesContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_importAsNames
+	return p
+}
+
+func (*ImportAsNamesContext) IsImportAsNamesContext() {}
+
+func NewImportAsNamesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportAsNamesContext {
+	var p = new(ImportAsNamesContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_importAsNames
+
+	return p
+}
+
+func (s *ImportAsNamesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ImportAsNamesContext) AllImportAsName() []IImportAsNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IImportAsNameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IImportAsNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IImportAsNameContext); ok {
+			tst[i] = t.(IImportAsNameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ImportAsNamesContext) ImportAsName(i int) IImportAsNameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IImportAsNameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IImportAsNameContext)
+}
+
+func (s *ImportAsNamesContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *ImportAsNamesContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *ImportAsNamesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportAsNamesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ImportAsNamesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportAsNames(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ImportAsNames() (localctx IImportAsNamesContext) {
+	this := p
+	_ = this
+
+	localctx = NewImportAsNamesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 16, PythonParserRULE_importAsNames)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(270)
+		p.ImportAsName()
+	}
+	p.SetState(275)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(271)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(272)
+				p.ImportAsName()
+			}
+
+		}
+		p.SetState(277)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 25, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IImportAsNameContext is an interface to support dynamic dispatch.
+type IImportAsNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsImportAsNameContext differentiates from other interfaces.
+	IsImportAsNameContext()
+}
+
+type ImportAsNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyImportAsNameContext() *ImportAsNameContext {
+	var p = new(ImportAsNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_importAsName
+	return p
+}
+
+func (*ImportAsNameContext) IsImportAsNameContext() {}
+
+func NewImportAsNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ImportAsNameContext {
+	var p = new(ImportAsNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_importAsName
+
+	return p
+}
+
+func (s *ImportAsNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ImportAsNameContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ImportAsNameContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *ImportAsNameContext) AS() antlr.TerminalNode {
+	return s.GetToken(PythonParserAS, 0)
+}
+
+func (s *ImportAsNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ImportAsNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ImportAsNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitImportAsName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ImportAsName() (localctx IImportAsNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewImportAsNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 18, PythonParserRULE_importAsName)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(278)
+		p.Name()
+	}
+	p.SetState(281)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserAS {
+		{
+			p.SetState(279)
+			p.Match(PythonParserAS)
+		}
+		{
+			p.SetState(280)
+			p.Name()
+		}
+
+	}
+
+	return localctx
+}
+
+// IDottedAsNamesContext is an interface to support dynamic dispatch.
+type IDottedAsNamesContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDottedAsNamesContext differentiates from other interfaces.
+	IsDottedAsNamesContext()
+}
+
+type DottedAsNamesContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDottedAsNamesContext() *DottedAsNamesContext {
+	var p = new(DottedAsNamesContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_dottedAsNames
+	return p
+}
+
+func (*DottedAsNamesContext) IsDottedAsNamesContext() {}
+
+func NewDottedAsNamesContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DottedAsNamesContext {
+	var p = new(DottedAsNamesContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_dottedAsNames
+
+	return p
+}
+
+func (s *DottedAsNamesContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DottedAsNamesContext) AllDottedAsName() []IDottedAsNameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDottedAsNameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDottedAsNameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDottedAsNameContext); ok {
+			tst[i] = t.(IDottedAsNameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DottedAsNamesContext) DottedAsName(i int) IDottedAsNameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDottedAsNameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDottedAsNameContext)
+}
+
+func (s *DottedAsNamesContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *DottedAsNamesContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *DottedAsNamesContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DottedAsNamesContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DottedAsNamesContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDottedAsNames(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) DottedAsNames() (localctx IDottedAsNamesContext) {
+	this := p
+	_ = this
+
+	localctx = NewDottedAsNamesContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 20, PythonParserRULE_dottedAsNames)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(283)
+		p.DottedAsName()
+	}
+	p.SetState(288)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == PythonParserCOMMA {
+		{
+			p.SetState(284)
+			p.Match(PythonParserCOMMA)
+		}
+		{
+			p.SetState(285)
+			p.DottedAsName()
+		}
+
+		p.SetState(290)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IDottedAsNameContext is an interface to support dynamic dispatch.
+type IDottedAsNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDottedAsNameContext differentiates from other interfaces.
+	IsDottedAsNameContext()
+}
+
+type DottedAsNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDottedAsNameContext() *DottedAsNameContext {
+	var p = new(DottedAsNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_dottedAsName
+	return p
+}
+
+func (*DottedAsNameContext) IsDottedAsNameContext() {}
+
+func NewDottedAsNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DottedAsNameContext {
+	var p = new(DottedAsNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_dottedAsName
+
+	return p
+}
+
+func (s *DottedAsNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DottedAsNameContext) DottedName() IDottedNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDottedNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDottedNameContext)
+}
+
+func (s *DottedAsNameContext) AS() antlr.TerminalNode {
+	return s.GetToken(PythonParserAS, 0)
+}
+
+func (s *DottedAsNameContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *DottedAsNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DottedAsNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DottedAsNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDottedAsName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) DottedAsName() (localctx IDottedAsNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewDottedAsNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 22, PythonParserRULE_dottedAsName)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(291)
+		p.DottedName()
+	}
+	p.SetState(294)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserAS {
+		{
+			p.SetState(292)
+			p.Match(PythonParserAS)
+		}
+		{
+			p.SetState(293)
+			p.Name()
+		}
+
+	}
+
+	return localctx
+}
+
+// IDottedNameContext is an interface to support dynamic dispatch.
+type IDottedNameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDottedNameContext differentiates from other interfaces.
+	IsDottedNameContext()
+}
+
+type DottedNameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDottedNameContext() *DottedNameContext {
+	var p = new(DottedNameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_dottedName
+	return p
+}
+
+func (*DottedNameContext) IsDottedNameContext() {}
+
+func NewDottedNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DottedNameContext {
+	var p = new(DottedNameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_dottedName
+
+	return p
+}
+
+func (s *DottedNameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DottedNameContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DottedNameContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *DottedNameContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserDOT)
+}
+
+func (s *DottedNameContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserDOT, i)
+}
+
+func (s *DottedNameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DottedNameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DottedNameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDottedName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) DottedName() (localctx IDottedNameContext) {
+	this := p
+	_ = this
+
+	localctx = NewDottedNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 24, PythonParserRULE_dottedName)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(296)
+		p.Name()
+	}
+	p.SetState(301)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == PythonParserDOT {
+		{
+			p.SetState(297)
+			p.Match(PythonParserDOT)
+		}
+		{
+			p.SetState(298)
+			p.Name()
+		}
+
+		p.SetState(303)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ICompoundStatementContext is an interface to support dynamic dispatch.
+type ICompoundStatementContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompoundStatementContext differentiates from other interfaces.
+	IsCompoundStatementContext()
+}
+
+type CompoundStatementContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompoundStatementContext() *CompoundStatementContext {
+	var p = new(CompoundStatementContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_compoundStatement
+	return p
+}
+
+func (*CompoundStatementContext) IsCompoundStatementContext() {}
+
+func NewCompoundStatementContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompoundStatementContext {
+	var p = new(CompoundStatementContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_compoundStatement
+
+	return p
+}
+
+func (s *CompoundStatementContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompoundStatementContext) CopyFrom(ctx *CompoundStatementContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *CompoundStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompoundStatementContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type MatchStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewMatchStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MatchStatementContext {
+	var p = new(MatchStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *MatchStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MatchStatementContext) MATCH() antlr.TerminalNode {
+	return s.GetToken(PythonParserMATCH, 0)
+}
+
+func (s *MatchStatementContext) SubjectExpr() ISubjectExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubjectExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubjectExprContext)
+}
+
+func (s *MatchStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *MatchStatementContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNEWLINE, 0)
+}
+
+func (s *MatchStatementContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(PythonParserINDENT, 0)
+}
+
+func (s *MatchStatementContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(PythonParserDEDENT, 0)
+}
+
+func (s *MatchStatementContext) AllCaseBlock() []ICaseBlockContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICaseBlockContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICaseBlockContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICaseBlockContext); ok {
+			tst[i] = t.(ICaseBlockContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MatchStatementContext) CaseBlock(i int) ICaseBlockContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICaseBlockContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICaseBlockContext)
+}
+
+func (s *MatchStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitMatchStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type IfStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewIfStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IfStatementContext {
+	var p = new(IfStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *IfStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IfStatementContext) IF() antlr.TerminalNode {
+	return s.GetToken(PythonParserIF, 0)
+}
+
+func (s *IfStatementContext) NamedExprTest() INamedExprTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *IfStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *IfStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *IfStatementContext) AllElifClause() []IElifClauseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IElifClauseContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IElifClauseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IElifClauseContext); ok {
+			tst[i] = t.(IElifClauseContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *IfStatementContext) ElifClause(i int) IElifClauseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElifClauseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElifClauseContext)
+}
+
+func (s *IfStatementContext) ElseClause() IElseClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElseClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseClauseContext)
+}
+
+func (s *IfStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitIfStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DefinitionStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewDefinitionStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DefinitionStatementContext {
+	var p = new(DefinitionStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *DefinitionStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DefinitionStatementContext) ClassDef() IClassDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IClassDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClassDefContext)
+}
+
+func (s *DefinitionStatementContext) FuncDef() IFuncDefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFuncDefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFuncDefContext)
+}
+
+func (s *DefinitionStatementContext) AllDecorator() []IDecoratorContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDecoratorContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDecoratorContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDecoratorContext); ok {
+			tst[i] = t.(IDecoratorContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DefinitionStatementContext) Decorator(i int) IDecoratorContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDecoratorContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDecoratorContext)
+}
+
+func (s *DefinitionStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDefinitionStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TryStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewTryStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TryStatementContext {
+	var p = new(TryStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *TryStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TryStatementContext) TRY() antlr.TerminalNode {
+	return s.GetToken(PythonParserTRY, 0)
+}
+
+func (s *TryStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *TryStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *TryStatementContext) FinallyClause() IFinallyClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IFinallyClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IFinallyClauseContext)
+}
+
+func (s *TryStatementContext) AllExceptClause() []IExceptClauseContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExceptClauseContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExceptClauseContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExceptClauseContext); ok {
+			tst[i] = t.(IExceptClauseContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TryStatementContext) ExceptClause(i int) IExceptClauseContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExceptClauseContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExceptClauseContext)
+}
+
+func (s *TryStatementContext) ElseClause() IElseClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElseClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseClauseContext)
+}
+
+func (s *TryStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTryStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type WhileStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewWhileStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WhileStatementContext {
+	var p = new(WhileStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *WhileStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WhileStatementContext) WHILE() antlr.TerminalNode {
+	return s.GetToken(PythonParserWHILE, 0)
+}
+
+func (s *WhileStatementContext) NamedExprTest() INamedExprTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *WhileStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *WhileStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *WhileStatementContext) ElseClause() IElseClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElseClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseClauseContext)
+}
+
+func (s *WhileStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitWhileStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ForStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewForStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ForStatementContext {
+	var p = new(ForStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *ForStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ForStatementContext) FOR() antlr.TerminalNode {
+	return s.GetToken(PythonParserFOR, 0)
+}
+
+func (s *ForStatementContext) Exprlist() IExprlistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprlistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprlistContext)
+}
+
+func (s *ForStatementContext) IN() antlr.TerminalNode {
+	return s.GetToken(PythonParserIN, 0)
+}
+
+func (s *ForStatementContext) Testlist() ITestlistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistContext)
+}
+
+func (s *ForStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *ForStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ForStatementContext) ASYNC() antlr.TerminalNode {
+	return s.GetToken(PythonParserASYNC, 0)
+}
+
+func (s *ForStatementContext) ElseClause() IElseClauseContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IElseClauseContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IElseClauseContext)
+}
+
+func (s *ForStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitForStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type WithStatementContext struct {
+	*CompoundStatementContext
+}
+
+func NewWithStatementContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *WithStatementContext {
+	var p = new(WithStatementContext)
+
+	p.CompoundStatementContext = NewEmptyCompoundStatementContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CompoundStatementContext))
+
+	return p
+}
+
+func (s *WithStatementContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WithStatementContext) WITH() antlr.TerminalNode {
+	return s.GetToken(PythonParserWITH, 0)
+}
+
+func (s *WithStatementContext) WithItems() IWithItemsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithItemsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithItemsContext)
+}
+
+func (s *WithStatementContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *WithStatementContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *WithStatementContext) ASYNC() antlr.TerminalNode {
+	return s.GetToken(PythonParserASYNC, 0)
+}
+
+func (s *WithStatementContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitWithStatement(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CompoundStatement() (localctx ICompoundStatementContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompoundStatementContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 26, PythonParserRULE_compoundStatement)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(383)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 43, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewIfStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(304)
+			p.Match(PythonParserIF)
+		}
+		{
+			p.SetState(305)
+			p.NamedExprTest()
+		}
+		{
+			p.SetState(306)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(307)
+			p.Block()
+		}
+		p.SetState(311)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserELIF {
+			{
+				p.SetState(308)
+				p.ElifClause()
+			}
+
+			p.SetState(313)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(315)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserELSE {
+			{
+				p.SetState(314)
+				p.ElseClause()
+			}
+
+		}
+
+	case 2:
+		localctx = NewWhileStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(317)
+			p.Match(PythonParserWHILE)
+		}
+		{
+			p.SetState(318)
+			p.NamedExprTest()
+		}
+		{
+			p.SetState(319)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(320)
+			p.Block()
+		}
+		p.SetState(322)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserELSE {
+			{
+				p.SetState(321)
+				p.ElseClause()
+			}
+
+		}
+
+	case 3:
+		localctx = NewForStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		p.SetState(325)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASYNC {
+			{
+				p.SetState(324)
+				p.Match(PythonParserASYNC)
+			}
+
+		}
+		{
+			p.SetState(327)
+			p.Match(PythonParserFOR)
+		}
+		{
+			p.SetState(328)
+			p.Exprlist()
+		}
+		{
+			p.SetState(329)
+			p.Match(PythonParserIN)
+		}
+		{
+			p.SetState(330)
+			p.Testlist()
+		}
+		{
+			p.SetState(331)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(332)
+			p.Block()
+		}
+		p.SetState(334)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserELSE {
+			{
+				p.SetState(333)
+				p.ElseClause()
+			}
+
+		}
+
+	case 4:
+		localctx = NewTryStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(336)
+			p.Match(PythonParserTRY)
+		}
+		{
+			p.SetState(337)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(338)
+			p.Block()
+		}
+		p.SetState(351)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserEXCEPT:
+			p.SetState(340)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for ok := true; ok; ok = _la == PythonParserEXCEPT {
+				{
+					p.SetState(339)
+					p.ExceptClause()
+				}
+
+				p.SetState(342)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+			p.SetState(345)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserELSE {
+				{
+					p.SetState(344)
+					p.ElseClause()
+				}
+
+			}
+			p.SetState(348)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserFINALLY {
+				{
+					p.SetState(347)
+					p.FinallyClause()
+				}
+
+			}
+
+		case PythonParserFINALLY:
+			{
+				p.SetState(350)
+				p.FinallyClause()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	case 5:
+		localctx = NewWithStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		p.SetState(354)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASYNC {
+			{
+				p.SetState(353)
+				p.Match(PythonParserASYNC)
+			}
+
+		}
+		{
+			p.SetState(356)
+			p.Match(PythonParserWITH)
+		}
+		{
+			p.SetState(357)
+			p.WithItems()
+		}
+		{
+			p.SetState(358)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(359)
+			p.Block()
+		}
+
+	case 6:
+		localctx = NewDefinitionStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		p.SetState(364)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserAT {
+			{
+				p.SetState(361)
+				p.Decorator()
+			}
+
+			p.SetState(366)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		p.SetState(369)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserCLASS:
+			{
+				p.SetState(367)
+				p.ClassDef()
+			}
+
+		case PythonParserASYNC, PythonParserDEF:
+			{
+				p.SetState(368)
+				p.FuncDef()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	case 7:
+		localctx = NewMatchStatementContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(371)
+			p.Match(PythonParserMATCH)
+		}
+		{
+			p.SetState(372)
+			p.SubjectExpr()
+		}
+		{
+			p.SetState(373)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(374)
+			p.Match(PythonParserNEWLINE)
+		}
+		{
+			p.SetState(375)
+			p.Match(PythonParserINDENT)
+		}
+		p.SetState(377)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == PythonParserCASE {
+			{
+				p.SetState(376)
+				p.CaseBlock()
+			}
+
+			p.SetState(379)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(381)
+			p.Match(PythonParserDEDENT)
+		}
+
+	}
+
+	return localctx
+}
+
+// IBlockContext is an interface to support dynamic dispatch.
+type IBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsBlockContext differentiates from other interfaces.
+	IsBlockContext()
+}
+
+type BlockContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyBlockContext() *BlockContext {
+	var p = new(BlockContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_block
+	return p
+}
+
+func (*BlockContext) IsBlockContext() {}
+
+func NewBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *BlockContext {
+	var p = new(BlockContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_block
+
+	return p
+}
+
+func (s *BlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *BlockContext) SimpleStatements() ISimpleStatementsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISimpleStatementsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISimpleStatementsContext)
+}
+
+func (s *BlockContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNEWLINE, 0)
+}
+
+func (s *BlockContext) INDENT() antlr.TerminalNode {
+	return s.GetToken(PythonParserINDENT, 0)
+}
+
+func (s *BlockContext) DEDENT() antlr.TerminalNode {
+	return s.GetToken(PythonParserDEDENT, 0)
+}
+
+func (s *BlockContext) AllStatement() []IStatementContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStatementContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStatementContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStatementContext); ok {
+			tst[i] = t.(IStatementContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BlockContext) Statement(i int) IStatementContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStatementContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStatementContext)
+}
+
+func (s *BlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *BlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitBlock(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Block() (localctx IBlockContext) {
+	this := p
+	_ = this
+
+	localctx = NewBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 28, PythonParserRULE_block)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(395)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserASSERT, PythonParserAWAIT, PythonParserBREAK, PythonParserCONTINUE, PythonParserDEL, PythonParserFROM, PythonParserGLOBAL, PythonParserIMPORT, PythonParserLAMBDA, PythonParserNONLOCAL, PythonParserNOT, PythonParserPASS, PythonParserRAISE, PythonParserRETURN, PythonParserYIELD, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(385)
+			p.SimpleStatements()
+		}
+
+	case PythonParserNEWLINE:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(386)
+			p.Match(PythonParserNEWLINE)
+		}
+		{
+			p.SetState(387)
+			p.Match(PythonParserINDENT)
+		}
+		p.SetState(389)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467833284551049016) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67109891) != 0 {
+			{
+				p.SetState(388)
+				p.Statement()
+			}
+
+			p.SetState(391)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+		{
+			p.SetState(393)
+			p.Match(PythonParserDEDENT)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IElifClauseContext is an interface to support dynamic dispatch.
+type IElifClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsElifClauseContext differentiates from other interfaces.
+	IsElifClauseContext()
+}
+
+type ElifClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElifClauseContext() *ElifClauseContext {
+	var p = new(ElifClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_elifClause
+	return p
+}
+
+func (*ElifClauseContext) IsElifClauseContext() {}
+
+func NewElifClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElifClauseContext {
+	var p = new(ElifClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_elifClause
+
+	return p
+}
+
+func (s *ElifClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ElifClauseContext) ELIF() antlr.TerminalNode {
+	return s.GetToken(PythonParserELIF, 0)
+}
+
+func (s *ElifClauseContext) NamedExprTest() INamedExprTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *ElifClauseContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *ElifClauseContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ElifClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ElifClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ElifClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitElifClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ElifClause() (localctx IElifClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewElifClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 30, PythonParserRULE_elifClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(397)
+		p.Match(PythonParserELIF)
+	}
+	{
+		p.SetState(398)
+		p.NamedExprTest()
+	}
+	{
+		p.SetState(399)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(400)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// IElseClauseContext is an interface to support dynamic dispatch.
+type IElseClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsElseClauseContext differentiates from other interfaces.
+	IsElseClauseContext()
+}
+
+type ElseClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyElseClauseContext() *ElseClauseContext {
+	var p = new(ElseClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_elseClause
+	return p
+}
+
+func (*ElseClauseContext) IsElseClauseContext() {}
+
+func NewElseClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ElseClauseContext {
+	var p = new(ElseClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_elseClause
+
+	return p
+}
+
+func (s *ElseClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ElseClauseContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(PythonParserELSE, 0)
+}
+
+func (s *ElseClauseContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *ElseClauseContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ElseClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ElseClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ElseClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitElseClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ElseClause() (localctx IElseClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewElseClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 32, PythonParserRULE_elseClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(402)
+		p.Match(PythonParserELSE)
+	}
+	{
+		p.SetState(403)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(404)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// IExceptClauseContext is an interface to support dynamic dispatch.
+type IExceptClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExceptClauseContext differentiates from other interfaces.
+	IsExceptClauseContext()
+}
+
+type ExceptClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExceptClauseContext() *ExceptClauseContext {
+	var p = new(ExceptClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_exceptClause
+	return p
+}
+
+func (*ExceptClauseContext) IsExceptClauseContext() {}
+
+func NewExceptClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExceptClauseContext {
+	var p = new(ExceptClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_exceptClause
+
+	return p
+}
+
+func (s *ExceptClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExceptClauseContext) EXCEPT() antlr.TerminalNode {
+	return s.GetToken(PythonParserEXCEPT, 0)
+}
+
+func (s *ExceptClauseContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *ExceptClauseContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ExceptClauseContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *ExceptClauseContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *ExceptClauseContext) AS() antlr.TerminalNode {
+	return s.GetToken(PythonParserAS, 0)
+}
+
+func (s *ExceptClauseContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *ExceptClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExceptClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExceptClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitExceptClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ExceptClause() (localctx IExceptClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewExceptClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 34, PythonParserRULE_exceptClause)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(406)
+		p.Match(PythonParserEXCEPT)
+	}
+	p.SetState(408)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserSTAR {
+		{
+			p.SetState(407)
+			p.Match(PythonParserSTAR)
+		}
+
+	}
+	p.SetState(415)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467815419333575736) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+		{
+			p.SetState(410)
+			p.Test()
+		}
+		p.SetState(413)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserAS {
+			{
+				p.SetState(411)
+				p.Match(PythonParserAS)
+			}
+			{
+				p.SetState(412)
+				p.Name()
+			}
+
+		}
+
+	}
+	{
+		p.SetState(417)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(418)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// IFinallyClauseContext is an interface to support dynamic dispatch.
+type IFinallyClauseContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFinallyClauseContext differentiates from other interfaces.
+	IsFinallyClauseContext()
+}
+
+type FinallyClauseContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFinallyClauseContext() *FinallyClauseContext {
+	var p = new(FinallyClauseContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_finallyClause
+	return p
+}
+
+func (*FinallyClauseContext) IsFinallyClauseContext() {}
+
+func NewFinallyClauseContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FinallyClauseContext {
+	var p = new(FinallyClauseContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_finallyClause
+
+	return p
+}
+
+func (s *FinallyClauseContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FinallyClauseContext) FINALLY() antlr.TerminalNode {
+	return s.GetToken(PythonParserFINALLY, 0)
+}
+
+func (s *FinallyClauseContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *FinallyClauseContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *FinallyClauseContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FinallyClauseContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FinallyClauseContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitFinallyClause(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) FinallyClause() (localctx IFinallyClauseContext) {
+	this := p
+	_ = this
+
+	localctx = NewFinallyClauseContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 36, PythonParserRULE_finallyClause)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(420)
+		p.Match(PythonParserFINALLY)
+	}
+	{
+		p.SetState(421)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(422)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// IWithItemsContext is an interface to support dynamic dispatch.
+type IWithItemsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWithItemsContext differentiates from other interfaces.
+	IsWithItemsContext()
+}
+
+type WithItemsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWithItemsContext() *WithItemsContext {
+	var p = new(WithItemsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_withItems
+	return p
+}
+
+func (*WithItemsContext) IsWithItemsContext() {}
+
+func NewWithItemsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithItemsContext {
+	var p = new(WithItemsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_withItems
+
+	return p
+}
+
+func (s *WithItemsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WithItemsContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *WithItemsContext) AllWithItem() []IWithItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IWithItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IWithItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IWithItemContext); ok {
+			tst[i] = t.(IWithItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *WithItemsContext) WithItem(i int) IWithItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IWithItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IWithItemContext)
+}
+
+func (s *WithItemsContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *WithItemsContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *WithItemsContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *WithItemsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WithItemsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WithItemsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitWithItems(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) WithItems() (localctx IWithItemsContext) {
+	this := p
+	_ = this
+
+	localctx = NewWithItemsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 38, PythonParserRULE_withItems)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(446)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 52, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(424)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		{
+			p.SetState(425)
+			p.WithItem()
+		}
+		p.SetState(430)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(426)
+					p.Match(PythonParserCOMMA)
+				}
+				{
+					p.SetState(427)
+					p.WithItem()
+				}
+
+			}
+			p.SetState(432)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 49, p.GetParserRuleContext())
+		}
+		p.SetState(434)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOMMA {
+			{
+				p.SetState(433)
+				p.Match(PythonParserCOMMA)
+			}
+
+		}
+		{
+			p.SetState(436)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(438)
+			p.WithItem()
+		}
+		p.SetState(443)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for _la == PythonParserCOMMA {
+			{
+				p.SetState(439)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(440)
+				p.WithItem()
+			}
+
+			p.SetState(445)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	}
+
+	return localctx
+}
+
+// IWithItemContext is an interface to support dynamic dispatch.
+type IWithItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsWithItemContext differentiates from other interfaces.
+	IsWithItemContext()
+}
+
+type WithItemContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyWithItemContext() *WithItemContext {
+	var p = new(WithItemContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_withItem
+	return p
+}
+
+func (*WithItemContext) IsWithItemContext() {}
+
+func NewWithItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *WithItemContext {
+	var p = new(WithItemContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_withItem
+
+	return p
+}
+
+func (s *WithItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *WithItemContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *WithItemContext) AS() antlr.TerminalNode {
+	return s.GetToken(PythonParserAS, 0)
+}
+
+func (s *WithItemContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *WithItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *WithItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *WithItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitWithItem(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) WithItem() (localctx IWithItemContext) {
+	this := p
+	_ = this
+
+	localctx = NewWithItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 40, PythonParserRULE_withItem)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(448)
+		p.Test()
+	}
+	p.SetState(451)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserAS {
+		{
+			p.SetState(449)
+			p.Match(PythonParserAS)
+		}
+		{
+			p.SetState(450)
+			p.expr(0)
+		}
+
+	}
+
+	return localctx
+}
+
+// IDecoratorContext is an interface to support dynamic dispatch.
+type IDecoratorContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDecoratorContext differentiates from other interfaces.
+	IsDecoratorContext()
+}
+
+type DecoratorContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDecoratorContext() *DecoratorContext {
+	var p = new(DecoratorContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_decorator
+	return p
+}
+
+func (*DecoratorContext) IsDecoratorContext() {}
+
+func NewDecoratorContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DecoratorContext {
+	var p = new(DecoratorContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_decorator
+
+	return p
+}
+
+func (s *DecoratorContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DecoratorContext) AT() antlr.TerminalNode {
+	return s.GetToken(PythonParserAT, 0)
+}
+
+func (s *DecoratorContext) NamedExprTest() INamedExprTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *DecoratorContext) NEWLINE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNEWLINE, 0)
+}
+
+func (s *DecoratorContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DecoratorContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DecoratorContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDecorator(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Decorator() (localctx IDecoratorContext) {
+	this := p
+	_ = this
+
+	localctx = NewDecoratorContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 42, PythonParserRULE_decorator)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(453)
+		p.Match(PythonParserAT)
+	}
+	{
+		p.SetState(454)
+		p.NamedExprTest()
+	}
+	{
+		p.SetState(455)
+		p.Match(PythonParserNEWLINE)
+	}
+
+	return localctx
+}
+
+// IFuncDefContext is an interface to support dynamic dispatch.
+type IFuncDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsFuncDefContext differentiates from other interfaces.
+	IsFuncDefContext()
+}
+
+type FuncDefContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyFuncDefContext() *FuncDefContext {
+	var p = new(FuncDefContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_funcDef
+	return p
+}
+
+func (*FuncDefContext) IsFuncDefContext() {}
+
+func NewFuncDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *FuncDefContext {
+	var p = new(FuncDefContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_funcDef
+
+	return p
+}
+
+func (s *FuncDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *FuncDefContext) DEF() antlr.TerminalNode {
+	return s.GetToken(PythonParserDEF, 0)
+}
+
+func (s *FuncDefContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *FuncDefContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *FuncDefContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *FuncDefContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *FuncDefContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *FuncDefContext) ASYNC() antlr.TerminalNode {
+	return s.GetToken(PythonParserASYNC, 0)
+}
+
+func (s *FuncDefContext) TypedArgsList() ITypedArgsListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypedArgsListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypedArgsListContext)
+}
+
+func (s *FuncDefContext) ARROW() antlr.TerminalNode {
+	return s.GetToken(PythonParserARROW, 0)
+}
+
+func (s *FuncDefContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *FuncDefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FuncDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *FuncDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitFuncDef(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) FuncDef() (localctx IFuncDefContext) {
+	this := p
+	_ = this
+
+	localctx = NewFuncDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 44, PythonParserRULE_funcDef)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(458)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserASYNC {
+		{
+			p.SetState(457)
+			p.Match(PythonParserASYNC)
+		}
+
+	}
+	{
+		p.SetState(460)
+		p.Match(PythonParserDEF)
+	}
+	{
+		p.SetState(461)
+		p.Name()
+	}
+	{
+		p.SetState(462)
+		p.Match(PythonParserOPEN_PAREN)
+	}
+	p.SetState(464)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199271526467) != 0 {
+		{
+			p.SetState(463)
+			p.TypedArgsList()
+		}
+
+	}
+	{
+		p.SetState(466)
+		p.Match(PythonParserCLOSE_PAREN)
+	}
+	p.SetState(469)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserARROW {
+		{
+			p.SetState(467)
+			p.Match(PythonParserARROW)
+		}
+		{
+			p.SetState(468)
+			p.Test()
+		}
+
+	}
+	{
+		p.SetState(471)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(472)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// IClassDefContext is an interface to support dynamic dispatch.
+type IClassDefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsClassDefContext differentiates from other interfaces.
+	IsClassDefContext()
+}
+
+type ClassDefContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyClassDefContext() *ClassDefContext {
+	var p = new(ClassDefContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_classDef
+	return p
+}
+
+func (*ClassDefContext) IsClassDefContext() {}
+
+func NewClassDefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassDefContext {
+	var p = new(ClassDefContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_classDef
+
+	return p
+}
+
+func (s *ClassDefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ClassDefContext) CLASS() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLASS, 0)
+}
+
+func (s *ClassDefContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *ClassDefContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *ClassDefContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *ClassDefContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *ClassDefContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *ClassDefContext) Arglist() IArglistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArglistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArglistContext)
+}
+
+func (s *ClassDefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClassDefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ClassDefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitClassDef(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ClassDef() (localctx IClassDefContext) {
+	this := p
+	_ = this
+
+	localctx = NewClassDefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 46, PythonParserRULE_classDef)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(474)
+		p.Match(PythonParserCLASS)
+	}
+	{
+		p.SetState(475)
+		p.Name()
+	}
+	p.SetState(481)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserOPEN_PAREN {
+		{
+			p.SetState(476)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		p.SetState(478)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3470084811333305400) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(477)
+				p.Arglist()
+			}
+
+		}
+		{
+			p.SetState(480)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	}
+	{
+		p.SetState(483)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(484)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// ITypedArgsListContext is an interface to support dynamic dispatch.
+type ITypedArgsListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypedArgsListContext differentiates from other interfaces.
+	IsTypedArgsListContext()
+}
+
+type TypedArgsListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypedArgsListContext() *TypedArgsListContext {
+	var p = new(TypedArgsListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_typedArgsList
+	return p
+}
+
+func (*TypedArgsListContext) IsTypedArgsListContext() {}
+
+func NewTypedArgsListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypedArgsListContext {
+	var p = new(TypedArgsListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_typedArgsList
+
+	return p
+}
+
+func (s *TypedArgsListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypedArgsListContext) AllTypedArg() []ITypedArgContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITypedArgContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITypedArgContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITypedArgContext); ok {
+			tst[i] = t.(ITypedArgContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TypedArgsListContext) TypedArg(i int) ITypedArgContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITypedArgContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITypedArgContext)
+}
+
+func (s *TypedArgsListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *TypedArgsListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *TypedArgsListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypedArgsListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TypedArgsListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTypedArgsList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) TypedArgsList() (localctx ITypedArgsListContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypedArgsListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 48, PythonParserRULE_typedArgsList)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(486)
+		p.TypedArg()
+	}
+	p.SetState(491)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(487)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(488)
+				p.TypedArg()
+			}
+
+		}
+		p.SetState(493)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 59, p.GetParserRuleContext())
+	}
+	p.SetState(495)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(494)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// ITypedArgContext is an interface to support dynamic dispatch.
+type ITypedArgContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTypedArgContext differentiates from other interfaces.
+	IsTypedArgContext()
+}
+
+type TypedArgContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTypedArgContext() *TypedArgContext {
+	var p = new(TypedArgContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_typedArg
+	return p
+}
+
+func (*TypedArgContext) IsTypedArgContext() {}
+
+func NewTypedArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TypedArgContext {
+	var p = new(TypedArgContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_typedArg
+
+	return p
+}
+
+func (s *TypedArgContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TypedArgContext) CopyFrom(ctx *TypedArgContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *TypedArgContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TypedArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PosOnlyMarkerContext struct {
+	*TypedArgContext
+}
+
+func NewPosOnlyMarkerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PosOnlyMarkerContext {
+	var p = new(PosOnlyMarkerContext)
+
+	p.TypedArgContext = NewEmptyTypedArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypedArgContext))
+
+	return p
+}
+
+func (s *PosOnlyMarkerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PosOnlyMarkerContext) DIV() antlr.TerminalNode {
+	return s.GetToken(PythonParserDIV, 0)
+}
+
+func (s *PosOnlyMarkerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitPosOnlyMarker(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NamedParameterContext struct {
+	*TypedArgContext
+}
+
+func NewNamedParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NamedParameterContext {
+	var p = new(NamedParameterContext)
+
+	p.TypedArgContext = NewEmptyTypedArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypedArgContext))
+
+	return p
+}
+
+func (s *NamedParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamedParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *NamedParameterContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *NamedParameterContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *NamedParameterContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *NamedParameterContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, 0)
+}
+
+func (s *NamedParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNamedParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type VarParameterContext struct {
+	*TypedArgContext
+}
+
+func NewVarParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *VarParameterContext {
+	var p = new(VarParameterContext)
+
+	p.TypedArgContext = NewEmptyTypedArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypedArgContext))
+
+	return p
+}
+
+func (s *VarParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VarParameterContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *VarParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *VarParameterContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *VarParameterContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *VarParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitVarParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type KwParameterContext struct {
+	*TypedArgContext
+}
+
+func NewKwParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *KwParameterContext {
+	var p = new(KwParameterContext)
+
+	p.TypedArgContext = NewEmptyTypedArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TypedArgContext))
+
+	return p
+}
+
+func (s *KwParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KwParameterContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *KwParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *KwParameterContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *KwParameterContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *KwParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitKwParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) TypedArg() (localctx ITypedArgContext) {
+	this := p
+	_ = this
+
+	localctx = NewTypedArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 50, PythonParserRULE_typedArg)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(521)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserMATCH, PythonParserCASE, PythonParserNAME:
+		localctx = NewNamedParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(497)
+			p.Name()
+		}
+		p.SetState(500)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOLON {
+			{
+				p.SetState(498)
+				p.Match(PythonParserCOLON)
+			}
+			{
+				p.SetState(499)
+				p.Test()
+			}
+
+		}
+		p.SetState(504)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASSIGN {
+			{
+				p.SetState(502)
+				p.Match(PythonParserASSIGN)
+			}
+			{
+				p.SetState(503)
+				p.Test()
+			}
+
+		}
+
+	case PythonParserSTAR:
+		localctx = NewVarParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(506)
+			p.Match(PythonParserSTAR)
+		}
+		p.SetState(512)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199254740995) != 0 {
+			{
+				p.SetState(507)
+				p.Name()
+			}
+			p.SetState(510)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOLON {
+				{
+					p.SetState(508)
+					p.Match(PythonParserCOLON)
+				}
+				{
+					p.SetState(509)
+					p.Test()
+				}
+
+			}
+
+		}
+
+	case PythonParserPOWER:
+		localctx = NewKwParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(514)
+			p.Match(PythonParserPOWER)
+		}
+		{
+			p.SetState(515)
+			p.Name()
+		}
+		p.SetState(518)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOLON {
+			{
+				p.SetState(516)
+				p.Match(PythonParserCOLON)
+			}
+			{
+				p.SetState(517)
+				p.Test()
+			}
+
+		}
+
+	case PythonParserDIV:
+		localctx = NewPosOnlyMarkerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(520)
+			p.Match(PythonParserDIV)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IVarArgsListContext is an interface to support dynamic dispatch.
+type IVarArgsListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsVarArgsListContext differentiates from other interfaces.
+	IsVarArgsListContext()
+}
+
+type VarArgsListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVarArgsListContext() *VarArgsListContext {
+	var p = new(VarArgsListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_varArgsList
+	return p
+}
+
+func (*VarArgsListContext) IsVarArgsListContext() {}
+
+func NewVarArgsListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarArgsListContext {
+	var p = new(VarArgsListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_varArgsList
+
+	return p
+}
+
+func (s *VarArgsListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VarArgsListContext) AllVarArg() []IVarArgContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IVarArgContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IVarArgContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IVarArgContext); ok {
+			tst[i] = t.(IVarArgContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *VarArgsListContext) VarArg(i int) IVarArgContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVarArgContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVarArgContext)
+}
+
+func (s *VarArgsListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *VarArgsListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *VarArgsListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VarArgsListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *VarArgsListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitVarArgsList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) VarArgsList() (localctx IVarArgsListContext) {
+	this := p
+	_ = this
+
+	localctx = NewVarArgsListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 52, PythonParserRULE_varArgsList)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(523)
+		p.VarArg()
+	}
+	p.SetState(528)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(524)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(525)
+				p.VarArg()
+			}
+
+		}
+		p.SetState(530)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 67, p.GetParserRuleContext())
+	}
+	p.SetState(532)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(531)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// IVarArgContext is an interface to support dynamic dispatch.
+type IVarArgContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsVarArgContext differentiates from other interfaces.
+	IsVarArgContext()
+}
+
+type VarArgContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyVarArgContext() *VarArgContext {
+	var p = new(VarArgContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_varArg
+	return p
+}
+
+func (*VarArgContext) IsVarArgContext() {}
+
+func NewVarArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *VarArgContext {
+	var p = new(VarArgContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_varArg
+
+	return p
+}
+
+func (s *VarArgContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *VarArgContext) CopyFrom(ctx *VarArgContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *VarArgContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *VarArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type LambdaVarParameterContext struct {
+	*VarArgContext
+}
+
+func NewLambdaVarParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LambdaVarParameterContext {
+	var p = new(LambdaVarParameterContext)
+
+	p.VarArgContext = NewEmptyVarArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*VarArgContext))
+
+	return p
+}
+
+func (s *LambdaVarParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdaVarParameterContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *LambdaVarParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *LambdaVarParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdaVarParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LambdaNamedParameterContext struct {
+	*VarArgContext
+}
+
+func NewLambdaNamedParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LambdaNamedParameterContext {
+	var p = new(LambdaNamedParameterContext)
+
+	p.VarArgContext = NewEmptyVarArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*VarArgContext))
+
+	return p
+}
+
+func (s *LambdaNamedParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdaNamedParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *LambdaNamedParameterContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, 0)
+}
+
+func (s *LambdaNamedParameterContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *LambdaNamedParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdaNamedParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LambdaPosOnlyMarkerContext struct {
+	*VarArgContext
+}
+
+func NewLambdaPosOnlyMarkerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LambdaPosOnlyMarkerContext {
+	var p = new(LambdaPosOnlyMarkerContext)
+
+	p.VarArgContext = NewEmptyVarArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*VarArgContext))
+
+	return p
+}
+
+func (s *LambdaPosOnlyMarkerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdaPosOnlyMarkerContext) DIV() antlr.TerminalNode {
+	return s.GetToken(PythonParserDIV, 0)
+}
+
+func (s *LambdaPosOnlyMarkerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdaPosOnlyMarker(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LambdaKwParameterContext struct {
+	*VarArgContext
+}
+
+func NewLambdaKwParameterContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LambdaKwParameterContext {
+	var p = new(LambdaKwParameterContext)
+
+	p.VarArgContext = NewEmptyVarArgContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*VarArgContext))
+
+	return p
+}
+
+func (s *LambdaKwParameterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdaKwParameterContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *LambdaKwParameterContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *LambdaKwParameterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdaKwParameter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) VarArg() (localctx IVarArgContext) {
+	this := p
+	_ = this
+
+	localctx = NewVarArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 54, PythonParserRULE_varArg)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(546)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserMATCH, PythonParserCASE, PythonParserNAME:
+		localctx = NewLambdaNamedParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(534)
+			p.Name()
+		}
+		p.SetState(537)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASSIGN {
+			{
+				p.SetState(535)
+				p.Match(PythonParserASSIGN)
+			}
+			{
+				p.SetState(536)
+				p.Test()
+			}
+
+		}
+
+	case PythonParserSTAR:
+		localctx = NewLambdaVarParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(539)
+			p.Match(PythonParserSTAR)
+		}
+		p.SetState(541)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199254740995) != 0 {
+			{
+				p.SetState(540)
+				p.Name()
+			}
+
+		}
+
+	case PythonParserPOWER:
+		localctx = NewLambdaKwParameterContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(543)
+			p.Match(PythonParserPOWER)
+		}
+		{
+			p.SetState(544)
+			p.Name()
+		}
+
+	case PythonParserDIV:
+		localctx = NewLambdaPosOnlyMarkerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(545)
+			p.Match(PythonParserDIV)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ISubjectExprContext is an interface to support dynamic dispatch.
+type ISubjectExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSubjectExprContext differentiates from other interfaces.
+	IsSubjectExprContext()
+}
+
+type SubjectExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubjectExprContext() *SubjectExprContext {
+	var p = new(SubjectExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_subjectExpr
+	return p
+}
+
+func (*SubjectExprContext) IsSubjectExprContext() {}
+
+func NewSubjectExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubjectExprContext {
+	var p = new(SubjectExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_subjectExpr
+
+	return p
+}
+
+func (s *SubjectExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubjectExprContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *SubjectExprContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *SubjectExprContext) AllNamedExprTest() []INamedExprTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INamedExprTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INamedExprTestContext); ok {
+			tst[i] = t.(INamedExprTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SubjectExprContext) NamedExprTest(i int) INamedExprTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *SubjectExprContext) AllStarExpr() []IStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStarExprContext); ok {
+			tst[i] = t.(IStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SubjectExprContext) StarExpr(i int) IStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *SubjectExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubjectExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubjectExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSubjectExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) SubjectExpr() (localctx ISubjectExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewSubjectExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 56, PythonParserRULE_subjectExpr)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(573)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 78, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(550)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+			{
+				p.SetState(548)
+				p.NamedExprTest()
+			}
+
+		case PythonParserSTAR:
+			{
+				p.SetState(549)
+				p.StarExpr()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+		{
+			p.SetState(552)
+			p.Match(PythonParserCOMMA)
+		}
+		p.SetState(570)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467833011519620152) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			p.SetState(555)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+				{
+					p.SetState(553)
+					p.NamedExprTest()
+				}
+
+			case PythonParserSTAR:
+				{
+					p.SetState(554)
+					p.StarExpr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+			p.SetState(564)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(557)
+						p.Match(PythonParserCOMMA)
+					}
+					p.SetState(560)
+					p.GetErrorHandler().Sync(p)
+
+					switch p.GetTokenStream().LA(1) {
+					case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+						{
+							p.SetState(558)
+							p.NamedExprTest()
+						}
+
+					case PythonParserSTAR:
+						{
+							p.SetState(559)
+							p.StarExpr()
+						}
+
+					default:
+						panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					}
+
+				}
+				p.SetState(566)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 75, p.GetParserRuleContext())
+			}
+			p.SetState(568)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOMMA {
+				{
+					p.SetState(567)
+					p.Match(PythonParserCOMMA)
+				}
+
+			}
+
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(572)
+			p.NamedExprTest()
+		}
+
+	}
+
+	return localctx
+}
+
+// ICaseBlockContext is an interface to support dynamic dispatch.
+type ICaseBlockContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCaseBlockContext differentiates from other interfaces.
+	IsCaseBlockContext()
+}
+
+type CaseBlockContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCaseBlockContext() *CaseBlockContext {
+	var p = new(CaseBlockContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_caseBlock
+	return p
+}
+
+func (*CaseBlockContext) IsCaseBlockContext() {}
+
+func NewCaseBlockContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CaseBlockContext {
+	var p = new(CaseBlockContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_caseBlock
+
+	return p
+}
+
+func (s *CaseBlockContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CaseBlockContext) CASE() antlr.TerminalNode {
+	return s.GetToken(PythonParserCASE, 0)
+}
+
+func (s *CaseBlockContext) CasePatterns() ICasePatternsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternsContext)
+}
+
+func (s *CaseBlockContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *CaseBlockContext) Block() IBlockContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IBlockContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IBlockContext)
+}
+
+func (s *CaseBlockContext) IF() antlr.TerminalNode {
+	return s.GetToken(PythonParserIF, 0)
+}
+
+func (s *CaseBlockContext) NamedExprTest() INamedExprTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *CaseBlockContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CaseBlockContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CaseBlockContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCaseBlock(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CaseBlock() (localctx ICaseBlockContext) {
+	this := p
+	_ = this
+
+	localctx = NewCaseBlockContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 58, PythonParserRULE_caseBlock)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(575)
+		p.Match(PythonParserCASE)
+	}
+	{
+		p.SetState(576)
+		p.CasePatterns()
+	}
+	p.SetState(579)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserIF {
+		{
+			p.SetState(577)
+			p.Match(PythonParserIF)
+		}
+		{
+			p.SetState(578)
+			p.NamedExprTest()
+		}
+
+	}
+	{
+		p.SetState(581)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(582)
+		p.Block()
+	}
+
+	return localctx
+}
+
+// ICasePatternsContext is an interface to support dynamic dispatch.
+type ICasePatternsContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCasePatternsContext differentiates from other interfaces.
+	IsCasePatternsContext()
+}
+
+type CasePatternsContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCasePatternsContext() *CasePatternsContext {
+	var p = new(CasePatternsContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_casePatterns
+	return p
+}
+
+func (*CasePatternsContext) IsCasePatternsContext() {}
+
+func NewCasePatternsContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CasePatternsContext {
+	var p = new(CasePatternsContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_casePatterns
+
+	return p
+}
+
+func (s *CasePatternsContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CasePatternsContext) AllCasePattern() []ICasePatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICasePatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICasePatternContext); ok {
+			tst[i] = t.(ICasePatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *CasePatternsContext) CasePattern(i int) ICasePatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternContext)
+}
+
+func (s *CasePatternsContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *CasePatternsContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *CasePatternsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CasePatternsContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CasePatternsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCasePatterns(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CasePatterns() (localctx ICasePatternsContext) {
+	this := p
+	_ = this
+
+	localctx = NewCasePatternsContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 60, PythonParserRULE_casePatterns)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(584)
+		p.CasePattern()
+	}
+	p.SetState(589)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(585)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(586)
+				p.CasePattern()
+			}
+
+		}
+		p.SetState(591)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 80, p.GetParserRuleContext())
+	}
+	p.SetState(593)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(592)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// ICasePatternContext is an interface to support dynamic dispatch.
+type ICasePatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCasePatternContext differentiates from other interfaces.
+	IsCasePatternContext()
+}
+
+type CasePatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCasePatternContext() *CasePatternContext {
+	var p = new(CasePatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_casePattern
+	return p
+}
+
+func (*CasePatternContext) IsCasePatternContext() {}
+
+func NewCasePatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CasePatternContext {
+	var p = new(CasePatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_casePattern
+
+	return p
+}
+
+func (s *CasePatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CasePatternContext) CopyFrom(ctx *CasePatternContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *CasePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CasePatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type AsPatternContext struct {
+	*CasePatternContext
+}
+
+func NewAsPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AsPatternContext {
+	var p = new(AsPatternContext)
+
+	p.CasePatternContext = NewEmptyCasePatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CasePatternContext))
+
+	return p
+}
+
+func (s *AsPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AsPatternContext) OrPattern() IOrPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrPatternContext)
+}
+
+func (s *AsPatternContext) AS() antlr.TerminalNode {
+	return s.GetToken(PythonParserAS, 0)
+}
+
+func (s *AsPatternContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *AsPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAsPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StarPatternContext struct {
+	*CasePatternContext
+}
+
+func NewStarPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StarPatternContext {
+	var p = new(StarPatternContext)
+
+	p.CasePatternContext = NewEmptyCasePatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*CasePatternContext))
+
+	return p
+}
+
+func (s *StarPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StarPatternContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *StarPatternContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *StarPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStarPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CasePattern() (localctx ICasePatternContext) {
+	this := p
+	_ = this
+
+	localctx = NewCasePatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 62, PythonParserRULE_casePattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(602)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserSTAR:
+		localctx = NewStarPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(595)
+			p.Match(PythonParserSTAR)
+		}
+		{
+			p.SetState(596)
+			p.Name()
+		}
+
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserMINUS, PythonParserOPEN_BRACE, PythonParserNAME:
+		localctx = NewAsPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(597)
+			p.OrPattern()
+		}
+		p.SetState(600)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserAS {
+			{
+				p.SetState(598)
+				p.Match(PythonParserAS)
+			}
+			{
+				p.SetState(599)
+				p.Name()
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IOrPatternContext is an interface to support dynamic dispatch.
+type IOrPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOrPatternContext differentiates from other interfaces.
+	IsOrPatternContext()
+}
+
+type OrPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrPatternContext() *OrPatternContext {
+	var p = new(OrPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_orPattern
+	return p
+}
+
+func (*OrPatternContext) IsOrPatternContext() {}
+
+func NewOrPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrPatternContext {
+	var p = new(OrPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_orPattern
+
+	return p
+}
+
+func (s *OrPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrPatternContext) AllClosedPattern() []IClosedPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IClosedPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IClosedPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IClosedPatternContext); ok {
+			tst[i] = t.(IClosedPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OrPatternContext) ClosedPattern(i int) IClosedPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IClosedPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClosedPatternContext)
+}
+
+func (s *OrPatternContext) AllOR_OP() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserOR_OP)
+}
+
+func (s *OrPatternContext) OR_OP(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserOR_OP, i)
+}
+
+func (s *OrPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OrPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitOrPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) OrPattern() (localctx IOrPatternContext) {
+	this := p
+	_ = this
+
+	localctx = NewOrPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 64, PythonParserRULE_orPattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(604)
+		p.ClosedPattern()
+	}
+	p.SetState(609)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == PythonParserOR_OP {
+		{
+			p.SetState(605)
+			p.Match(PythonParserOR_OP)
+		}
+		{
+			p.SetState(606)
+			p.ClosedPattern()
+		}
+
+		p.SetState(611)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IClosedPatternContext is an interface to support dynamic dispatch.
+type IClosedPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsClosedPatternContext differentiates from other interfaces.
+	IsClosedPatternContext()
+}
+
+type ClosedPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyClosedPatternContext() *ClosedPatternContext {
+	var p = new(ClosedPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_closedPattern
+	return p
+}
+
+func (*ClosedPatternContext) IsClosedPatternContext() {}
+
+func NewClosedPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClosedPatternContext {
+	var p = new(ClosedPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_closedPattern
+
+	return p
+}
+
+func (s *ClosedPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ClosedPatternContext) CopyFrom(ctx *ClosedPatternContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ClosedPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClosedPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type ValuePatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewValuePatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ValuePatternContext {
+	var p = new(ValuePatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *ValuePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ValuePatternContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ValuePatternContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *ValuePatternContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserDOT)
+}
+
+func (s *ValuePatternContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserDOT, i)
+}
+
+func (s *ValuePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitValuePattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type TuplePatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewTuplePatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TuplePatternContext {
+	var p = new(TuplePatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *TuplePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TuplePatternContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *TuplePatternContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *TuplePatternContext) CasePattern() ICasePatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternContext)
+}
+
+func (s *TuplePatternContext) COMMA() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, 0)
+}
+
+func (s *TuplePatternContext) CasePatterns() ICasePatternsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternsContext)
+}
+
+func (s *TuplePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTuplePattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type GroupPatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewGroupPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *GroupPatternContext {
+	var p = new(GroupPatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *GroupPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *GroupPatternContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *GroupPatternContext) CasePattern() ICasePatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternContext)
+}
+
+func (s *GroupPatternContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *GroupPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitGroupPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type LiteralClosedPatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewLiteralClosedPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *LiteralClosedPatternContext {
+	var p = new(LiteralClosedPatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *LiteralClosedPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LiteralClosedPatternContext) LiteralPattern() ILiteralPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralPatternContext)
+}
+
+func (s *LiteralClosedPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLiteralClosedPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CapturePatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewCapturePatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CapturePatternContext {
+	var p = new(CapturePatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *CapturePatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CapturePatternContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *CapturePatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCapturePattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type MappingPatternsContext struct {
+	*ClosedPatternContext
+}
+
+func NewMappingPatternsContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *MappingPatternsContext {
+	var p = new(MappingPatternsContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *MappingPatternsContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MappingPatternsContext) OPEN_BRACE() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_BRACE, 0)
+}
+
+func (s *MappingPatternsContext) CLOSE_BRACE() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_BRACE, 0)
+}
+
+func (s *MappingPatternsContext) AllMappingPattern() []IMappingPatternContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IMappingPatternContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IMappingPatternContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IMappingPatternContext); ok {
+			tst[i] = t.(IMappingPatternContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MappingPatternsContext) MappingPattern(i int) IMappingPatternContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IMappingPatternContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IMappingPatternContext)
+}
+
+func (s *MappingPatternsContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *MappingPatternsContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *MappingPatternsContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitMappingPatterns(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ClassPatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewClassPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ClassPatternContext {
+	var p = new(ClassPatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *ClassPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClassPatternContext) DottedName() IDottedNameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDottedNameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDottedNameContext)
+}
+
+func (s *ClassPatternContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *ClassPatternContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *ClassPatternContext) AllClassPatternArg() []IClassPatternArgContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IClassPatternArgContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IClassPatternArgContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IClassPatternArgContext); ok {
+			tst[i] = t.(IClassPatternArgContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ClassPatternContext) ClassPatternArg(i int) IClassPatternArgContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IClassPatternArgContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IClassPatternArgContext)
+}
+
+func (s *ClassPatternContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *ClassPatternContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *ClassPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitClassPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ListPatternContext struct {
+	*ClosedPatternContext
+}
+
+func NewListPatternContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ListPatternContext {
+	var p = new(ListPatternContext)
+
+	p.ClosedPatternContext = NewEmptyClosedPatternContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ClosedPatternContext))
+
+	return p
+}
+
+func (s *ListPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListPatternContext) OPEN_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_BRACKET, 0)
+}
+
+func (s *ListPatternContext) CLOSE_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_BRACKET, 0)
+}
+
+func (s *ListPatternContext) CasePatterns() ICasePatternsContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternsContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternsContext)
+}
+
+func (s *ListPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitListPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ClosedPattern() (localctx IClosedPatternContext) {
+	this := p
+	_ = this
+
+	localctx = NewClosedPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 66, PythonParserRULE_closedPattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(671)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 95, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewLiteralClosedPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(612)
+			p.LiteralPattern()
+		}
+
+	case 2:
+		localctx = NewCapturePatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(613)
+			p.Name()
+		}
+
+	case 3:
+		localctx = NewValuePatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(614)
+			p.Name()
+		}
+		p.SetState(617)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == PythonParserDOT {
+			{
+				p.SetState(615)
+				p.Match(PythonParserDOT)
+			}
+			{
+				p.SetState(616)
+				p.Name()
+			}
+
+			p.SetState(619)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case 4:
+		localctx = NewGroupPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(621)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		{
+			p.SetState(622)
+			p.CasePattern()
+		}
+		{
+			p.SetState(623)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case 5:
+		localctx = NewTuplePatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(625)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		p.SetState(631)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2314907108195172408) != 0 || _la == PythonParserOPEN_BRACE || _la == PythonParserNAME {
+			{
+				p.SetState(626)
+				p.CasePattern()
+			}
+			{
+				p.SetState(627)
+				p.Match(PythonParserCOMMA)
+			}
+			p.SetState(629)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2314907108195172408) != 0 || _la == PythonParserOPEN_BRACE || _la == PythonParserNAME {
+				{
+					p.SetState(628)
+					p.CasePatterns()
+				}
+
+			}
+
+		}
+		{
+			p.SetState(633)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case 6:
+		localctx = NewListPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(634)
+			p.Match(PythonParserOPEN_BRACKET)
+		}
+		p.SetState(636)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2314907108195172408) != 0 || _la == PythonParserOPEN_BRACE || _la == PythonParserNAME {
+			{
+				p.SetState(635)
+				p.CasePatterns()
+			}
+
+		}
+		{
+			p.SetState(638)
+			p.Match(PythonParserCLOSE_BRACKET)
+		}
+
+	case 7:
+		localctx = NewMappingPatternsContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(639)
+			p.Match(PythonParserOPEN_BRACE)
+		}
+		p.SetState(651)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2308098932195983416) != 0 || _la == PythonParserNAME {
+			{
+				p.SetState(640)
+				p.MappingPattern()
+			}
+			p.SetState(645)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(641)
+						p.Match(PythonParserCOMMA)
+					}
+					{
+						p.SetState(642)
+						p.MappingPattern()
+					}
+
+				}
+				p.SetState(647)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 89, p.GetParserRuleContext())
+			}
+			p.SetState(649)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOMMA {
+				{
+					p.SetState(648)
+					p.Match(PythonParserCOMMA)
+				}
+
+			}
+
+		}
+		{
+			p.SetState(653)
+			p.Match(PythonParserCLOSE_BRACE)
+		}
+
+	case 8:
+		localctx = NewClassPatternContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(654)
+			p.DottedName()
+		}
+		{
+			p.SetState(655)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		p.SetState(667)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&2314907108195172408) != 0 || _la == PythonParserOPEN_BRACE || _la == PythonParserNAME {
+			{
+				p.SetState(656)
+				p.ClassPatternArg()
+			}
+			p.SetState(661)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(657)
+						p.Match(PythonParserCOMMA)
+					}
+					{
+						p.SetState(658)
+						p.ClassPatternArg()
+					}
+
+				}
+				p.SetState(663)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 92, p.GetParserRuleContext())
+			}
+			p.SetState(665)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOMMA {
+				{
+					p.SetState(664)
+					p.Match(PythonParserCOMMA)
+				}
+
+			}
+
+		}
+		{
+			p.SetState(669)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	}
+
+	return localctx
+}
+
+// ILiteralPatternContext is an interface to support dynamic dispatch.
+type ILiteralPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLiteralPatternContext differentiates from other interfaces.
+	IsLiteralPatternContext()
+}
+
+type LiteralPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLiteralPatternContext() *LiteralPatternContext {
+	var p = new(LiteralPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_literalPattern
+	return p
+}
+
+func (*LiteralPatternContext) IsLiteralPatternContext() {}
+
+func NewLiteralPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LiteralPatternContext {
+	var p = new(LiteralPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_literalPattern
+
+	return p
+}
+
+func (s *LiteralPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LiteralPatternContext) AllNUMBER() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserNUMBER)
+}
+
+func (s *LiteralPatternContext) NUMBER(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserNUMBER, i)
+}
+
+func (s *LiteralPatternContext) AllMINUS() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserMINUS)
+}
+
+func (s *LiteralPatternContext) MINUS(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserMINUS, i)
+}
+
+func (s *LiteralPatternContext) ADD() antlr.TerminalNode {
+	return s.GetToken(PythonParserADD, 0)
+}
+
+func (s *LiteralPatternContext) AllSTRING() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserSTRING)
+}
+
+func (s *LiteralPatternContext) STRING(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserSTRING, i)
+}
+
+func (s *LiteralPatternContext) NONE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNONE, 0)
+}
+
+func (s *LiteralPatternContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(PythonParserTRUE, 0)
+}
+
+func (s *LiteralPatternContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(PythonParserFALSE, 0)
+}
+
+func (s *LiteralPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LiteralPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LiteralPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLiteralPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) LiteralPattern() (localctx ILiteralPatternContext) {
+	this := p
+	_ = this
+
+	localctx = NewLiteralPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 68, PythonParserRULE_literalPattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(689)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserNUMBER, PythonParserMINUS:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(674)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserMINUS {
+			{
+				p.SetState(673)
+				p.Match(PythonParserMINUS)
+			}
+
+		}
+		{
+			p.SetState(676)
+			p.Match(PythonParserNUMBER)
+		}
+		p.SetState(679)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserADD || _la == PythonParserMINUS {
+			{
+				p.SetState(677)
+				_la = p.GetTokenStream().LA(1)
+
+				if !(_la == PythonParserADD || _la == PythonParserMINUS) {
+					p.GetErrorHandler().RecoverInline(p)
+				} else {
+					p.GetErrorHandler().ReportMatch(p)
+					p.Consume()
+				}
+			}
+			{
+				p.SetState(678)
+				p.Match(PythonParserNUMBER)
+			}
+
+		}
+
+	case PythonParserSTRING:
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(682)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		for ok := true; ok; ok = _la == PythonParserSTRING {
+			{
+				p.SetState(681)
+				p.Match(PythonParserSTRING)
+			}
+
+			p.SetState(684)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+		}
+
+	case PythonParserNONE:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(686)
+			p.Match(PythonParserNONE)
+		}
+
+	case PythonParserTRUE:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(687)
+			p.Match(PythonParserTRUE)
+		}
+
+	case PythonParserFALSE:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(688)
+			p.Match(PythonParserFALSE)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IMappingPatternContext is an interface to support dynamic dispatch.
+type IMappingPatternContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsMappingPatternContext differentiates from other interfaces.
+	IsMappingPatternContext()
+}
+
+type MappingPatternContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyMappingPatternContext() *MappingPatternContext {
+	var p = new(MappingPatternContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_mappingPattern
+	return p
+}
+
+func (*MappingPatternContext) IsMappingPatternContext() {}
+
+func NewMappingPatternContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *MappingPatternContext {
+	var p = new(MappingPatternContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_mappingPattern
+
+	return p
+}
+
+func (s *MappingPatternContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *MappingPatternContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *MappingPatternContext) CasePattern() ICasePatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternContext)
+}
+
+func (s *MappingPatternContext) LiteralPattern() ILiteralPatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILiteralPatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILiteralPatternContext)
+}
+
+func (s *MappingPatternContext) AllName() []INameContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INameContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INameContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INameContext); ok {
+			tst[i] = t.(INameContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *MappingPatternContext) Name(i int) INameContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *MappingPatternContext) AllDOT() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserDOT)
+}
+
+func (s *MappingPatternContext) DOT(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserDOT, i)
+}
+
+func (s *MappingPatternContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *MappingPatternContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *MappingPatternContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *MappingPatternContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitMappingPattern(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) MappingPattern() (localctx IMappingPatternContext) {
+	this := p
+	_ = this
+
+	localctx = NewMappingPatternContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 70, PythonParserRULE_mappingPattern)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(706)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserMINUS, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		p.SetState(699)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserSTRING, PythonParserNUMBER, PythonParserMINUS:
+			{
+				p.SetState(691)
+				p.LiteralPattern()
+			}
+
+		case PythonParserMATCH, PythonParserCASE, PythonParserNAME:
+			{
+				p.SetState(692)
+				p.Name()
+			}
+			p.SetState(695)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			for ok := true; ok; ok = _la == PythonParserDOT {
+				{
+					p.SetState(693)
+					p.Match(PythonParserDOT)
+				}
+				{
+					p.SetState(694)
+					p.Name()
+				}
+
+				p.SetState(697)
+				p.GetErrorHandler().Sync(p)
+				_la = p.GetTokenStream().LA(1)
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+		{
+			p.SetState(701)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(702)
+			p.CasePattern()
+		}
+
+	case PythonParserPOWER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(704)
+			p.Match(PythonParserPOWER)
+		}
+		{
+			p.SetState(705)
+			p.Name()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IClassPatternArgContext is an interface to support dynamic dispatch.
+type IClassPatternArgContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsClassPatternArgContext differentiates from other interfaces.
+	IsClassPatternArgContext()
+}
+
+type ClassPatternArgContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyClassPatternArgContext() *ClassPatternArgContext {
+	var p = new(ClassPatternArgContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_classPatternArg
+	return p
+}
+
+func (*ClassPatternArgContext) IsClassPatternArgContext() {}
+
+func NewClassPatternArgContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ClassPatternArgContext {
+	var p = new(ClassPatternArgContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_classPatternArg
+
+	return p
+}
+
+func (s *ClassPatternArgContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ClassPatternArgContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *ClassPatternArgContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, 0)
+}
+
+func (s *ClassPatternArgContext) CasePattern() ICasePatternContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICasePatternContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICasePatternContext)
+}
+
+func (s *ClassPatternArgContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ClassPatternArgContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ClassPatternArgContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitClassPatternArg(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) ClassPatternArg() (localctx IClassPatternArgContext) {
+	this := p
+	_ = this
+
+	localctx = NewClassPatternArgContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 72, PythonParserRULE_classPatternArg)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(713)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 103, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(708)
+			p.Name()
+		}
+		{
+			p.SetState(709)
+			p.Match(PythonParserASSIGN)
+		}
+		{
+			p.SetState(710)
+			p.CasePattern()
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(712)
+			p.CasePattern()
+		}
+
+	}
+
+	return localctx
+}
+
+// INamedExprTestContext is an interface to support dynamic dispatch.
+type INamedExprTestContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNamedExprTestContext differentiates from other interfaces.
+	IsNamedExprTestContext()
+}
+
+type NamedExprTestContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNamedExprTestContext() *NamedExprTestContext {
+	var p = new(NamedExprTestContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_namedExprTest
+	return p
+}
+
+func (*NamedExprTestContext) IsNamedExprTestContext() {}
+
+func NewNamedExprTestContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NamedExprTestContext {
+	var p = new(NamedExprTestContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_namedExprTest
+
+	return p
+}
+
+func (s *NamedExprTestContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NamedExprTestContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *NamedExprTestContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *NamedExprTestContext) WALRUS() antlr.TerminalNode {
+	return s.GetToken(PythonParserWALRUS, 0)
+}
+
+func (s *NamedExprTestContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamedExprTestContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NamedExprTestContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNamedExprTest(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) NamedExprTest() (localctx INamedExprTestContext) {
+	this := p
+	_ = this
+
+	localctx = NewNamedExprTestContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 74, PythonParserRULE_namedExprTest)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(718)
+	p.GetErrorHandler().Sync(p)
+
+	if p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 104, p.GetParserRuleContext()) == 1 {
+		{
+			p.SetState(715)
+			p.Name()
+		}
+		{
+			p.SetState(716)
+			p.Match(PythonParserWALRUS)
+		}
+
+	}
+	{
+		p.SetState(720)
+		p.Test()
+	}
+
+	return localctx
+}
+
+// ITestContext is an interface to support dynamic dispatch.
+type ITestContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTestContext differentiates from other interfaces.
+	IsTestContext()
+}
+
+type TestContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTestContext() *TestContext {
+	var p = new(TestContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_test
+	return p
+}
+
+func (*TestContext) IsTestContext() {}
+
+func NewTestContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TestContext {
+	var p = new(TestContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_test
+
+	return p
+}
+
+func (s *TestContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TestContext) AllOrTest() []IOrTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IOrTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IOrTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IOrTestContext); ok {
+			tst[i] = t.(IOrTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestContext) OrTest(i int) IOrTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrTestContext)
+}
+
+func (s *TestContext) IF() antlr.TerminalNode {
+	return s.GetToken(PythonParserIF, 0)
+}
+
+func (s *TestContext) ELSE() antlr.TerminalNode {
+	return s.GetToken(PythonParserELSE, 0)
+}
+
+func (s *TestContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *TestContext) Lambdef() ILambdefContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILambdefContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILambdefContext)
+}
+
+func (s *TestContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TestContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TestContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTest(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Test() (localctx ITestContext) {
+	this := p
+	_ = this
+
+	localctx = NewTestContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 76, PythonParserRULE_test)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(731)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(722)
+			p.OrTest()
+		}
+		p.SetState(728)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserIF {
+			{
+				p.SetState(723)
+				p.Match(PythonParserIF)
+			}
+			{
+				p.SetState(724)
+				p.OrTest()
+			}
+			{
+				p.SetState(725)
+				p.Match(PythonParserELSE)
+			}
+			{
+				p.SetState(726)
+				p.Test()
+			}
+
+		}
+
+	case PythonParserLAMBDA:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(730)
+			p.Lambdef()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ITestNoCondContext is an interface to support dynamic dispatch.
+type ITestNoCondContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTestNoCondContext differentiates from other interfaces.
+	IsTestNoCondContext()
+}
+
+type TestNoCondContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTestNoCondContext() *TestNoCondContext {
+	var p = new(TestNoCondContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_testNoCond
+	return p
+}
+
+func (*TestNoCondContext) IsTestNoCondContext() {}
+
+func NewTestNoCondContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TestNoCondContext {
+	var p = new(TestNoCondContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_testNoCond
+
+	return p
+}
+
+func (s *TestNoCondContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TestNoCondContext) OrTest() IOrTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrTestContext)
+}
+
+func (s *TestNoCondContext) LambdefNoCond() ILambdefNoCondContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ILambdefNoCondContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ILambdefNoCondContext)
+}
+
+func (s *TestNoCondContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TestNoCondContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TestNoCondContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTestNoCond(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) TestNoCond() (localctx ITestNoCondContext) {
+	this := p
+	_ = this
+
+	localctx = NewTestNoCondContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 78, PythonParserRULE_testNoCond)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(735)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(733)
+			p.OrTest()
+		}
+
+	case PythonParserLAMBDA:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(734)
+			p.LambdefNoCond()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ILambdefContext is an interface to support dynamic dispatch.
+type ILambdefContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLambdefContext differentiates from other interfaces.
+	IsLambdefContext()
+}
+
+type LambdefContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLambdefContext() *LambdefContext {
+	var p = new(LambdefContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_lambdef
+	return p
+}
+
+func (*LambdefContext) IsLambdefContext() {}
+
+func NewLambdefContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LambdefContext {
+	var p = new(LambdefContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_lambdef
+
+	return p
+}
+
+func (s *LambdefContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LambdefContext) LAMBDA() antlr.TerminalNode {
+	return s.GetToken(PythonParserLAMBDA, 0)
+}
+
+func (s *LambdefContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *LambdefContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *LambdefContext) VarArgsList() IVarArgsListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVarArgsListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVarArgsListContext)
+}
+
+func (s *LambdefContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdefContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LambdefContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdef(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Lambdef() (localctx ILambdefContext) {
+	this := p
+	_ = this
+
+	localctx = NewLambdefContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 80, PythonParserRULE_lambdef)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(737)
+		p.Match(PythonParserLAMBDA)
+	}
+	p.SetState(739)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199271526467) != 0 {
+		{
+			p.SetState(738)
+			p.VarArgsList()
+		}
+
+	}
+	{
+		p.SetState(741)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(742)
+		p.Test()
+	}
+
+	return localctx
+}
+
+// ILambdefNoCondContext is an interface to support dynamic dispatch.
+type ILambdefNoCondContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsLambdefNoCondContext differentiates from other interfaces.
+	IsLambdefNoCondContext()
+}
+
+type LambdefNoCondContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyLambdefNoCondContext() *LambdefNoCondContext {
+	var p = new(LambdefNoCondContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_lambdefNoCond
+	return p
+}
+
+func (*LambdefNoCondContext) IsLambdefNoCondContext() {}
+
+func NewLambdefNoCondContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *LambdefNoCondContext {
+	var p = new(LambdefNoCondContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_lambdefNoCond
+
+	return p
+}
+
+func (s *LambdefNoCondContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *LambdefNoCondContext) LAMBDA() antlr.TerminalNode {
+	return s.GetToken(PythonParserLAMBDA, 0)
+}
+
+func (s *LambdefNoCondContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *LambdefNoCondContext) TestNoCond() ITestNoCondContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestNoCondContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestNoCondContext)
+}
+
+func (s *LambdefNoCondContext) VarArgsList() IVarArgsListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IVarArgsListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IVarArgsListContext)
+}
+
+func (s *LambdefNoCondContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *LambdefNoCondContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *LambdefNoCondContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitLambdefNoCond(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) LambdefNoCond() (localctx ILambdefNoCondContext) {
+	this := p
+	_ = this
+
+	localctx = NewLambdefNoCondContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 82, PythonParserRULE_lambdefNoCond)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(744)
+		p.Match(PythonParserLAMBDA)
+	}
+	p.SetState(746)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199271526467) != 0 {
+		{
+			p.SetState(745)
+			p.VarArgsList()
+		}
+
+	}
+	{
+		p.SetState(748)
+		p.Match(PythonParserCOLON)
+	}
+	{
+		p.SetState(749)
+		p.TestNoCond()
+	}
+
+	return localctx
+}
+
+// IOrTestContext is an interface to support dynamic dispatch.
+type IOrTestContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsOrTestContext differentiates from other interfaces.
+	IsOrTestContext()
+}
+
+type OrTestContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyOrTestContext() *OrTestContext {
+	var p = new(OrTestContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_orTest
+	return p
+}
+
+func (*OrTestContext) IsOrTestContext() {}
+
+func NewOrTestContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *OrTestContext {
+	var p = new(OrTestContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_orTest
+
+	return p
+}
+
+func (s *OrTestContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *OrTestContext) AllAndTest() []IAndTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IAndTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IAndTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IAndTestContext); ok {
+			tst[i] = t.(IAndTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *OrTestContext) AndTest(i int) IAndTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAndTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAndTestContext)
+}
+
+func (s *OrTestContext) AllOR() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserOR)
+}
+
+func (s *OrTestContext) OR(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserOR, i)
+}
+
+func (s *OrTestContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *OrTestContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *OrTestContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitOrTest(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) OrTest() (localctx IOrTestContext) {
+	this := p
+	_ = this
+
+	localctx = NewOrTestContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 84, PythonParserRULE_orTest)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(751)
+		p.AndTest()
+	}
+	p.SetState(756)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == PythonParserOR {
+		{
+			p.SetState(752)
+			p.Match(PythonParserOR)
+		}
+		{
+			p.SetState(753)
+			p.AndTest()
+		}
+
+		p.SetState(758)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// IAndTestContext is an interface to support dynamic dispatch.
+type IAndTestContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAndTestContext differentiates from other interfaces.
+	IsAndTestContext()
+}
+
+type AndTestContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAndTestContext() *AndTestContext {
+	var p = new(AndTestContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_andTest
+	return p
+}
+
+func (*AndTestContext) IsAndTestContext() {}
+
+func NewAndTestContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AndTestContext {
+	var p = new(AndTestContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_andTest
+
+	return p
+}
+
+func (s *AndTestContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AndTestContext) AllNotTest() []INotTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INotTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INotTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INotTestContext); ok {
+			tst[i] = t.(INotTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AndTestContext) NotTest(i int) INotTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INotTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INotTestContext)
+}
+
+func (s *AndTestContext) AllAND() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserAND)
+}
+
+func (s *AndTestContext) AND(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserAND, i)
+}
+
+func (s *AndTestContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AndTestContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AndTestContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAndTest(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) AndTest() (localctx IAndTestContext) {
+	this := p
+	_ = this
+
+	localctx = NewAndTestContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 86, PythonParserRULE_andTest)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(759)
+		p.NotTest()
+	}
+	p.SetState(764)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for _la == PythonParserAND {
+		{
+			p.SetState(760)
+			p.Match(PythonParserAND)
+		}
+		{
+			p.SetState(761)
+			p.NotTest()
+		}
+
+		p.SetState(766)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// INotTestContext is an interface to support dynamic dispatch.
+type INotTestContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNotTestContext differentiates from other interfaces.
+	IsNotTestContext()
+}
+
+type NotTestContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNotTestContext() *NotTestContext {
+	var p = new(NotTestContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_notTest
+	return p
+}
+
+func (*NotTestContext) IsNotTestContext() {}
+
+func NewNotTestContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NotTestContext {
+	var p = new(NotTestContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_notTest
+
+	return p
+}
+
+func (s *NotTestContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NotTestContext) NOT() antlr.TerminalNode {
+	return s.GetToken(PythonParserNOT, 0)
+}
+
+func (s *NotTestContext) NotTest() INotTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INotTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INotTestContext)
+}
+
+func (s *NotTestContext) Comparison() IComparisonContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IComparisonContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IComparisonContext)
+}
+
+func (s *NotTestContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NotTestContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NotTestContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNotTest(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) NotTest() (localctx INotTestContext) {
+	this := p
+	_ = this
+
+	localctx = NewNotTestContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 88, PythonParserRULE_notTest)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(770)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserNOT:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(767)
+			p.Match(PythonParserNOT)
+		}
+		{
+			p.SetState(768)
+			p.NotTest()
+		}
+
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(769)
+			p.Comparison()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IComparisonContext is an interface to support dynamic dispatch.
+type IComparisonContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsComparisonContext differentiates from other interfaces.
+	IsComparisonContext()
+}
+
+type ComparisonContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyComparisonContext() *ComparisonContext {
+	var p = new(ComparisonContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_comparison
+	return p
+}
+
+func (*ComparisonContext) IsComparisonContext() {}
+
+func NewComparisonContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ComparisonContext {
+	var p = new(ComparisonContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_comparison
+
+	return p
+}
+
+func (s *ComparisonContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ComparisonContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ComparisonContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ComparisonContext) AllCompOp() []ICompOpContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ICompOpContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ICompOpContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ICompOpContext); ok {
+			tst[i] = t.(ICompOpContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ComparisonContext) CompOp(i int) ICompOpContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompOpContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompOpContext)
+}
+
+func (s *ComparisonContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ComparisonContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ComparisonContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitComparison(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Comparison() (localctx IComparisonContext) {
+	this := p
+	_ = this
+
+	localctx = NewComparisonContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 90, PythonParserRULE_comparison)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(772)
+		p.expr(0)
+	}
+	p.SetState(778)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	for (int64((_la-25)) & ^0x3f) == 0 && ((int64(1)<<(_la-25))&1117103813820435) != 0 {
+		{
+			p.SetState(773)
+			p.CompOp()
+		}
+		{
+			p.SetState(774)
+			p.expr(0)
+		}
+
+		p.SetState(780)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+	}
+
+	return localctx
+}
+
+// ICompOpContext is an interface to support dynamic dispatch.
+type ICompOpContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompOpContext differentiates from other interfaces.
+	IsCompOpContext()
+}
+
+type CompOpContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompOpContext() *CompOpContext {
+	var p = new(CompOpContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_compOp
+	return p
+}
+
+func (*CompOpContext) IsCompOpContext() {}
+
+func NewCompOpContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompOpContext {
+	var p = new(CompOpContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_compOp
+
+	return p
+}
+
+func (s *CompOpContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompOpContext) LESS_THAN() antlr.TerminalNode {
+	return s.GetToken(PythonParserLESS_THAN, 0)
+}
+
+func (s *CompOpContext) GREATER_THAN() antlr.TerminalNode {
+	return s.GetToken(PythonParserGREATER_THAN, 0)
+}
+
+func (s *CompOpContext) EQUALS() antlr.TerminalNode {
+	return s.GetToken(PythonParserEQUALS, 0)
+}
+
+func (s *CompOpContext) GT_EQ() antlr.TerminalNode {
+	return s.GetToken(PythonParserGT_EQ, 0)
+}
+
+func (s *CompOpContext) LT_EQ() antlr.TerminalNode {
+	return s.GetToken(PythonParserLT_EQ, 0)
+}
+
+func (s *CompOpContext) NOT_EQ_1() antlr.TerminalNode {
+	return s.GetToken(PythonParserNOT_EQ_1, 0)
+}
+
+func (s *CompOpContext) NOT_EQ_2() antlr.TerminalNode {
+	return s.GetToken(PythonParserNOT_EQ_2, 0)
+}
+
+func (s *CompOpContext) IN() antlr.TerminalNode {
+	return s.GetToken(PythonParserIN, 0)
+}
+
+func (s *CompOpContext) NOT() antlr.TerminalNode {
+	return s.GetToken(PythonParserNOT, 0)
+}
+
+func (s *CompOpContext) IS() antlr.TerminalNode {
+	return s.GetToken(PythonParserIS, 0)
+}
+
+func (s *CompOpContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompOpContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompOpContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCompOp(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CompOp() (localctx ICompOpContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompOpContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 92, PythonParserRULE_compOp)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(794)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 114, p.GetParserRuleContext()) {
+	case 1:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(781)
+			p.Match(PythonParserLESS_THAN)
+		}
+
+	case 2:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(782)
+			p.Match(PythonParserGREATER_THAN)
+		}
+
+	case 3:
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(783)
+			p.Match(PythonParserEQUALS)
+		}
+
+	case 4:
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(784)
+			p.Match(PythonParserGT_EQ)
+		}
+
+	case 5:
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(785)
+			p.Match(PythonParserLT_EQ)
+		}
+
+	case 6:
+		p.EnterOuterAlt(localctx, 6)
+		{
+			p.SetState(786)
+			p.Match(PythonParserNOT_EQ_1)
+		}
+
+	case 7:
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(787)
+			p.Match(PythonParserNOT_EQ_2)
+		}
+
+	case 8:
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(788)
+			p.Match(PythonParserIN)
+		}
+
+	case 9:
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(789)
+			p.Match(PythonParserNOT)
+		}
+		{
+			p.SetState(790)
+			p.Match(PythonParserIN)
+		}
+
+	case 10:
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(791)
+			p.Match(PythonParserIS)
+		}
+
+	case 11:
+		p.EnterOuterAlt(localctx, 11)
+		{
+			p.SetState(792)
+			p.Match(PythonParserIS)
+		}
+		{
+			p.SetState(793)
+			p.Match(PythonParserNOT)
+		}
+
+	}
+
+	return localctx
+}
+
+// IStarExprContext is an interface to support dynamic dispatch.
+type IStarExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsStarExprContext differentiates from other interfaces.
+	IsStarExprContext()
+}
+
+type StarExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyStarExprContext() *StarExprContext {
+	var p = new(StarExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_starExpr
+	return p
+}
+
+func (*StarExprContext) IsStarExprContext() {}
+
+func NewStarExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *StarExprContext {
+	var p = new(StarExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_starExpr
+
+	return p
+}
+
+func (s *StarExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *StarExprContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *StarExprContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *StarExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StarExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *StarExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStarExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) StarExpr() (localctx IStarExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewStarExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 94, PythonParserRULE_starExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(796)
+		p.Match(PythonParserSTAR)
+	}
+	{
+		p.SetState(797)
+		p.expr(0)
+	}
+
+	return localctx
+}
+
+// IExprContext is an interface to support dynamic dispatch.
+type IExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprContext differentiates from other interfaces.
+	IsExprContext()
+}
+
+type ExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprContext() *ExprContext {
+	var p = new(ExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_expr
+	return p
+}
+
+func (*ExprContext) IsExprContext() {}
+
+func NewExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprContext {
+	var p = new(ExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_expr
+
+	return p
+}
+
+func (s *ExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprContext) CopyFrom(ctx *ExprContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type PowerExprContext struct {
+	*ExprContext
+}
+
+func NewPowerExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PowerExprContext {
+	var p = new(PowerExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PowerExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PowerExprContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *PowerExprContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *PowerExprContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *PowerExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitPowerExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type BinaryExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewBinaryExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *BinaryExprContext {
+	var p = new(BinaryExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *BinaryExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *BinaryExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *BinaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *BinaryExprContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *BinaryExprContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *BinaryExprContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *BinaryExprContext) AT() antlr.TerminalNode {
+	return s.GetToken(PythonParserAT, 0)
+}
+
+func (s *BinaryExprContext) DIV() antlr.TerminalNode {
+	return s.GetToken(PythonParserDIV, 0)
+}
+
+func (s *BinaryExprContext) MOD() antlr.TerminalNode {
+	return s.GetToken(PythonParserMOD, 0)
+}
+
+func (s *BinaryExprContext) IDIV() antlr.TerminalNode {
+	return s.GetToken(PythonParserIDIV, 0)
+}
+
+func (s *BinaryExprContext) ADD() antlr.TerminalNode {
+	return s.GetToken(PythonParserADD, 0)
+}
+
+func (s *BinaryExprContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(PythonParserMINUS, 0)
+}
+
+func (s *BinaryExprContext) LEFT_SHIFT() antlr.TerminalNode {
+	return s.GetToken(PythonParserLEFT_SHIFT, 0)
+}
+
+func (s *BinaryExprContext) RIGHT_SHIFT() antlr.TerminalNode {
+	return s.GetToken(PythonParserRIGHT_SHIFT, 0)
+}
+
+func (s *BinaryExprContext) AND_OP() antlr.TerminalNode {
+	return s.GetToken(PythonParserAND_OP, 0)
+}
+
+func (s *BinaryExprContext) XOR() antlr.TerminalNode {
+	return s.GetToken(PythonParserXOR, 0)
+}
+
+func (s *BinaryExprContext) OR_OP() antlr.TerminalNode {
+	return s.GetToken(PythonParserOR_OP, 0)
+}
+
+func (s *BinaryExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitBinaryExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type PrimaryExprContext struct {
+	*ExprContext
+}
+
+func NewPrimaryExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PrimaryExprContext {
+	var p = new(PrimaryExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *PrimaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PrimaryExprContext) AtomExpr() IAtomExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAtomExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomExprContext)
+}
+
+func (s *PrimaryExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitPrimaryExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type UnaryExprContext struct {
+	*ExprContext
+	op antlr.Token
+}
+
+func NewUnaryExprContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *UnaryExprContext {
+	var p = new(UnaryExprContext)
+
+	p.ExprContext = NewEmptyExprContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ExprContext))
+
+	return p
+}
+
+func (s *UnaryExprContext) GetOp() antlr.Token { return s.op }
+
+func (s *UnaryExprContext) SetOp(v antlr.Token) { s.op = v }
+
+func (s *UnaryExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *UnaryExprContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *UnaryExprContext) ADD() antlr.TerminalNode {
+	return s.GetToken(PythonParserADD, 0)
+}
+
+func (s *UnaryExprContext) MINUS() antlr.TerminalNode {
+	return s.GetToken(PythonParserMINUS, 0)
+}
+
+func (s *UnaryExprContext) NOT_OP() antlr.TerminalNode {
+	return s.GetToken(PythonParserNOT_OP, 0)
+}
+
+func (s *UnaryExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitUnaryExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Expr() (localctx IExprContext) {
+	return p.expr(0)
+}
+
+func (p *PythonParser) expr(_p int) (localctx IExprContext) {
+	this := p
+	_ = this
+
+	var _parentctx antlr.ParserRuleContext = p.GetParserRuleContext()
+	_parentState := p.GetState()
+	localctx = NewExprContext(p, p.GetParserRuleContext(), _parentState)
+	var _prevctx IExprContext = localctx
+	var _ antlr.ParserRuleContext = _prevctx // TODO: To prevent unused variable warning.
+	_startState := 96
+	p.EnterRecursionRule(localctx, 96, PythonParserRULE_expr, _p)
+	var _la int
+
+	defer func() {
+		p.UnrollRecursionContexts(_parentctx)
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(803)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserOPEN_BRACE, PythonParserNAME:
+		localctx = NewPrimaryExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+
+		{
+			p.SetState(800)
+			p.AtomExpr()
+		}
+
+	case PythonParserADD, PythonParserMINUS, PythonParserNOT_OP:
+		localctx = NewUnaryExprContext(p, localctx)
+		p.SetParserRuleContext(localctx)
+		_prevctx = localctx
+		{
+			p.SetState(801)
+
+			var _lt = p.GetTokenStream().LT(1)
+
+			localctx.(*UnaryExprContext).op = _lt
+
+			_la = p.GetTokenStream().LA(1)
+
+			if !((int64((_la-60)) & ^0x3f) == 0 && ((int64(1)<<(_la-60))&35) != 0) {
+				var _ri = p.GetErrorHandler().RecoverInline(p)
+
+				localctx.(*UnaryExprContext).op = _ri
+			} else {
+				p.GetErrorHandler().ReportMatch(p)
+				p.Consume()
+			}
+		}
+		{
+			p.SetState(802)
+			p.expr(7)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.GetParserRuleContext().SetStop(p.GetTokenStream().LT(-1))
+	p.SetState(828)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			if p.GetParseListeners() != nil {
+				p.TriggerExitRuleEvent()
+			}
+			_prevctx = localctx
+			p.SetState(826)
+			p.GetErrorHandler().Sync(p)
+			switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 116, p.GetParserRuleContext()) {
+			case 1:
+				localctx = NewPowerExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(805)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 8)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 8)", ""))
+				}
+				{
+					p.SetState(806)
+					p.Match(PythonParserPOWER)
+				}
+				{
+					p.SetState(807)
+					p.expr(8)
+				}
+
+			case 2:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(808)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 6)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 6)", ""))
+				}
+				{
+					p.SetState(809)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryExprContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !((int64((_la-44)) & ^0x3f) == 0 && ((int64(1)<<(_la-44))&2149318657) != 0) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryExprContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(810)
+					p.expr(7)
+				}
+
+			case 3:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(811)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 5)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 5)", ""))
+				}
+				{
+					p.SetState(812)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryExprContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PythonParserADD || _la == PythonParserMINUS) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryExprContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(813)
+					p.expr(6)
+				}
+
+			case 4:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(814)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 4)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 4)", ""))
+				}
+				{
+					p.SetState(815)
+
+					var _lt = p.GetTokenStream().LT(1)
+
+					localctx.(*BinaryExprContext).op = _lt
+
+					_la = p.GetTokenStream().LA(1)
+
+					if !(_la == PythonParserLEFT_SHIFT || _la == PythonParserRIGHT_SHIFT) {
+						var _ri = p.GetErrorHandler().RecoverInline(p)
+
+						localctx.(*BinaryExprContext).op = _ri
+					} else {
+						p.GetErrorHandler().ReportMatch(p)
+						p.Consume()
+					}
+				}
+				{
+					p.SetState(816)
+					p.expr(5)
+				}
+
+			case 5:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(817)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 3)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 3)", ""))
+				}
+				{
+					p.SetState(818)
+
+					var _m = p.Match(PythonParserAND_OP)
+
+					localctx.(*BinaryExprContext).op = _m
+				}
+				{
+					p.SetState(819)
+					p.expr(4)
+				}
+
+			case 6:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(820)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 2)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 2)", ""))
+				}
+				{
+					p.SetState(821)
+
+					var _m = p.Match(PythonParserXOR)
+
+					localctx.(*BinaryExprContext).op = _m
+				}
+				{
+					p.SetState(822)
+					p.expr(3)
+				}
+
+			case 7:
+				localctx = NewBinaryExprContext(p, NewExprContext(p, _parentctx, _parentState))
+				p.PushNewRecursionContext(localctx, _startState, PythonParserRULE_expr)
+				p.SetState(823)
+
+				if !(p.Precpred(p.GetParserRuleContext(), 1)) {
+					panic(antlr.NewFailedPredicateException(p, "p.Precpred(p.GetParserRuleContext(), 1)", ""))
+				}
+				{
+					p.SetState(824)
+
+					var _m = p.Match(PythonParserOR_OP)
+
+					localctx.(*BinaryExprContext).op = _m
+				}
+				{
+					p.SetState(825)
+					p.expr(2)
+				}
+
+			}
+
+		}
+		p.SetState(830)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 117, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IAtomExprContext is an interface to support dynamic dispatch.
+type IAtomExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAtomExprContext differentiates from other interfaces.
+	IsAtomExprContext()
+}
+
+type AtomExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAtomExprContext() *AtomExprContext {
+	var p = new(AtomExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_atomExpr
+	return p
+}
+
+func (*AtomExprContext) IsAtomExprContext() {}
+
+func NewAtomExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AtomExprContext {
+	var p = new(AtomExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_atomExpr
+
+	return p
+}
+
+func (s *AtomExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AtomExprContext) Atom() IAtomContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IAtomContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IAtomContext)
+}
+
+func (s *AtomExprContext) AWAIT() antlr.TerminalNode {
+	return s.GetToken(PythonParserAWAIT, 0)
+}
+
+func (s *AtomExprContext) AllTrailer() []ITrailerContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITrailerContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITrailerContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITrailerContext); ok {
+			tst[i] = t.(ITrailerContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *AtomExprContext) Trailer(i int) ITrailerContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITrailerContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITrailerContext)
+}
+
+func (s *AtomExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AtomExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *AtomExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAtomExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) AtomExpr() (localctx IAtomExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewAtomExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 98, PythonParserRULE_atomExpr)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(832)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserAWAIT {
+		{
+			p.SetState(831)
+			p.Match(PythonParserAWAIT)
+		}
+
+	}
+	{
+		p.SetState(834)
+		p.Atom()
+	}
+	p.SetState(838)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(835)
+				p.Trailer()
+			}
+
+		}
+		p.SetState(840)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 119, p.GetParserRuleContext())
+	}
+
+	return localctx
+}
+
+// IAtomContext is an interface to support dynamic dispatch.
+type IAtomContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsAtomContext differentiates from other interfaces.
+	IsAtomContext()
+}
+
+type AtomContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyAtomContext() *AtomContext {
+	var p = new(AtomContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_atom
+	return p
+}
+
+func (*AtomContext) IsAtomContext() {}
+
+func NewAtomContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *AtomContext {
+	var p = new(AtomContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_atom
+
+	return p
+}
+
+func (s *AtomContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *AtomContext) CopyFrom(ctx *AtomContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *AtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AtomContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type TrueAtomContext struct {
+	*AtomContext
+}
+
+func NewTrueAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *TrueAtomContext {
+	var p = new(TrueAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *TrueAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TrueAtomContext) TRUE() antlr.TerminalNode {
+	return s.GetToken(PythonParserTRUE, 0)
+}
+
+func (s *TrueAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTrueAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type FalseAtomContext struct {
+	*AtomContext
+}
+
+func NewFalseAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *FalseAtomContext {
+	var p = new(FalseAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *FalseAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *FalseAtomContext) FALSE() antlr.TerminalNode {
+	return s.GetToken(PythonParserFALSE, 0)
+}
+
+func (s *FalseAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitFalseAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NumberAtomContext struct {
+	*AtomContext
+}
+
+func NewNumberAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NumberAtomContext {
+	var p = new(NumberAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *NumberAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NumberAtomContext) NUMBER() antlr.TerminalNode {
+	return s.GetToken(PythonParserNUMBER, 0)
+}
+
+func (s *NumberAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNumberAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StringAtomContext struct {
+	*AtomContext
+}
+
+func NewStringAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StringAtomContext {
+	var p = new(StringAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *StringAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StringAtomContext) AllSTRING() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserSTRING)
+}
+
+func (s *StringAtomContext) STRING(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserSTRING, i)
+}
+
+func (s *StringAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStringAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ListAtomContext struct {
+	*AtomContext
+}
+
+func NewListAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ListAtomContext {
+	var p = new(ListAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *ListAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ListAtomContext) OPEN_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_BRACKET, 0)
+}
+
+func (s *ListAtomContext) CLOSE_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_BRACKET, 0)
+}
+
+func (s *ListAtomContext) TestlistComp() ITestlistCompContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistCompContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistCompContext)
+}
+
+func (s *ListAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitListAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type EllipsisAtomContext struct {
+	*AtomContext
+}
+
+func NewEllipsisAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *EllipsisAtomContext {
+	var p = new(EllipsisAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *EllipsisAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *EllipsisAtomContext) ELLIPSIS() antlr.TerminalNode {
+	return s.GetToken(PythonParserELLIPSIS, 0)
+}
+
+func (s *EllipsisAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitEllipsisAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type ParenAtomContext struct {
+	*AtomContext
+}
+
+func NewParenAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *ParenAtomContext {
+	var p = new(ParenAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *ParenAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ParenAtomContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *ParenAtomContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *ParenAtomContext) YieldExpr() IYieldExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IYieldExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IYieldExprContext)
+}
+
+func (s *ParenAtomContext) TestlistComp() ITestlistCompContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistCompContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistCompContext)
+}
+
+func (s *ParenAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitParenAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NameAtomContext struct {
+	*AtomContext
+}
+
+func NewNameAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NameAtomContext {
+	var p = new(NameAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *NameAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NameAtomContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *NameAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNameAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NoneAtomContext struct {
+	*AtomContext
+}
+
+func NewNoneAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NoneAtomContext {
+	var p = new(NoneAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *NoneAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NoneAtomContext) NONE() antlr.TerminalNode {
+	return s.GetToken(PythonParserNONE, 0)
+}
+
+func (s *NoneAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNoneAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type DictOrSetAtomContext struct {
+	*AtomContext
+}
+
+func NewDictOrSetAtomContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DictOrSetAtomContext {
+	var p = new(DictOrSetAtomContext)
+
+	p.AtomContext = NewEmptyAtomContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*AtomContext))
+
+	return p
+}
+
+func (s *DictOrSetAtomContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DictOrSetAtomContext) OPEN_BRACE() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_BRACE, 0)
+}
+
+func (s *DictOrSetAtomContext) CLOSE_BRACE() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_BRACE, 0)
+}
+
+func (s *DictOrSetAtomContext) DictOrSetMaker() IDictOrSetMakerContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDictOrSetMakerContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDictOrSetMakerContext)
+}
+
+func (s *DictOrSetAtomContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDictOrSetAtom(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Atom() (localctx IAtomContext) {
+	this := p
+	_ = this
+
+	localctx = NewAtomContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 100, PythonParserRULE_atom)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(868)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserOPEN_PAREN:
+		localctx = NewParenAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(841)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		p.SetState(844)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserYIELD:
+			{
+				p.SetState(842)
+				p.YieldExpr()
+			}
+
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+			{
+				p.SetState(843)
+				p.TestlistComp()
+			}
+
+		case PythonParserCLOSE_PAREN:
+
+		default:
+		}
+		{
+			p.SetState(846)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case PythonParserOPEN_BRACKET:
+		localctx = NewListAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(847)
+			p.Match(PythonParserOPEN_BRACKET)
+		}
+		p.SetState(849)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467833011519620152) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(848)
+				p.TestlistComp()
+			}
+
+		}
+		{
+			p.SetState(851)
+			p.Match(PythonParserCLOSE_BRACKET)
+		}
+
+	case PythonParserOPEN_BRACE:
+		localctx = NewDictOrSetAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(852)
+			p.Match(PythonParserOPEN_BRACE)
+		}
+		p.SetState(854)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3470084811333305400) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(853)
+				p.DictOrSetMaker()
+			}
+
+		}
+		{
+			p.SetState(856)
+			p.Match(PythonParserCLOSE_BRACE)
+		}
+
+	case PythonParserMATCH, PythonParserCASE, PythonParserNAME:
+		localctx = NewNameAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(857)
+			p.Name()
+		}
+
+	case PythonParserNUMBER:
+		localctx = NewNumberAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(858)
+			p.Match(PythonParserNUMBER)
+		}
+
+	case PythonParserSTRING:
+		localctx = NewStringAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 6)
+		p.SetState(860)
+		p.GetErrorHandler().Sync(p)
+		_alt = 1
+		for ok := true; ok; ok = _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			switch _alt {
+			case 1:
+				{
+					p.SetState(859)
+					p.Match(PythonParserSTRING)
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+			p.SetState(862)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 123, p.GetParserRuleContext())
+		}
+
+	case PythonParserELLIPSIS:
+		localctx = NewEllipsisAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 7)
+		{
+			p.SetState(864)
+			p.Match(PythonParserELLIPSIS)
+		}
+
+	case PythonParserNONE:
+		localctx = NewNoneAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 8)
+		{
+			p.SetState(865)
+			p.Match(PythonParserNONE)
+		}
+
+	case PythonParserTRUE:
+		localctx = NewTrueAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 9)
+		{
+			p.SetState(866)
+			p.Match(PythonParserTRUE)
+		}
+
+	case PythonParserFALSE:
+		localctx = NewFalseAtomContext(p, localctx)
+		p.EnterOuterAlt(localctx, 10)
+		{
+			p.SetState(867)
+			p.Match(PythonParserFALSE)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// INameContext is an interface to support dynamic dispatch.
+type INameContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsNameContext differentiates from other interfaces.
+	IsNameContext()
+}
+
+type NameContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyNameContext() *NameContext {
+	var p = new(NameContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_name
+	return p
+}
+
+func (*NameContext) IsNameContext() {}
+
+func NewNameContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *NameContext {
+	var p = new(NameContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_name
+
+	return p
+}
+
+func (s *NameContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *NameContext) NAME() antlr.TerminalNode {
+	return s.GetToken(PythonParserNAME, 0)
+}
+
+func (s *NameContext) MATCH() antlr.TerminalNode {
+	return s.GetToken(PythonParserMATCH, 0)
+}
+
+func (s *NameContext) CASE() antlr.TerminalNode {
+	return s.GetToken(PythonParserCASE, 0)
+}
+
+func (s *NameContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NameContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *NameContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitName(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Name() (localctx INameContext) {
+	this := p
+	_ = this
+
+	localctx = NewNameContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 102, PythonParserRULE_name)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(870)
+		_la = p.GetTokenStream().LA(1)
+
+		if !((int64((_la-38)) & ^0x3f) == 0 && ((int64(1)<<(_la-38))&9007199254740995) != 0) {
+			p.GetErrorHandler().RecoverInline(p)
+		} else {
+			p.GetErrorHandler().ReportMatch(p)
+			p.Consume()
+		}
+	}
+
+	return localctx
+}
+
+// ITestlistCompContext is an interface to support dynamic dispatch.
+type ITestlistCompContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTestlistCompContext differentiates from other interfaces.
+	IsTestlistCompContext()
+}
+
+type TestlistCompContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTestlistCompContext() *TestlistCompContext {
+	var p = new(TestlistCompContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_testlistComp
+	return p
+}
+
+func (*TestlistCompContext) IsTestlistCompContext() {}
+
+func NewTestlistCompContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TestlistCompContext {
+	var p = new(TestlistCompContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_testlistComp
+
+	return p
+}
+
+func (s *TestlistCompContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TestlistCompContext) AllNamedExprTest() []INamedExprTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INamedExprTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INamedExprTestContext); ok {
+			tst[i] = t.(INamedExprTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestlistCompContext) NamedExprTest(i int) INamedExprTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *TestlistCompContext) AllStarExpr() []IStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStarExprContext); ok {
+			tst[i] = t.(IStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestlistCompContext) StarExpr(i int) IStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *TestlistCompContext) CompFor() ICompForContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompForContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompForContext)
+}
+
+func (s *TestlistCompContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *TestlistCompContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *TestlistCompContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TestlistCompContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TestlistCompContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTestlistComp(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) TestlistComp() (localctx ITestlistCompContext) {
+	this := p
+	_ = this
+
+	localctx = NewTestlistCompContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 104, PythonParserRULE_testlistComp)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(874)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		{
+			p.SetState(872)
+			p.NamedExprTest()
+		}
+
+	case PythonParserSTAR:
+		{
+			p.SetState(873)
+			p.StarExpr()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.SetState(890)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserASYNC, PythonParserFOR:
+		{
+			p.SetState(876)
+			p.CompFor()
+		}
+
+	case PythonParserCLOSE_PAREN, PythonParserCOMMA, PythonParserCLOSE_BRACKET:
+		p.SetState(884)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext())
+
+		for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+			if _alt == 1 {
+				{
+					p.SetState(877)
+					p.Match(PythonParserCOMMA)
+				}
+				p.SetState(880)
+				p.GetErrorHandler().Sync(p)
+
+				switch p.GetTokenStream().LA(1) {
+				case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+					{
+						p.SetState(878)
+						p.NamedExprTest()
+					}
+
+				case PythonParserSTAR:
+					{
+						p.SetState(879)
+						p.StarExpr()
+					}
+
+				default:
+					panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+				}
+
+			}
+			p.SetState(886)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 127, p.GetParserRuleContext())
+		}
+		p.SetState(888)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOMMA {
+			{
+				p.SetState(887)
+				p.Match(PythonParserCOMMA)
+			}
+
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ITrailerContext is an interface to support dynamic dispatch.
+type ITrailerContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTrailerContext differentiates from other interfaces.
+	IsTrailerContext()
+}
+
+type TrailerContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTrailerContext() *TrailerContext {
+	var p = new(TrailerContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_trailer
+	return p
+}
+
+func (*TrailerContext) IsTrailerContext() {}
+
+func NewTrailerContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TrailerContext {
+	var p = new(TrailerContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_trailer
+
+	return p
+}
+
+func (s *TrailerContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TrailerContext) CopyFrom(ctx *TrailerContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *TrailerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TrailerContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type AttributeTrailerContext struct {
+	*TrailerContext
+}
+
+func NewAttributeTrailerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *AttributeTrailerContext {
+	var p = new(AttributeTrailerContext)
+
+	p.TrailerContext = NewEmptyTrailerContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TrailerContext))
+
+	return p
+}
+
+func (s *AttributeTrailerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *AttributeTrailerContext) DOT() antlr.TerminalNode {
+	return s.GetToken(PythonParserDOT, 0)
+}
+
+func (s *AttributeTrailerContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *AttributeTrailerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitAttributeTrailer(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SubscriptTrailerContext struct {
+	*TrailerContext
+}
+
+func NewSubscriptTrailerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SubscriptTrailerContext {
+	var p = new(SubscriptTrailerContext)
+
+	p.TrailerContext = NewEmptyTrailerContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TrailerContext))
+
+	return p
+}
+
+func (s *SubscriptTrailerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubscriptTrailerContext) OPEN_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_BRACKET, 0)
+}
+
+func (s *SubscriptTrailerContext) SubscriptList() ISubscriptListContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubscriptListContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubscriptListContext)
+}
+
+func (s *SubscriptTrailerContext) CLOSE_BRACKET() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_BRACKET, 0)
+}
+
+func (s *SubscriptTrailerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSubscriptTrailer(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type CallTrailerContext struct {
+	*TrailerContext
+}
+
+func NewCallTrailerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *CallTrailerContext {
+	var p = new(CallTrailerContext)
+
+	p.TrailerContext = NewEmptyTrailerContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*TrailerContext))
+
+	return p
+}
+
+func (s *CallTrailerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CallTrailerContext) OPEN_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserOPEN_PAREN, 0)
+}
+
+func (s *CallTrailerContext) CLOSE_PAREN() antlr.TerminalNode {
+	return s.GetToken(PythonParserCLOSE_PAREN, 0)
+}
+
+func (s *CallTrailerContext) Arglist() IArglistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArglistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArglistContext)
+}
+
+func (s *CallTrailerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCallTrailer(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Trailer() (localctx ITrailerContext) {
+	this := p
+	_ = this
+
+	localctx = NewTrailerContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 106, PythonParserRULE_trailer)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(903)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserOPEN_PAREN:
+		localctx = NewCallTrailerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(892)
+			p.Match(PythonParserOPEN_PAREN)
+		}
+		p.SetState(894)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3470084811333305400) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(893)
+				p.Arglist()
+			}
+
+		}
+		{
+			p.SetState(896)
+			p.Match(PythonParserCLOSE_PAREN)
+		}
+
+	case PythonParserOPEN_BRACKET:
+		localctx = NewSubscriptTrailerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(897)
+			p.Match(PythonParserOPEN_BRACKET)
+		}
+		{
+			p.SetState(898)
+			p.SubscriptList()
+		}
+		{
+			p.SetState(899)
+			p.Match(PythonParserCLOSE_BRACKET)
+		}
+
+	case PythonParserDOT:
+		localctx = NewAttributeTrailerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(901)
+			p.Match(PythonParserDOT)
+		}
+		{
+			p.SetState(902)
+			p.Name()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ISubscriptListContext is an interface to support dynamic dispatch.
+type ISubscriptListContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSubscriptListContext differentiates from other interfaces.
+	IsSubscriptListContext()
+}
+
+type SubscriptListContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubscriptListContext() *SubscriptListContext {
+	var p = new(SubscriptListContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_subscriptList
+	return p
+}
+
+func (*SubscriptListContext) IsSubscriptListContext() {}
+
+func NewSubscriptListContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubscriptListContext {
+	var p = new(SubscriptListContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_subscriptList
+
+	return p
+}
+
+func (s *SubscriptListContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubscriptListContext) AllSubscript() []ISubscriptContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ISubscriptContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ISubscriptContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ISubscriptContext); ok {
+			tst[i] = t.(ISubscriptContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SubscriptListContext) Subscript(i int) ISubscriptContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ISubscriptContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ISubscriptContext)
+}
+
+func (s *SubscriptListContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *SubscriptListContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *SubscriptListContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubscriptListContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *SubscriptListContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSubscriptList(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) SubscriptList() (localctx ISubscriptListContext) {
+	this := p
+	_ = this
+
+	localctx = NewSubscriptListContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 108, PythonParserRULE_subscriptList)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(905)
+		p.Subscript()
+	}
+	p.SetState(910)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 132, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(906)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(907)
+				p.Subscript()
+			}
+
+		}
+		p.SetState(912)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 132, p.GetParserRuleContext())
+	}
+	p.SetState(914)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(913)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// ISubscriptContext is an interface to support dynamic dispatch.
+type ISubscriptContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsSubscriptContext differentiates from other interfaces.
+	IsSubscriptContext()
+}
+
+type SubscriptContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptySubscriptContext() *SubscriptContext {
+	var p = new(SubscriptContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_subscript
+	return p
+}
+
+func (*SubscriptContext) IsSubscriptContext() {}
+
+func NewSubscriptContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *SubscriptContext {
+	var p = new(SubscriptContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_subscript
+
+	return p
+}
+
+func (s *SubscriptContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *SubscriptContext) CopyFrom(ctx *SubscriptContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *SubscriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SubscriptContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type IndexSubscriptContext struct {
+	*SubscriptContext
+}
+
+func NewIndexSubscriptContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *IndexSubscriptContext {
+	var p = new(IndexSubscriptContext)
+
+	p.SubscriptContext = NewEmptySubscriptContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SubscriptContext))
+
+	return p
+}
+
+func (s *IndexSubscriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *IndexSubscriptContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *IndexSubscriptContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitIndexSubscript(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SliceSubscriptContext struct {
+	*SubscriptContext
+}
+
+func NewSliceSubscriptContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SliceSubscriptContext {
+	var p = new(SliceSubscriptContext)
+
+	p.SubscriptContext = NewEmptySubscriptContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SubscriptContext))
+
+	return p
+}
+
+func (s *SliceSubscriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SliceSubscriptContext) AllCOLON() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOLON)
+}
+
+func (s *SliceSubscriptContext) COLON(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, i)
+}
+
+func (s *SliceSubscriptContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SliceSubscriptContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *SliceSubscriptContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSliceSubscript(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StarSubscriptContext struct {
+	*SubscriptContext
+}
+
+func NewStarSubscriptContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StarSubscriptContext {
+	var p = new(StarSubscriptContext)
+
+	p.SubscriptContext = NewEmptySubscriptContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*SubscriptContext))
+
+	return p
+}
+
+func (s *StarSubscriptContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StarSubscriptContext) StarExpr() IStarExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *StarSubscriptContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStarSubscript(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Subscript() (localctx ISubscriptContext) {
+	this := p
+	_ = this
+
+	localctx = NewSubscriptContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 110, PythonParserRULE_subscript)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(931)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 138, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewIndexSubscriptContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(916)
+			p.Test()
+		}
+
+	case 2:
+		localctx = NewSliceSubscriptContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(918)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467815419333575736) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(917)
+				p.Test()
+			}
+
+		}
+		{
+			p.SetState(920)
+			p.Match(PythonParserCOLON)
+		}
+		p.SetState(922)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467815419333575736) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+			{
+				p.SetState(921)
+				p.Test()
+			}
+
+		}
+		p.SetState(928)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserCOLON {
+			{
+				p.SetState(924)
+				p.Match(PythonParserCOLON)
+			}
+			p.SetState(926)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&3467815419333575736) != 0 || (int64((_la-65)) & ^0x3f) == 0 && ((int64(1)<<(_la-65))&67108867) != 0 {
+				{
+					p.SetState(925)
+					p.Test()
+				}
+
+			}
+
+		}
+
+	case 3:
+		localctx = NewStarSubscriptContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(930)
+			p.StarExpr()
+		}
+
+	}
+
+	return localctx
+}
+
+// IExprlistContext is an interface to support dynamic dispatch.
+type IExprlistContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsExprlistContext differentiates from other interfaces.
+	IsExprlistContext()
+}
+
+type ExprlistContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyExprlistContext() *ExprlistContext {
+	var p = new(ExprlistContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_exprlist
+	return p
+}
+
+func (*ExprlistContext) IsExprlistContext() {}
+
+func NewExprlistContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ExprlistContext {
+	var p = new(ExprlistContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_exprlist
+
+	return p
+}
+
+func (s *ExprlistContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ExprlistContext) AllExpr() []IExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IExprContext); ok {
+			tst[i] = t.(IExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprlistContext) Expr(i int) IExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *ExprlistContext) AllStarExpr() []IStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStarExprContext); ok {
+			tst[i] = t.(IStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ExprlistContext) StarExpr(i int) IStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *ExprlistContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *ExprlistContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *ExprlistContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ExprlistContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ExprlistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitExprlist(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Exprlist() (localctx IExprlistContext) {
+	this := p
+	_ = this
+
+	localctx = NewExprlistContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 112, PythonParserRULE_exprlist)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(935)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		{
+			p.SetState(933)
+			p.expr(0)
+		}
+
+	case PythonParserSTAR:
+		{
+			p.SetState(934)
+			p.StarExpr()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.SetState(944)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 141, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(937)
+				p.Match(PythonParserCOMMA)
+			}
+			p.SetState(940)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+				{
+					p.SetState(938)
+					p.expr(0)
+				}
+
+			case PythonParserSTAR:
+				{
+					p.SetState(939)
+					p.StarExpr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		}
+		p.SetState(946)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 141, p.GetParserRuleContext())
+	}
+	p.SetState(948)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(947)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// ITestlistContext is an interface to support dynamic dispatch.
+type ITestlistContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTestlistContext differentiates from other interfaces.
+	IsTestlistContext()
+}
+
+type TestlistContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTestlistContext() *TestlistContext {
+	var p = new(TestlistContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_testlist
+	return p
+}
+
+func (*TestlistContext) IsTestlistContext() {}
+
+func NewTestlistContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TestlistContext {
+	var p = new(TestlistContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_testlist
+
+	return p
+}
+
+func (s *TestlistContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TestlistContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestlistContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *TestlistContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *TestlistContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *TestlistContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TestlistContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TestlistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTestlist(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Testlist() (localctx ITestlistContext) {
+	this := p
+	_ = this
+
+	localctx = NewTestlistContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 114, PythonParserRULE_testlist)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(950)
+		p.Test()
+	}
+	p.SetState(955)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 143, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(951)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(952)
+				p.Test()
+			}
+
+		}
+		p.SetState(957)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 143, p.GetParserRuleContext())
+	}
+	p.SetState(959)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(958)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// ITestlistStarExprContext is an interface to support dynamic dispatch.
+type ITestlistStarExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsTestlistStarExprContext differentiates from other interfaces.
+	IsTestlistStarExprContext()
+}
+
+type TestlistStarExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyTestlistStarExprContext() *TestlistStarExprContext {
+	var p = new(TestlistStarExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_testlistStarExpr
+	return p
+}
+
+func (*TestlistStarExprContext) IsTestlistStarExprContext() {}
+
+func NewTestlistStarExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *TestlistStarExprContext {
+	var p = new(TestlistStarExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_testlistStarExpr
+
+	return p
+}
+
+func (s *TestlistStarExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *TestlistStarExprContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestlistStarExprContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *TestlistStarExprContext) AllStarExpr() []IStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStarExprContext); ok {
+			tst[i] = t.(IStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *TestlistStarExprContext) StarExpr(i int) IStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *TestlistStarExprContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *TestlistStarExprContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *TestlistStarExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *TestlistStarExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *TestlistStarExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitTestlistStarExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) TestlistStarExpr() (localctx ITestlistStarExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewTestlistStarExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 116, PythonParserRULE_testlistStarExpr)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(963)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		{
+			p.SetState(961)
+			p.Test()
+		}
+
+	case PythonParserSTAR:
+		{
+			p.SetState(962)
+			p.StarExpr()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+	p.SetState(972)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(965)
+				p.Match(PythonParserCOMMA)
+			}
+			p.SetState(968)
+			p.GetErrorHandler().Sync(p)
+
+			switch p.GetTokenStream().LA(1) {
+			case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+				{
+					p.SetState(966)
+					p.Test()
+				}
+
+			case PythonParserSTAR:
+				{
+					p.SetState(967)
+					p.StarExpr()
+				}
+
+			default:
+				panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+			}
+
+		}
+		p.SetState(974)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 147, p.GetParserRuleContext())
+	}
+	p.SetState(976)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(975)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// IDictOrSetMakerContext is an interface to support dynamic dispatch.
+type IDictOrSetMakerContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDictOrSetMakerContext differentiates from other interfaces.
+	IsDictOrSetMakerContext()
+}
+
+type DictOrSetMakerContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDictOrSetMakerContext() *DictOrSetMakerContext {
+	var p = new(DictOrSetMakerContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_dictOrSetMaker
+	return p
+}
+
+func (*DictOrSetMakerContext) IsDictOrSetMakerContext() {}
+
+func NewDictOrSetMakerContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DictOrSetMakerContext {
+	var p = new(DictOrSetMakerContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_dictOrSetMaker
+
+	return p
+}
+
+func (s *DictOrSetMakerContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DictOrSetMakerContext) CopyFrom(ctx *DictOrSetMakerContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *DictOrSetMakerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DictOrSetMakerContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type DictMakerContext struct {
+	*DictOrSetMakerContext
+}
+
+func NewDictMakerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *DictMakerContext {
+	var p = new(DictMakerContext)
+
+	p.DictOrSetMakerContext = NewEmptyDictOrSetMakerContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*DictOrSetMakerContext))
+
+	return p
+}
+
+func (s *DictMakerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DictMakerContext) AllDictItem() []IDictItemContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IDictItemContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IDictItemContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IDictItemContext); ok {
+			tst[i] = t.(IDictItemContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DictMakerContext) DictItem(i int) IDictItemContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IDictItemContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IDictItemContext)
+}
+
+func (s *DictMakerContext) CompFor() ICompForContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompForContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompForContext)
+}
+
+func (s *DictMakerContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *DictMakerContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *DictMakerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDictMaker(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type SetMakerContext struct {
+	*DictOrSetMakerContext
+}
+
+func NewSetMakerContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *SetMakerContext {
+	var p = new(SetMakerContext)
+
+	p.DictOrSetMakerContext = NewEmptyDictOrSetMakerContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*DictOrSetMakerContext))
+
+	return p
+}
+
+func (s *SetMakerContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *SetMakerContext) AllNamedExprTest() []INamedExprTestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]INamedExprTestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(INamedExprTestContext); ok {
+			tst[i] = t.(INamedExprTestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SetMakerContext) NamedExprTest(i int) INamedExprTestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INamedExprTestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INamedExprTestContext)
+}
+
+func (s *SetMakerContext) AllStarExpr() []IStarExprContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IStarExprContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IStarExprContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IStarExprContext); ok {
+			tst[i] = t.(IStarExprContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *SetMakerContext) StarExpr(i int) IStarExprContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IStarExprContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IStarExprContext)
+}
+
+func (s *SetMakerContext) CompFor() ICompForContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompForContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompForContext)
+}
+
+func (s *SetMakerContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *SetMakerContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *SetMakerContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitSetMaker(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) DictOrSetMaker() (localctx IDictOrSetMakerContext) {
+	this := p
+	_ = this
+
+	localctx = NewDictOrSetMakerContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 118, PythonParserRULE_dictOrSetMaker)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.SetState(1012)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 157, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewDictMakerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(978)
+			p.DictItem()
+		}
+		p.SetState(990)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserASYNC, PythonParserFOR:
+			{
+				p.SetState(979)
+				p.CompFor()
+			}
+
+		case PythonParserCOMMA, PythonParserCLOSE_BRACE:
+			p.SetState(984)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 149, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(980)
+						p.Match(PythonParserCOMMA)
+					}
+					{
+						p.SetState(981)
+						p.DictItem()
+					}
+
+				}
+				p.SetState(986)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 149, p.GetParserRuleContext())
+			}
+			p.SetState(988)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOMMA {
+				{
+					p.SetState(987)
+					p.Match(PythonParserCOMMA)
+				}
+
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	case 2:
+		localctx = NewSetMakerContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		p.SetState(994)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+			{
+				p.SetState(992)
+				p.NamedExprTest()
+			}
+
+		case PythonParserSTAR:
+			{
+				p.SetState(993)
+				p.StarExpr()
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+		p.SetState(1010)
+		p.GetErrorHandler().Sync(p)
+
+		switch p.GetTokenStream().LA(1) {
+		case PythonParserASYNC, PythonParserFOR:
+			{
+				p.SetState(996)
+				p.CompFor()
+			}
+
+		case PythonParserCOMMA, PythonParserCLOSE_BRACE:
+			p.SetState(1004)
+			p.GetErrorHandler().Sync(p)
+			_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
+
+			for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+				if _alt == 1 {
+					{
+						p.SetState(997)
+						p.Match(PythonParserCOMMA)
+					}
+					p.SetState(1000)
+					p.GetErrorHandler().Sync(p)
+
+					switch p.GetTokenStream().LA(1) {
+					case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+						{
+							p.SetState(998)
+							p.NamedExprTest()
+						}
+
+					case PythonParserSTAR:
+						{
+							p.SetState(999)
+							p.StarExpr()
+						}
+
+					default:
+						panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+					}
+
+				}
+				p.SetState(1006)
+				p.GetErrorHandler().Sync(p)
+				_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 154, p.GetParserRuleContext())
+			}
+			p.SetState(1008)
+			p.GetErrorHandler().Sync(p)
+			_la = p.GetTokenStream().LA(1)
+
+			if _la == PythonParserCOMMA {
+				{
+					p.SetState(1007)
+					p.Match(PythonParserCOMMA)
+				}
+
+			}
+
+		default:
+			panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+		}
+
+	}
+
+	return localctx
+}
+
+// IDictItemContext is an interface to support dynamic dispatch.
+type IDictItemContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsDictItemContext differentiates from other interfaces.
+	IsDictItemContext()
+}
+
+type DictItemContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyDictItemContext() *DictItemContext {
+	var p = new(DictItemContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_dictItem
+	return p
+}
+
+func (*DictItemContext) IsDictItemContext() {}
+
+func NewDictItemContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *DictItemContext {
+	var p = new(DictItemContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_dictItem
+
+	return p
+}
+
+func (s *DictItemContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *DictItemContext) AllTest() []ITestContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(ITestContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]ITestContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(ITestContext); ok {
+			tst[i] = t.(ITestContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *DictItemContext) Test(i int) ITestContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *DictItemContext) COLON() antlr.TerminalNode {
+	return s.GetToken(PythonParserCOLON, 0)
+}
+
+func (s *DictItemContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *DictItemContext) Expr() IExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprContext)
+}
+
+func (s *DictItemContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *DictItemContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *DictItemContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitDictItem(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) DictItem() (localctx IDictItemContext) {
+	this := p
+	_ = this
+
+	localctx = NewDictItemContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 120, PythonParserRULE_dictItem)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(1020)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1014)
+			p.Test()
+		}
+		{
+			p.SetState(1015)
+			p.Match(PythonParserCOLON)
+		}
+		{
+			p.SetState(1016)
+			p.Test()
+		}
+
+	case PythonParserPOWER:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1018)
+			p.Match(PythonParserPOWER)
+		}
+		{
+			p.SetState(1019)
+			p.expr(0)
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// IArglistContext is an interface to support dynamic dispatch.
+type IArglistContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsArglistContext differentiates from other interfaces.
+	IsArglistContext()
+}
+
+type ArglistContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArglistContext() *ArglistContext {
+	var p = new(ArglistContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_arglist
+	return p
+}
+
+func (*ArglistContext) IsArglistContext() {}
+
+func NewArglistContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArglistContext {
+	var p = new(ArglistContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_arglist
+
+	return p
+}
+
+func (s *ArglistContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArglistContext) AllArgument() []IArgumentContext {
+	children := s.GetChildren()
+	len := 0
+	for _, ctx := range children {
+		if _, ok := ctx.(IArgumentContext); ok {
+			len++
+		}
+	}
+
+	tst := make([]IArgumentContext, len)
+	i := 0
+	for _, ctx := range children {
+		if t, ok := ctx.(IArgumentContext); ok {
+			tst[i] = t.(IArgumentContext)
+			i++
+		}
+	}
+
+	return tst
+}
+
+func (s *ArglistContext) Argument(i int) IArgumentContext {
+	var t antlr.RuleContext
+	j := 0
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IArgumentContext); ok {
+			if j == i {
+				t = ctx.(antlr.RuleContext)
+				break
+			}
+			j++
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IArgumentContext)
+}
+
+func (s *ArglistContext) AllCOMMA() []antlr.TerminalNode {
+	return s.GetTokens(PythonParserCOMMA)
+}
+
+func (s *ArglistContext) COMMA(i int) antlr.TerminalNode {
+	return s.GetToken(PythonParserCOMMA, i)
+}
+
+func (s *ArglistContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArglistContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *ArglistContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitArglist(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Arglist() (localctx IArglistContext) {
+	this := p
+	_ = this
+
+	localctx = NewArglistContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 122, PythonParserRULE_arglist)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	var _alt int
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1022)
+		p.Argument()
+	}
+	p.SetState(1027)
+	p.GetErrorHandler().Sync(p)
+	_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 159, p.GetParserRuleContext())
+
+	for _alt != 2 && _alt != antlr.ATNInvalidAltNumber {
+		if _alt == 1 {
+			{
+				p.SetState(1023)
+				p.Match(PythonParserCOMMA)
+			}
+			{
+				p.SetState(1024)
+				p.Argument()
+			}
+
+		}
+		p.SetState(1029)
+		p.GetErrorHandler().Sync(p)
+		_alt = p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 159, p.GetParserRuleContext())
+	}
+	p.SetState(1031)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserCOMMA {
+		{
+			p.SetState(1030)
+			p.Match(PythonParserCOMMA)
+		}
+
+	}
+
+	return localctx
+}
+
+// IArgumentContext is an interface to support dynamic dispatch.
+type IArgumentContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsArgumentContext differentiates from other interfaces.
+	IsArgumentContext()
+}
+
+type ArgumentContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyArgumentContext() *ArgumentContext {
+	var p = new(ArgumentContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_argument
+	return p
+}
+
+func (*ArgumentContext) IsArgumentContext() {}
+
+func NewArgumentContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *ArgumentContext {
+	var p = new(ArgumentContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_argument
+
+	return p
+}
+
+func (s *ArgumentContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *ArgumentContext) CopyFrom(ctx *ArgumentContext) {
+	s.BaseParserRuleContext.CopyFrom(ctx.BaseParserRuleContext)
+}
+
+func (s *ArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *ArgumentContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+type KwArgumentContext struct {
+	*ArgumentContext
+}
+
+func NewKwArgumentContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *KwArgumentContext {
+	var p = new(KwArgumentContext)
+
+	p.ArgumentContext = NewEmptyArgumentContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ArgumentContext))
+
+	return p
+}
+
+func (s *KwArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KwArgumentContext) POWER() antlr.TerminalNode {
+	return s.GetToken(PythonParserPOWER, 0)
+}
+
+func (s *KwArgumentContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *KwArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitKwArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type NamedExprArgumentContext struct {
+	*ArgumentContext
+}
+
+func NewNamedExprArgumentContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *NamedExprArgumentContext {
+	var p = new(NamedExprArgumentContext)
+
+	p.ArgumentContext = NewEmptyArgumentContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ArgumentContext))
+
+	return p
+}
+
+func (s *NamedExprArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *NamedExprArgumentContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *NamedExprArgumentContext) WALRUS() antlr.TerminalNode {
+	return s.GetToken(PythonParserWALRUS, 0)
+}
+
+func (s *NamedExprArgumentContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *NamedExprArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitNamedExprArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type PositionalArgumentContext struct {
+	*ArgumentContext
+}
+
+func NewPositionalArgumentContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *PositionalArgumentContext {
+	var p = new(PositionalArgumentContext)
+
+	p.ArgumentContext = NewEmptyArgumentContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ArgumentContext))
+
+	return p
+}
+
+func (s *PositionalArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *PositionalArgumentContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *PositionalArgumentContext) CompFor() ICompForContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompForContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompForContext)
+}
+
+func (s *PositionalArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitPositionalArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type KeywordArgumentContext struct {
+	*ArgumentContext
+}
+
+func NewKeywordArgumentContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *KeywordArgumentContext {
+	var p = new(KeywordArgumentContext)
+
+	p.ArgumentContext = NewEmptyArgumentContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ArgumentContext))
+
+	return p
+}
+
+func (s *KeywordArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *KeywordArgumentContext) Name() INameContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(INameContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(INameContext)
+}
+
+func (s *KeywordArgumentContext) ASSIGN() antlr.TerminalNode {
+	return s.GetToken(PythonParserASSIGN, 0)
+}
+
+func (s *KeywordArgumentContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *KeywordArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitKeywordArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+type StarArgumentContext struct {
+	*ArgumentContext
+}
+
+func NewStarArgumentContext(parser antlr.Parser, ctx antlr.ParserRuleContext) *StarArgumentContext {
+	var p = new(StarArgumentContext)
+
+	p.ArgumentContext = NewEmptyArgumentContext()
+	p.parser = parser
+	p.CopyFrom(ctx.(*ArgumentContext))
+
+	return p
+}
+
+func (s *StarArgumentContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *StarArgumentContext) STAR() antlr.TerminalNode {
+	return s.GetToken(PythonParserSTAR, 0)
+}
+
+func (s *StarArgumentContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *StarArgumentContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitStarArgument(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) Argument() (localctx IArgumentContext) {
+	this := p
+	_ = this
+
+	localctx = NewArgumentContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 124, PythonParserRULE_argument)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(1049)
+	p.GetErrorHandler().Sync(p)
+	switch p.GetInterpreter().AdaptivePredict(p.GetTokenStream(), 162, p.GetParserRuleContext()) {
+	case 1:
+		localctx = NewPositionalArgumentContext(p, localctx)
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1033)
+			p.Test()
+		}
+		p.SetState(1035)
+		p.GetErrorHandler().Sync(p)
+		_la = p.GetTokenStream().LA(1)
+
+		if _la == PythonParserASYNC || _la == PythonParserFOR {
+			{
+				p.SetState(1034)
+				p.CompFor()
+			}
+
+		}
+
+	case 2:
+		localctx = NewNamedExprArgumentContext(p, localctx)
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1037)
+			p.Name()
+		}
+		{
+			p.SetState(1038)
+			p.Match(PythonParserWALRUS)
+		}
+		{
+			p.SetState(1039)
+			p.Test()
+		}
+
+	case 3:
+		localctx = NewKeywordArgumentContext(p, localctx)
+		p.EnterOuterAlt(localctx, 3)
+		{
+			p.SetState(1041)
+			p.Name()
+		}
+		{
+			p.SetState(1042)
+			p.Match(PythonParserASSIGN)
+		}
+		{
+			p.SetState(1043)
+			p.Test()
+		}
+
+	case 4:
+		localctx = NewStarArgumentContext(p, localctx)
+		p.EnterOuterAlt(localctx, 4)
+		{
+			p.SetState(1045)
+			p.Match(PythonParserSTAR)
+		}
+		{
+			p.SetState(1046)
+			p.Test()
+		}
+
+	case 5:
+		localctx = NewKwArgumentContext(p, localctx)
+		p.EnterOuterAlt(localctx, 5)
+		{
+			p.SetState(1047)
+			p.Match(PythonParserPOWER)
+		}
+		{
+			p.SetState(1048)
+			p.Test()
+		}
+
+	}
+
+	return localctx
+}
+
+// ICompForContext is an interface to support dynamic dispatch.
+type ICompForContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompForContext differentiates from other interfaces.
+	IsCompForContext()
+}
+
+type CompForContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompForContext() *CompForContext {
+	var p = new(CompForContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_compFor
+	return p
+}
+
+func (*CompForContext) IsCompForContext() {}
+
+func NewCompForContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompForContext {
+	var p = new(CompForContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_compFor
+
+	return p
+}
+
+func (s *CompForContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompForContext) FOR() antlr.TerminalNode {
+	return s.GetToken(PythonParserFOR, 0)
+}
+
+func (s *CompForContext) Exprlist() IExprlistContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IExprlistContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IExprlistContext)
+}
+
+func (s *CompForContext) IN() antlr.TerminalNode {
+	return s.GetToken(PythonParserIN, 0)
+}
+
+func (s *CompForContext) OrTest() IOrTestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(IOrTestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(IOrTestContext)
+}
+
+func (s *CompForContext) ASYNC() antlr.TerminalNode {
+	return s.GetToken(PythonParserASYNC, 0)
+}
+
+func (s *CompForContext) CompIter() ICompIterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompIterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompIterContext)
+}
+
+func (s *CompForContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompForContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompForContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCompFor(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CompFor() (localctx ICompForContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompForContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 126, PythonParserRULE_compFor)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	p.SetState(1052)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if _la == PythonParserASYNC {
+		{
+			p.SetState(1051)
+			p.Match(PythonParserASYNC)
+		}
+
+	}
+	{
+		p.SetState(1054)
+		p.Match(PythonParserFOR)
+	}
+	{
+		p.SetState(1055)
+		p.Exprlist()
+	}
+	{
+		p.SetState(1056)
+		p.Match(PythonParserIN)
+	}
+	{
+		p.SetState(1057)
+		p.OrTest()
+	}
+	p.SetState(1059)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&9437696) != 0 {
+		{
+			p.SetState(1058)
+			p.CompIter()
+		}
+
+	}
+
+	return localctx
+}
+
+// ICompIterContext is an interface to support dynamic dispatch.
+type ICompIterContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompIterContext differentiates from other interfaces.
+	IsCompIterContext()
+}
+
+type CompIterContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompIterContext() *CompIterContext {
+	var p = new(CompIterContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_compIter
+	return p
+}
+
+func (*CompIterContext) IsCompIterContext() {}
+
+func NewCompIterContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompIterContext {
+	var p = new(CompIterContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_compIter
+
+	return p
+}
+
+func (s *CompIterContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompIterContext) CompFor() ICompForContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompForContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompForContext)
+}
+
+func (s *CompIterContext) CompIf() ICompIfContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompIfContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompIfContext)
+}
+
+func (s *CompIterContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompIterContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompIterContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCompIter(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CompIter() (localctx ICompIterContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompIterContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 128, PythonParserRULE_compIter)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.SetState(1063)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserASYNC, PythonParserFOR:
+		p.EnterOuterAlt(localctx, 1)
+		{
+			p.SetState(1061)
+			p.CompFor()
+		}
+
+	case PythonParserIF:
+		p.EnterOuterAlt(localctx, 2)
+		{
+			p.SetState(1062)
+			p.CompIf()
+		}
+
+	default:
+		panic(antlr.NewNoViableAltException(p, nil, nil, nil, nil, nil))
+	}
+
+	return localctx
+}
+
+// ICompIfContext is an interface to support dynamic dispatch.
+type ICompIfContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsCompIfContext differentiates from other interfaces.
+	IsCompIfContext()
+}
+
+type CompIfContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyCompIfContext() *CompIfContext {
+	var p = new(CompIfContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_compIf
+	return p
+}
+
+func (*CompIfContext) IsCompIfContext() {}
+
+func NewCompIfContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *CompIfContext {
+	var p = new(CompIfContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_compIf
+
+	return p
+}
+
+func (s *CompIfContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *CompIfContext) IF() antlr.TerminalNode {
+	return s.GetToken(PythonParserIF, 0)
+}
+
+func (s *CompIfContext) TestNoCond() ITestNoCondContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestNoCondContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestNoCondContext)
+}
+
+func (s *CompIfContext) CompIter() ICompIterContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ICompIterContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ICompIterContext)
+}
+
+func (s *CompIfContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *CompIfContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *CompIfContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitCompIf(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) CompIf() (localctx ICompIfContext) {
+	this := p
+	_ = this
+
+	localctx = NewCompIfContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 130, PythonParserRULE_compIf)
+	var _la int
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1065)
+		p.Match(PythonParserIF)
+	}
+	{
+		p.SetState(1066)
+		p.TestNoCond()
+	}
+	p.SetState(1068)
+	p.GetErrorHandler().Sync(p)
+	_la = p.GetTokenStream().LA(1)
+
+	if (int64(_la) & ^0x3f) == 0 && ((int64(1)<<_la)&9437696) != 0 {
+		{
+			p.SetState(1067)
+			p.CompIter()
+		}
+
+	}
+
+	return localctx
+}
+
+// IYieldExprContext is an interface to support dynamic dispatch.
+type IYieldExprContext interface {
+	antlr.ParserRuleContext
+
+	// GetParser returns the parser.
+	GetParser() antlr.Parser
+
+	// IsYieldExprContext differentiates from other interfaces.
+	IsYieldExprContext()
+}
+
+type YieldExprContext struct {
+	*antlr.BaseParserRuleContext
+	parser antlr.Parser
+}
+
+func NewEmptyYieldExprContext() *YieldExprContext {
+	var p = new(YieldExprContext)
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(nil, -1)
+	p.RuleIndex = PythonParserRULE_yieldExpr
+	return p
+}
+
+func (*YieldExprContext) IsYieldExprContext() {}
+
+func NewYieldExprContext(parser antlr.Parser, parent antlr.ParserRuleContext, invokingState int) *YieldExprContext {
+	var p = new(YieldExprContext)
+
+	p.BaseParserRuleContext = antlr.NewBaseParserRuleContext(parent, invokingState)
+
+	p.parser = parser
+	p.RuleIndex = PythonParserRULE_yieldExpr
+
+	return p
+}
+
+func (s *YieldExprContext) GetParser() antlr.Parser { return s.parser }
+
+func (s *YieldExprContext) YIELD() antlr.TerminalNode {
+	return s.GetToken(PythonParserYIELD, 0)
+}
+
+func (s *YieldExprContext) FROM() antlr.TerminalNode {
+	return s.GetToken(PythonParserFROM, 0)
+}
+
+func (s *YieldExprContext) Test() ITestContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestContext)
+}
+
+func (s *YieldExprContext) TestlistStarExpr() ITestlistStarExprContext {
+	var t antlr.RuleContext
+	for _, ctx := range s.GetChildren() {
+		if _, ok := ctx.(ITestlistStarExprContext); ok {
+			t = ctx.(antlr.RuleContext)
+			break
+		}
+	}
+
+	if t == nil {
+		return nil
+	}
+
+	return t.(ITestlistStarExprContext)
+}
+
+func (s *YieldExprContext) GetRuleContext() antlr.RuleContext {
+	return s
+}
+
+func (s *YieldExprContext) ToStringTree(ruleNames []string, recog antlr.Recognizer) string {
+	return antlr.TreesStringTree(s, ruleNames, recog)
+}
+
+func (s *YieldExprContext) Accept(visitor antlr.ParseTreeVisitor) interface{} {
+	switch t := visitor.(type) {
+	case PythonParserVisitor:
+		return t.VisitYieldExpr(s)
+
+	default:
+		return t.VisitChildren(s)
+	}
+}
+
+func (p *PythonParser) YieldExpr() (localctx IYieldExprContext) {
+	this := p
+	_ = this
+
+	localctx = NewYieldExprContext(p, p.GetParserRuleContext(), p.GetState())
+	p.EnterRule(localctx, 132, PythonParserRULE_yieldExpr)
+
+	defer func() {
+		p.ExitRule()
+	}()
+
+	defer func() {
+		if err := recover(); err != nil {
+			if v, ok := err.(antlr.RecognitionException); ok {
+				localctx.SetException(v)
+				p.GetErrorHandler().ReportError(p, v)
+				p.GetErrorHandler().Recover(p, v)
+			} else {
+				panic(err)
+			}
+		}
+	}()
+
+	p.EnterOuterAlt(localctx, 1)
+	{
+		p.SetState(1070)
+		p.Match(PythonParserYIELD)
+	}
+	p.SetState(1074)
+	p.GetErrorHandler().Sync(p)
+
+	switch p.GetTokenStream().LA(1) {
+	case PythonParserFROM:
+		{
+			p.SetState(1071)
+			p.Match(PythonParserFROM)
+		}
+		{
+			p.SetState(1072)
+			p.Test()
+		}
+
+	case PythonParserFALSE, PythonParserNONE, PythonParserTRUE, PythonParserAWAIT, PythonParserLAMBDA, PythonParserNOT, PythonParserMATCH, PythonParserCASE, PythonParserSTRING, PythonParserNUMBER, PythonParserELLIPSIS, PythonParserSTAR, PythonParserOPEN_PAREN, PythonParserOPEN_BRACKET, PythonParserADD, PythonParserMINUS, PythonParserNOT_OP, PythonParserOPEN_BRACE, PythonParserNAME:
+		{
+			p.SetState(1073)
+			p.TestlistStarExpr()
+		}
+
+	case PythonParserCLOSE_PAREN, PythonParserSEMI_COLON, PythonParserASSIGN, PythonParserNEWLINE:
+
+	default:
+	}
+
+	return localctx
+}
+
+func (p *PythonParser) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	switch ruleIndex {
+	case 48:
+		var t *ExprContext = nil
+		if localctx != nil {
+			t = localctx.(*ExprContext)
+		}
+		return p.Expr_Sempred(t, predIndex)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(ruleIndex))
+	}
+}
+
+func (p *PythonParser) Expr_Sempred(localctx antlr.RuleContext, predIndex int) bool {
+	this := p
+	_ = this
+
+	switch predIndex {
+	case 0:
+		return p.Precpred(p.GetParserRuleContext(), 8)
+
+	case 1:
+		return p.Precpred(p.GetParserRuleContext(), 6)
+
+	case 2:
+		return p.Precpred(p.GetParserRuleContext(), 5)
+
+	case 3:
+		return p.Precpred(p.GetParserRuleContext(), 4)
+
+	case 4:
+		return p.Precpred(p.GetParserRuleContext(), 3)
+
+	case 5:
+		return p.Precpred(p.GetParserRuleContext(), 2)
+
+	case 6:
+		return p.Precpred(p.GetParserRuleContext(), 1)
+
+	default:
+		panic("No predicate with index: " + fmt.Sprint(predIndex))
+	}
+}
@@ -0,0 +1,375 @@
+// Code generated from java-escape by ANTLR 4.11.1. DO NOT EDIT.
+
+package pythonparser // PythonParser
+import "github.com/antlr/antlr4/runtime/Go/antlr/v4"
+
+// A complete Visitor for a parse tree produced by PythonParser.
+type PythonParserVisitor interface {
+	antlr.ParseTreeVisitor
+
+	// Visit a parse tree produced by PythonParser#fileInput.
+	VisitFileInput(ctx *FileInputContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#statement.
+	VisitStatement(ctx *StatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#simpleStatements.
+	VisitSimpleStatements(ctx *SimpleStatementsContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ExpressionStatement.
+	VisitExpressionStatement(ctx *ExpressionStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#YieldStatement.
+	VisitYieldStatement(ctx *YieldStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#DelStatement.
+	VisitDelStatement(ctx *DelStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#PassStatement.
+	VisitPassStatement(ctx *PassStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#BreakStatement.
+	VisitBreakStatement(ctx *BreakStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ContinueStatement.
+	VisitContinueStatement(ctx *ContinueStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ReturnStatement.
+	VisitReturnStatement(ctx *ReturnStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#RaiseStatement.
+	VisitRaiseStatement(ctx *RaiseStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ImportStatement.
+	VisitImportStatement(ctx *ImportStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ImportFromStatement.
+	VisitImportFromStatement(ctx *ImportFromStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#GlobalStatement.
+	VisitGlobalStatement(ctx *GlobalStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NonlocalStatement.
+	VisitNonlocalStatement(ctx *NonlocalStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#AssertStatement.
+	VisitAssertStatement(ctx *AssertStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#AnnotatedAssign.
+	VisitAnnotatedAssign(ctx *AnnotatedAssignContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#AugmentedAssign.
+	VisitAugmentedAssign(ctx *AugmentedAssignContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NormalAssign.
+	VisitNormalAssign(ctx *NormalAssignContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#augAssign.
+	VisitAugAssign(ctx *AugAssignContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#importFromModule.
+	VisitImportFromModule(ctx *ImportFromModuleContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#importTargets.
+	VisitImportTargets(ctx *ImportTargetsContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#importAsNames.
+	VisitImportAsNames(ctx *ImportAsNamesContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#importAsName.
+	VisitImportAsName(ctx *ImportAsNameContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#dottedAsNames.
+	VisitDottedAsNames(ctx *DottedAsNamesContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#dottedAsName.
+	VisitDottedAsName(ctx *DottedAsNameContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#dottedName.
+	VisitDottedName(ctx *DottedNameContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#IfStatement.
+	VisitIfStatement(ctx *IfStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#WhileStatement.
+	VisitWhileStatement(ctx *WhileStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ForStatement.
+	VisitForStatement(ctx *ForStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#TryStatement.
+	VisitTryStatement(ctx *TryStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#WithStatement.
+	VisitWithStatement(ctx *WithStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#DefinitionStatement.
+	VisitDefinitionStatement(ctx *DefinitionStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#MatchStatement.
+	VisitMatchStatement(ctx *MatchStatementContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#block.
+	VisitBlock(ctx *BlockContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#elifClause.
+	VisitElifClause(ctx *ElifClauseContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#elseClause.
+	VisitElseClause(ctx *ElseClauseContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#exceptClause.
+	VisitExceptClause(ctx *ExceptClauseContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#finallyClause.
+	VisitFinallyClause(ctx *FinallyClauseContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#withItems.
+	VisitWithItems(ctx *WithItemsContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#withItem.
+	VisitWithItem(ctx *WithItemContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#decorator.
+	VisitDecorator(ctx *DecoratorContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#funcDef.
+	VisitFuncDef(ctx *FuncDefContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#classDef.
+	VisitClassDef(ctx *ClassDefContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#typedArgsList.
+	VisitTypedArgsList(ctx *TypedArgsListContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NamedParameter.
+	VisitNamedParameter(ctx *NamedParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#VarParameter.
+	VisitVarParameter(ctx *VarParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#KwParameter.
+	VisitKwParameter(ctx *KwParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#PosOnlyMarker.
+	VisitPosOnlyMarker(ctx *PosOnlyMarkerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#varArgsList.
+	VisitVarArgsList(ctx *VarArgsListContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#LambdaNamedParameter.
+	VisitLambdaNamedParameter(ctx *LambdaNamedParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#LambdaVarParameter.
+	VisitLambdaVarParameter(ctx *LambdaVarParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#LambdaKwParameter.
+	VisitLambdaKwParameter(ctx *LambdaKwParameterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#LambdaPosOnlyMarker.
+	VisitLambdaPosOnlyMarker(ctx *LambdaPosOnlyMarkerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#subjectExpr.
+	VisitSubjectExpr(ctx *SubjectExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#caseBlock.
+	VisitCaseBlock(ctx *CaseBlockContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#casePatterns.
+	VisitCasePatterns(ctx *CasePatternsContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#StarPattern.
+	VisitStarPattern(ctx *StarPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#AsPattern.
+	VisitAsPattern(ctx *AsPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#orPattern.
+	VisitOrPattern(ctx *OrPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#LiteralClosedPattern.
+	VisitLiteralClosedPattern(ctx *LiteralClosedPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#CapturePattern.
+	VisitCapturePattern(ctx *CapturePatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ValuePattern.
+	VisitValuePattern(ctx *ValuePatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#GroupPattern.
+	VisitGroupPattern(ctx *GroupPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#TuplePattern.
+	VisitTuplePattern(ctx *TuplePatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ListPattern.
+	VisitListPattern(ctx *ListPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#MappingPatterns.
+	VisitMappingPatterns(ctx *MappingPatternsContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ClassPattern.
+	VisitClassPattern(ctx *ClassPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#literalPattern.
+	VisitLiteralPattern(ctx *LiteralPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#mappingPattern.
+	VisitMappingPattern(ctx *MappingPatternContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#classPatternArg.
+	VisitClassPatternArg(ctx *ClassPatternArgContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#namedExprTest.
+	VisitNamedExprTest(ctx *NamedExprTestContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#test.
+	VisitTest(ctx *TestContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#testNoCond.
+	VisitTestNoCond(ctx *TestNoCondContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#lambdef.
+	VisitLambdef(ctx *LambdefContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#lambdefNoCond.
+	VisitLambdefNoCond(ctx *LambdefNoCondContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#orTest.
+	VisitOrTest(ctx *OrTestContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#andTest.
+	VisitAndTest(ctx *AndTestContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#notTest.
+	VisitNotTest(ctx *NotTestContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#comparison.
+	VisitComparison(ctx *ComparisonContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#compOp.
+	VisitCompOp(ctx *CompOpContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#starExpr.
+	VisitStarExpr(ctx *StarExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#PowerExpr.
+	VisitPowerExpr(ctx *PowerExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#BinaryExpr.
+	VisitBinaryExpr(ctx *BinaryExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#PrimaryExpr.
+	VisitPrimaryExpr(ctx *PrimaryExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#UnaryExpr.
+	VisitUnaryExpr(ctx *UnaryExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#atomExpr.
+	VisitAtomExpr(ctx *AtomExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ParenAtom.
+	VisitParenAtom(ctx *ParenAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#ListAtom.
+	VisitListAtom(ctx *ListAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#DictOrSetAtom.
+	VisitDictOrSetAtom(ctx *DictOrSetAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NameAtom.
+	VisitNameAtom(ctx *NameAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NumberAtom.
+	VisitNumberAtom(ctx *NumberAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#StringAtom.
+	VisitStringAtom(ctx *StringAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#EllipsisAtom.
+	VisitEllipsisAtom(ctx *EllipsisAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NoneAtom.
+	VisitNoneAtom(ctx *NoneAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#TrueAtom.
+	VisitTrueAtom(ctx *TrueAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#FalseAtom.
+	VisitFalseAtom(ctx *FalseAtomContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#name.
+	VisitName(ctx *NameContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#testlistComp.
+	VisitTestlistComp(ctx *TestlistCompContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#CallTrailer.
+	VisitCallTrailer(ctx *CallTrailerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#SubscriptTrailer.
+	VisitSubscriptTrailer(ctx *SubscriptTrailerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#AttributeTrailer.
+	VisitAttributeTrailer(ctx *AttributeTrailerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#subscriptList.
+	VisitSubscriptList(ctx *SubscriptListContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#IndexSubscript.
+	VisitIndexSubscript(ctx *IndexSubscriptContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#SliceSubscript.
+	VisitSliceSubscript(ctx *SliceSubscriptContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#StarSubscript.
+	VisitStarSubscript(ctx *StarSubscriptContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#exprlist.
+	VisitExprlist(ctx *ExprlistContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#testlist.
+	VisitTestlist(ctx *TestlistContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#testlistStarExpr.
+	VisitTestlistStarExpr(ctx *TestlistStarExprContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#DictMaker.
+	VisitDictMaker(ctx *DictMakerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#SetMaker.
+	VisitSetMaker(ctx *SetMakerContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#dictItem.
+	VisitDictItem(ctx *DictItemContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#arglist.
+	VisitArglist(ctx *ArglistContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#PositionalArgument.
+	VisitPositionalArgument(ctx *PositionalArgumentContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#NamedExprArgument.
+	VisitNamedExprArgument(ctx *NamedExprArgumentContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#KeywordArgument.
+	VisitKeywordArgument(ctx *KeywordArgumentContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#StarArgument.
+	VisitStarArgument(ctx *StarArgumentContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#KwArgument.
+	VisitKwArgument(ctx *KwArgumentContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#compFor.
+	VisitCompFor(ctx *CompForContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#compIter.
+	VisitCompIter(ctx *CompIterContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#compIf.
+	VisitCompIf(ctx *CompIfContext) interface{}
+
+	// Visit a parse tree produced by PythonParser#yieldExpr.
+	VisitYieldExpr(ctx *YieldExprContext) interface{}
+}
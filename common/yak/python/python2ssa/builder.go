@@ -0,0 +1,81 @@
+package python2ssa
+
+import (
+	"path/filepath"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+type SSABuilder struct {
+	ssa.DummyPreHandler
+}
+
+var Builder = &SSABuilder{}
+
+func (s *SSABuilder) Create() ssa.Builder {
+	return &SSABuilder{}
+}
+
+func (*SSABuilder) Build(src string, force bool, b *ssa.FunctionBuilder) error {
+	ast, err := Frontend(src, force)
+	if err != nil {
+		return err
+	}
+	b.SupportClosure = true
+	b.SupportClass = true
+	astBuilder := &astbuilder{
+		FunctionBuilder: b,
+		modules:         make(map[string]*ssa.Program),
+	}
+	astBuilder.VisitFileInput(ast)
+	return nil
+}
+
+func (*SSABuilder) FilterFile(path string) bool {
+	return filepath.Ext(path) == ".py"
+}
+
+func (*SSABuilder) GetLanguage() consts.Language {
+	return consts.PY
+}
+
+type astbuilder struct {
+	*ssa.FunctionBuilder
+
+	// modules is imported python module, key is the local name bind in this file
+	modules map[string]*ssa.Program
+	// scopes record the binding names of each enclosing function, the last one is current
+	scopes []*pyScope
+}
+
+// pyScope is the python name scope of a function (or module)
+type pyScope struct {
+	// bound is all names assigned in this scope
+	bound map[string]struct{}
+	// outer is names declared by `global` / `nonlocal`
+	outer map[string]struct{}
+	// isModule means the names should be exported
+	isModule bool
+}
+
+func Frontend(src string, force bool) (pythonparser.IFileInputContext, error) {
+	errListener := antlr4util.NewErrorListener()
+	lexer := pythonparser.NewPythonLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	tokenStream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	parser := pythonparser.NewPythonParser(tokenStream)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	parser.SetErrorHandler(antlr.NewDefaultErrorStrategy())
+	ast := parser.FileInput()
+	if force || len(errListener.GetErrors()) == 0 {
+		return ast, nil
+	}
+	return nil, utils.Errorf("parse AST FrontEnd error: %v", errListener.GetErrorString())
+}
@@ -0,0 +1,1005 @@
+package python2ssa
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/google/uuid"
+	"github.com/yaklang/yaklang/common/utils"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func binaryOpcode(op string) (ssa.BinaryOpcode, bool) {
+	switch op {
+	case "+":
+		return ssa.OpAdd, true
+	case "-":
+		return ssa.OpSub, true
+	case "*":
+		return ssa.OpMul, true
+	case "/", "//":
+		return ssa.OpDiv, true
+	case "%":
+		return ssa.OpMod, true
+	case "**":
+		return ssa.OpPow, true
+	case "<<":
+		return ssa.OpShl, true
+	case ">>":
+		return ssa.OpShr, true
+	case "&":
+		return ssa.OpAnd, true
+	case "|":
+		return ssa.OpOr, true
+	case "^":
+		return ssa.OpXor, true
+	case "@":
+		// matrix multiplication
+		return ssa.OpMul, true
+	}
+	return "", false
+}
+
+func compareOpcode(op string) (ssa.BinaryOpcode, bool) {
+	switch op {
+	case "<":
+		return ssa.OpLt, true
+	case ">":
+		return ssa.OpGt, true
+	case "<=":
+		return ssa.OpLtEq, true
+	case ">=":
+		return ssa.OpGtEq, true
+	case "==", "is":
+		return ssa.OpEq, true
+	case "!=", "<>", "is not":
+		return ssa.OpNotEq, true
+	case "in":
+		return ssa.OpIn, true
+	}
+	return "", false
+}
+
+// ================================ helper ================================
+
+// listElements get the rule children of list-like node, the separators are skipped
+func listElements(tree antlr.Tree) []antlr.Tree {
+	var ret []antlr.Tree
+	for _, child := range tree.GetChildren() {
+		if _, ok := child.(antlr.TerminalNode); ok {
+			continue
+		}
+		ret = append(ret, child)
+	}
+	return ret
+}
+
+// hasComma check the list-like node is a tuple, `a,` is tuple but `a` is not
+func hasComma(tree antlr.Tree) bool {
+	for _, child := range tree.GetChildren() {
+		if t, ok := child.(antlr.TerminalNode); ok && t.GetSymbol().GetTokenType() == pythonparser.PythonParserCOMMA {
+			return true
+		}
+	}
+	return false
+}
+
+// atomExprOf get the atom expression if the node is just a primary, such as `a.b[c]` but not `a + b`
+func atomExprOf(tree antlr.Tree) *pythonparser.AtomExprContext {
+	switch i := tree.(type) {
+	case *pythonparser.AtomExprContext:
+		return i
+	case *pythonparser.PrimaryExprContext:
+		return atomExprOf(i.AtomExpr())
+	case *pythonparser.NamedExprTestContext:
+		if i.WALRUS() == nil {
+			return atomExprOf(i.Test())
+		}
+	case *pythonparser.TestContext:
+		if i.IF() == nil && i.Lambdef() == nil {
+			return atomExprOf(i.OrTest(0))
+		}
+	case *pythonparser.TestNoCondContext:
+		return atomExprOf(i.OrTest())
+	case *pythonparser.OrTestContext:
+		if len(i.AllAndTest()) == 1 {
+			return atomExprOf(i.AndTest(0))
+		}
+	case *pythonparser.AndTestContext:
+		if len(i.AllNotTest()) == 1 {
+			return atomExprOf(i.NotTest(0))
+		}
+	case *pythonparser.NotTestContext:
+		if i.NOT() == nil {
+			return atomExprOf(i.Comparison())
+		}
+	case *pythonparser.ComparisonContext:
+		if len(i.AllCompOp()) == 0 {
+			return atomExprOf(i.Expr(0))
+		}
+	}
+	return nil
+}
+
+// simpleName get the name if the node is just a name, such as `a` but not `a.b`
+func simpleName(tree antlr.Tree) string {
+	if list, ok := tree.(*pythonparser.TestlistStarExprContext); ok {
+		elts := listElements(list)
+		if len(elts) != 1 || hasComma(list) {
+			return ""
+		}
+		tree = elts[0]
+	}
+	atomExpr := atomExprOf(tree)
+	if atomExpr == nil || atomExpr.AWAIT() != nil || len(atomExpr.AllTrailer()) > 0 {
+		return ""
+	}
+	return nameOfAtom(atomExpr.Atom())
+}
+
+func nameOfAtom(atom pythonparser.IAtomContext) string {
+	if name, ok := atom.(*pythonparser.NameAtomContext); ok {
+		return name.GetText()
+	}
+	return ""
+}
+
+// spanRange is the range from the start of one node to the stop of another,
+// such as `a.b` in the atom expression `a.b.c()`
+type spanRange struct {
+	start, stop antlr.ParserRuleContext
+}
+
+func (s *spanRange) GetStart() antlr.Token { return s.start.GetStart() }
+func (s *spanRange) GetStop() antlr.Token  { return s.stop.GetStop() }
+func (s *spanRange) GetText() string       { return "" }
+
+// ================================ expression ================================
+
+// buildValue build the node which may be one of several expression rules
+func (b *astbuilder) buildValue(tree antlr.Tree) ssa.Value {
+	switch i := tree.(type) {
+	case nil:
+		return nil
+	case *pythonparser.TestContext:
+		return b.VisitTest(i)
+	case *pythonparser.NamedExprTestContext:
+		return b.VisitNamedExprTest(i)
+	case *pythonparser.StarExprContext:
+		return b.VisitStarExpr(i)
+	case *pythonparser.YieldExprContext:
+		return b.VisitYieldExpr(i)
+	case *pythonparser.TestlistStarExprContext:
+		return b.VisitTestlistStarExpr(i)
+	case *pythonparser.TestlistContext:
+		return b.VisitTestlist(i)
+	case *pythonparser.ExprlistContext:
+		return b.buildTuple(i)
+	case *pythonparser.TestNoCondContext:
+		return b.VisitTestNoCond(i)
+	case *pythonparser.OrTestContext:
+		return b.VisitOrTest(i)
+	case pythonparser.IExprContext:
+		return b.VisitExpr(i)
+	}
+	text := ""
+	if node, ok := tree.(antlr.ParseTree); ok {
+		text = node.GetText()
+	}
+	b.NewError(ssa.Error, TAG, ExpressionNotSupport(text))
+	return b.EmitUndefined("")
+}
+
+func (b *astbuilder) buildValues(trees []antlr.Tree) []ssa.Value {
+	values := make([]ssa.Value, 0, len(trees))
+	for _, tree := range trees {
+		if value := b.buildValue(tree); !utils.IsNil(value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// buildTuple build `a, b` as tuple, the single element without comma is itself
+func (b *astbuilder) buildTuple(node antlr.ParserRuleContext) ssa.Value {
+	recoverRange := b.SetRange(node)
+	defer recoverRange()
+
+	elts := listElements(node)
+	if len(elts) == 1 && !hasComma(node) {
+		return b.buildValue(elts[0])
+	}
+	return b.buildSliceLiteral(b.buildValues(elts))
+}
+
+func (b *astbuilder) buildSliceLiteral(values []ssa.Value) ssa.Value {
+	obj := b.InterfaceAddFieldBuild(len(values),
+		func(i int) ssa.Value { return b.EmitConstInst(i) },
+		func(i int) ssa.Value { return values[i] },
+	)
+	if typ, ok := obj.GetType().(*ssa.ObjectType); ok {
+		typ.Kind = ssa.SliceTypeKind
+	}
+	return obj
+}
+
+func (b *astbuilder) VisitTestlistStarExpr(raw pythonparser.ITestlistStarExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	return b.buildTuple(raw)
+}
+
+func (b *astbuilder) VisitTestlist(raw pythonparser.ITestlistContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	return b.buildTuple(raw)
+}
+
+func (b *astbuilder) VisitYieldExpr(raw pythonparser.IYieldExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.YieldExprContext)
+	if i == nil {
+		return nil
+	}
+
+	if from := i.Test(); from != nil {
+		// `yield from iter`
+		return b.VisitTest(from)
+	}
+	if value := i.TestlistStarExpr(); value != nil {
+		return b.VisitTestlistStarExpr(value)
+	}
+	return b.EmitConstInstNil()
+}
+
+func (b *astbuilder) VisitNamedExprTest(raw pythonparser.INamedExprTestContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.NamedExprTestContext)
+	if i == nil {
+		return nil
+	}
+
+	value := b.VisitTest(i.Test())
+	if name := i.Name(); name != nil {
+		// `(name := value)`
+		b.assignName(name.GetText(), value)
+	}
+	return value
+}
+
+func (b *astbuilder) VisitTest(raw pythonparser.ITestContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.TestContext)
+	if i == nil {
+		return nil
+	}
+
+	if lambda := i.Lambdef(); lambda != nil {
+		return b.VisitLambdef(lambda)
+	}
+	if i.IF() != nil {
+		// `body if cond else orelse`
+		return b.handlerJumpExpression(
+			func() ssa.Value { return b.VisitOrTest(i.OrTest(1)) },
+			func() ssa.Value { return b.VisitOrTest(i.OrTest(0)) },
+			func() ssa.Value { return b.VisitTest(i.Test()) },
+		)
+	}
+	return b.VisitOrTest(i.OrTest(0))
+}
+
+func (b *astbuilder) VisitTestNoCond(raw pythonparser.ITestNoCondContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+
+	i, _ := raw.(*pythonparser.TestNoCondContext)
+	if i == nil {
+		return nil
+	}
+
+	if lambda := i.LambdefNoCond(); lambda != nil {
+		return b.VisitLambdefNoCond(lambda)
+	}
+	return b.VisitOrTest(i.OrTest())
+}
+
+// VisitOrTest build short-circuit `a or b`, the result is one of the operands
+func (b *astbuilder) VisitOrTest(raw pythonparser.IOrTestContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.OrTestContext)
+	if i == nil {
+		return nil
+	}
+
+	operands := i.AllAndTest()
+	ret := b.VisitAndTest(operands[0])
+	for _, operand := range operands[1:] {
+		left := ret
+		operand := operand
+		next := func() ssa.Value { return b.VisitAndTest(operand) }
+		self := func() ssa.Value { return left }
+		ret = b.handlerJumpExpression(self, self, next)
+	}
+	return ret
+}
+
+// VisitAndTest build short-circuit `a and b`, the result is one of the operands
+func (b *astbuilder) VisitAndTest(raw pythonparser.IAndTestContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.AndTestContext)
+	if i == nil {
+		return nil
+	}
+
+	operands := i.AllNotTest()
+	ret := b.VisitNotTest(operands[0])
+	for _, operand := range operands[1:] {
+		left := ret
+		operand := operand
+		next := func() ssa.Value { return b.VisitNotTest(operand) }
+		self := func() ssa.Value { return left }
+		ret = b.handlerJumpExpression(self, next, self)
+	}
+	return ret
+}
+
+func (b *astbuilder) VisitNotTest(raw pythonparser.INotTestContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.NotTestContext)
+	if i == nil {
+		return nil
+	}
+
+	if i.NOT() != nil {
+		return b.EmitUnOp(ssa.OpNot, b.VisitNotTest(i.NotTest()))
+	}
+	return b.VisitComparison(i.Comparison())
+}
+
+// VisitComparison build chained comparison `a < b < c` as `a < b and b < c`
+func (b *astbuilder) VisitComparison(raw pythonparser.IComparisonContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.ComparisonContext)
+	if i == nil {
+		return nil
+	}
+
+	left := b.VisitExpr(i.Expr(0))
+	var ret ssa.Value
+	for index, item := range i.AllCompOp() {
+		right := b.VisitExpr(i.Expr(index + 1))
+		// `not in` and `is not` are two tokens
+		var tokens []string
+		for _, child := range item.GetChildren() {
+			if t, ok := child.(antlr.TerminalNode); ok {
+				tokens = append(tokens, t.GetText())
+			}
+		}
+		op := strings.Join(tokens, " ")
+
+		var value ssa.Value
+		if op == "not in" {
+			value = b.EmitUnOp(ssa.OpNot, b.EmitBinOp(ssa.OpIn, left, right))
+		} else if opcode, ok := compareOpcode(op); ok {
+			value = b.EmitBinOp(opcode, left, right)
+		} else {
+			b.NewError(ssa.Error, TAG, BinaryOperatorNotSupport(op))
+			value = b.EmitUndefined("")
+		}
+		if ret == nil {
+			ret = value
+		} else {
+			ret = b.EmitBinOp(ssa.OpLogicAnd, ret, value)
+		}
+		left = right
+	}
+	if ret == nil {
+		return left
+	}
+	return ret
+}
+
+func (b *astbuilder) VisitStarExpr(raw pythonparser.IStarExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+
+	i, _ := raw.(*pythonparser.StarExprContext)
+	if i == nil {
+		return nil
+	}
+	return b.VisitExpr(i.Expr())
+}
+
+func (b *astbuilder) VisitExpr(raw pythonparser.IExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.PrimaryExprContext:
+		return b.VisitAtomExpr(i.AtomExpr())
+	case *pythonparser.PowerExprContext:
+		left := b.VisitExpr(i.Expr(0))
+		right := b.VisitExpr(i.Expr(1))
+		return b.EmitBinOp(ssa.OpPow, left, right)
+	case *pythonparser.UnaryExprContext:
+		operand := b.VisitExpr(i.Expr())
+		switch i.GetOp().GetTokenType() {
+		case pythonparser.PythonParserMINUS:
+			return b.EmitUnOp(ssa.OpNeg, operand)
+		case pythonparser.PythonParserADD:
+			return b.EmitUnOp(ssa.OpPlus, operand)
+		case pythonparser.PythonParserNOT_OP:
+			return b.EmitUnOp(ssa.OpBitwiseNot, operand)
+		}
+		b.NewError(ssa.Error, TAG, UnaryOperatorNotSupport(i.GetOp().GetText()))
+		return operand
+	case *pythonparser.BinaryExprContext:
+		left := b.VisitExpr(i.Expr(0))
+		right := b.VisitExpr(i.Expr(1))
+		op := i.GetOp().GetText()
+		opcode, ok := binaryOpcode(op)
+		if !ok {
+			b.NewError(ssa.Error, TAG, BinaryOperatorNotSupport(op))
+			return b.EmitUndefined("")
+		}
+		return b.EmitBinOp(opcode, left, right)
+	}
+	b.NewError(ssa.Error, TAG, ExpressionNotSupport(raw.GetText()))
+	return b.EmitUndefined("")
+}
+
+func (b *astbuilder) VisitAtomExpr(raw pythonparser.IAtomExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.AtomExprContext)
+	if i == nil {
+		return nil
+	}
+	// `await x` is the value of x
+	return b.buildAtomExpr(i, len(i.AllTrailer()))
+}
+
+// buildAtomExpr build the atom and the first n trailers of atom expression
+func (b *astbuilder) buildAtomExpr(raw *pythonparser.AtomExprContext, n int) ssa.Value {
+	atom := raw.Atom()
+	trailers := raw.AllTrailer()[:n]
+
+	var value ssa.Value
+	start := 0
+	// `Cls(...)` and `module.Cls(...)` create instance
+	for index := 0; index < len(trailers) && index < 2; index++ {
+		call, ok := trailers[index].(*pythonparser.CallTrailerContext)
+		if !ok {
+			continue
+		}
+		if class := b.classOf(atom, trailers[:index]); class != nil {
+			recoverRange := b.SetRange(&spanRange{start: atom, stop: call})
+			args, ellipsis := b.VisitArglist(call.Arglist())
+			value = b.newInstance(class, args, ellipsis)
+			recoverRange()
+			start = index + 1
+		}
+		break
+	}
+	if value == nil && len(trailers) > 0 {
+		// `module.member`
+		if lib, ok := b.modules[nameOfAtom(atom)]; ok {
+			if attr, ok := trailers[0].(*pythonparser.AttributeTrailerContext); ok {
+				if member := lib.GetExprotValue(attr.Name().GetText()); !utils.IsNil(member) {
+					value = member
+					start = 1
+				}
+			}
+		}
+	}
+	if value == nil {
+		value = b.VisitAtom(atom)
+	}
+
+	for index := start; index < len(trailers); index++ {
+		recoverRange := b.SetRange(&spanRange{start: atom, stop: trailers[index]})
+		switch trailer := trailers[index].(type) {
+		case *pythonparser.AttributeTrailerContext:
+			key := b.EmitConstInst(trailer.Name().GetText())
+			isCall := false
+			if index+1 < len(trailers) {
+				_, isCall = trailers[index+1].(*pythonparser.CallTrailerContext)
+			}
+			if typ := value.GetType(); isCall && typ != nil && typ.GetTypeKind() == ssa.ClassBluePrintTypeKind {
+				// `obj.method(...)`, the method of class need the object as first argument
+				value = b.ReadMemberCallMethodVariable(value, key)
+			} else {
+				value = b.ReadMemberCallVariable(value, key)
+			}
+		case *pythonparser.SubscriptTrailerContext:
+			value = b.buildSubscript(value, trailer.SubscriptList())
+		case *pythonparser.CallTrailerContext:
+			args, ellipsis := b.VisitArglist(trailer.Arglist())
+			c := b.NewCall(value, args)
+			c.IsEllipsis = ellipsis
+			value = b.EmitCall(c)
+		}
+		recoverRange()
+	}
+	return value
+}
+
+// classOf get the class blueprint of `Cls` or `module.Cls`
+func (b *astbuilder) classOf(atom pythonparser.IAtomContext, trailers []pythonparser.ITrailerContext) *ssa.ClassBluePrint {
+	name := nameOfAtom(atom)
+	if name == "" {
+		return nil
+	}
+	switch len(trailers) {
+	case 0:
+		return b.GetClassBluePrint(name)
+	case 1:
+		if attr, ok := trailers[0].(*pythonparser.AttributeTrailerContext); ok {
+			if lib, ok := b.modules[name]; ok {
+				return lib.GetClassBluePrint(attr.Name().GetText())
+			}
+		}
+	}
+	return nil
+}
+
+// buildDottedName read the value of `a.b.c`
+func (b *astbuilder) buildDottedName(names []pythonparser.INameContext) ssa.Value {
+	if len(names) == 0 {
+		return b.EmitUndefined("")
+	}
+	start := 1
+	var value ssa.Value
+	if lib, ok := b.modules[names[0].GetText()]; ok && len(names) > 1 {
+		if member := lib.GetExprotValue(names[1].GetText()); !utils.IsNil(member) {
+			value = member
+			start = 2
+		}
+	}
+	if value == nil {
+		value = b.ReadValue(names[0].GetText())
+	}
+	for _, name := range names[start:] {
+		value = b.ReadMemberCallVariable(value, b.EmitConstInst(name.GetText()))
+	}
+	return value
+}
+
+// VisitArglist build the arguments of call, keyword argument is passed by position, most data flow is kept
+func (b *astbuilder) VisitArglist(raw pythonparser.IArglistContext) ([]ssa.Value, bool) {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil, false
+	}
+
+	i, _ := raw.(*pythonparser.ArglistContext)
+	if i == nil {
+		return nil, false
+	}
+
+	var positional, keywords []ssa.Value
+	ellipsis := false
+	for _, item := range i.AllArgument() {
+		recoverRange := b.SetRange(item)
+		var value ssa.Value
+		isKeyword := false
+		switch arg := item.(type) {
+		case *pythonparser.PositionalArgumentContext:
+			if compFor := arg.CompFor(); compFor != nil {
+				// `f(x for x in y)`
+				value = b.buildComprehension(compFor, nil, func() ssa.Value { return b.VisitTest(arg.Test()) })
+			} else {
+				value = b.VisitTest(arg.Test())
+			}
+		case *pythonparser.NamedExprArgumentContext:
+			value = b.VisitTest(arg.Test())
+			b.assignName(arg.Name().GetText(), value)
+		case *pythonparser.StarArgumentContext:
+			ellipsis = true
+			value = b.VisitTest(arg.Test())
+		case *pythonparser.KeywordArgumentContext:
+			isKeyword = true
+			value = b.VisitTest(arg.Test())
+		case *pythonparser.KwArgumentContext:
+			isKeyword = true
+			value = b.VisitTest(arg.Test())
+		}
+		recoverRange()
+		if utils.IsNil(value) {
+			continue
+		}
+		if isKeyword {
+			keywords = append(keywords, value)
+		} else {
+			positional = append(positional, value)
+		}
+	}
+	return append(positional, keywords...), ellipsis
+}
+
+// buildSubscript read `obj[key]` or `obj[lower:upper:step]`
+func (b *astbuilder) buildSubscript(obj ssa.Value, raw pythonparser.ISubscriptListContext) ssa.Value {
+	if isSliceSubscript(raw) {
+		slice := raw.(*pythonparser.SubscriptListContext).Subscript(0).(*pythonparser.SliceSubscriptContext)
+		lower, upper, step := b.VisitSliceSubscript(slice)
+		return b.EmitMakeSlice(obj, lower, upper, step)
+	}
+	return b.ReadMemberCallVariable(obj, b.VisitSubscriptList(raw))
+}
+
+// isSliceSubscript check the subscript is `[lower:upper:step]`
+func isSliceSubscript(raw pythonparser.ISubscriptListContext) bool {
+	i, _ := raw.(*pythonparser.SubscriptListContext)
+	if i == nil || hasComma(i) {
+		return false
+	}
+	_, ok := i.Subscript(0).(*pythonparser.SliceSubscriptContext)
+	return ok
+}
+
+// VisitSubscriptList build the key of subscript, `a[1, 2]` take the tuple as key
+func (b *astbuilder) VisitSubscriptList(raw pythonparser.ISubscriptListContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.SubscriptListContext)
+	if i == nil {
+		return nil
+	}
+
+	subscripts := i.AllSubscript()
+	if len(subscripts) == 1 && !hasComma(i) {
+		return b.VisitSubscript(subscripts[0])
+	}
+	values := make([]ssa.Value, 0, len(subscripts))
+	for _, subscript := range subscripts {
+		if value := b.VisitSubscript(subscript); !utils.IsNil(value) {
+			values = append(values, value)
+		}
+	}
+	return b.buildSliceLiteral(values)
+}
+
+func (b *astbuilder) VisitSubscript(raw pythonparser.ISubscriptContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.IndexSubscriptContext:
+		return b.VisitTest(i.Test())
+	case *pythonparser.SliceSubscriptContext:
+		// slice out of subscript, such as `a[1:2, 3]`
+		lower, upper, step := b.VisitSliceSubscript(i)
+		return b.EmitMakeSlice(b.EmitUndefined(""), lower, upper, step)
+	case *pythonparser.StarSubscriptContext:
+		return b.VisitStarExpr(i.StarExpr())
+	}
+	return nil
+}
+
+// VisitSliceSubscript build the parts of `lower:upper:step`, the absent part is nil
+func (b *astbuilder) VisitSliceSubscript(raw *pythonparser.SliceSubscriptContext) (lower, upper, step ssa.Value) {
+	part := 0
+	for _, child := range raw.GetChildren() {
+		switch node := child.(type) {
+		case antlr.TerminalNode:
+			// COLON
+			part++
+		case *pythonparser.TestContext:
+			value := b.VisitTest(node)
+			switch part {
+			case 0:
+				lower = value
+			case 1:
+				upper = value
+			default:
+				step = value
+			}
+		}
+	}
+	return
+}
+
+// ================================ atom ================================
+
+func (b *astbuilder) VisitAtom(raw pythonparser.IAtomContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.NameAtomContext:
+		return b.ReadValue(i.GetText())
+	case *pythonparser.NumberAtomContext:
+		return b.buildNumber(i.GetText())
+	case *pythonparser.StringAtomContext:
+		return b.VisitStrings(i.AllSTRING())
+	case *pythonparser.NoneAtomContext:
+		return b.EmitConstInstNil()
+	case *pythonparser.TrueAtomContext:
+		return b.EmitConstInst(true)
+	case *pythonparser.FalseAtomContext:
+		return b.EmitConstInst(false)
+	case *pythonparser.EllipsisAtomContext:
+		return b.EmitConstInst("...")
+	case *pythonparser.ParenAtomContext:
+		if yield := i.YieldExpr(); yield != nil {
+			return b.VisitYieldExpr(yield)
+		}
+		if list, _ := i.TestlistComp().(*pythonparser.TestlistCompContext); list != nil {
+			return b.VisitTestlistComp(list, false)
+		}
+		// empty tuple
+		return b.buildSliceLiteral(nil)
+	case *pythonparser.ListAtomContext:
+		if list, _ := i.TestlistComp().(*pythonparser.TestlistCompContext); list != nil {
+			return b.VisitTestlistComp(list, true)
+		}
+		return b.buildSliceLiteral(nil)
+	case *pythonparser.DictOrSetAtomContext:
+		return b.VisitDictOrSetMaker(i.DictOrSetMaker())
+	}
+	b.NewError(ssa.Error, TAG, ExpressionNotSupport(raw.GetText()))
+	return b.EmitUndefined("")
+}
+
+// VisitTestlistComp build the content of `(...)` and `[...]`, parenthesized single
+// expression is itself, and the comprehension is generator or list comprehension
+func (b *astbuilder) VisitTestlistComp(raw *pythonparser.TestlistCompContext, isList bool) ssa.Value {
+	elts := listElements(raw)
+	if compFor := raw.CompFor(); compFor != nil {
+		elt := elts[0]
+		return b.buildComprehension(compFor, nil, func() ssa.Value { return b.buildValue(elt) })
+	}
+	if !isList && len(elts) == 1 && !hasComma(raw) {
+		return b.buildValue(elts[0])
+	}
+	return b.buildSliceLiteral(b.buildValues(elts))
+}
+
+func (b *astbuilder) VisitDictOrSetMaker(raw pythonparser.IDictOrSetMakerContext) ssa.Value {
+	switch i := raw.(type) {
+	case *pythonparser.SetMakerContext:
+		elts := listElements(i)
+		if compFor := i.CompFor(); compFor != nil {
+			elt := elts[0]
+			return b.buildComprehension(compFor, nil, func() ssa.Value { return b.buildValue(elt) })
+		}
+		return b.buildSliceLiteral(b.buildValues(elts))
+	case *pythonparser.DictMakerContext:
+		items := i.AllDictItem()
+		if compFor := i.CompFor(); compFor != nil {
+			item, _ := items[0].(*pythonparser.DictItemContext)
+			return b.buildComprehension(compFor,
+				func() ssa.Value { return b.VisitTest(item.Test(0)) },
+				func() ssa.Value { return b.VisitTest(item.Test(1)) },
+			)
+		}
+		return b.buildDict(items)
+	}
+	// `{}` is empty dict
+	return b.buildDict(nil)
+}
+
+func (b *astbuilder) buildDict(items []pythonparser.IDictItemContext) ssa.Value {
+	keys := make([]ssa.Value, 0, len(items))
+	values := make([]ssa.Value, 0, len(items))
+	for _, raw := range items {
+		item, _ := raw.(*pythonparser.DictItemContext)
+		if item == nil {
+			continue
+		}
+		if item.POWER() != nil {
+			// `{**other}`, the items of other is unknown
+			b.VisitExpr(item.Expr())
+			continue
+		}
+		key := b.VisitTest(item.Test(0))
+		value := b.VisitTest(item.Test(1))
+		if utils.IsNil(key) || utils.IsNil(value) {
+			continue
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+	obj := b.InterfaceAddFieldBuild(len(values),
+		func(i int) ssa.Value { return keys[i] },
+		func(i int) ssa.Value { return values[i] },
+	)
+	if typ, ok := obj.GetType().(*ssa.ObjectType); ok {
+		typ.Kind = ssa.MapTypeKind
+	}
+	return obj
+}
+
+// pyComprehension is one `for target in iter if cond` of comprehension
+type pyComprehension struct {
+	target pythonparser.IExprlistContext
+	iter   pythonparser.IOrTestContext
+	ifs    []pythonparser.ITestNoCondContext
+}
+
+// comprehensions flatten `for a in x if c for b in y` to generators
+func comprehensions(raw pythonparser.ICompForContext) []*pyComprehension {
+	var ret []*pyComprehension
+	for raw != nil {
+		compFor, _ := raw.(*pythonparser.CompForContext)
+		if compFor == nil {
+			break
+		}
+		gen := &pyComprehension{target: compFor.Exprlist(), iter: compFor.OrTest()}
+		ret = append(ret, gen)
+		raw = nil
+		for iter := compFor.CompIter(); iter != nil; {
+			compIter, _ := iter.(*pythonparser.CompIterContext)
+			if compIter == nil {
+				break
+			}
+			if next := compIter.CompFor(); next != nil {
+				raw = next
+				break
+			}
+			compIf, _ := compIter.CompIf().(*pythonparser.CompIfContext)
+			if compIf == nil {
+				break
+			}
+			gen.ifs = append(gen.ifs, compIf.TestNoCond())
+			iter = compIf.CompIter()
+		}
+	}
+	return ret
+}
+
+/*
+buildComprehension build list/set/dict comprehension and generator expression
+
+	[elt for target in iter if cond]
+
+is same as
+
+	result = []
+	for target in iter:
+		if cond:
+			result[key] = elt
+*/
+func (b *astbuilder) buildComprehension(compFor pythonparser.ICompForContext, key, elt func() ssa.Value) ssa.Value {
+	generators := comprehensions(compFor)
+	result := b.EmitEmptyContainer()
+	var build func(i int)
+	build = func(i int) {
+		if i >= len(generators) {
+			value := elt()
+			var k ssa.Value
+			if key != nil {
+				k = key()
+			} else {
+				k = b.EmitUndefined("")
+			}
+			variable := b.CreateMemberCallVariable(result, k)
+			b.AssignVariable(variable, value)
+			return
+		}
+
+		gen := generators[i]
+		loop := b.CreateLoopBuilder()
+		var iter ssa.Value
+		loop.SetFirst(func() []ssa.Value {
+			iter = b.VisitOrTest(gen.iter)
+			return []ssa.Value{iter}
+		})
+		loop.SetCondition(func() ssa.Value {
+			item, field, ok := b.EmitNext(iter, true)
+			ssa.DeleteInst(field)
+			b.assignTarget(gen.target, item)
+			return ok
+		})
+		loop.SetBody(func() {
+			if len(gen.ifs) == 0 {
+				build(i + 1)
+				return
+			}
+			ifb := b.CreateIfBuilder()
+			ifb.AppendItem(func() ssa.Value {
+				var cond ssa.Value
+				for _, test := range gen.ifs {
+					value := b.VisitTestNoCond(test)
+					if cond == nil {
+						cond = value
+					} else {
+						cond = b.EmitBinOp(ssa.OpLogicAnd, cond, value)
+					}
+				}
+				return cond
+			}, func() {
+				build(i + 1)
+			})
+			ifb.Build()
+		})
+		loop.Finish()
+	}
+	build(0)
+	return result
+}
+
+/*
+handlerJumpExpression build conditional expression with phi
+
+	if cond:
+		id = trueExpr
+	else:
+		id = falseExpr
+	id = phi[trueExpr, falseExpr]
+*/
+func (b *astbuilder) handlerJumpExpression(cond, trueExpr, falseExpr func() ssa.Value) ssa.Value {
+	id := uuid.NewString()
+	variable := b.CreateVariable(id)
+	b.AssignVariable(variable, b.EmitValueOnlyDeclare(id))
+	ifb := b.CreateIfBuilder()
+	ifb.AppendItem(
+		cond,
+		func() {
+			variable := b.CreateVariable(id)
+			b.AssignVariable(variable, trueExpr())
+		},
+	)
+	ifb.SetElse(func() {
+		variable := b.CreateVariable(id)
+		b.AssignVariable(variable, falseExpr())
+	})
+	ifb.Build()
+	return b.ReadValue(id)
+}
@@ -0,0 +1,351 @@
+package python2ssa
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// pyParam is the parameter of function or lambda
+type pyParam struct {
+	name string
+	node antlr.ParserRuleContext
+	// def is the default value
+	def pythonparser.ITestContext
+	// isVararg means `*args`
+	isVararg bool
+}
+
+// typedParams collect the parameters of `def`, the bare `*` and `/` markers are skipped
+func typedParams(raw pythonparser.ITypedArgsListContext) []*pyParam {
+	i, _ := raw.(*pythonparser.TypedArgsListContext)
+	if i == nil {
+		return nil
+	}
+
+	var params []*pyParam
+	for _, item := range i.AllTypedArg() {
+		switch arg := item.(type) {
+		case *pythonparser.NamedParameterContext:
+			param := &pyParam{name: arg.Name().GetText(), node: arg}
+			if arg.ASSIGN() != nil {
+				param.def = arg.Test(len(arg.AllTest()) - 1)
+			}
+			params = append(params, param)
+		case *pythonparser.VarParameterContext:
+			if name := arg.Name(); name != nil {
+				params = append(params, &pyParam{name: name.GetText(), node: arg, isVararg: true})
+			}
+		case *pythonparser.KwParameterContext:
+			params = append(params, &pyParam{name: arg.Name().GetText(), node: arg})
+		}
+	}
+	return params
+}
+
+// lambdaParams collect the parameters of `lambda`, the bare `*` and `/` markers are skipped
+func lambdaParams(raw pythonparser.IVarArgsListContext) []*pyParam {
+	i, _ := raw.(*pythonparser.VarArgsListContext)
+	if i == nil {
+		return nil
+	}
+
+	var params []*pyParam
+	for _, item := range i.AllVarArg() {
+		switch arg := item.(type) {
+		case *pythonparser.LambdaNamedParameterContext:
+			params = append(params, &pyParam{name: arg.Name().GetText(), node: arg, def: arg.Test()})
+		case *pythonparser.LambdaVarParameterContext:
+			if name := arg.Name(); name != nil {
+				params = append(params, &pyParam{name: name.GetText(), node: arg, isVararg: true})
+			}
+		case *pythonparser.LambdaKwParameterContext:
+			params = append(params, &pyParam{name: arg.Name().GetText(), node: arg})
+		}
+	}
+	return params
+}
+
+func (b *astbuilder) VisitFuncDef(raw pythonparser.IFuncDefContext, decorators []pythonparser.IDecoratorContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.FuncDefContext)
+	if i == nil {
+		return
+	}
+
+	// bind the name first, so the function can call itself
+	name := i.Name().GetText()
+	newFunc := b.NewFunc(name)
+	b.assignName(name, newFunc)
+
+	b.buildFunctionBody(newFunc, typedParams(i.TypedArgsList()), i.Block(), nil, func() {
+		b.VisitBlock(i.Block())
+	})
+
+	if value := b.applyDecorators(decorators, newFunc); value != ssa.Value(newFunc) {
+		b.assignName(name, value)
+	}
+}
+
+// applyDecorators call decorators from bottom to top: `@a @b def f` is `f = a(b(f))`
+func (b *astbuilder) applyDecorators(decorators []pythonparser.IDecoratorContext, value ssa.Value) ssa.Value {
+	for index := len(decorators) - 1; index >= 0; index-- {
+		d, _ := decorators[index].(*pythonparser.DecoratorContext)
+		if d == nil {
+			continue
+		}
+		recoverRange := b.SetRange(d)
+		decorator := b.VisitNamedExprTest(d.NamedExprTest())
+		if !utils.IsNil(decorator) {
+			value = b.EmitCall(b.NewCall(decorator, []ssa.Value{value}))
+		}
+		recoverRange()
+	}
+	return value
+}
+
+// buildFunctionBody build parameters and body for function, if the self is not nil,
+// the first parameter is the instance (or class for classmethod) of the class.
+func (b *astbuilder) buildFunctionBody(newFunc *ssa.Function, params []*pyParam, body antlr.Tree, self ssa.Type, build func()) {
+	// default value is evaluated when the function is defined
+	defaults := make(map[*pyParam]ssa.Value)
+	for _, param := range params {
+		if param.def != nil {
+			defaults[param] = b.VisitTest(param.def)
+		}
+	}
+
+	b.FunctionBuilder = b.PushFunction(newFunc)
+	{
+		scope := b.pushScope(body, params, false)
+
+		names := make(map[string]struct{})
+		for index, param := range params {
+			recoverRange := b.SetRange(param.node)
+			names[param.name] = struct{}{}
+			p := b.NewParam(param.name)
+			if self != nil && index == 0 {
+				p.SetType(self)
+			}
+			if value, ok := defaults[param]; ok && !utils.IsNil(value) {
+				p.SetDefault(value)
+			}
+			if param.isVararg && index == len(params)-1 {
+				// `*args` without keyword-only parameters after it
+				b.HandlerEllipsis()
+			}
+			recoverRange()
+		}
+		b.declareLocals(scope, names)
+
+		build()
+
+		b.popScope()
+		b.Finish()
+	}
+	b.FunctionBuilder = b.PopFunction()
+}
+
+func (b *astbuilder) VisitLambdef(raw pythonparser.ILambdefContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+
+	i, _ := raw.(*pythonparser.LambdefContext)
+	if i == nil {
+		return nil
+	}
+	return b.buildLambda(lambdaParams(i.VarArgsList()), i.Test(), func() ssa.Value {
+		return b.VisitTest(i.Test())
+	})
+}
+
+func (b *astbuilder) VisitLambdefNoCond(raw pythonparser.ILambdefNoCondContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+
+	i, _ := raw.(*pythonparser.LambdefNoCondContext)
+	if i == nil {
+		return nil
+	}
+	return b.buildLambda(lambdaParams(i.VarArgsList()), i.TestNoCond(), func() ssa.Value {
+		return b.VisitTestNoCond(i.TestNoCond())
+	})
+}
+
+func (b *astbuilder) buildLambda(params []*pyParam, body antlr.ParserRuleContext, build func() ssa.Value) ssa.Value {
+	newFunc := b.NewFunc("")
+	b.buildFunctionBody(newFunc, params, nil, nil, func() {
+		recoverRange := b.SetRange(body)
+		b.EmitReturn([]ssa.Value{build()})
+		recoverRange()
+	})
+	return newFunc
+}
+
+// ================================ class ================================
+
+func (b *astbuilder) VisitClassDef(raw pythonparser.IClassDefContext, decorators []pythonparser.IDecoratorContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.ClassDefContext)
+	if i == nil {
+		return
+	}
+
+	class := b.CreateClassBluePrint(i.Name().GetText())
+	if args, _ := i.Arglist().(*pythonparser.ArglistContext); args != nil {
+		for _, item := range args.AllArgument() {
+			recoverRange := b.SetRange(item)
+			switch arg := item.(type) {
+			case *pythonparser.PositionalArgumentContext:
+				if parent := b.getClassBluePrint(arg.Test()); parent != nil && arg.CompFor() == nil {
+					class.AddParentClass(parent)
+				} else {
+					b.VisitTest(arg.Test())
+				}
+			case *pythonparser.KeywordArgumentContext:
+				// `metaclass=...`
+				b.VisitTest(arg.Test())
+			}
+			recoverRange()
+		}
+	}
+	container := class.GetClassContainer()
+
+	for _, item := range blockItems(i.Block()) {
+		recoverRange := b.SetRange(item)
+		switch stmt := item.(type) {
+		case *pythonparser.DefinitionStatementContext:
+			if def, _ := stmt.FuncDef().(*pythonparser.FuncDefContext); def != nil {
+				b.buildMethod(class, def, stmt.AllDecorator())
+			} else {
+				b.VisitCompoundStatement(stmt)
+			}
+		case *pythonparser.ExpressionStatementContext:
+			b.buildClassAttribute(class, container, stmt)
+		case pythonparser.ISimpleStatementContext:
+			b.VisitSimpleStatement(stmt)
+		case pythonparser.ICompoundStatementContext:
+			b.VisitCompoundStatement(stmt)
+		}
+		recoverRange()
+	}
+
+	for _, raw := range decorators {
+		if d, _ := raw.(*pythonparser.DecoratorContext); d != nil {
+			b.VisitNamedExprTest(d.NamedExprTest())
+		}
+	}
+}
+
+// buildClassAttribute handle the assignment in class body, the name target is class attribute,
+// which can be accessed by both class and instance
+func (b *astbuilder) buildClassAttribute(class *ssa.ClassBluePrint, container ssa.Value, stmt *pythonparser.ExpressionStatementContext) {
+	switch assign := stmt.AssignPart().(type) {
+	case *pythonparser.NormalAssignContext:
+		targets, valueNode := assignParts(stmt, assign)
+		value := b.buildValue(valueNode)
+		for _, target := range targets {
+			if name := simpleName(target); name != "" {
+				b.addClassAttribute(class, container, name, value)
+			} else {
+				b.assignTarget(target, value)
+			}
+		}
+	case *pythonparser.AnnotatedAssignContext:
+		name := simpleName(stmt.TestlistStarExpr())
+		if name == "" || assign.ASSIGN() == nil {
+			return
+		}
+		var value ssa.Value
+		if yield := assign.YieldExpr(); yield != nil {
+			value = b.VisitYieldExpr(yield)
+		} else {
+			value = b.VisitTestlistStarExpr(assign.TestlistStarExpr())
+		}
+		b.addClassAttribute(class, container, name, value)
+	default:
+		b.VisitExpressionStatement(stmt)
+	}
+}
+
+func (b *astbuilder) addClassAttribute(class *ssa.ClassBluePrint, container ssa.Value, name string, value ssa.Value) {
+	if utils.IsNil(value) {
+		return
+	}
+	class.AddNormalMember(name, value)
+	if !utils.IsNil(container) {
+		variable := b.CreateMemberCallVariable(container, b.EmitConstInst(name))
+		b.AssignVariable(variable, value)
+	}
+}
+
+func (b *astbuilder) buildMethod(class *ssa.ClassBluePrint, def *pythonparser.FuncDefContext, decorators []pythonparser.IDecoratorContext) {
+	isStatic, isClassMethod := false, false
+	for _, raw := range decorators {
+		d, _ := raw.(*pythonparser.DecoratorContext)
+		if d == nil {
+			continue
+		}
+		switch simpleName(d.NamedExprTest()) {
+		case "staticmethod":
+			isStatic = true
+		case "classmethod":
+			isClassMethod = true
+		}
+	}
+
+	name := def.Name().GetText()
+	newFunc := b.NewFunc(name)
+	var self ssa.Type = class
+	if isStatic {
+		self = nil
+	}
+	b.buildFunctionBody(newFunc, typedParams(def.TypedArgsList()), def.Block(), self, func() {
+		b.VisitBlock(def.Block())
+	})
+
+	switch {
+	case name == "__init__":
+		class.Constructor = newFunc
+	case isStatic || isClassMethod:
+		class.AddStaticMethod(name, newFunc)
+		if container := class.GetClassContainer(); !utils.IsNil(container) {
+			variable := b.CreateMemberCallVariable(container, b.EmitConstInst(name))
+			b.AssignVariable(variable, newFunc)
+		}
+	default:
+		class.AddMethod(name, newFunc)
+	}
+}
+
+// getClassBluePrint get the class blueprint of expression `Cls` or `module.Cls`
+func (b *astbuilder) getClassBluePrint(tree antlr.Tree) *ssa.ClassBluePrint {
+	atomExpr := atomExprOf(tree)
+	if atomExpr == nil || atomExpr.AWAIT() != nil {
+		return nil
+	}
+	return b.classOf(atomExpr.Atom(), atomExpr.AllTrailer())
+}
+
+// newInstance create object of class and call the constructor `__init__`
+func (b *astbuilder) newInstance(class *ssa.ClassBluePrint, args []ssa.Value, ellipsis bool) ssa.Value {
+	obj := b.EmitMakeWithoutType(nil, nil)
+	obj.SetType(class)
+	constructor := class.GetConstructOrDestruct("constructor")
+	if utils.IsNil(constructor) {
+		return obj
+	}
+	c := b.NewCall(constructor, append([]ssa.Value{obj}, args...))
+	c.IsEllipsis = ellipsis
+	b.EmitCall(c)
+	return obj
+}
@@ -0,0 +1,479 @@
+package python2ssa
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/antlr4util"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *astbuilder) buildNumber(literal string) ssa.Value {
+	text := strings.ReplaceAll(literal, "_", "")
+	lower := strings.ToLower(text)
+	isInt := true
+	switch {
+	case strings.HasSuffix(lower, "j"):
+		// complex number, keep the literal
+		return b.EmitConstInst(text)
+	case strings.HasPrefix(lower, "0x"), strings.HasPrefix(lower, "0o"), strings.HasPrefix(lower, "0b"):
+	case strings.ContainsAny(lower, ".e"):
+		isInt = false
+	}
+	if isInt {
+		if i, err := strconv.ParseInt(text, 0, 64); err == nil {
+			return b.EmitConstInst(i)
+		}
+		// big integer, keep the literal
+		return b.EmitConstInst(text)
+	}
+	if f, err := strconv.ParseFloat(text, 64); err == nil {
+		return b.EmitConstInst(f)
+	}
+	return b.EmitConstInst(text)
+}
+
+// VisitStrings build adjacent string literals (implicit concatenation), the formatted
+// values of f-string are concatenated with the literal parts, so they flow to the string
+func (b *astbuilder) VisitStrings(tokens []antlr.TerminalNode) ssa.Value {
+	var values []ssa.Value
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() == 0 {
+			return
+		}
+		values = append(values, b.EmitConstInst(literal.String()))
+		literal.Reset()
+	}
+
+	for _, token := range tokens {
+		prefix, head, body := splitStringToken(token.GetText())
+		if !prefix.format {
+			if !prefix.raw {
+				// []byte is unhashable in const pool, keep bytes literal as string like other frontends
+				body = decodeEscape(body, prefix.bytes)
+			}
+			literal.WriteString(body)
+			continue
+		}
+
+		symbol := token.GetSymbol()
+		line, column := advancePosition(symbol.GetLine(), symbol.GetColumn(), head)
+		parts, err := splitFString(body, prefix.raw, line, column)
+		if err != nil {
+			b.NewError(ssa.Error, TAG, FormattedStringError(err.Error()))
+		}
+		for _, part := range parts {
+			if !part.isField {
+				literal.WriteString(part.literal)
+				continue
+			}
+			flush()
+			if value := b.buildFStringField(part); !utils.IsNil(value) {
+				values = append(values, value)
+			}
+		}
+	}
+	flush()
+
+	if len(values) == 0 {
+		return b.EmitConstInst("")
+	}
+	ret := values[0]
+	for _, value := range values[1:] {
+		ret = b.EmitBinOp(ssa.OpAdd, ret, value)
+	}
+	return ret
+}
+
+// buildFStringField build the expression of replacement field, the expression is parsed
+// with padding before it, so the position of tokens is same as in the source file.
+func (b *astbuilder) buildFStringField(part *fstringPart) ssa.Value {
+	for _, spec := range part.spec {
+		if spec.isField {
+			b.buildFStringField(spec)
+		}
+	}
+
+	padding := part.column - 1
+	if padding < 0 {
+		padding = 0
+	}
+	src := strings.Repeat("\n", part.line-1) + strings.Repeat(" ", padding) + "(" + part.field + ")"
+
+	errListener := antlr4util.NewErrorListener()
+	lexer := pythonparser.NewPythonLexer(antlr.NewInputStream(src))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errListener)
+	parser := pythonparser.NewPythonParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errListener)
+	atom, _ := parser.Atom().(*pythonparser.ParenAtomContext)
+
+	switch parser.GetCurrentToken().GetTokenType() {
+	case pythonparser.PythonParserNEWLINE, antlr.TokenEOF:
+	default:
+		atom = nil
+	}
+	if atom == nil || len(errListener.GetErrors()) > 0 {
+		b.NewError(ssa.Error, TAG, FormattedStringError("invalid expression: "+part.field))
+		return b.EmitUndefined(strings.TrimSpace(part.field))
+	}
+	if yield := atom.YieldExpr(); yield != nil {
+		return b.VisitYieldExpr(yield)
+	}
+	if list, _ := atom.TestlistComp().(*pythonparser.TestlistCompContext); list != nil {
+		return b.VisitTestlistComp(list, false)
+	}
+	b.NewError(ssa.Error, TAG, FormattedStringError("empty expression"))
+	return b.EmitUndefined("")
+}
+
+// VisitLiteralPattern build the literal in match pattern, such as `-1`, `1 + 2j` and `"a" "b"`
+func (b *astbuilder) VisitLiteralPattern(raw pythonparser.ILiteralPatternContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.LiteralPatternContext)
+	if i == nil {
+		return nil
+	}
+
+	switch {
+	case i.NONE() != nil:
+		return b.EmitConstInstNil()
+	case i.TRUE() != nil:
+		return b.EmitConstInst(true)
+	case i.FALSE() != nil:
+		return b.EmitConstInst(false)
+	case len(i.AllSTRING()) > 0:
+		return b.VisitStrings(i.AllSTRING())
+	}
+
+	var value ssa.Value
+	negative := false
+	var opcode ssa.BinaryOpcode = ssa.OpAdd
+	for _, child := range i.GetChildren() {
+		token, ok := child.(antlr.TerminalNode)
+		if !ok {
+			continue
+		}
+		switch token.GetSymbol().GetTokenType() {
+		case pythonparser.PythonParserMINUS:
+			if value == nil {
+				negative = true
+			} else {
+				opcode = ssa.OpSub
+			}
+		case pythonparser.PythonParserADD:
+			opcode = ssa.OpAdd
+		case pythonparser.PythonParserNUMBER:
+			number := b.buildNumber(token.GetText())
+			if value == nil {
+				if negative {
+					number = b.EmitUnOp(ssa.OpNeg, number)
+				}
+				value = number
+			} else {
+				value = b.EmitBinOp(opcode, value, number)
+			}
+		}
+	}
+	if value == nil {
+		return b.EmitUndefined("")
+	}
+	return value
+}
+
+// ================================ string literal ================================
+
+type stringPrefix struct {
+	raw, bytes, format bool
+}
+
+// splitStringToken split string token to prefix, head (prefix and quote) and body
+func splitStringToken(s string) (stringPrefix, string, string) {
+	var prefix stringPrefix
+	i := 0
+	for ; i < len(s) && s[i] != '\'' && s[i] != '"'; i++ {
+		switch s[i] {
+		case 'r', 'R':
+			prefix.raw = true
+		case 'b', 'B':
+			prefix.bytes = true
+		case 'f', 'F':
+			prefix.format = true
+		}
+	}
+	body := s[i:]
+	quote := "'"
+	if strings.HasPrefix(body, `"""`) || strings.HasPrefix(body, `'''`) {
+		quote = body[:3]
+	} else if len(body) > 0 {
+		quote = body[:1]
+	}
+	body = strings.TrimPrefix(body, quote)
+	body = strings.TrimSuffix(body, quote)
+	return prefix, s[:i] + quote, body
+}
+
+// advancePosition returns the position after text started at line and column
+func advancePosition(line, column int, text string) (int, int) {
+	for _, r := range text {
+		if r == '\n' {
+			line++
+			column = 0
+		} else {
+			column++
+		}
+	}
+	return line, column
+}
+
+// fstringPart is the literal text or the replacement field of f-string
+type fstringPart struct {
+	isField bool
+	literal string
+	// field is the expression in braces, line and column is the position of it
+	field        string
+	line, column int
+	// spec is the format spec after ':', it may contain nested fields
+	spec []*fstringPart
+}
+
+// splitFString split f-string body to literal parts and replacement fields
+func splitFString(body string, raw bool, line, column int) ([]*fstringPart, error) {
+	var ret []*fstringPart
+	runes := []rune(body)
+	var literal strings.Builder
+	flush := func() {
+		if literal.Len() == 0 {
+			return
+		}
+		value := literal.String()
+		if !raw {
+			value = decodeEscape(value, false)
+		}
+		ret = append(ret, &fstringPart{literal: value})
+		literal.Reset()
+	}
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+		switch {
+		case (r == '{' || r == '}') && i+1 < len(runes) && runes[i+1] == r:
+			// `{{` and `}}` are escaped braces
+			literal.WriteRune(r)
+			column += 2
+			i++
+		case r == '{':
+			flush()
+			end := matchFStringBrace(runes, i)
+			if end < 0 {
+				return ret, utils.Errorf("expecting '}' at %d:%d", line, column)
+			}
+			fieldLine, fieldColumn := advancePosition(line, column, "{")
+			field, err := splitFStringField(runes[i+1:end], raw, fieldLine, fieldColumn)
+			if field != nil {
+				ret = append(ret, field)
+			}
+			if err != nil {
+				return ret, err
+			}
+			line, column = advancePosition(line, column, string(runes[i:end+1]))
+			i = end
+		default:
+			literal.WriteRune(r)
+			line, column = advancePosition(line, column, string(r))
+		}
+	}
+	flush()
+	return ret, nil
+}
+
+// matchFStringBrace find the '}' matched with '{' at index start
+func matchFStringBrace(runes []rune, start int) int {
+	depth := 0
+	var quote rune
+	for i := start; i < len(runes); i++ {
+		r := runes[i]
+		if quote != 0 {
+			if r == '\\' {
+				i++
+				continue
+			}
+			if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '\'', '"':
+			quote = r
+		case '{', '[', '(':
+			depth++
+		case '}', ']', ')':
+			depth--
+			if depth == 0 {
+				if r == '}' {
+					return i
+				}
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// splitFStringField split `expr[=][!conv][:spec]` inside braces
+func splitFStringField(inner []rune, raw bool, line, column int) (*fstringPart, error) {
+	// find the end of expression part at depth 0
+	depth := 0
+	var quote rune
+	exprEnd := len(inner)
+	specStart := -1
+	for i := 0; i < len(inner); i++ {
+		r := inner[i]
+		if quote != 0 {
+			if r == '\\' {
+				i++
+			} else if r == quote {
+				quote = 0
+			}
+			continue
+		}
+		switch r {
+		case '\'', '"':
+			quote = r
+		case '{', '[', '(':
+			depth++
+		case '}', ']', ')':
+			depth--
+		case '!':
+			if depth == 0 && i+1 < len(inner) && inner[i+1] != '=' {
+				exprEnd = i
+				if i+2 < len(inner) && inner[i+2] == ':' {
+					specStart = i + 3
+				}
+				i = len(inner)
+			}
+		case ':':
+			if depth == 0 {
+				exprEnd = i
+				specStart = i + 1
+				i = len(inner)
+			}
+		}
+	}
+
+	exprText := string(inner[:exprEnd])
+	// self documenting expression `f"{x=}"`
+	trimmed := strings.TrimRight(exprText, " ")
+	if strings.HasSuffix(trimmed, "=") && !strings.HasSuffix(trimmed, "==") && !strings.HasSuffix(trimmed, "!=") &&
+		!strings.HasSuffix(trimmed, "<=") && !strings.HasSuffix(trimmed, ">=") {
+		exprText = strings.TrimSuffix(trimmed, "=")
+	}
+
+	field := &fstringPart{isField: true, field: exprText, line: line, column: column}
+	if specStart >= 0 && specStart <= len(inner) {
+		specLine, specColumn := advancePosition(line, column, string(inner[:specStart]))
+		spec, err := splitFString(string(inner[specStart:]), raw, specLine, specColumn)
+		field.spec = spec
+		if err != nil {
+			return field, err
+		}
+	}
+	return field, nil
+}
+
+// decodeEscape decode python escape sequence in non-raw string literal
+func decodeEscape(s string, isBytes bool) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c != '\\' || i+1 >= len(s) {
+			buf.WriteByte(c)
+			continue
+		}
+		i++
+		switch e := s[i]; e {
+		case '\n':
+			// line continuation
+		case '\r':
+			// line continuation with CRLF
+			if i+1 < len(s) && s[i+1] == '\n' {
+				i++
+			}
+		case '\\', '\'', '"':
+			buf.WriteByte(e)
+		case 'a':
+			buf.WriteByte('\a')
+		case 'b':
+			buf.WriteByte('\b')
+		case 'f':
+			buf.WriteByte('\f')
+		case 'n':
+			buf.WriteByte('\n')
+		case 'r':
+			buf.WriteByte('\r')
+		case 't':
+			buf.WriteByte('\t')
+		case 'v':
+			buf.WriteByte('\v')
+		case '0', '1', '2', '3', '4', '5', '6', '7':
+			j := i
+			for j < len(s) && j < i+3 && s[j] >= '0' && s[j] <= '7' {
+				j++
+			}
+			n, _ := strconv.ParseUint(s[i:j], 8, 32)
+			writeCode(&buf, rune(n), isBytes)
+			i = j - 1
+		case 'x':
+			if i+2 < len(s) {
+				if n, err := strconv.ParseUint(s[i+1:i+3], 16, 32); err == nil {
+					writeCode(&buf, rune(n), isBytes)
+					i += 2
+					continue
+				}
+			}
+			buf.WriteString(`\x`)
+		case 'u', 'U':
+			size := 4
+			if e == 'U' {
+				size = 8
+			}
+			if !isBytes && i+size < len(s) {
+				if n, err := strconv.ParseUint(s[i+1:i+1+size], 16, 32); err == nil && utf8.ValidRune(rune(n)) {
+					buf.WriteRune(rune(n))
+					i += size
+					continue
+				}
+			}
+			buf.WriteByte('\\')
+			buf.WriteByte(e)
+		default:
+			// unknown escape (and \N{...}) keep as it is
+			buf.WriteByte('\\')
+			buf.WriteByte(e)
+		}
+	}
+	return buf.String()
+}
+
+func writeCode(buf *strings.Builder, r rune, isBytes bool) {
+	if isBytes {
+		buf.WriteByte(byte(r))
+		return
+	}
+	buf.WriteRune(r)
+}
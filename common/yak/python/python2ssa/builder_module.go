@@ -0,0 +1,523 @@
+package python2ssa
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils/memedit"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// entry point
+func (b *astbuilder) VisitFileInput(raw pythonparser.IFileInputContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.FileInputContext)
+	if i == nil {
+		return
+	}
+
+	// every python file is a module, the module is built in library `@init` function,
+	// so other files can import the names exported by this module.
+	name, path := b.moduleName()
+	var lib *ssa.Program
+	if name != "" {
+		prog := b.GetProgram()
+		var skip bool
+		lib, skip = prog.GetLibrary(name)
+		if skip {
+			return
+		}
+		if lib == nil {
+			lib = prog.NewLibrary(name, path)
+		}
+		lib.PushEditor(prog.GetCurrentEditor())
+
+		init := lib.GetAndCreateFunction(name, "@init")
+		init.SetType(ssa.NewFunctionType("", []ssa.Type{ssa.CreateAnyType()}, ssa.CreateAnyType(), false))
+		builder := lib.GetAndCreateFunctionBuilder(name, "@init")
+		if builder != nil {
+			builder.SetBuildSupport(b.FunctionBuilder)
+			builder.SetEditor(prog.GetApplication().GetCurrentEditor())
+			currentBuilder := b.FunctionBuilder
+			b.FunctionBuilder = builder
+			defer func() {
+				for _, e := range builder.GetProgram().GetErrors() {
+					currentBuilder.GetProgram().AddError(e)
+				}
+				b.FunctionBuilder = currentBuilder
+			}()
+		}
+	}
+
+	scope := b.pushScope(i, nil, true)
+	b.declareLocals(scope, nil)
+	b.VisitStatements(i.AllStatement())
+	b.popScope()
+
+	if lib == nil {
+		return
+	}
+	for name := range scope.bound {
+		if value := b.PeekValue(name); value != nil {
+			lib.SetExprotValue(name, value)
+		}
+	}
+	b.Finish()
+}
+
+// moduleName get dotted module name of current file, `pkg/__init__.py` is `pkg`
+func (b *astbuilder) moduleName() (string, []string) {
+	editor := b.GetEditor()
+	if editor == nil || editor.GetFilename() == "" {
+		return "", nil
+	}
+	loader := b.GetProgram().Loader
+	fs := loader.GetFilesysFileSystem()
+	sep := string(fs.GetSeparators())
+
+	filename := editor.GetFilename()
+	if base := loader.GetBasePath(); base != "" && base != "." {
+		filename = strings.TrimPrefix(filename, base)
+	}
+	filename = strings.TrimPrefix(filename, "."+sep)
+	filename = strings.TrimLeft(filename, sep)
+
+	path := strings.Split(filename, sep)
+	parts := make([]string, len(path))
+	copy(parts, path)
+	parts[len(parts)-1] = strings.TrimSuffix(parts[len(parts)-1], ".py")
+	if parts[len(parts)-1] == "__init__" && len(parts) > 1 {
+		parts = parts[:len(parts)-1]
+	}
+	return strings.Join(parts, "."), path
+}
+
+// ================================ scope ================================
+
+func (b *astbuilder) pushScope(body antlr.Tree, params []*pyParam, isModule bool) *pyScope {
+	scope := &pyScope{
+		bound:    make(map[string]struct{}),
+		outer:    make(map[string]struct{}),
+		isModule: isModule,
+	}
+	if body != nil {
+		collectBinding(body, scope)
+	}
+	for _, param := range params {
+		scope.bound[param.name] = struct{}{}
+	}
+	b.scopes = append(b.scopes, scope)
+	return scope
+}
+
+func (b *astbuilder) popScope() {
+	if len(b.scopes) > 0 {
+		b.scopes = b.scopes[:len(b.scopes)-1]
+	}
+}
+
+func (b *astbuilder) currentScope() *pyScope {
+	if len(b.scopes) == 0 {
+		return nil
+	}
+	return b.scopes[len(b.scopes)-1]
+}
+
+// declareLocals declare the names assigned in scope at the enter block, python
+// variable is function level rather than block level, so the value assigned in
+// `if` / `for` body can be merged by phi, and the assignment in function will not
+// change the value of enclosing function (closure side-effect).
+func (b *astbuilder) declareLocals(scope *pyScope, params map[string]struct{}) {
+	names := make([]string, 0, len(scope.bound))
+	for name := range scope.bound {
+		if _, ok := scope.outer[name]; ok {
+			continue
+		}
+		if _, ok := params[name]; ok {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		variable := b.CreateLocalVariable(name)
+		b.AssignVariable(variable, b.EmitValueOnlyDeclare(name))
+	}
+}
+
+// assignName bind value to name in current scope. the name assigned in function is local
+// unless declared by `global` / `nonlocal`, so it should not be a side-effect of closure.
+func (b *astbuilder) assignName(name string, value ssa.Value) {
+	variable := b.CreateVariable(name)
+	if scope := b.currentScope(); scope != nil && !scope.isModule {
+		if _, ok := scope.outer[name]; !ok {
+			supportClosure := b.SupportClosure
+			b.SupportClosure = false
+			defer func() { b.SupportClosure = supportClosure }()
+		}
+	}
+	b.AssignVariable(variable, value)
+}
+
+// collectBinding collect all names bound in the scope, nested function and class are skipped
+func collectBinding(tree antlr.Tree, scope *pyScope) {
+	bind := func(name string) {
+		scope.bound[name] = struct{}{}
+	}
+	declareOuter := func(names []pythonparser.INameContext) {
+		for _, name := range names {
+			scope.outer[name.GetText()] = struct{}{}
+		}
+	}
+
+	switch node := tree.(type) {
+	case *pythonparser.FuncDefContext:
+		bind(node.Name().GetText())
+		return
+	case *pythonparser.ClassDefContext:
+		bind(node.Name().GetText())
+		return
+	case *pythonparser.ExpressionStatementContext:
+		switch assign := node.AssignPart().(type) {
+		case *pythonparser.NormalAssignContext:
+			targets, _ := assignParts(node, assign)
+			for _, target := range targets {
+				targetBinding(target, bind)
+			}
+		case *pythonparser.AugmentedAssignContext:
+			targetBinding(node.TestlistStarExpr(), bind)
+		case *pythonparser.AnnotatedAssignContext:
+			if assign.ASSIGN() != nil {
+				targetBinding(node.TestlistStarExpr(), bind)
+			}
+		}
+		return
+	case *pythonparser.ForStatementContext:
+		targetBinding(node.Exprlist(), bind)
+	case *pythonparser.WithItemContext:
+		if expr := node.Expr(); expr != nil {
+			targetBinding(expr, bind)
+		}
+		return
+	case *pythonparser.ExceptClauseContext:
+		if name := node.Name(); name != nil {
+			bind(name.GetText())
+		}
+	case *pythonparser.ImportStatementContext:
+		for _, alias := range node.DottedAsNames().(*pythonparser.DottedAsNamesContext).AllDottedAsName() {
+			bind(importBindName(alias))
+		}
+		return
+	case *pythonparser.ImportFromStatementContext:
+		for _, alias := range importAsNames(node) {
+			bind(importBindName(alias))
+		}
+		return
+	case *pythonparser.GlobalStatementContext:
+		declareOuter(node.AllName())
+		return
+	case *pythonparser.NonlocalStatementContext:
+		declareOuter(node.AllName())
+		return
+	case *pythonparser.CasePatternsContext:
+		patternBinding(node, bind)
+		return
+	case *pythonparser.NamedExprTestContext, *pythonparser.TestContext, *pythonparser.TestlistContext,
+		*pythonparser.ExprlistContext, *pythonparser.TestlistStarExprContext, *pythonparser.YieldExprContext,
+		*pythonparser.SubjectExprContext:
+		// no statement inside expression
+		return
+	}
+
+	for _, child := range tree.GetChildren() {
+		collectBinding(child, scope)
+	}
+}
+
+// targetBinding collect the names of assignment target
+func targetBinding(tree antlr.Tree, bind func(string)) {
+	switch node := tree.(type) {
+	case *pythonparser.TestlistStarExprContext, *pythonparser.ExprlistContext,
+		*pythonparser.TestlistContext, *pythonparser.TestlistCompContext:
+		for _, elt := range listElements(node) {
+			targetBinding(elt, bind)
+		}
+	case *pythonparser.StarExprContext:
+		targetBinding(node.Expr(), bind)
+	case *pythonparser.NamedExprTestContext:
+		targetBinding(node.Test(), bind)
+	case *pythonparser.TestContext:
+		if atomExpr := atomExprOf(node); atomExpr != nil {
+			targetBinding(atomExpr, bind)
+		}
+	case *pythonparser.PrimaryExprContext:
+		targetBinding(node.AtomExpr(), bind)
+	case *pythonparser.AtomExprContext:
+		if len(node.AllTrailer()) == 0 {
+			targetBinding(node.Atom(), bind)
+		}
+	case *pythonparser.NameAtomContext:
+		bind(node.GetText())
+	case *pythonparser.ParenAtomContext:
+		if list := node.TestlistComp(); list != nil {
+			targetBinding(list, bind)
+		}
+	case *pythonparser.ListAtomContext:
+		if list := node.TestlistComp(); list != nil {
+			targetBinding(list, bind)
+		}
+	}
+}
+
+// patternBinding collect the captured names of match pattern, `_` is wildcard
+func patternBinding(tree antlr.Tree, bind func(string)) {
+	switch node := tree.(type) {
+	case *pythonparser.StarPatternContext:
+		if name := node.Name().GetText(); name != "_" {
+			bind(name)
+		}
+		return
+	case *pythonparser.AsPatternContext:
+		if name := node.Name(); name != nil {
+			bind(name.GetText())
+		}
+	case *pythonparser.CapturePatternContext:
+		if name := node.Name().GetText(); name != "_" {
+			bind(name)
+		}
+		return
+	case *pythonparser.MappingPatternContext:
+		if node.POWER() != nil {
+			bind(node.Name(0).GetText())
+			return
+		}
+	case *pythonparser.LiteralClosedPatternContext, *pythonparser.ValuePatternContext:
+		return
+	}
+	for _, child := range tree.GetChildren() {
+		patternBinding(child, bind)
+	}
+}
+
+// ================================ import ================================
+
+// importBindName is the local name bound by import alias:
+// `import a.b` bind `a`, `import a.b as c` and `from a import b as c` bind `c`
+func importBindName(raw antlr.Tree) string {
+	switch alias := raw.(type) {
+	case *pythonparser.DottedAsNameContext:
+		if name := alias.Name(); name != nil {
+			return name.GetText()
+		}
+		return alias.DottedName().(*pythonparser.DottedNameContext).Name(0).GetText()
+	case *pythonparser.ImportAsNameContext:
+		return alias.Name(len(alias.AllName()) - 1).GetText()
+	}
+	return ""
+}
+
+// importAsNames get the names of `from ... import`, it is empty for `import *`
+func importAsNames(stmt *pythonparser.ImportFromStatementContext) []pythonparser.IImportAsNameContext {
+	targets, _ := stmt.ImportTargets().(*pythonparser.ImportTargetsContext)
+	if targets == nil {
+		return nil
+	}
+	names, _ := targets.ImportAsNames().(*pythonparser.ImportAsNamesContext)
+	if names == nil {
+		return nil
+	}
+	return names.AllImportAsName()
+}
+
+// VisitImportStatement handle `import a.b.c [as d]`
+func (b *astbuilder) VisitImportStatement(raw *pythonparser.ImportStatementContext) {
+	for _, item := range raw.DottedAsNames().(*pythonparser.DottedAsNamesContext).AllDottedAsName() {
+		alias := item.(*pythonparser.DottedAsNameContext)
+		recoverRange := b.SetRange(alias)
+		name := alias.DottedName().GetText()
+		if as := alias.Name(); as != nil {
+			b.assignName(as.GetText(), b.importModule(name, 0, as.GetText()))
+		} else {
+			// `import a.b` load both `a` and `a.b`, but only `a` is bound
+			top, _, _ := strings.Cut(name, ".")
+			value := b.importModule(top, 0, top)
+			if top != name {
+				b.importModule(name, 0, "")
+			}
+			b.assignName(top, value)
+		}
+		recoverRange()
+	}
+}
+
+// VisitImportFromStatement handle `from ..a.b import c [as d]`
+func (b *astbuilder) VisitImportFromStatement(raw *pythonparser.ImportFromStatementContext) {
+	module, level := "", 0
+	if from, _ := raw.ImportFromModule().(*pythonparser.ImportFromModuleContext); from != nil {
+		level = len(from.AllDOT()) + 3*len(from.AllELLIPSIS())
+		if name := from.DottedName(); name != nil {
+			module = name.GetText()
+		}
+	}
+
+	lib, found := b.loadModule(module, level)
+	if targets, _ := raw.ImportTargets().(*pythonparser.ImportTargetsContext); targets != nil && targets.STAR() != nil {
+		if lib != nil {
+			for name, value := range lib.ExprotValue {
+				if strings.HasPrefix(name, "_") {
+					continue
+				}
+				b.bindImportName(lib, name, name, value)
+			}
+		}
+		return
+	}
+
+	for _, item := range importAsNames(raw) {
+		alias := item.(*pythonparser.ImportAsNameContext)
+		recoverRange := b.SetRange(alias)
+		name := alias.Name(0).GetText()
+		local := importBindName(alias)
+		var value ssa.Value
+		if found {
+			value = lib.GetExprotValue(name)
+			if value == nil {
+				// maybe sub module: `from pkg import mod`
+				sub := name
+				if module != "" {
+					sub = module + "." + name
+				}
+				if subLib, ok := b.loadModule(sub, level); ok {
+					b.modules[local] = subLib
+					value = b.moduleValue(sub)
+				}
+			}
+			if value == nil {
+				b.NewError(ssa.Warn, TAG, ImportNameNotFound(module, name))
+			}
+		}
+		if value == nil {
+			// external module, create member of undefined module
+			moduleValue := b.moduleValue(strings.Repeat(".", level) + module)
+			value = b.ReadMemberCallVariable(moduleValue, b.EmitConstInst(name))
+		}
+		b.bindImportName(lib, name, local, value)
+		recoverRange()
+	}
+}
+
+func (b *astbuilder) bindImportName(lib *ssa.Program, name, local string, value ssa.Value) {
+	if lib != nil {
+		if class := lib.GetClassBluePrint(name); class != nil {
+			b.GetProgram().ClassBluePrint[local] = class
+		}
+	}
+	b.assignName(local, value)
+}
+
+// importModule load module and return the value bind to the local name
+func (b *astbuilder) importModule(name string, level int, local string) ssa.Value {
+	lib, found := b.loadModule(name, level)
+	if found && local != "" {
+		b.modules[local] = lib
+	}
+	return b.moduleValue(name)
+}
+
+// moduleValue is the value of module object, the member of module is read by `modules`
+func (b *astbuilder) moduleValue(name string) ssa.Value {
+	return b.EmitUndefined(name)
+}
+
+// loadModule find the module in project and build it if not built yet
+func (b *astbuilder) loadModule(name string, level int) (*ssa.Program, bool) {
+	prog := b.GetProgram()
+	fullName := b.resolveModuleName(name, level)
+	if fullName == "" {
+		return nil, false
+	}
+	if lib, _ := prog.GetLibrary(fullName); lib != nil {
+		return lib, true
+	}
+
+	path := b.findModuleFile(fullName)
+	if path == "" {
+		log.Debugf("python import %s: %s", fullName, ImportModuleNotFound(fullName))
+		return nil, false
+	}
+	fs := prog.Loader.GetFilesysFileSystem()
+	raw, err := fs.ReadFile(path)
+	if err != nil {
+		log.Warnf("read python module %s failed: %v", path, err)
+		return nil, false
+	}
+	if err := prog.GetApplication().Build(path, memedit.NewMemEditor(string(raw)), b.FunctionBuilder); err != nil {
+		log.Warnf("build python module %s failed: %v", path, err)
+	}
+	if lib, _ := prog.GetLibrary(fullName); lib != nil {
+		return lib, true
+	}
+	return nil, false
+}
+
+// resolveModuleName convert relative import to absolute module name
+func (b *astbuilder) resolveModuleName(name string, level int) string {
+	if level == 0 {
+		return name
+	}
+	current, _ := b.moduleName()
+	if current == "" {
+		return ""
+	}
+	parts := strings.Split(current, ".")
+	editor := b.GetEditor()
+	if !strings.HasSuffix(editor.GetFilename(), "__init__.py") {
+		// module in package, the package is the parent
+		parts = parts[:len(parts)-1]
+	}
+	if level-1 > len(parts) {
+		return ""
+	}
+	parts = parts[:len(parts)-(level-1)]
+	if name != "" {
+		parts = append(parts, name)
+	}
+	return strings.Join(parts, ".")
+}
+
+// findModuleFile find `a/b.py` or `a/b/__init__.py` for module `a.b`
+func (b *astbuilder) findModuleFile(name string) string {
+	loader := b.GetProgram().Loader
+	fs := loader.GetFilesysFileSystem()
+	parts := strings.Split(name, ".")
+
+	var bases []string
+	if base := loader.GetBasePath(); base != "" {
+		bases = append(bases, base)
+	}
+	// script directory is in sys.path too
+	if editor := b.GetEditor(); editor != nil {
+		dir, _ := fs.PathSplit(editor.GetFilename())
+		bases = append(bases, dir)
+	}
+	bases = append(bases, "")
+
+	for _, base := range bases {
+		elems := append([]string{base}, parts...)
+		module := fs.Join(elems...)
+		for _, candidate := range []string{module + ".py", fs.Join(module, "__init__.py")} {
+			if info, err := fs.Stat(candidate); err == nil && !info.IsDir() {
+				return candidate
+			}
+		}
+	}
+	return ""
+}
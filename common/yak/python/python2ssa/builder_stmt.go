@@ -0,0 +1,722 @@
+package python2ssa
+
+import (
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr/v4"
+	"github.com/yaklang/yaklang/common/utils"
+	pythonparser "github.com/yaklang/yaklang/common/yak/python/parser"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+func (b *astbuilder) VisitStatements(stmts []pythonparser.IStatementContext) {
+	for _, stmt := range stmts {
+		if b.IsBlockFinish() {
+			// unreachable code after return / raise / break / continue
+			return
+		}
+		b.VisitStatement(stmt)
+	}
+}
+
+func (b *astbuilder) VisitStatement(raw pythonparser.IStatementContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.StatementContext)
+	if i == nil {
+		return
+	}
+
+	if stmts := i.SimpleStatements(); stmts != nil {
+		b.VisitSimpleStatements(stmts)
+	} else if stmt := i.CompoundStatement(); stmt != nil {
+		b.VisitCompoundStatement(stmt)
+	}
+}
+
+// VisitSimpleStatements handle the statements in one line: `a = 1; b = 2`
+func (b *astbuilder) VisitSimpleStatements(raw pythonparser.ISimpleStatementsContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.SimpleStatementsContext)
+	if i == nil {
+		return
+	}
+
+	for _, stmt := range i.AllSimpleStatement() {
+		if b.IsBlockFinish() {
+			return
+		}
+		b.VisitSimpleStatement(stmt)
+	}
+}
+
+func (b *astbuilder) VisitBlock(raw pythonparser.IBlockContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.BlockContext)
+	if i == nil {
+		return
+	}
+
+	if stmts := i.SimpleStatements(); stmts != nil {
+		b.VisitSimpleStatements(stmts)
+		return
+	}
+	b.VisitStatements(i.AllStatement())
+}
+
+// VisitElseClause build the `else` block of if / for / while / try
+func (b *astbuilder) VisitElseClause(raw pythonparser.IElseClauseContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+
+	i, _ := raw.(*pythonparser.ElseClauseContext)
+	if i == nil {
+		return
+	}
+	b.VisitBlock(i.Block())
+}
+
+// blockItems flatten the block to simple statements and compound statements
+func blockItems(raw pythonparser.IBlockContext) []antlr.ParserRuleContext {
+	i, _ := raw.(*pythonparser.BlockContext)
+	if i == nil {
+		return nil
+	}
+
+	var items []antlr.ParserRuleContext
+	addSimple := func(raw pythonparser.ISimpleStatementsContext) {
+		if stmts, _ := raw.(*pythonparser.SimpleStatementsContext); stmts != nil {
+			for _, stmt := range stmts.AllSimpleStatement() {
+				items = append(items, stmt)
+			}
+		}
+	}
+	addSimple(i.SimpleStatements())
+	for _, item := range i.AllStatement() {
+		stmt, _ := item.(*pythonparser.StatementContext)
+		if stmt == nil {
+			continue
+		}
+		if compound := stmt.CompoundStatement(); compound != nil {
+			items = append(items, compound)
+		} else {
+			addSimple(stmt.SimpleStatements())
+		}
+	}
+	return items
+}
+
+func (b *astbuilder) VisitSimpleStatement(raw pythonparser.ISimpleStatementContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.ExpressionStatementContext:
+		b.VisitExpressionStatement(i)
+	case *pythonparser.YieldStatementContext:
+		b.VisitYieldExpr(i.YieldExpr())
+	case *pythonparser.DelStatementContext:
+		// `del` only remove binding, keep the value for data flow
+	case *pythonparser.PassStatementContext:
+	case *pythonparser.BreakStatementContext:
+		if !b.Break() {
+			b.NewError(ssa.Error, TAG, UnexpectedBreakStmt())
+		}
+	case *pythonparser.ContinueStatementContext:
+		if !b.Continue() {
+			b.NewError(ssa.Error, TAG, UnexpectedContinueStmt())
+		}
+	case *pythonparser.ReturnStatementContext:
+		if value := i.TestlistStarExpr(); value != nil {
+			b.EmitReturn([]ssa.Value{b.VisitTestlistStarExpr(value)})
+		} else {
+			b.EmitReturn(nil)
+		}
+	case *pythonparser.RaiseStatementContext:
+		b.VisitRaiseStatement(i)
+	case *pythonparser.GlobalStatementContext, *pythonparser.NonlocalStatementContext:
+		// handled when enter scope
+	case *pythonparser.ImportStatementContext:
+		b.VisitImportStatement(i)
+	case *pythonparser.ImportFromStatementContext:
+		b.VisitImportFromStatement(i)
+	case *pythonparser.AssertStatementContext:
+		cond := b.VisitTest(i.Test(0))
+		var msg ssa.Value
+		if test := i.Test(1); test != nil {
+			msg = b.VisitTest(test)
+		}
+		b.EmitAssert(cond, msg, i.Test(0).GetText())
+	}
+}
+
+func (b *astbuilder) VisitCompoundStatement(raw pythonparser.ICompoundStatementContext) {
+	if b == nil || raw == nil || b.IsStop() {
+		return
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	switch i := raw.(type) {
+	case *pythonparser.IfStatementContext:
+		b.VisitIfStatement(i)
+	case *pythonparser.WhileStatementContext:
+		b.VisitWhileStatement(i)
+	case *pythonparser.ForStatementContext:
+		b.VisitForStatement(i)
+	case *pythonparser.TryStatementContext:
+		b.VisitTryStatement(i)
+	case *pythonparser.WithStatementContext:
+		b.VisitWithStatement(i)
+	case *pythonparser.MatchStatementContext:
+		b.VisitMatchStatement(i)
+	case *pythonparser.DefinitionStatementContext:
+		if def := i.FuncDef(); def != nil {
+			b.VisitFuncDef(def, i.AllDecorator())
+		} else if def := i.ClassDef(); def != nil {
+			b.VisitClassDef(def, i.AllDecorator())
+		}
+	}
+}
+
+func (b *astbuilder) VisitExpressionStatement(raw *pythonparser.ExpressionStatementContext) {
+	switch assign := raw.AssignPart().(type) {
+	case nil:
+		b.VisitTestlistStarExpr(raw.TestlistStarExpr())
+	case *pythonparser.NormalAssignContext:
+		// `a = b = value`
+		targets, valueNode := assignParts(raw, assign)
+		value := b.buildValue(valueNode)
+		if utils.IsNil(value) {
+			value = b.EmitUndefined("")
+		}
+		for _, target := range targets {
+			b.assignTarget(target, value)
+		}
+	case *pythonparser.AugmentedAssignContext:
+		target := raw.TestlistStarExpr()
+		left := b.VisitTestlistStarExpr(target)
+		var right ssa.Value
+		if value := assign.YieldExpr(); value != nil {
+			right = b.VisitYieldExpr(value)
+		} else {
+			right = b.VisitTestlist(assign.Testlist())
+		}
+		op := strings.TrimSuffix(assign.AugAssign().GetText(), "=")
+		opcode, ok := binaryOpcode(op)
+		if !ok {
+			b.NewError(ssa.Error, TAG, BinaryOperatorNotSupport(op+"="))
+			return
+		}
+		b.assignTarget(target, b.EmitBinOp(opcode, left, right))
+	case *pythonparser.AnnotatedAssignContext:
+		// `a: int` only declare the type
+		if assign.ASSIGN() == nil {
+			return
+		}
+		var value ssa.Value
+		if yield := assign.YieldExpr(); yield != nil {
+			value = b.VisitYieldExpr(yield)
+		} else {
+			value = b.VisitTestlistStarExpr(assign.TestlistStarExpr())
+		}
+		b.assignTarget(raw.TestlistStarExpr(), value)
+	}
+}
+
+// assignParts split `a = b = value` to the targets `a`, `b` and the value
+func assignParts(stmt *pythonparser.ExpressionStatementContext, assign *pythonparser.NormalAssignContext) ([]antlr.Tree, antlr.Tree) {
+	targets := []antlr.Tree{stmt.TestlistStarExpr()}
+	values := listElements(assign)
+	if len(values) == 0 {
+		return nil, nil
+	}
+	return append(targets, values[:len(values)-1]...), values[len(values)-1]
+}
+
+// assignTarget bind the value to assignment target, tuple and list target are unpacked
+func (b *astbuilder) assignTarget(target antlr.Tree, value ssa.Value) {
+	if node, ok := target.(antlr.ParserRuleContext); ok {
+		recoverRange := b.SetRange(node)
+		defer recoverRange()
+	}
+
+	switch i := target.(type) {
+	case *pythonparser.TestlistStarExprContext, *pythonparser.ExprlistContext, *pythonparser.TestlistContext:
+		elts := listElements(i)
+		if len(elts) == 1 && !hasComma(i) {
+			b.assignTarget(elts[0], value)
+			return
+		}
+		b.unpackTarget(elts, value)
+		return
+	case *pythonparser.TestlistCompContext:
+		if i.CompFor() == nil {
+			elts := listElements(i)
+			if len(elts) == 1 && !hasComma(i) {
+				b.assignTarget(elts[0], value)
+				return
+			}
+			b.unpackTarget(elts, value)
+			return
+		}
+	case *pythonparser.StarExprContext:
+		b.assignTarget(i.Expr(), value)
+		return
+	case *pythonparser.NamedExprTestContext:
+		if i.WALRUS() == nil {
+			b.assignTarget(i.Test(), value)
+			return
+		}
+	case *pythonparser.TestContext:
+		if atomExpr := atomExprOf(i); atomExpr != nil {
+			b.assignTarget(atomExpr, value)
+			return
+		}
+	case *pythonparser.PrimaryExprContext:
+		b.assignTarget(i.AtomExpr(), value)
+		return
+	case *pythonparser.AtomExprContext:
+		if i.AWAIT() != nil {
+			break
+		}
+		trailers := i.AllTrailer()
+		if len(trailers) == 0 {
+			b.assignTarget(i.Atom(), value)
+			return
+		}
+		last := len(trailers) - 1
+		switch trailer := trailers[last].(type) {
+		case *pythonparser.AttributeTrailerContext:
+			obj := b.buildAtomExpr(i, last)
+			variable := b.CreateMemberCallVariable(obj, b.EmitConstInst(trailer.Name().GetText()))
+			b.AssignVariable(variable, value)
+			return
+		case *pythonparser.SubscriptTrailerContext:
+			obj := b.buildAtomExpr(i, last)
+			if isSliceSubscript(trailer.SubscriptList()) {
+				// slice assignment `a[1:2] = b` modify many elements
+				return
+			}
+			variable := b.CreateMemberCallVariable(obj, b.VisitSubscriptList(trailer.SubscriptList()))
+			b.AssignVariable(variable, value)
+			return
+		}
+	case *pythonparser.NameAtomContext:
+		b.assignName(i.GetText(), value)
+		return
+	case *pythonparser.ParenAtomContext:
+		if list := i.TestlistComp(); list != nil {
+			b.assignTarget(list, value)
+			return
+		}
+	case *pythonparser.ListAtomContext:
+		// `[a, b] = value` is always unpacked
+		if list, _ := i.TestlistComp().(*pythonparser.TestlistCompContext); list == nil {
+			return
+		} else if list.CompFor() == nil {
+			b.unpackTarget(listElements(list), value)
+			return
+		}
+	}
+
+	text := ""
+	if node, ok := target.(antlr.ParseTree); ok {
+		text = node.GetText()
+	}
+	b.NewError(ssa.Error, TAG, AssignTargetNotSupport(text))
+}
+
+func (b *astbuilder) unpackTarget(elts []antlr.Tree, value ssa.Value) {
+	for i, elt := range elts {
+		if starred, ok := elt.(*pythonparser.StarExprContext); ok {
+			// `a, *rest = value`
+			rest := b.EmitMakeSlice(value, b.EmitConstInst(i), nil, nil)
+			b.assignTarget(starred.Expr(), rest)
+			continue
+		}
+		b.assignTarget(elt, b.ReadMemberCallVariable(value, b.EmitConstInst(i)))
+	}
+}
+
+func (b *astbuilder) VisitRaiseStatement(raw *pythonparser.RaiseStatementContext) {
+	var info ssa.Value
+	if exc := raw.Test(0); exc != nil {
+		info = b.VisitTest(exc)
+	} else {
+		// re-raise current exception
+		info = b.EmitUndefined("")
+	}
+	if cause := raw.Test(1); cause != nil {
+		b.VisitTest(cause)
+	}
+	b.EmitPanic(info)
+}
+
+func (b *astbuilder) VisitIfStatement(raw *pythonparser.IfStatementContext) {
+	builder := b.CreateIfBuilder()
+	addItem := func(cond pythonparser.INamedExprTestContext, block pythonparser.IBlockContext) {
+		builder.AppendItem(
+			func() ssa.Value {
+				recoverRange := b.SetRange(cond)
+				b.AppendBlockRange()
+				recoverRange()
+				return b.VisitNamedExprTest(cond)
+			},
+			func() {
+				b.VisitBlock(block)
+			},
+		)
+	}
+	addItem(raw.NamedExprTest(), raw.Block())
+	for _, item := range raw.AllElifClause() {
+		if elif, _ := item.(*pythonparser.ElifClauseContext); elif != nil {
+			addItem(elif.NamedExprTest(), elif.Block())
+		}
+	}
+	if elseClause := raw.ElseClause(); elseClause != nil {
+		builder.SetElse(func() {
+			b.VisitElseClause(elseClause)
+		})
+	}
+	builder.Build()
+}
+
+func (b *astbuilder) VisitWhileStatement(raw *pythonparser.WhileStatementContext) {
+	loop := b.CreateLoopBuilder()
+	loop.SetCondition(func() ssa.Value {
+		condition := b.VisitNamedExprTest(raw.NamedExprTest())
+		if utils.IsNil(condition) {
+			condition = b.EmitConstInst(true)
+		}
+		return condition
+	})
+	loop.SetBody(func() {
+		b.VisitBlock(raw.Block())
+	})
+	loop.Finish()
+	// `while ... else`, the else block run when loop is not break
+	b.VisitElseClause(raw.ElseClause())
+}
+
+func (b *astbuilder) VisitForStatement(raw *pythonparser.ForStatementContext) {
+	loop := b.CreateLoopBuilder()
+	var iter ssa.Value
+	loop.SetFirst(func() []ssa.Value {
+		iter = b.VisitTestlist(raw.Testlist())
+		return []ssa.Value{iter}
+	})
+	loop.SetCondition(func() ssa.Value {
+		// `for x in iter` same as yak `for x in iter`, the key is the item
+		key, field, ok := b.EmitNext(iter, true)
+		ssa.DeleteInst(field)
+		b.assignTarget(raw.Exprlist(), key)
+		return ok
+	})
+	loop.SetBody(func() {
+		b.VisitBlock(raw.Block())
+	})
+	loop.Finish()
+	b.VisitElseClause(raw.ElseClause())
+}
+
+func (b *astbuilder) VisitTryStatement(raw *pythonparser.TryStatementContext) {
+	tryBuilder := b.BuildTry()
+	tryBuilder.BuildTryBlock(func() {
+		b.VisitBlock(raw.Block())
+		// `try ... else`, run when no exception raised
+		b.VisitElseClause(raw.ElseClause())
+	})
+	for _, item := range raw.AllExceptClause() {
+		handler, _ := item.(*pythonparser.ExceptClauseContext)
+		if handler == nil {
+			continue
+		}
+		tryBuilder.BuildErrorCatch(func() string {
+			if typ := handler.Test(); typ != nil {
+				b.VisitTest(typ)
+			}
+			if name := handler.Name(); name != nil {
+				return name.GetText()
+			}
+			return ""
+		}, func() {
+			b.VisitBlock(handler.Block())
+		})
+	}
+	if finally, _ := raw.FinallyClause().(*pythonparser.FinallyClauseContext); finally != nil {
+		tryBuilder.BuildFinally(func() {
+			b.VisitBlock(finally.Block())
+		})
+	}
+	tryBuilder.Finish()
+}
+
+func (b *astbuilder) VisitWithStatement(raw *pythonparser.WithStatementContext) {
+	if items, _ := raw.WithItems().(*pythonparser.WithItemsContext); items != nil {
+		for _, raw := range items.AllWithItem() {
+			item, _ := raw.(*pythonparser.WithItemContext)
+			if item == nil {
+				continue
+			}
+			recoverRange := b.SetRange(item)
+			context := b.VisitTest(item.Test())
+			if target := item.Expr(); target != nil {
+				// `with open(f) as fp`, the fp is the result of `__enter__`, usually the context self
+				b.assignTarget(target, context)
+			}
+			recoverRange()
+		}
+	}
+	b.VisitBlock(raw.Block())
+}
+
+// VisitMatchStatement build `match` as if-elif chain, the captured names are bound in
+// the condition of each case, so they flow from the subject into case body and guard
+func (b *astbuilder) VisitMatchStatement(raw *pythonparser.MatchStatementContext) {
+	subject := b.VisitSubjectExpr(raw.SubjectExpr())
+	if utils.IsNil(subject) {
+		subject = b.EmitUndefined("")
+	}
+	builder := b.CreateIfBuilder()
+	hasCase := false
+	for _, item := range raw.AllCaseBlock() {
+		c, _ := item.(*pythonparser.CaseBlockContext)
+		if c == nil {
+			continue
+		}
+		patterns := c.CasePatterns()
+		guard := c.NamedExprTest()
+		if guard == nil && isIrrefutablePattern(patterns) {
+			// `case _:` or `case x:` always match, the following cases are unreachable
+			body := func() {
+				recoverRange := b.SetRange(patterns)
+				b.buildPattern(patterns, subject)
+				recoverRange()
+				b.VisitBlock(c.Block())
+			}
+			if !hasCase {
+				body()
+				return
+			}
+			builder.SetElse(body)
+			break
+		}
+		hasCase = true
+		builder.AppendItem(
+			func() ssa.Value {
+				recoverRange := b.SetRange(patterns)
+				defer recoverRange()
+				b.AppendBlockRange()
+				cond := b.buildPattern(patterns, subject)
+				if guard != nil {
+					cond = b.EmitBinOp(ssa.OpLogicAnd, cond, b.VisitNamedExprTest(guard))
+				}
+				return cond
+			},
+			func() {
+				b.VisitBlock(c.Block())
+			},
+		)
+	}
+	if hasCase {
+		builder.Build()
+	}
+}
+
+// VisitSubjectExpr build the subject of match, `match a, b:` match the tuple
+func (b *astbuilder) VisitSubjectExpr(raw pythonparser.ISubjectExprContext) ssa.Value {
+	if b == nil || raw == nil || b.IsStop() {
+		return nil
+	}
+	recoverRange := b.SetRange(raw)
+	defer recoverRange()
+
+	i, _ := raw.(*pythonparser.SubjectExprContext)
+	if i == nil {
+		return nil
+	}
+	elts := listElements(i)
+	if len(elts) == 1 && !hasComma(i) {
+		return b.buildValue(elts[0])
+	}
+	return b.buildSliceLiteral(b.buildValues(elts))
+}
+
+func isIrrefutablePattern(tree antlr.Tree) bool {
+	switch i := tree.(type) {
+	case *pythonparser.CasePatternsContext:
+		patterns := i.AllCasePattern()
+		return len(patterns) == 1 && !hasComma(i) && isIrrefutablePattern(patterns[0])
+	case *pythonparser.AsPatternContext:
+		return isIrrefutablePattern(i.OrPattern())
+	case *pythonparser.OrPatternContext:
+		for _, sub := range i.AllClosedPattern() {
+			if isIrrefutablePattern(sub) {
+				return true
+			}
+		}
+	case *pythonparser.GroupPatternContext:
+		return isIrrefutablePattern(i.CasePattern())
+	case *pythonparser.CapturePatternContext:
+		return true
+	}
+	return false
+}
+
+// buildPattern bind the captured names of pattern to value and return the match condition
+func (b *astbuilder) buildPattern(tree antlr.Tree, value ssa.Value) ssa.Value {
+	if node, ok := tree.(antlr.ParserRuleContext); ok {
+		recoverRange := b.SetRange(node)
+		defer recoverRange()
+	}
+
+	var cond ssa.Value
+	and := func(other ssa.Value) {
+		if utils.IsNil(other) {
+			return
+		}
+		if utils.IsNil(cond) {
+			cond = other
+		} else {
+			cond = b.EmitBinOp(ssa.OpLogicAnd, cond, other)
+		}
+	}
+	switch i := tree.(type) {
+	case *pythonparser.CasePatternsContext:
+		patterns := i.AllCasePattern()
+		if len(patterns) == 1 && !hasComma(i) {
+			return b.buildPattern(patterns[0], value)
+		}
+		and(b.buildSequencePattern(patterns, value))
+	case *pythonparser.StarPatternContext:
+		if name := i.Name().GetText(); name != "_" {
+			b.assignName(name, value)
+		}
+	case *pythonparser.AsPatternContext:
+		and(b.buildPattern(i.OrPattern(), value))
+		if name := i.Name(); name != nil {
+			b.assignName(name.GetText(), value)
+		}
+	case *pythonparser.OrPatternContext:
+		patterns := i.AllClosedPattern()
+		if len(patterns) == 1 {
+			return b.buildPattern(patterns[0], value)
+		}
+		for _, sub := range patterns {
+			subCond := b.buildPattern(sub, value)
+			if utils.IsNil(cond) {
+				cond = subCond
+			} else {
+				cond = b.EmitBinOp(ssa.OpLogicOr, cond, subCond)
+			}
+		}
+	case *pythonparser.LiteralClosedPatternContext:
+		and(b.EmitBinOp(ssa.OpEq, value, b.VisitLiteralPattern(i.LiteralPattern())))
+	case *pythonparser.CapturePatternContext:
+		if name := i.Name().GetText(); name != "_" {
+			b.assignName(name, value)
+		}
+	case *pythonparser.ValuePatternContext:
+		and(b.EmitBinOp(ssa.OpEq, value, b.buildDottedName(i.AllName())))
+	case *pythonparser.GroupPatternContext:
+		and(b.buildPattern(i.CasePattern(), value))
+	case *pythonparser.TuplePatternContext:
+		var patterns []pythonparser.ICasePatternContext
+		if first := i.CasePattern(); first != nil {
+			patterns = append(patterns, first)
+		}
+		if rest, _ := i.CasePatterns().(*pythonparser.CasePatternsContext); rest != nil {
+			patterns = append(patterns, rest.AllCasePattern()...)
+		}
+		and(b.buildSequencePattern(patterns, value))
+	case *pythonparser.ListPatternContext:
+		var patterns []pythonparser.ICasePatternContext
+		if list, _ := i.CasePatterns().(*pythonparser.CasePatternsContext); list != nil {
+			patterns = list.AllCasePattern()
+		}
+		and(b.buildSequencePattern(patterns, value))
+	case *pythonparser.MappingPatternsContext:
+		for _, item := range i.AllMappingPattern() {
+			mapping, _ := item.(*pythonparser.MappingPatternContext)
+			if mapping == nil {
+				continue
+			}
+			if mapping.POWER() != nil {
+				b.assignName(mapping.Name(0).GetText(), value)
+				continue
+			}
+			var key ssa.Value
+			if literal := mapping.LiteralPattern(); literal != nil {
+				key = b.VisitLiteralPattern(literal)
+			} else {
+				key = b.buildDottedName(mapping.AllName())
+			}
+			and(b.buildPattern(mapping.CasePattern(), b.ReadMemberCallVariable(value, key)))
+		}
+	case *pythonparser.ClassPatternContext:
+		isinstance := b.ReadValue("isinstance")
+		var cls ssa.Value
+		if name, _ := i.DottedName().(*pythonparser.DottedNameContext); name != nil {
+			cls = b.buildDottedName(name.AllName())
+		}
+		and(b.EmitCall(b.NewCall(isinstance, []ssa.Value{value, cls})))
+		index := 0
+		for _, item := range i.AllClassPatternArg() {
+			arg, _ := item.(*pythonparser.ClassPatternArgContext)
+			if arg == nil {
+				continue
+			}
+			if name := arg.Name(); name != nil {
+				and(b.buildPattern(arg.CasePattern(), b.ReadMemberCallVariable(value, b.EmitConstInst(name.GetText()))))
+				continue
+			}
+			// positional sub-pattern depends on `__match_args__`, take it as the element by index
+			and(b.buildPattern(arg.CasePattern(), b.ReadMemberCallVariable(value, b.EmitConstInst(index))))
+			index++
+		}
+	}
+	if utils.IsNil(cond) {
+		cond = b.EmitConstInst(true)
+	}
+	return cond
+}
+
+// buildSequencePattern match the elements of sequence one by one
+func (b *astbuilder) buildSequencePattern(patterns []pythonparser.ICasePatternContext, value ssa.Value) ssa.Value {
+	var cond ssa.Value
+	afterStar := false
+	for i, sub := range patterns {
+		if star, ok := sub.(*pythonparser.StarPatternContext); ok {
+			afterStar = true
+			if name := star.Name().GetText(); name != "_" {
+				b.assignName(name, b.EmitMakeSlice(value, b.EmitConstInst(i), nil, nil))
+			}
+			continue
+		}
+		index := i
+		if afterStar {
+			// `[first, *rest, last]`, elements after star are indexed from the end
+			index = i - len(patterns)
+		}
+		subCond := b.buildPattern(sub, b.ReadMemberCallVariable(value, b.EmitConstInst(index)))
+		if utils.IsNil(cond) {
+			cond = subCond
+		} else {
+			cond = b.EmitBinOp(ssa.OpLogicAnd, cond, subCond)
+		}
+	}
+	return cond
+}
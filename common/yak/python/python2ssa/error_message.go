@@ -0,0 +1,45 @@
+package python2ssa
+
+import (
+	"fmt"
+
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const TAG ssa.ErrorTag = "pythonast"
+
+func UnaryOperatorNotSupport(op string) string {
+	return fmt.Sprintf("unary operator not support: %s", op)
+}
+
+func BinaryOperatorNotSupport(op string) string {
+	return fmt.Sprintf("binary operator not support: %s", op)
+}
+
+func AssignTargetNotSupport(target string) string {
+	return fmt.Sprintf("cannot assign to %s", target)
+}
+
+func ImportModuleNotFound(name string) string {
+	return fmt.Sprintf("import module %s not found in project, treat it as external module", name)
+}
+
+func ImportNameNotFound(module, name string) string {
+	return fmt.Sprintf("cannot import name %s from module %s", name, module)
+}
+
+func UnexpectedBreakStmt() string {
+	return "'break' outside loop"
+}
+
+func UnexpectedContinueStmt() string {
+	return "'continue' not properly in loop"
+}
+
+func ExpressionNotSupport(expr string) string {
+	return fmt.Sprintf("expression not support: %s", expr)
+}
+
+func FormattedStringError(msg string) string {
+	return fmt.Sprintf("f-string: %s", msg)
+}
@@ -0,0 +1,57 @@
+a if b else c
+lambda: 0
+lambda x, *y, **z: x + 1
+lambda x, /, y=1, *, z: x
+[x * 2 for x in range(10) if x % 2 == 0]
+[y for x in matrix for y in x if y if y > 0]
+{k: v for k, v in d.items()}
+{x for x in items}
+{1, 2, 3}
+{**a, "b": 1}
+{}
+[]
+()
+(1,)
+(x for x in y)
+a[1:2, ::3]
+a[:]
+a[::-1]
+a[..., 0]
+not a or b and c
+-x ** 2
+2 ** -1
+~x & y | z ^ w << 1 >> 2
+a @ b
+a // b % c
+a < b <= c != d
+a is not b
+a not in b
+a <> b
+f(*args, key=1, **kwargs)
+f(x for x in y)
+f(a, (b := 1))
+sum(x for x in y)
+obj.method().attr[0](1)
+b"\x00bytes" + rb"\d"
+"a" "b" 'c'
+u"unicode"
+R"raw\n"
+'''triple 'quoted' string'''
+...
+0x1F + 0o17 + 0b1010 + 1_000_000 + 00
+1.5e-3 + .5 + 5. + 3j + 1.5J
+print(f"select * from user where id={uid!r} and name='{name:>10}' {{raw}}")
+print(f'{x=}', f"{a['key']}", F"{x:{width}.{precision}}", rf"{path}\n")
+print(f"""multi
+{line}
+""")
+await fetch()
+[*a, *b]
+print(*a, sep="")
+x = [i async for i in aiter() if await i]
+result = yield
+match = re.match(r"\d+", text)
+match(x)
+match[0]
+case = 1
+print(match, case)
@@ -0,0 +1,33 @@
+match command.split():
+    case [action]:
+        pass
+    case ["go", ("north" | "south") as direction, *_]:
+        pass
+    case {"x": 0, "y": -1 | 1+2j, **rest}:
+        pass
+    case Point(x, y=0) | mod.Point():
+        pass
+    case status.OK if ok:
+        pass
+    case None | True | False:
+        pass
+    case (a, b), c:
+        pass
+    case ():
+        pass
+    case "a" "b":
+        pass
+    case {Color.RED: value}:
+        pass
+    case [1, 2, *others, 3]:
+        pass
+    case _:
+        pass
+
+match x, y:
+    case 1, 2:
+        pass
+
+match *values, last:
+    case _:
+        pass
@@ -0,0 +1,177 @@
+#!/usr/bin/env python3
+# -*- coding: utf-8 -*-
+"""module docstring"""
+
+import os, sys as system
+import os.path
+from ..pkg.mod import (a as b, c,)
+from . import views
+from ... import parent
+from .models import *
+
+x = 1; y = 2
+a = b = [1, 2, *rest]
+x += 1
+x //= 2
+count: int = 0
+names: list
+del a[0], b.c
+first, *middle, last = items
+(p, q), r = pair, 3
+obj.attr = value
+obj[key] = value
+obj[1:2] = [3]
+
+
+def add(a, b=1, /, c=2, *args, d, e=3, **kwargs) -> int:
+    """doc"""
+    global counter
+    counter += 1
+    return a + b
+
+
+def outer():
+    value = 1
+
+    def inner():
+        nonlocal value
+        value += 1
+        return value
+
+    return inner
+
+
+def keyword_only(*, key: str = "k", **options: dict) -> None:
+    pass
+
+
+async def fetch(url):
+    async with session.get(url) as resp:
+        async for chunk in resp.content:
+            yield chunk
+        return await resp.text()
+
+
+def generator():
+    yield
+    yield 1, 2
+    x = yield from other()
+    received = yield
+
+
+@app.route("/index", methods=["GET", "POST"])
+@login_required
+def index():
+    pass
+
+
+@buttons[0].clicked.connect
+def handler():
+    pass
+
+
+class User(Base, metaclass=Meta):
+    name = "guest"
+    age: int = 0
+
+    def __init__(self, name):
+        super().__init__()
+        self.name = name
+
+    @property
+    def upper(self):
+        return self.name.upper()
+
+    @staticmethod
+    def create(*args, **kwargs):
+        return User(*args, **kwargs)
+
+    @classmethod
+    def from_dict(cls, data):
+        return cls(data["name"])
+
+
+class Empty: pass
+
+
+if a:
+    pass
+elif b:
+    pass
+else:
+    pass
+
+for i, v in enumerate(items):
+    if v is not None and v not in seen:
+        continue
+    break
+else:
+    done = True
+
+while (n := next(it)) > 0:
+    n -= 1
+else:
+    n = 0
+
+try:
+    risky()
+except (ValueError, TypeError) as e:
+    raise RuntimeError("x") from e
+except Exception:
+    raise
+except:
+    pass
+else:
+    ok()
+finally:
+    clean()
+
+try:
+    risky()
+finally:
+    clean()
+
+try:
+    risky()
+except* ValueError as group:
+    pass
+
+with open(a) as f, open(b) as g:
+    data = f.read()
+
+with (
+    open(a) as f,
+    open(b) as g,
+):
+    pass
+
+assert x, "message"
+assert isinstance(x, int)
+
+if x: y = 1; z = 2
+
+lines = """
+multi line string
+    with indentation
+"""
+
+total = 1 + \
+    2
+
+value = {
+    "key": [
+        1,
+        2,
+    ],
+        "other": (3,
+    4),
+}
+
+
+def tabs():
+	if True:
+		return 1
+	return 2
+
+
+for _ in range(3): pass
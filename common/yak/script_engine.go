@@ -41,10 +41,13 @@ import (
 	"github.com/yaklang/yaklang/common/rpa"
 	"github.com/yaklang/yaklang/common/sca"
 	"github.com/yaklang/yaklang/common/simulator"
+	"github.com/yaklang/yaklang/common/smuggle"
 	"github.com/yaklang/yaklang/common/systemd"
 	"github.com/yaklang/yaklang/common/t3"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/comparer"
+	"github.com/yaklang/yaklang/common/utils/graphqlx"
+	"github.com/yaklang/yaklang/common/utils/grpcx"
 	"github.com/yaklang/yaklang/common/utils/htmlquery"
 	"github.com/yaklang/yaklang/common/xhtml"
 	"github.com/yaklang/yaklang/common/yak/antlr4yak"
@@ -298,6 +301,15 @@ func initYaklangLib() {
 	// ssa
 	yaklang.Import("ssa", ssaapi.Exports)
 
+	// grpc / grpc-web protobuf 解码
+	yaklang.Import("grpc", grpcx.Exports)
+
+	// graphql 内省、Schema 解析与别名/批量请求检测
+	yaklang.Import("graphql", graphqlx.Exports)
+
+	// HTTP 请求走私 / 解析不同步检测
+	yaklang.Import("smuggle", smuggle.Exports)
+
 	// openapi
 	yaklang.Import("openapi", openapi.Exports)
 
@@ -45,3 +45,25 @@ func updateToDatabase(prog *Program) {
 	ir.ExtraFile = prog.ExtraFile
 	ssadb.UpdateProgram(ir)
 }
+
+// ContinueFromDatabase make the program update the existed ir program record in database
+// instead of creating a new one, it is used by incremental compile.
+// the files in dropped (removed or going to be re-compiled) will be deleted from the file list.
+func (prog *Program) ContinueFromDatabase(p *ssadb.IrProgram, dropped ...string) {
+	if p == nil {
+		return
+	}
+	prog.irProgram = p
+	for name, hash := range p.FileList {
+		prog.FileList[name] = hash
+	}
+	if prog.ExtraFile == nil {
+		prog.ExtraFile = make(map[string]string)
+	}
+	for name, hash := range p.ExtraFile {
+		prog.ExtraFile[name] = hash
+	}
+	for _, name := range dropped {
+		delete(prog.FileList, name)
+	}
+}
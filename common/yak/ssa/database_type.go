@@ -21,6 +21,11 @@ func SaveTypeToDB(typ Type) int {
 	case *FunctionType:
 		param["name"] = t.Name
 		param["fullTypeName"] = t.GetFullTypeNames()
+		// keep the class of method, the call graph resolve virtual call by it
+		if t.ObjectType != nil {
+			param["isMethod"] = t.IsMethod
+			param["objectType"] = SaveTypeToDB(t.ObjectType)
+		}
 	case *ObjectType:
 		param["name"] = t.Name
 		param["fullTypeName"] = t.GetFullTypeNames()
@@ -85,6 +90,10 @@ func GetTypeFromDB(id int) Type {
 			// })
 		}
 		typ.fullTypeName = utils.InterfaceToStringSlice(params["fullTypeName"])
+		if id, ok := params["objectType"]; ok {
+			typ.IsMethod = utils.InterfaceToBoolean(params["isMethod"])
+			typ.ObjectType = GetTypeFromDB(utils.InterfaceToInt(id))
+		}
 		return typ
 	case ObjectTypeKind, SliceTypeKind, MapTypeKind, TupleTypeKind, StructTypeKind:
 		typ := &ObjectType{}
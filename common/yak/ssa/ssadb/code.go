@@ -129,6 +129,13 @@ func GetIrByVariable(db *gorm.DB, program, name string) []*IrCode {
 	return ret
 }
 
+// GetFunctionIds return the ids of all function definitions in program
+func GetFunctionIds(db *gorm.DB, program string) []int64 {
+	var ids []int64
+	db.Model(&IrCode{}).Where("program_name = ? AND is_function = ?", program, true).Order("id").Pluck("id", &ids)
+	return ids
+}
+
 func (r *IrCode) IsEmptySourceCodeHash() bool {
 	if r == nil {
 		return true
@@ -0,0 +1,183 @@
+package ssadb
+
+import (
+	"path"
+	"strings"
+
+	"github.com/jinzhu/gorm"
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/log"
+)
+
+// sqlite limit the number of host parameters in one statement,
+// so the "IN (?)" query should be split into chunks.
+const incrementalQueryChunkSize = 500
+
+// IrSourceKey return the path of the source file relative to the program root,
+// the folder path of IrSource is start with "/<program-name>".
+func IrSourceKey(program, folderPath, fileName string) string {
+	folder := strings.TrimPrefix(folderPath, "/")
+	folder = strings.TrimPrefix(folder, program)
+	return path.Join("/", folder, fileName)
+}
+
+// GetProgramSourceHashes return all source files of the program,
+// key is the path relative to the program root (see IrSourceKey), value is the sha256 of file content.
+func GetProgramSourceHashes(db *gorm.DB, program string) map[string]string {
+	var sources []*IrSource
+	if err := db.Model(&IrSource{}).
+		Where("program_name = ?", program).
+		Where("quoted_code != ?", "").
+		Select("folder_path, file_name, source_code_hash").
+		Find(&sources).Error; err != nil {
+		log.Errorf("query program %s source hash failed: %v", program, err)
+		return nil
+	}
+	ret := make(map[string]string, len(sources))
+	for _, source := range sources {
+		ret[IrSourceKey(program, source.FolderPath, source.FileName)] = source.SourceCodeHash
+	}
+	return ret
+}
+
+// GetCrossSourceHashes return the source hashes of other files which ir code
+// has def-use or call relationship with the ir code in given source hashes.
+func GetCrossSourceHashes(db *gorm.DB, program string, hashes []string) []string {
+	if len(hashes) == 0 {
+		return nil
+	}
+	self := lo.SliceToMap(hashes, func(h string) (string, struct{}) { return h, struct{}{} })
+
+	ids := make(map[int64]struct{})
+	for _, chunk := range lo.Chunk(hashes, incrementalQueryChunkSize) {
+		var codes []*IrCode
+		if err := db.Model(&IrCode{}).
+			Where("program_name = ?", program).
+			Where("source_code_hash IN (?)", chunk).
+			Select("id, defs, users, called_by, object_parent, object_members").
+			Find(&codes).Error; err != nil {
+			log.Errorf("query ir code by source hash failed: %v", err)
+			continue
+		}
+		for _, code := range codes {
+			for _, list := range [][]int64{code.Defs, code.Users, code.CalledBy} {
+				for _, id := range list {
+					ids[id] = struct{}{}
+				}
+			}
+			if code.ObjectParent > 0 {
+				ids[code.ObjectParent] = struct{}{}
+			}
+			for _, member := range code.ObjectMembers {
+				ids[member.key] = struct{}{}
+				ids[member.value] = struct{}{}
+			}
+		}
+	}
+	return getSourceHashByIds(db, program, lo.Keys(ids), self)
+}
+
+// GetSourceHashesDefineNames return the source hashes of files which define
+// the class or function with the given names.
+func GetSourceHashesDefineNames(db *gorm.DB, program string, names []string) []string {
+	if len(names) == 0 {
+		return nil
+	}
+	ids := make(map[int64]struct{})
+	for _, chunk := range lo.Chunk(names, incrementalQueryChunkSize) {
+		var classValues []int64
+		if err := db.Model(&IrIndex{}).
+			Where("program_name = ?", program).
+			Where("class_name IN (?)", chunk).
+			Pluck("value_id", &classValues).Error; err != nil {
+			log.Errorf("query ir index by class name failed: %v", err)
+		}
+		for _, id := range classValues {
+			ids[id] = struct{}{}
+		}
+
+		var functionValues []int64
+		if err := db.Model(&IrCode{}).
+			Where("program_name = ?", program).
+			Where("is_function = ?", true).
+			Where("name IN (?)", chunk).
+			Pluck("id", &functionValues).Error; err != nil {
+			log.Errorf("query ir code by function name failed: %v", err)
+		}
+		for _, id := range functionValues {
+			ids[id] = struct{}{}
+		}
+	}
+	return getSourceHashByIds(db, program, lo.Keys(ids), nil)
+}
+
+func getSourceHashByIds(db *gorm.DB, program string, ids []int64, exclude map[string]struct{}) []string {
+	ret := make(map[string]struct{})
+	for _, chunk := range lo.Chunk(ids, incrementalQueryChunkSize) {
+		var hashes []string
+		if err := db.Model(&IrCode{}).
+			Where("program_name = ?", program).
+			Where("id IN (?)", chunk).
+			Where("source_code_hash != ?", "").
+			Pluck("DISTINCT(source_code_hash)", &hashes).Error; err != nil {
+			log.Errorf("query source hash by ir code id failed: %v", err)
+			continue
+		}
+		for _, hash := range hashes {
+			if _, ok := exclude[hash]; ok {
+				continue
+			}
+			ret[hash] = struct{}{}
+		}
+	}
+	return lo.Keys(ret)
+}
+
+// DeleteProgramSources delete the source files and all ir code (with index and offset) compiled from them,
+// the audit results which point to the deleted ir code will be deleted too.
+func DeleteProgramSources(db *gorm.DB, program string, hashes []string) {
+	if len(hashes) == 0 {
+		return
+	}
+	for _, chunk := range lo.Chunk(hashes, incrementalQueryChunkSize) {
+		var ids []int64
+		db.Model(&IrCode{}).
+			Where("program_name = ?", program).
+			Where("source_code_hash IN (?)", chunk).
+			Pluck("id", &ids)
+		deleteAuditResultsByIrCodes(db, program, ids)
+		for _, idChunk := range lo.Chunk(ids, incrementalQueryChunkSize) {
+			db.Model(&IrIndex{}).Where("program_name = ? AND value_id IN (?)", program, idChunk).Unscoped().Delete(&IrIndex{})
+			db.Model(&IrCode{}).Where("program_name = ? AND id IN (?)", program, idChunk).Unscoped().Delete(&IrCode{})
+		}
+		db.Model(&IrOffset{}).Where("program_name = ? AND file_hash IN (?)", program, chunk).Unscoped().Delete(&IrOffset{})
+		db.Model(&IrSource{}).Where("program_name = ? AND source_code_hash IN (?)", program, chunk).Unscoped().Delete(&IrSource{})
+	}
+}
+
+// deleteAuditResultsByIrCodes delete the whole audit result (with all nodes and edges)
+// if any node of it point to the given ir code.
+func deleteAuditResultsByIrCodes(db *gorm.DB, program string, ids []int64) {
+	results := make(map[uint]struct{})
+	for _, idChunk := range lo.Chunk(ids, incrementalQueryChunkSize) {
+		var resultIds []uint
+		if err := db.Model(&AuditNode{}).
+			Where("program_name = ? AND ir_code_id IN (?)", program, idChunk).
+			Pluck("DISTINCT(result_id)", &resultIds).Error; err != nil {
+			log.Errorf("query audit result by ir code failed: %v", err)
+			continue
+		}
+		for _, id := range resultIds {
+			results[id] = struct{}{}
+		}
+	}
+	for _, resultChunk := range lo.Chunk(lo.Keys(results), incrementalQueryChunkSize) {
+		var nodeIds []uint
+		db.Model(&AuditNode{}).Where("result_id IN (?)", resultChunk).Pluck("id", &nodeIds)
+		for _, nodeChunk := range lo.Chunk(nodeIds, incrementalQueryChunkSize) {
+			db.Model(&AuditEdge{}).Where("from_node IN (?) OR to_node IN (?)", nodeChunk, nodeChunk).Unscoped().Delete(&AuditEdge{})
+		}
+		db.Model(&AuditNode{}).Where("result_id IN (?)", resultChunk).Unscoped().Delete(&AuditNode{})
+		db.Model(&AuditResult{}).Where("id IN (?)", resultChunk).Unscoped().Delete(&AuditResult{})
+	}
+}
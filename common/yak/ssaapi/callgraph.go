@@ -0,0 +1,630 @@
+package ssaapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/dot"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+)
+
+const (
+	CodeGraphKindCall    = "callgraph"
+	CodeGraphKindPackage = "package"
+
+	CodeGraphNodeFunction = "function"
+	CodeGraphNodeMethod   = "method"
+	CodeGraphNodeClosure  = "closure"
+	CodeGraphNodeExtern   = "extern"
+	CodeGraphNodePackage  = "package"
+
+	CodeGraphEdgeDirect  = "direct"
+	CodeGraphEdgeVirtual = "virtual"
+	CodeGraphEdgeClosure = "closure"
+	CodeGraphEdgeExtern  = "extern"
+	CodeGraphEdgeImport  = "import"
+	CodeGraphEdgeInherit = "inherit"
+)
+
+// CodeGraph is the call graph or package dependency graph of program,
+// it can be exported as dot, graphml or json.
+type CodeGraph struct {
+	Kind  string           `json:"kind"`
+	Nodes []*CodeGraphNode `json:"nodes"`
+	Edges []*CodeGraphEdge `json:"edges"`
+}
+
+type CodeGraphNode struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Package string `json:"package,omitempty"`
+	Kind    string `json:"kind"`
+	File    string `json:"file,omitempty"`
+	Line    int    `json:"line,omitempty"`
+}
+
+type CodeGraphEdge struct {
+	From  string `json:"from"`
+	To    string `json:"to"`
+	Kind  string `json:"kind"`
+	Count int    `json:"count"`
+}
+
+type codeGraphConfig struct {
+	packagePrefix []string
+	withExtern    bool
+}
+
+type CodeGraphOption func(*codeGraphConfig)
+
+// WithCodeGraphPackagePrefix only keep the nodes in packages with these prefixes,
+// both "com.example" and "com/example" are accepted.
+func WithCodeGraphPackagePrefix(prefix ...string) CodeGraphOption {
+	return func(c *codeGraphConfig) {
+		for _, p := range prefix {
+			p = strings.Trim(strings.ReplaceAll(strings.TrimSpace(p), "/", "."), ".")
+			if p != "" {
+				c.packagePrefix = append(c.packagePrefix, p)
+			}
+		}
+	}
+}
+
+// WithCodeGraphExtern keep the callee which is not defined in program (library or builtin) as extern node.
+func WithCodeGraphExtern(b bool) CodeGraphOption {
+	return func(c *codeGraphConfig) {
+		c.withExtern = b
+	}
+}
+
+func (c *codeGraphConfig) matchPackage(pkg string) bool {
+	if len(c.packagePrefix) == 0 {
+		return true
+	}
+	for _, prefix := range c.packagePrefix {
+		if pkg == prefix || strings.HasPrefix(pkg, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
+
+type codeGraphFunction struct {
+	fn        *ssa.Function
+	node      *CodeGraphNode
+	blueprint *ssa.ClassBluePrint
+}
+
+type codeGraphBuilder struct {
+	prog   *Program
+	config *codeGraphConfig
+
+	funcs     []*codeGraphFunction
+	funcByID  map[int64]*codeGraphFunction
+	nodes     map[string]*CodeGraphNode
+	edges     map[string]*CodeGraphEdge
+	edgeOrder []string
+}
+
+func newCodeGraphBuilder(p *Program, opts ...CodeGraphOption) *codeGraphBuilder {
+	config := &codeGraphConfig{}
+	for _, opt := range opts {
+		opt(config)
+	}
+	b := &codeGraphBuilder{
+		prog:     p,
+		config:   config,
+		funcByID: make(map[int64]*codeGraphFunction),
+		nodes:    make(map[string]*CodeGraphNode),
+		edges:    make(map[string]*CodeGraphEdge),
+	}
+	for _, fn := range p.allFunctions() {
+		b.addFunction(fn)
+	}
+	return b
+}
+
+// allFunctions return all function definitions in program and its libraries
+func (p *Program) allFunctions() []*ssa.Function {
+	var ret []*ssa.Function
+	if p.IsFromDatabase() {
+		for _, id := range ssadb.GetFunctionIds(ssadb.GetDB(), p.GetProgramName()) {
+			if fn, ok := ssa.ToFunction(p.Program.GetInstructionById(id)); ok {
+				ret = append(ret, fn)
+			}
+		}
+		return ret
+	}
+
+	visited := make(map[*ssa.Program]struct{})
+	var walk func(*ssa.Program)
+	walk = func(prog *ssa.Program) {
+		if prog == nil {
+			return
+		}
+		if _, ok := visited[prog]; ok {
+			return
+		}
+		visited[prog] = struct{}{}
+		prog.EachFunction(func(fn *ssa.Function) {
+			ret = append(ret, fn)
+		})
+		names := lo.Keys(prog.UpStream)
+		sort.Strings(names)
+		for _, name := range names {
+			walk(prog.UpStream[name])
+		}
+	}
+	walk(p.Program)
+	return ret
+}
+
+func (b *codeGraphBuilder) addFunction(fn *ssa.Function) {
+	if _, ok := b.funcByID[fn.GetId()]; ok {
+		return
+	}
+	item := &codeGraphFunction{fn: fn}
+	if ft, ok := ssa.ToFunctionType(fn.GetType()); ok {
+		item.blueprint, _ = ft.ObjectType.(*ssa.ClassBluePrint)
+	}
+
+	node := &CodeGraphNode{
+		ID:   fmt.Sprint(fn.GetId()),
+		Name: fn.GetName(),
+		Kind: CodeGraphNodeFunction,
+	}
+	switch {
+	case fn.GetParent() != nil:
+		node.Kind = CodeGraphNodeClosure
+	case item.blueprint != nil:
+		node.Kind = CodeGraphNodeMethod
+		node.Name = item.blueprint.Name + "." + fn.GetMethodName()
+	}
+	if rng := fn.GetRange(); rng != nil {
+		if editor := rng.GetEditor(); editor != nil {
+			node.File = editor.GetFilename()
+		}
+		if start := rng.GetStart(); start != nil {
+			node.Line = start.GetLine()
+		}
+	}
+	node.Package = functionPackage(fn, item.blueprint, node.File)
+
+	item.node = node
+	b.funcByID[fn.GetId()] = item
+	b.funcs = append(b.funcs, item)
+}
+
+// functionPackage guess the package of function by its class, program or file path
+func functionPackage(fn *ssa.Function, blueprint *ssa.ClassBluePrint, file string) string {
+	if names := blueprint.GetFullTypeNames(); len(names) > 0 {
+		if idx := strings.LastIndex(names[0], "."); idx > 0 {
+			return names[0][:idx]
+		}
+	}
+	if prog := fn.GetProgram(); prog != nil && prog.ProgramKind == ssa.Library {
+		return prog.Name
+	}
+	if file != "" {
+		if dir := path.Dir(strings.ReplaceAll(file, "\\", "/")); dir != "." && dir != "/" {
+			return strings.ReplaceAll(strings.Trim(dir, "/"), "/", ".")
+		}
+	}
+	if prog := fn.GetProgram(); prog != nil {
+		return prog.Name
+	}
+	return ""
+}
+
+func (b *codeGraphBuilder) addNode(node *CodeGraphNode) {
+	if _, ok := b.nodes[node.ID]; !ok {
+		b.nodes[node.ID] = node
+	}
+}
+
+func (b *codeGraphBuilder) addEdge(from, to, kind string) {
+	key := from + "->" + to + ":" + kind
+	if edge, ok := b.edges[key]; ok {
+		edge.Count++
+		return
+	}
+	b.edges[key] = &CodeGraphEdge{From: from, To: to, Kind: kind, Count: 1}
+	b.edgeOrder = append(b.edgeOrder, key)
+}
+
+func (b *codeGraphBuilder) graph(kind string) *CodeGraph {
+	g := &CodeGraph{Kind: kind}
+	for _, node := range b.nodes {
+		g.Nodes = append(g.Nodes, node)
+	}
+	sort.SliceStable(g.Nodes, func(i, j int) bool {
+		if g.Nodes[i].Package != g.Nodes[j].Package {
+			return g.Nodes[i].Package < g.Nodes[j].Package
+		}
+		return g.Nodes[i].ID < g.Nodes[j].ID
+	})
+	for _, key := range b.edgeOrder {
+		g.Edges = append(g.Edges, b.edges[key])
+	}
+	return g
+}
+
+// resolveCallee return the functions which may be called by the call instruction,
+// the interface/virtual call is resolved by class blueprint, and the closure by its definition.
+func (b *codeGraphBuilder) resolveCallee(v ssa.Value, visited map[int64]struct{}) (funcs []*codeGraphFunction, kind string, extern string) {
+	if utils.IsNil(v) {
+		return nil, "", ""
+	}
+	if _, ok := visited[v.GetId()]; ok {
+		return nil, "", ""
+	}
+	visited[v.GetId()] = struct{}{}
+
+	if fn, ok := ssa.ToFunction(v); ok {
+		item, ok := b.funcByID[fn.GetId()]
+		if !ok {
+			b.addFunction(fn)
+			item = b.funcByID[fn.GetId()]
+		}
+		if fn.GetParent() != nil {
+			return []*codeGraphFunction{item}, CodeGraphEdgeClosure, ""
+		}
+		return []*codeGraphFunction{item}, CodeGraphEdgeDirect, ""
+	}
+	if phi, ok := ssa.ToPhi(v); ok {
+		for _, edge := range phi.Edge {
+			fs, k, _ := b.resolveCallee(edge, visited)
+			if len(fs) > 0 {
+				funcs = append(funcs, fs...)
+				kind = k
+			}
+		}
+		if len(funcs) > 0 {
+			return funcs, kind, ""
+		}
+	}
+	if param, ok := ssa.ToParameter(v); ok && param.IsFreeValue {
+		if fs, _, _ := b.resolveCallee(param.GetDefault(), visited); len(fs) > 0 {
+			return fs, CodeGraphEdgeClosure, ""
+		}
+	}
+	if v.IsMember() {
+		key := ssa.GetKeyString(v.GetKey())
+		if blueprint, ok := v.GetObject().GetType().(*ssa.ClassBluePrint); ok {
+			if fs := b.resolveVirtual(blueprint, key); len(fs) > 0 {
+				return fs, CodeGraphEdgeVirtual, ""
+			}
+			return nil, "", blueprint.Name + "." + key
+		}
+		return nil, "", key
+	}
+	return nil, "", v.GetName()
+}
+
+// resolveVirtual return the method implementations of blueprint and its sub classes
+func (b *codeGraphBuilder) resolveVirtual(target *ssa.ClassBluePrint, method string) []*codeGraphFunction {
+	var fullName string
+	if names := target.GetFullTypeNames(); len(names) > 0 {
+		fullName = names[0]
+	}
+	var ret []*codeGraphFunction
+	for _, item := range b.funcs {
+		if item.blueprint == nil || item.fn.GetMethodName() != method {
+			continue
+		}
+		if isSubClassBluePrint(item.blueprint, target, fullName, make(map[*ssa.ClassBluePrint]struct{})) {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+func isSubClassBluePrint(bp, target *ssa.ClassBluePrint, fullName string, visited map[*ssa.ClassBluePrint]struct{}) bool {
+	if bp == nil {
+		return false
+	}
+	if _, ok := visited[bp]; ok {
+		return false
+	}
+	visited[bp] = struct{}{}
+	if bp == target || bp.Name == target.Name {
+		return true
+	}
+	if fullName != "" && utils.StringArrayContains(bp.GetFullTypeNames(), fullName) {
+		return true
+	}
+	for _, parent := range bp.ParentClass {
+		if isSubClassBluePrint(parent, target, fullName, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+func (b *codeGraphBuilder) eachCall(handler func(caller *codeGraphFunction, callee ssa.Value)) {
+	for _, item := range b.funcs {
+		for _, block := range item.fn.Blocks {
+			blk, ok := ssa.ToBasicBlock(block)
+			if !ok {
+				continue
+			}
+			for _, inst := range blk.Insts {
+				if call, ok := ssa.ToCall(inst); ok {
+					handler(item, call.Method)
+				}
+			}
+		}
+	}
+}
+
+// GetCallGraph build the call graph of program, every node is a function definition,
+// call on interface or super class will link to all implementations as virtual edge.
+func (p *Program) GetCallGraph(opts ...CodeGraphOption) *CodeGraph {
+	b := newCodeGraphBuilder(p, opts...)
+	for _, item := range b.funcs {
+		if b.config.matchPackage(item.node.Package) {
+			b.addNode(item.node)
+		}
+	}
+	b.eachCall(func(caller *codeGraphFunction, callee ssa.Value) {
+		if !b.config.matchPackage(caller.node.Package) {
+			return
+		}
+		funcs, kind, extern := b.resolveCallee(callee, make(map[int64]struct{}))
+		for _, target := range funcs {
+			if !b.config.matchPackage(target.node.Package) {
+				continue
+			}
+			b.addNode(target.node)
+			b.addEdge(caller.node.ID, target.node.ID, kind)
+		}
+		if len(funcs) == 0 && extern != "" && b.config.withExtern {
+			node := &CodeGraphNode{ID: "extern:" + extern, Name: extern, Kind: CodeGraphNodeExtern}
+			b.addNode(node)
+			b.addEdge(caller.node.ID, node.ID, CodeGraphEdgeExtern)
+		}
+	})
+	return b.graph(CodeGraphKindCall)
+}
+
+// GetPackageDependencyGraph build the dependency graph between packages of program,
+// the dependency comes from cross-package call and class inheritance.
+func (p *Program) GetPackageDependencyGraph(opts ...CodeGraphOption) *CodeGraph {
+	b := newCodeGraphBuilder(p, opts...)
+	addPackage := func(pkg string) bool {
+		if pkg == "" || !b.config.matchPackage(pkg) {
+			return false
+		}
+		b.addNode(&CodeGraphNode{ID: pkg, Name: pkg, Package: pkg, Kind: CodeGraphNodePackage})
+		return true
+	}
+	for _, item := range b.funcs {
+		addPackage(item.node.Package)
+	}
+	b.eachCall(func(caller *codeGraphFunction, callee ssa.Value) {
+		funcs, _, _ := b.resolveCallee(callee, make(map[int64]struct{}))
+		for _, target := range funcs {
+			from, to := caller.node.Package, target.node.Package
+			if from == to || !addPackage(from) || !addPackage(to) {
+				continue
+			}
+			b.addEdge(from, to, CodeGraphEdgeImport)
+		}
+	})
+
+	// the blueprints are shared by methods, walk each of them once
+	visited := make(map[*ssa.ClassBluePrint]struct{})
+	for _, item := range b.funcs {
+		bp := item.blueprint
+		if bp == nil {
+			continue
+		}
+		if _, ok := visited[bp]; ok {
+			continue
+		}
+		visited[bp] = struct{}{}
+		from := blueprintPackage(bp)
+		for _, parent := range bp.ParentClass {
+			to := blueprintPackage(parent)
+			if from == to || !addPackage(from) || !addPackage(to) {
+				continue
+			}
+			b.addEdge(from, to, CodeGraphEdgeInherit)
+		}
+	}
+	return b.graph(CodeGraphKindPackage)
+}
+
+func blueprintPackage(bp *ssa.ClassBluePrint) string {
+	names := bp.GetFullTypeNames()
+	if len(names) == 0 {
+		return ""
+	}
+	if idx := strings.LastIndex(names[0], "."); idx > 0 {
+		return names[0][:idx]
+	}
+	return ""
+}
+
+func (g *CodeGraph) GetNode(id string) *CodeGraphNode {
+	for _, node := range g.Nodes {
+		if node.ID == id {
+			return node
+		}
+	}
+	return nil
+}
+
+// GetNodeByName return the first node with name, the name of method is `Class.method`
+func (g *CodeGraph) GetNodeByName(name string) *CodeGraphNode {
+	for _, node := range g.Nodes {
+		if node.Name == name {
+			return node
+		}
+	}
+	return nil
+}
+
+// HasEdge check the edge between nodes with name, the empty kind matches all kinds of edge
+func (g *CodeGraph) HasEdge(from, to string, kind string) bool {
+	for _, edge := range g.Edges {
+		if kind != "" && edge.Kind != kind {
+			continue
+		}
+		f, t := g.GetNode(edge.From), g.GetNode(edge.To)
+		if f != nil && t != nil && f.Name == from && t.Name == to {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *CodeGraph) DotGraph() string {
+	graph := dot.New()
+	graph.MakeDirected()
+	graph.GraphAttribute("rankdir", "LR")
+	graph.DefaultNodeAttribute("shape", "box")
+
+	ids := make(map[string]int)
+	// the functions in same package are grouped as cluster
+	clusters := make(map[string]*dot.Graph)
+	for _, node := range g.Nodes {
+		sub := graph
+		if g.Kind == CodeGraphKindCall && node.Package != "" {
+			if cluster, ok := clusters[node.Package]; ok {
+				sub = cluster
+			} else {
+				sub = graph.CreateSubGraph(node.Package)
+				clusters[node.Package] = sub
+			}
+		}
+		id := sub.AddNode(node.Name)
+		if node.Kind == CodeGraphNodeExtern {
+			sub.NodeAttribute(id, "style", "dashed")
+		}
+		ids[node.ID] = id
+	}
+	for _, edge := range g.Edges {
+		from, ok1 := ids[edge.From]
+		to, ok2 := ids[edge.To]
+		if !ok1 || !ok2 {
+			continue
+		}
+		label := edge.Kind
+		if edge.Count > 1 {
+			label = fmt.Sprintf("%s x%d", edge.Kind, edge.Count)
+		}
+		switch edge.Kind {
+		case CodeGraphEdgeVirtual, CodeGraphEdgeExtern, CodeGraphEdgeInherit:
+			graph.AddDashEdge(from, to, label)
+		default:
+			graph.AddEdge(from, to, label)
+		}
+	}
+	var buf bytes.Buffer
+	graph.GenerateDOT(&buf)
+	return buf.String()
+}
+
+func (g *CodeGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+type graphMLKey struct {
+	ID       string `xml:"id,attr"`
+	For      string `xml:"for,attr"`
+	AttrName string `xml:"attr.name,attr"`
+	AttrType string `xml:"attr.type,attr"`
+}
+
+type graphMLNode struct {
+	ID   string        `xml:"id,attr"`
+	Data []graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	Source string        `xml:"source,attr"`
+	Target string        `xml:"target,attr"`
+	Data   []graphMLData `xml:"data"`
+}
+
+type graphMLDocument struct {
+	XMLName xml.Name     `xml:"graphml"`
+	XMLNS   string       `xml:"xmlns,attr"`
+	Keys    []graphMLKey `xml:"key"`
+	Graph   struct {
+		ID          string        `xml:"id,attr"`
+		EdgeDefault string        `xml:"edgedefault,attr"`
+		Nodes       []graphMLNode `xml:"node"`
+		Edges       []graphMLEdge `xml:"edge"`
+	} `xml:"graph"`
+}
+
+func (g *CodeGraph) GraphML() ([]byte, error) {
+	doc := &graphMLDocument{
+		XMLNS: "http://graphml.graphdrawing.org/xmlns",
+		Keys: []graphMLKey{
+			{ID: "name", For: "node", AttrName: "name", AttrType: "string"},
+			{ID: "package", For: "node", AttrName: "package", AttrType: "string"},
+			{ID: "kind", For: "node", AttrName: "kind", AttrType: "string"},
+			{ID: "file", For: "node", AttrName: "file", AttrType: "string"},
+			{ID: "line", For: "node", AttrName: "line", AttrType: "int"},
+			{ID: "edge_kind", For: "edge", AttrName: "kind", AttrType: "string"},
+			{ID: "count", For: "edge", AttrName: "count", AttrType: "int"},
+		},
+	}
+	doc.Graph.ID = g.Kind
+	doc.Graph.EdgeDefault = "directed"
+	for _, node := range g.Nodes {
+		item := graphMLNode{ID: node.ID, Data: []graphMLData{
+			{Key: "name", Value: node.Name},
+			{Key: "package", Value: node.Package},
+			{Key: "kind", Value: node.Kind},
+		}}
+		if node.File != "" {
+			item.Data = append(item.Data, graphMLData{Key: "file", Value: node.File})
+		}
+		if node.Line > 0 {
+			item.Data = append(item.Data, graphMLData{Key: "line", Value: fmt.Sprint(node.Line)})
+		}
+		doc.Graph.Nodes = append(doc.Graph.Nodes, item)
+	}
+	for _, edge := range g.Edges {
+		doc.Graph.Edges = append(doc.Graph.Edges, graphMLEdge{Source: edge.From, Target: edge.To, Data: []graphMLData{
+			{Key: "edge_kind", Value: edge.Kind},
+			{Key: "count", Value: fmt.Sprint(edge.Count)},
+		}})
+	}
+	raw, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), raw...), nil
+}
+
+// Export the graph in format: dot, graphml or json
+func (g *CodeGraph) Export(format string) ([]byte, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "dot":
+		return []byte(g.DotGraph()), nil
+	case "graphml", "xml":
+		return g.GraphML()
+	case "json":
+		return g.JSON()
+	default:
+		return nil, utils.Errorf("unsupported graph format: %v, expect dot, graphml or json", format)
+	}
+}
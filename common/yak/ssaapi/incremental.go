@@ -0,0 +1,275 @@
+package ssaapi
+
+import (
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+// FileChangeSet is the difference between the source files in file system
+// and the source files saved in database for the same program.
+// all file is the path relative to the program root, like "/src/main.java".
+type FileChangeSet struct {
+	Added     []string
+	Modified  []string
+	Removed   []string
+	Unchanged []string
+}
+
+func (s *FileChangeSet) HasChanged() bool {
+	if s == nil {
+		return false
+	}
+	return len(s.Added)+len(s.Modified)+len(s.Removed) > 0
+}
+
+type projectFile struct {
+	path string // path in file system
+	hash string // sha256 of content, same as IrSource.SourceCodeHash
+	code string
+}
+
+// incrementalState record which file should be re-compiled in incremental compile
+type incrementalState struct {
+	irProgram *ssadb.IrProgram
+	changes   *FileChangeSet
+	rebuild   map[string]struct{} // relative path
+}
+
+func (s *incrementalState) shouldCompile(c *config, filePath string) bool {
+	if s == nil {
+		return true
+	}
+	_, ok := s.rebuild[c.sourceKey(filePath)]
+	return ok
+}
+
+// DiffProjectFiles compare the source files in file system with the program saved in database,
+// the program name should be set by WithProgramName.
+func DiffProjectFiles(fs fi.FileSystem, opts ...Option) (*FileChangeSet, error) {
+	config := defaultConfig()
+	for _, opt := range opts {
+		opt(config)
+	}
+	if config.err != nil {
+		return nil, config.err
+	}
+	if fs == nil {
+		return nil, utils.Errorf("need set filesystem")
+	}
+	if config.ProgramName == "" {
+		return nil, utils.Errorf("need set program name")
+	}
+	config.fs = fs
+	changes, _ := config.diffProjectFiles(ssadb.GetProgramSourceHashes(ssadb.GetDB(), config.ProgramName))
+	return changes, nil
+}
+
+// sourceKey is the same path as IrSource saved, see config.init
+func (c *config) sourceKey(filePath string) string {
+	folderName, fileName := c.fs.PathSplit(filePath)
+	folders := []string{"/"}
+	folders = append(folders, strings.Split(folderName, string(c.fs.GetSeparators()))...)
+	folders = append(folders, fileName)
+	return path.Join(folders...)
+}
+
+func (c *config) collectProjectFiles() map[string]*projectFile {
+	files := make(map[string]*projectFile)
+	filesys.Recursive(c.programPath,
+		filesys.WithFileSystem(c.fs),
+		filesys.WithDirStat(func(s string, fi fs.FileInfo) error {
+			_, name := c.fs.PathSplit(s)
+			if name == "test" || name == ".git" {
+				return filesys.SkipDir
+			}
+			return nil
+		}),
+		filesys.WithFileStat(func(filePath string, fi fs.FileInfo) error {
+			if fi.Size() == 0 {
+				return nil
+			}
+			if err := c.checkLanguage(filePath); err != nil {
+				return nil
+			}
+			raw, err := c.fs.ReadFile(filePath)
+			if err != nil {
+				log.Warnf("read file %s failed: %v", filePath, err)
+				return nil
+			}
+			code := string(raw)
+			files[c.sourceKey(filePath)] = &projectFile{
+				path: filePath,
+				hash: codec.Sha256(code),
+				code: code,
+			}
+			return nil
+		}),
+	)
+	return files
+}
+
+func (c *config) diffProjectFiles(saved map[string]string) (*FileChangeSet, map[string]*projectFile) {
+	current := c.collectProjectFiles()
+	changes := &FileChangeSet{}
+	for key, file := range current {
+		hash, ok := saved[key]
+		switch {
+		case !ok:
+			changes.Added = append(changes.Added, key)
+		case hash != file.hash:
+			changes.Modified = append(changes.Modified, key)
+		default:
+			changes.Unchanged = append(changes.Unchanged, key)
+		}
+	}
+	for key := range saved {
+		if _, ok := current[key]; !ok {
+			changes.Removed = append(changes.Removed, key)
+		}
+	}
+	for _, list := range [][]string{changes.Added, changes.Modified, changes.Removed, changes.Unchanged} {
+		sort.Strings(list)
+	}
+	return changes, current
+}
+
+// declarationRegexps match the name of class, function or exported value declared in the source,
+// methods and local variables are not included.
+var declarationRegexps = []*regexp.Regexp{
+	regexp.MustCompile(`\b(?:class|interface|enum|trait|struct|union|record|def|func|function|type)\s+([A-Za-z_$][A-Za-z0-9_$]*)`),
+	regexp.MustCompile(`\bexport\s+(?:default\s+)?(?:const|let|var|(?:async\s+)?function\*?)\s+([A-Za-z_$][A-Za-z0-9_$]*)`),
+	regexp.MustCompile(`(?m)^([A-Za-z_$][A-Za-z0-9_$]*)\s*:?=\s*(?:fn|func|function)\b`),
+	// c/c++ function definition start at the beginning of line
+	regexp.MustCompile(`(?m)^[A-Za-z_][\w\s\*&:<>,]*?\b([A-Za-z_]\w*)\s*\([^;{)]*\)\s*\{`),
+}
+
+func declaredNames(code string) []string {
+	var names []string
+	for _, re := range declarationRegexps {
+		for _, match := range re.FindAllStringSubmatch(code, -1) {
+			names = append(names, match[1])
+		}
+	}
+	return names
+}
+
+// prepareIncremental diff the project with the program in database, and delete the ir code of
+// the files that should be re-compiled. the re-compiled files are the changed files and all files
+// related to them:
+//   - files have def-use/call relationship with the changed or removed files in database.
+//   - files define the class or function with the same name declared in the changed or added files.
+//
+// the relationship is calculated until no more file found, so the unchanged files kept in
+// database never reference the re-compiled ir code.
+// return nil state when the program is not existed in database, the project will be fully compiled.
+func (c *config) prepareIncremental() (*incrementalState, error) {
+	if c.ProgramName == "" {
+		return nil, utils.Errorf("incremental compile need program name")
+	}
+	irProgram, err := ssadb.GetProgram(c.ProgramName, string(ssa.Application))
+	if err != nil {
+		log.Infof("program %s not existed in database, compile the whole project", c.ProgramName)
+		return nil, nil
+	}
+
+	db := ssadb.GetDB()
+	saved := ssadb.GetProgramSourceHashes(db, c.ProgramName)
+	changes, current := c.diffProjectFiles(saved)
+	state := &incrementalState{
+		irProgram: irProgram,
+		changes:   changes,
+		rebuild:   make(map[string]struct{}),
+	}
+	log.Infof("incremental compile %s: added %d, modified %d, removed %d, unchanged %d",
+		c.ProgramName, len(changes.Added), len(changes.Modified), len(changes.Removed), len(changes.Unchanged))
+	if !changes.HasChanged() {
+		return state, nil
+	}
+
+	hash2keys := make(map[string][]string)
+	for key, hash := range saved {
+		hash2keys[hash] = append(hash2keys[hash], key)
+	}
+
+	affected := make(map[string]struct{})
+	var frontier []string
+	addHash := func(hashes ...string) {
+		for _, hash := range hashes {
+			if _, ok := affected[hash]; ok {
+				continue
+			}
+			affected[hash] = struct{}{}
+			frontier = append(frontier, hash)
+		}
+	}
+	for _, key := range changes.Modified {
+		addHash(saved[key])
+	}
+	for _, key := range changes.Removed {
+		addHash(saved[key])
+	}
+
+	names := make(map[string]struct{})
+	for _, key := range append(append([]string{}, changes.Added...), changes.Modified...) {
+		state.rebuild[key] = struct{}{}
+		for _, name := range declaredNames(current[key].code) {
+			names[name] = struct{}{}
+		}
+	}
+	nameList := make([]string, 0, len(names))
+	for name := range names {
+		nameList = append(nameList, name)
+	}
+	addHash(ssadb.GetSourceHashesDefineNames(db, c.ProgramName, nameList)...)
+
+	for len(frontier) > 0 {
+		hashes := frontier
+		frontier = nil
+		addHash(ssadb.GetCrossSourceHashes(db, c.ProgramName, hashes)...)
+	}
+
+	for hash := range affected {
+		for _, key := range hash2keys[hash] {
+			if _, ok := current[key]; ok {
+				state.rebuild[key] = struct{}{}
+			}
+		}
+	}
+	log.Infof("incremental compile %s: %d files will be re-compiled", c.ProgramName, len(state.rebuild))
+
+	hashes := make([]string, 0, len(affected))
+	for hash := range affected {
+		hashes = append(hashes, hash)
+	}
+	ssadb.DeleteProgramSources(db, c.ProgramName, hashes)
+	return state, nil
+}
+
+// droppedFiles return the file list name of the program that removed or going to be re-compiled
+func (s *incrementalState) droppedFiles(c *config) []string {
+	removed := make(map[string]struct{}, len(s.changes.Removed))
+	for _, key := range s.changes.Removed {
+		removed[key] = struct{}{}
+	}
+	var ret []string
+	for name := range s.irProgram.FileList {
+		key := c.sourceKey(name)
+		if _, ok := removed[key]; ok {
+			ret = append(ret, name)
+		} else if _, ok := s.rebuild[key]; ok {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
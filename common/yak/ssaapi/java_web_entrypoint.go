@@ -0,0 +1,626 @@
+package ssaapi
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+const (
+	WebFrameworkSpring  = "spring"
+	WebFrameworkJaxRS   = "jax-rs"
+	WebFrameworkServlet = "servlet"
+	WebFrameworkStruts  = "struts"
+)
+
+// the source of the parameter in http request
+const (
+	WebParamPath   = "path"
+	WebParamQuery  = "query"
+	WebParamForm   = "form"
+	WebParamBody   = "body"
+	WebParamHeader = "header"
+	WebParamCookie = "cookie"
+	// the whole request object, like HttpServletRequest
+	WebParamRequest = "request"
+)
+
+// WebEntrypointParam is the user controlled value of the entrypoint,
+// the value is the formal parameter of handler or the call like `request.getParameter("name")`.
+type WebEntrypointParam struct {
+	Name   string
+	Source string
+	Value  *Value
+}
+
+// WebEntrypoint is the http handler of java web framework, the route is joined with the prefix of class,
+// the empty methods means all http methods are accepted.
+type WebEntrypoint struct {
+	Framework string
+	Routes    []string
+	Methods   []string
+	Handler   *Value
+	Params    []*WebEntrypointParam
+}
+
+func (e *WebEntrypoint) String() string {
+	methods := "*"
+	if len(e.Methods) > 0 {
+		methods = strings.Join(e.Methods, "|")
+	}
+	var params []string
+	for _, p := range e.Params {
+		params = append(params, fmt.Sprintf("%s:%s", p.Source, p.Name))
+	}
+	return fmt.Sprintf("[%s] %s %s -> %s(%s)", e.Framework, methods, strings.Join(e.Routes, ","), e.Handler.GetName(), strings.Join(params, ", "))
+}
+
+var (
+	httpMethodRegexp = regexp.MustCompile(`\b(GET|POST|PUT|DELETE|PATCH|HEAD|OPTIONS|TRACE)\b`)
+
+	springMappingMethods = map[string]string{
+		"RequestMapping": "",
+		"GetMapping":     "GET",
+		"PostMapping":    "POST",
+		"PutMapping":     "PUT",
+		"DeleteMapping":  "DELETE",
+		"PatchMapping":   "PATCH",
+	}
+	springParamSources = map[string]string{
+		"PathVariable":   WebParamPath,
+		"RequestParam":   WebParamQuery,
+		"RequestBody":    WebParamBody,
+		"RequestHeader":  WebParamHeader,
+		"CookieValue":    WebParamCookie,
+		"ModelAttribute": WebParamForm,
+		"RequestPart":    WebParamForm,
+		"MatrixVariable": WebParamPath,
+	}
+
+	jaxrsHttpMethods  = []string{"GET", "POST", "PUT", "DELETE", "PATCH", "HEAD", "OPTIONS"}
+	jaxrsParamSources = map[string]string{
+		"PathParam":   WebParamPath,
+		"QueryParam":  WebParamQuery,
+		"FormParam":   WebParamForm,
+		"HeaderParam": WebParamHeader,
+		"CookieParam": WebParamCookie,
+		"MatrixParam": WebParamPath,
+		"BeanParam":   WebParamForm,
+	}
+
+	servletHandlerMethods = map[string]string{
+		"doGet":     "GET",
+		"doPost":    "POST",
+		"doPut":     "PUT",
+		"doDelete":  "DELETE",
+		"doPatch":   "PATCH",
+		"doHead":    "HEAD",
+		"doOptions": "OPTIONS",
+		"service":   "",
+	}
+	// the method of request object which return user controlled data
+	servletRequestGetters = map[string]string{
+		"getParameter":       WebParamQuery,
+		"getParameterValues": WebParamQuery,
+		"getParameterMap":    WebParamQuery,
+		"getParameterNames":  WebParamQuery,
+		"getQueryString":     WebParamQuery,
+		"getPathInfo":        WebParamPath,
+		"getRequestURI":      WebParamPath,
+		"getHeader":          WebParamHeader,
+		"getHeaders":         WebParamHeader,
+		"getCookies":         WebParamCookie,
+		"getInputStream":     WebParamBody,
+		"getReader":          WebParamBody,
+		"getPart":            WebParamForm,
+		"getParts":           WebParamForm,
+	}
+
+	// the formal parameters with these types are not controlled by user
+	javaWebIgnoreParamTypes = []string{
+		"Response", "Model", "ModelMap", "BindingResult", "Errors", "HttpSession",
+		"Principal", "RedirectAttributes", "Locale", "SessionStatus", "UriComponentsBuilder",
+	}
+	javaWebRequestParamTypes = []string{"ServletRequest", "WebRequest", "HttpRequest"}
+)
+
+// GetJavaWebEntrypoints recognise the http entrypoints of Spring MVC, JAX-RS, Servlet and Struts2 in program.
+func (p *Program) GetJavaWebEntrypoints() []*WebEntrypoint {
+	if p == nil {
+		return nil
+	}
+	var ret []*WebEntrypoint
+	ret = append(ret, p.springEntrypoints()...)
+	ret = append(ret, p.jaxrsEntrypoints()...)
+	ret = append(ret, p.servletEntrypoints()...)
+	ret = append(ret, p.strutsEntrypoints()...)
+	return ret
+}
+
+// queryWebValues run the syntaxflow and return the values of `$output`, every value only appears once.
+func (p *Program) queryWebValues(code string) Values {
+	result, err := p.SyntaxFlowWithError(code)
+	if err != nil {
+		log.Debugf("query web entrypoint %#v failed: %v", code, err)
+		return nil
+	}
+	var ret Values
+	visited := make(map[int64]struct{})
+	for _, v := range result.GetValues("output") {
+		if _, ok := visited[v.GetId()]; ok {
+			continue
+		}
+		visited[v.GetId()] = struct{}{}
+		ret = append(ret, v)
+	}
+	return ret
+}
+
+// javaClassAnnotations return the class container annotated by the annotation, the key is the id of method in class.
+func (p *Program) javaClassAnnotations(annotation string) map[int64]*Value {
+	ret := make(map[int64]*Value)
+	for _, class := range p.queryWebValues(annotation + ".__ref__ as $output") {
+		if _, ok := ssa.ToMake(class.node); !ok {
+			continue
+		}
+		for _, member := range class.GetAllMember() {
+			if member.IsFunction() {
+				ret[member.GetId()] = class
+			}
+		}
+	}
+	return ret
+}
+
+// javaAnnotations return the annotation containers of value, like `value.annotation.GetMapping`
+func javaAnnotations(v *Value, name string) Values {
+	if v == nil {
+		return nil
+	}
+	var ret Values
+	for _, kv := range v.GetMembers() {
+		if codec.AnyToString(kv[0].GetConstValue()) != "annotation" {
+			continue
+		}
+		for _, annotation := range kv[1].GetMembers() {
+			key := codec.AnyToString(annotation[0].GetConstValue())
+			if key == name || strings.HasSuffix(key, "."+name) {
+				ret = append(ret, annotation[1])
+			}
+		}
+	}
+	return ret
+}
+
+// javaAnnotationValues return the string values of the first existed key in annotations,
+// the array value like `{"/a", "/b"}` is flattened.
+func javaAnnotationValues(annotations Values, keys ...string) []string {
+	for _, key := range keys {
+		for _, annotation := range annotations {
+			for _, kv := range annotation.GetMembers() {
+				if codec.AnyToString(kv[0].GetConstValue()) != key {
+					continue
+				}
+				if ret := javaAnnotationStrings(kv[1]); len(ret) > 0 {
+					return ret
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func javaAnnotationStrings(v *Value) []string {
+	if v.IsConstInst() {
+		return []string{codec.AnyToString(v.GetConstValue())}
+	}
+	if _, ok := ssa.ToMake(v.node); ok {
+		members := v.GetMembers()
+		sort.SliceStable(members, func(i, j int) bool {
+			return codec.Atoi(codec.AnyToString(members[i][0].GetConstValue())) < codec.Atoi(codec.AnyToString(members[j][0].GetConstValue()))
+		})
+		var ret []string
+		for _, kv := range members {
+			ret = append(ret, javaAnnotationStrings(kv[1])...)
+		}
+		return ret
+	}
+	// the enum value like `RequestMethod.POST` is undefined, use the source code
+	if rng := v.GetRange(); rng != nil {
+		if text := strings.TrimSpace(rng.GetText()); text != "" {
+			return []string{text}
+		}
+	}
+	return nil
+}
+
+func joinWebRoutes(prefixes, paths []string) []string {
+	if len(prefixes) == 0 {
+		prefixes = []string{""}
+	}
+	if len(paths) == 0 {
+		paths = []string{""}
+	}
+	var ret []string
+	for _, prefix := range prefixes {
+		for _, p := range paths {
+			route := path.Join("/", prefix, p)
+			if strings.HasSuffix(p, "/") && route != "/" {
+				route += "/"
+			}
+			ret = append(ret, route)
+		}
+	}
+	return utils.RemoveRepeatStringSlice(ret)
+}
+
+func parseHttpMethods(raw []string) []string {
+	var ret []string
+	for _, item := range raw {
+		ret = append(ret, httpMethodRegexp.FindAllString(strings.ToUpper(item), -1)...)
+	}
+	return utils.RemoveRepeatStringSlice(ret)
+}
+
+// javaMethodName return the method name of handler, the function name is `Class_method` in java2ssa.
+func javaMethodName(handler *Value) string {
+	name := handler.GetName()
+	if f, ok := ssa.ToFunction(handler.node); ok && f.GetMethodName() != "" {
+		return f.GetMethodName()
+	}
+	if index := strings.LastIndex(name, "_"); index >= 0 {
+		return name[index+1:]
+	}
+	return name
+}
+
+func javaClassName(handler *Value) string {
+	if bp, ok := handler.GetFunctionObjectType().(*ssa.ClassBluePrint); ok && bp != nil {
+		return bp.Name
+	}
+	name := handler.GetName()
+	if index := strings.LastIndex(name, "_"); index >= 0 {
+		return name[:index]
+	}
+	return ""
+}
+
+// javaTypeMatch check the type name of value, the full type names are checked for the class not in program
+func javaTypeMatch(v *Value, names []string) bool {
+	typeNames := []string{v.GetType().String()}
+	if typ := v.GetType().t; typ != nil {
+		typeNames = append(typeNames, typ.GetFullTypeNames()...)
+	}
+	for _, typeName := range typeNames {
+		for _, name := range names {
+			if strings.Contains(typeName, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// javaFormalParams return the formal parameters of handler without `this`
+func javaFormalParams(handler *Value) Values {
+	var ret Values
+	for _, param := range handler.GetParameters() {
+		if param.GetName() == "this" {
+			continue
+		}
+		ret = append(ret, param)
+	}
+	return ret
+}
+
+// javaRequestGetterParams find the user controlled data from request object in handler,
+// like `request.getParameter("name")`.
+func (p *Program) javaRequestGetterParams(handlers Values) map[int64][]*WebEntrypointParam {
+	ret := make(map[int64][]*WebEntrypointParam)
+	if len(handlers) == 0 {
+		return ret
+	}
+	handlerIDs := make(map[int64]struct{})
+	for _, handler := range handlers {
+		handlerIDs[handler.GetId()] = struct{}{}
+	}
+	var getters []string
+	for getter := range servletRequestGetters {
+		getters = append(getters, getter)
+	}
+	sort.Strings(getters)
+	code := fmt.Sprintf("./^(%s)$/() as $output", strings.Join(getters, "|"))
+	for _, call := range p.queryWebValues(code) {
+		fun := call.GetFunction()
+		if fun == nil {
+			continue
+		}
+		if _, ok := handlerIDs[fun.GetId()]; !ok {
+			continue
+		}
+		method := ""
+		if callee := call.GetCallee(); callee != nil {
+			method = callee.GetName()
+			if index := strings.LastIndexAny(method, "._"); index >= 0 {
+				method = method[index+1:]
+			}
+		}
+		source, ok := servletRequestGetters[method]
+		if !ok {
+			continue
+		}
+		name := method
+		if args := call.GetCallArgs(); len(args) > 0 {
+			for _, arg := range args {
+				if arg.IsConstInst() {
+					name = codec.AnyToString(arg.GetConstValue())
+					break
+				}
+			}
+		}
+		ret[fun.GetId()] = append(ret[fun.GetId()], &WebEntrypointParam{Name: name, Source: source, Value: call})
+	}
+	return ret
+}
+
+func (p *Program) springEntrypoints() []*WebEntrypoint {
+	handlers := p.queryWebValues("*Mapping.__ref__?{opcode: function} as $output")
+	if len(handlers) == 0 {
+		return nil
+	}
+	classes := p.javaClassAnnotations("RequestMapping")
+	getters := p.javaRequestGetterParams(handlers)
+
+	var ret []*WebEntrypoint
+	for _, handler := range handlers {
+		var (
+			found   bool
+			paths   []string
+			methods []string
+		)
+		for name, method := range springMappingMethods {
+			annotations := javaAnnotations(handler, name)
+			if len(annotations) == 0 {
+				continue
+			}
+			found = true
+			paths = append(paths, javaAnnotationValues(annotations, "value", "path")...)
+			if method != "" {
+				methods = append(methods, method)
+			} else {
+				methods = append(methods, javaAnnotationValues(annotations, "method")...)
+			}
+		}
+		if !found {
+			continue
+		}
+		var prefixes []string
+		if class, ok := classes[handler.GetId()]; ok {
+			prefixes = javaAnnotationValues(javaAnnotations(class, "RequestMapping"), "value", "path")
+		}
+		entry := &WebEntrypoint{
+			Framework: WebFrameworkSpring,
+			Routes:    joinWebRoutes(prefixes, paths),
+			Methods:   parseHttpMethods(methods),
+			Handler:   handler,
+		}
+		for _, param := range javaFormalParams(handler) {
+			if item := javaAnnotatedParam(param, springParamSources, WebParamQuery); item != nil {
+				entry.Params = append(entry.Params, item)
+			}
+		}
+		entry.Params = append(entry.Params, getters[handler.GetId()]...)
+		ret = append(ret, entry)
+	}
+	return ret
+}
+
+// javaAnnotatedParam create the entrypoint param by the annotation of formal parameter,
+// the parameter without annotation use the default source.
+func javaAnnotatedParam(param *Value, sources map[string]string, defaultSource string) *WebEntrypointParam {
+	for annotation, source := range sources {
+		annotations := javaAnnotations(param, annotation)
+		if len(annotations) == 0 {
+			continue
+		}
+		name := param.GetName()
+		if names := javaAnnotationValues(annotations, "value", "name"); len(names) > 0 && names[0] != "" {
+			name = names[0]
+		}
+		return &WebEntrypointParam{Name: name, Source: source, Value: param}
+	}
+	if len(javaAnnotations(param, "Context")) > 0 && !javaTypeMatch(param, javaWebRequestParamTypes) {
+		// jax-rs inject the context object like UriInfo
+		return nil
+	}
+	if javaTypeMatch(param, javaWebRequestParamTypes) {
+		return &WebEntrypointParam{Name: param.GetName(), Source: WebParamRequest, Value: param}
+	}
+	if javaTypeMatch(param, javaWebIgnoreParamTypes) {
+		return nil
+	}
+	return &WebEntrypointParam{Name: param.GetName(), Source: defaultSource, Value: param}
+}
+
+func (p *Program) jaxrsEntrypoints() []*WebEntrypoint {
+	handlers := p.queryWebValues(fmt.Sprintf(
+		"/^(%s|Path)$/.__ref__?{opcode: function} as $output", strings.Join(jaxrsHttpMethods, "|"),
+	))
+	if len(handlers) == 0 {
+		return nil
+	}
+	classes := p.javaClassAnnotations("Path")
+
+	var ret []*WebEntrypoint
+	for _, handler := range handlers {
+		var methods []string
+		for _, method := range jaxrsHttpMethods {
+			if len(javaAnnotations(handler, method)) > 0 {
+				methods = append(methods, method)
+			}
+		}
+		paths := javaAnnotationValues(javaAnnotations(handler, "Path"), "value")
+		var prefixes []string
+		if class, ok := classes[handler.GetId()]; ok {
+			prefixes = javaAnnotationValues(javaAnnotations(class, "Path"), "value")
+		}
+		if len(methods) == 0 && len(prefixes) == 0 && len(paths) == 0 {
+			continue
+		}
+		entry := &WebEntrypoint{
+			Framework: WebFrameworkJaxRS,
+			Routes:    joinWebRoutes(prefixes, paths),
+			Methods:   methods,
+			Handler:   handler,
+		}
+		for _, param := range javaFormalParams(handler) {
+			if item := javaAnnotatedParam(param, jaxrsParamSources, WebParamBody); item != nil {
+				entry.Params = append(entry.Params, item)
+			}
+		}
+		ret = append(ret, entry)
+	}
+	return ret
+}
+
+func (p *Program) servletEntrypoints() []*WebEntrypoint {
+	var names []string
+	for name := range servletHandlerMethods {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	handlers := p.queryWebValues(fmt.Sprintf("/^(%s)$/?{opcode: function} as $output", strings.Join(names, "|")))
+	if len(handlers) == 0 {
+		return nil
+	}
+	classes := p.javaClassAnnotations("WebServlet")
+
+	var servlets Values
+	for _, handler := range handlers {
+		params := javaFormalParams(handler)
+		_, annotated := classes[handler.GetId()]
+		if !annotated && (len(params) == 0 || !javaTypeMatch(params[0], javaWebRequestParamTypes)) {
+			continue
+		}
+		servlets = append(servlets, handler)
+	}
+	getters := p.javaRequestGetterParams(servlets)
+
+	var ret []*WebEntrypoint
+	for _, handler := range servlets {
+		entry := &WebEntrypoint{
+			Framework: WebFrameworkServlet,
+			Handler:   handler,
+		}
+		if method := servletHandlerMethods[javaMethodName(handler)]; method != "" {
+			entry.Methods = []string{method}
+		}
+		if class, ok := classes[handler.GetId()]; ok {
+			routes := javaAnnotationValues(javaAnnotations(class, "WebServlet"), "value", "urlPatterns")
+			if len(routes) > 0 {
+				entry.Routes = joinWebRoutes(nil, routes)
+			}
+		}
+		if params := javaFormalParams(handler); len(params) > 0 {
+			entry.Params = append(entry.Params, &WebEntrypointParam{Name: params[0].GetName(), Source: WebParamRequest, Value: params[0]})
+		}
+		entry.Params = append(entry.Params, getters[handler.GetId()]...)
+		ret = append(ret, entry)
+	}
+	return ret
+}
+
+// strutsEntrypoints find the struts2 actions, the action is the method annotated by `@Action` or
+// the `execute` method of class extends ActionSupport, the setters of action class are the parameters.
+func (p *Program) strutsEntrypoints() []*WebEntrypoint {
+	handlers := p.queryWebValues("Action.__ref__?{opcode: function} as $output")
+	annotated := make(map[int64]struct{})
+	for _, handler := range handlers {
+		annotated[handler.GetId()] = struct{}{}
+	}
+	for _, handler := range p.queryWebValues("execute?{opcode: function} as $output") {
+		if _, ok := annotated[handler.GetId()]; ok {
+			continue
+		}
+		if bp, ok := handler.GetFunctionObjectType().(*ssa.ClassBluePrint); ok && bp != nil {
+			isAction := false
+			var parents []string
+			for _, parent := range bp.ParentClass {
+				if parent != nil {
+					parents = append(parents, parent.Name)
+				}
+			}
+			// the blueprint loaded from database only keeps the full type names of parents
+			if names := bp.GetFullTypeNames(); len(names) > 1 {
+				parents = append(parents, names[1:]...)
+			}
+			for _, parent := range parents {
+				if strings.HasSuffix(parent, "Action") || strings.HasSuffix(parent, "ActionSupport") {
+					isAction = true
+				}
+			}
+			if !isAction {
+				continue
+			}
+		}
+		handlers = append(handlers, handler)
+	}
+	if len(handlers) == 0 {
+		return nil
+	}
+	namespaces := p.javaClassAnnotations("Namespace")
+
+	setters := make(map[string][]*WebEntrypointParam)
+	for _, setter := range p.queryWebValues("/^set[A-Z]/?{opcode: function} as $output") {
+		params := javaFormalParams(setter)
+		if len(params) != 1 {
+			continue
+		}
+		name := strings.TrimPrefix(javaMethodName(setter), "set")
+		name = strings.ToLower(name[:1]) + name[1:]
+		class := javaClassName(setter)
+		setters[class] = append(setters[class], &WebEntrypointParam{Name: name, Source: WebParamForm, Value: params[0]})
+	}
+
+	var ret []*WebEntrypoint
+	for _, handler := range handlers {
+		var prefixes []string
+		if class, ok := namespaces[handler.GetId()]; ok {
+			prefixes = javaAnnotationValues(javaAnnotations(class, "Namespace"), "value")
+		}
+		routes := javaAnnotationValues(javaAnnotations(handler, "Action"), "value")
+		if len(routes) == 0 {
+			// convention plugin: UserListAction -> user-list
+			routes = []string{strutsConventionActionName(javaClassName(handler))}
+		}
+		ret = append(ret, &WebEntrypoint{
+			Framework: WebFrameworkStruts,
+			Routes:    joinWebRoutes(prefixes, routes),
+			Handler:   handler,
+			Params:    setters[javaClassName(handler)],
+		})
+	}
+	return ret
+}
+
+func strutsConventionActionName(class string) string {
+	class = strings.TrimSuffix(class, "Action")
+	var buf strings.Builder
+	for i, r := range class {
+		if r >= 'A' && r <= 'Z' {
+			if i > 0 {
+				buf.WriteByte('-')
+			}
+			r += 'a' - 'A'
+		}
+		buf.WriteRune(r)
+	}
+	return buf.String()
+}
@@ -13,9 +13,11 @@ import (
 	"github.com/yaklang/yaklang/common/utils/filesys"
 	"github.com/yaklang/yaklang/common/utils/memedit"
 	js2ssa "github.com/yaklang/yaklang/common/yak/JS2ssa"
+	"github.com/yaklang/yaklang/common/yak/c/c2ssa"
 	"github.com/yaklang/yaklang/common/yak/go2ssa"
 	"github.com/yaklang/yaklang/common/yak/java/java2ssa"
 	"github.com/yaklang/yaklang/common/yak/php/php2ssa"
+	"github.com/yaklang/yaklang/common/yak/python/python2ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa"
 	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
 	"github.com/yaklang/yaklang/common/yak/ssa4analyze"
@@ -30,6 +32,8 @@ const (
 	PHP  = consts.PHP
 	JAVA = consts.JAVA
 	GO   = consts.GO
+	PY   = consts.PY
+	C    = consts.C
 )
 
 var LanguageBuilders = map[consts.Language]ssa.Builder{
@@ -38,6 +42,8 @@ var LanguageBuilders = map[consts.Language]ssa.Builder{
 	PHP:  php2ssa.Builder,
 	JAVA: java2ssa.Builder,
 	GO:   go2ssa.Builder,
+	PY:   python2ssa.Builder,
+	C:    c2ssa.Builder,
 }
 
 var AllLanguageBuilders = []ssa.Builder{
@@ -47,6 +53,8 @@ var AllLanguageBuilders = []ssa.Builder{
 	yak2ssa.Builder,
 	js2ssa.Builder,
 	go2ssa.Builder,
+	python2ssa.Builder,
+	c2ssa.Builder,
 }
 
 func (c *config) parseProject() (Programs, error) {
@@ -59,13 +67,31 @@ func (c *config) parseProject() (Programs, error) {
 	if c.databasePath != "" {
 		consts.SetSSADataBasePath(c.databasePath)
 	}
+	var incremental *incrementalState
+	if c.incremental {
+		state, err := c.prepareIncremental()
+		if err != nil {
+			return nil, err
+		}
+		if state != nil && !state.changes.HasChanged() {
+			log.Infof("program %s not changed, load from database", c.ProgramName)
+			prog, err := c.fromDatabase()
+			if err != nil {
+				return nil, err
+			}
+			return Programs{prog}, nil
+		}
+		incremental = state
+	}
 	programPath := c.programPath
 	prog, builder, err := c.init()
 
 	if err != nil {
 		return nil, err
 	}
-	if prog.Name != "" {
+	if incremental != nil {
+		prog.ContinueFromDatabase(incremental.irProgram, incremental.droppedFiles(c)...)
+	} else if prog.Name != "" {
 		ssadb.SaveFolder(prog.Name, []string{"/"})
 	}
 
@@ -96,6 +122,9 @@ func (c *config) parseProject() (Programs, error) {
 			if fi.Size() == 0 {
 				return nil
 			}
+			if !incremental.shouldCompile(c, path) {
+				return nil
+			}
 			// check
 			if err := c.checkLanguagePreHandler(path); err == nil {
 				if language := c.LanguageBuilder; language != nil {
@@ -132,6 +161,9 @@ func (c *config) parseProject() (Programs, error) {
 			if err := c.checkLanguage(path); err != nil {
 				return nil, err
 			}
+			if !incremental.shouldCompile(c, path) {
+				return nil, utils.Wrapf(ssareducer.SkippedError, "file[%s] is not changed, skip in incremental compile", path)
+			}
 
 			// build
 			if err := prog.Build(path, memedit.NewMemEditor(raw), builder); err != nil {
@@ -157,9 +189,8 @@ func (c *config) parseProject() (Programs, error) {
 	//for _, program := range prog.ChildApplication {
 	//	progs = append(progs, NewProgram(program, c))
 	//}
-	if c.SaveToProfile {
+	if c.SaveToProfile && (incremental == nil || ssadb.GetSSAProgram(c.ProgramName) == nil) {
 		ssadb.SaveSSAProgram(c.ProgramName, c.ProgramDescription, string(c.language))
-
 	}
 	return progs, nil
 }
@@ -0,0 +1,181 @@
+package ssaapi
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+type SyntaxFlowRiskState string
+
+const (
+	// risk only existed in head result
+	SyntaxFlowRiskNew SyntaxFlowRiskState = "new"
+	// risk only existed in baseline result
+	SyntaxFlowRiskFixed SyntaxFlowRiskState = "fixed"
+	// risk existed in both result
+	SyntaxFlowRiskUnchanged SyntaxFlowRiskState = "unchanged"
+)
+
+// SyntaxFlowRisk is a alert value of SyntaxFlowResult with a stable fingerprint,
+// the fingerprint do not contain line number, so it will not change when the code is moved.
+type SyntaxFlowRisk struct {
+	Fingerprint string
+	Rule        string
+	Variable    string
+	Value       *Value
+	Result      *SyntaxFlowResult
+	State       SyntaxFlowRiskState
+}
+
+func (r *SyntaxFlowRisk) String() string {
+	return utils.ShrinkString(r.Value.StringWithRange(), 100)
+}
+
+type SyntaxFlowBaselineDiff struct {
+	New       []*SyntaxFlowRisk
+	Fixed     []*SyntaxFlowRisk
+	Unchanged []*SyntaxFlowRisk
+}
+
+// ruleIdentity return the stable identity of the rule,
+// the rule loaded from file may not have rule name, use the content hash instead.
+func (r *SyntaxFlowResult) ruleIdentity() string {
+	if r == nil || r.rule == nil {
+		return ""
+	}
+	switch {
+	case r.rule.RuleName != "":
+		return r.rule.RuleName
+	case r.rule.Content != "":
+		return codec.Sha256(r.rule.Content)
+	default:
+		return r.rule.Title
+	}
+}
+
+// GetRisks return all alert values of the result as risk.
+func (r *SyntaxFlowResult) GetRisks() []*SyntaxFlowRisk {
+	if r == nil {
+		return nil
+	}
+	rule := r.ruleIdentity()
+	var ret []*SyntaxFlowRisk
+	for _, name := range r.GetAlertVariables() {
+		for _, v := range r.GetValues(name) {
+			if v == nil {
+				continue
+			}
+			ret = append(ret, &SyntaxFlowRisk{
+				Fingerprint: SyntaxFlowRiskFingerprint(rule, name, v),
+				Rule:        rule,
+				Variable:    name,
+				Value:       v,
+				Result:      r,
+			})
+		}
+	}
+	return ret
+}
+
+// normalizeRiskCode remove all whitespace of code, so the format change will not affect fingerprint.
+func normalizeRiskCode(code string) string {
+	return strings.Join(strings.Fields(code), "")
+}
+
+func valueRiskCode(v *Value) string {
+	if v == nil {
+		return ""
+	}
+	if rng := v.GetRange(); rng != nil {
+		if text := rng.GetText(); text != "" {
+			return normalizeRiskCode(text)
+		}
+	}
+	return normalizeRiskCode(v.String())
+}
+
+// riskSourceCodes collect the code of the source (the root of predecessors) of the value.
+func riskSourceCodes(v *Value) []string {
+	visited := make(map[int64]struct{})
+	sources := make(map[string]struct{})
+	var walk func(*Value)
+	walk = func(v *Value) {
+		if v == nil {
+			return
+		}
+		if _, ok := visited[v.GetId()]; ok {
+			return
+		}
+		visited[v.GetId()] = struct{}{}
+		if len(v.Predecessors) == 0 {
+			sources[valueRiskCode(v)] = struct{}{}
+			return
+		}
+		for _, pred := range v.Predecessors {
+			walk(pred.Node)
+		}
+	}
+	for _, pred := range v.Predecessors {
+		walk(pred.Node)
+	}
+	ret := make([]string, 0, len(sources))
+	for code := range sources {
+		ret = append(ret, code)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+// SyntaxFlowRiskFingerprint calculate the fingerprint of alert value by
+// rule, variable, file, function and the normalized code of sink and source,
+// line number and whitespace is not included, so unrelated changes will not affect it.
+func SyntaxFlowRiskFingerprint(rule, variable string, v *Value) string {
+	var filename, function string
+	if rng := v.GetRange(); rng != nil && rng.GetEditor() != nil {
+		filename = rng.GetEditor().GetFilename()
+	}
+	if fun := v.GetFunction(); fun != nil {
+		function = fun.GetName()
+	}
+	parts := []string{rule, variable, filename, function, valueRiskCode(v)}
+	parts = append(parts, riskSourceCodes(v)...)
+	return codec.Sha256(strings.Join(parts, "\x00"))
+}
+
+// DiffSyntaxFlowResults compare the risks of head results with baseline results by fingerprint,
+// the same fingerprint appear multiple times will be matched one by one.
+func DiffSyntaxFlowResults(baseline, head []*SyntaxFlowResult) *SyntaxFlowBaselineDiff {
+	diff := &SyntaxFlowBaselineDiff{}
+	var baseRisks []*SyntaxFlowRisk
+	pending := make(map[string][]*SyntaxFlowRisk)
+	for _, result := range baseline {
+		for _, risk := range result.GetRisks() {
+			baseRisks = append(baseRisks, risk)
+			pending[risk.Fingerprint] = append(pending[risk.Fingerprint], risk)
+		}
+	}
+	for _, result := range head {
+		for _, risk := range result.GetRisks() {
+			if matched := pending[risk.Fingerprint]; len(matched) > 0 {
+				pending[risk.Fingerprint] = matched[1:]
+				matched[0].State = SyntaxFlowRiskUnchanged
+				risk.State = SyntaxFlowRiskUnchanged
+				diff.Unchanged = append(diff.Unchanged, risk)
+				continue
+			}
+			risk.State = SyntaxFlowRiskNew
+			diff.New = append(diff.New, risk)
+		}
+	}
+	for _, risk := range baseRisks {
+		if risk.State == SyntaxFlowRiskUnchanged {
+			continue
+		}
+		risk.State = SyntaxFlowRiskFixed
+		diff.Fixed = append(diff.Fixed, risk)
+	}
+	return diff
+}
@@ -0,0 +1,276 @@
+package ssaapi
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/dot"
+)
+
+// the reason of the edge between two steps in data flow path
+const (
+	// DataFlowEdgePredecessor is the syntaxflow analysis step, like `#->` or native call
+	DataFlowEdgePredecessor = "predecessor"
+	// DataFlowEdgeDependOn means the next step depends on this step (use-def chain)
+	DataFlowEdgeDependOn = "depend-on"
+	// DataFlowEdgeEffectOn means this step is effected by the next step (def-use chain)
+	DataFlowEdgeEffectOn = "effect-on"
+)
+
+const (
+	dataFlowPathLimit      = 32
+	dataFlowPathDepthLimit = 128
+	// the max count of values visited when search paths of one value
+	dataFlowPathVisitLimit = 4096
+)
+
+// DataFlowStep is a step in data flow path, Edge/EdgeLabel describe why the data flow from this step to the next one.
+type DataFlowStep struct {
+	Index       int    `json:"index"`
+	ValueID     int64  `json:"value_id"`
+	Value       string `json:"value"`
+	OpCode      string `json:"opcode"`
+	File        string `json:"file"`
+	StartLine   int    `json:"start_line"`
+	StartColumn int    `json:"start_column"`
+	EndLine     int    `json:"end_line"`
+	EndColumn   int    `json:"end_column"`
+	// empty for the last step
+	Edge      string `json:"edge,omitempty"`
+	EdgeLabel string `json:"edge_label,omitempty"`
+
+	value *Value
+}
+
+func (s *DataFlowStep) GetValue() *Value {
+	return s.value
+}
+
+func (s *DataFlowStep) Location() string {
+	if s.StartLine <= 0 {
+		return s.File
+	}
+	return fmt.Sprintf("%s:%d:%d", s.File, s.StartLine, s.StartColumn)
+}
+
+func (s *DataFlowStep) EdgeString() string {
+	if s.EdgeLabel == "" {
+		return s.Edge
+	}
+	return s.Edge + ": " + s.EdgeLabel
+}
+
+// DataFlowPath is the explanation of why a value is in the result of syntaxflow,
+// the first step is the origin of analysis and the last step is the result value.
+type DataFlowPath struct {
+	Steps []*DataFlowStep `json:"steps"`
+}
+
+func (p *DataFlowPath) First() *DataFlowStep {
+	if p == nil || len(p.Steps) == 0 {
+		return nil
+	}
+	return p.Steps[0]
+}
+
+func (p *DataFlowPath) Last() *DataFlowStep {
+	if p == nil || len(p.Steps) == 0 {
+		return nil
+	}
+	return p.Steps[len(p.Steps)-1]
+}
+
+func (p *DataFlowPath) String() string {
+	var buf strings.Builder
+	for _, step := range p.Steps {
+		buf.WriteString(fmt.Sprintf("%d. [%-6s] %s\t%s\n", step.Index, step.OpCode, utils.ShrinkString(step.Value, 64), step.Location()))
+		if step.Edge != "" {
+			buf.WriteString(fmt.Sprintf("   --(%s)-->\n", step.EdgeString()))
+		}
+	}
+	return buf.String()
+}
+
+func newDataFlowStep(v *Value) *DataFlowStep {
+	step := &DataFlowStep{
+		ValueID: v.GetId(),
+		Value:   v.String(),
+		OpCode:  v.GetOpcode(),
+		value:   v,
+	}
+	rng := v.GetRange()
+	if rng == nil {
+		return step
+	}
+	if editor := rng.GetEditor(); editor != nil {
+		step.File = editor.GetFilename()
+		if step.File == "" {
+			step.File = "[md5:" + editor.SourceCodeMd5() + "]"
+		}
+	}
+	if start := rng.GetStart(); start != nil {
+		step.StartLine, step.StartColumn = start.GetLine(), start.GetColumn()
+	}
+	if end := rng.GetEnd(); end != nil {
+		step.EndLine, step.EndColumn = end.GetLine(), end.GetColumn()
+	}
+	return step
+}
+
+type dataFlowPathEdge struct {
+	value *Value
+	kind  string
+	label string
+}
+
+// dataFlowPathEdges return the previous values of v, the predecessors from syntaxflow are preferred,
+// the use-def chain is used when no predecessor, just like the analysis order.
+func dataFlowPathEdges(v *Value) []dataFlowPathEdge {
+	var ret []dataFlowPathEdge
+	for _, pred := range v.Predecessors {
+		if pred == nil || pred.Node == nil {
+			continue
+		}
+		var label string
+		if pred.Info != nil {
+			label = pred.Info.Label
+			if pred.Info.Step > 0 {
+				label = fmt.Sprintf("step[%v]: %v", pred.Info.Step, label)
+			}
+		}
+		ret = append(ret, dataFlowPathEdge{value: pred.Node, kind: DataFlowEdgePredecessor, label: label})
+	}
+	if len(ret) > 0 {
+		return ret
+	}
+	for _, dep := range v.DependOn {
+		ret = append(ret, dataFlowPathEdge{value: dep, kind: DataFlowEdgeDependOn})
+	}
+	for _, eff := range v.EffectOn {
+		ret = append(ret, dataFlowPathEdge{value: eff, kind: DataFlowEdgeEffectOn})
+	}
+	return ret
+}
+
+// GetDataFlowPaths return the step-by-step paths from the origin of analysis to the value,
+// the paths are built from the syntaxflow predecessors and the use-def chain.
+func (v *Value) GetDataFlowPaths() []*DataFlowPath {
+	if v == nil || v.IsNil() {
+		return nil
+	}
+	var paths []*DataFlowPath
+	visitCount := 0
+	onPath := make(map[*Value]struct{})
+	// stack is from the result value to the current value
+	var stack []dataFlowPathEdge
+
+	var dfs func(edge dataFlowPathEdge)
+	dfs = func(edge dataFlowPathEdge) {
+		if len(paths) >= dataFlowPathLimit {
+			return
+		}
+		visitCount++
+		stack = append(stack, edge)
+		onPath[edge.value] = struct{}{}
+		defer func() {
+			stack = stack[:len(stack)-1]
+			delete(onPath, edge.value)
+		}()
+
+		var nexts []dataFlowPathEdge
+		if len(stack) < dataFlowPathDepthLimit && visitCount < dataFlowPathVisitLimit {
+			for _, next := range dataFlowPathEdges(edge.value) {
+				if _, ok := onPath[next.value]; ok {
+					continue
+				}
+				nexts = append(nexts, next)
+			}
+		}
+		if len(nexts) == 0 {
+			paths = append(paths, newDataFlowPath(stack))
+			return
+		}
+		for _, next := range nexts {
+			dfs(next)
+		}
+	}
+	dfs(dataFlowPathEdge{value: v})
+	return paths
+}
+
+// newDataFlowPath reverse the stack to path, the edge of stack[i] is from stack[i].value to stack[i-1].value
+func newDataFlowPath(stack []dataFlowPathEdge) *DataFlowPath {
+	path := &DataFlowPath{}
+	for i := len(stack) - 1; i >= 0; i-- {
+		step := newDataFlowStep(stack[i].value)
+		step.Index = len(path.Steps)
+		if i > 0 {
+			step.Edge, step.EdgeLabel = stack[i].kind, stack[i].label
+		}
+		path.Steps = append(path.Steps, step)
+	}
+	return path
+}
+
+// DataFlowPathDotGraph render the data flow paths of value as dot graph,
+// the nodes and edges are the same as the steps in GetDataFlowPaths.
+func (v *Value) DataFlowPathDotGraph() string {
+	return DataFlowPathsDotGraph(v.GetDataFlowPaths())
+}
+
+func DataFlowPathsDotGraph(paths []*DataFlowPath) string {
+	graph := dot.New()
+	graph.MakeDirected()
+	graph.GraphAttribute("rankdir", "TB")
+	nodes := make(map[int64]int)
+	edges := make(map[string]struct{})
+	createNode := func(step *DataFlowStep) int {
+		if id, ok := nodes[step.ValueID]; ok {
+			return id
+		}
+		label := fmt.Sprintf("[%s] %s", step.OpCode, utils.ShrinkString(step.Value, 64))
+		if loc := step.Location(); loc != "" {
+			label += "\n" + loc
+		}
+		id := graph.AddNode(label)
+		nodes[step.ValueID] = id
+		return id
+	}
+	for _, path := range paths {
+		for i, step := range path.Steps {
+			from := createNode(step)
+			if i == 0 {
+				graph.NodeAttribute(from, "color", "blue")
+			}
+			if i == len(path.Steps)-1 {
+				graph.NodeAttribute(from, "color", "red")
+				continue
+			}
+			to := createNode(path.Steps[i+1])
+			key := fmt.Sprintf("%d-%d-%s", from, to, step.EdgeString())
+			if _, ok := edges[key]; ok {
+				continue
+			}
+			edges[key] = struct{}{}
+			edgeID := graph.AddEdge(from, to, step.EdgeString())
+			if step.Edge == DataFlowEdgePredecessor {
+				graph.EdgeAttribute(edgeID, "color", "red")
+				graph.EdgeAttribute(edgeID, "fontcolor", "red")
+			}
+		}
+	}
+	var buf bytes.Buffer
+	graph.GenerateDOT(&buf)
+	return buf.String()
+}
+
+// GetDataFlowPaths return the data flow paths of all values of the variable.
+func (r *SyntaxFlowResult) GetDataFlowPaths(name string) []*DataFlowPath {
+	var paths []*DataFlowPath
+	for _, v := range r.GetValues(name) {
+		paths = append(paths, v.GetDataFlowPaths()...)
+	}
+	return paths
+}
@@ -133,10 +133,22 @@ const (
 
 	// NativeCall_IsSanitizeName checks for potential sanitization function names
 	NativeCall_IsSanitizeName = "isSanitizeName"
+
+	// NativeCall_Taint is used to search the taint path from source to sink by TaintSpec
+	// use it like: <taint("java-servlet")> as $vuln
+	// or: $sink<taint(source: "$param", sanitizer: "$filter")> as $vuln
+	NativeCall_Taint = "taint"
+
+	// NativeCall_JavaWebEntrypoint is used to find the entrypoints of java web framework (spring/jax-rs/servlet/struts)
+	// use it like: <javaWebEntrypoint> as $params
+	// or: <javaWebEntrypoint(return: "handler", framework: "spring", method: "POST")> as $handler
+	NativeCall_JavaWebEntrypoint = "javaWebEntrypoint"
 )
 
 func init() {
 	registerNativeCall(NativeCall_IsSanitizeName, nc_func(nativeCallSanitizeNames), nc_desc("检查是否为潜在的过滤函数名称"))
+	registerNativeCall(NativeCall_Taint, nc_func(nativeCallTaint), nc_desc("根据污点规格(source/sink/sanitizer/propagator)搜索从 source 到 sink 的污点路径，返回可达的 sink"))
+	registerNativeCall(NativeCall_JavaWebEntrypoint, nc_func(nativeCallJavaWebEntrypoint), nc_desc("识别 Java Web 框架(Spring/JAX-RS/Servlet/Struts)入口，返回用户可控参数、处理函数或路由"))
 
 	registerNativeCall(NativeCall_VersionIn, nc_func(func(v sfvm.ValueOperator, frame *sfvm.SFFrame, params *sfvm.NativeCallActualParams) (bool, sfvm.ValueOperator, error) {
 		gt := params.GetString("greaterThan")  // <
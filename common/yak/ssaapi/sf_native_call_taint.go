@@ -0,0 +1,99 @@
+package ssaapi
+
+import (
+	"strings"
+
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	"github.com/yaklang/yaklang/common/utils"
+)
+
+// nativeCallTaint run taint analysis by TaintSpec, the spec can be a build-in spec name or the yaml content,
+// the source/sink/sanitizer/propagator can be overwritten by variable or syntaxflow code:
+//
+//	<taint("yak")> as $vuln
+//	exec(* as $sink); $sink<taint(source: "$input", sanitizer: "$filter")> as $vuln
+//
+// if input is the program, the sinks of spec are searched, otherwise the input values are the sinks.
+// the sinks reached by source are returned, and the source is the predecessor of sink.
+var nativeCallTaint sfvm.NativeCallFunc = func(v sfvm.ValueOperator, frame *sfvm.SFFrame, params *sfvm.NativeCallActualParams) (bool, sfvm.ValueOperator, error) {
+	contextResult, err := frame.GetSFResult()
+	if err != nil {
+		return false, nil, err
+	}
+	program, err := fetchProgram(v)
+	if err != nil {
+		return false, nil, err
+	}
+
+	spec := &TaintSpec{Name: "inline"}
+	if name := params.GetString(0, "spec", "name"); name != "" {
+		if ret, ok := GetTaintSpec(name); ok {
+			clone := *ret
+			spec = &clone
+		} else if ret, err := ParseTaintSpec(name); err == nil {
+			spec = ret
+		} else {
+			return false, nil, utils.Errorf("taint spec %#v not found", name)
+		}
+	}
+	for _, item := range []struct {
+		field *[]string
+		key   string
+	}{
+		{&spec.Sources, taintRoleSource},
+		{&spec.Sinks, taintRoleSink},
+		{&spec.Sanitizers, taintRoleSanitizer},
+		{&spec.Propagators, taintRolePropagator},
+	} {
+		if code := params.GetString(item.key, item.key+"s"); code != "" {
+			*item.field = []string{code}
+		}
+	}
+	if len(spec.Sources) == 0 {
+		return false, nil, utils.Error("taint need source, use <taint(spec: ...)> or <taint(source: ...)>")
+	}
+
+	query := func(role, code string) (Values, error) {
+		code = strings.TrimSpace(code)
+		if utils.MatchAnyOfRegexp(code, `^\$[a-zA-Z_][a-zA-Z_0-9]*$`) {
+			val, ok := contextResult.SymbolTable.Get(strings.TrimPrefix(code, "$"))
+			if !ok {
+				return nil, utils.Errorf("variable %s not found", code)
+			}
+			return SyntaxFlowVariableToValues(val), nil
+		}
+		result, err := SyntaxFlowWithVMContext(program, code, contextResult, frame.GetVM().GetConfig())
+		if err != nil {
+			return nil, err
+		}
+		return taintResultValues(result, role), nil
+	}
+
+	var sinks Values
+	if isProgram(v) {
+		if len(spec.Sinks) == 0 {
+			return false, nil, utils.Error("taint need sink, use <taint(spec: ...)> or <taint(sink: ...)>")
+		}
+		sinks = taintRoleValues(query, taintRoleSink, spec.Sinks)
+	} else {
+		sinks = SyntaxFlowVariableToValues(v)
+	}
+
+	result, err := TaintWithQuery(spec, sinks, query)
+	if err != nil {
+		return false, nil, err
+	}
+	if len(result.Paths) == 0 {
+		return false, new(Values), utils.Errorf("no taint path found")
+	}
+	var rets []sfvm.ValueOperator
+	for _, sink := range result.Sinks() {
+		for _, path := range result.Paths {
+			if path.Sink == sink {
+				sink.AppendPredecessor(path.Source, frame.WithPredecessorContext("taint: "+spec.Name))
+			}
+		}
+		rets = append(rets, sink)
+	}
+	return true, sfvm.NewValues(rets), nil
+}
@@ -0,0 +1,93 @@
+package ssaapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/samber/lo"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+// nativeCallJavaWebEntrypoint return the user controlled parameters of java web entrypoints in program:
+//
+//	<javaWebEntrypoint> as $params
+//	<javaWebEntrypoint(framework: "spring", method: "POST", source: "body")> as $body
+//	<javaWebEntrypoint(return: "handler")> as $handler
+//	<javaWebEntrypoint(return: "route")> as $route
+//
+// the handler is the predecessor of returned value, and the route/method/framework are set to DescInfo of value.
+var nativeCallJavaWebEntrypoint sfvm.NativeCallFunc = func(v sfvm.ValueOperator, frame *sfvm.SFFrame, params *sfvm.NativeCallActualParams) (bool, sfvm.ValueOperator, error) {
+	prog, err := fetchProgram(v)
+	if err != nil {
+		return false, nil, err
+	}
+	kind := strings.ToLower(params.GetString(0, "return", "kind"))
+	frameworks := utils.PrettifyListFromStringSplitEx(strings.ToLower(params.GetString("framework")), ",", "|")
+	methods := utils.PrettifyListFromStringSplitEx(strings.ToUpper(params.GetString("method")), ",", "|")
+	sources := utils.PrettifyListFromStringSplitEx(strings.ToLower(params.GetString("source")), ",", "|")
+	route := params.GetString("route")
+
+	var rets []sfvm.ValueOperator
+	for _, entry := range prog.GetJavaWebEntrypoints() {
+		if len(frameworks) > 0 && !utils.StringArrayContains(frameworks, entry.Framework) {
+			continue
+		}
+		// the entrypoint without method accepts all methods
+		if len(methods) > 0 && len(entry.Methods) > 0 && !lo.Some(entry.Methods, methods) {
+			continue
+		}
+		if route != "" && !lo.SomeBy(entry.Routes, func(r string) bool {
+			return strings.Contains(r, route) || utils.MatchAnyOfGlob(r, route)
+		}) {
+			continue
+		}
+
+		label := webEntrypointLabel(entry)
+		desc := map[string]string{
+			"framework": entry.Framework,
+			"route":     strings.Join(entry.Routes, ","),
+			"method":    strings.Join(entry.Methods, ","),
+		}
+		switch kind {
+		case "handler", "func", "function":
+			entry.Handler.DescInfo = desc
+			rets = append(rets, entry.Handler)
+		case "route":
+			for _, r := range entry.Routes {
+				value := entry.Handler.NewValue(ssa.NewConst(r))
+				value.DescInfo = desc
+				value.AppendPredecessor(entry.Handler, frame.WithPredecessorContext(label))
+				rets = append(rets, value)
+			}
+		default:
+			for _, param := range entry.Params {
+				if len(sources) > 0 && !utils.StringArrayContains(sources, param.Source) {
+					continue
+				}
+				param.Value.DescInfo = map[string]string{
+					"framework": entry.Framework,
+					"route":     desc["route"],
+					"method":    desc["method"],
+					"name":      param.Name,
+					"source":    param.Source,
+				}
+				param.Value.AppendPredecessor(entry.Handler, frame.WithPredecessorContext(label))
+				rets = append(rets, param.Value)
+			}
+		}
+	}
+	if len(rets) == 0 {
+		return false, new(Values), utils.Errorf("no java web entrypoint found")
+	}
+	return true, sfvm.NewValues(rets), nil
+}
+
+func webEntrypointLabel(entry *WebEntrypoint) string {
+	methods := "*"
+	if len(entry.Methods) > 0 {
+		methods = strings.Join(entry.Methods, "|")
+	}
+	return fmt.Sprintf("%s %s (%s)", methods, strings.Join(entry.Routes, ","), entry.Framework)
+}
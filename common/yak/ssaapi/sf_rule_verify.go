@@ -0,0 +1,350 @@
+package ssaapi
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/syntaxflow/sfvm"
+	fi "github.com/yaklang/yaklang/common/utils/filesys/filesys_interface"
+)
+
+// SyntaxFlowExpectation is the expected alert position written in the fixture code of rule, like:
+//
+//	Runtime.getRuntime().exec(cmd); // sf:expect
+//	Runtime.getRuntime().exec(cmd); // sf:expect 2 $sink
+//	Runtime.getRuntime().exec("ls"); // sf:expect-not
+//
+// the annotation in a single line is for the next code line.
+type SyntaxFlowExpectation struct {
+	File     string
+	Line     int
+	Variable string // empty for all alert variables
+	Count    int    // the count of alert values start at the line
+}
+
+func (e *SyntaxFlowExpectation) String() string {
+	variable := "alert"
+	if e.Variable != "" {
+		variable = "$" + e.Variable
+	}
+	return fmt.Sprintf("%s:%d expect %d %s", e.File, e.Line, e.Count, variable)
+}
+
+var syntaxFlowExpectRegexp = regexp.MustCompile(`(?://|#|/\*|<!--)\s*sf:expect(-not)?\b([^\r\n]*)`)
+
+// ParseSyntaxFlowExpectations parse the `sf:expect` annotations in the code
+func ParseSyntaxFlowExpectations(filename, code string) []*SyntaxFlowExpectation {
+	var ret []*SyntaxFlowExpectation
+	var pending []*SyntaxFlowExpectation
+	for index, line := range strings.Split(code, "\n") {
+		lineNumber := index + 1
+		loc := syntaxFlowExpectRegexp.FindStringSubmatchIndex(line)
+		if loc == nil {
+			if strings.TrimSpace(line) != "" {
+				for _, e := range pending {
+					e.Line = lineNumber
+				}
+				ret = append(ret, pending...)
+				pending = nil
+			}
+			continue
+		}
+
+		expect := &SyntaxFlowExpectation{File: filename, Line: lineNumber, Count: 1}
+		if loc[2] >= 0 {
+			expect.Count = 0
+		}
+		args := strings.NewReplacer("*/", " ", "-->", " ").Replace(line[loc[4]:loc[5]])
+		for _, arg := range strings.Fields(args) {
+			if strings.HasPrefix(arg, "$") {
+				expect.Variable = strings.TrimPrefix(arg, "$")
+			} else if count, err := strconv.Atoi(arg); err == nil && loc[2] < 0 {
+				expect.Count = count
+			}
+		}
+		if strings.TrimSpace(line[:loc[0]]) == "" {
+			pending = append(pending, expect)
+		} else {
+			ret = append(ret, expect)
+		}
+	}
+	return ret
+}
+
+type SyntaxFlowRuleTestCase struct {
+	Name       string
+	Negative   bool
+	Language   consts.Language
+	Files      []string
+	AlertCount int
+	Errors     []string
+}
+
+func (c *SyntaxFlowRuleTestCase) Passed() bool {
+	return len(c.Errors) == 0
+}
+
+func (c *SyntaxFlowRuleTestCase) errorf(format string, args ...any) {
+	c.Errors = append(c.Errors, fmt.Sprintf(format, args...))
+}
+
+// SyntaxFlowRuleTestReport is the result of testing a rule with the fixtures in desc,
+// `file://` fixtures should be alerted, and `safefile://` fixtures should not be alerted.
+type SyntaxFlowRuleTestReport struct {
+	RuleName string
+	Language string
+	Cases    []*SyntaxFlowRuleTestCase
+	// compile error of rule
+	Error string
+}
+
+// Skipped means the rule have no fixture to test
+func (r *SyntaxFlowRuleTestReport) Skipped() bool {
+	return r.Error == "" && len(r.Cases) == 0
+}
+
+func (r *SyntaxFlowRuleTestReport) Passed() bool {
+	if r.Error != "" {
+		return false
+	}
+	for _, c := range r.Cases {
+		if !c.Passed() {
+			return false
+		}
+	}
+	return true
+}
+
+// Status return "pass", "fail" or "skip"
+func (r *SyntaxFlowRuleTestReport) Status() string {
+	switch {
+	case r.Skipped():
+		return "skip"
+	case r.Passed():
+		return "pass"
+	default:
+		return "fail"
+	}
+}
+
+func (r *SyntaxFlowRuleTestReport) String() string {
+	var buf strings.Builder
+	buf.WriteString(fmt.Sprintf("[%s] %s", strings.ToUpper(r.Status()), r.RuleName))
+	if r.Error != "" {
+		buf.WriteString(": " + r.Error)
+	}
+	for _, c := range r.Cases {
+		buf.WriteString(fmt.Sprintf("\n  %s(%s) alert: %d", c.Name, strings.Join(c.Files, ","), c.AlertCount))
+		for _, e := range c.Errors {
+			buf.WriteString("\n    " + e)
+		}
+	}
+	return buf.String()
+}
+
+// RunSyntaxFlowRuleTest run the rule with the fixtures in desc and check the result.
+func RunSyntaxFlowRuleTest(rule *schema.SyntaxFlowRule) *SyntaxFlowRuleTestReport {
+	report := RunSyntaxFlowRuleTestContent(rule.Content)
+	if rule.RuleName != "" {
+		report.RuleName = rule.RuleName
+	}
+	return report
+}
+
+// RunSyntaxFlowRuleTestContent compile the fixtures in desc of rule in memory and check the alert of rule:
+//   - `file://` fixture must be alerted, the `alert_min/alert_max/alert_num/alert_high/alert_mid/alert_low`
+//     in desc and `sf:expect` annotations in fixture are checked.
+//   - `safefile://` fixture must not be alerted.
+func RunSyntaxFlowRuleTestContent(content string) *SyntaxFlowRuleTestReport {
+	report := &SyntaxFlowRuleTestReport{}
+	frame, err := sfvm.NewSyntaxFlowVirtualMachine().Compile(content)
+	if err != nil {
+		report.Error = err.Error()
+		return report
+	}
+	rule := frame.GetRule()
+	report.RuleName = rule.RuleName
+	if report.RuleName == "" {
+		report.RuleName = rule.Title
+	}
+	report.Language = rule.Language
+
+	if len(frame.VerifyFs) > 0 {
+		c := &SyntaxFlowRuleTestCase{Name: "positive"}
+		language, vfs, err := frame.ExtractVerifyFilesystemAndLanguage()
+		if err != nil {
+			c.errorf("load fixture failed: %v", err)
+		} else {
+			c.Language = language
+			testSyntaxFlowRuleFixture(frame, content, c, vfs, frame.VerifyFs)
+		}
+		report.Cases = append(report.Cases, c)
+	}
+	if len(frame.NegativeFs) > 0 {
+		c := &SyntaxFlowRuleTestCase{Name: "negative", Negative: true}
+		language, vfs, err := frame.ExtractNegativeFilesystemAndLanguage()
+		if err != nil {
+			c.errorf("load fixture failed: %v", err)
+		} else {
+			c.Language = language
+			testSyntaxFlowRuleFixture(frame, content, c, vfs, frame.NegativeFs)
+		}
+		report.Cases = append(report.Cases, c)
+	}
+	return report
+}
+
+func testSyntaxFlowRuleFixture(frame *sfvm.SFFrame, content string, c *SyntaxFlowRuleTestCase, vfs fi.FileSystem, files map[string]string) {
+	for name := range files {
+		c.Files = append(c.Files, name)
+	}
+	sort.Strings(c.Files)
+
+	progs, err := ParseProject(vfs, WithLanguage(c.Language))
+	if err != nil {
+		c.errorf("compile fixture failed: %v", err)
+		return
+	}
+	if len(progs) == 0 {
+		c.errorf("compile fixture failed: no program")
+		return
+	}
+	CheckSyntaxFlowRuleFixture(frame, content, c, progs)
+}
+
+// CheckSyntaxFlowRuleFixture run the rule on the programs compiled from the fixtures of c,
+// and record the problems in c.Errors. `yak syntaxflow-test` and the builtin rule test share this check.
+func CheckSyntaxFlowRuleFixture(frame *sfvm.SFFrame, content string, c *SyntaxFlowRuleTestCase, progs Programs) {
+	files := frame.VerifyFs
+	if c.Negative {
+		files = frame.NegativeFs
+	}
+	var expectations []*SyntaxFlowExpectation
+	for name, code := range files {
+		expectations = append(expectations, ParseSyntaxFlowExpectations(name, code)...)
+	}
+
+	result, err := progs.SyntaxFlowWithError(content, sfvm.WithEnableDebug(false))
+	if err != nil {
+		if c.Negative && !errors.Is(err, sfvm.CriticalError) {
+			// the rule not matched anything in safe code
+			return
+		}
+		c.errorf("syntaxflow failed: %v", err)
+		return
+	}
+	if errs := result.GetErrors(); len(errs) > 0 {
+		if !c.Negative {
+			c.errorf("syntaxflow runtime error: %v", strings.Join(errs, "; "))
+		}
+		return
+	}
+
+	// level count is the count of alert variables in this level, not the values
+	levels := make(map[string]int)
+	alerts := make(map[string]Values)
+	for _, name := range result.GetAlertVariables() {
+		vs := result.GetValues(name)
+		alerts[name] = vs
+		c.AlertCount += len(vs)
+		if info, ok := result.GetAlertEx(name); ok {
+			switch info.Level {
+			case "mid", "m", "middle":
+				levels["mid"]++
+			case "high", "h":
+				levels["high"]++
+			case "info", "low":
+				levels["low"]++
+			}
+		}
+	}
+
+	if c.Negative {
+		for name, vs := range alerts {
+			if len(vs) > 0 {
+				c.errorf("safe fixture should not be alerted, but got $%s: %v", name, vs)
+			}
+		}
+		return
+	}
+
+	if c.AlertCount == 0 {
+		c.errorf("no alert value found")
+	}
+	if frame.GetRule().AllowIncluded && len(result.GetValues("output")) == 0 {
+		c.errorf("lib: %v is not exporting output in `alert`", result.Name())
+	}
+	if min := frame.GetExtraInfoInt("alert_min", "vuln_min", "alertMin", "vulnMin"); min > 0 && c.AlertCount < min {
+		c.errorf("alert count %d is less than alert_min %d", c.AlertCount, min)
+	}
+	if max := frame.GetExtraInfoInt("alert_max", "vuln_max", "alertMax", "vulnMax"); max > 0 && c.AlertCount > max {
+		c.errorf("alert count %d is more than alert_max %d", c.AlertCount, max)
+	}
+	if num := frame.GetExtraInfoInt("alert_num", "vuln_num", "alertNum", "vulnNum"); num > 0 && c.AlertCount != num {
+		c.errorf("alert count %d is not equal to alert_num %d", c.AlertCount, num)
+	}
+	for _, item := range []struct {
+		level string
+		keys  []string
+	}{
+		{"high", []string{"alert_high", "alertHigh", "vulnHigh"}},
+		{"mid", []string{"alert_mid", "alertMid", "vulnMid"}},
+		{"low", []string{"alert_low", "alertLow", "vulnLow", "alert_info"}},
+	} {
+		if expect := frame.GetExtraInfoInt(item.keys[0], item.keys[1:]...); expect > 0 && levels[item.level] < expect {
+			c.errorf("alert %s count %d is less than %s %d", item.level, levels[item.level], item.keys[0], expect)
+		}
+	}
+
+	for _, expect := range expectations {
+		count := 0
+		for name, vs := range alerts {
+			if expect.Variable != "" && expect.Variable != name {
+				continue
+			}
+			for _, v := range vs {
+				if syntaxFlowValueAtLine(v, expect.File, expect.Line) {
+					count++
+				}
+			}
+		}
+		if count != expect.Count {
+			c.errorf("%s, but got %d", expect, count)
+		}
+	}
+}
+
+// syntaxFlowValueAtLine check the value is defined or used as call argument at the line,
+// the alert value is usually the argument of sink call, but defined in other line.
+func syntaxFlowValueAtLine(v *Value, file string, line int) bool {
+	at := func(v *Value) bool {
+		rng := v.GetRange()
+		if rng == nil || rng.GetEditor() == nil || rng.GetStart() == nil {
+			return false
+		}
+		return rng.GetStart().GetLine() == line && sameFixtureFile(rng.GetEditor().GetFilename(), file)
+	}
+	if at(v) {
+		return true
+	}
+	for _, user := range v.GetUsers() {
+		if user.IsCall() && at(user) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameFixtureFile(a, b string) bool {
+	normalize := func(s string) string {
+		return strings.ToLower(strings.TrimLeft(strings.ReplaceAll(s, "\\", "/"), "/"))
+	}
+	a, b = normalize(a), normalize(b)
+	return a == b || strings.HasSuffix(a, "/"+b) || strings.HasSuffix(b, "/"+a)
+}
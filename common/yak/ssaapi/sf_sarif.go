@@ -1,6 +1,9 @@
 package ssaapi
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/yaklang/yaklang/common/go-funk"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/sarif"
@@ -73,96 +76,55 @@ func (s *SarifContext) AddSSAValue(v *Value, extraMsg ...string) {
 	s.CreateCodeFlowsFromPredecessor(v)
 }
 
-func (s *SarifContext) createCodeFlowsFromPredecessor(v *Value, add func(flow *sarif.CodeFlow), visited map[int64]struct{}) {
-	if visited == nil {
-		visited = make(map[int64]struct{})
-	}
-
-	if _, ok := visited[v.GetId()]; ok {
-		return
-	}
-	visited[v.GetId()] = struct{}{}
-
-	if len(v.Predecessors) > 0 {
-		log.Infof("create codeflow preds: %v from: %v", len(v.Predecessors), v)
-	}
-
-	preds := v.Predecessors
-	for _, tf := range preds {
-		rg := tf.Node.GetRange()
-		if rg == nil {
-			continue
-		}
-		if rg.GetEditor() == nil {
-			continue
-		}
-
-		artid := s.GetArtifactIdFromEditor(rg.GetEditor())
-		if artid < 0 {
-			continue
-		}
-		tfInstance := sarif.NewThreadFlow().WithLocations([]*sarif.ThreadFlowLocation{
-			sarif.NewThreadFlowLocation().WithLocation(
-				s.CreateLocation(artid, rg),
-			),
-		}).WithMessage(sarif.NewTextMessage(tf.Info.Label))
-
-		codeflow := sarif.NewCodeFlow().WithThreadFlows([]*sarif.ThreadFlow{
-			tfInstance,
-		}).WithTextMessage(tf.Node.StringWithRange())
-
-		add(codeflow)
-		s.locations = append(s.locations, s.CreateLocation(artid, rg))
-		s.createCodeFlowsFromPredecessor(tf.Node, add, visited)
-		invoc := sarif.NewInvocation().WithArguments([]string{tf.Info.Label})
-		s.invocations = append(s.invocations, invoc)
-	}
-
-	if len(preds) > 0 {
-		return
-	}
-
-	for _, dep := range v.DependOn {
-		rg := dep.GetRange()
-		if rg == nil {
-			continue
-		}
-		if rg.GetEditor() == nil {
-			continue
+// CreateCodeFlowsFromPredecessor create a sarif.CodeFlow for every data flow path of value,
+// the steps of path are the locations of the only sarif.ThreadFlow, same as GetDataFlowPaths.
+func (s *SarifContext) CreateCodeFlowsFromPredecessor(v *Value) []*sarif.CodeFlow {
+	var flows []*sarif.CodeFlow
+	locations := make(map[int64]struct{})
+	invocations := make(map[string]struct{})
+	for _, path := range v.GetDataFlowPaths() {
+		var tfls []*sarif.ThreadFlowLocation
+		for _, step := range path.Steps {
+			tfl := sarif.NewThreadFlowLocation().WithExecutionOrder(step.Index)
+			if step.Edge != "" {
+				tfl.WithKinds([]string{step.Edge})
+			}
+			msg := sarif.NewTextMessage(fmt.Sprintf("[%s] %s", step.OpCode, step.Value))
+			loc := sarif.NewLocation().WithMessage(msg)
+			if rg := step.GetValue().GetRange(); rg != nil && rg.GetEditor() != nil {
+				if artid := s.GetArtifactIdFromEditor(rg.GetEditor()); artid >= 0 {
+					loc = s.CreateLocation(artid, rg).WithMessage(msg)
+					if _, ok := locations[step.ValueID]; !ok {
+						locations[step.ValueID] = struct{}{}
+						s.locations = append(s.locations, s.CreateLocation(artid, rg))
+					}
+				}
+			}
+			if step.Edge == DataFlowEdgePredecessor && step.EdgeLabel != "" {
+				if _, ok := invocations[step.EdgeLabel]; !ok {
+					invocations[step.EdgeLabel] = struct{}{}
+					s.invocations = append(s.invocations, sarif.NewInvocation().WithArguments([]string{step.EdgeLabel}))
+				}
+			}
+			tfls = append(tfls, tfl.WithLocation(loc))
 		}
 
-		artid := s.GetArtifactIdFromEditor(rg.GetEditor())
-		if artid < 0 {
-			continue
-		}
-		s.locations = append(s.locations, s.CreateLocation(artid, rg))
-		s.createCodeFlowsFromPredecessor(dep, add, visited)
-	}
-	for _, eff := range v.EffectOn {
-		rg := eff.GetRange()
-		if rg == nil {
-			continue
-		}
-		if rg.GetEditor() == nil {
-			continue
+		var edges []string
+		for _, step := range path.Steps {
+			if step.Edge != "" {
+				edges = append(edges, step.EdgeString())
+			}
 		}
-
-		artid := s.GetArtifactIdFromEditor(rg.GetEditor())
-		if artid < 0 {
-			continue
+		threadFlow := sarif.NewThreadFlow().WithLocations(tfls)
+		if len(edges) > 0 {
+			threadFlow.WithMessage(sarif.NewTextMessage(strings.Join(edges, " -> ")))
 		}
-		s.locations = append(s.locations, s.CreateLocation(artid, rg))
-		s.createCodeFlowsFromPredecessor(eff, add, visited)
-	}
-}
-
-func (s *SarifContext) CreateCodeFlowsFromPredecessor(v *Value) []*sarif.CodeFlow {
-	var flows []*sarif.CodeFlow
-
-	addFlow := func(tf *sarif.CodeFlow) {
-		s.codeFlows = append(s.codeFlows, tf)
+		flow := sarif.NewCodeFlow().WithThreadFlows([]*sarif.ThreadFlow{
+			threadFlow,
+		}).WithTextMessage(v.StringWithRange())
+		flows = append(flows, flow)
 	}
-	s.createCodeFlowsFromPredecessor(v, addFlow, nil)
+	s.codeFlows = append(s.codeFlows, flows...)
 	return flows
 }
 
@@ -34,6 +34,7 @@ type config struct {
 	feedCode        bool
 	ignoreSyntaxErr bool
 	reCompile       bool
+	incremental     bool
 	databasePath    string
 
 	// input, code or project path
@@ -100,6 +101,18 @@ func WithReCompile(b bool) Option {
 	}
 }
 
+// WithIncrementalCompile only re-compile the changed files (and the files related to them)
+// when the program is existed in database, the unchanged ir code will be kept.
+func WithIncrementalCompile(b ...bool) Option {
+	return func(c *config) {
+		if len(b) > 0 {
+			c.incremental = b[0]
+		} else {
+			c.incremental = true
+		}
+	}
+}
+
 func WithError(err error) Option {
 	return func(c *config) {
 		c.err = err
@@ -380,6 +393,7 @@ var Exports = map[string]any{
 	"withProcess":       WithProcess,
 	"withEntryFile":     WithFileSystemEntry,
 	"withReCompile":     WithReCompile,
+	"withIncremental":   WithIncrementalCompile,
 	"withSaveToProfile": WithSaveToProfile,
 	"withContext":       WithContext,
 	// "": with,
@@ -0,0 +1,414 @@
+package ssaapi
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssa"
+)
+
+const (
+	taintRoleSource     = "source"
+	taintRoleSink       = "sink"
+	taintRoleSanitizer  = "sanitizer"
+	taintRolePropagator = "propagator"
+
+	// the max level of single-predecessor blocks checked for sanitizer guard and reachable
+	taintGuardBlockLimit = 16
+)
+
+// TaintPath is a data flow path from source to sink, Nodes[0] is the source and the last one is the sink.
+type TaintPath struct {
+	Source *Value
+	Sink   *Value
+	Nodes  Values
+}
+
+func (p *TaintPath) String() string {
+	nodes := make([]string, 0, len(p.Nodes))
+	for _, node := range p.Nodes {
+		nodes = append(nodes, utils.ShrinkString(node.String(), 40))
+	}
+	return strings.Join(nodes, " -> ")
+}
+
+type TaintResult struct {
+	Spec  *TaintSpec
+	Paths []*TaintPath
+}
+
+// Sinks return the sinks reached by source, every sink only appears once.
+func (r *TaintResult) Sinks() Values {
+	if r == nil {
+		return nil
+	}
+	var ret Values
+	visited := make(map[int64]struct{})
+	for _, path := range r.Paths {
+		if _, ok := visited[path.Sink.GetId()]; ok {
+			continue
+		}
+		visited[path.Sink.GetId()] = struct{}{}
+		ret = append(ret, path.Sink)
+	}
+	return ret
+}
+
+type taintSet map[int64]*Value
+
+func newTaintSet(vs Values) taintSet {
+	s := make(taintSet, len(vs))
+	for _, v := range vs {
+		s[v.GetId()] = v
+	}
+	return s
+}
+
+// match check the value itself or the callee of the call value is in the set,
+// so the spec can describe a function as sanitizer like `/escape/ as $sanitizer`.
+func (s taintSet) match(v *Value) bool {
+	if len(s) == 0 || v == nil {
+		return false
+	}
+	if _, ok := s[v.GetId()]; ok {
+		return true
+	}
+	if v.IsCall() {
+		if callee := v.GetCallee(); callee != nil {
+			if _, ok := s[callee.GetId()]; ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// taintQuery execute a snippet of TaintSpec and return the values of the role
+type taintQuery func(role, code string) (Values, error)
+
+func taintRoleValues(query taintQuery, role string, codes []string) Values {
+	var ret Values
+	for _, code := range codes {
+		if strings.TrimSpace(code) == "" {
+			continue
+		}
+		vs, err := query(role, code)
+		if err != nil {
+			// the snippet may not match the program, like the servlet source in spring project
+			log.Warnf("taint %s %#v failed: %v", role, code, err)
+			continue
+		}
+		ret = append(ret, vs...)
+	}
+	return ret
+}
+
+// taintResultValues pick the values of snippet result:
+// the variable named as role (like $source), or the unnamed values, or all variables.
+func taintResultValues(result *SyntaxFlowResult, role string) Values {
+	if result == nil {
+		return nil
+	}
+	if vs := result.GetValues(role); len(vs) > 0 {
+		return vs
+	}
+	if vs := result.GetUnNameValues(); len(vs) > 0 {
+		return vs
+	}
+	var ret Values
+	result.GetAllVariable().ForEach(func(name string, _ any) {
+		ret = append(ret, result.GetValues(name)...)
+	})
+	return ret
+}
+
+type taintEngine struct {
+	spec        *TaintSpec
+	sources     taintSet
+	sanitizers  taintSet
+	propagators taintSet
+}
+
+// Taint run the taint analysis of spec on the program.
+func (p *Program) Taint(spec *TaintSpec, opts ...OperationOption) (*TaintResult, error) {
+	query := func(role, code string) (Values, error) {
+		result, err := p.SyntaxFlowWithError(code)
+		if err != nil {
+			return nil, err
+		}
+		return taintResultValues(result, role), nil
+	}
+	sinks := taintRoleValues(query, taintRoleSink, spec.Sinks)
+	return TaintWithQuery(spec, sinks, query, opts...)
+}
+
+// TaintWithQuery run the taint analysis from the sinks, the sources/sanitizers/propagators of spec
+// are executed by query.
+func TaintWithQuery(spec *TaintSpec, sinks Values, query taintQuery, opts ...OperationOption) (*TaintResult, error) {
+	if spec == nil {
+		return nil, utils.Error("taint spec is nil")
+	}
+	engine := &taintEngine{spec: spec}
+	for _, item := range []struct {
+		role  string
+		codes []string
+		set   *taintSet
+	}{
+		{taintRoleSource, spec.Sources, &engine.sources},
+		{taintRoleSanitizer, spec.Sanitizers, &engine.sanitizers},
+		{taintRolePropagator, spec.Propagators, &engine.propagators},
+	} {
+		*item.set = newTaintSet(taintRoleValues(query, item.role, item.codes))
+	}
+
+	result := &TaintResult{Spec: spec}
+	if len(engine.sources) == 0 {
+		return result, nil
+	}
+	for _, sink := range sinks {
+		result.Paths = append(result.Paths, engine.trace(sink, opts...)...)
+	}
+	return result, nil
+}
+
+func (e *taintEngine) stop(v *Value) bool {
+	if e.sanitizers.match(v) {
+		return true
+	}
+	if valueReachable(v) == -1 {
+		// infeasible path, the block never be executed
+		return true
+	}
+	if len(e.propagators) > 0 && isExternCall(v) && !e.propagators.match(v) {
+		return true
+	}
+	return false
+}
+
+// trace search the sources of sink by top-defs, the search stop at source and sanitizer,
+// then rebuild the path from the depend-on edges and drop the infeasible or guarded paths.
+func (e *taintEngine) trace(sink *Value, opts ...OperationOption) []*TaintPath {
+	if sink == nil || valueReachable(sink) == -1 || e.guarded(sink) {
+		return nil
+	}
+	var hits Values
+	hook := WithHookEveryNode(func(v *Value) error {
+		if e.sources.match(v) {
+			hits = append(hits, v)
+			return utils.Error("abort")
+		}
+		if e.stop(v) {
+			return utils.Error("abort")
+		}
+		return nil
+	})
+	sink.GetTopDefs(append(opts, hook)...)
+
+	var paths []*TaintPath
+	visited := make(map[int64]struct{})
+	for _, hit := range hits {
+		if _, ok := visited[hit.GetId()]; ok {
+			continue
+		}
+		visited[hit.GetId()] = struct{}{}
+		nodes := dependOnPath(sink, hit, feasibleEdge)
+		if len(nodes) == 0 {
+			log.Debugf("taint: no feasible path from %v to %v", hit, sink)
+			continue
+		}
+		paths = append(paths, &TaintPath{
+			Source: hit,
+			Sink:   sink,
+			Nodes:  nodes,
+		})
+	}
+	return paths
+}
+
+// dependOnPath find the shortest path from sink to target by the feasible depend-on edges,
+// return the path from target to sink.
+func dependOnPath(sink, target *Value, feasible func(user, def *Value) bool) Values {
+	parent := map[*Value]*Value{sink: nil}
+	visited := map[int64]struct{}{sink.GetId(): {}}
+	queue := []*Value{sink}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if current.GetId() == target.GetId() {
+			var path Values
+			for node := current; node != nil; node = parent[node] {
+				path = append(path, node)
+			}
+			return path
+		}
+		for _, next := range current.DependOn {
+			if _, ok := visited[next.GetId()]; ok {
+				continue
+			}
+			if !feasible(current, next) {
+				continue
+			}
+			visited[next.GetId()] = struct{}{}
+			parent[next] = current
+			queue = append(queue, next)
+		}
+	}
+	return nil
+}
+
+// feasibleEdge check the data flow edge from def to user:
+//   - the def in unreachable block is never executed.
+//   - the def of phi must come from the reachable predecessor block.
+//   - the control flow condition of phi is not data flow.
+func feasibleEdge(user, def *Value) bool {
+	if valueReachable(def) == -1 {
+		return false
+	}
+	phi, ok := ssa.ToPhi(user.node)
+	if !ok {
+		return true
+	}
+	index := -1
+	for k, edge := range phi.Edge {
+		if edge != nil && edge.GetId() == def.GetId() {
+			index = k
+			break
+		}
+	}
+	if index == -1 {
+		return false
+	}
+	if block := phi.GetBlock(); block != nil && index < len(block.Preds) {
+		if pred, ok := ssa.ToBasicBlock(block.Preds[index]); ok && blockReachable(pred) == -1 {
+			return false
+		}
+	}
+	return true
+}
+
+// guarded check the sink is only used when sanitizer return true, like:
+//
+//	if filter(a) { exec(a) }
+//
+// the sink is guarded when all the calls use it are in the true branch of sanitizer condition.
+func (e *taintEngine) guarded(sink *Value) bool {
+	if len(e.sanitizers) == 0 || sink.node == nil {
+		return false
+	}
+	var sites Values
+	for _, user := range sink.GetUsers() {
+		if user.IsCall() && !e.sanitizers.match(user) {
+			sites = append(sites, user)
+		}
+	}
+	if len(sites) == 0 {
+		sites = Values{sink}
+	}
+	for _, site := range sites {
+		if !e.siteGuarded(site) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *taintEngine) siteGuarded(site *Value) bool {
+	block := site.node.GetBlock()
+	for i := 0; block != nil && i < taintGuardBlockLimit; i++ {
+		cond, branch, ok := blockBranch(block)
+		if !ok {
+			break
+		}
+		if unOp, ok := ssa.ToUnOp(cond); ok && unOp.Op == ssa.OpNot {
+			// if !filter(a) { return } else { exec(a) }
+			cond, branch = unOp.X, !branch
+		}
+		if branch {
+			candidates := append([]ssa.Value{cond}, cond.GetValues()...)
+			for _, candidate := range candidates {
+				if candidate != nil && e.sanitizers.match(site.NewValue(candidate)) {
+					return true
+				}
+			}
+		}
+		block, _ = ssa.ToBasicBlock(block.Preds[0])
+	}
+	return false
+}
+
+// blockBranch return the condition of the if instruction in the only predecessor of block,
+// and the block is the true or false branch of it.
+func blockBranch(block *ssa.BasicBlock) (ssa.Value, bool, bool) {
+	if block == nil || len(block.Preds) != 1 {
+		return nil, false, false
+	}
+	pred, ok := ssa.ToBasicBlock(block.Preds[0])
+	if !ok || len(pred.Insts) == 0 {
+		return nil, false, false
+	}
+	ifInst, ok := ssa.ToIfInstruction(pred.LastInst())
+	if !ok || ifInst.Cond == nil {
+		return nil, false, false
+	}
+	switch {
+	case ifInst.True != nil && ifInst.True.GetId() == block.GetId():
+		return ifInst.Cond, true, true
+	case ifInst.False != nil && ifInst.False.GetId() == block.GetId():
+		return ifInst.Cond, false, true
+	}
+	return nil, false, false
+}
+
+// blockReachable is like BasicBlock.Reachable, but also check the constant condition
+// of the if instructions which the block dominated by, like `if false { ... }`.
+func blockReachable(block *ssa.BasicBlock) int {
+	for i := 0; block != nil && i < taintGuardBlockLimit; i++ {
+		if reachable := block.Reachable(); reachable != 0 {
+			return reachable
+		}
+		cond, branch, ok := blockBranch(block)
+		if !ok {
+			break
+		}
+		if c, ok := ssa.ToConst(cond); ok && c.IsBoolean() && c.Boolean() != branch {
+			return -1
+		}
+		block, _ = ssa.ToBasicBlock(block.Preds[0])
+	}
+	return 0
+}
+
+func valueReachable(v *Value) int {
+	if v == nil || v.node == nil {
+		return 0
+	}
+	return blockReachable(v.node.GetBlock())
+}
+
+// isExternCall check the value is a call of function without body, like library function
+func isExternCall(v *Value) bool {
+	call, ok := ssa.ToCall(v.node)
+	if !ok || call.Method == nil {
+		return false
+	}
+	fun, ok := ssa.ToFunction(call.Method)
+	if !ok && call.Method.GetReference() != nil {
+		fun, ok = ssa.ToFunction(call.Method.GetReference())
+	}
+	return !ok || fun.IsExtern()
+}
+
+func (r *TaintResult) String() string {
+	var buf strings.Builder
+	name := ""
+	if r.Spec != nil {
+		name = r.Spec.Name
+	}
+	buf.WriteString(fmt.Sprintf("taint[%s]: %d paths\n", name, len(r.Paths)))
+	for _, path := range r.Paths {
+		buf.WriteString("  " + path.String() + "\n")
+	}
+	return buf.String()
+}
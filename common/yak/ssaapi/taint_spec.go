@@ -0,0 +1,121 @@
+package ssaapi
+
+import (
+	"embed"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed taintspec/*.yaml
+var taintSpecFS embed.FS
+
+// TaintSpec is the declarative description of a taint analysis, every item of
+// sources/sinks/sanitizers/propagators is a SyntaxFlow snippet, the values of the snippet
+// are the unnamed values, if no unnamed value, all variables of the snippet are used.
+//
+// example:
+//
+//	name: yak-exec
+//	language: yak
+//	sources:
+//	  - cli.String() as $source
+//	sinks:
+//	  - exec(* as $sink)
+//	sanitizers:
+//	  - /filter|escape/() as $sanitizer
+type TaintSpec struct {
+	Name        string `yaml:"name"`
+	Language    string `yaml:"language"`
+	Framework   string `yaml:"framework"`
+	Description string `yaml:"description"`
+
+	Sources    []string `yaml:"sources"`
+	Sinks      []string `yaml:"sinks"`
+	Sanitizers []string `yaml:"sanitizers"`
+	// Propagators are the external calls which pass the taint from arguments to return value,
+	// if empty, all external calls propagate the taint.
+	Propagators []string `yaml:"propagators"`
+}
+
+func ParseTaintSpec(raw string) (*TaintSpec, error) {
+	var spec TaintSpec
+	if err := yaml.Unmarshal([]byte(raw), &spec); err != nil {
+		return nil, utils.Errorf("parse taint spec failed: %v", err)
+	}
+	if len(spec.Sources) == 0 {
+		return nil, utils.Errorf("taint spec %#v need at least one source", spec.Name)
+	}
+	if len(spec.Sinks) == 0 {
+		return nil, utils.Errorf("taint spec %#v need at least one sink", spec.Name)
+	}
+	return &spec, nil
+}
+
+var (
+	taintSpecMutex sync.RWMutex
+	taintSpecs     = make(map[string]*TaintSpec)
+)
+
+// RegisterTaintSpec register a taint spec by name, the spec with the same name will be replaced.
+func RegisterTaintSpec(spec *TaintSpec) error {
+	if spec == nil || spec.Name == "" {
+		return utils.Error("taint spec need name")
+	}
+	taintSpecMutex.Lock()
+	defer taintSpecMutex.Unlock()
+	taintSpecs[spec.Name] = spec
+	return nil
+}
+
+func GetTaintSpec(name string) (*TaintSpec, bool) {
+	taintSpecMutex.RLock()
+	defer taintSpecMutex.RUnlock()
+	spec, ok := taintSpecs[name]
+	return spec, ok
+}
+
+// GetTaintSpecsByLanguage return all taint specs of the language, sorted by name.
+func GetTaintSpecsByLanguage(language string) []*TaintSpec {
+	taintSpecMutex.RLock()
+	defer taintSpecMutex.RUnlock()
+	var ret []*TaintSpec
+	for _, spec := range taintSpecs {
+		if language == "" || strings.EqualFold(spec.Language, language) {
+			ret = append(ret, spec)
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Name < ret[j].Name
+	})
+	return ret
+}
+
+func init() {
+	entries, err := taintSpecFS.ReadDir("taintspec")
+	if err != nil {
+		log.Errorf("read build-in taint spec failed: %v", err)
+		return
+	}
+	for _, entry := range entries {
+		raw, err := taintSpecFS.ReadFile(path.Join("taintspec", entry.Name()))
+		if err != nil {
+			log.Errorf("read build-in taint spec %s failed: %v", entry.Name(), err)
+			continue
+		}
+		spec, err := ParseTaintSpec(string(raw))
+		if err != nil {
+			log.Errorf("build-in taint spec %s: %v", entry.Name(), err)
+			continue
+		}
+		if spec.Name == "" {
+			spec.Name = strings.TrimSuffix(entry.Name(), path.Ext(entry.Name()))
+		}
+		_ = RegisterTaintSpec(spec)
+	}
+}
@@ -0,0 +1,137 @@
+package ssaapi
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestC_Basic(t *testing.T) {
+	t.Run("assign and call", func(t *testing.T) {
+		code := `
+int main() {
+	int a = 1;
+	int b = a + 2;
+	printf("%d", b);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlow(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"\"%d\"", "1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("function return", func(t *testing.T) {
+		code := `
+int add(int x, int y) {
+	return x + y;
+}
+
+int main() {
+	int c = add(1, 2);
+	printf("%d", c);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("call before definition", func(t *testing.T) {
+		code := `
+static const char *get(void);
+
+int main() {
+	printf("%s", get());
+	return 0;
+}
+
+static const char *get(void) {
+	return "value";
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"\"value\""},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("if else phi", func(t *testing.T) {
+		code := `
+int main(int argc, char **argv) {
+	int a;
+	if (argc > 1) {
+		a = 1;
+	} else {
+		a = 2;
+	}
+	printf("%d", a);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("for and switch", func(t *testing.T) {
+		code := `
+int main(int argc, char **argv) {
+	int a = 0;
+	for (int i = 0; i < argc; i++) {
+		switch (i) {
+		case 1:
+			a = 10;
+			break;
+		default:
+			a = 20;
+		}
+	}
+	do {
+		a = a + 1;
+	} while (a < 100);
+	printf("%d", a);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"0", "10", "20", "1"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("macro expansion", func(t *testing.T) {
+		code := `
+#define PREFIX "echo "
+#define RUN(cmd) system(cmd)
+
+int main(int argc, char **argv) {
+	char buf[256];
+	sprintf(buf, PREFIX "%s", argv[1]);
+	RUN(buf);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"\"echo %s\"", "argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("conditional compilation", func(t *testing.T) {
+		code := `
+#define DEBUG 1
+int main() {
+#if DEBUG
+	int level = 1;
+#else
+	int level = 2;
+#endif
+	printf("%d", level);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlow(t, code, `printf(* #-> as $a)`, map[string][]string{
+			"a": {"\"%d\"", "1"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+}
@@ -0,0 +1,197 @@
+package ssaapi
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestC_Dataflow(t *testing.T) {
+	t.Run("argv to system by sprintf", func(t *testing.T) {
+		code := `
+#include <stdio.h>
+#include <stdlib.h>
+
+int main(int argc, char *argv[]) {
+	char cmd[128];
+	sprintf(cmd, "ping -c 1 %s", argv[1]);
+	system(cmd);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("recv to system", func(t *testing.T) {
+		code := `
+#include <sys/socket.h>
+
+void handle(int fd) {
+	char buf[1024];
+	int n = recv(fd, buf, sizeof(buf) - 1, 0);
+	if (n <= 0) {
+		return;
+	}
+	buf[n] = 0;
+	system(buf);
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"Undefined-recv", "fd"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("strcpy source and sink", func(t *testing.T) {
+		code := `
+#include <string.h>
+
+void copy(char *input) {
+	char local[16];
+	strcpy(local, input);
+	puts(local);
+}
+
+int main(int argc, char **argv) {
+	copy(argv[1]);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `strcpy(*?{opcode: param} as $a)`, map[string][]string{
+			"a": {"input"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+		ssatest.CheckSyntaxFlowContain(t, code, `puts(* #-> as $a)`, map[string][]string{
+			"a": {"argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("struct member", func(t *testing.T) {
+		code := `
+typedef struct {
+	int id;
+	char *cmd;
+} request_t;
+
+void run(request_t *req) {
+	system(req->cmd);
+}
+
+int main(int argc, char **argv) {
+	request_t req;
+	req.id = 1;
+	req.cmd = argv[1];
+	run(&req);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("struct initializer", func(t *testing.T) {
+		code := `
+struct option {
+	const char *name;
+	const char *value;
+};
+
+int main() {
+	struct option opt = {"name", "ls -al"};
+	system(opt.value);
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlow(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"\"ls -al\""},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("include header in project", func(t *testing.T) {
+		vf := filesys.NewVirtualFs()
+		vf.AddFile("include/config.h", `
+#ifndef CONFIG_H
+#define CONFIG_H
+#define SHELL "/bin/sh -c "
+const char *read_input(void);
+#endif
+`)
+		vf.AddFile("src/input.c", `
+#include "config.h"
+#include <stdlib.h>
+
+const char *read_input(void) {
+	return getenv("INPUT");
+}
+`)
+		vf.AddFile("src/main.c", `
+#include <stdio.h>
+#include "config.h"
+
+int main() {
+	char cmd[256];
+	snprintf(cmd, sizeof(cmd), SHELL "%s", read_input());
+	system(cmd);
+	return 0;
+}
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, vf, `system(* #-> as $a)`, map[string][]string{
+			"a": {"\"/bin/sh -c %s\"", "\"INPUT\""},
+		}, true, ssaapi.WithLanguage(ssaapi.C))
+	})
+}
+
+func TestCPP_Dataflow(t *testing.T) {
+	t.Run("class method and field", func(t *testing.T) {
+		code := `
+#include <string>
+
+class Command {
+public:
+	Command(const std::string &cmd) : cmd_(cmd) {}
+	void run() {
+		system(cmd_.c_str());
+	}
+private:
+	std::string cmd_;
+};
+
+int main(int argc, char **argv) {
+	Command c(argv[1]);
+	c.run();
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+
+	t.Run("method defined out of class", func(t *testing.T) {
+		code := `
+namespace app {
+class Handler {
+public:
+	std::string build(const std::string &name);
+};
+
+std::string Handler::build(const std::string &name) {
+	return "echo " + name;
+}
+}
+
+int main(int argc, char **argv) {
+	app::Handler h;
+	std::string cmd = h.build(argv[1]);
+	system(cmd.c_str());
+	return 0;
+}
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `system(* #-> as $a)`, map[string][]string{
+			"a": {"\"echo \"", "argv"},
+		}, ssaapi.WithLanguage(ssaapi.C))
+	})
+}
@@ -0,0 +1,105 @@
+package java
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func callGraphFS() *filesys.VirtualFS {
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("com/example/a/Animal.java", `package com.example.a;
+public interface Animal { String say(); }
+`)
+	vf.AddFile("com/example/a/Dog.java", `package com.example.a;
+public class Dog implements Animal {
+    public String say() { return helper(); }
+    private String helper() { return "wang"; }
+}
+`)
+	vf.AddFile("com/example/b/Main.java", `package com.example.b;
+import com.example.a.*;
+public class Main {
+    public static void run(Animal a) {
+        System.out.println(a.say());
+    }
+    public static void main(String[] args) { run(new Dog()); }
+}
+`)
+	return vf
+}
+
+func TestCallGraph(t *testing.T) {
+	ssatest.CheckWithFS(callGraphFS(), t, func(progs ssaapi.Programs) error {
+		require.NotEmpty(t, progs)
+		prog := progs[0]
+
+		graph := prog.GetCallGraph()
+		for _, edge := range graph.Edges {
+			t.Logf("%v -> %v (%v)", graph.GetNode(edge.From).Name, graph.GetNode(edge.To).Name, edge.Kind)
+		}
+		require.True(t, graph.HasEdge("Main_main", "Main_run", ssaapi.CodeGraphEdgeDirect))
+		require.True(t, graph.HasEdge("Dog.say", "Dog.helper", ssaapi.CodeGraphEdgeDirect))
+		require.True(t, graph.HasEdge("Main_run", "Dog.say", ssaapi.CodeGraphEdgeVirtual))
+		require.Equal(t, "com.example.a", graph.GetNodeByName("Dog.say").Package)
+		require.Nil(t, graph.GetNodeByName("extern:println"))
+
+		t.Run("package prefix", func(t *testing.T) {
+			graph := prog.GetCallGraph(ssaapi.WithCodeGraphPackagePrefix("com/example/a"))
+			require.NotNil(t, graph.GetNodeByName("Dog.say"))
+			require.Nil(t, graph.GetNodeByName("Main_run"))
+			require.True(t, graph.HasEdge("Dog.say", "Dog.helper", ""))
+			require.False(t, graph.HasEdge("Main_run", "Dog.say", ""))
+		})
+
+		t.Run("extern", func(t *testing.T) {
+			graph := prog.GetCallGraph(ssaapi.WithCodeGraphExtern(true))
+			require.True(t, graph.HasEdge("Main_run", "println", ssaapi.CodeGraphEdgeExtern))
+		})
+
+		t.Run("export", func(t *testing.T) {
+			raw, err := graph.Export("json")
+			require.NoError(t, err)
+			var decoded ssaapi.CodeGraph
+			require.NoError(t, json.Unmarshal(raw, &decoded))
+			require.Len(t, decoded.Edges, len(graph.Edges))
+
+			raw, err = graph.Export("graphml")
+			require.NoError(t, err)
+			require.Contains(t, string(raw), `<graphml xmlns="http://graphml.graphdrawing.org/xmlns">`)
+			require.Contains(t, string(raw), `<data key="edge_kind">virtual</data>`)
+
+			raw, err = graph.Export("dot")
+			require.NoError(t, err)
+			require.True(t, strings.Contains(string(raw), "digraph"))
+			require.Contains(t, string(raw), `"Dog.say"`)
+			require.Contains(t, string(raw), `"com.example.a"`)
+
+			_, err = graph.Export("svg")
+			require.Error(t, err)
+		})
+		return nil
+	}, ssaapi.WithLanguage(ssaapi.JAVA))
+}
+
+func TestPackageDependencyGraph(t *testing.T) {
+	ssatest.CheckWithFS(callGraphFS(), t, func(progs ssaapi.Programs) error {
+		require.NotEmpty(t, progs)
+		graph := progs[0].GetPackageDependencyGraph()
+		for _, edge := range graph.Edges {
+			t.Logf("%v -> %v (%v)", edge.From, edge.To, edge.Kind)
+		}
+		require.True(t, graph.HasEdge("com.example.b", "com.example.a", ssaapi.CodeGraphEdgeImport))
+		require.False(t, graph.HasEdge("com.example.a", "com.example.b", ""))
+
+		graph = progs[0].GetPackageDependencyGraph(ssaapi.WithCodeGraphPackagePrefix("com.example.b"))
+		require.Len(t, graph.Nodes, 1)
+		require.Empty(t, graph.Edges)
+		return nil
+	}, ssaapi.WithLanguage(ssaapi.JAVA))
+}
@@ -0,0 +1,190 @@
+package java
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssa/ssadb"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+func getIrCodeIdsBySource(t *testing.T, progName, code string) []int64 {
+	var ids []int64
+	err := ssadb.GetDB().Model(&ssadb.IrCode{}).
+		Where("program_name = ?", progName).
+		Where("source_code_hash = ?", codec.Sha256(code)).
+		Order("id asc").
+		Pluck("id", &ids).Error
+	require.NoError(t, err)
+	return ids
+}
+
+func TestIncrementalCompile(t *testing.T) {
+	codeA := `
+package com.example;
+class A {
+	public int get() {
+		return 1;
+	}
+}
+`
+	codeB := `
+package com.example;
+class B {
+	public static void main(String[] args) {
+		A a = new A();
+		println(a.get());
+	}
+}
+`
+	codeC := `
+package com.example;
+class C {
+	public void run(String cmd) {
+		Runtime.getRuntime().exec("ls");
+	}
+}
+`
+	codeCModified := `
+package com.example;
+class C {
+	public void run(String cmd) {
+		Runtime.getRuntime().exec(cmd);
+	}
+}
+`
+	codeD := `
+package com.example;
+class D {
+	public void call() {
+		println("d");
+	}
+}
+`
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("src/main/java/A.java", codeA)
+	vf.AddFile("src/main/java/B.java", codeB)
+	vf.AddFile("src/main/java/C.java", codeC)
+
+	progName := uuid.NewString()
+	defer ssadb.DeleteProgram(ssadb.GetDB(), progName)
+	opts := []ssaapi.Option{
+		ssaapi.WithLanguage(ssaapi.JAVA),
+		ssaapi.WithProgramName(progName),
+		ssaapi.WithIncrementalCompile(),
+	}
+
+	_, err := ssaapi.ParseProject(vf, opts...)
+	require.NoError(t, err)
+	idsA := getIrCodeIdsBySource(t, progName, codeA)
+	idsB := getIrCodeIdsBySource(t, progName, codeB)
+	require.NotEmpty(t, idsA)
+	require.NotEmpty(t, idsB)
+
+	t.Run("not changed", func(t *testing.T) {
+		changes, err := ssaapi.DiffProjectFiles(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+		require.NoError(t, err)
+		require.False(t, changes.HasChanged())
+		require.Len(t, changes.Unchanged, 3)
+
+		progs, err := ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+		require.Len(t, progs, 1)
+		require.Equal(t, idsA, getIrCodeIdsBySource(t, progName, codeA))
+	})
+
+	t.Run("modify and add file", func(t *testing.T) {
+		vf.AddFile("src/main/java/C.java", codeCModified)
+		vf.AddFile("src/main/java/D.java", codeD)
+
+		changes, err := ssaapi.DiffProjectFiles(vf, ssaapi.WithLanguage(ssaapi.JAVA), ssaapi.WithProgramName(progName))
+		require.NoError(t, err)
+		require.Equal(t, []string{"/src/main/java/C.java"}, changes.Modified)
+		require.Equal(t, []string{"/src/main/java/D.java"}, changes.Added)
+
+		_, err = ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+
+		// unchanged files keep their ir code
+		require.Equal(t, idsA, getIrCodeIdsBySource(t, progName, codeA))
+		require.Equal(t, idsB, getIrCodeIdsBySource(t, progName, codeB))
+		// the old version of modified file is removed
+		require.Empty(t, getIrCodeIdsBySource(t, progName, codeC))
+		require.NotEmpty(t, getIrCodeIdsBySource(t, progName, codeCModified))
+		require.NotEmpty(t, getIrCodeIdsBySource(t, progName, codeD))
+
+		prog, err := ssaapi.FromDatabase(progName)
+		require.NoError(t, err)
+		res, err := prog.SyntaxFlowWithError(`.exec(* #-> as $param)`)
+		require.NoError(t, err)
+		require.Contains(t, res.GetValues("param").String(), "cmd")
+	})
+
+	t.Run("modify file referenced by others", func(t *testing.T) {
+		codeAModified := strings.Replace(codeA, "return 1;", "return 2;", 1)
+		vf.AddFile("src/main/java/A.java", codeAModified)
+		_, err := ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+
+		// B use A, so B should be re-compiled too
+		require.NotEmpty(t, getIrCodeIdsBySource(t, progName, codeAModified))
+		newIdsB := getIrCodeIdsBySource(t, progName, codeB)
+		require.NotEmpty(t, newIdsB)
+		require.NotEqual(t, idsB, newIdsB)
+		idsA = getIrCodeIdsBySource(t, progName, codeAModified)
+		codeA = codeAModified
+	})
+
+	t.Run("remove file", func(t *testing.T) {
+		vf.RemoveFileOrDir("src/main/java/D.java")
+		_, err := ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+		require.Empty(t, getIrCodeIdsBySource(t, progName, codeD))
+		require.Equal(t, idsA, getIrCodeIdsBySource(t, progName, codeA))
+		require.NotContains(t, ssadb.GetProgramSourceHashes(ssadb.GetDB(), progName), "/src/main/java/D.java")
+	})
+
+	t.Run("add file only mention other class name", func(t *testing.T) {
+		vf.AddFile("src/main/java/E.java", `
+package com.example;
+class E {
+	public void call() {
+		println("A B");
+	}
+}
+`)
+		_, err := ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+		require.Equal(t, idsA, getIrCodeIdsBySource(t, progName, codeA))
+	})
+
+	t.Run("keep audit result of unchanged files", func(t *testing.T) {
+		prog, err := ssaapi.FromDatabase(progName)
+		require.NoError(t, err)
+		execResult, err := prog.SyntaxFlowWithError(`.exec(* as $param)`)
+		require.NoError(t, err)
+		execResultID, err := execResult.Save()
+		require.NoError(t, err)
+		printResult, err := prog.SyntaxFlowWithError(`.get() as $get`)
+		require.NoError(t, err)
+		require.NotEmpty(t, printResult.GetValues("get"))
+		printResultID, err := printResult.Save()
+		require.NoError(t, err)
+
+		vf.AddFile("src/main/java/C.java", strings.Replace(codeCModified, "exec(cmd)", "exec(cmd + \" -l\")", 1))
+		_, err = ssaapi.ParseProject(vf, opts...)
+		require.NoError(t, err)
+
+		_, err = ssadb.GetResultByID(execResultID)
+		require.Error(t, err)
+		_, err = ssadb.GetResultByID(printResultID)
+		require.NoError(t, err)
+		res, err := ssaapi.CreateResultByID(printResultID)
+		require.NoError(t, err)
+		require.NotEmpty(t, res.GetValues("get"))
+	})
+}
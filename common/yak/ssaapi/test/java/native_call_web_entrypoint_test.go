@@ -0,0 +1,137 @@
+package java
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+const webEntrypointCode = `package com.example;
+import org.springframework.web.bind.annotation.*;
+import javax.servlet.http.*;
+import javax.ws.rs.*;
+import com.opensymphony.xwork2.ActionSupport;
+
+@RestController
+@RequestMapping("/api")
+public class UserController {
+    @GetMapping(value = "/user/{id}")
+    public String get(@PathVariable("id") String id, @RequestParam(name="q") String q, HttpServletRequest req) {
+        String a = req.getParameter("aaa");
+        return id;
+    }
+
+    @RequestMapping(value = {"/a", "/b"}, method = RequestMethod.POST)
+    public String post(@RequestBody String body, @RequestHeader("X-Token") String token) {
+        return body;
+    }
+}
+
+@WebServlet(urlPatterns = "/servlet")
+public class MyServlet extends HttpServlet {
+    protected void doGet(HttpServletRequest req, HttpServletResponse resp) {
+        String n = req.getParameter("name");
+    }
+}
+
+@Path("/rs")
+public class RS {
+    @GET
+    @Path("/{id}")
+    public String get(@PathParam("id") String id, @QueryParam("q") String q) { return id; }
+}
+
+public class LoginAction extends ActionSupport {
+    private String username;
+    public void setUsername(String username) { this.username = username; }
+    public String execute() { return "success"; }
+}
+`
+
+func TestJavaWebEntrypoint(t *testing.T) {
+	ssatest.CheckJava(t, webEntrypointCode, func(prog *ssaapi.Program) error {
+		entries := make(map[string]*ssaapi.WebEntrypoint)
+		for _, entry := range prog.GetJavaWebEntrypoints() {
+			t.Log(entry.String())
+			entries[entry.Handler.GetName()] = entry
+		}
+
+		get := entries["UserController_get"]
+		require.NotNil(t, get)
+		require.Equal(t, ssaapi.WebFrameworkSpring, get.Framework)
+		require.Equal(t, []string{"/api/user/{id}"}, get.Routes)
+		require.Equal(t, []string{"GET"}, get.Methods)
+		params := make(map[string]string)
+		for _, p := range get.Params {
+			params[p.Name] = p.Source
+		}
+		require.Equal(t, ssaapi.WebParamPath, params["id"])
+		require.Equal(t, ssaapi.WebParamQuery, params["q"])
+		require.Equal(t, ssaapi.WebParamQuery, params["aaa"])
+
+		post := entries["UserController_post"]
+		require.NotNil(t, post)
+		require.Equal(t, []string{"/api/a", "/api/b"}, post.Routes)
+		require.Equal(t, []string{"POST"}, post.Methods)
+		params = make(map[string]string)
+		for _, p := range post.Params {
+			params[p.Name] = p.Source
+		}
+		require.Equal(t, ssaapi.WebParamBody, params["body"])
+		require.Equal(t, ssaapi.WebParamHeader, params["X-Token"])
+
+		servlet := entries["MyServlet_doGet"]
+		require.NotNil(t, servlet)
+		require.Equal(t, ssaapi.WebFrameworkServlet, servlet.Framework)
+		require.Equal(t, []string{"/servlet"}, servlet.Routes)
+
+		rs := entries["RS_get"]
+		require.NotNil(t, rs)
+		require.Equal(t, ssaapi.WebFrameworkJaxRS, rs.Framework)
+		require.Equal(t, []string{"/rs/{id}"}, rs.Routes)
+
+		action := entries["LoginAction_execute"]
+		require.NotNil(t, action)
+		require.Equal(t, ssaapi.WebFrameworkStruts, action.Framework)
+		require.Equal(t, []string{"/login"}, action.Routes)
+		require.Len(t, action.Params, 1)
+		require.Equal(t, "username", action.Params[0].Name)
+		return nil
+	}, ssaapi.WithLanguage(ssaapi.JAVA))
+}
+
+func TestJavaWebEntrypoint_NativeCall(t *testing.T) {
+	ssatest.CheckJava(t, webEntrypointCode, func(prog *ssaapi.Program) error {
+		result, err := prog.SyntaxFlowWithError(`
+<javaWebEntrypoint> as $params;
+<javaWebEntrypoint(framework: "spring", source: "body,header")> as $springBody;
+<javaWebEntrypoint(return: "handler", method: "POST")> as $postHandler;
+<javaWebEntrypoint(return: "route", framework: "jax-rs")> as $rsRoute;
+`)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, result.GetValues("params").Len(), 8)
+
+		springBody := result.GetValues("springBody")
+		require.Equal(t, 2, springBody.Len())
+		for _, v := range springBody {
+			require.Equal(t, "/api/a,/api/b", v.DescInfo["route"])
+		}
+
+		handlers := result.GetValues("postHandler")
+		names := make([]string, 0, handlers.Len())
+		for _, v := range handlers {
+			names = append(names, v.GetName())
+		}
+		// struts/servlet(web.xml) entrypoint without method accepts POST
+		require.Contains(t, names, "UserController_post")
+		require.NotContains(t, names, "UserController_get")
+		require.NotContains(t, names, "RS_get")
+
+		routes := result.GetValues("rsRoute")
+		require.Equal(t, 1, routes.Len())
+		require.Equal(t, "/rs/{id}", routes[0].GetConstValue())
+		return nil
+	}, ssaapi.WithLanguage(ssaapi.JAVA))
+}
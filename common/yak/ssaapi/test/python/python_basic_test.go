@@ -0,0 +1,132 @@
+package ssaapi
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestPython_Basic(t *testing.T) {
+	t.Run("assign and call", func(t *testing.T) {
+		code := `
+a = 1
+b = a + 2
+print(b)
+`
+		ssatest.CheckSyntaxFlow(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("function return", func(t *testing.T) {
+		code := `
+def f(x, y=2):
+    return x
+
+c = f(1)
+print(c)
+`
+		ssatest.CheckSyntaxFlow(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"1"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("if else phi", func(t *testing.T) {
+		code := `
+import os
+if os.getenv("A"):
+    a = 1
+else:
+    a = 2
+print(a)
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("f-string", func(t *testing.T) {
+		code := `
+import request
+name = request.args.get("name")
+cmd = f"echo {name}"
+os.system(cmd)
+`
+		ssatest.CheckSyntaxFlow(t, code, `os.system(* #-> as $a)`, map[string][]string{
+			"a": {"\"echo \"", "\"name\"", "Undefined-request", "Undefined-request.args(valid)", "Undefined-request.args.get(valid)"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("bytes literal", func(t *testing.T) {
+		code := `
+x = b"bytes"
+print(x)
+`
+		ssatest.CheckSyntaxFlow(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"\"bytes\""},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("for loop", func(t *testing.T) {
+		code := `
+a = 0
+for i in [1, 2]:
+    a = a + i
+print(a)
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"0", "1", "2"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("match statement", func(t *testing.T) {
+		code := `
+import os
+match os.getenv("CMD").split():
+    case ["run", *args] if args:
+        os.system(args)
+    case {"cmd": cmd}:
+        os.system(cmd)
+    case other:
+        print(other)
+`
+		ssatest.CheckSyntaxFlowContain(t, code, `os.system(* #-> as $a)`, map[string][]string{
+			"a": {"\"CMD\""},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+		ssatest.CheckSyntaxFlowContain(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"\"CMD\""},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("local variable not modify global", func(t *testing.T) {
+		code := `
+a = 1
+def f():
+    a = 2
+    return a
+f()
+print(a)
+`
+		ssatest.CheckSyntaxFlow(t, code, `print(* #-> as $a)`, map[string][]string{
+			"a": {"1"},
+		}, ssaapi.WithLanguage(ssaapi.PY))
+	})
+}
+
+func TestPython_Class(t *testing.T) {
+	code := `
+class A:
+    def __init__(self, value):
+        self.value = value
+
+    def get(self):
+        return self.value
+
+a = A(1)
+print(a.get())
+`
+	ssatest.CheckSyntaxFlowContain(t, code, `print(* #-> as $a)`, map[string][]string{
+		"a": {"1"},
+	}, ssaapi.WithLanguage(ssaapi.PY))
+}
@@ -0,0 +1,40 @@
+package ssaapi
+
+import (
+	"testing"
+
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestPython_Import(t *testing.T) {
+	t.Run("from import function", func(t *testing.T) {
+		vf := filesys.NewVirtualFs()
+		vf.AddFile("utils/helper.py", `
+def source():
+    return "hello"
+`)
+		vf.AddFile("main.py", `
+from utils.helper import source
+print(source())
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, vf, `print(* #-> as $a)`, map[string][]string{
+			"a": {"\"hello\""},
+		}, true, ssaapi.WithLanguage(ssaapi.PY))
+	})
+
+	t.Run("import module", func(t *testing.T) {
+		vf := filesys.NewVirtualFs()
+		vf.AddFile("config.py", `
+value = 1
+`)
+		vf.AddFile("main.py", `
+import config
+print(config.value)
+`)
+		ssatest.CheckSyntaxFlowWithFS(t, vf, `print(* #-> as $a)`, map[string][]string{
+			"a": {"1"},
+		}, true, ssaapi.WithLanguage(ssaapi.PY))
+	})
+}
@@ -1,7 +1,6 @@
 package ssatest
 
 import (
-	"errors"
 	"fmt"
 	"io/fs"
 	"sort"
@@ -474,6 +473,8 @@ func checkFunctionEx(
 	return nil
 }
 
+// EvaluateVerifyFilesystem check the rule with the fixtures in desc by ssaapi.CheckSyntaxFlowRuleFixture,
+// every fixture is checked in memory and with database.
 func EvaluateVerifyFilesystem(i string, t assert.TestingT) error {
 	frame, err := sfvm.NewSyntaxFlowVirtualMachine().Compile(i)
 	if err != nil {
@@ -485,119 +486,27 @@ func EvaluateVerifyFilesystem(i string, t assert.TestingT) error {
 	}
 
 	var errs []error
-	CheckWithFS(vfs, t, func(programs ssaapi.Programs) error {
-		result, err := programs.SyntaxFlowWithError(i, sfvm.WithEnableDebug(false))
-		if err != nil {
-			errs = append(errs, err)
-			return err
-		}
-		if len(result.GetErrors()) > 0 {
-			for _, e := range result.GetErrors() {
-				errs = append(errs, utils.Errorf("syntax flow failed: %v", e))
-			}
-			return utils.Errorf("syntax flow failed: %v", strings.Join(result.GetErrors(), "\n"))
-		}
-		if len(result.GetAlertVariables()) <= 0 {
-			errs = append(errs, utils.Errorf("alert symbol table is empty"))
-			return err
-		}
-		if frame.GetRule().AllowIncluded {
-			libOutput := result.GetValues("output")
-			if libOutput == nil {
-				errs = append(errs, utils.Errorf("lib: %v is not exporting output in `alert`", result.Name()))
-			}
-			if len(libOutput) <= 0 {
-				errs = append(errs, utils.Errorf("lib: %v is not exporting output in `alert` (empty result)", result.Name()))
-			}
-		}
-		var (
-			alertCount = 0
-			alert_high = 0
-			alert_mid  = 0
-			alert_info = 0
-		)
-
-		for _, name := range result.GetAlertVariables() {
-			alertCount += len(result.GetValues(name))
-			if info, b := result.GetAlertEx(name); b {
-				switch info.Level {
-				case "mid", "m", "middle":
-					alert_mid++
-				case "high", "h":
-					alert_high++
-				case "info", "low":
-					alert_info++
-				}
+	check := func(negative bool) func(ssaapi.Programs) error {
+		return func(programs ssaapi.Programs) error {
+			c := &ssaapi.SyntaxFlowRuleTestCase{Negative: negative, Language: l}
+			ssaapi.CheckSyntaxFlowRuleFixture(frame, i, c, programs)
+			for _, e := range c.Errors {
+				errs = append(errs, utils.Error(e))
 			}
-		}
-		if alertCount <= 0 {
-			errs = append(errs, utils.Errorf("alert symbol table is empty"))
 			return nil
 		}
-		result.Show()
-
-		ret := frame.GetExtraInfoInt("alert_min", "vuln_min", "alertMin", "vulnMin")
-		if ret > 0 {
-			if alertCount < frame.GetExtraInfoInt("alert_min", "vuln_min", "alertMin", "vulnMin") {
-				errs = append(errs, utils.Errorf("alert symbol table is less than alert_min config: %v actual got: %v", ret, alertCount))
-				return nil
-			}
-		}
-		high := frame.GetExtraInfoInt("alert_high", "alertHigh", "vulnHigh")
-		if high > 0 {
-			if alert_high < high {
-				errs = append(errs, utils.Errorf("alert symbol table is less than alert_high config: %v, actual got: %v", high, alert_high))
-				return nil
-			}
-		}
-		mid := frame.GetExtraInfoInt("alert_mid", "alertMid", "vulnMid")
-		if mid > 0 {
-			if alert_mid < mid {
-				errs = append(errs, utils.Errorf("alert symbol table is less than alert_mid config: %v, actual got: %v", mid, alert_mid))
-				return nil
-			}
-		}
-		low := frame.GetExtraInfoInt("alert_low", "alertMid", "vulnMid", "alert_info")
-		if low > 0 {
-			if alert_info < low {
-				errs = append(errs, utils.Errorf("alert symbol table is less than alert_low config: %v, actual got: %v", low, alert_info))
-				return nil
-			}
-		}
-		return nil
-	}, ssaapi.WithLanguage(l))
+	}
+	CheckWithFS(vfs, t, check(false), ssaapi.WithLanguage(l))
 	if len(errs) > 0 {
 		return utils.JoinErrors(errs...)
 	}
 
 	l, vfs, _ = frame.ExtractNegativeFilesystemAndLanguage()
 	if vfs != nil && l != "" {
-		CheckWithFS(vfs, t, func(programs ssaapi.Programs) error {
-			result, err := programs.SyntaxFlowWithError(i, sfvm.WithEnableDebug(false))
-			if err != nil {
-				if errors.Is(err, sfvm.CriticalError) {
-					errs = append(errs, err)
-					return err
-				}
-			}
-			if result != nil {
-				if len(result.GetErrors()) > 0 {
-					return nil
-				}
-				if len(result.GetAlertVariables()) > 0 {
-					for _, name := range result.GetAlertVariables() {
-						vals := result.GetValues(name)
-						errs = append(errs, utils.Errorf("alert symbol table not empty, have: %v: %v", name, vals))
-					}
-				}
-			}
-			return nil
-		})
+		CheckWithFS(vfs, t, check(true), ssaapi.WithLanguage(l))
 	}
-
 	if len(errs) > 0 {
 		return utils.JoinErrors(errs...)
 	}
-
 	return nil
 }
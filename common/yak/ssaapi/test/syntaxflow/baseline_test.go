@@ -0,0 +1,59 @@
+package syntaxflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+func TestSyntaxFlowBaselineDiff(t *testing.T) {
+	rule := `
+exec(* #-> as $source) as $sink
+alert $sink
+`
+	base := `
+a = cli.String("a")
+exec(a)
+exec("ls")
+`
+	// line moved and whitespace changed, "ls" fixed, "b" is new
+	head := `
+// a comment line
+
+a = cli.String("a")
+exec( a )
+b = cli.String("b")
+exec(b)
+`
+	query := func(code string) *ssaapi.SyntaxFlowResult {
+		prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.Yak))
+		require.NoError(t, err)
+		result, err := prog.SyntaxFlowWithError(rule)
+		require.NoError(t, err)
+		return result
+	}
+	baseResult, headResult := query(base), query(head)
+
+	diff := ssaapi.DiffSyntaxFlowResults(
+		[]*ssaapi.SyntaxFlowResult{baseResult},
+		[]*ssaapi.SyntaxFlowResult{headResult},
+	)
+	require.Len(t, diff.Unchanged, 1)
+	require.Contains(t, diff.Unchanged[0].String(), "a")
+	require.Len(t, diff.New, 1)
+	require.Contains(t, diff.New[0].String(), "b")
+	require.Equal(t, ssaapi.SyntaxFlowRiskNew, diff.New[0].State)
+	require.Len(t, diff.Fixed, 1)
+	require.Contains(t, diff.Fixed[0].String(), "ls")
+
+	t.Run("same result", func(t *testing.T) {
+		diff := ssaapi.DiffSyntaxFlowResults(
+			[]*ssaapi.SyntaxFlowResult{headResult},
+			[]*ssaapi.SyntaxFlowResult{query(head)},
+		)
+		require.Len(t, diff.Unchanged, 2)
+		require.Empty(t, diff.New)
+		require.Empty(t, diff.Fixed)
+	})
+}
@@ -0,0 +1,92 @@
+package syntaxflow
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+func TestDataFlowPath(t *testing.T) {
+	prog, err := ssaapi.Parse(`a = cli.String("a")
+b = a + "x"
+exec.System(b)
+`, ssaapi.WithLanguage(ssaapi.Yak))
+	require.NoError(t, err)
+	result, err := prog.SyntaxFlowWithError(`
+exec.System(* as $sink)
+$sink<taint(source: "cli.String()")> as $vuln
+alert $vuln
+`)
+	require.NoError(t, err)
+	vuln := result.GetValues("vuln")
+	require.Len(t, vuln, 1)
+
+	paths := vuln[0].GetDataFlowPaths()
+	require.NotEmpty(t, paths)
+	for _, path := range paths {
+		t.Log("\n" + path.String())
+		require.Equal(t, vuln[0].GetId(), path.Last().ValueID)
+		require.Empty(t, path.Last().Edge)
+		for i, step := range path.Steps {
+			require.Equal(t, i, step.Index)
+			if i != len(path.Steps)-1 {
+				require.NotEmpty(t, step.Edge)
+			}
+		}
+	}
+	var hasTaint bool
+	for _, path := range paths {
+		first := path.First()
+		if strings.Contains(first.Value, "cli.String") && first.Edge == ssaapi.DataFlowEdgePredecessor {
+			require.Contains(t, first.EdgeLabel, "taint: inline")
+			require.Equal(t, 1, first.StartLine)
+			hasTaint = true
+		}
+	}
+	require.True(t, hasTaint, "the taint source should be the origin of path")
+
+	t.Run("json", func(t *testing.T) {
+		raw, err := json.Marshal(paths)
+		require.NoError(t, err)
+		require.Contains(t, string(raw), `"edge":"predecessor"`)
+		require.Contains(t, string(raw), `"opcode":"Call"`)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		dot := vuln[0].DataFlowPathDotGraph()
+		t.Log(dot)
+		require.Contains(t, dot, "taint: inline")
+		for _, path := range paths {
+			for _, step := range path.Steps {
+				if step.Edge != "" {
+					require.Contains(t, dot, step.EdgeString())
+				}
+			}
+		}
+	})
+
+	t.Run("sarif", func(t *testing.T) {
+		report, err := ssaapi.ConvertSyntaxFlowResultToSarif(result)
+		require.NoError(t, err)
+		require.NotEmpty(t, report.Runs)
+		require.NotEmpty(t, report.Runs[0].Results)
+		flows := report.Runs[0].Results[0].CodeFlows
+		require.Len(t, flows, len(paths))
+		for i, flow := range flows {
+			require.Len(t, flow.ThreadFlows, 1)
+			locations := flow.ThreadFlows[0].Locations
+			require.Len(t, locations, len(paths[i].Steps))
+			for j, location := range locations {
+				step := paths[i].Steps[j]
+				require.Equal(t, step.Index, *location.ExecutionOrder)
+				if step.Edge != "" {
+					require.Equal(t, []string{step.Edge}, location.Kinds)
+				}
+				require.Equal(t, step.StartLine, *location.Location.PhysicalLocation.Region.StartLine)
+			}
+		}
+	})
+}
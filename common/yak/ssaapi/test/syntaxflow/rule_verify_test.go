@@ -0,0 +1,137 @@
+package syntaxflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+func TestSyntaxFlowExpectations(t *testing.T) {
+	code := `a = cli.String("a")
+exec.System(a) // sf:expect
+// sf:expect 2 $sink
+
+exec.System(a, a)
+exec.System("ls") # sf:expect-not
+`
+	expects := ssaapi.ParseSyntaxFlowExpectations("a.yak", code)
+	require.Len(t, expects, 3)
+	require.Equal(t, 2, expects[0].Line)
+	require.Equal(t, 1, expects[0].Count)
+	require.Equal(t, 5, expects[1].Line)
+	require.Equal(t, 2, expects[1].Count)
+	require.Equal(t, "sink", expects[1].Variable)
+	require.Equal(t, 6, expects[2].Line)
+	require.Equal(t, 0, expects[2].Count)
+}
+
+func TestSyntaxFlowRuleTest(t *testing.T) {
+	rule := func(fixture string) string {
+		return `
+desc(
+	title: "exec cli input",
+	lang: yak,
+	alert_min: 1,
+	'file://a.yak': <<<CODE
+` + fixture + `
+CODE,
+	'safefile://safe.yak': <<<CODE
+exec.System("ls")
+CODE
+)
+exec.System(* as $sink)
+$sink<taint(source: "cli.String()")> as $vuln
+alert $vuln
+`
+	}
+
+	t.Run("pass", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(rule(`
+a = cli.String("a")
+exec.System(a) // sf:expect
+exec.System("ls") // sf:expect-not
+`))
+		t.Log(report.String())
+		require.True(t, report.Passed())
+		require.False(t, report.Skipped())
+		require.Len(t, report.Cases, 2)
+		require.Equal(t, 1, report.Cases[0].AlertCount)
+		require.Equal(t, 0, report.Cases[1].AlertCount)
+	})
+
+	t.Run("position not match", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(rule(`
+a = cli.String("a")
+exec.System(a)
+exec.System("ls") // sf:expect
+`))
+		t.Log(report.String())
+		require.False(t, report.Passed())
+		require.Len(t, report.Cases[0].Errors, 1)
+		require.Contains(t, report.Cases[0].Errors[0], "a.yak:4 expect 1 alert, but got 0")
+	})
+
+	t.Run("safe fixture alerted", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(`
+desc(
+	lang: yak,
+	'file://a.yak': <<<CODE
+exec.System(cli.String("a"))
+CODE,
+	'safefile://safe.yak': <<<CODE
+exec.System(cli.String("b"))
+CODE
+)
+exec.System(* as $sink)
+alert $sink
+`)
+		t.Log(report.String())
+		require.False(t, report.Passed())
+		require.True(t, report.Cases[0].Passed())
+		require.False(t, report.Cases[1].Passed())
+	})
+
+	t.Run("alert level counts variables", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(`
+desc(
+	lang: yak,
+	alert_high: 2,
+	'file://a.yak': <<<CODE
+exec.System(cli.String("a"))
+exec.System(cli.String("b"))
+CODE
+)
+exec.System(* as $sink)
+alert $sink for {level: high}
+`)
+		t.Log(report.String())
+		require.False(t, report.Passed())
+		require.Equal(t, 2, report.Cases[0].AlertCount)
+		require.Contains(t, report.Cases[0].Errors[0], "alert high count 1 is less than alert_high 2")
+	})
+
+	t.Run("lib without output", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(`
+desc(
+	lang: yak,
+	lib: "yak-exec-sink",
+	'file://a.yak': <<<CODE
+exec.System(cli.String("a"))
+CODE
+)
+exec.System(* as $sink)
+alert $sink
+`)
+		t.Log(report.String())
+		require.False(t, report.Passed())
+		require.Len(t, report.Cases[0].Errors, 1)
+		require.Contains(t, report.Cases[0].Errors[0], "is not exporting output")
+	})
+
+	t.Run("no fixture", func(t *testing.T) {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(`exec.System(* as $sink)`)
+		require.True(t, report.Skipped())
+		require.True(t, report.Passed())
+	})
+}
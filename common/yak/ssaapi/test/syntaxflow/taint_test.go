@@ -0,0 +1,202 @@
+package syntaxflow
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+)
+
+func TestTaint_Spec(t *testing.T) {
+	code := `
+a = cli.String("a")
+b = codec.DecodeBase64(a)
+exec.System(b)
+
+c = cli.String("c")
+d = escape(c)
+exec.System(d)
+
+e = cli.String("e")
+if filter(e) {
+	exec.System(e)
+} else {
+	exec.System(e + "x")
+}
+
+f = "ls"
+if false {
+	f = cli.String("f")
+}
+exec.System(f)
+`
+	prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.Yak))
+	require.NoError(t, err)
+
+	check := func(t *testing.T, spec *ssaapi.TaintSpec, want ...string) {
+		res, err := prog.Taint(spec)
+		require.NoError(t, err)
+		t.Log(res.String())
+		var got []string
+		for _, path := range res.Paths {
+			require.Equal(t, path.Source, path.Nodes[0])
+			require.Equal(t, path.Sink, path.Nodes[len(path.Nodes)-1])
+			got = append(got, path.Source.String())
+		}
+		require.Len(t, got, len(want))
+		for i, w := range want {
+			require.Contains(t, got[i], w)
+		}
+	}
+
+	t.Run("build-in spec", func(t *testing.T) {
+		spec, ok := ssaapi.GetTaintSpec("yak")
+		require.True(t, ok)
+		// c is sanitized, exec(e) is guarded by filter, f is unreachable
+		check(t, spec, `"a"`, `"e"`)
+	})
+
+	t.Run("propagators", func(t *testing.T) {
+		spec, err := ssaapi.ParseTaintSpec(`
+name: yak-propagator
+language: yak
+sources:
+  - cli.String() as $source
+sinks:
+  - exec.System(* as $sink)
+propagators:
+  - escape() as $propagator
+`)
+		require.NoError(t, err)
+		// codec.DecodeBase64 is not propagator, escape is not sanitizer here
+		check(t, spec, `"c"`, `"e"`, `"e"`)
+	})
+}
+
+func TestTaint_GuardUnary(t *testing.T) {
+	code := `
+a = cli.String("a")
+if !filter(a) {
+	println("a is unsafe")
+} else {
+	exec.System(a)
+}
+
+b = cli.String("b")
+if -filter(b) {
+	println("b is unsafe")
+} else {
+	exec.System(b)
+}
+
+c = cli.String("c")
+if ^filter(c) {
+	println("c is unsafe")
+} else {
+	exec.System(c)
+}
+`
+	prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.Yak))
+	require.NoError(t, err)
+	spec, ok := ssaapi.GetTaintSpec("yak")
+	require.True(t, ok)
+	res, err := prog.Taint(spec)
+	require.NoError(t, err)
+	t.Log(res.String())
+	// only `!` flips the branch, the else branch of `-filter(b)` and `^filter(c)` is not guarded
+	var got []string
+	for _, path := range res.Paths {
+		got = append(got, path.Source.String())
+	}
+	require.Len(t, got, 2)
+	require.Contains(t, got[0], `"b"`)
+	require.Contains(t, got[1], `"c"`)
+}
+
+func TestTaint_NativeCall(t *testing.T) {
+	code := `
+a = cli.String("a")
+exec.System(a)
+b = cli.String("b")
+exec.System(escape(b))
+exec.System("ls")
+`
+	prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.Yak))
+	require.NoError(t, err)
+
+	t.Run("spec name", func(t *testing.T) {
+		res, err := prog.SyntaxFlowWithError(`<taint("yak")> as $vuln`)
+		require.NoError(t, err)
+		vuln := res.GetValues("vuln")
+		require.Len(t, vuln, 1)
+		require.Contains(t, vuln.String(), `"a"`)
+	})
+
+	t.Run("inline variable", func(t *testing.T) {
+		res, err := prog.SyntaxFlowWithError(`
+cli.String() as $input
+exec.System(* as $sink)
+$sink<taint(source: "$input")> as $vuln
+`)
+		require.NoError(t, err)
+		vuln := res.GetValues("vuln")
+		require.Len(t, vuln, 2)
+		var labels []string
+		for _, pred := range vuln[0].Predecessors {
+			labels = append(labels, pred.Info.Label)
+		}
+		require.Contains(t, labels, "taint: inline")
+	})
+
+	t.Run("inline sanitizer", func(t *testing.T) {
+		res, err := prog.SyntaxFlowWithError(`
+exec.System(* as $sink)
+$sink<taint(source: "cli.String()", sanitizer: "escape")> as $vuln
+`)
+		require.NoError(t, err)
+		vuln := res.GetValues("vuln")
+		require.Len(t, vuln, 1)
+		require.Contains(t, vuln.String(), `"a"`)
+	})
+}
+
+func TestTaint_Language(t *testing.T) {
+	t.Run("java servlet", func(t *testing.T) {
+		code := `
+import javax.servlet.http.*;
+public class CmdServlet extends HttpServlet {
+	protected void doGet(HttpServletRequest request, HttpServletResponse response) {
+		String cmd = request.getParameter("cmd");
+		Runtime.getRuntime().exec(cmd);
+		String safe = request.getParameter("safe");
+		Runtime.getRuntime().exec(Util.escapeShell(safe));
+	}
+}
+`
+		prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.JAVA))
+		require.NoError(t, err)
+		spec, ok := ssaapi.GetTaintSpec("java-servlet")
+		require.True(t, ok)
+		res, err := prog.Taint(spec)
+		require.NoError(t, err)
+		require.Len(t, res.Paths, 1)
+		require.Contains(t, res.Paths[0].Source.String(), `"cmd"`)
+	})
+
+	t.Run("php", func(t *testing.T) {
+		code := `<?php
+$a = $_GET["a"];
+system($a);
+$b = $_POST["b"];
+system(escapeshellarg($b));
+`
+		prog, err := ssaapi.Parse(code, ssaapi.WithLanguage(ssaapi.PHP))
+		require.NoError(t, err)
+		spec, ok := ssaapi.GetTaintSpec("php")
+		require.True(t, ok)
+		res, err := prog.Taint(spec)
+		require.NoError(t, err)
+		require.Len(t, res.Sinks(), 1)
+		require.Contains(t, res.Sinks().String(), "$a")
+	})
+}
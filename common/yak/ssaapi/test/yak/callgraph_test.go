@@ -0,0 +1,38 @@
+package ssaapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yak/ssaapi/test/ssatest"
+)
+
+func TestCallGraph_Closure(t *testing.T) {
+	ssatest.Check(t, `
+check = (a) => {
+	return a + 1
+}
+handle = func(b) {
+	inner = () => {
+		return check(b)
+	}
+	return inner()
+}
+handle(1)
+println("done")
+`, func(prog *ssaapi.Program) error {
+		graph := prog.GetCallGraph(ssaapi.WithCodeGraphExtern(true))
+		for _, edge := range graph.Edges {
+			t.Logf("%v -> %v (%v)", graph.GetNode(edge.From).Name, graph.GetNode(edge.To).Name, edge.Kind)
+		}
+		handle := graph.GetNodeByName("handle")
+		require.NotNil(t, handle)
+		require.Equal(t, ssaapi.CodeGraphNodeClosure, handle.Kind)
+		require.True(t, graph.HasEdge("main", "handle", ssaapi.CodeGraphEdgeClosure))
+		require.True(t, graph.HasEdge("handle", "inner", ssaapi.CodeGraphEdgeClosure))
+		require.True(t, graph.HasEdge("inner", "check", ssaapi.CodeGraphEdgeClosure))
+		require.True(t, graph.HasEdge("main", "println", ssaapi.CodeGraphEdgeExtern))
+		return nil
+	}, ssaapi.WithLanguage(ssaapi.Yak))
+}
@@ -596,6 +596,7 @@ var FuzzExports = map[string]interface{}{
 	"context":            mutate.OptContext,
 	"noEncode":           mutate.OptDisableAutoEncode,
 	"showTag":            mutate.OptFriendlyDisplay,
+	"jwtKey":             mutate.OptJWTKey,
 	"UrlsToHTTPRequests": mutate.UrlsToHTTPRequests,
 	"UrlToHTTPRequest":   _urlToFuzzRequest,
 
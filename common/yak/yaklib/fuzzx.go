@@ -23,6 +23,7 @@ var FuzzxExports = map[string]interface{}{
 	"noRedirect":         mutate.WithPoolOpt_NoFollowRedirect,
 	"noFixContentLength": mutate.WithPoolOpt_noFixContentLength,
 	"connPool":           mutate.WithPoolOpt_ConnPool,
+	"sessionHandler":     mutate.WithPoolOpt_SessionHandler,
 	"delay":              mutate.WithPoolOPt_DelaySeconds,
 	"namingContext":      mutate.WithPoolOpt_NamingContext,
 }
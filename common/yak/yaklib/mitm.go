@@ -31,6 +31,7 @@ var MitmExports = map[string]interface{}{
 	"gmtls":                mitmConfigGMTLS,
 	"gmtlsPrefer":          mitmConfigGMTLSPrefer,
 	"gmtlsOnly":            mitmConfigGMTLSOnly,
+	"http3":                mitmConfigHTTP3,
 }
 
 // Start 启动一个 MITM (中间人)代理服务器，它的第一个参数是端口，接下来可以接收零个到多个选项函数，用于影响中间人代理服务器的行为
@@ -58,6 +59,7 @@ type mitmConfig struct {
 	gmtls              bool
 	gmtlsPrefer        bool
 	gmtlsOnly          bool
+	http3              bool
 
 	// 是否开启透明劫持
 	isTransparent            bool
@@ -117,6 +119,18 @@ func mitmConfigGMTLSOnly(b bool) MitmConfigOpt {
 	}
 }
 
+// http3 是一个选项参数，用于指定中间人代理服务器是否同时在同端口的 UDP 上提供 HTTP/3 (QUIC) 劫持，默认为false
+// 浏览器不会通过代理发送 HTTP/3 请求，需要配合 hosts 劫持或 Alt-Svc 使用
+// Example:
+// ```
+// mitm.Start(8080, mitm.http3(true))
+// ```
+func mitmConfigHTTP3(b bool) MitmConfigOpt {
+	return func(config *mitmConfig) {
+		config.http3 = b
+	}
+}
+
 var MitmConfigContext = mitmConfigContext
 
 // context 是一个选项函数，用于指定中间人代理服务器的上下文
@@ -314,6 +328,7 @@ func startBridge(
 		crep.MITM_SetGM(config.gmtls),
 		crep.MITM_SetGMPrefer(config.gmtlsPrefer),
 		crep.MITM_SetGMOnly(config.gmtlsOnly),
+		crep.MITM_SetHTTP3(config.http3),
 		crep.MITM_SetWebsocketHijackMode(true),
 		crep.MITM_SetForceTextFrame(config.wsForceTextFrame),
 		crep.MITM_SetWebsocketRequestHijackRaw(func(req []byte, r *http.Request, rspIns *http.Response, t int64) []byte {
@@ -482,5 +482,8 @@ var FingerprintScanExports = map[string]interface{}{
 	// 全部服务扫描
 	"all": _allOption,
 
+	// 对 TLS 服务进行 JARM / JA4S / 证书链指纹识别
+	"tlsFingerprint": fp.WithTLSFingerprint,
+
 	"disableDefaultRule": _disableDefaultFingerprint,
 }
@@ -396,7 +396,7 @@ func MarshalYakitOutput(t interface{}) (string, string) {
 }
 
 func NewPortFromMatchResult(f *fp.MatchResult) *schema.Port {
-	return &schema.Port{
+	port := &schema.Port{
 		Host:        f.Target,
 		Port:        f.Port,
 		Proto:       string(f.GetProto()),
@@ -408,6 +408,14 @@ func NewPortFromMatchResult(f *fp.MatchResult) *schema.Port {
 		From:        "servicescan",
 		HtmlTitle:   f.GetHtmlTitle(),
 	}
+	if tlsFp := f.GetTLSFingerprint(); tlsFp != nil {
+		port.Jarm = tlsFp.JARM
+		port.JA4S = tlsFp.JA4S
+		port.JA4X = tlsFp.LeafJA4X()
+		port.CertChainHash = tlsFp.CertChainHash
+		port.TLSFingerprintName = strings.Join(tlsFp.MatchedNames(), "|")
+	}
+	return port
 }
 
 func NewPortFromSpaceEngineResult(f *base.NetSpaceEngineResult) *schema.Port {
@@ -128,6 +128,12 @@ func ToGrpcPort(r *schema.Port) *ypb.Port {
 		CreatedAt: r.CreatedAt.Unix(),
 		UpdatedAt: r.UpdatedAt.Unix(),
 		TaskName:  fixUTF8(r.TaskName),
+
+		Jarm:               r.Jarm,
+		JA4S:               r.JA4S,
+		JA4X:               r.JA4X,
+		CertChainHash:      r.CertChainHash,
+		TLSFingerprintName: fixUTF8(r.TLSFingerprintName),
 	}
 }
 
@@ -26,6 +26,7 @@ import (
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/mutate"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/comparer"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/poc"
@@ -596,6 +597,28 @@ func (s *Server) HTTPFuzzer(req *ypb.FuzzerRequest, stream ypb.Yak_HTTPFuzzerSer
 		_, task.Port, _ = utils.ParseStringToHostPort(task.Host)
 	}()
 
+	// 自动基线聚类，为每个响应计算异常分数
+	var anomalyDetector *comparer.AnomalyDetector
+	if req.GetEnableAnomalyDetection() {
+		anomalyDetector = comparer.NewAnomalyDetector(comparer.WithAnomalyBaselineSize(int(req.GetAnomalyBaselineSize())))
+	}
+
+	// 会话处理规则：宏请求、登出检测与自动重新登录
+	// 规则挂载到本次任务的 session 上，请求共享登录后下发的 Cookie，任务结束后卸载
+	var fuzzerSession string
+	if config := req.GetSessionHandlerConfig(); config != "" {
+		sessionHandler, err := buildFuzzerSessionHandler(config)
+		if err != nil {
+			return err
+		}
+		fuzzerSession = fuzzerSessionPreFix + runtimeID
+		lowhttp.AttachSessionHandler(fuzzerSession, sessionHandler)
+		defer func() {
+			lowhttp.AttachSessionHandler(fuzzerSession, nil)
+			lowhttp.CookiejarPool.Delete(fuzzerSession)
+		}()
+	}
+
 	inStatusCode := utils.ParseStringToPorts(req.GetRetryInStatusCode())
 	notInStatusCode := utils.ParseStringToPorts(req.GetRetryNotInStatusCode())
 
@@ -703,6 +726,7 @@ func (s *Server) HTTPFuzzer(req *ypb.FuzzerRequest, stream ypb.Yak_HTTPFuzzerSer
 			mutate.WithPoolOpt_RuntimeId(runtimeID),
 			mutate.WithPoolOpt_WithPayloads(true),
 			mutate.WithPoolOpt_RandomSession(true),
+			mutate.WithPoolOpt_Session(fuzzerSession),
 		}
 
 		fuzzMode := req.GetFuzzTagMode() // ""/"close"/"standard"/"legacy"
@@ -976,6 +1000,14 @@ func (s *Server) HTTPFuzzer(req *ypb.FuzzerRequest, stream ypb.Yak_HTTPFuzzerSer
 				}
 			}
 
+			if anomalyDetector != nil && rsp.Ok {
+				anomaly := anomalyDetector.Feed(rsp.ResponseRaw, rsp.DurationMs)
+				rsp.AnomalyScore = anomaly.Score
+				rsp.IsAnomaly = anomaly.IsOutlier
+				rsp.AnomalyClusterId = int64(anomaly.ClusterID)
+				rsp.AnomalyReasons = anomaly.Reasons
+			}
+
 			if rsp.StatusCode > 0 {
 				// 通过长度过滤
 				if minBody <= maxBody && (minBody > 0 || maxBody > 0) {
@@ -0,0 +1,54 @@
+package yakgrpc
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestGRPCMUSTPASS_HTTPFuzzer_AnomalyDetection(t *testing.T) {
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		id := request.URL.Query().Get("id")
+		if strings.Contains(id, "'") {
+			writer.WriteHeader(http.StatusInternalServerError)
+			writer.Write([]byte("You have an error in your SQL syntax; check the manual that corresponds to your MySQL server version"))
+			return
+		}
+		writer.Write([]byte("<html><body><h1>Article</h1><p>the article " + id + " is not published yet, please come back later or browse other articles</p></body></html>"))
+	})
+
+	c, err := NewLocalClient()
+	require.NoError(t, err)
+	stream, err := c.HTTPFuzzer(context.Background(), &ypb.FuzzerRequest{
+		Request: fmt.Sprintf(`GET /article?id={{list(1|2|3|4|5|6|1'|7)}} HTTP/1.1
+Host: %v
+`, utils.HostPort(host, port)),
+		ForceFuzz:              true,
+		Concurrent:             1,
+		EnableAnomalyDetection: true,
+	})
+	require.NoError(t, err)
+
+	var anomalies []string
+	for {
+		rsp, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		if rsp.GetIsAnomaly() {
+			anomalies = append(anomalies, rsp.GetPayloads()[0])
+			require.Greater(t, rsp.GetAnomalyScore(), 0.4)
+			require.Contains(t, rsp.GetAnomalyReasons(), "status code 500 differs from baseline 200")
+			require.EqualValues(t, 2, rsp.GetAnomalyClusterId())
+		} else {
+			require.EqualValues(t, 1, rsp.GetAnomalyClusterId())
+		}
+	}
+	require.Equal(t, []string{"1'"}, anomalies)
+}
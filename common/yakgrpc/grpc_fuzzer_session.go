@@ -0,0 +1,45 @@
+package yakgrpc
+
+import (
+	"encoding/json"
+
+	"github.com/yaklang/yaklang/common/log"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak/httptpl"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// fuzzerSessionConfig 是 Web Fuzzer 的会话处理配置，在 lowhttp.SessionHandler 的基础上支持使用 matcher 检测登出
+type fuzzerSessionConfig struct {
+	lowhttp.SessionHandler
+	LogoutMatchers []*ypb.HTTPResponseMatcher `json:"LogoutMatchers"`
+}
+
+func buildFuzzerSessionHandler(raw string) (*lowhttp.SessionHandler, error) {
+	var config fuzzerSessionConfig
+	if err := json.Unmarshal([]byte(raw), &config); err != nil {
+		return nil, utils.Wrap(err, "parse session handler config failed")
+	}
+	handler := &config.SessionHandler
+	if len(config.LogoutMatchers) > 0 {
+		matchers := make([]*httptpl.YakMatcher, 0, len(config.LogoutMatchers))
+		for _, m := range config.LogoutMatchers {
+			matchers = append(matchers, httptpl.NewMatcherFromGRPCModel(m))
+		}
+		handler.LogoutMatcher = func(rsp []byte) bool {
+			for _, m := range matchers {
+				matched, err := m.Execute(&httptpl.RespForMatch{RawPacket: rsp}, nil)
+				if err != nil {
+					log.Warnf("session logout matcher execute failed: %v", err)
+					continue
+				}
+				if matched {
+					return true
+				}
+			}
+			return false
+		}
+	}
+	return handler, nil
+}
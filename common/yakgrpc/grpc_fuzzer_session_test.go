@@ -0,0 +1,91 @@
+package yakgrpc
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestGRPCMUSTPASS_HTTPFuzzer_SessionHandler(t *testing.T) {
+	var (
+		mu      sync.Mutex
+		logins  int
+		session = map[string]int{}
+	)
+	host, port := utils.DebugMockHTTPHandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		switch request.URL.Path {
+		case "/login":
+			logins++
+			token := fmt.Sprintf("token%d", logins)
+			session[token] = 0
+			writer.Write([]byte(`{"token":"` + token + `"}`))
+		default:
+			token := request.Header.Get("X-Token")
+			used, ok := session[token]
+			// 每个 token 只能使用 2 次
+			if !ok || used >= 2 {
+				writer.Write([]byte(`{"code":401,"msg":"please login"}`))
+				return
+			}
+			session[token]++
+			writer.Write([]byte(`{"code":0,"data":"` + request.URL.Query().Get("id") + `"}`))
+		}
+	})
+	addr := utils.HostPort(host, port)
+
+	config, err := json.Marshal(map[string]any{
+		"LoginMacros": []map[string]any{{
+			"Packet":     "POST /login HTTP/1.1\r\nHost: " + addr + "\r\n\r\nuser=admin",
+			"Extractors": []map[string]any{{"Name": "token", "Type": "json", "Rule": "$.token"}},
+		}},
+		"Injections": []map[string]any{{"Variable": "token", "Position": "header", "Key": "X-Token"}},
+		"LogoutMatchers": []*ypb.HTTPResponseMatcher{{
+			MatcherType: "word",
+			Scope:       "body",
+			Group:       []string{"please login"},
+		}},
+	})
+	require.NoError(t, err)
+
+	c, err := NewLocalClient()
+	require.NoError(t, err)
+	stream, err := c.HTTPFuzzer(context.Background(), &ypb.FuzzerRequest{
+		Request: fmt.Sprintf(`GET /api?id={{int(1-6)}} HTTP/1.1
+Host: %v
+X-Token: expired
+`, addr),
+		ForceFuzz:            true,
+		Concurrent:           1,
+		SessionHandlerConfig: string(config),
+	})
+	require.NoError(t, err)
+
+	count := 0
+	for {
+		rsp, err := stream.Recv()
+		if err != nil {
+			break
+		}
+		count++
+		require.Contains(t, string(rsp.GetResponseRaw()), `"code":0`, string(rsp.GetRequestRaw()))
+	}
+	require.Equal(t, 6, count)
+	require.Equal(t, 3, logins)
+
+	// session handler of the task is detached after the task finished
+	lowhttp.SessionHandlerPool.Range(func(key, value any) bool {
+		require.False(t, strings.HasPrefix(utils.InterfaceToString(key), fuzzerSessionPreFix), key)
+		return true
+	})
+}
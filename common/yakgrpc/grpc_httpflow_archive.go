@@ -0,0 +1,82 @@
+package yakgrpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/httparchive"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+var httpArchiveFileExt = map[httparchive.Format]string{
+	httparchive.FormatHAR:     ".har",
+	httparchive.FormatBurp:    ".xml",
+	httparchive.FormatPostman: ".postman_collection.json",
+}
+
+func (s *Server) ExportHTTPFlowsToFile(ctx context.Context, req *ypb.ExportHTTPFlowsToFileRequest) (*ypb.ExportHTTPFlowsToFileResponse, error) {
+	format, err := httparchive.ParseFormat(req.GetFormat())
+	if err != nil {
+		return nil, err
+	}
+	raw, count, err := yakit.ExportHTTPFlowsToArchive(ctx, s.GetProjectDatabase(), req.GetFilter(), format, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	targetPath := req.GetTargetPath()
+	if targetPath == "" {
+		targetPath = filepath.Join(
+			consts.GetDefaultYakitBaseTempDir(),
+			fmt.Sprintf("httpflows-%v%v", time.Now().Format("20060102-150405"), httpArchiveFileExt[format]),
+		)
+	}
+	if err := os.WriteFile(targetPath, raw, 0o644); err != nil {
+		return nil, utils.Errorf("write %v failed: %v", targetPath, err)
+	}
+	return &ypb.ExportHTTPFlowsToFileResponse{
+		TargetPath: targetPath,
+		Count:      int64(count),
+	}, nil
+}
+
+func (s *Server) ImportHTTPFlowsFromFile(ctx context.Context, req *ypb.ImportHTTPFlowsFromFileRequest) (*ypb.ImportHTTPFlowsFromFileResponse, error) {
+	raw := req.GetContent()
+	if req.GetInputPath() != "" {
+		var err error
+		raw, err = os.ReadFile(req.GetInputPath())
+		if err != nil {
+			return nil, utils.Errorf("read %v failed: %v", req.GetInputPath(), err)
+		}
+	}
+	if len(raw) <= 0 {
+		return nil, utils.Error("empty http archive")
+	}
+
+	var format httparchive.Format
+	if req.GetFormat() != "" {
+		var err error
+		format, err = httparchive.ParseFormat(req.GetFormat())
+		if err != nil {
+			return nil, err
+		}
+	}
+	flows, format, err := yakit.ImportHTTPFlowsFromArchive(s.GetProjectDatabase(), raw, format, req.GetSourceType(), req.GetTags()...)
+	if err != nil {
+		return nil, err
+	}
+	rsp := &ypb.ImportHTTPFlowsFromFileResponse{
+		Format: string(format),
+		Count:  int64(len(flows)),
+	}
+	for _, flow := range flows {
+		rsp.Ids = append(rsp.Ids, int64(flow.ID))
+	}
+	return rsp, nil
+}
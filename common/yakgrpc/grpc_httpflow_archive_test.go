@@ -0,0 +1,104 @@
+package yakgrpc
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestGRPCMUSTPASS_HTTPFlow_ArchiveExportImport(t *testing.T) {
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+	db := consts.GetGormProjectDatabase()
+
+	token := utils.RandStringBytes(16)
+	request := []byte(fmt.Sprintf("POST /%v HTTP/1.1\r\nHost: www.example.com\r\nContent-Length: 3\r\n\r\na=1", token))
+	response := []byte("HTTP/1.1 200 OK\r\nContent-Type: text/html\r\nContent-Length: 5\r\n\r\nhello")
+	flow, err := yakit.CreateHTTPFlow(
+		yakit.CreateHTTPFlowWithHTTPS(true),
+		yakit.CreateHTTPFlowWithURL("https://www.example.com/"+token),
+		yakit.CreateHTTPFlowWithRequestRaw(request),
+		yakit.CreateHTTPFlowWithFixResponseRaw(response),
+		yakit.CreateHTTPFlowWithRemoteAddr("93.184.216.34:443"),
+		yakit.CreateHTTPFlowWithDuration(150*time.Millisecond),
+		yakit.CreateHTTPFlowWithSource("mitm"),
+		yakit.CreateHTTPFlowWithTags("YAKIT_COLOR_RED|"+token),
+	)
+	require.NoError(t, err)
+	flow.Notes = "notes-" + token
+	require.NoError(t, yakit.InsertHTTPFlow(db, flow))
+
+	for _, format := range []string{"har", "burp", "postman"} {
+		t.Run(format, func(t *testing.T) {
+			target := filepath.Join(t.TempDir(), "flows."+format)
+			exported, err := client.ExportHTTPFlowsToFile(context.Background(), &ypb.ExportHTTPFlowsToFileRequest{
+				Filter:     &ypb.QueryHTTPFlowRequest{IncludeId: []int64{int64(flow.ID)}},
+				Format:     format,
+				TargetPath: target,
+			})
+			require.NoError(t, err)
+			require.EqualValues(t, 1, exported.GetCount())
+			require.Equal(t, target, exported.GetTargetPath())
+
+			imported, err := client.ImportHTTPFlowsFromFile(context.Background(), &ypb.ImportHTTPFlowsFromFileRequest{
+				InputPath: target,
+				Tags:      []string{"imported"},
+			})
+			require.NoError(t, err)
+			require.Equal(t, format, imported.GetFormat())
+			require.EqualValues(t, 1, imported.GetCount())
+			defer yakit.DeleteHTTPFlow(db, &ypb.DeleteHTTPFlowRequest{Id: imported.GetIds()})
+
+			got, err := client.GetHTTPFlowById(context.Background(), &ypb.GetHTTPFlowByIdRequest{Id: imported.GetIds()[0]})
+			require.NoError(t, err)
+			require.Equal(t, request, got.GetRequest())
+			require.Equal(t, response, got.GetResponse())
+			require.True(t, got.GetIsHTTPS())
+			require.Equal(t, "import", got.GetSourceType())
+			require.Equal(t, "YAKIT_COLOR_RED|"+token+"|imported", got.GetTags())
+			require.Equal(t, flow.Notes, got.GetNotes())
+			stored, err := yakit.GetHTTPFlow(db, imported.GetIds()[0])
+			require.NoError(t, err)
+			require.Equal(t, "93.184.216.34:443", stored.RemoteAddr)
+			require.Equal(t, flow.CreatedAt.Unix(), got.GetCreatedAt())
+			if format != "burp" {
+				require.EqualValues(t, 150, got.GetDurationMs())
+			}
+		})
+	}
+	yakit.DeleteHTTPFlow(db, &ypb.DeleteHTTPFlowRequest{Id: []int64{int64(flow.ID)}})
+}
+
+func TestGRPCMUSTPASS_HybridScan_TargetFromHAR(t *testing.T) {
+	token := utils.RandStringBytes(16)
+	har := fmt.Sprintf(`{"log":{"version":"1.2","creator":{"name":"test","version":"1"},"entries":[
+{"startedDateTime":"2024-01-02T03:04:05Z","time":1,"request":{"method":"GET","url":"https://www.example.com/a?%v=1","httpVersion":"HTTP/1.1","headers":[],"queryString":[],"cookies":[],"headersSize":-1,"bodySize":0},"response":{"status":0,"statusText":"","httpVersion":"","headers":[],"cookies":[],"content":{"size":0,"mimeType":""},"redirectURL":"","headersSize":-1,"bodySize":-1},"cache":{},"timings":{"send":0,"wait":0,"receive":0}},
+{"startedDateTime":"2024-01-02T03:04:06Z","time":1,"request":{"method":"POST","url":"http://www.example.com/b","httpVersion":"HTTP/1.1","headers":[{"name":"Content-Type","value":"application/json"}],"queryString":[],"cookies":[],"headersSize":-1,"bodySize":2,"postData":{"mimeType":"application/json","text":"{}"}},"response":{"status":0,"statusText":"","httpVersion":"","headers":[],"cookies":[],"content":{"size":0,"mimeType":""},"redirectURL":"","headersSize":-1,"bodySize":-1},"cache":{},"timings":{"send":0,"wait":0,"receive":0}}
+]}}`, token)
+	fileName := filepath.Join(t.TempDir(), "targets.har")
+	require.NoError(t, os.WriteFile(fileName, []byte(har), 0o644))
+
+	targets, err := TargetGenerator(context.Background(), consts.GetGormProjectDatabase(), &ypb.HybridScanInputTarget{
+		InputFile: []string{fileName},
+	})
+	require.NoError(t, err)
+	var results []*HybridScanTarget
+	for target := range targets {
+		results = append(results, target)
+	}
+	require.Len(t, results, 2)
+	require.True(t, results[0].IsHttps)
+	require.Equal(t, "https://www.example.com/a?"+token+"=1", results[0].Url)
+	require.Contains(t, string(results[0].Request), "GET /a?"+token+"=1 HTTP/1.1")
+	require.False(t, results[1].IsHttps)
+	require.Contains(t, string(results[1].Request), "POST /b HTTP/1.1")
+}
@@ -866,7 +866,7 @@ func (s *Server) MITM(stream ypb.Yak_MITMServer) error {
 
 			// 处理响应规则
 			if replacer.haveHijackingRules() {
-				rules, rspHooked, dropped := replacer.hook(false, true, rsp)
+				rules, rspHooked, dropped := replacer.hook(false, true, rsp, req)
 				if dropped {
 					httpctx.SetContextValueInfoFromRequest(req, httpctx.RESPONSE_CONTEXT_KEY_IsDropped, true)
 					log.Warn("response should be dropped(VIA replacer.hook)")
@@ -883,7 +883,7 @@ func (s *Server) MITM(stream ypb.Yak_MITMServer) error {
 		}
 
 		// 非自动转发的情况下处理替换器
-		rules, rsp1, shouldBeDropped := replacer.hook(false, true, rsp)
+		rules, rsp1, shouldBeDropped := replacer.hook(false, true, rsp, req)
 		if shouldBeDropped {
 			log.Warn("response should be dropped(VIA replacer.hook)")
 			httpctx.SetContextValueInfoFromRequest(req, httpctx.RESPONSE_CONTEXT_KEY_IsDropped, true)
@@ -1645,6 +1645,38 @@ func (s *Server) MITM(stream ypb.Yak_MITMServer) error {
 			log.Debugf("insert http flow %v cost: %s", truncate(reqUrl), time.Now().Sub(startCreateFlow))
 		}
 	}
+	// 被过滤（直接放行）的流量，仅执行开启了 EnableForPassthrough 的替换规则
+	handlePassthroughRequest := func(isHttps bool, originReqIns *http.Request, req []byte) []byte {
+		if !replacer.havePassthroughRules() {
+			return req
+		}
+		rules, modified, dropped := replacer.hookPassthrough(true, false, req, isHttps, originReqIns)
+		if dropped {
+			log.Warn("MITM: passthrough request dropped by hook (VIA replacer.hookPassthrough)")
+			return nil
+		}
+		if len(rules) <= 0 {
+			return req
+		}
+		httpctx.AppendMatchedRule(originReqIns, rules...)
+		return modified
+	}
+	handlePassthroughResponse := func(isHttps bool, req *http.Request, rsp []byte) []byte {
+		if !replacer.havePassthroughRules() {
+			return rsp
+		}
+		rules, modified, dropped := replacer.hookPassthrough(false, true, rsp, req)
+		if dropped {
+			log.Warn("MITM: passthrough response dropped by hook (VIA replacer.hookPassthrough)")
+			return nil
+		}
+		if len(rules) <= 0 {
+			return rsp
+		}
+		httpctx.AppendMatchedRule(req, rules...)
+		return modified
+	}
+
 	// 核心 MITM 服务器
 	var opts []crep.MITMConfig
 	for _, cert := range firstReq.GetCertificates() {
@@ -1658,11 +1690,14 @@ func (s *Server) MITM(stream ypb.Yak_MITMServer) error {
 		crep.MITM_SetDownstreamProxy(downstreamProxy),
 		crep.MITM_SetHTTPResponseHijackRaw(handleHijackResponse),
 		crep.MITM_SetHTTPRequestHijackRaw(handleHijackRequest),
+		crep.MITM_SetPassthroughRequestRewriter(handlePassthroughRequest),
+		crep.MITM_SetPassthroughResponseRewriter(handlePassthroughResponse),
 		crep.MITM_SetWebsocketRequestHijackRaw(handleHijackWsRequest),
 		crep.MITM_SetWebsocketResponseHijackRaw(handleHijackWsResponse),
 		crep.MITM_SetHTTPResponseMirror(handleMirrorResponse),
 		crep.MITM_SetWebsocketHijackMode(true),
 		crep.MITM_SetHTTP2(firstReq.GetEnableHttp2()),
+		crep.MITM_SetHTTP3(firstReq.GetEnableHttp3()),
 		crep.MITM_MergeOptions(opts...),
 		crep.MITM_SetGM(enableGMTLS),
 		crep.MITM_SetGMPrefer(preferGMTLS),
@@ -1712,7 +1747,9 @@ func (s *Server) ExportMITMReplacerRules(ctx context.Context, _ *ypb.Empty) (*yp
 		if err != nil {
 			return nil, err
 		}
-		raw, err := json.MarshalIndent(replacers, "", "    ")
+		pack := NewMITMReplacerRulePack("mitm-replacer", replacers...)
+		pack.Version = consts.GetYakVersion()
+		raw, err := json.MarshalIndent(pack, "", "    ")
 		if err != nil {
 			return nil, err
 		}
@@ -1724,15 +1761,9 @@ func (s *Server) ExportMITMReplacerRules(ctx context.Context, _ *ypb.Empty) (*yp
 func (s *Server) ImportMITMReplacerRules(ctx context.Context, req *ypb.ImportMITMReplacerRulesRequest) (*ypb.Empty, error) {
 	replace := req.GetReplaceAll()
 
-	var newRules []*ypb.MITMContentReplacer
-	var newRule ypb.MITMContentReplacer
-	_ = json.Unmarshal(req.GetJsonRaw(), &newRules)
-	if len(newRules) < 0 {
-		_ = json.Unmarshal(req.GetJsonRaw(), &newRule)
-		if newRule.Rule == "" {
-			return nil, utils.Error("cannot identify rule (json)")
-		}
-		newRules = append(newRules, &newRule)
+	newRules, err := ParseMITMReplacerRules(req.GetJsonRaw())
+	if err != nil {
+		return nil, err
 	}
 
 	if len(newRules) <= 0 {
@@ -1740,7 +1771,11 @@ func (s *Server) ImportMITMReplacerRules(ctx context.Context, req *ypb.ImportMIT
 	}
 
 	if replace {
-		err := yakit.SetKey(s.GetProfileDatabase(), MITMReplacerKeyRecords, string(req.GetJsonRaw()))
+		raw, err := json.Marshal(newRules)
+		if err != nil {
+			return nil, err
+		}
+		err = yakit.SetKey(s.GetProfileDatabase(), MITMReplacerKeyRecords, string(raw))
 		if err != nil {
 			return nil, err
 		}
@@ -1761,7 +1796,7 @@ func (s *Server) ImportMITMReplacerRules(ctx context.Context, req *ypb.ImportMIT
 	if err != nil {
 		return nil, err
 	}
-	_ = yakit.SetKey(s.GetProfileDatabase(), MITMReplacerKeyRecords, string(raw))
+	err = yakit.SetKey(s.GetProfileDatabase(), MITMReplacerKeyRecords, string(raw))
 	if err != nil {
 		return nil, err
 	}
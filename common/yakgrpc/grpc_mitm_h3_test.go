@@ -0,0 +1,76 @@
+package yakgrpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/quic-go/quic-go"
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/utils/tlsutils"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestGRPCMUSTPASS_MITM_HTTP3(t *testing.T) {
+	ca, key, err := tlsutils.GenerateSelfSignedCertKey("127.0.0.1", nil, nil)
+	require.NoError(t, err)
+	cert, err := tls.X509KeyPair(ca, key)
+	require.NoError(t, err)
+	upstreamPort := utils.GetRandomAvailableUDPPort()
+	l, err := quic.ListenAddr(utils.HostPort("127.0.0.1", upstreamPort), &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{lowhttp.H3},
+	}, nil)
+	require.NoError(t, err)
+	defer l.Close()
+
+	ctx, cancel := context.WithCancel(utils.TimeoutContextSeconds(40))
+	defer cancel()
+	token := utils.RandStringBytes(32)
+	go lowhttp.ServeHTTP3(ctx, l, func(header []byte, body io.ReadCloser) ([]byte, io.ReadCloser, error) {
+		return []byte("HTTP/1.1 200 OK\r\nContent-Type: text/plain\r\n\r\n"), io.NopCloser(bytes.NewBufferString(token)), nil
+	})
+
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+	stream, err := client.MITM(ctx)
+	require.NoError(t, err)
+	mitmPort := utils.GetRandomAvailableTCPPort()
+	stream.Send(&ypb.MITMRequest{
+		Host:        "127.0.0.1",
+		Port:        uint32(mitmPort),
+		EnableHttp3: true,
+	})
+
+	for {
+		rsp, err := stream.Recv()
+		require.NoError(t, err)
+		if !strings.Contains(string(rsp.GetMessage().GetMessage()), "starting mitm server") {
+			continue
+		}
+		// HTTP/3 is served on the udp port with the same number as mitm
+		packet := fmt.Sprintf("GET /h3?token=%v HTTP/3\r\nHost: %v\r\n\r\n", token, utils.HostPort("127.0.0.1", upstreamPort))
+		httpRsp, err := lowhttp.HTTPWithoutRedirect(lowhttp.WithPacketBytes([]byte(packet)),
+			lowhttp.WithHost("127.0.0.1"), lowhttp.WithPort(mitmPort), lowhttp.WithTimeout(10*time.Second), lowhttp.WithSaveHTTPFlow(false))
+		require.NoError(t, err)
+		require.True(t, httpRsp.Http3)
+		_, body := lowhttp.SplitHTTPHeadersAndBodyFromPacket(httpRsp.RawPacket)
+		require.Equal(t, token, string(body))
+		break
+	}
+	cancel()
+
+	flows, err := QueryHTTPFlows(utils.TimeoutContextSeconds(3), client, &ypb.QueryHTTPFlowRequest{
+		Keyword:    token,
+		SourceType: "mitm",
+	}, 1)
+	require.NoError(t, err)
+	require.Contains(t, flows.GetData()[0].GetUrl(), "/h3?token=")
+}
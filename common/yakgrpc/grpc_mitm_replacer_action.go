@@ -0,0 +1,304 @@
+package yakgrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/antchfx/xmlquery"
+	"github.com/yaklang/yaklang/common/jsonpath"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yak"
+	"github.com/yaklang/yaklang/common/yak/yaklib"
+	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
+)
+
+const (
+	replacerActionRegexp   = "regexp"
+	replacerActionHeader   = "header"
+	replacerActionJSONPath = "json-path"
+	replacerActionXPath    = "xpath"
+	replacerActionYak      = "yak"
+)
+
+// replacerYakLib 是 yak 动作表达式中可用的库
+var replacerYakLib = map[string]any{
+	"str":   yaklib.StringsExport,
+	"re":    yaklib.RegexpExport,
+	"codec": yaklib.CodecExports,
+	"json":  yaklib.JsonExports,
+	"poc":   yaklib.PoCExports,
+}
+
+// replacerScope 描述数据包所属请求的 method / host / path，用于规则作用域匹配
+type replacerScope struct {
+	method string
+	host   string
+	path   string
+}
+
+func newReplacerScopeFromRequest(req *http.Request) *replacerScope {
+	if req == nil {
+		return nil
+	}
+	scope := &replacerScope{method: req.Method, host: req.Host}
+	if req.URL != nil {
+		if scope.host == "" {
+			scope.host = req.URL.Host
+		}
+		scope.path = req.URL.Path
+	}
+	return scope
+}
+
+func newReplacerScopeFromPacket(packet []byte) *replacerScope {
+	path := lowhttp.GetHTTPRequestPath(packet)
+	if i := strings.IndexAny(path, "?#"); i >= 0 {
+		path = path[:i]
+	}
+	return &replacerScope{
+		method: lowhttp.GetHTTPRequestMethod(packet),
+		host:   lowhttp.GetHTTPPacketHeader(packet, "Host"),
+		path:   path,
+	}
+}
+
+func (m *MITMReplaceRule) hasScope() bool {
+	return len(m.GetScopeHosts()) > 0 || len(m.GetScopePaths()) > 0 || len(m.GetScopeMethods()) > 0
+}
+
+// matchScope 判断数据包是否在规则作用域内，无法确定作用域时仅放行未设置作用域的规则
+func (m *MITMReplaceRule) matchScope(scope *replacerScope) bool {
+	if !m.hasScope() {
+		return true
+	}
+	if scope == nil {
+		return false
+	}
+	if methods := m.GetScopeMethods(); len(methods) > 0 {
+		matched := false
+		for _, method := range methods {
+			if strings.EqualFold(method, scope.method) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	if hosts := m.GetScopeHosts(); len(hosts) > 0 {
+		hostname, _, err := utils.ParseStringToHostPort(scope.host)
+		if err != nil {
+			hostname = scope.host
+		}
+		if !utils.MatchAnyOfGlob(scope.host, hosts...) && !utils.MatchAnyOfGlob(hostname, hosts...) {
+			return false
+		}
+	}
+	if paths := m.GetScopePaths(); len(paths) > 0 && !utils.MatchAnyOfGlob(scope.path, paths...) {
+		return false
+	}
+	return true
+}
+
+// requirementsMatched 条件链：RequireMatched 中的规则需要已经在当前数据包上命中
+func (m *MITMReplaceRule) requirementsMatched(matchedNames map[string]struct{}) bool {
+	for _, name := range m.GetRequireMatched() {
+		if _, ok := matchedNames[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func (m *MITMReplaceRule) actionType() string {
+	switch ret := strings.ToLower(strings.TrimSpace(m.GetActionType())); ret {
+	case "":
+		return replacerActionRegexp
+	case "jsonpath", "json_path", "json":
+		return replacerActionJSONPath
+	default:
+		return ret
+	}
+}
+
+func (m *MITMReplaceRule) isRegexpAction() bool {
+	return m.actionType() == replacerActionRegexp
+}
+
+// preconditionMatched Rule 在非正则动作中作为前置匹配条件，为空时总是满足
+func (m *MITMReplaceRule) preconditionMatched(packet []byte, isReq bool) (bool, error) {
+	if m.Rule == "" {
+		return true, nil
+	}
+	if !m.EnableForURI && !m.EnableForHeader && !m.EnableForBody {
+		re, err := m.compile()
+		if err != nil {
+			return false, err
+		}
+		return re.MatchString(string(packet))
+	}
+	_, matched, err := m.MatchPacket(packet, isReq)
+	if err != nil {
+		return false, err
+	}
+	return len(matched) > 0, nil
+}
+
+// applyAction 执行非正则替换的动作，返回是否命中以及修改后的数据包
+func (m *MITMReplaceRule) applyAction(packet []byte, isReq bool, scope *replacerScope) (bool, []byte, error) {
+	if isReq && !m.EnableForRequest || !isReq && !m.EnableForResponse {
+		return false, packet, nil
+	}
+	if m.actionType() == replacerActionHeader {
+		return m.applyHeaderAction(packet)
+	}
+
+	ok, err := m.preconditionMatched(packet, isReq)
+	if err != nil || !ok {
+		return false, packet, err
+	}
+	switch m.actionType() {
+	case replacerActionJSONPath:
+		return m.applyJSONPathAction(packet)
+	case replacerActionXPath:
+		return m.applyXPathAction(packet)
+	case replacerActionYak:
+		return m.applyYakAction(packet, isReq, scope)
+	default:
+		return false, packet, utils.Errorf("unsupported replacer action type: %v", m.GetActionType())
+	}
+}
+
+func (m *MITMReplaceRule) applyHeaderAction(packet []byte) (bool, []byte, error) {
+	key := m.GetActionTarget()
+	if key == "" {
+		return false, packet, utils.Error("header action need ActionTarget as header name")
+	}
+	value := lowhttp.GetHTTPPacketHeader(packet, key)
+	if m.Rule != "" {
+		if value == "" {
+			return false, packet, nil
+		}
+		re, err := m.compile()
+		if err != nil {
+			return false, packet, err
+		}
+		if ok, _ := re.MatchString(value); !ok {
+			return false, packet, nil
+		}
+		replaced, err := re.Replace(value, m.Result, -1, -1)
+		if err != nil {
+			return false, packet, err
+		}
+		return true, lowhttp.ReplaceHTTPPacketHeader(packet, key, replaced), nil
+	}
+	if m.Result == "" {
+		if value == "" {
+			return false, packet, nil
+		}
+		return true, lowhttp.DeleteHTTPPacketHeader(packet, key), nil
+	}
+	return true, lowhttp.ReplaceHTTPPacketHeader(packet, key, m.Result), nil
+}
+
+func (m *MITMReplaceRule) applyJSONPathAction(packet []byte) (bool, []byte, error) {
+	body := lowhttp.GetHTTPPacketBody(packet)
+	if !json.Valid(body) {
+		return false, packet, nil
+	}
+	if jsonpath.Find(body, m.GetActionTarget()) == nil {
+		return false, packet, nil
+	}
+	var value any = m.Result
+	var literal any
+	if err := json.Unmarshal([]byte(m.Result), &literal); err == nil {
+		value = literal
+	}
+	replaced, err := jsonpath.ReplaceStringWithError(string(body), m.GetActionTarget(), value)
+	if err != nil {
+		return false, packet, err
+	}
+	return true, lowhttp.ReplaceHTTPPacketBody(packet, []byte(replaced), false), nil
+}
+
+func (m *MITMReplaceRule) applyXPathAction(packet []byte) (bool, []byte, error) {
+	body := lowhttp.GetHTTPPacketBody(packet)
+	if len(bytes.TrimSpace(body)) == 0 {
+		return false, packet, nil
+	}
+	doc, err := xmlquery.Parse(bytes.NewReader(body))
+	if err != nil {
+		return false, packet, nil
+	}
+	nodes, err := xmlquery.QueryAll(doc, m.GetActionTarget())
+	if err != nil {
+		return false, packet, err
+	}
+	if len(nodes) <= 0 {
+		return false, packet, nil
+	}
+	for _, node := range nodes {
+		if node.Type == xmlquery.AttributeNode {
+			for i, attr := range node.Parent.Attr {
+				if attr.Name.Local == node.Data {
+					node.Parent.Attr[i].Value = m.Result
+				}
+			}
+			continue
+		}
+		for child := node.FirstChild; child != nil; {
+			next := child.NextSibling
+			xmlquery.RemoveFromTree(child)
+			child = next
+		}
+		xmlquery.AddChild(node, &xmlquery.Node{Type: xmlquery.TextNode, Data: m.Result})
+	}
+	// 原文没有 xml 声明时 xmlquery 会输出空声明 <?xml?>，需要去掉
+	output := strings.TrimPrefix(doc.OutputXML(false), "<?xml?>")
+	return true, lowhttp.ReplaceHTTPPacketBody(packet, []byte(output), false), nil
+}
+
+func (m *MITMReplaceRule) applyYakAction(packet []byte, isReq bool, scope *replacerScope) (bool, []byte, error) {
+	if m.GetYakExpression() == "" {
+		return false, packet, utils.Error("yak action need YakExpression")
+	}
+	m.sandboxOnce.Do(func() {
+		m.sandbox = yak.NewSandbox(yak.WithSandbox_ExternalLib(replacerYakLib))
+	})
+	vars := map[string]any{
+		"packet":     string(packet),
+		"isRequest":  isReq,
+		"isResponse": !isReq,
+		"method":     "",
+		"host":       "",
+		"path":       "",
+	}
+	if scope != nil {
+		vars["method"], vars["host"], vars["path"] = scope.method, scope.host, scope.path
+	}
+	value, err := m.sandbox.ExecuteAsExpressionRaw(m.GetYakExpression(), vars)
+	if err != nil {
+		return false, packet, err
+	}
+	switch ret := value.(type) {
+	case nil:
+		return false, packet, nil
+	case bool:
+		return ret, packet, nil
+	case string, []byte:
+		newPacket := codec.AnyToBytes(ret)
+		if len(newPacket) <= 0 {
+			return false, packet, nil
+		}
+		if isReq {
+			newPacket = lowhttp.FixHTTPRequest(newPacket)
+		}
+		return true, newPacket, nil
+	default:
+		return false, packet, utils.Errorf("yak action expression should return bool/string/bytes, got %T", value)
+	}
+}
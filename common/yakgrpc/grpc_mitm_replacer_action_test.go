@@ -0,0 +1,257 @@
+package yakgrpc
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/lowhttp"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+const replacerActionTestRequest = `POST /api/user?id=1 HTTP/1.1
+Host: www.example.com
+Content-Type: application/json
+X-Token: abc-123
+Content-Length: 36
+
+{"user":{"name":"guest","role":"u"}}`
+
+func TestGRPCMUSTPASS_ReplacerScope(t *testing.T) {
+	replacer := NewMITMReplacer()
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		Rule:             `guest`,
+		Result:           `admin`,
+		EnableForRequest: true,
+		EnableForBody:    true,
+		ScopeHosts:       []string{"*.example.com"},
+		ScopePaths:       []string{"/api/*"},
+		ScopeMethods:     []string{"post"},
+	})
+
+	_, modified, _ := replacer.hook(true, false, []byte(replacerActionTestRequest))
+	require.Contains(t, string(modified), `"admin"`)
+
+	outOfScope := strings.Replace(replacerActionTestRequest, "www.example.com", "www.example.org", 1)
+	_, modified, _ = replacer.hook(true, false, []byte(outOfScope))
+	require.Contains(t, string(modified), `"guest"`)
+
+	outOfScope = strings.Replace(replacerActionTestRequest, "POST", "PUT", 1)
+	_, modified, _ = replacer.hook(true, false, []byte(outOfScope))
+	require.Contains(t, string(modified), `"guest"`)
+
+	// response scope comes from the request
+	req, err := http.NewRequest("GET", "http://www.example.com/static/a.js", nil)
+	require.NoError(t, err)
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		Rule:              `guest`,
+		Result:            `admin`,
+		EnableForResponse: true,
+		EnableForBody:     true,
+		ScopePaths:        []string{"/api/*"},
+	})
+	rsp := []byte("HTTP/1.1 200 OK\r\nContent-Length: 5\r\n\r\nguest")
+	rules, _, _ := replacer.hook(false, true, rsp, req)
+	require.Len(t, rules, 0)
+	req.URL.Path = "/api/user"
+	rules, modified, _ = replacer.hook(false, true, rsp, req)
+	require.Len(t, rules, 1)
+	require.Contains(t, string(modified), "admin")
+}
+
+func TestGRPCMUSTPASS_ReplacerActions(t *testing.T) {
+	for _, testCase := range []struct {
+		name  string
+		rule  *ypb.MITMContentReplacer
+		check func(t *testing.T, packet []byte)
+	}{
+		{
+			name: "header replace by regexp",
+			rule: &ypb.MITMContentReplacer{
+				ActionType: "header", ActionTarget: "X-Token", Rule: `\d+`, Result: "456",
+			},
+			check: func(t *testing.T, packet []byte) {
+				require.Equal(t, "abc-456", lowhttp.GetHTTPPacketHeader(packet, "X-Token"))
+			},
+		},
+		{
+			name: "header set",
+			rule: &ypb.MITMContentReplacer{
+				ActionType: "header", ActionTarget: "X-Added", Result: "yes",
+			},
+			check: func(t *testing.T, packet []byte) {
+				require.Equal(t, "yes", lowhttp.GetHTTPPacketHeader(packet, "X-Added"))
+			},
+		},
+		{
+			name: "header delete",
+			rule: &ypb.MITMContentReplacer{
+				ActionType: "header", ActionTarget: "X-Token",
+			},
+			check: func(t *testing.T, packet []byte) {
+				require.NotContains(t, string(packet), "X-Token")
+			},
+		},
+		{
+			name: "json-path",
+			rule: &ypb.MITMContentReplacer{
+				ActionType: "json-path", ActionTarget: "$.user.role", Result: `"admin"`,
+			},
+			check: func(t *testing.T, packet []byte) {
+				var body map[string]map[string]any
+				require.NoError(t, json.Unmarshal(lowhttp.GetHTTPPacketBody(packet), &body))
+				require.Equal(t, "admin", body["user"]["role"])
+				require.Equal(t, "guest", body["user"]["name"])
+			},
+		},
+		{
+			name: "yak expression",
+			rule: &ypb.MITMContentReplacer{
+				ActionType:    "yak",
+				YakExpression: `str.ReplaceAll(packet, "guest", method + "-" + path)`,
+			},
+			check: func(t *testing.T, packet []byte) {
+				require.Contains(t, string(packet), `"POST-/api/user"`)
+			},
+		},
+	} {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.rule.EnableForRequest = true
+			replacer := NewMITMReplacer()
+			replacer.SetRules(testCase.rule)
+			rules, modified, _ := replacer.hook(true, false, []byte(replacerActionTestRequest))
+			require.Len(t, rules, 1)
+			testCase.check(t, modified)
+		})
+	}
+}
+
+func TestGRPCMUSTPASS_ReplacerXPathAction(t *testing.T) {
+	replacer := NewMITMReplacer()
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		ActionType:        "xpath",
+		ActionTarget:      "//user/name",
+		Result:            "admin",
+		EnableForResponse: true,
+	}, &ypb.MITMContentReplacer{
+		ActionType:        "xpath",
+		ActionTarget:      "//user/@role",
+		Result:            "root",
+		EnableForResponse: true,
+		Index:             1,
+	})
+	rsp := []byte("HTTP/1.1 200 OK\r\nContent-Type: text/xml\r\nContent-Length: 44\r\n\r\n<user role=\"u\"><name>guest</name></user>")
+	rules, modified, _ := replacer.hook(false, true, rsp)
+	require.Len(t, rules, 2)
+	body := string(lowhttp.GetHTTPPacketBody(modified))
+	require.Contains(t, body, "<name>admin</name>")
+	require.Contains(t, body, `role="root"`)
+}
+
+func TestGRPCMUSTPASS_ReplacerYakActionConcurrent(t *testing.T) {
+	replacer := NewMITMReplacer()
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		ActionType:       "yak",
+		YakExpression:    `str.ReplaceAll(packet, "guest", "admin")`,
+		EnableForRequest: true,
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 16; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, modified, _ := replacer.hook(true, false, []byte(replacerActionTestRequest))
+			require.Contains(t, string(modified), `"admin"`)
+		}()
+	}
+	wg.Wait()
+}
+
+func TestGRPCMUSTPASS_ReplacerChain(t *testing.T) {
+	replacer := NewMITMReplacer()
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		VerboseName:      "is-guest",
+		ActionType:       "yak",
+		YakExpression:    `str.Contains(packet, "guest")`,
+		EnableForRequest: true,
+		Index:            0,
+	}, &ypb.MITMContentReplacer{
+		RequireMatched:   []string{"is-guest"},
+		ActionType:       "header",
+		ActionTarget:     "X-Guest",
+		Result:           "1",
+		EnableForRequest: true,
+		StopOnMatch:      true,
+		Index:            1,
+	}, &ypb.MITMContentReplacer{
+		ActionType:       "header",
+		ActionTarget:     "X-After-Stop",
+		Result:           "1",
+		EnableForRequest: true,
+		Index:            2,
+	})
+
+	rules, modified, _ := replacer.hook(true, false, []byte(replacerActionTestRequest))
+	require.Len(t, rules, 2)
+	require.Equal(t, "1", lowhttp.GetHTTPPacketHeader(modified, "X-Guest"))
+	require.Empty(t, lowhttp.GetHTTPPacketHeader(modified, "X-After-Stop"))
+
+	notGuest := strings.ReplaceAll(replacerActionTestRequest, "guest", "alice")
+	rules, modified, _ = replacer.hook(true, false, []byte(notGuest))
+	require.Len(t, rules, 1)
+	require.Empty(t, lowhttp.GetHTTPPacketHeader(modified, "X-Guest"))
+	require.Equal(t, "1", lowhttp.GetHTTPPacketHeader(modified, "X-After-Stop"))
+}
+
+func TestGRPCMUSTPASS_ReplacerPassthrough(t *testing.T) {
+	replacer := NewMITMReplacer()
+	replacer.SetRules(&ypb.MITMContentReplacer{
+		Rule:             `guest`,
+		Result:           `admin`,
+		EnableForRequest: true,
+		EnableForBody:    true,
+	}, &ypb.MITMContentReplacer{
+		ActionType:           "header",
+		ActionTarget:         "X-Passthrough",
+		Result:               "1",
+		EnableForRequest:     true,
+		EnableForPassthrough: true,
+		Index:                1,
+	})
+	require.Len(t, replacer.GetPassthroughRules(), 1)
+
+	rules, modified, _ := replacer.hookPassthrough(true, false, []byte(replacerActionTestRequest))
+	require.Len(t, rules, 1)
+	require.Equal(t, "1", lowhttp.GetHTTPPacketHeader(modified, "X-Passthrough"))
+	require.Contains(t, string(modified), `"guest"`)
+}
+
+func TestGRPCMUSTPASS_ReplacerRulePack(t *testing.T) {
+	rules := []*ypb.MITMContentReplacer{
+		{Rule: "a", Result: "b", EnableForRequest: true},
+		{ActionType: "yak", YakExpression: "true", ScopeHosts: []string{"*.example.com"}},
+	}
+	pack, err := json.Marshal(NewMITMReplacerRulePack("test", rules...))
+	require.NoError(t, err)
+	parsed, err := ParseMITMReplacerRules(pack)
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+	require.Equal(t, []string{"*.example.com"}, parsed[1].ScopeHosts)
+
+	array, err := json.Marshal(rules)
+	require.NoError(t, err)
+	parsed, err = ParseMITMReplacerRules(array)
+	require.NoError(t, err)
+	require.Len(t, parsed, 2)
+
+	parsed, err = ParseMITMReplacerRules([]byte(`{"Rule": "a", "Result": "b"}`))
+	require.NoError(t, err)
+	require.Len(t, parsed, 1)
+
+	_, err = ParseMITMReplacerRules([]byte(`{"Color": "red"}`))
+	require.Error(t, err)
+}
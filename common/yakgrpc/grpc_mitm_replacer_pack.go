@@ -0,0 +1,61 @@
+package yakgrpc
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+const mitmReplacerRulePackKind = "mitm-replacer-rule-pack"
+
+// MITMReplacerRulePack 规则包，用于导入导出一组有序的 MITM 替换规则
+type MITMReplacerRulePack struct {
+	Kind        string                     `json:"Kind"`
+	Name        string                     `json:"Name,omitempty"`
+	Description string                     `json:"Description,omitempty"`
+	Version     string                     `json:"Version,omitempty"`
+	Rules       []*ypb.MITMContentReplacer `json:"Rules"`
+}
+
+func NewMITMReplacerRulePack(name string, rules ...*ypb.MITMContentReplacer) *MITMReplacerRulePack {
+	return &MITMReplacerRulePack{
+		Kind:  mitmReplacerRulePackKind,
+		Name:  name,
+		Rules: rules,
+	}
+}
+
+// ParseMITMReplacerRules 兼容规则包、规则数组以及单条规则三种 JSON 格式
+func ParseMITMReplacerRules(raw []byte) ([]*ypb.MITMContentReplacer, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) <= 0 {
+		return nil, utils.Error("empty rules json")
+	}
+
+	if raw[0] == '[' {
+		var rules []*ypb.MITMContentReplacer
+		if err := json.Unmarshal(raw, &rules); err != nil {
+			return nil, utils.Errorf("unmarshal rules failed: %v", err)
+		}
+		return rules, nil
+	}
+
+	var pack MITMReplacerRulePack
+	if err := json.Unmarshal(raw, &pack); err != nil {
+		return nil, utils.Errorf("unmarshal rule pack failed: %v", err)
+	}
+	if pack.Kind == mitmReplacerRulePackKind || pack.Rules != nil {
+		return pack.Rules, nil
+	}
+
+	var rule ypb.MITMContentReplacer
+	if err := json.Unmarshal(raw, &rule); err != nil {
+		return nil, utils.Errorf("unmarshal rule failed: %v", err)
+	}
+	if rule.Rule == "" && rule.ActionType == "" {
+		return nil, utils.Error("cannot identify rule (json)")
+	}
+	return []*ypb.MITMContentReplacer{&rule}, nil
+}
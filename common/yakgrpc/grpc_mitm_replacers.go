@@ -19,6 +19,7 @@ import (
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/utils/lowhttp/httpctx"
+	"github.com/yaklang/yaklang/common/yak"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
@@ -78,6 +79,9 @@ func FixPacket(packet []byte, isReq bool) (fixed []byte, use bool) {
 type MITMReplaceRule struct {
 	*ypb.MITMContentReplacer
 	cache *regexp2.Regexp
+	// 规则会被多个连接并发执行，sandbox 只初始化一次
+	sandbox     *yak.Sandbox
+	sandboxOnce sync.Once
 }
 
 func (r *MITMReplaceRule) compile() (*regexp2.Regexp, error) {
@@ -402,6 +406,8 @@ type mitmReplacer struct {
 	_hijackingRules Rules
 	// 已经启动的仅需要镜像劫持的规则
 	_mirrorRules Rules
+	// 已经启动的对被过滤（直接放行）流量生效的规则
+	_passthroughRules Rules
 
 	autoSave func(...*MITMReplaceRule)
 
@@ -457,16 +463,17 @@ func (m *mitmReplacer) LoadRules(ruleContents []*ypb.MITMContentReplacer) {
 	var rules []*MITMReplaceRule
 	for _, i := range ruleContents {
 		rules = append(rules, &MITMReplaceRule{
-			i,
-			nil,
+			MITMContentReplacer: i,
 		})
 	}
 	m._ruleRegexpCache = new(sync.Map)
 	m._hijackingRules = nil
 	m._mirrorRules = nil
+	m._passthroughRules = nil
 	m.allRules = sortContentReplacer(rules)
 	enabledRules := funk.Filter(rules, func(i *MITMReplaceRule) bool {
-		if i.Rule == "" {
+		// 非正则动作的 Rule 仅作为前置条件，可以为空
+		if i.Rule == "" && i.isRegexpAction() {
 			return false
 		}
 
@@ -476,12 +483,14 @@ func (m *mitmReplacer) LoadRules(ruleContents []*ypb.MITMContentReplacer) {
 		}
 
 		// 缓存
-		re, err := i.compile()
-		if err != nil {
-			log.Debugf("rule: %v(%v) is disabled(cannot compiled): %v", i.VerboseName, i.Rule, err)
-			return false
+		if i.Rule != "" {
+			re, err := i.compile()
+			if err != nil {
+				log.Debugf("rule: %v(%v) is disabled(cannot compiled): %v", i.VerboseName, i.Rule, err)
+				return false
+			}
+			log.Debugf("rule: %v is enabled", re.String())
 		}
-		log.Debugf("rule: %v is enabled", re.String())
 
 		if i.GetNoReplace() {
 			// mirror rules
@@ -490,6 +499,9 @@ func (m *mitmReplacer) LoadRules(ruleContents []*ypb.MITMContentReplacer) {
 		} else {
 			log.Debugf("load hijacked rules; %s", i.VerboseName)
 			m._hijackingRules = append(m._hijackingRules, i)
+			if i.GetEnableForPassthrough() {
+				m._passthroughRules = append(m._passthroughRules, i)
+			}
 		}
 
 		return true
@@ -497,6 +509,7 @@ func (m *mitmReplacer) LoadRules(ruleContents []*ypb.MITMContentReplacer) {
 	m.rules = sortContentReplacer(enabledRules)
 	m._mirrorRules = sortContentReplacer(m._mirrorRules)
 	m._hijackingRules = sortContentReplacer(m._hijackingRules)
+	m._passthroughRules = sortContentReplacer(m._passthroughRules)
 }
 
 func (m *mitmReplacer) AutoSaveCallback(f func(...*MITMReplaceRule)) {
@@ -534,6 +547,10 @@ func (m *mitmReplacer) GetHijackingRules() []*ypb.MITMContentReplacer {
 	return m._hijackingRules.MITMContentReplacers()
 }
 
+func (m *mitmReplacer) GetPassthroughRules() []*ypb.MITMContentReplacer {
+	return m._passthroughRules.MITMContentReplacers()
+}
+
 func stringForSettingColor(s string, extraTag []string, flow *schema.HTTPFlow) {
 	flow.AddTag(extraTag...)
 	log.Debugf("set color[%v] for %v", s, flow.Url)
@@ -595,12 +612,16 @@ func (m *mitmReplacer) hookColor(request, response []byte, req *http.Request, fl
 		return nil
 	}
 
+	scope := newReplacerScopeFromRequest(req)
 	for _, rule := range m._mirrorRules {
 		matchResults := make([]*yakit.MatchResult, 0)
 		newMatchResults := make([]*yakit.MatchResult, 0)
 		if !rule.EnableForRequest && !rule.EnableForResponse {
 			continue
 		}
+		if !rule.isRegexpAction() || !rule.matchScope(scope) {
+			continue
+		}
 
 		if rule.EnableForRequest {
 			_, newMatchResults, err = rule.MatchPacket(request, true)
@@ -660,6 +681,10 @@ func (m *mitmReplacer) haveHijackingRules() bool {
 	return m._hijackingRules != nil
 }
 
+func (m *mitmReplacer) havePassthroughRules() bool {
+	return m != nil && m._passthroughRules != nil
+}
+
 func (m *mitmReplacer) replaceHTTPHeader(rule *ypb.MITMContentReplacer, headerMerged string, bodyMerged []byte, isRequest bool) (string, bool /*matched*/) {
 	r := m.getRule(rule)
 	if r == nil {
@@ -815,18 +840,42 @@ func (m *mitmReplacer) replaceBody(rule *ypb.MITMContentReplacer, bodyMerged []b
 	return []byte(merged), true
 }
 
+// hook 对劫持的流量执行替换规则，args 中的 bool 表示是否为 https，*http.Request 用于确定响应的作用域
 func (m *mitmReplacer) hook(isRequest, isResponse bool, origin []byte, args ...any) ([]*ypb.MITMContentReplacer, []byte, bool) {
-	var matchedRules Rules
 	if m == nil {
-		return matchedRules.MITMContentReplacers(), origin, false
+		return nil, origin, false
 	}
-	var rules []*MITMReplaceRule
+	return m.hookRules(m._hijackingRules, isRequest, isResponse, origin, args...)
+}
+
+// hookPassthrough 对被过滤（直接放行）的流量执行开启了 EnableForPassthrough 的规则
+func (m *mitmReplacer) hookPassthrough(isRequest, isResponse bool, origin []byte, args ...any) ([]*ypb.MITMContentReplacer, []byte, bool) {
+	if m == nil {
+		return nil, origin, false
+	}
+	return m.hookRules(m._passthroughRules, isRequest, isResponse, origin, args...)
+}
 
-	rules = m._hijackingRules
+func (m *mitmReplacer) hookRules(rules Rules, isRequest, isResponse bool, origin []byte, args ...any) ([]*ypb.MITMContentReplacer, []byte, bool) {
+	var matchedRules Rules
 	if len(rules) <= 0 {
 		return matchedRules.MITMContentReplacers(), origin, false
 	}
 
+	var extraArgHttps bool
+	var scope *replacerScope
+	for _, arg := range args {
+		switch ret := arg.(type) {
+		case *http.Request:
+			scope = newReplacerScopeFromRequest(ret)
+		default:
+			extraArgHttps, _ = strconv.ParseBool(utils.InterfaceToString(ret))
+		}
+	}
+	if scope == nil && isRequest {
+		scope = newReplacerScopeFromPacket(origin)
+	}
+
 	originPacket := origin
 	_ = originPacket
 	if isResponse {
@@ -882,6 +931,8 @@ func (m *mitmReplacer) hook(isRequest, isResponse bool, origin []byte, args ...a
 	extraRepeat := false
 	var matchedRule *MITMReplaceRule
 	modifiedPacket := origin
+	// 条件链：记录当前数据包上已经命中的规则
+	matchedNames := make(map[string]struct{})
 	for _, rule := range rules {
 		if rule.NoReplace {
 			continue
@@ -889,13 +940,33 @@ func (m *mitmReplacer) hook(isRequest, isResponse bool, origin []byte, args ...a
 		if !((rule.EnableForRequest && isRequest) || (rule.EnableForResponse && isResponse) || rule.GetEnableForURI()) {
 			continue
 		}
-		matched, packet, err := rule.MatchAndReplacePacket(modifiedPacket, isRequest)
-		if err != nil && !isMatchTimeout(err) {
-			log.Errorf("match package failed: %v", err)
+		if !rule.matchScope(scope) || !rule.requirementsMatched(matchedNames) {
 			continue
 		}
-		modifiedPacket = packet
-		if len(matched) > 0 {
+
+		var matched bool
+		if rule.isRegexpAction() {
+			results, packet, err := rule.MatchAndReplacePacket(modifiedPacket, isRequest)
+			if err != nil && !isMatchTimeout(err) {
+				log.Errorf("match package failed: %v", err)
+				continue
+			}
+			if packet != nil {
+				modifiedPacket = packet
+			}
+			matched = len(results) > 0
+		} else {
+			ok, packet, err := rule.applyAction(modifiedPacket, isRequest, scope)
+			if err != nil {
+				log.Errorf("apply replacer action[%v] failed: %v", rule.actionType(), err)
+				continue
+			}
+			if ok {
+				modifiedPacket = packet
+			}
+			matched = ok
+		}
+		if matched {
 			if rule.GetDrop() {
 				dropPacket = true
 			}
@@ -906,14 +977,14 @@ func (m *mitmReplacer) hook(isRequest, isResponse bool, origin []byte, args ...a
 			}
 
 			matchedRules = append(matchedRules, rule)
+			matchedNames[rule.GetVerboseName()] = struct{}{}
+			if rule.GetStopOnMatch() {
+				break
+			}
 		}
 	}
 
 	if extraRepeat && isRequest { // 不修改原请求，修改后的请求作为额外发包
-		var extraArgHttps bool
-		if len(args) > 0 {
-			extraArgHttps, _ = strconv.ParseBool(utils.InterfaceToString(args[0]))
-		}
 		m.wg.Add(1)
 		go func() {
 			defer func() {
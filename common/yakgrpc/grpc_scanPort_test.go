@@ -11,11 +11,16 @@ import (
 	"testing"
 
 	"github.com/davecgh/go-spew/spew"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/consts"
+	"github.com/yaklang/yaklang/common/fp"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
 	"github.com/yaklang/yaklang/common/yak"
+	"github.com/yaklang/yaklang/common/yak/yaklib"
+	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 )
 
@@ -181,3 +186,48 @@ dump(res)
 		panic(err)
 	}
 }
+
+func TestGRPCMUSTPASS_QueryPorts_TLSFingerprint(t *testing.T) {
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+
+	host, port := utils.DebugMockHTTPS([]byte("HTTP/1.1 200 OK\r\nContent-Length: 2\r\n\r\nok"))
+	matcher, err := fp.NewDefaultFingerprintMatcher(fp.NewConfig(
+		fp.WithOnlyEnableWebFingerprint(true),
+		fp.WithTLSFingerprint(true),
+		fp.WithProbeTimeoutHumanRead(3),
+	))
+	require.NoError(t, err)
+	result, err := matcher.Match(host, port)
+	require.NoError(t, err)
+	require.True(t, result.IsOpen())
+	require.Len(t, result.GetJARM(), 62)
+	require.NotEmpty(t, result.GetJA4S())
+	require.NotEmpty(t, result.GetCertChainHash())
+
+	runtimeId := uuid.NewString()
+	p := yaklib.NewPortFromMatchResult(result)
+	p.RuntimeId = runtimeId
+	require.NoError(t, yakit.CreateOrUpdatePort(consts.GetGormProjectDatabase(), p.CalcHash(), p))
+	defer client.DeletePorts(context.Background(), &ypb.DeletePortsRequest{Filter: &ypb.QueryPortsRequest{RuntimeId: runtimeId}})
+
+	for _, fingerprint := range []string{result.GetJARM(), result.GetJA4S(), result.GetCertChainHash()} {
+		ports, err := client.QueryPorts(context.Background(), &ypb.QueryPortsRequest{
+			RuntimeId:      runtimeId,
+			TLSFingerprint: fingerprint,
+		})
+		require.NoError(t, err)
+		require.Len(t, ports.Data, 1)
+		require.Equal(t, result.GetJARM(), ports.Data[0].Jarm)
+		require.Equal(t, result.GetJA4S(), ports.Data[0].JA4S)
+		require.Equal(t, result.GetCertChainHash(), ports.Data[0].CertChainHash)
+		require.NotEmpty(t, ports.Data[0].JA4X)
+	}
+
+	ports, err := client.QueryPorts(context.Background(), &ypb.QueryPortsRequest{
+		RuntimeId:      runtimeId,
+		TLSFingerprint: "not-existed-fingerprint",
+	})
+	require.NoError(t, err)
+	require.Len(t, ports.Data, 0)
+}
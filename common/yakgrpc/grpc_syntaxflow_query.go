@@ -0,0 +1,88 @@
+package yakgrpc
+
+import (
+	"context"
+
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// SyntaxFlowQuery run syntaxflow on the compiled program, every value in result carries its data flow paths,
+// the paths are the same as the code flows in sarif and the `--dot` graph.
+func (s *Server) SyntaxFlowQuery(ctx context.Context, req *ypb.SyntaxFlowQueryRequest) (*ypb.SyntaxFlowQueryResponse, error) {
+	if req.GetProgramName() == "" {
+		return nil, utils.Error("program name is required")
+	}
+	if req.GetQueryContent() == "" {
+		return nil, utils.Error("query content is required")
+	}
+	prog, err := ssaapi.FromDatabase(req.GetProgramName())
+	if err != nil {
+		return nil, utils.Wrapf(err, "load program %v failed", req.GetProgramName())
+	}
+	result, err := prog.SyntaxFlowWithError(req.GetQueryContent())
+	if err != nil {
+		return nil, err
+	}
+
+	rsp := &ypb.SyntaxFlowQueryResponse{
+		RuleName: result.Name(),
+		Errors:   result.GetErrors(),
+	}
+	alerts := make(map[string]struct{})
+	for _, name := range result.GetAlertVariables() {
+		alerts[name] = struct{}{}
+	}
+	result.GetAllVariable().ForEach(func(name string, _ any) {
+		_, isAlert := alerts[name]
+		for _, v := range result.GetValues(name) {
+			item := syntaxFlowValueToGRPCModel(v)
+			item.Variable = name
+			item.IsAlert = isAlert
+			rsp.Values = append(rsp.Values, item)
+		}
+	})
+	return rsp, nil
+}
+
+func syntaxFlowValueToGRPCModel(v *ssaapi.Value) *ypb.SyntaxFlowResultValue {
+	ret := &ypb.SyntaxFlowResultValue{
+		ValueID: v.GetId(),
+		Value:   v.String(),
+		OpCode:  v.GetOpcode(),
+	}
+	if rng := v.GetRange(); rng != nil {
+		ret.Range = RangeIfToGrpcRange(rng)
+		ret.Range.Code = rng.GetText()
+		if editor := rng.GetEditor(); editor != nil {
+			ret.File = editor.GetFilename()
+		}
+	}
+	for _, path := range v.GetDataFlowPaths() {
+		item := &ypb.SyntaxFlowDataFlowPath{}
+		for _, step := range path.Steps {
+			grpcStep := &ypb.SyntaxFlowDataFlowStep{
+				Index:     int64(step.Index),
+				ValueID:   step.ValueID,
+				Value:     step.Value,
+				OpCode:    step.OpCode,
+				File:      step.File,
+				Edge:      step.Edge,
+				EdgeLabel: step.EdgeLabel,
+				Range: &ypb.Range{
+					StartLine:   int64(step.StartLine),
+					StartColumn: int64(step.StartColumn),
+					EndLine:     int64(step.EndLine),
+					EndColumn:   int64(step.EndColumn),
+				},
+			}
+			if rng := step.GetValue().GetRange(); rng != nil {
+				grpcStep.Range.Code = rng.GetText()
+			}
+			item.Steps = append(item.Steps, grpcStep)
+		}
+		ret.DataFlowPaths = append(ret.DataFlowPaths, item)
+	}
+	return ret
+}
@@ -0,0 +1,59 @@
+package yakgrpc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/yaklang/yaklang/common/utils/filesys"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+func TestGRPCMUSTPASS_SyntaxFlow_Query_DataFlowPath(t *testing.T) {
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+
+	vf := filesys.NewVirtualFs()
+	vf.AddFile("a.yak", `a = cli.String("a")
+b = a + "x"
+exec.System(b)
+`)
+	programName, clean := initProgram(t, vf, ssaapi.WithLanguage(ssaapi.Yak))
+	defer clean()
+
+	rsp, err := client.SyntaxFlowQuery(context.Background(), &ypb.SyntaxFlowQueryRequest{
+		ProgramName: programName,
+		QueryContent: `
+exec.System(* as $sink)
+$sink<taint(source: "cli.String()")> as $vuln
+alert $vuln
+`,
+	})
+	require.NoError(t, err)
+
+	var vuln *ypb.SyntaxFlowResultValue
+	for _, v := range rsp.GetValues() {
+		if v.GetVariable() == "vuln" {
+			vuln = v
+		}
+	}
+	require.NotNil(t, vuln)
+	require.True(t, vuln.GetIsAlert())
+	require.NotEmpty(t, vuln.GetDataFlowPaths())
+
+	var hasTaint bool
+	for _, path := range vuln.GetDataFlowPaths() {
+		steps := path.GetSteps()
+		require.NotEmpty(t, steps)
+		last := steps[len(steps)-1]
+		require.Equal(t, vuln.GetValueID(), last.GetValueID())
+		require.Empty(t, last.GetEdge())
+		if steps[0].GetEdgeLabel() != "" && steps[0].GetRange().GetStartLine() == 1 {
+			require.Contains(t, steps[0].GetEdgeLabel(), "taint")
+			require.Contains(t, steps[0].GetRange().GetCode(), "cli.String")
+			hasTaint = true
+		}
+	}
+	require.True(t, hasTaint)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/syntaxflow/sfdb"
+	"github.com/yaklang/yaklang/common/yak/ssaapi"
 	"github.com/yaklang/yaklang/common/yakgrpc/yakit"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
 	"strings"
@@ -92,4 +93,49 @@ func ParseSyntaxFlowInput(ruleInput *ypb.SyntaxFlowRuleInput) (*schema.SyntaxFlo
 	return rule, nil
 }
 
+// SyntaxFlowRuleTest run the rules with the fixtures in desc, the rule content in request is tested directly,
+// otherwise the rules in database filtered by request are tested one by one.
+func (s *Server) SyntaxFlowRuleTest(req *ypb.SyntaxFlowRuleTestRequest, stream ypb.Yak_SyntaxFlowRuleTestServer) error {
+	if req.GetContent() != "" {
+		report := ssaapi.RunSyntaxFlowRuleTestContent(req.GetContent())
+		rsp := syntaxFlowRuleTestReportToGRPCModel(report)
+		rsp.Total, rsp.Current = 1, 1
+		return stream.Send(rsp)
+	}
+
+	db := yakit.FilterSyntaxFlowRule(s.GetProfileDatabase().Model(&schema.SyntaxFlowRule{}), req.GetFilter())
+	var total int64
+	if err := db.Count(&total).Error; err != nil {
+		return err
+	}
+	var current int64
+	for rule := range sfdb.YieldSyntaxFlowRules(db, stream.Context()) {
+		current++
+		rsp := syntaxFlowRuleTestReportToGRPCModel(ssaapi.RunSyntaxFlowRuleTest(rule))
+		rsp.Total, rsp.Current = total, current
+		if err := stream.Send(rsp); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
+func syntaxFlowRuleTestReportToGRPCModel(report *ssaapi.SyntaxFlowRuleTestReport) *ypb.SyntaxFlowRuleTestResponse {
+	rsp := &ypb.SyntaxFlowRuleTestResponse{
+		RuleName: report.RuleName,
+		Status:   report.Status(),
+		Error:    report.Error,
+	}
+	for _, c := range report.Cases {
+		rsp.Cases = append(rsp.Cases, &ypb.SyntaxFlowRuleTestCase{
+			Name:       c.Name,
+			Negative:   c.Negative,
+			Language:   string(c.Language),
+			Files:      c.Files,
+			AlertCount: int64(c.AlertCount),
+			Errors:     c.Errors,
+			Passed:     c.Passed(),
+		})
+	}
+	return rsp
+}
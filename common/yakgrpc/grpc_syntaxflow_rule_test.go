@@ -6,6 +6,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+	"strings"
 	"testing"
 )
 
@@ -125,3 +126,74 @@ func TestGRPCMUSTPASS_SyntaxFlow_Rule(t *testing.T) {
 	})
 
 }
+
+func TestGRPCMUSTPASS_SyntaxFlow_RuleTest(t *testing.T) {
+	client, err := NewLocalClient()
+	require.NoError(t, err)
+
+	content := `
+desc(
+	title: "exec cli input",
+	lang: yak,
+	'file://a.yak': <<<CODE
+a = cli.String("a")
+exec.System(a) // sf:expect
+exec.System("ls") // sf:expect-not
+CODE,
+	'safefile://safe.yak': <<<CODE
+exec.System("ls")
+CODE
+)
+exec.System(* as $sink)
+$sink<taint(source: "cli.String()")> as $vuln
+alert $vuln
+`
+	check := func(stream ypb.Yak_SyntaxFlowRuleTestClient) *ypb.SyntaxFlowRuleTestResponse {
+		var rsps []*ypb.SyntaxFlowRuleTestResponse
+		for {
+			rsp, err := stream.Recv()
+			if err != nil {
+				break
+			}
+			rsps = append(rsps, rsp)
+		}
+		require.Len(t, rsps, 1)
+		return rsps[0]
+	}
+
+	t.Run("test rule content", func(t *testing.T) {
+		stream, err := client.SyntaxFlowRuleTest(context.Background(), &ypb.SyntaxFlowRuleTestRequest{
+			Content: content,
+		})
+		require.NoError(t, err)
+		rsp := check(stream)
+		require.Equal(t, "pass", rsp.GetStatus())
+		require.Len(t, rsp.GetCases(), 2)
+		require.Equal(t, int64(1), rsp.GetCases()[0].GetAlertCount())
+	})
+
+	t.Run("test rule in database", func(t *testing.T) {
+		ruleName := uuid.NewString()
+		_, err := client.CreateSyntaxFlowRule(context.Background(), &ypb.CreateSyntaxFlowRuleRequest{
+			SyntaxFlowInput: &ypb.SyntaxFlowRuleInput{
+				RuleName: ruleName,
+				Content:  strings.ReplaceAll(content, "exec.System(a) // sf:expect", "exec.System(a) // sf:expect 2"),
+				Language: "yak",
+			},
+		})
+		require.NoError(t, err)
+		defer client.DeleteSyntaxFlowRule(context.Background(), &ypb.DeleteSyntaxFlowRuleRequest{
+			Filter: &ypb.SyntaxFlowRuleFilter{RuleNames: []string{ruleName}},
+		})
+
+		stream, err := client.SyntaxFlowRuleTest(context.Background(), &ypb.SyntaxFlowRuleTestRequest{
+			Filter: &ypb.SyntaxFlowRuleFilter{RuleNames: []string{ruleName}},
+		})
+		require.NoError(t, err)
+		rsp := check(stream)
+		require.Equal(t, ruleName, rsp.GetRuleName())
+		require.Equal(t, "fail", rsp.GetStatus())
+		require.False(t, rsp.GetCases()[0].GetPassed())
+		require.True(t, rsp.GetCases()[1].GetPassed())
+	})
+}
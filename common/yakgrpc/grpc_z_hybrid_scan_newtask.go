@@ -12,6 +12,7 @@ import (
 	"github.com/yaklang/yaklang/common/consts"
 	"github.com/yaklang/yaklang/common/filter"
 	"github.com/yaklang/yaklang/common/fp"
+	"github.com/yaklang/yaklang/common/httparchive"
 	"github.com/yaklang/yaklang/common/log"
 	"github.com/yaklang/yaklang/common/netx"
 	"github.com/yaklang/yaklang/common/schema"
@@ -444,6 +445,8 @@ func TargetGenerator(ctx context.Context, db *gorm.DB, targetConfig *ypb.HybridS
 	outTarget := make(chan *HybridScanTarget)
 	inputTarget := targetConfig.GetInput()
 	inputTargetFile := targetConfig.GetInputFile()
+	// HAR / Burp XML / Postman Collection 中的请求直接作为目标，不再经过模板构建
+	var archiveTargets []*HybridScanTarget
 	if len(inputTargetFile) != 0 {
 		inputTarget += "\n" + strings.Join(
 			lo.FilterMap(inputTargetFile, func(file string, _ int) (string, bool) {
@@ -451,17 +454,44 @@ func TargetGenerator(ctx context.Context, db *gorm.DB, targetConfig *ypb.HybridS
 				if err != nil {
 					return "", false
 				}
+				if format := yakit.ParseArchiveFormatFromPath(file); format != "" {
+					flows, err := httparchive.Parse(fileContent, format)
+					if err == nil {
+						for _, flow := range flows {
+							archiveTargets = append(archiveTargets, &HybridScanTarget{
+								IsHttps: flow.IsHTTPS,
+								Request: flow.Request,
+								Url:     flow.GetUrl(),
+							})
+						}
+						return "", false
+					}
+					log.Warnf("parse %v as http archive failed: %v, fallback to plain targets", file, err)
+				}
 				return strings.ReplaceAll(string(fileContent), "\r", ""), true
 			}), "\n",
 		)
 	}
 	inputTarget = strings.TrimSpace(inputTarget)
-	buildRes, err := BuildHttpRequestPacket(db, targetConfig.GetHTTPRequestTemplate(), inputTarget)
-	if err != nil {
-		return nil, err
+	buildRes := make(chan *HTTPRequestBuilderRes)
+	if inputTarget != "" || len(archiveTargets) <= 0 {
+		var err error
+		buildRes, err = BuildHttpRequestPacket(db, targetConfig.GetHTTPRequestTemplate(), inputTarget)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		close(buildRes)
 	}
 	go func() {
 		defer close(outTarget)
+		for _, target := range archiveTargets {
+			select {
+			case <-ctx.Done():
+				return
+			case outTarget <- target:
+			}
+		}
 		for target := range buildRes {
 			select {
 			case <-ctx.Done():
@@ -16,6 +16,7 @@ import (
 	"github.com/yaklang/yaklang/common/mutate"
 	"github.com/yaklang/yaklang/common/schema"
 	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/utils/grpcx"
 	"github.com/yaklang/yaklang/common/utils/lowhttp"
 	"github.com/yaklang/yaklang/common/yak/yaklib/codec"
 	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
@@ -87,6 +88,7 @@ func toHTTPFlowGRPCModel(f *schema.HTTPFlow, full bool) (*ypb.HTTPFlow, error) {
 		TooLargeResponseBodyFile:   utf8safe(f.TooLargeResponseBodyFile),
 		TooLargeResponseHeaderFile: utf8safe(f.TooLargeResponseHeaderFile),
 		DurationMs:                 f.Duration / int64(time.Millisecond),
+		Notes:                      utf8safe(f.Notes),
 		Payloads: lo.Map(strings.Split(f.Payload, ","), func(i string, _ int) string {
 			return utf8safe(i)
 		}),
@@ -267,6 +269,17 @@ func toHTTPFlowGRPCModel(f *schema.HTTPFlow, full bool) (*ypb.HTTPFlow, error) {
 			}
 			flow.JsonObjects = append(flow.JsonObjects, utf8safe(j))
 		}
+
+		// gRPC 的 body 是 protobuf 二进制，解码后的消息以 JSON 的形式展示
+		if unquotedRequest != "" && grpcx.IsGRPCContentType(lowhttp.GetHTTPPacketHeader([]byte(unquotedRequest), "Content-Type")) {
+			messages := grpcx.DecodeRequestMessages([]byte(unquotedRequest))
+			if unquotedResponse != "" {
+				messages = append(messages, grpcx.DecodeResponseMessages([]byte(unquotedRequest), []byte(unquotedResponse))...)
+			}
+			for _, j := range messages {
+				flow.JsonObjects = append(flow.JsonObjects, utf8safe(j))
+			}
+		}
 	}
 	SetHTTPFlowCacheGRPCModel(f, full, flow)
 	return flow, nil
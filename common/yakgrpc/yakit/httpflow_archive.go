@@ -0,0 +1,127 @@
+package yakit
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jinzhu/gorm"
+	"github.com/yaklang/yaklang/common/httparchive"
+	"github.com/yaklang/yaklang/common/schema"
+	"github.com/yaklang/yaklang/common/utils"
+	"github.com/yaklang/yaklang/common/yakgrpc/ypb"
+)
+
+// HTTPFlowToArchiveFlow 转换为与存档格式无关的 httparchive.Flow，保留原始数据包
+func HTTPFlowToArchiveFlow(flow *schema.HTTPFlow) (*httparchive.Flow, error) {
+	request, err := strconv.Unquote(flow.Request)
+	if err != nil {
+		return nil, utils.Errorf("unquote request of httpflow[%v] failed: %v", flow.ID, err)
+	}
+	var response string
+	if flow.Response != "" {
+		response, err = strconv.Unquote(flow.Response)
+		if err != nil {
+			return nil, utils.Errorf("unquote response of httpflow[%v] failed: %v", flow.ID, err)
+		}
+	}
+	return &httparchive.Flow{
+		IsHTTPS:    flow.IsHTTPS,
+		Url:        flow.Url,
+		Request:    []byte(request),
+		Response:   []byte(response),
+		RemoteAddr: flow.RemoteAddr,
+		StartedAt:  flow.CreatedAt,
+		Duration:   time.Duration(flow.Duration),
+		Tags:       utils.PrettifyListFromStringSplited(flow.Tags, "|"),
+		Notes:      flow.Notes,
+	}, nil
+}
+
+// ArchiveFlowToHTTPFlow 由 httparchive.Flow 创建 HTTPFlow，不会写入数据库
+func ArchiveFlowToHTTPFlow(flow *httparchive.Flow, source string, extraTags ...string) (*schema.HTTPFlow, error) {
+	if source == "" {
+		source = "import"
+	}
+	ret, err := CreateHTTPFlow(
+		CreateHTTPFlowWithHTTPS(flow.IsHTTPS),
+		CreateHTTPFlowWithURL(flow.GetUrl()),
+		CreateHTTPFlowWithRequestRaw(flow.Request),
+		CreateHTTPFlowWithFixResponseRaw(flow.Response),
+		CreateHTTPFlowWithRemoteAddr(flow.RemoteAddr),
+		CreateHTTPFlowWithDuration(flow.Duration),
+		CreateHTTPFlowWithSource(source),
+	)
+	if err != nil {
+		return nil, err
+	}
+	ret.AddTag(flow.Tags...)
+	ret.AddTag(extraTags...)
+	ret.Notes = flow.Notes
+	if !flow.StartedAt.IsZero() {
+		ret.CreatedAt = flow.StartedAt
+		ret.UpdatedAt = flow.StartedAt
+	}
+	ret.Hash = ret.CalcHash()
+	return ret, nil
+}
+
+// ExportHTTPFlowsToArchive 按照过滤条件导出 HTTPFlow，返回存档内容与导出数量
+func ExportHTTPFlowsToArchive(ctx context.Context, db *gorm.DB, filter *ypb.QueryHTTPFlowRequest, format httparchive.Format, name string) ([]byte, int, error) {
+	db = FilterHTTPFlow(db, filter).Order("id asc")
+	var flows []*httparchive.Flow
+	for flow := range YieldHTTPFlows(db, ctx) {
+		archiveFlow, err := HTTPFlowToArchiveFlow(flow)
+		if err != nil {
+			return nil, 0, err
+		}
+		flows = append(flows, archiveFlow)
+	}
+	raw, err := httparchive.Marshal(flows, format, name)
+	if err != nil {
+		return nil, 0, err
+	}
+	return raw, len(flows), nil
+}
+
+// ImportHTTPFlowsFromArchive 解析存档并写入数据库，format 为空时自动识别
+func ImportHTTPFlowsFromArchive(db *gorm.DB, raw []byte, format httparchive.Format, source string, extraTags ...string) ([]*schema.HTTPFlow, httparchive.Format, error) {
+	if format == "" {
+		var err error
+		format, err = httparchive.DetectFormat(raw)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	flows, err := httparchive.Parse(raw, format)
+	if err != nil {
+		return nil, format, err
+	}
+	var ret []*schema.HTTPFlow
+	for _, flow := range flows {
+		httpFlow, err := ArchiveFlowToHTTPFlow(flow, source, extraTags...)
+		if err != nil {
+			return ret, format, utils.Errorf("create httpflow for %v failed: %v", flow.GetUrl(), err)
+		}
+		if err := InsertHTTPFlow(db, httpFlow); err != nil {
+			return ret, format, err
+		}
+		ret = append(ret, httpFlow)
+	}
+	return ret, format, nil
+}
+
+// ParseArchiveFormatFromPath 根据文件名推断存档格式，无法推断时返回空
+func ParseArchiveFormatFromPath(path string) httparchive.Format {
+	path = strings.ToLower(path)
+	switch {
+	case strings.HasSuffix(path, ".har"):
+		return httparchive.FormatHAR
+	case strings.HasSuffix(path, ".xml"):
+		return httparchive.FormatBurp
+	case strings.HasSuffix(path, ".postman_collection.json"):
+		return httparchive.FormatPostman
+	}
+	return ""
+}
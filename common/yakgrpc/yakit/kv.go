@@ -390,6 +390,12 @@ func ConfigureNetWork(c *ypb.GlobalNetworkConfig) {
 		netx.DialX_WithEnableSystemProxyFromEnv(c.GetEnableSystemProxyFromEnv()),
 	)
 
+	// 代理路由（规则 / PAC），配置未变化时沿用当前路由表
+	interval := time.Duration(c.GetProxyHealthCheckInterval()) * time.Second
+	if err := netx.ConfigureDefaultProxyRouter(c.GetProxyRoutes(), c.GetProxyPAC(), interval, c.GetProxyHealthCheckTarget()); err != nil {
+		log.Errorf("build proxy router failed: %s", err)
+	}
+
 	// 插件扫描黑白名单
 	SetGlobalPluginScanLists(c.IncludePluginScanURIs, c.ExcludePluginScanURIs)
 
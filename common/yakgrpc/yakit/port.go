@@ -3,6 +3,7 @@ package yakit
 import (
 	"context"
 	"github.com/yaklang/yaklang/common/schema"
+	"strings"
 	"time"
 
 	"github.com/jinzhu/gorm"
@@ -79,6 +80,10 @@ func CreateOrUpdatePort(db *gorm.DB, hash string, i interface{}) error {
 			p.ServiceType = utils.PrettifyShrinkJoin("/", p.ServiceType, ret.ServiceType)
 			p.CPE = utils.PrettifyShrinkJoin("|", p.CPE, p.CPE)
 			p.State = ret.State
+			if ret.Jarm != "" || ret.JA4S != "" || ret.CertChainHash != "" {
+				p.Jarm, p.JA4S, p.JA4X, p.CertChainHash = ret.Jarm, ret.JA4S, ret.JA4X, ret.CertChainHash
+				p.TLSFingerprintName = ret.TLSFingerprintName
+			}
 			return db.Save(p).Error
 		}
 	}
@@ -112,6 +117,7 @@ func QueryPorts(db *gorm.DB, params *ypb.QueryPortsRequest) (*bizhelper.Paginato
 	}
 	db = db.Model(&schema.Port{}) // .Debug(
 	db = db.Select(`id,created_at,updated_at,cpe,host,port,proto,service_type,task_name,html_title,` + "`from`" + `,hash,state,ip_integer,
+jarm,ja4s,ja4x,cert_chain_hash,tls_fingerprint_name,
 --when fingerprint length <=20kb return self
 case when 
 	length(fingerprint) <= 20480 then fingerprint
@@ -184,6 +190,13 @@ func FilterPort(db *gorm.DB, params *ypb.QueryPortsRequest) *gorm.DB {
 	if params.GetRuntimeId() != "" {
 		db = db.Where("runtime_id = ?", params.GetRuntimeId())
 	}
+	if tlsFp := strings.TrimSpace(params.GetTLSFingerprint()); tlsFp != "" {
+		// 精确匹配任意一种 TLS 指纹哈希，或模糊匹配命中的指纹名称
+		db = db.Where(
+			"jarm = ? OR ja4s = ? OR ja4x = ? OR cert_chain_hash = ? OR tls_fingerprint_name LIKE ?",
+			tlsFp, tlsFp, tlsFp, tlsFp, "%"+tlsFp+"%",
+		)
+	}
 	//else {
 	//	db = db.Where("runtime_id is null OR (runtime_id = '')")
 	//}
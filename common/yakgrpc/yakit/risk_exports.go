@@ -58,6 +58,7 @@ var (
 		{Types: []string{"ssti"}, Verbose: "SSTI"},
 		{Types: []string{"ssrf"}, Verbose: "SSRF"},
 		{Types: []string{"csrf"}, Verbose: "CSRF"},
+		{Types: []string{"http-request-smuggling", "request-smuggling", "desync"}, Verbose: "HTTP请求走私"},
 		{Types: []string{"random-port-trigger[tcp]"}, Verbose: "反连[TCP]-随机端口"},
 		{Types: []string{"random-port-trigger[udp]"}, Verbose: "反连[UDP]-随机端口"},
 		{Types: []string{"reverse", "reverse-"}, Verbose: "反连[unknown]"},
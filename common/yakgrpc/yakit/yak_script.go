@@ -130,6 +130,7 @@ func CreateTemporaryYakScriptEx(t string, code string, suffix ...string) (name s
 
 func NewTemporaryYakScript(t string, code string, suffix ...string) (*schema.YakScript, error) {
 	name := fmt.Sprintf("tmp-%v", ksuid.New().String()+strings.Join(suffix, ""))
+	var tags string
 	if strings.TrimSpace(strings.ToLower(t)) == "nuclei" {
 		// nuclei
 		tempInfo := make(map[string]any)
@@ -139,12 +140,23 @@ func NewTemporaryYakScript(t string, code string, suffix ...string) (*schema.Yak
 		}
 		nameInfo := utils.MapGetString(tempInfo, "id")
 		name = "[TMP]-" + nameInfo + "-" + ksuid.New().String() + strings.Join(suffix, "-")
+
+		// 保留 tags，workflows 会按 tags 查找模板
+		var desc struct {
+			Info struct {
+				Tags any `yaml:"tags"`
+			} `yaml:"info"`
+		}
+		if yaml.Unmarshal([]byte(code), &desc) == nil && desc.Info.Tags != nil {
+			tags = strings.Join(utils.InterfaceToStringSlice(desc.Info.Tags), ",")
+		}
 	}
 	return &schema.YakScript{
 		ScriptName: name,
 		Type:       t,
 		Content:    code,
 		Author:     "temp",
+		Tags:       tags,
 		Ignored:    true,
 	}, nil
 }
@@ -516,6 +516,10 @@ type YakClient interface {
 	UpdateSyntaxFlowRule(ctx context.Context, in *UpdateSyntaxFlowRuleRequest, opts ...grpc.CallOption) (*DbOperateMessage, error)
 	DeleteSyntaxFlowRule(ctx context.Context, in *DeleteSyntaxFlowRuleRequest, opts ...grpc.CallOption) (*DbOperateMessage, error)
 	QuerySyntaxFlowRuleGroup(ctx context.Context, in *QuerySyntaxFlowRuleGroupRequest, opts ...grpc.CallOption) (*QuerySyntaxFlowRuleGroupResponse, error)
+	SyntaxFlowRuleTest(ctx context.Context, in *SyntaxFlowRuleTestRequest, opts ...grpc.CallOption) (Yak_SyntaxFlowRuleTestClient, error)
+	SyntaxFlowQuery(ctx context.Context, in *SyntaxFlowQueryRequest, opts ...grpc.CallOption) (*SyntaxFlowQueryResponse, error)
+	ExportHTTPFlowsToFile(ctx context.Context, in *ExportHTTPFlowsToFileRequest, opts ...grpc.CallOption) (*ExportHTTPFlowsToFileResponse, error)
+	ImportHTTPFlowsFromFile(ctx context.Context, in *ImportHTTPFlowsFromFileRequest, opts ...grpc.CallOption) (*ImportHTTPFlowsFromFileResponse, error)
 }
 
 type yakClient struct {
@@ -5522,6 +5526,65 @@ func (c *yakClient) QuerySyntaxFlowRuleGroup(ctx context.Context, in *QuerySynta
 	return out, nil
 }
 
+func (c *yakClient) SyntaxFlowRuleTest(ctx context.Context, in *SyntaxFlowRuleTestRequest, opts ...grpc.CallOption) (Yak_SyntaxFlowRuleTestClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Yak_ServiceDesc.Streams[67], "/ypb.Yak/SyntaxFlowRuleTest", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &yakSyntaxFlowRuleTestClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Yak_SyntaxFlowRuleTestClient interface {
+	Recv() (*SyntaxFlowRuleTestResponse, error)
+	grpc.ClientStream
+}
+
+type yakSyntaxFlowRuleTestClient struct {
+	grpc.ClientStream
+}
+
+func (x *yakSyntaxFlowRuleTestClient) Recv() (*SyntaxFlowRuleTestResponse, error) {
+	m := new(SyntaxFlowRuleTestResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *yakClient) SyntaxFlowQuery(ctx context.Context, in *SyntaxFlowQueryRequest, opts ...grpc.CallOption) (*SyntaxFlowQueryResponse, error) {
+	out := new(SyntaxFlowQueryResponse)
+	err := c.cc.Invoke(ctx, "/ypb.Yak/SyntaxFlowQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yakClient) ExportHTTPFlowsToFile(ctx context.Context, in *ExportHTTPFlowsToFileRequest, opts ...grpc.CallOption) (*ExportHTTPFlowsToFileResponse, error) {
+	out := new(ExportHTTPFlowsToFileResponse)
+	err := c.cc.Invoke(ctx, "/ypb.Yak/ExportHTTPFlowsToFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yakClient) ImportHTTPFlowsFromFile(ctx context.Context, in *ImportHTTPFlowsFromFileRequest, opts ...grpc.CallOption) (*ImportHTTPFlowsFromFileResponse, error) {
+	out := new(ImportHTTPFlowsFromFileResponse)
+	err := c.cc.Invoke(ctx, "/ypb.Yak/ImportHTTPFlowsFromFile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // YakServer is the server API for Yak service.
 // All implementations must embed UnimplementedYakServer
 // for forward compatibility
@@ -6024,6 +6087,10 @@ type YakServer interface {
 	UpdateSyntaxFlowRule(context.Context, *UpdateSyntaxFlowRuleRequest) (*DbOperateMessage, error)
 	DeleteSyntaxFlowRule(context.Context, *DeleteSyntaxFlowRuleRequest) (*DbOperateMessage, error)
 	QuerySyntaxFlowRuleGroup(context.Context, *QuerySyntaxFlowRuleGroupRequest) (*QuerySyntaxFlowRuleGroupResponse, error)
+	SyntaxFlowRuleTest(*SyntaxFlowRuleTestRequest, Yak_SyntaxFlowRuleTestServer) error
+	SyntaxFlowQuery(context.Context, *SyntaxFlowQueryRequest) (*SyntaxFlowQueryResponse, error)
+	ExportHTTPFlowsToFile(context.Context, *ExportHTTPFlowsToFileRequest) (*ExportHTTPFlowsToFileResponse, error)
+	ImportHTTPFlowsFromFile(context.Context, *ImportHTTPFlowsFromFileRequest) (*ImportHTTPFlowsFromFileResponse, error)
 	mustEmbedUnimplementedYakServer()
 }
 
@@ -7186,6 +7253,18 @@ func (UnimplementedYakServer) DeleteSyntaxFlowRule(context.Context, *DeleteSynta
 func (UnimplementedYakServer) QuerySyntaxFlowRuleGroup(context.Context, *QuerySyntaxFlowRuleGroupRequest) (*QuerySyntaxFlowRuleGroupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QuerySyntaxFlowRuleGroup not implemented")
 }
+func (UnimplementedYakServer) SyntaxFlowRuleTest(*SyntaxFlowRuleTestRequest, Yak_SyntaxFlowRuleTestServer) error {
+	return status.Errorf(codes.Unimplemented, "method SyntaxFlowRuleTest not implemented")
+}
+func (UnimplementedYakServer) SyntaxFlowQuery(context.Context, *SyntaxFlowQueryRequest) (*SyntaxFlowQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SyntaxFlowQuery not implemented")
+}
+func (UnimplementedYakServer) ExportHTTPFlowsToFile(context.Context, *ExportHTTPFlowsToFileRequest) (*ExportHTTPFlowsToFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportHTTPFlowsToFile not implemented")
+}
+func (UnimplementedYakServer) ImportHTTPFlowsFromFile(context.Context, *ImportHTTPFlowsFromFileRequest) (*ImportHTTPFlowsFromFileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportHTTPFlowsFromFile not implemented")
+}
 func (UnimplementedYakServer) mustEmbedUnimplementedYakServer() {}
 
 // UnsafeYakServer may be embedded to opt out of forward compatibility for this service.
@@ -14380,6 +14459,81 @@ func _Yak_QuerySyntaxFlowRuleGroup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Yak_SyntaxFlowRuleTest_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SyntaxFlowRuleTestRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(YakServer).SyntaxFlowRuleTest(m, &yakSyntaxFlowRuleTestServer{stream})
+}
+
+type Yak_SyntaxFlowRuleTestServer interface {
+	Send(*SyntaxFlowRuleTestResponse) error
+	grpc.ServerStream
+}
+
+type yakSyntaxFlowRuleTestServer struct {
+	grpc.ServerStream
+}
+
+func (x *yakSyntaxFlowRuleTestServer) Send(m *SyntaxFlowRuleTestResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Yak_SyntaxFlowQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyntaxFlowQueryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YakServer).SyntaxFlowQuery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ypb.Yak/SyntaxFlowQuery",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YakServer).SyntaxFlowQuery(ctx, req.(*SyntaxFlowQueryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Yak_ExportHTTPFlowsToFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportHTTPFlowsToFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YakServer).ExportHTTPFlowsToFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ypb.Yak/ExportHTTPFlowsToFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YakServer).ExportHTTPFlowsToFile(ctx, req.(*ExportHTTPFlowsToFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Yak_ImportHTTPFlowsFromFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportHTTPFlowsFromFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YakServer).ImportHTTPFlowsFromFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ypb.Yak/ImportHTTPFlowsFromFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YakServer).ImportHTTPFlowsFromFile(ctx, req.(*ImportHTTPFlowsFromFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Yak_ServiceDesc is the grpc.ServiceDesc for Yak service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -15659,6 +15813,18 @@ var Yak_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QuerySyntaxFlowRuleGroup",
 			Handler:    _Yak_QuerySyntaxFlowRuleGroup_Handler,
 		},
+		{
+			MethodName: "SyntaxFlowQuery",
+			Handler:    _Yak_SyntaxFlowQuery_Handler,
+		},
+		{
+			MethodName: "ExportHTTPFlowsToFile",
+			Handler:    _Yak_ExportHTTPFlowsToFile_Handler,
+		},
+		{
+			MethodName: "ImportHTTPFlowsFromFile",
+			Handler:    _Yak_ImportHTTPFlowsFromFile_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -16006,6 +16172,11 @@ var Yak_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Yak_FetchPortAssetFromSpaceEngine_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SyntaxFlowRuleTest",
+			Handler:       _Yak_SyntaxFlowRuleTest_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "yakgrpc.proto",
 }